@@ -0,0 +1,219 @@
+// Package axes configures the cartesian axes of a figure.
+//
+// Axes are referenced like in the traces, x, y, x2, y2 and so on. The layout and the axis are created if the figure does not have them yet.
+//
+//	err := axes.Log(fig, "y")
+package axes
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var axisPattern = regexp.MustCompile(`^([xy])([1-9][0-9]*)?$`)
+
+// Log displays the axis in logarithmic scale
+func Log(fig *grob.Fig, axis string) error {
+	return set(fig, axis, "type", "log")
+}
+
+// Reversed displays the axis from the highest to the lowest value
+func Reversed(fig *grob.Fig, axis string) error {
+	return set(fig, axis, "autorange", "reversed")
+}
+
+// Range fixes the range of the axis. For log axes, the range is given in exponents of 10
+func Range(fig *grob.Fig, axis string, min, max interface{}) error {
+	return set(fig, axis, "range", []interface{}{min, max})
+}
+
+// Title sets the title of the axis
+func Title(fig *grob.Fig, axis string, text string) error {
+	return set(fig, axis, "title.text", text)
+}
+
+// CategoryOrder displays the categories of the axis in the given order
+func CategoryOrder(fig *grob.Fig, axis string, order []string) error {
+	err := set(fig, axis, "categoryorder", "array")
+	if err != nil {
+		return err
+	}
+	return set(fig, axis, "categoryarray", order)
+}
+
+// Match links the axis to the target axis, so they always display the same range.
+// Both must be x or y axes
+func Match(fig *grob.Fig, axis, target string) error {
+	for _, a := range []string{axis, target} {
+		_, err := layoutName(a)
+		if err != nil {
+			return err
+		}
+	}
+	if axis[0] != target[0] {
+		return fmt.Errorf("axis %s cannot match %s, they must be both x or y axes", axis, target)
+	}
+	return set(fig, axis, "matches", target)
+}
+
+// SkipWeekends hides the weekends from the date axis, from Saturday to Monday at midnight
+func SkipWeekends(fig *grob.Fig, axis string) error {
+	return addRangebreaks(fig, axis, map[string]interface{}{"bounds": []string{"sat", "mon"}})
+}
+
+// BusinessHours hides the hours out of the business hours from the date axis, between close and open of the next day.
+// open and close are the time since midnight, like 9*time.Hour and 17*time.Hour+30*time.Minute.
+//
+// plotly.js displays the dates as written, ignoring their time zone. If tz is given, the []time.Time values of the traces
+// on the axis are converted to it, so the business hours are those of that time zone.
+func BusinessHours(fig *grob.Fig, axis string, open, close time.Duration, tz *time.Location) error {
+	if open < 0 || close > 24*time.Hour || open >= close {
+		return fmt.Errorf("business hours from %s to %s are not valid, they must be in the same day", open, close)
+	}
+	if tz != nil {
+		err := convertDates(fig, axis, tz)
+		if err != nil {
+			return err
+		}
+	}
+	return addRangebreaks(fig, axis, map[string]interface{}{
+		"pattern": "hour",
+		"bounds":  []float64{close.Hours(), open.Hours()},
+	})
+}
+
+// addRangebreaks appends the rangebreaks to those of the axis
+func addRangebreaks(fig *grob.Fig, axis string, breaks ...map[string]interface{}) error {
+	name, err := layoutName(axis)
+	if err != nil {
+		return err
+	}
+	items := []interface{}{}
+	if fig.Layout != nil {
+		field := reflect.ValueOf(fig.Layout).Elem().FieldByName(fieldName(name))
+		if field.IsValid() && !field.IsNil() {
+			current := field.Elem().FieldByName("Rangebreaks")
+			if !current.IsNil() {
+				list := reflect.ValueOf(current.Interface())
+				if list.Kind() == reflect.Slice || list.Kind() == reflect.Array {
+					for i := 0; i < list.Len(); i++ {
+						items = append(items, list.Index(i).Interface())
+					}
+				} else {
+					items = append(items, current.Interface())
+				}
+			}
+		}
+	}
+	for _, b := range breaks {
+		items = append(items, b)
+	}
+	return set(fig, axis, "rangebreaks", items)
+}
+
+// convertDates converts the []time.Time values of the traces on the axis to the time zone
+func convertDates(fig *grob.Fig, axis string, tz *time.Location) error {
+	ref, err := layoutName(axis)
+	if err != nil {
+		return err
+	}
+	label := strings.ToUpper(axis[:1])
+	for _, trace := range fig.Data {
+		value := reflect.ValueOf(trace)
+		if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+			continue
+		}
+		value = value.Elem()
+		anchor := value.FieldByName(label + "axis")
+		values := value.FieldByName(label)
+		if !anchor.IsValid() || !values.IsValid() || values.Kind() != reflect.Interface || values.IsNil() {
+			continue
+		}
+		traceAxis := strings.ToLower(label)
+		if anchor.Kind() == reflect.Interface && !anchor.IsNil() && fmt.Sprint(anchor.Interface()) != "" {
+			traceAxis = fmt.Sprint(anchor.Interface())
+		}
+		if name, err := layoutName(traceAxis); err != nil || name != ref {
+			continue
+		}
+		dates, ok := values.Interface().([]time.Time)
+		if !ok {
+			continue
+		}
+		converted := make([]time.Time, len(dates))
+		for i, date := range dates {
+			converted[i] = date.In(tz)
+		}
+		values.Set(reflect.ValueOf(converted))
+	}
+	return nil
+}
+
+// RangeButton is a button of a range selector that zooms the x axis to a period, such as the last 6 months
+type RangeButton struct {
+	// Count is the number of steps of the period, like 6 for 6 months
+	Count float64 `json:"count,omitempty"`
+	// Label of the button
+	Label string `json:"label,omitempty"`
+	// Step is the unit of the period: second, minute, hour, day, month, year, or all to display the whole range
+	Step string `json:"step,omitempty"`
+	// Stepmode is backward to count back from the last date, or todate to start at the beginning of the current step, like year to date
+	Stepmode string `json:"stepmode,omitempty"`
+}
+
+// WithRangeSlider displays a range slider below the x axis to zoom by dragging its handles
+func WithRangeSlider(fig *grob.Fig, axis string) error {
+	if axis == "" || axis[0] != 'x' {
+		return fmt.Errorf("axis %s cannot have a range slider, it must be an x axis", axis)
+	}
+	return set(fig, axis, "rangeslider.visible", true)
+}
+
+// WithRangeSelector displays the buttons above the date x axis to zoom to their periods,
+// such as the buttons of presets.Standard
+func WithRangeSelector(fig *grob.Fig, axis string, buttons []RangeButton) error {
+	if axis == "" || axis[0] != 'x' {
+		return fmt.Errorf("axis %s cannot have a range selector, it must be an x axis", axis)
+	}
+	err := set(fig, axis, "rangeselector.buttons", buttons)
+	if err != nil {
+		return err
+	}
+	return set(fig, axis, "rangeselector.visible", true)
+}
+
+// set sets the attribute of the axis in the layout
+func set(fig *grob.Fig, axis, attribute string, value interface{}) error {
+	name, err := layoutName(axis)
+	if err != nil {
+		return err
+	}
+	return fig.UpdateLayout(grob.SetLayout(name+"."+attribute, value))
+}
+
+// layoutName returns the name of the axis in the layout, such as xaxis2 for x2
+func layoutName(axis string) (string, error) {
+	match := axisPattern.FindStringSubmatch(axis)
+	if match == nil {
+		return "", fmt.Errorf("%s is not an axis, use x, y, x2, y2...", axis)
+	}
+	if match[2] == "1" {
+		// x1 is another name of x
+		return match[1] + "axis", nil
+	}
+	return match[1] + "axis" + match[2], nil
+}
+
+// fieldName returns the name of the field of grob.Layout of the axis, such as XAxis2 for xaxis2
+func fieldName(name string) string {
+	label := strings.ToUpper(name[:1])
+	if len(name) == len("xaxis") {
+		return label + "axis"
+	}
+	return label + "Axis" + name[len("xaxis"):]
+}
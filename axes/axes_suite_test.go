@@ -0,0 +1,13 @@
+package axes_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestAxes(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Axes Suite")
+}
@@ -0,0 +1,102 @@
+package axes_test
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/MetalBlueberry/go-plotly/axes"
+	"github.com/MetalBlueberry/go-plotly/axes/presets"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var _ = Describe("Axes", func() {
+
+	var fig *grob.Fig
+	BeforeEach(func() {
+		fig = &grob.Fig{}
+	})
+
+	It("Should configure the axes", func() {
+		Expect(axes.Log(fig, "y")).To(Succeed())
+		Expect(axes.Reversed(fig, "x2")).To(Succeed())
+		Expect(axes.Range(fig, "x", 0, 10)).To(Succeed())
+		Expect(axes.Title(fig, "y1", "count")).To(Succeed())
+
+		Expect(fig.Layout.Yaxis.Type).To(Equal(grob.LayoutYaxisTypeLog))
+		Expect(fig.Layout.XAxis2.Autorange).To(Equal("reversed"))
+		Expect(fig.Layout.Xaxis.Range).To(Equal([]interface{}{0, 10}))
+		Expect(fig.Layout.Yaxis.Title.Text).To(Equal("count"))
+	})
+
+	It("Should order the categories", func() {
+		Expect(axes.CategoryOrder(fig, "x", []string{"low", "mid", "high"})).To(Succeed())
+		Expect(fig.Layout.Xaxis.Categoryorder).To(Equal(grob.LayoutXaxisCategoryorderArray))
+		Expect(fig.Layout.Xaxis.Categoryarray).To(Equal([]string{"low", "mid", "high"}))
+	})
+
+	It("Should match axes of the same direction", func() {
+		Expect(axes.Match(fig, "x2", "x")).To(Succeed())
+		Expect(fig.Layout.XAxis2.Matches).To(BeEquivalentTo("x"))
+
+		Expect(axes.Match(fig, "x2", "y")).NotTo(Succeed())
+		Expect(axes.Match(fig, "x2", "z")).NotTo(Succeed())
+		Expect(axes.Match(fig, "", "x")).NotTo(Succeed())
+	})
+
+	It("Should reject unknown axes", func() {
+		Expect(axes.Log(fig, "z")).NotTo(Succeed())
+		Expect(axes.Log(fig, "x0")).NotTo(Succeed())
+		Expect(axes.Log(fig, "x99")).NotTo(Succeed())
+	})
+
+	It("Should add a range slider and a range selector to x axes", func() {
+		Expect(axes.WithRangeSlider(fig, "x")).To(Succeed())
+		Expect(axes.WithRangeSelector(fig, "x", presets.Standard)).To(Succeed())
+
+		Expect(fig.Layout.Xaxis.Rangeslider.Visible).To(Equal(grob.True))
+		Expect(fig.Layout.Xaxis.Rangeselector.Visible).To(Equal(grob.True))
+		Expect(fig.Layout.Xaxis.Rangeselector.Buttons).To(Equal(presets.Standard))
+
+		data, err := json.Marshal(fig.Layout.Xaxis.Rangeselector.Buttons)
+		Expect(err).To(BeNil())
+		Expect(data).To(MatchJSON(`[
+			{"count": 1, "label": "1m", "step": "month", "stepmode": "backward"},
+			{"count": 6, "label": "6m", "step": "month", "stepmode": "backward"},
+			{"count": 1, "label": "YTD", "step": "year", "stepmode": "todate"},
+			{"count": 1, "label": "1y", "step": "year", "stepmode": "backward"},
+			{"label": "All", "step": "all"}
+		]`))
+
+		Expect(axes.WithRangeSlider(fig, "y")).NotTo(Succeed())
+		Expect(axes.WithRangeSelector(fig, "y2", presets.Intraday)).NotTo(Succeed())
+	})
+
+	It("Should hide the weekends and the hours out of business", func() {
+		Expect(axes.SkipWeekends(fig, "x")).To(Succeed())
+		Expect(axes.BusinessHours(fig, "x", 9*time.Hour, 17*time.Hour+30*time.Minute, nil)).To(Succeed())
+
+		data, err := json.Marshal(fig.Layout.Xaxis.Rangebreaks)
+		Expect(err).To(BeNil())
+		Expect(data).To(MatchJSON(`[{"bounds": ["sat", "mon"]}, {"pattern": "hour", "bounds": [17.5, 9]}]`))
+
+		Expect(axes.BusinessHours(fig, "x", 17*time.Hour, 9*time.Hour, nil)).NotTo(Succeed())
+		Expect(axes.SkipWeekends(fig, "z")).NotTo(Succeed())
+	})
+
+	It("Should convert the dates to the time zone of the business hours", func() {
+		tz := time.FixedZone("CET", 3600)
+		date := time.Date(2021, 3, 1, 8, 0, 0, 0, time.UTC)
+		first := &grob.Scatter{X: []time.Time{date}}
+		second := &grob.Scatter{X: []time.Time{date}, Xaxis: "x2"}
+		fig.AddTraces(first, second)
+
+		Expect(axes.BusinessHours(fig, "x2", 9*time.Hour, 17*time.Hour, tz)).To(Succeed())
+		Expect(first.X.([]time.Time)[0].Location()).To(Equal(time.UTC))
+		Expect(second.X.([]time.Time)[0].Hour()).To(Equal(9))
+		Expect(fig.Layout.XAxis2.Rangebreaks).To(HaveLen(1))
+		Expect(fig.Layout.Xaxis).To(BeNil())
+	})
+})
@@ -0,0 +1,35 @@
+// Package presets are the buttons of the usual range selectors of time axes.
+//
+//	err := axes.WithRangeSelector(fig, "x", presets.Standard)
+package presets
+
+import "github.com/MetalBlueberry/go-plotly/axes"
+
+// All displays the whole range
+var All = axes.RangeButton{Label: "All", Step: "all"}
+
+// Standard zooms to the last month, the last 6 months, the year to date, the last year or the whole range
+var Standard = []axes.RangeButton{
+	{Count: 1, Label: "1m", Step: "month", Stepmode: "backward"},
+	{Count: 6, Label: "6m", Step: "month", Stepmode: "backward"},
+	{Count: 1, Label: "YTD", Step: "year", Stepmode: "todate"},
+	{Count: 1, Label: "1y", Step: "year", Stepmode: "backward"},
+	All,
+}
+
+// Intraday zooms to the last hour, the last 6 hours, today, the last week or the whole range
+var Intraday = []axes.RangeButton{
+	{Count: 1, Label: "1h", Step: "hour", Stepmode: "backward"},
+	{Count: 6, Label: "6h", Step: "hour", Stepmode: "backward"},
+	{Count: 1, Label: "Today", Step: "day", Stepmode: "todate"},
+	{Count: 7, Label: "1w", Step: "day", Stepmode: "backward"},
+	All,
+}
+
+// Years zooms to the last year, the last 5 years, the last 10 years or the whole range
+var Years = []axes.RangeButton{
+	{Count: 1, Label: "1y", Step: "year", Stepmode: "backward"},
+	{Count: 5, Label: "5y", Step: "year", Stepmode: "backward"},
+	{Count: 10, Label: "10y", Step: "year", Stepmode: "backward"},
+	All,
+}
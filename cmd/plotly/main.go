@@ -0,0 +1,209 @@
+// Command plotly renders figures written as JSON by any plotly library to HTML or static images,
+// validates them, serves the figures of a directory and compares the schemas of plotly.js versions.
+//
+//	plotly render fig.json -o fig.html
+//	plotly render -o fig.png -width 1200 -height 800 fig.json
+//	plotly quick -csv data.csv -x time -y temp,pressure -kind line -o out.html
+//	plotly validate figures/*.json
+//	plotly serve -addr localhost:8080 ./figures
+//	plotly watch sensors.yaml
+//	plotly schema diff v2.27 v2.31
+//
+// The format is given by the extension of the output: html, png, jpeg, webp, svg, pdf or eps.
+// Images are rendered by the backends of the export package, HTML pages load plotly.js from the CDN
+// unless a bundle is given with -plotlyjs.
+//
+// quick plots the columns of a CSV file, read with the ingest package, as a line, scatter, bar, histogram or box chart of
+// the express package, for ad-hoc plotting from the terminal.
+//
+// validate checks the figures against the plotly schema, like grob.Fig.Validate, and reports the attributes unknown to it
+// with the closest known attribute. It exits with status 1 if a figure is invalid, so it can gate CI and commits.
+//
+// serve displays an index of the figure files of the directory and its subdirectories. The directory is watched,
+// the browsers display the new versions of the figures and the index is updated when files are added or removed.
+//
+// watch serves the figure of a spec file, which names a CSV or JSON data file and how to plot it, see the spec package.
+// The figure is rebuilt and sent to the browsers when the spec or the data file change.
+//
+// schema diff downloads the schemas of two plotly.js versions and reports the attributes added, removed and changed,
+// with the changes of the Go code that the generator would produce, to plan the upgrades of the generated code.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/MetalBlueberry/go-plotly/export"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/offline"
+)
+
+const usage = `Usage:
+	plotly render [flags] <figure.json>
+	plotly quick [flags]
+	plotly validate [flags] <figure.json>...
+	plotly serve [flags] [directory]
+	plotly watch [flags] <spec.yaml>
+	plotly schema diff [flags] <old> <new>
+`
+
+const renderUsage = `Usage: plotly render [flags] <figure.json>
+
+Renders the figure to HTML or to an image, the format is given by the extension of the output.
+The figure is read from the standard input if the file is -.
+
+Flags:
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	var err error
+	switch os.Args[1] {
+	case "render":
+		err = render(os.Args[2:], os.Stdin)
+	case "quick":
+		err = quick(os.Args[2:], os.Stdin)
+	case "validate":
+		err = validate(os.Args[2:], os.Stdout)
+	case "serve":
+		err = serve(os.Args[2:])
+	case "watch":
+		err = watch(os.Args[2:])
+	case "schema":
+		err = schema(os.Args[2:], os.Stdout)
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	if errors.Is(err, flag.ErrHelp) {
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "plotly: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+// render parses the flags of the render command and writes the figure
+func render(args []string, stdin io.Reader) error {
+	flags := flag.NewFlagSet("render", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), renderUsage)
+		flags.PrintDefaults()
+	}
+	output := flags.String("o", "", "output file, defaults to the figure file with the html extension")
+	width := flags.Int("width", 0, "width of the image in pixels, defaults to the layout width")
+	height := flags.Int("height", 0, "height of the image in pixels, defaults to the layout height")
+	scale := flags.Float64("scale", 0, "scale of the image resolution, 2 doubles width and height")
+	plotlyJS := flags.String("plotlyjs", "", "plotly.js bundle inlined in HTML pages or used by the image backends")
+	kaleido := flags.String("kaleido", "", "path of the kaleido executable, forces the kaleido backend")
+	orca := flags.String("orca", "", "URL of an orca server, forces the orca backend")
+	chrome := flags.String("chrome", "", "path of the Chrome executable, forces the Chrome backend")
+
+	// the figure file can be given before the flags
+	var input string
+	if len(args) > 0 && (args[0] == "-" || !strings.HasPrefix(args[0], "-")) {
+		input, args = args[0], args[1:]
+	}
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+	if input == "" && flags.NArg() > 0 {
+		input = flags.Arg(0)
+	} else if input == "" || flags.NArg() > 0 {
+		flags.Usage()
+		return flag.ErrHelp
+	}
+
+	if *output == "" {
+		if input == "-" {
+			return errors.New("the output is required when the figure is read from the standard input")
+		}
+		*output = strings.TrimSuffix(input, filepath.Ext(input)) + ".html"
+	}
+
+	fig, err := readFigure(input, stdin)
+	if err != nil {
+		return err
+	}
+
+	return writeFigure(fig, *output, export.Options{
+		Width:    *width,
+		Height:   *height,
+		Scale:    *scale,
+		Kaleido:  *kaleido,
+		Orca:     *orca,
+		Chrome:   *chrome,
+		PlotlyJS: *plotlyJS,
+	})
+}
+
+// writeFigure writes the figure as an HTML page or an image depending on the extension of the output
+func writeFigure(fig *grob.Fig, output string, opts export.Options) error {
+	format := strings.ToLower(strings.TrimPrefix(filepath.Ext(output), "."))
+	switch format {
+	case "html", "htm":
+		return writeHTML(fig, output, opts.PlotlyJS)
+	case "jpg":
+		format = string(export.FormatJPEG)
+	}
+	switch export.Format(format) {
+	case export.FormatPNG, export.FormatJPEG, export.FormatWEBP, export.FormatSVG, export.FormatPDF, export.FormatEPS:
+	default:
+		return fmt.Errorf("cannot render %s, the extension must be html, png, jpeg, webp, svg, pdf or eps", output)
+	}
+
+	file, err := os.Create(output)
+	if err != nil {
+		return fmt.Errorf("cannot create output, %w", err)
+	}
+	err = export.ToImage(fig, file, export.Format(format), opts)
+	if err != nil {
+		file.Close()
+		os.Remove(output)
+		return fmt.Errorf("cannot render image, %w", err)
+	}
+	return file.Close()
+}
+
+// readFigure decodes the figure keeping the attributes unknown to grob, so they are rendered too
+func readFigure(input string, stdin io.Reader) (*grob.Fig, error) {
+	var data []byte
+	var err error
+	if input == "-" {
+		data, err = ioutil.ReadAll(stdin)
+	} else {
+		data, err = ioutil.ReadFile(input)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read figure, %w", err)
+	}
+	return grob.FromPlotlyJSON(data)
+}
+
+// writeHTML writes the page of the figure, with the plotly.js bundle inlined if given
+func writeHTML(fig *grob.Fig, output, plotlyJS string) error {
+	if plotlyJS != "" {
+		return offline.ToHtmlStandalone(fig, output, offline.Options{PlotlyJSPath: plotlyJS})
+	}
+	file, err := os.Create(output)
+	if err != nil {
+		return fmt.Errorf("cannot create output, %w", err)
+	}
+	err = offline.WriteHtml(fig, file)
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("cannot render html, %w", err)
+	}
+	return file.Close()
+}
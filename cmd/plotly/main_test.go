@@ -0,0 +1,95 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("render", func() {
+
+	figure := `{"data": [{"type": "bar", "x": ["a", "b"], "y": [1, 2]}], "layout": {"title": {"text": "rendered"}}}`
+
+	var (
+		dir string
+		// orca is a fake orca server, the images contain the name of their format
+		orca *httptest.Server
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "render")
+		Expect(err).To(BeNil())
+		Expect(ioutil.WriteFile(filepath.Join(dir, "fig.json"), []byte(figure), 0644)).To(Succeed())
+
+		orca = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/ping" {
+				return
+			}
+			request := struct {
+				Format string `json:"format"`
+			}{}
+			json.NewDecoder(r.Body).Decode(&request)
+			w.Write([]byte(request.Format))
+		}))
+	})
+
+	AfterEach(func() {
+		orca.Close()
+		os.RemoveAll(dir)
+	})
+
+	// expand replaces {dir} with the temporary directory and {orca} with the URL of the fake orca server
+	expand := func(args []string) []string {
+		expanded := make([]string, len(args))
+		for i, arg := range args {
+			expanded[i] = strings.NewReplacer("{dir}", dir, "{orca}", orca.URL).Replace(arg)
+		}
+		return expanded
+	}
+
+	DescribeTable("writes the figure",
+		func(args []string, output, content string) {
+			err := render(expand(args), strings.NewReader(figure))
+			Expect(err).To(BeNil())
+			data, err := ioutil.ReadFile(filepath.Join(dir, output))
+			Expect(err).To(BeNil())
+			Expect(string(data)).To(ContainSubstring(content))
+		},
+		Entry("file before flags", []string{"{dir}/fig.json", "-o", "{dir}/out.html"}, "out.html", "rendered"),
+		Entry("file after flags", []string{"-o", "{dir}/out.html", "{dir}/fig.json"}, "out.html", "rendered"),
+		Entry("default output", []string{"{dir}/fig.json"}, "fig.html", "rendered"),
+		Entry("standard input", []string{"-", "-o", "{dir}/out.html"}, "out.html", "rendered"),
+		Entry("htm extension", []string{"{dir}/fig.json", "-o", "{dir}/out.htm"}, "out.htm", "rendered"),
+		Entry("png extension", []string{"{dir}/fig.json", "-o", "{dir}/out.png", "-orca", "{orca}"}, "out.png", "png"),
+		Entry("jpeg extension", []string{"{dir}/fig.json", "-o", "{dir}/out.jpeg", "-orca", "{orca}"}, "out.jpeg", "jpeg"),
+		Entry("jpg alias of jpeg", []string{"{dir}/fig.json", "-o", "{dir}/out.jpg", "-orca", "{orca}"}, "out.jpg", "jpeg"),
+		Entry("upper case extension", []string{"{dir}/fig.json", "-o", "{dir}/out.SVG", "-orca", "{orca}"}, "out.SVG", "svg"),
+		Entry("webp extension", []string{"{dir}/fig.json", "-o", "{dir}/out.webp", "-orca", "{orca}"}, "out.webp", "webp"),
+		Entry("pdf extension", []string{"{dir}/fig.json", "-o", "{dir}/out.pdf", "-orca", "{orca}"}, "out.pdf", "pdf"),
+		Entry("eps extension", []string{"{dir}/fig.json", "-o", "{dir}/out.eps", "-orca", "{orca}"}, "out.eps", "eps"),
+	)
+
+	DescribeTable("fails",
+		func(args []string, message string) {
+			err := render(expand(args), strings.NewReader(figure))
+			Expect(err).To(MatchError(ContainSubstring(message)))
+			files, err := ioutil.ReadDir(dir)
+			Expect(err).To(BeNil())
+			Expect(files).To(HaveLen(1), "only the figure is in the directory")
+		},
+		Entry("standard input without output", []string{"-"}, "the output is required"),
+		Entry("unsupported extension", []string{"{dir}/fig.json", "-o", "{dir}/out.gif"}, "the extension must be html, png, jpeg, webp, svg, pdf or eps"),
+		Entry("missing figure", []string{"{dir}/missing.json", "-o", "{dir}/out.html"}, "cannot read figure"),
+		Entry("unreachable backend", []string{"{dir}/fig.json", "-o", "{dir}/out.png", "-orca", "http://127.0.0.1:1"}, "cannot render image"),
+		Entry("no figure", []string{"-o", "{dir}/out.html"}, "help requested"),
+	)
+})
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/MetalBlueberry/go-plotly/export"
+	"github.com/MetalBlueberry/go-plotly/express"
+	"github.com/MetalBlueberry/go-plotly/ingest"
+	"github.com/MetalBlueberry/go-plotly/spec"
+)
+
+const quickUsage = `Usage: plotly quick [flags]
+
+Plots the columns of a CSV file with the express charts and writes the figure to HTML or to an image,
+the format is given by the extension of the output. The CSV file is read from the standard input if it is - or not given.
+
+	plotly quick -csv data.csv -x time -y temp,pressure -kind line -o out.html
+
+Flags:
+`
+
+// quick parses the flags of the quick command, plots the CSV file and writes the figure
+func quick(args []string, stdin io.Reader) error {
+	flags := flag.NewFlagSet("quick", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), quickUsage)
+		flags.PrintDefaults()
+	}
+	input := flags.String("csv", "-", "CSV file whose first row is the header with the names of the columns")
+	x := flags.String("x", "", "column of the x values, defaults to the row index")
+	y := flags.String("y", "", "comma separated columns to plot, defaults to every numeric column but x")
+	kind := flags.String("kind", "line", "kind of chart: line, scatter, bar, histogram or box")
+	title := flags.String("title", "", "title of the figure")
+	comma := flags.String("comma", ",", "field delimiter of the CSV file")
+	output := flags.String("o", "", "output file, defaults to the CSV file with the html extension")
+	plotlyJS := flags.String("plotlyjs", "", "plotly.js bundle inlined in HTML pages or used by the image backends")
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+	if flags.NArg() > 0 {
+		flags.Usage()
+		return flag.ErrHelp
+	}
+
+	delimiter, size := utf8.DecodeRuneInString(*comma)
+	if size == 0 || size != len(*comma) {
+		return fmt.Errorf("the delimiter must be a single character, got %q", *comma)
+	}
+	if *output == "" {
+		if *input == "-" {
+			return errors.New("the output is required when the CSV file is read from the standard input")
+		}
+		*output = strings.TrimSuffix(*input, filepath.Ext(*input)) + ".html"
+	}
+
+	opts := ingest.Options{
+		X:     *x,
+		Comma: delimiter,
+	}
+	if *y != "" {
+		opts.Y = strings.Split(*y, ",")
+		for i := range opts.Y {
+			opts.Y[i] = strings.TrimSpace(opts.Y[i])
+		}
+	}
+	table, err := readTable(*input, stdin, opts)
+	if err != nil {
+		return err
+	}
+	fig, err := spec.Chart(table, spec.Kind(*kind), express.Options{Title: *title})
+	if err != nil {
+		return err
+	}
+	return writeFigure(fig, *output, export.Options{PlotlyJS: *plotlyJS})
+}
+
+// readTable reads the CSV file, or the standard input if the file is -
+func readTable(input string, stdin io.Reader, opts ingest.Options) (*ingest.Table, error) {
+	if input == "-" {
+		return ingest.FromCSV(stdin, opts)
+	}
+	file, err := os.Open(input)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open csv, %w", err)
+	}
+	defer file.Close()
+	return ingest.FromCSV(file, opts)
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("quick", func() {
+
+	csv := "time,temp,pressure,humidity\n1,20.5,1013,40\n2,21,1012,42\n3,21.5,1011,45\n"
+
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "quick")
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("Should plot the CSV of the standard input as HTML", func() {
+		output := filepath.Join(dir, "out.html")
+		err := quick([]string{"-x", "time", "-y", "temp, pressure ", "-kind", "scatter", "-title", "Sensors", "-o", output}, strings.NewReader(csv))
+		Expect(err).To(BeNil())
+
+		data, err := ioutil.ReadFile(output)
+		Expect(err).To(BeNil())
+		page := string(data)
+		Expect(page).To(ContainSubstring(`Sensors`))
+		Expect(page).To(ContainSubstring(`"name":"temp"`))
+		Expect(page).To(ContainSubstring(`"name":"pressure"`))
+		Expect(page).NotTo(ContainSubstring(`"name":"humidity"`))
+	})
+
+	It("Should read the CSV file with the delimiter", func() {
+		input := filepath.Join(dir, "data.csv")
+		Expect(ioutil.WriteFile(input, []byte(strings.ReplaceAll(csv, ",", ";")), 0644)).To(Succeed())
+
+		Expect(quick([]string{"-csv", input, "-comma", ";", "-x", "time"}, strings.NewReader(""))).To(Succeed())
+
+		data, err := ioutil.ReadFile(filepath.Join(dir, "data.html"))
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(ContainSubstring(`"name":"humidity"`))
+	})
+
+	It("Should require a single character delimiter", func() {
+		output := filepath.Join(dir, "out.html")
+		Expect(quick([]string{"-comma", "", "-o", output}, strings.NewReader(csv))).To(MatchError(`the delimiter must be a single character, got ""`))
+		Expect(quick([]string{"-comma", ";;", "-o", output}, strings.NewReader(csv))).To(MatchError(`the delimiter must be a single character, got ";;"`))
+		Expect(output).NotTo(BeAnExistingFile())
+	})
+
+	It("Should require the output when the CSV is read from the standard input", func() {
+		err := quick([]string{"-x", "time"}, strings.NewReader(csv))
+		Expect(err).To(MatchError("the output is required when the CSV file is read from the standard input"))
+	})
+
+	It("Should fail with unknown columns", func() {
+		err := quick([]string{"-y", "wind", "-o", filepath.Join(dir, "out.html")}, strings.NewReader(csv))
+		Expect(err).To(HaveOccurred())
+	})
+})
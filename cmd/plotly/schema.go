@@ -0,0 +1,121 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/MetalBlueberry/go-plotly/generator"
+)
+
+// schemaURL is the URL of the schema of a plotly.js version, like v2.27.0
+const schemaURL = "https://raw.githubusercontent.com/plotly/plotly.js/%s/dist/plot-schema.json"
+
+const schemaUsage = `Usage: plotly schema diff [flags] <old> <new>
+
+Compares the schemas of two plotly.js versions, like v2.27 and v2.31, and reports the attributes added, removed
+and changed with the changes of the generated Go code. A schema file can be given instead of a version.
+
+Flags:
+`
+
+// schema parses the flags of the schema command and prints the differences of the schemas
+func schema(args []string, w io.Writer) error {
+	flags := flag.NewFlagSet("schema diff", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), schemaUsage)
+		flags.PrintDefaults()
+	}
+	breaking := flags.Bool("breaking", false, "only report the changes that break the generated Go code")
+	if len(args) == 0 || args[0] != "diff" {
+		flags.Usage()
+		return flag.ErrHelp
+	}
+	err := flags.Parse(args[1:])
+	if err != nil {
+		return err
+	}
+	if flags.NArg() != 2 {
+		flags.Usage()
+		return flag.ErrHelp
+	}
+
+	before, err := loadSchema(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	after, err := loadSchema(flags.Arg(1))
+	if err != nil {
+		return err
+	}
+
+	changes, err := generator.Diff(before, after)
+	if err != nil {
+		return err
+	}
+	for _, change := range changes {
+		if *breaking && !isBreaking(change) {
+			continue
+		}
+		fmt.Fprintf(w, "%-8s %s\n", change.Kind, change.Path)
+		for _, detail := range change.Details {
+			fmt.Fprintf(w, "         %s\n", detail)
+		}
+		for _, api := range change.GoAPI {
+			fmt.Fprintf(w, "         go: %s\n", api)
+		}
+	}
+	return nil
+}
+
+func isBreaking(change generator.Change) bool {
+	for _, api := range change.GoAPI {
+		if strings.HasSuffix(api, ", breaking") {
+			return true
+		}
+	}
+	return false
+}
+
+// loadSchema reads the schema file, or downloads the schema of the plotly.js version if there is no such file
+func loadSchema(source string) (*generator.Root, error) {
+	file, err := os.Open(source)
+	if err == nil {
+		defer file.Close()
+		root, err := generator.LoadSchema(file)
+		if err != nil {
+			return nil, fmt.Errorf("cannot load schema %s, %w", source, err)
+		}
+		return root, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, fmt.Errorf("cannot read schema, %w", err)
+	}
+
+	version := schemaVersion(source)
+	resp, err := http.Get(fmt.Sprintf(schemaURL, version))
+	if err != nil {
+		return nil, fmt.Errorf("cannot download schema %s, %w", version, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("cannot download schema %s, %s", version, resp.Status)
+	}
+	root, err := generator.LoadSchema(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load schema %s, %w", version, err)
+	}
+	return root, nil
+}
+
+// schemaVersion returns the git tag of the plotly.js version, v2.27 is v2.27.0
+func schemaVersion(version string) string {
+	version = "v" + strings.TrimPrefix(version, "v")
+	if strings.Count(version, ".") == 1 {
+		version += ".0"
+	}
+	return version
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/MetalBlueberry/go-plotly/offline"
+)
+
+const serveUsage = `Usage: plotly serve [flags] [directory]
+
+Serves the figure JSON files of the directory, the current one by default, with an index of them.
+The browsers are updated when the files change.
+
+Flags:
+`
+
+// serve parses the flags of the serve command and serves the directory until interrupted
+func serve(args []string) error {
+	flags := flag.NewFlagSet("serve", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), serveUsage)
+		flags.PrintDefaults()
+	}
+	addr := flags.String("addr", "localhost:8080", "address to listen on")
+	title := flags.String("title", "", "title of the index, defaults to the name of the directory")
+	interval := flags.Duration("interval", time.Second, "interval between the checks of the files")
+	plotlyJS := flags.String("plotlyjs", "", "plotly.js bundle served instead of the CDN")
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+	if flags.NArg() > 1 {
+		flags.Usage()
+		return flag.ErrHelp
+	}
+	dir := "."
+	if flags.NArg() == 1 {
+		dir = flags.Arg(0)
+	}
+	info, err := os.Stat(dir)
+	if err != nil {
+		return fmt.Errorf("cannot serve directory, %w", err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("cannot serve %s, it is not a directory", dir)
+	}
+	if *title == "" {
+		abs, err := filepath.Abs(dir)
+		if err != nil {
+			return err
+		}
+		*title = filepath.Base(abs)
+	}
+
+	srv, err := offline.NewServer(nil, offline.Options{
+		Addr:         *addr,
+		Title:        *title,
+		PlotlyJSPath: *plotlyJS,
+		Responsive:   true,
+	})
+	if err != nil {
+		return err
+	}
+	g := &gallery{
+		dir:    dir,
+		srv:    srv,
+		loaded: map[string]fileState{},
+	}
+	g.scan()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	go func() {
+		ticker := time.NewTicker(*interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				g.scan()
+			}
+		}
+	}()
+	log.Printf("serving %s at http://%s", dir, *addr)
+	return srv.Run(ctx)
+}
+
+// gallery serves the figure files of a directory, a file dir/daily/sales.json is served at /daily/sales
+type gallery struct {
+	dir    string
+	srv    *offline.Server
+	loaded map[string]fileState
+}
+
+// fileState tells if a file changed since it was loaded
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+// scan loads the figure files added or modified since the last scan and removes the figures of the deleted files.
+// Files that cannot be loaded are reported and checked again when they change.
+func (g *gallery) scan() {
+	seen := map[string]bool{}
+	err := filepath.Walk(g.dir, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !strings.EqualFold(filepath.Ext(file), ".json") {
+			return nil
+		}
+		rel, err := filepath.Rel(g.dir, file)
+		if err != nil {
+			return err
+		}
+		path := "/" + strings.TrimSuffix(filepath.ToSlash(rel), filepath.Ext(rel))
+		seen[path] = true
+
+		state := fileState{modTime: info.ModTime(), size: info.Size()}
+		if previous, ok := g.loaded[path]; ok && previous == state {
+			return nil
+		}
+		g.loaded[path] = state
+		fig, err := readFigure(file, nil)
+		if err == nil {
+			err = g.srv.Handle(path, fig)
+		}
+		if err != nil {
+			log.Printf("cannot serve %s, %s", file, err)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("cannot scan %s, %s", g.dir, err)
+		return
+	}
+	for path := range g.loaded {
+		if seen[path] {
+			continue
+		}
+		delete(g.loaded, path)
+		// the figure is not served if it could not be loaded
+		g.srv.Remove(path)
+	}
+}
@@ -0,0 +1,224 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+const validateUsage = `Usage: plotly validate [flags] <figure.json>...
+
+Checks the figures against the plotly schema and prints the invalid attributes with their JSON path,
+like data[0].marker.size. It exits with status 1 if any figure is invalid.
+
+Flags:
+`
+
+// validate parses the flags of the validate command and checks every figure file
+func validate(args []string, w io.Writer) error {
+	flags := flag.NewFlagSet("validate", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), validateUsage)
+		flags.PrintDefaults()
+	}
+	allowUnknown := flags.Bool("allow-unknown", false, "accept the attributes unknown to the schema, such as those of newer plotly.js versions")
+	references := flags.Bool("references", false, "check that the subplots referenced by the traces are defined in the layout")
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return flag.ErrHelp
+	}
+
+	invalid := 0
+	for _, file := range flags.Args() {
+		errs, err := validateFile(file, !*allowUnknown, *references)
+		if err != nil {
+			return err
+		}
+		for _, e := range errs {
+			fmt.Fprintf(w, "%s: %s: %s\n", file, e.Path, e.Message)
+		}
+		if len(errs) > 0 {
+			invalid++
+		}
+	}
+	if invalid > 0 {
+		return fmt.Errorf("%d of %d figures are invalid", invalid, flags.NArg())
+	}
+	return nil
+}
+
+// validateFile returns the invalid attributes of the figure, with suggestions for the typos
+func validateFile(file string, disallowUnknown, references bool) (grob.ValidationErrors, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read figure, %w", err)
+	}
+
+	fig := &grob.Fig{}
+	errs := grob.ValidationErrors{}
+	err = collect(&errs, grob.UnmarshalOptions{DisallowUnknownFields: disallowUnknown}.Unmarshal(data, fig))
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode %s, %w", file, err)
+	}
+	err = collect(&errs, fig.Validate())
+	if err != nil {
+		return nil, err
+	}
+	if references {
+		err = collect(&errs, fig.ValidateReferences())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for i := range errs {
+		if suggestion := suggest(fig, errs[i]); suggestion != "" {
+			errs[i].Message += ", did you mean " + suggestion + "?"
+		}
+	}
+	sort.SliceStable(errs, func(i, j int) bool { return errs[i].Path < errs[j].Path })
+	return errs, nil
+}
+
+// collect appends the validation errors, other errors are returned
+func collect(errs *grob.ValidationErrors, err error) error {
+	var validation grob.ValidationErrors
+	if errors.As(err, &validation) {
+		*errs = append(*errs, validation...)
+		return nil
+	}
+	return err
+}
+
+var (
+	// indexPattern matches the indexes of arrays in JSON paths
+	indexPattern = regexp.MustCompile(`\[[0-9]+\]`)
+	// tracePattern matches the path of a trace, like data[0] or frames[1].data[0]
+	tracePattern = regexp.MustCompile(`^(frames\[([0-9]+)\]\.)?data\[([0-9]+)\]`)
+	// numberedPattern matches the numbered subplots, like xaxis2
+	numberedPattern = regexp.MustCompile(`^([a-z]+)[1-9][0-9]*$`)
+)
+
+// suggest returns the closest attribute of the schema to an unknown field, or the closest allowed value to an invalid value
+func suggest(fig *grob.Fig, e grob.ValidationError) string {
+	path, ok := schemaPath(fig, e.Path)
+	if !ok {
+		return ""
+	}
+	if e.Message == "unknown field" {
+		i := strings.LastIndex(path, ".")
+		if i < 0 {
+			return ""
+		}
+		parent, name := path[:i], path[i+1:]
+		candidates := []string{}
+		for _, child := range grob.AttributePaths(parent + ".") {
+			if child = strings.TrimPrefix(child, parent+"."); !strings.Contains(child, ".") {
+				candidates = append(candidates, child)
+			}
+		}
+		return closest(name, candidates)
+	}
+
+	info, ok := grob.Describe(path)
+	if !ok || info.ValType != "enumerated" && info.ValType != "flaglist" {
+		return ""
+	}
+	value := e.Message
+	if quoted := strings.Index(value, "flag \""); quoted == 0 {
+		value, _ = strconv.Unquote(value[len("flag "):strings.Index(value, " is not")])
+	} else if end := strings.Index(value, " is not one of"); end > 0 {
+		value = value[:end]
+	} else {
+		return ""
+	}
+	candidates := append([]string{}, info.Flags...)
+	for _, v := range append(info.Values, info.Extras...) {
+		if s, ok := v.(string); ok && !strings.HasPrefix(s, "/") {
+			candidates = append(candidates, s)
+		}
+	}
+	return closest(value, candidates)
+}
+
+// schemaPath returns the path of the attribute registry for the JSON path of the figure, data[0].marker.size is scatter.marker.size
+func schemaPath(fig *grob.Fig, path string) (string, bool) {
+	if match := tracePattern.FindStringSubmatch(path); match != nil {
+		traces := fig.Data
+		if match[1] != "" {
+			frame, _ := strconv.Atoi(match[2])
+			if frame >= len(fig.Frames) {
+				return "", false
+			}
+			traces = fig.Frames[frame].Data
+		}
+		index, _ := strconv.Atoi(match[3])
+		if index >= len(traces) || traces[index] == nil {
+			return "", false
+		}
+		path = string(traces[index].GetType()) + path[len(match[0]):]
+	} else if strings.HasPrefix(path, "frames[") {
+		path = path[strings.Index(path, "].")+2:]
+	}
+	parts := strings.Split(indexPattern.ReplaceAllString(path, ""), ".")
+	for i, part := range parts {
+		if match := numberedPattern.FindStringSubmatch(part); match != nil {
+			parts[i] = match[1]
+		}
+	}
+	return strings.Join(parts, "."), len(parts) > 1
+}
+
+// closest returns the candidate with the fewest edits from the name, with at most one edit more than a third of its length
+func closest(name string, candidates []string) string {
+	best, bestDistance := "", len(name)/3+2
+	for _, candidate := range candidates {
+		if d := distance(strings.ToLower(name), strings.ToLower(candidate)); d < bestDistance {
+			best, bestDistance = candidate, d
+		}
+	}
+	return best
+}
+
+// distance is the Levenshtein distance between the strings
+func distance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+func min(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
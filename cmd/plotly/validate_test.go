@@ -0,0 +1,56 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("validate", func() {
+
+	var out *bytes.Buffer
+
+	BeforeEach(func() {
+		out = &bytes.Buffer{}
+	})
+
+	It("Should accept valid figures", func() {
+		Expect(validate([]string{"testdata/valid.json"}, out)).To(Succeed())
+		Expect(out.String()).To(BeEmpty())
+	})
+
+	It("Should print the invalid attributes with suggestions", func() {
+		err := validate([]string{"testdata/valid.json", "testdata/invalid.json"}, out)
+		Expect(err).To(MatchError("1 of 2 figures are invalid"))
+		Expect(out.String()).To(Equal(
+			"testdata/invalid.json: data[0].markr: unknown field, did you mean marker?\n" +
+				`testdata/invalid.json: data[0].mode: flag "line" is not one of lines, markers, text, did you mean lines?` + "\n",
+		))
+	})
+
+	It("Should accept unknown attributes if allowed", func() {
+		err := validate([]string{"-allow-unknown", "testdata/invalid.json"}, out)
+		Expect(err).To(MatchError("1 of 1 figures are invalid"))
+		Expect(out.String()).NotTo(ContainSubstring("markr"))
+		Expect(out.String()).To(ContainSubstring("data[0].mode"))
+	})
+
+	It("Should check the references if asked", func() {
+		Expect(validate([]string{"testdata/references.json"}, out)).To(Succeed())
+
+		err := validate([]string{"-references", "testdata/references.json"}, out)
+		Expect(err).To(MatchError("1 of 1 figures are invalid"))
+		Expect(out.String()).To(Equal("testdata/references.json: data[0].xaxis: x2 references layout.xaxis2, which is not defined\n"))
+	})
+
+	It("Should fail if a figure cannot be read", func() {
+		err := validate([]string{"testdata/missing.json"}, out)
+		Expect(err).To(MatchError(ContainSubstring("cannot read figure")))
+	})
+
+	It("Should require a figure", func() {
+		Expect(validate([]string{}, out)).To(MatchError(flag.ErrHelp))
+	})
+})
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"time"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/offline"
+	"github.com/MetalBlueberry/go-plotly/spec"
+)
+
+const watchUsage = `Usage: plotly watch [flags] <spec.yaml>
+
+Serves the figure of the spec and rebuilds it when the spec or its data file change, the browsers are updated.
+The spec names a CSV or JSON data file and how to plot it:
+
+	data: readings.csv
+	kind: line
+	x: time
+	y: [temp, pressure]
+	title: Sensors
+
+Flags:
+`
+
+// watch parses the flags of the watch command and serves the figure of the spec until interrupted
+func watch(args []string) error {
+	flags := flag.NewFlagSet("watch", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), watchUsage)
+		flags.PrintDefaults()
+	}
+	addr := flags.String("addr", "localhost:8080", "address to listen on")
+	interval := flags.Duration("interval", time.Second, "interval between the checks of the files")
+	plotlyJS := flags.String("plotlyjs", "", "plotly.js bundle served instead of the CDN")
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return flag.ErrHelp
+	}
+	file := flags.Arg(0)
+	// the spec is loaded once to fail early, later errors are reported while watching
+	_, err = spec.Load(file)
+	if err != nil {
+		return err
+	}
+
+	srv, err := offline.NewServer(nil, offline.Options{
+		Addr:         *addr,
+		Title:        strings.TrimSuffix(filepath.Base(file), filepath.Ext(file)),
+		PlotlyJSPath: *plotlyJS,
+		Responsive:   true,
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	go spec.Watch(ctx, file, *interval, func(fig *grob.Fig, err error) {
+		if err == nil {
+			err = srv.Update(fig)
+		}
+		if err != nil {
+			log.Printf("cannot build %s, %s", file, err)
+		}
+	})
+	log.Printf("serving %s at http://%s", file, *addr)
+	return srv.Run(ctx)
+}
@@ -0,0 +1,75 @@
+// Package colors contains qualitative palettes and assigns their colors to categories.
+//
+// The palettes are the qualitative color sequences of plotly express.
+package colors
+
+import (
+	"sort"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// Palette is a sequence of colors to tell categories apart
+type Palette []string
+
+// Qualitative palettes
+var (
+	// Plotly is the default colorway of plotly
+	Plotly = Palette{"#636EFA", "#EF553B", "#00CC96", "#AB63FA", "#FFA15A", "#19D3F3", "#FF6692", "#B6E880", "#FF97FF", "#FECB52"}
+	// D3 is the category10 scheme of d3
+	D3 = Palette{"#1F77B4", "#FF7F0E", "#2CA02C", "#D62728", "#9467BD", "#8C564B", "#E377C2", "#7F7F7F", "#BCBD22", "#17BECF"}
+	// G10 are the colors of Google charts
+	G10 = Palette{"#3366CC", "#DC3912", "#FF9900", "#109618", "#990099", "#0099C6", "#DD4477", "#66AA00", "#B82E2E", "#316395"}
+	// T10 is the tableau10 scheme of vega
+	T10 = Palette{"#4C78A8", "#F58518", "#E45756", "#72B7B2", "#54A24B", "#EECA3B", "#B279A2", "#FF9DA6", "#9D755D", "#BAB0AC"}
+	// Set1 is a ColorBrewer palette of saturated colors
+	Set1 = Palette{"rgb(228,26,28)", "rgb(55,126,184)", "rgb(77,175,74)", "rgb(152,78,163)", "rgb(255,127,0)", "rgb(255,255,51)", "rgb(166,86,40)", "rgb(247,129,191)", "rgb(153,153,153)"}
+	// Set2 is a ColorBrewer palette of soft colors
+	Set2 = Palette{"rgb(102,194,165)", "rgb(252,141,98)", "rgb(141,160,203)", "rgb(231,138,195)", "rgb(166,216,84)", "rgb(255,217,47)", "rgb(229,196,148)", "rgb(179,179,179)"}
+	// Dark2 is a ColorBrewer palette of dark colors
+	Dark2 = Palette{"rgb(27,158,119)", "rgb(217,95,2)", "rgb(117,112,179)", "rgb(231,41,138)", "rgb(102,166,30)", "rgb(230,171,2)", "rgb(166,118,29)", "rgb(102,102,102)"}
+	// Pastel1 is a ColorBrewer palette of pastel colors
+	Pastel1 = Palette{"rgb(251,180,174)", "rgb(179,205,227)", "rgb(204,235,197)", "rgb(222,203,228)", "rgb(254,217,166)", "rgb(255,255,204)", "rgb(229,216,189)", "rgb(253,218,236)", "rgb(242,242,242)"}
+)
+
+// Color returns the ith color, the palette starts over once all the colors are used
+func (p Palette) Color(i int) string {
+	return p[i%len(p)]
+}
+
+// Colorway returns the palette as a list of colors, to be used as Layout.Colorway
+func (p Palette) Colorway() grob.ColorList {
+	colorway := make(grob.ColorList, len(p))
+	for i, color := range p {
+		colorway[i] = color
+	}
+	return colorway
+}
+
+// ByCategory returns the color of every value and the color of each category.
+// Categories get the colors in alphabetical order, so the same categories have the same colors in every chart
+// regardless of the order of the values. If palette is nil, Plotly is used
+func ByCategory(values []string, palette Palette) ([]string, map[string]string) {
+	if len(palette) == 0 {
+		palette = Plotly
+	}
+
+	categories := []string{}
+	mapping := map[string]string{}
+	for _, value := range values {
+		if _, ok := mapping[value]; !ok {
+			mapping[value] = ""
+			categories = append(categories, value)
+		}
+	}
+	sort.Strings(categories)
+	for i, category := range categories {
+		mapping[category] = palette.Color(i)
+	}
+
+	colors := make([]string, len(values))
+	for i, value := range values {
+		colors[i] = mapping[value]
+	}
+	return colors, mapping
+}
@@ -0,0 +1,13 @@
+package colors_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestColors(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Colors Suite")
+}
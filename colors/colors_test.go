@@ -0,0 +1,34 @@
+package colors_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/MetalBlueberry/go-plotly/colors"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var _ = Describe("Colors", func() {
+
+	It("Should color the values by category", func() {
+		values, mapping := colors.ByCategory([]string{"pear", "apple", "pear"}, colors.D3)
+		Expect(mapping).To(Equal(map[string]string{
+			"apple": colors.D3[0],
+			"pear":  colors.D3[1],
+		}))
+		Expect(values).To(Equal([]string{colors.D3[1], colors.D3[0], colors.D3[1]}))
+	})
+
+	It("Should give the same colors regardless of the order", func() {
+		_, first := colors.ByCategory([]string{"a", "b", "c"}, nil)
+		_, second := colors.ByCategory([]string{"c", "b", "a", "a"}, nil)
+		Expect(first).To(Equal(second))
+		Expect(first["a"]).To(Equal(colors.Plotly[0]))
+	})
+
+	It("Should cycle through the palette", func() {
+		palette := colors.Palette{"red", "blue"}
+		Expect(palette.Color(3)).To(Equal("blue"))
+		Expect(palette.Colorway()).To(Equal(grob.ColorList{"red", "blue"}))
+	})
+})
@@ -0,0 +1,146 @@
+package colors
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// DefaultColorscale is the colorscale of Continuous if none is given
+const DefaultColorscale = "Viridis"
+
+// ContinuousOptions configure Continuous
+type ContinuousOptions struct {
+	// Colorscale is the name of a plotly colorscale or a list of [level, color] pairs, defaults to DefaultColorscale
+	Colorscale grob.ColorScale
+	// Title of the colorbar
+	Title string
+	// Percentile clips the color range at both ends, for example 2 uses the range between the 2nd and the 98th percentiles,
+	// so a few outliers do not squeeze the rest of the values into a small part of the colorscale
+	Percentile float64
+	// Reverse reverses the colorscale
+	Reverse bool
+}
+
+func computeContinuousOptions(def ContinuousOptions, opt ...ContinuousOptions) ContinuousOptions {
+	if len(opt) == 1 {
+		opts := opt[0]
+		if opts.Colorscale != nil {
+			def.Colorscale = opts.Colorscale
+		}
+		if opts.Title != "" {
+			def.Title = opts.Title
+		}
+		if opts.Percentile != 0 {
+			def.Percentile = opts.Percentile
+		}
+		if opts.Reverse {
+			def.Reverse = opts.Reverse
+		}
+	}
+	return def
+}
+
+// Continuous colors the markers of the trace by values. It sets the marker color, colorscale, cmin and cmax
+// and shows a colorbar with the title. The trace must have a marker with a colorscale, such as scatter or bar.
+// NaN values are drawn with plotly's default for missing colors.
+//
+// Limits equal to 0 are omitted by the generated types, plotly then uses the min or max of the values.
+func Continuous(trace grob.Trace, values []float64, opt ...ContinuousOptions) error {
+	opts := computeContinuousOptions(ContinuousOptions{
+		Colorscale: DefaultColorscale,
+	}, opt...)
+	if opts.Percentile < 0 || opts.Percentile >= 50 {
+		return fmt.Errorf("percentile %g must be between 0 and 50", opts.Percentile)
+	}
+
+	value := reflect.ValueOf(trace)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("trace %s has no marker", trace.GetType())
+	}
+	marker := value.Elem().FieldByName("Marker")
+	if marker.Kind() != reflect.Ptr || marker.Type().Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("trace %s has no marker", trace.GetType())
+	}
+	if marker.IsNil() {
+		marker.Set(reflect.New(marker.Type().Elem()))
+	}
+	marker = marker.Elem()
+	if !marker.FieldByName("Colorscale").IsValid() {
+		return fmt.Errorf("the marker of trace %s has no colorscale", trace.GetType())
+	}
+
+	colors := make([]interface{}, len(values))
+	for i, v := range values {
+		if !math.IsNaN(v) {
+			colors[i] = v
+		}
+	}
+	cmin, cmax := Range(values, opts.Percentile)
+
+	setField(marker, "Color", colors)
+	setField(marker, "Colorscale", opts.Colorscale)
+	setField(marker, "Cmin", cmin)
+	setField(marker, "Cmax", cmax)
+	setField(marker, "Showscale", grob.True)
+	if opts.Reverse {
+		setField(marker, "Reversescale", grob.True)
+	}
+	if opts.Title != "" {
+		colorbar := marker.FieldByName("Colorbar")
+		if colorbar.Kind() == reflect.Ptr {
+			if colorbar.IsNil() {
+				colorbar.Set(reflect.New(colorbar.Type().Elem()))
+			}
+			title := colorbar.Elem().FieldByName("Title")
+			if title.Kind() == reflect.Ptr {
+				if title.IsNil() {
+					title.Set(reflect.New(title.Type().Elem()))
+				}
+				setField(title.Elem(), "Text", opts.Title)
+			}
+		}
+	}
+	return nil
+}
+
+// setField sets the field of the struct if it exists and can hold the value
+func setField(object reflect.Value, name string, value interface{}) {
+	field := object.FieldByName(name)
+	v := reflect.ValueOf(value)
+	if !field.IsValid() || !field.CanSet() {
+		return
+	}
+	if v.Type().AssignableTo(field.Type()) {
+		field.Set(v)
+	} else if v.Type().ConvertibleTo(field.Type()) {
+		field.Set(v.Convert(field.Type()))
+	}
+}
+
+// Range returns the min and max of the values ignoring NaN. If percentile is not 0, the range goes from
+// that percentile to 100 - percentile instead, interpolating between the closest values.
+func Range(values []float64, percentile float64) (float64, float64) {
+	sorted := make([]float64, 0, len(values))
+	for _, v := range values {
+		if !math.IsNaN(v) {
+			sorted = append(sorted, v)
+		}
+	}
+	if len(sorted) == 0 {
+		return 0, 0
+	}
+	sort.Float64s(sorted)
+	return quantile(sorted, percentile/100), quantile(sorted, 1-percentile/100)
+}
+
+// quantile interpolates linearly between the closest ranks of the sorted values
+func quantile(sorted []float64, q float64) float64 {
+	pos := q * float64(len(sorted)-1)
+	lower := int(math.Floor(pos))
+	upper := int(math.Ceil(pos))
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(pos-float64(lower))
+}
@@ -0,0 +1,59 @@
+package colors_test
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/MetalBlueberry/go-plotly/colors"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var _ = Describe("Continuous", func() {
+
+	It("Should color the markers and add a colorbar", func() {
+		trace := &grob.Scatter{}
+		err := colors.Continuous(trace, []float64{3, math.NaN(), 1, 2}, colors.ContinuousOptions{Title: "depth"})
+		Expect(err).To(BeNil())
+
+		Expect(trace.Marker.Color).To(Equal([]interface{}{3.0, nil, 1.0, 2.0}))
+		Expect(trace.Marker.Colorscale).To(Equal(colors.DefaultColorscale))
+		Expect(trace.Marker.Cmin).To(Equal(1.0))
+		Expect(trace.Marker.Cmax).To(Equal(3.0))
+		Expect(trace.Marker.Showscale).To(Equal(grob.True))
+		Expect(trace.Marker.Reversescale).To(BeNil())
+		Expect(trace.Marker.Colorbar.Title.Text).To(Equal("depth"))
+	})
+
+	It("Should keep the existing marker attributes", func() {
+		trace := &grob.Bar{
+			Marker: &grob.BarMarker{
+				Opacity: 0.5,
+			},
+		}
+		err := colors.Continuous(trace, []float64{1, 2}, colors.ContinuousOptions{Colorscale: "RdBu", Reverse: true})
+		Expect(err).To(BeNil())
+		Expect(trace.Marker.Opacity).To(Equal(0.5))
+		Expect(trace.Marker.Colorscale).To(Equal("RdBu"))
+		Expect(trace.Marker.Reversescale).To(Equal(grob.True))
+		Expect(trace.Marker.Colorbar).To(BeNil())
+	})
+
+	It("Should clip the range to the percentiles", func() {
+		values := []float64{}
+		for i := 0; i <= 100; i++ {
+			values = append(values, float64(i))
+		}
+		values = append(values, 10000)
+
+		min, max := colors.Range(values, 5)
+		Expect(min).To(BeNumerically("~", 5.05, 1e-9))
+		Expect(max).To(BeNumerically("~", 95.95, 1e-9))
+	})
+
+	It("Should fail for traces without marker colorscale", func() {
+		Expect(colors.Continuous(&grob.Heatmap{}, []float64{1})).NotTo(Succeed())
+		Expect(colors.Continuous(&grob.Scatter{}, []float64{1}, colors.ContinuousOptions{Percentile: 60})).NotTo(Succeed())
+	})
+})
@@ -0,0 +1,730 @@
+// Package dashboard builds dashboards of figures: pages split in tabs, each a responsive grid of panels,
+// with controls shared by all the pages, like dropdowns and date ranges, whose values are given to Go callbacks
+// that build the figures. It is a lightweight alternative to Dash for Go programs.
+//
+//	d := &dashboard.Dashboard{
+//		Title:    "Production",
+//		Controls: []dashboard.Control{dashboard.Dropdown{Name: "site", Options: []string{"Lyon", "Oslo"}}},
+//		Pages: []dashboard.Page{{
+//			Title: "Overview",
+//			Tabs: []dashboard.Tab{{
+//				Panels: []dashboard.Panel{{Title: "Output", Callback: func(v dashboard.Values) (*grob.Fig, error) {
+//					return outputChart(v["site"])
+//				}}},
+//			}},
+//		}},
+//	}
+//	err := d.Serve(ctx, "localhost:8080")
+//
+// A dashboard is served live, the callbacks being called again when the controls change, or exported as a single
+// HTML page. The exported page embeds the figures of every combination of the dropdown values, so it works without
+// server, and the date ranges zoom the date x axes of the figures instead of calling the callbacks.
+package dashboard
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/offline"
+)
+
+// DefaultMaxCombinations is the default number of combinations of the dropdown values embedded in exported pages
+const DefaultMaxCombinations = 64
+
+// dateLayout is the format of the values of the date ranges
+const dateLayout = "2006-01-02"
+
+// Dashboard is a set of pages of figures with shared controls
+type Dashboard struct {
+	// Title of the dashboard, displayed above the pages
+	Title string
+	// Controls are displayed above the pages, their values are given to the callbacks of the panels of every page
+	Controls []Control
+	// Pages of the dashboard, the navigation bar is hidden if there is only one
+	Pages []Page
+}
+
+// Page is a named set of tabs
+type Page struct {
+	Title string
+	// Tabs of the page, the tab bar is hidden if there is only one
+	Tabs []Tab
+}
+
+// Tab is a named grid of panels
+type Tab struct {
+	Title string
+	// Columns is the number of columns of the grid, defaults to 2. The grid collapses to a single column on small screens
+	Columns int
+	Panels  []Panel
+}
+
+// Callback builds the figure of a panel for the values of the controls
+type Callback func(values Values) (*grob.Fig, error)
+
+// Panel is a cell of the grid that displays a figure
+type Panel struct {
+	// Title is displayed above the figure
+	Title string
+	// Fig is a figure that does not depend on the controls
+	Fig *grob.Fig
+	// Callback builds the figure for the values of the controls, it takes precedence over Fig
+	Callback Callback
+	// ColSpan and RowSpan are the number of columns and rows the panel takes, defaults to 1
+	ColSpan int
+	RowSpan int
+}
+
+// Control is an input shared by the pages of the dashboard, a Dropdown or a DateRange
+type Control interface {
+	control() controlData
+}
+
+// Dropdown selects a value among its options
+type Dropdown struct {
+	// Name of the value given to the callbacks
+	Name string
+	// Label is displayed before the dropdown, defaults to the name
+	Label   string
+	Options []string
+	// Value is the initial value, defaults to the first option
+	Value string
+}
+
+func (c Dropdown) control() controlData {
+	value := c.Value
+	if value == "" && len(c.Options) > 0 {
+		value = c.Options[0]
+	}
+	return controlData{Kind: "dropdown", Name: c.Name, Label: c.Label, Options: c.Options, Value: value}
+}
+
+// DateRange selects the dates between Start and End, the days of the dates are given to the callbacks
+type DateRange struct {
+	// Name of the range given to the callbacks, see Values.DateRange
+	Name string
+	// Label is displayed before the dates, defaults to the name
+	Label string
+	// Start and End are the initial range, unbounded if zero
+	Start time.Time
+	End   time.Time
+}
+
+func (c DateRange) control() controlData {
+	data := controlData{Kind: "daterange", Name: c.Name, Label: c.Label}
+	if !c.Start.IsZero() {
+		data.Start = c.Start.Format(dateLayout)
+	}
+	if !c.End.IsZero() {
+		data.End = c.End.Format(dateLayout)
+	}
+	return data
+}
+
+// Values are the values of the controls by name. A date range is given by two dates formatted like 2006-01-02,
+// with the .start and .end suffixes added to its name, empty if the range is unbounded.
+type Values map[string]string
+
+// DateRange returns the range of the date range control, the dates are zero if the range is unbounded
+func (v Values) DateRange(name string) (time.Time, time.Time, error) {
+	var dates [2]time.Time
+	for i, suffix := range []string{".start", ".end"} {
+		value := v[name+suffix]
+		if value == "" {
+			continue
+		}
+		date, err := time.Parse(dateLayout, value)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("cannot parse %s%s, %w", name, suffix, err)
+		}
+		dates[i] = date
+	}
+	return dates[0], dates[1], nil
+}
+
+// Options configure the HTML pages
+type Options struct {
+	// PlotlyJSURL is the URL of the plotly.js script, it takes precedence over PlotlyJSVersion
+	PlotlyJSURL string
+	// PlotlyJSVersion is the version of plotly.js loaded from the CDN, defaults to offline.DefaultPlotlyJSVersion
+	PlotlyJSVersion string
+	// MaxCombinations is the number of combinations of the dropdown values that an exported page can embed,
+	// defaults to DefaultMaxCombinations. Exporting a dashboard with more combinations fails, serve it instead
+	MaxCombinations int
+}
+
+func computeOptions(def Options, opt ...Options) Options {
+	if len(opt) == 1 {
+		opts := opt[0]
+		if opts.PlotlyJSURL != "" {
+			def.PlotlyJSURL = opts.PlotlyJSURL
+		}
+		if opts.PlotlyJSVersion != "" {
+			def.PlotlyJSVersion = opts.PlotlyJSVersion
+		}
+		if opts.MaxCombinations != 0 {
+			def.MaxCombinations = opts.MaxCombinations
+		}
+	}
+	return def
+}
+
+// plotlyJSURL returns the URL to load plotly.js from
+func (opts Options) plotlyJSURL() string {
+	if opts.PlotlyJSURL != "" {
+		return opts.PlotlyJSURL
+	}
+	return "https://cdn.plot.ly/plotly-" + opts.PlotlyJSVersion + ".min.js"
+}
+
+// WriteHTML writes the dashboard as a single HTML page. The callbacks are called for every combination of the dropdown values,
+// with the initial date ranges, and the figures are embedded in the page.
+func (d *Dashboard) WriteHTML(w io.Writer, opt ...Options) error {
+	opts := computeOptions(Options{
+		PlotlyJSVersion: offline.DefaultPlotlyJSVersion,
+		MaxCombinations: DefaultMaxCombinations,
+	}, opt...)
+	controls, err := d.controls()
+	if err != nil {
+		return err
+	}
+
+	dropdowns := []controlData{}
+	combinations := 1
+	for _, c := range controls {
+		if c.Kind == "dropdown" {
+			dropdowns = append(dropdowns, c)
+			combinations *= len(c.Options)
+		}
+	}
+	if combinations > opts.MaxCombinations {
+		return fmt.Errorf("cannot export %d combinations of the dropdown values, the maximum is %d, serve the dashboard instead", combinations, opts.MaxCombinations)
+	}
+
+	// the figures of the callbacks by combination, the key is the index of the selected option of every dropdown
+	figures := map[string]map[string]*grob.Fig{}
+	values := initialValues(controls)
+	indexes := make([]int, len(dropdowns))
+	for n := 0; n < combinations; n++ {
+		key := make([]string, len(dropdowns))
+		for i, dropdown := range dropdowns {
+			values[dropdown.Name] = dropdown.Options[indexes[i]]
+			key[i] = strconv.Itoa(indexes[i])
+		}
+		figs, err := d.figures(-1, copyValues(values))
+		if err != nil {
+			return err
+		}
+		figures[strings.Join(key, ",")] = figs
+
+		// next combination, the last dropdown changes first
+		for i := len(indexes) - 1; i >= 0; i-- {
+			indexes[i]++
+			if indexes[i] < len(dropdowns[i].Options) {
+				break
+			}
+			indexes[i] = 0
+		}
+	}
+
+	combinationsJSON, err := scriptJSON(figures)
+	if err != nil {
+		return fmt.Errorf("cannot marshal figures, %w", err)
+	}
+	initial := []string{}
+	for _, dropdown := range dropdowns {
+		for i, option := range dropdown.Options {
+			if option == dropdown.Value {
+				initial = append(initial, strconv.Itoa(i))
+				break
+			}
+		}
+	}
+	return d.render(w, opts, controls, figures[strings.Join(initial, ",")], combinationsJSON)
+}
+
+// Handler returns a handler that serves the dashboard at / and the figures of the callbacks of a page
+// for the values of the controls at /figures, which the page requests when the controls change.
+func (d *Dashboard) Handler(opt ...Options) http.Handler {
+	opts := computeOptions(Options{
+		PlotlyJSVersion: offline.DefaultPlotlyJSVersion,
+	}, opt...)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+		controls, err := d.controls()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		figs, err := d.figures(-1, initialValues(controls))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		buf := &bytes.Buffer{}
+		err = d.render(buf, opts, controls, figs, "")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write(buf.Bytes())
+	})
+	mux.HandleFunc("/figures", func(w http.ResponseWriter, r *http.Request) {
+		controls, err := d.controls()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		page, err := strconv.Atoi(r.URL.Query().Get("page"))
+		if err != nil || page < 0 || page >= len(d.Pages) {
+			http.Error(w, "the page is not valid", http.StatusBadRequest)
+			return
+		}
+		values, err := parseValues(controls, r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		figs, err := d.figures(page, values)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(figs)
+	})
+	return mux
+}
+
+// Serve serves the dashboard live at the address until the context is done
+func (d *Dashboard) Serve(ctx context.Context, addr string, opt ...Options) error {
+	srv := &http.Server{
+		Addr:    addr,
+		Handler: d.Handler(opt...),
+	}
+	errs := make(chan error, 1)
+	go func() {
+		errs <- srv.ListenAndServe()
+	}()
+	select {
+	case err := <-errs:
+		return err
+	case <-ctx.Done():
+		shutdown, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return srv.Shutdown(shutdown)
+	}
+}
+
+// controls returns the data of the controls and checks that their names are unique
+func (d *Dashboard) controls() ([]controlData, error) {
+	controls := []controlData{}
+	names := map[string]bool{}
+	for _, c := range d.Controls {
+		data := c.control()
+		if data.Name == "" {
+			return nil, errors.New("a control has no name")
+		}
+		if names[data.Name] {
+			return nil, fmt.Errorf("control %s is defined twice", data.Name)
+		}
+		names[data.Name] = true
+		if data.Kind == "dropdown" {
+			if len(data.Options) == 0 {
+				return nil, fmt.Errorf("dropdown %s has no options", data.Name)
+			}
+			if !contains(data.Options, data.Value) {
+				return nil, fmt.Errorf("the value %s of dropdown %s is not one of its options", data.Value, data.Name)
+			}
+		}
+		if data.Label == "" {
+			data.Label = data.Name
+		}
+		controls = append(controls, data)
+	}
+	return controls, nil
+}
+
+// figures calls the callbacks of the panels of the page, or of every page if it is negative.
+// The figures are returned by the id of their panel
+func (d *Dashboard) figures(page int, values Values) (map[string]*grob.Fig, error) {
+	figs := map[string]*grob.Fig{}
+	for i, p := range d.Pages {
+		if page >= 0 && i != page {
+			continue
+		}
+		for j, tab := range p.Tabs {
+			for k, panel := range tab.Panels {
+				if panel.Callback == nil {
+					continue
+				}
+				fig, err := panel.Callback(values)
+				if err == nil && fig != nil {
+					fig, err = fig.WithDefaultTemplate()
+				}
+				if err != nil {
+					return nil, fmt.Errorf("cannot build figure %d of tab %d of page %d, %w", k, j, i, err)
+				}
+				figs[panelID(i, j, k)] = fig
+			}
+		}
+	}
+	return figs, nil
+}
+
+// render writes the page with the figures of the callbacks. combinations are the figures of every combination
+// of the dropdown values of exported pages, empty for live pages
+func (d *Dashboard) render(w io.Writer, opts Options, controls []controlData, figs map[string]*grob.Fig, combinations string) error {
+	data := pageData{
+		Title:        d.Title,
+		PlotlyJSURL:  opts.plotlyJSURL(),
+		Controls:     controls,
+		Live:         combinations == "",
+		Combinations: combinations,
+	}
+	for i, p := range d.Pages {
+		pageData := pageTabs{ID: fmt.Sprintf("page-%d", i), Title: p.Title}
+		for j, tab := range p.Tabs {
+			tabData := tabPanels{ID: fmt.Sprintf("tab-%d-%d", i, j), Title: tab.Title, Columns: tab.Columns}
+			if tabData.Columns <= 0 {
+				tabData.Columns = 2
+			}
+			for k, panel := range tab.Panels {
+				fig := panel.Fig
+				if panel.Callback != nil {
+					fig = figs[panelID(i, j, k)]
+				}
+				if fig == nil {
+					fig = &grob.Fig{}
+				}
+				fig, err := fig.WithDefaultTemplate()
+				if err != nil {
+					return err
+				}
+				figure, err := scriptJSON(fig)
+				if err != nil {
+					return fmt.Errorf("cannot marshal figure %d of tab %d of page %d, %w", k, j, i, err)
+				}
+				tabData.Panels = append(tabData.Panels, panelData{
+					ID:      panelID(i, j, k),
+					Title:   panel.Title,
+					ColSpan: atLeastOne(panel.ColSpan),
+					RowSpan: atLeastOne(panel.RowSpan),
+					Figure:  figure,
+				})
+			}
+			pageData.Tabs = append(pageData.Tabs, tabData)
+		}
+		data.Pages = append(data.Pages, pageData)
+	}
+
+	tmpl, err := template.New("dashboard").Parse(dashboardHtml)
+	if err != nil {
+		return err
+	}
+	return tmpl.Execute(w, data)
+}
+
+// initialValues are the initial values of the controls
+func initialValues(controls []controlData) Values {
+	values := Values{}
+	for _, c := range controls {
+		switch c.Kind {
+		case "dropdown":
+			values[c.Name] = c.Value
+		case "daterange":
+			values[c.Name+".start"] = c.Start
+			values[c.Name+".end"] = c.End
+		}
+	}
+	return values
+}
+
+// parseValues reads the values of the controls from the query, the values must be valid
+func parseValues(controls []controlData, r *http.Request) (Values, error) {
+	query := r.URL.Query()
+	values := Values{}
+	for _, c := range controls {
+		switch c.Kind {
+		case "dropdown":
+			value := query.Get(c.Name)
+			if !contains(c.Options, value) {
+				return nil, fmt.Errorf("%s is not an option of dropdown %s", value, c.Name)
+			}
+			values[c.Name] = value
+		case "daterange":
+			for _, name := range []string{c.Name + ".start", c.Name + ".end"} {
+				value := query.Get(name)
+				if _, err := time.Parse(dateLayout, value); value != "" && err != nil {
+					return nil, fmt.Errorf("%s is not a date like 2006-01-02", name)
+				}
+				values[name] = value
+			}
+		}
+	}
+	return values, nil
+}
+
+func copyValues(values Values) Values {
+	copied := Values{}
+	for name, value := range values {
+		copied[name] = value
+	}
+	return copied
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func panelID(page, tab, panel int) string {
+	return fmt.Sprintf("plot-%d-%d-%d", page, tab, panel)
+}
+
+func atLeastOne(n int) int {
+	if n < 1 {
+		return 1
+	}
+	return n
+}
+
+// scriptJSON encodes the value to be used as a literal inside a script tag
+func scriptJSON(v interface{}) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(bytes.ReplaceAll(data, []byte("</"), []byte("<\\/"))), nil
+}
+
+type controlData struct {
+	Kind    string
+	Name    string
+	Label   string
+	Options []string
+	Value   string
+	Start   string
+	End     string
+}
+
+type pageData struct {
+	Title        string
+	PlotlyJSURL  string
+	Controls     []controlData
+	Pages        []pageTabs
+	Live         bool
+	Combinations string
+}
+
+type pageTabs struct {
+	ID    string
+	Title string
+	Tabs  []tabPanels
+}
+
+type tabPanels struct {
+	ID      string
+	Title   string
+	Columns int
+	Panels  []panelData
+}
+
+type panelData struct {
+	ID      string
+	Title   string
+	ColSpan int
+	RowSpan int
+	Figure  string
+}
+
+var dashboardHtml = `
+<html>
+	<head>
+		<meta charset="utf-8">
+		<meta name="viewport" content="width=device-width, initial-scale=1">
+		<title>{{ .Title | html }}</title>
+		<script src="{{ .PlotlyJSURL }}"></script>
+		<style>
+			body { font-family: sans-serif; margin: 16px; }
+			.nav, .tabs { display: flex; gap: 4px; border-bottom: 1px solid #ccc; margin-bottom: 16px; }
+			.nav.single, .tabs.single { display: none; }
+			.nav button, .tabs button { border: none; background: none; padding: 8px 16px; cursor: pointer; }
+			.nav button.active, .tabs button.active { border-bottom: 2px solid #636efa; }
+			.controls { display: flex; flex-wrap: wrap; gap: 16px; align-items: center; margin-bottom: 16px; }
+			.controls label { display: flex; gap: 8px; align-items: center; }
+			.error { display: none; color: #b00020; margin-bottom: 16px; }
+			.page, .tab { display: none; }
+			.page.active { display: block; }
+			.tab.active { display: grid; grid-auto-rows: 450px; gap: 16px; }
+			.panel { display: flex; flex-direction: column; min-height: 0; }
+			.panel h3 { margin: 0 0 8px 0; }
+			.plot { flex: 1; min-height: 0; }
+			@media (max-width: 800px) {
+				.tab.active { grid-template-columns: minmax(0, 1fr) !important; }
+				.panel { grid-column: auto !important; }
+			}
+		</style>
+	</head>
+	<body>
+		{{ if .Title }}<h1>{{ .Title | html }}</h1>{{ end }}
+		<div class="controls">
+			{{- range .Controls }}
+			{{- $control := . }}
+			<label>{{ .Label | html }}
+				{{- if eq .Kind "dropdown" }}
+				<select data-control="{{ .Name | html }}" data-kind="dropdown" onchange="update()">
+					{{- range .Options }}
+					<option value="{{ . | html }}"{{ if eq . $control.Value }} selected{{ end }}>{{ . | html }}</option>
+					{{- end }}
+				</select>
+				{{- else }}
+				<input type="date" data-control="{{ .Name | html }}.start" data-kind="start" value="{{ .Start }}" onchange="update()">
+				<input type="date" data-control="{{ .Name | html }}.end" data-kind="end" value="{{ .End }}" onchange="update()">
+				{{- end }}
+			</label>
+			{{- end }}
+		</div>
+		<div id="error" class="error"></div>
+		<div class="nav{{ if le (len .Pages) 1 }} single{{ end }}">
+			{{- range $i, $page := .Pages }}
+			<button id="button-{{ $page.ID }}" onclick="showPage({{ $i }})"{{ if eq $i 0 }} class="active"{{ end }}>{{ $page.Title | html }}</button>
+			{{- end }}
+		</div>
+		{{- range $i, $page := .Pages }}
+		<div id="{{ $page.ID }}" class="page{{ if eq $i 0 }} active{{ end }}">
+			<div class="tabs{{ if le (len $page.Tabs) 1 }} single{{ end }}">
+				{{- range $j, $tab := $page.Tabs }}
+				<button id="button-{{ $tab.ID }}" onclick="showTab('{{ $tab.ID }}')"{{ if eq $j 0 }} class="active"{{ end }}>{{ $tab.Title | html }}</button>
+				{{- end }}
+			</div>
+			{{- range $j, $tab := $page.Tabs }}
+			<div id="{{ $tab.ID }}" class="tab{{ if eq $j 0 }} active{{ end }}" style="grid-template-columns: repeat({{ $tab.Columns }}, minmax(0, 1fr));">
+				{{- range $tab.Panels }}
+				<div class="panel" style="grid-column: span {{ .ColSpan }}; grid-row: span {{ .RowSpan }};">
+					{{ if .Title }}<h3>{{ .Title | html }}</h3>{{ end }}
+					<div id="{{ .ID }}" class="plot"></div>
+				</div>
+				{{- end }}
+			</div>
+			{{- end }}
+		</div>
+		{{- end }}
+		<script>
+			var live = {{ .Live }};
+			// the figures of the callbacks by the indexes of the selected options of the dropdowns, in exported pages
+			var combinations = {{ if .Live }}null{{ else }}{{ .Combinations }}{{ end }};
+			var currentPage = 0;
+			function plot(id, fig) {
+				fig.config = Object.assign({responsive: true}, fig.config);
+				Plotly.react(id, fig);
+			}
+			// plots rendered in hidden pages or tabs must be resized once visible
+			function resize(el) {
+				el.querySelectorAll('.tab.active .plot').forEach(function(plot) {
+					Plotly.Plots.resize(plot);
+				});
+			}
+			function showPage(index) {
+				currentPage = index;
+				document.querySelectorAll('.page, .nav button').forEach(function(el) {
+					el.classList.toggle('active', el.id === 'page-' + index || el.id === 'button-page-' + index);
+				});
+				if (live) {
+					update();
+				}
+				resize(document.getElementById('page-' + index));
+			}
+			function showTab(id) {
+				var page = document.getElementById(id).parentNode;
+				page.querySelectorAll('.tab, .tabs button').forEach(function(el) {
+					el.classList.toggle('active', el.id === id || el.id === 'button-' + id);
+				});
+				resize(page);
+			}
+			function showError(message) {
+				var el = document.getElementById('error');
+				el.textContent = message;
+				el.style.display = message ? 'block' : 'none';
+			}
+			function update() {
+				if (!live) {
+					var key = [];
+					document.querySelectorAll('[data-kind=dropdown]').forEach(function(el) {
+						key.push(el.selectedIndex);
+					});
+					var figs = combinations[key.join(',')] || {};
+					Object.keys(figs).forEach(function(id) {
+						plot(id, JSON.parse(JSON.stringify(figs[id])));
+					});
+					zoom();
+					return;
+				}
+				var params = new URLSearchParams({page: currentPage});
+				document.querySelectorAll('[data-control]').forEach(function(el) {
+					params.set(el.dataset.control, el.value);
+				});
+				fetch('figures?' + params).then(function(response) {
+					if (!response.ok) {
+						return response.text().then(function(text) {
+							throw new Error(text);
+						});
+					}
+					return response.json();
+				}).then(function(figs) {
+					showError('');
+					Object.keys(figs).forEach(function(id) {
+						plot(id, figs[id]);
+					});
+				}).catch(function(err) {
+					showError(err.message);
+				});
+			}
+			// exported pages zoom the date x axes to the first date range instead of calling the callbacks
+			function zoom() {
+				var start = document.querySelector('[data-kind=start]');
+				var end = document.querySelector('[data-kind=end]');
+				if (!start) {
+					return;
+				}
+				document.querySelectorAll('.plot').forEach(function(el) {
+					var xaxis = el._fullLayout && el._fullLayout.xaxis;
+					if (!xaxis || xaxis.type !== 'date') {
+						return;
+					}
+					if (!start.value && !end.value) {
+						Plotly.relayout(el, {'xaxis.autorange': true});
+						return;
+					}
+					Plotly.relayout(el, {'xaxis.range': [start.value || xaxis.range[0], end.value || xaxis.range[1]]});
+				});
+			}
+			{{- range .Pages }}{{ range .Tabs }}{{ range .Panels }}
+			plot('{{ .ID }}', {{ .Figure }});
+			{{- end }}{{ end }}{{ end }}
+			if (!live) {
+				zoom();
+			}
+		</script>
+	</body>
+</html>
+`
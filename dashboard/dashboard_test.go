@@ -0,0 +1,152 @@
+package dashboard_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/MetalBlueberry/go-plotly/dashboard"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var _ = Describe("Dashboard", func() {
+
+	var (
+		d     *dashboard.Dashboard
+		calls []dashboard.Values
+	)
+
+	BeforeEach(func() {
+		calls = nil
+		d = &dashboard.Dashboard{
+			Title: "Production",
+			Controls: []dashboard.Control{
+				dashboard.Dropdown{Name: "site", Label: "Site", Options: []string{"Lyon", "Oslo"}, Value: "Oslo"},
+				dashboard.Dropdown{Name: "line", Options: []string{"1", "2", "3"}},
+				dashboard.DateRange{Name: "period", Start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+			},
+			Pages: []dashboard.Page{
+				{
+					Title: "Overview",
+					Tabs: []dashboard.Tab{{
+						Title:   "Output",
+						Columns: 3,
+						Panels: []dashboard.Panel{
+							{Title: "<b>Output</b>", ColSpan: 2, Callback: func(values dashboard.Values) (*grob.Fig, error) {
+								calls = append(calls, values)
+								return &grob.Fig{Layout: &grob.Layout{Title: &grob.LayoutTitle{Text: values["site"] + " " + values["line"]}}}, nil
+							}},
+							{Fig: &grob.Fig{Layout: &grob.Layout{Title: &grob.LayoutTitle{Text: "</script>"}}}},
+						},
+					}},
+				},
+				{
+					Title: "Quality",
+					Tabs: []dashboard.Tab{
+						{Title: "Defects", Panels: []dashboard.Panel{{Fig: &grob.Fig{}}}},
+						{Title: "Scrap", Panels: []dashboard.Panel{{Fig: &grob.Fig{}}}},
+					},
+				},
+			},
+		}
+	})
+
+	It("Should export the figures of every combination of the dropdowns", func() {
+		buf := &bytes.Buffer{}
+		err := d.WriteHTML(buf, dashboard.Options{PlotlyJSVersion: "2.0.0"})
+		Expect(err).To(BeNil())
+		html := buf.String()
+
+		Expect(calls).To(HaveLen(6))
+		Expect(calls[0]).To(Equal(dashboard.Values{"site": "Lyon", "line": "1", "period.start": "2024-01-01", "period.end": ""}))
+		Expect(html).To(ContainSubstring(`plotly-2.0.0.min.js`))
+		Expect(html).To(ContainSubstring(`<option value="Oslo" selected>Oslo</option>`))
+		Expect(html).To(ContainSubstring(`data-control="period.start" data-kind="start" value="2024-01-01"`))
+		Expect(html).To(ContainSubstring(`"1,2":{"plot-0-0-0":{"layout":{"title":{"text":"Oslo 3"}}}}`))
+		// the initial figures are those of the initial values
+		Expect(html).To(ContainSubstring(`plot('plot-0-0-0', {"layout":{"title":{"text":"Oslo 1"}}})`))
+		Expect(html).To(ContainSubstring(`repeat(3, minmax(0, 1fr))`))
+		Expect(html).To(ContainSubstring(`grid-column: span 2`))
+		Expect(html).To(ContainSubstring(`<h3>&lt;b&gt;Output&lt;/b&gt;</h3>`))
+		Expect(html).To(ContainSubstring(`showTab('tab-1-1')`))
+		Expect(html).NotTo(ContainSubstring(`"text":"</script>"`))
+		Expect(html).To(ContainSubstring(`var live = false;`))
+	})
+
+	It("Should fail to export too many combinations", func() {
+		err := d.WriteHTML(&bytes.Buffer{}, dashboard.Options{MaxCombinations: 4})
+		Expect(err).To(MatchError("cannot export 6 combinations of the dropdown values, the maximum is 4, serve the dashboard instead"))
+	})
+
+	It("Should fail with invalid controls", func() {
+		d.Controls = append(d.Controls, dashboard.Dropdown{Name: "site", Options: []string{"a"}})
+		Expect(d.WriteHTML(&bytes.Buffer{})).NotTo(Succeed())
+		d.Controls = []dashboard.Control{dashboard.Dropdown{Name: "site", Options: []string{"a"}, Value: "b"}}
+		Expect(d.WriteHTML(&bytes.Buffer{})).NotTo(Succeed())
+		d.Controls = []dashboard.Control{dashboard.Dropdown{Name: "site"}}
+		Expect(d.WriteHTML(&bytes.Buffer{})).NotTo(Succeed())
+	})
+
+	It("Should call the callbacks when the controls change", func() {
+		srv := httptest.NewServer(d.Handler())
+		defer srv.Close()
+
+		resp, err := http.Get(srv.URL)
+		Expect(err).To(BeNil())
+		body, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		Expect(err).To(BeNil())
+		Expect(string(body)).To(ContainSubstring(`var live = true;`))
+		Expect(string(body)).To(ContainSubstring(`plot('plot-0-0-0', {"layout":{"title":{"text":"Oslo 1"}}})`))
+
+		resp, err = http.Get(srv.URL + "/figures?page=0&site=Lyon&line=2&period.start=2024-02-01&period.end=2024-03-01")
+		Expect(err).To(BeNil())
+		figs := map[string]*grob.Fig{}
+		Expect(json.NewDecoder(resp.Body).Decode(&figs)).To(Succeed())
+		resp.Body.Close()
+		Expect(figs).To(HaveLen(1))
+		Expect(figs["plot-0-0-0"].Layout.Title.Text).To(Equal("Lyon 2"))
+
+		start, end, err := calls[len(calls)-1].DateRange("period")
+		Expect(err).To(BeNil())
+		Expect(start).To(Equal(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)))
+		Expect(end).To(Equal(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)))
+
+		resp, err = http.Get(srv.URL + "/figures?page=1&site=Lyon&line=2")
+		Expect(err).To(BeNil())
+		figs = map[string]*grob.Fig{}
+		Expect(json.NewDecoder(resp.Body).Decode(&figs)).To(Succeed())
+		resp.Body.Close()
+		Expect(figs).To(BeEmpty())
+
+		for _, query := range []string{"page=0&site=Paris&line=2", "page=0&site=Lyon&line=2&period.start=tomorrow", "page=5&site=Lyon&line=2"} {
+			resp, err = http.Get(srv.URL + "/figures?" + query)
+			Expect(err).To(BeNil())
+			resp.Body.Close()
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest), query)
+		}
+	})
+
+	It("Should report the errors of the callbacks", func() {
+		d.Pages[0].Tabs[0].Panels[0].Callback = func(values dashboard.Values) (*grob.Fig, error) {
+			return nil, errors.New("database is down")
+		}
+		Expect(d.WriteHTML(&bytes.Buffer{})).To(MatchError("cannot build figure 0 of tab 0 of page 0, database is down"))
+
+		srv := httptest.NewServer(d.Handler())
+		defer srv.Close()
+		resp, err := http.Get(srv.URL + "/figures?page=0&site=Lyon&line=2")
+		Expect(err).To(BeNil())
+		body, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+		Expect(string(body)).To(ContainSubstring("database is down"))
+	})
+})
@@ -0,0 +1,164 @@
+package dataset
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/apache/arrow/go/v9/arrow"
+	"github.com/apache/arrow/go/v9/arrow/array"
+)
+
+// arrowTable reads the columns of an Arrow record
+type arrowTable struct {
+	rec arrow.Record
+}
+
+// Arrow returns a Table to read the columns of an Arrow record batch.
+// The values are copied, the record can be released once the traces are built
+func Arrow(rec arrow.Record) Table {
+	return arrowTable{rec: rec}
+}
+
+// Column implements Table. Every array type with a Value(int) method is supported, such as numbers, strings and booleans
+func (t arrowTable) Column(name string) ([]interface{}, error) {
+	indices := t.rec.Schema().FieldIndices(name)
+	if len(indices) == 0 {
+		return nil, fmt.Errorf("column %s not found", name)
+	}
+	column := t.rec.Column(indices[0])
+
+	value := reflect.ValueOf(column).MethodByName("Value")
+	if !value.IsValid() || value.Type().NumIn() != 1 || value.Type().In(0).Kind() != reflect.Int || value.Type().NumOut() != 1 {
+		return nil, fmt.Errorf("column %s of type %s is not supported", name, column.DataType())
+	}
+	values := make([]interface{}, column.Len())
+	for i := range values {
+		if column.IsNull(i) {
+			continue
+		}
+		values[i] = value.Call([]reflect.Value{reflect.ValueOf(i)})[0].Interface()
+	}
+	return values, nil
+}
+
+// ArrowArray is an Arrow array used as a data array of a trace, such as x, y or marker.color.
+// It is marshaled from the Arrow buffers, without converting the values to []float64 first, which halves the memory
+// of float32 sensor data. Null values, NaN and infinities are written as null, so plotly displays them as gaps,
+// and timestamps as dates in their time zone.
+//
+//	trace := &grob.Scattergl{X: dataset.ArrowArray{rec.Column(0)}, Y: dataset.ArrowArray{rec.Column(1)}}
+type ArrowArray struct {
+	arrow.Array
+}
+
+// MarshalJSON implements json.Marshaler. Every array type with a Value(int) method is supported
+func (a ArrowArray) MarshalJSON() ([]byte, error) {
+	if a.Array == nil {
+		return []byte("null"), nil
+	}
+	value, err := arrowValue(a.Array)
+	if err != nil {
+		return nil, err
+	}
+	b := make([]byte, 0, 2+a.Len()*8)
+	b = append(b, '[')
+	for i := 0; i < a.Len(); i++ {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		if a.IsNull(i) {
+			b = append(b, "null"...)
+			continue
+		}
+		b, err = value(b, i)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return append(b, ']'), nil
+}
+
+// arrowValue returns a function that appends the JSON of the value i of the array
+func arrowValue(column arrow.Array) (func(b []byte, i int) ([]byte, error), error) {
+	switch column := column.(type) {
+	case *array.Float64:
+		values := column.Float64Values()
+		return func(b []byte, i int) ([]byte, error) { return appendFloat(b, values[i], 64), nil }, nil
+	case *array.Float32:
+		values := column.Float32Values()
+		return func(b []byte, i int) ([]byte, error) { return appendFloat(b, float64(values[i]), 32), nil }, nil
+	case *array.Int64:
+		values := column.Int64Values()
+		return func(b []byte, i int) ([]byte, error) { return strconv.AppendInt(b, values[i], 10), nil }, nil
+	case *array.Int32:
+		values := column.Int32Values()
+		return func(b []byte, i int) ([]byte, error) { return strconv.AppendInt(b, int64(values[i]), 10), nil }, nil
+	case *array.Int16:
+		values := column.Int16Values()
+		return func(b []byte, i int) ([]byte, error) { return strconv.AppendInt(b, int64(values[i]), 10), nil }, nil
+	case *array.Int8:
+		values := column.Int8Values()
+		return func(b []byte, i int) ([]byte, error) { return strconv.AppendInt(b, int64(values[i]), 10), nil }, nil
+	case *array.Uint64:
+		values := column.Uint64Values()
+		return func(b []byte, i int) ([]byte, error) { return strconv.AppendUint(b, values[i], 10), nil }, nil
+	case *array.Uint32:
+		values := column.Uint32Values()
+		return func(b []byte, i int) ([]byte, error) { return strconv.AppendUint(b, uint64(values[i]), 10), nil }, nil
+	case *array.Uint16:
+		values := column.Uint16Values()
+		return func(b []byte, i int) ([]byte, error) { return strconv.AppendUint(b, uint64(values[i]), 10), nil }, nil
+	case *array.Uint8:
+		values := column.Uint8Values()
+		return func(b []byte, i int) ([]byte, error) { return strconv.AppendUint(b, uint64(values[i]), 10), nil }, nil
+	case *array.Boolean:
+		return func(b []byte, i int) ([]byte, error) { return strconv.AppendBool(b, column.Value(i)), nil }, nil
+	case *array.String:
+		return func(b []byte, i int) ([]byte, error) { return appendJSON(b, column.Value(i)) }, nil
+	case *array.Timestamp:
+		values := column.TimestampValues()
+		unit := column.DataType().(*arrow.TimestampType).Unit.Multiplier()
+		location := time.UTC
+		if zone := column.DataType().(*arrow.TimestampType).TimeZone; zone != "" {
+			loaded, err := time.LoadLocation(zone)
+			if err != nil {
+				return nil, fmt.Errorf("cannot load time zone %s, %w", zone, err)
+			}
+			location = loaded
+		}
+		return func(b []byte, i int) ([]byte, error) {
+			t := time.Unix(0, int64(values[i])*int64(unit)).In(location)
+			b = append(b, '"')
+			b = t.AppendFormat(b, "2006-01-02 15:04:05.999999999")
+			return append(b, '"'), nil
+		}, nil
+	}
+
+	value := reflect.ValueOf(column).MethodByName("Value")
+	if !value.IsValid() || value.Type().NumIn() != 1 || value.Type().In(0).Kind() != reflect.Int || value.Type().NumOut() != 1 {
+		return nil, fmt.Errorf("arrow array of type %s is not supported", column.DataType())
+	}
+	return func(b []byte, i int) ([]byte, error) {
+		return appendJSON(b, value.Call([]reflect.Value{reflect.ValueOf(i)})[0].Interface())
+	}, nil
+}
+
+// appendFloat appends the number with the precision of its bits, NaN and infinities are null
+func appendFloat(b []byte, f float64, bits int) []byte {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return append(b, "null"...)
+	}
+	return strconv.AppendFloat(b, f, 'g', -1, bits)
+}
+
+func appendJSON(b []byte, value interface{}) ([]byte, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return append(b, data...), nil
+}
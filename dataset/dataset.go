@@ -0,0 +1,206 @@
+// Package dataset builds traces from the columns of tabular data, such as a gota DataFrame or an Arrow record.
+// Matrices, such as gonum matrices, are converted to heatmaps, contours and surfaces.
+//
+// Columns are selected by name with a Mapping. If a Color column is given, the rows are split into one trace per category,
+// named after the category, in order of appearance.
+//
+//	traces, err := dataset.Scatter(dataset.Gota(df), dataset.Mapping{X: "date", Y: "price", Color: "ticker"})
+//	fig := &grob.Fig{Data: traces}
+package dataset
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// Table gives access to the columns of a dataset.
+// Missing values are returned as nil, so plotly displays them as gaps
+type Table interface {
+	Column(name string) ([]interface{}, error)
+}
+
+// Columns is a Table made of slices, such as []float64 or []string, by column name
+type Columns map[string]interface{}
+
+// Column implements Table
+func (c Columns) Column(name string) ([]interface{}, error) {
+	column, ok := c[name]
+	if !ok {
+		return nil, fmt.Errorf("column %s not found", name)
+	}
+	value := reflect.ValueOf(column)
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return nil, fmt.Errorf("column %s is not a slice, %T", name, column)
+	}
+	values := make([]interface{}, value.Len())
+	for i := range values {
+		values[i] = value.Index(i).Interface()
+	}
+	return values, nil
+}
+
+// Mapping selects the columns of the traces. Only Y is required
+type Mapping struct {
+	// X is the column of the x values, if empty the row index is used by plotly
+	X string
+	// Y is the column of the y values
+	Y string
+	// Color is a categorical column, there is a trace for each of its values
+	Color string
+	// Size is the column of the marker sizes, only used by Scatter
+	Size string
+	// Text is the column of the hover texts
+	Text string
+}
+
+// group are the values of the rows of a category
+type group struct {
+	name             string
+	x, y, size, text []interface{}
+}
+
+// Scatter builds a scatter trace per category with markers. Set the Mode of the traces to draw lines.
+// With a Size column, the traces are SizedScatter.
+func Scatter(table Table, m Mapping) (grob.Traces, error) {
+	groups, err := split(table, m)
+	if err != nil {
+		return nil, err
+	}
+	traces := make(grob.Traces, len(groups))
+	for i, g := range groups {
+		trace := &grob.Scatter{
+			Type: grob.TraceTypeScatter,
+			Mode: grob.ScatterModeMarkers,
+			Y:    g.y,
+		}
+		if g.name != "" {
+			trace.Name = g.name
+		}
+		if g.x != nil {
+			trace.X = g.x
+		}
+		if g.text != nil {
+			trace.Text = g.text
+		}
+		if g.size != nil {
+			traces[i] = &SizedScatter{Scatter: trace, Sizes: g.size}
+			continue
+		}
+		traces[i] = trace
+	}
+	return traces, nil
+}
+
+// SizedScatter is a scatter trace with a marker size per point.
+// The marker size of a grob.Scatter is a single number, so the Sizes are set in the marker when the trace is encoded
+type SizedScatter struct {
+	*grob.Scatter
+	// Sizes are the marker sizes of the points
+	Sizes []interface{}
+}
+
+// MarshalJSON encodes the scatter trace with the marker sizes
+func (t *SizedScatter) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(t.Scatter)
+	if err != nil {
+		return nil, err
+	}
+	trace := map[string]json.RawMessage{}
+	err = json.Unmarshal(data, &trace)
+	if err != nil {
+		return nil, err
+	}
+	marker := map[string]interface{}{}
+	if raw, ok := trace["marker"]; ok {
+		err = json.Unmarshal(raw, &marker)
+		if err != nil {
+			return nil, err
+		}
+	}
+	marker["size"] = t.Sizes
+	trace["marker"], err = json.Marshal(marker)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(trace)
+}
+
+// Bar builds a bar trace per category
+func Bar(table Table, m Mapping) (grob.Traces, error) {
+	groups, err := split(table, m)
+	if err != nil {
+		return nil, err
+	}
+	traces := make(grob.Traces, len(groups))
+	for i, g := range groups {
+		trace := &grob.Bar{
+			Type: grob.TraceTypeBar,
+			Y:    g.y,
+		}
+		if g.name != "" {
+			trace.Name = g.name
+		}
+		if g.x != nil {
+			trace.X = g.x
+		}
+		if g.text != nil {
+			trace.Text = g.text
+		}
+		traces[i] = trace
+	}
+	return traces, nil
+}
+
+// split reads the mapped columns and splits the rows by the Color column
+func split(table Table, m Mapping) ([]*group, error) {
+	if m.Y == "" {
+		return nil, fmt.Errorf("the y column is required")
+	}
+	y, err := table.Column(m.Y)
+	if err != nil {
+		return nil, err
+	}
+	columns := map[string][]interface{}{}
+	for _, name := range []string{m.X, m.Color, m.Size, m.Text} {
+		if name == "" {
+			continue
+		}
+		column, err := table.Column(name)
+		if err != nil {
+			return nil, err
+		}
+		if len(column) != len(y) {
+			return nil, fmt.Errorf("column %s has %d values, but column %s has %d", name, len(column), m.Y, len(y))
+		}
+		columns[name] = column
+	}
+
+	groups := []*group{}
+	byName := map[string]*group{}
+	for i := range y {
+		name := ""
+		if m.Color != "" {
+			name = fmt.Sprint(columns[m.Color][i])
+		}
+		g, ok := byName[name]
+		if !ok {
+			g = &group{name: name}
+			byName[name] = g
+			groups = append(groups, g)
+		}
+		g.y = append(g.y, y[i])
+		if m.X != "" {
+			g.x = append(g.x, columns[m.X][i])
+		}
+		if m.Size != "" {
+			g.size = append(g.size, columns[m.Size][i])
+		}
+		if m.Text != "" {
+			g.text = append(g.text, columns[m.Text][i])
+		}
+	}
+	return groups, nil
+}
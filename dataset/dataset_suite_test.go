@@ -0,0 +1,13 @@
+package dataset_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestDataset(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Dataset Suite")
+}
@@ -0,0 +1,163 @@
+package dataset_test
+
+import (
+	"encoding/json"
+	"math"
+
+	"github.com/apache/arrow/go/v9/arrow"
+	"github.com/apache/arrow/go/v9/arrow/array"
+	"github.com/apache/arrow/go/v9/arrow/memory"
+	"github.com/go-gota/gota/dataframe"
+	"github.com/go-gota/gota/series"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/MetalBlueberry/go-plotly/dataset"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var _ = Describe("Dataset", func() {
+
+	It("Should split the rows by category", func() {
+		table := dataset.Columns{
+			"day":    []int{1, 1, 2, 2},
+			"price":  []float64{10, 20, 11, 21},
+			"ticker": []string{"A", "B", "A", "B"},
+		}
+		traces, err := dataset.Scatter(table, dataset.Mapping{X: "day", Y: "price", Color: "ticker"})
+		Expect(err).To(BeNil())
+		Expect(traces).To(HaveLen(2))
+
+		a := traces[0].(*grob.Scatter)
+		Expect(a.Type).To(Equal(grob.TraceTypeScatter))
+		Expect(a.Name).To(Equal("A"))
+		Expect(a.X).To(Equal([]interface{}{1, 2}))
+		Expect(a.Y).To(Equal([]interface{}{10.0, 11.0}))
+
+		b := traces[1].(*grob.Scatter)
+		Expect(b.Name).To(Equal("B"))
+		Expect(b.Y).To(Equal([]interface{}{20.0, 21.0}))
+	})
+
+	It("Should set a marker size per point", func() {
+		table := dataset.Columns{
+			"day":    []int{1, 1, 2, 2},
+			"price":  []float64{10, 20, 11, 21},
+			"ticker": []string{"A", "B", "A", "B"},
+			"volume": []float64{5, 6, 7, 8},
+		}
+		traces, err := dataset.Scatter(table, dataset.Mapping{X: "day", Y: "price", Color: "ticker", Size: "volume"})
+		Expect(err).To(BeNil())
+		Expect(traces).To(HaveLen(2))
+
+		a := traces[0].(*dataset.SizedScatter)
+		Expect(a.GetType()).To(Equal(grob.TraceTypeScatter))
+		Expect(a.Name).To(Equal("A"))
+		Expect(a.Sizes).To(Equal([]interface{}{5.0, 7.0}))
+		a.Marker = &grob.ScatterMarker{Color: "red"}
+
+		fig := &grob.Fig{Data: traces}
+		data, err := json.Marshal(fig)
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(ContainSubstring(`"marker":{"color":"red","size":[5,7]}`))
+		Expect(string(data)).To(ContainSubstring(`"marker":{"size":[6,8]}`))
+		Expect(string(data)).To(ContainSubstring(`"name":"B"`))
+	})
+
+	It("Should build a single trace without color", func() {
+		table := dataset.Columns{
+			"fruit":  []string{"apple", "pear"},
+			"amount": []int{3, 4},
+		}
+		traces, err := dataset.Bar(table, dataset.Mapping{X: "fruit", Y: "amount", Text: "fruit"})
+		Expect(err).To(BeNil())
+		Expect(traces).To(HaveLen(1))
+		bar := traces[0].(*grob.Bar)
+		Expect(bar.Name).To(BeNil())
+		Expect(bar.X).To(Equal([]interface{}{"apple", "pear"}))
+		Expect(bar.Text).To(Equal([]interface{}{"apple", "pear"}))
+	})
+
+	It("Should fail with missing or inconsistent columns", func() {
+		table := dataset.Columns{
+			"x": []int{1, 2},
+			"y": []int{1},
+		}
+		_, err := dataset.Scatter(table, dataset.Mapping{Y: "z"})
+		Expect(err).NotTo(BeNil())
+		_, err = dataset.Scatter(table, dataset.Mapping{X: "x", Y: "y"})
+		Expect(err).NotTo(BeNil())
+		_, err = dataset.Scatter(table, dataset.Mapping{X: "x"})
+		Expect(err).NotTo(BeNil())
+	})
+
+	It("Should read gota data frames", func() {
+		df := dataframe.New(
+			series.New([]string{"a", "b", "a"}, series.String, "group"),
+			series.New([]float64{1, math.NaN(), 3}, series.Float, "value"),
+		)
+		traces, err := dataset.Scatter(dataset.Gota(df), dataset.Mapping{Y: "value", Color: "group"})
+		Expect(err).To(BeNil())
+		Expect(traces).To(HaveLen(2))
+		Expect(traces[0].(*grob.Scatter).Y).To(Equal([]interface{}{1.0, 3.0}))
+		// missing values are gaps
+		Expect(traces[1].(*grob.Scatter).Y).To(Equal([]interface{}{nil}))
+
+		_, err = dataset.Gota(df).Column("missing")
+		Expect(err).NotTo(BeNil())
+	})
+
+	It("Should read arrow records", func() {
+		pool := memory.NewGoAllocator()
+		schema := arrow.NewSchema([]arrow.Field{
+			{Name: "name", Type: arrow.BinaryTypes.String},
+			{Name: "value", Type: arrow.PrimitiveTypes.Int64, Nullable: true},
+		}, nil)
+		builder := array.NewRecordBuilder(pool, schema)
+		defer builder.Release()
+		builder.Field(0).(*array.StringBuilder).AppendValues([]string{"a", "b"}, nil)
+		builder.Field(1).(*array.Int64Builder).AppendValues([]int64{1, 0}, []bool{true, false})
+		rec := builder.NewRecord()
+		defer rec.Release()
+
+		traces, err := dataset.Bar(dataset.Arrow(rec), dataset.Mapping{X: "name", Y: "value"})
+		Expect(err).To(BeNil())
+		bar := traces[0].(*grob.Bar)
+		Expect(bar.X).To(Equal([]interface{}{"a", "b"}))
+		Expect(bar.Y).To(Equal([]interface{}{int64(1), nil}))
+
+		_, err = dataset.Arrow(rec).Column("missing")
+		Expect(err).NotTo(BeNil())
+	})
+
+	It("Should marshal arrow arrays as data arrays", func() {
+		pool := memory.NewGoAllocator()
+
+		floats := array.NewFloat32Builder(pool)
+		defer floats.Release()
+		floats.AppendValues([]float32{0.1, float32(math.NaN()), 3}, []bool{true, true, false})
+		y := floats.NewFloat32Array()
+		defer y.Release()
+
+		times := array.NewTimestampBuilder(pool, &arrow.TimestampType{Unit: arrow.Millisecond})
+		defer times.Release()
+		times.AppendValues([]arrow.Timestamp{1609459200000, 1609459200500, 0}, nil)
+		x := times.NewTimestampArray()
+		defer x.Release()
+
+		names := array.NewStringBuilder(pool)
+		defer names.Release()
+		names.AppendValues([]string{"a", `"b"`, "c"}, nil)
+		text := names.NewStringArray()
+		defer text.Release()
+
+		trace := &grob.Scattergl{X: dataset.ArrowArray{x}, Y: dataset.ArrowArray{y}, Text: dataset.ArrowArray{text}}
+		data, err := json.Marshal(trace)
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(Equal(`{"text":["a","\"b\"","c"],"x":["2021-01-01 00:00:00","2021-01-01 00:00:00.5","1970-01-01 00:00:00"],"y":[0.1,null,null]}`))
+
+		data, err = json.Marshal(dataset.ArrowArray{})
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(Equal("null"))
+	})
+})
@@ -0,0 +1,177 @@
+package dataset
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/subplots"
+	"github.com/MetalBlueberry/go-plotly/themes"
+)
+
+// Facets split the rows in small multiples, like facet_row and facet_col of plotly express. At least one of them is required
+type Facets struct {
+	// Row is a categorical column, there is a row of subplots for each of its values
+	Row string
+	// Col is a categorical column, there is a column of subplots for each of its values
+	Col string
+}
+
+// Builder builds the traces of a table, such as Scatter or Bar
+type Builder func(table Table, m Mapping) (grob.Traces, error)
+
+// Facet builds the traces of every facet with the builder and lays them out in a grid of subplots with shared axes.
+// The subplots are titled after their categories. Traces of the same Color category have the same color in every subplot
+// and share a single legend entry.
+func Facet(table Table, m Mapping, f Facets, build Builder) (*grob.Fig, error) {
+	if f.Row == "" && f.Col == "" {
+		return nil, fmt.Errorf("a row or col facet is required")
+	}
+	if m.Y == "" {
+		return nil, fmt.Errorf("the y column is required")
+	}
+	names := []string{}
+	for _, name := range []string{m.X, m.Y, m.Color, m.Size, m.Text} {
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	columns := Columns{}
+	for _, name := range names {
+		column, err := table.Column(name)
+		if err != nil {
+			return nil, err
+		}
+		columns[name] = column
+	}
+
+	rows, rowLabels, err := categories(table, f.Row)
+	if err != nil {
+		return nil, err
+	}
+	cols, colLabels, err := categories(table, f.Col)
+	if err != nil {
+		return nil, err
+	}
+	length := len(columns[m.Y].([]interface{}))
+	if (f.Row != "" && len(rows) != length) || (f.Col != "" && len(cols) != length) {
+		return nil, fmt.Errorf("the facet columns must have %d values like column %s", length, m.Y)
+	}
+	if len(rowLabels)*len(colLabels) > subplots.MaxCells {
+		return nil, fmt.Errorf("%d facets do not fit in a grid of subplots, the maximum is %d", len(rowLabels)*len(colLabels), subplots.MaxCells)
+	}
+
+	titles := []string{}
+	for _, row := range rowLabels {
+		for _, col := range colLabels {
+			parts := []string{}
+			if f.Row != "" {
+				parts = append(parts, fmt.Sprintf("%s=%s", f.Row, row))
+			}
+			if f.Col != "" {
+				parts = append(parts, fmt.Sprintf("%s=%s", f.Col, col))
+			}
+			titles = append(titles, strings.Join(parts, ", "))
+		}
+	}
+	sp := subplots.New(len(rowLabels), len(colLabels), subplots.Options{
+		SharedXAxes: true,
+		SharedYAxes: true,
+		Titles:      titles,
+	})
+
+	colors := map[string]interface{}{}
+	for i, row := range rowLabels {
+		for j, col := range colLabels {
+			facet := Columns{}
+			for name, column := range columns {
+				values := []interface{}{}
+				for k, value := range column.([]interface{}) {
+					if (f.Row == "" || rows[k] == row) && (f.Col == "" || cols[k] == col) {
+						values = append(values, value)
+					}
+				}
+				facet[name] = values
+			}
+			if len(facet[m.Y].([]interface{})) == 0 {
+				continue
+			}
+
+			traces, err := build(facet, m)
+			if err != nil {
+				return nil, err
+			}
+			for _, trace := range traces {
+				styleCategory(trace, colors)
+				err := sp.Add(trace, i+1, j+1)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	return sp.Figure(), nil
+}
+
+// categories returns the category of every row and the distinct categories in order of appearance.
+// Without column, there is a single empty category
+func categories(table Table, name string) ([]string, []string, error) {
+	if name == "" {
+		return nil, []string{""}, nil
+	}
+	column, err := table.Column(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	values := make([]string, len(column))
+	distinct := []string{}
+	seen := map[string]bool{}
+	for i, value := range column {
+		values[i] = fmt.Sprint(value)
+		if !seen[values[i]] {
+			seen[values[i]] = true
+			distinct = append(distinct, values[i])
+		}
+	}
+	return values, distinct, nil
+}
+
+// styleCategory gives the trace the color of its category, so it is the same in every facet,
+// and only shows in the legend the first trace of each category
+func styleCategory(trace grob.Trace, colors map[string]interface{}) {
+	value := reflect.ValueOf(trace)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return
+	}
+	value = value.Elem()
+
+	name := ""
+	if field := value.FieldByName("Name"); field.IsValid() && !field.IsNil() {
+		name = fmt.Sprint(field.Interface())
+	}
+	color, seen := colors[name]
+	if !seen {
+		color = themes.PlotlyColorway[len(colors)%len(themes.PlotlyColorway)]
+		colors[name] = color
+	}
+
+	if field := value.FieldByName("Legendgroup"); field.Kind() == reflect.Interface {
+		field.Set(reflect.ValueOf(name))
+	}
+	if field := value.FieldByName("Showlegend"); field.IsValid() && field.Type() == reflect.TypeOf(grob.True) && (seen || name == "") {
+		field.Set(reflect.ValueOf(grob.False))
+	}
+	for _, object := range []string{"Marker", "Line"} {
+		field := value.FieldByName(object)
+		if field.Kind() != reflect.Ptr || field.Type().Elem().Kind() != reflect.Struct {
+			continue
+		}
+		if field.IsNil() {
+			field.Set(reflect.New(field.Type().Elem()))
+		}
+		if colorField := field.Elem().FieldByName("Color"); colorField.Kind() == reflect.Interface && colorField.IsNil() {
+			colorField.Set(reflect.ValueOf(color))
+		}
+	}
+}
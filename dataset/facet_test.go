@@ -0,0 +1,67 @@
+package dataset_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/MetalBlueberry/go-plotly/dataset"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/themes"
+)
+
+var _ = Describe("Facet", func() {
+
+	table := dataset.Columns{
+		"day":    []string{"mon", "mon", "tue", "tue", "mon"},
+		"site":   []string{"north", "south", "north", "south", "north"},
+		"sensor": []string{"a", "a", "b", "a", "b"},
+		"value":  []float64{1, 2, 3, 4, 5},
+	}
+
+	It("Should lay out a subplot per category", func() {
+		fig, err := dataset.Facet(table, dataset.Mapping{Y: "value", Color: "sensor"}, dataset.Facets{Row: "day", Col: "site"}, dataset.Scatter)
+		Expect(err).To(BeNil())
+
+		// mon/north has a and b, mon/south a, tue/north b and tue/south a
+		Expect(fig.Data).To(HaveLen(5))
+		first := fig.Data[0].(*grob.Scatter)
+		Expect(first.Name).To(Equal("a"))
+		Expect(first.Y).To(Equal([]interface{}{1.0}))
+		Expect(first.Xaxis).To(Equal("x"))
+
+		last := fig.Data[4].(*grob.Scatter)
+		Expect(last.Name).To(Equal("a"))
+		Expect(last.Y).To(Equal([]interface{}{4.0}))
+		Expect(last.Xaxis).To(Equal("x4"))
+
+		Expect(fig.Layout.Xaxis.Matches).To(BeEquivalentTo("x3"))
+		annotations := fig.Layout.Annotations.([]map[string]interface{})
+		Expect(annotations[1]).To(HaveKeyWithValue("text", "day=mon, site=south"))
+	})
+
+	It("Should keep the colors and legend entries of the categories", func() {
+		fig, err := dataset.Facet(table, dataset.Mapping{Y: "value", Color: "sensor"}, dataset.Facets{Col: "site"}, dataset.Bar)
+		Expect(err).To(BeNil())
+		Expect(fig.Data).To(HaveLen(3))
+
+		colors := map[interface{}]interface{}{}
+		for _, trace := range fig.Data {
+			bar := trace.(*grob.Bar)
+			Expect(bar.Legendgroup).To(Equal(bar.Name))
+			if previous, ok := colors[bar.Name]; ok {
+				Expect(bar.Marker.Color).To(Equal(previous))
+				Expect(bar.Showlegend).To(Equal(grob.False))
+			} else {
+				Expect(bar.Showlegend).To(BeNil())
+			}
+			colors[bar.Name] = bar.Marker.Color
+		}
+		Expect(colors).To(HaveKeyWithValue("a", themes.PlotlyColorway[0]))
+		Expect(colors).To(HaveKeyWithValue("b", themes.PlotlyColorway[1]))
+	})
+
+	It("Should require a facet", func() {
+		_, err := dataset.Facet(table, dataset.Mapping{Y: "value"}, dataset.Facets{}, dataset.Scatter)
+		Expect(err).NotTo(BeNil())
+	})
+})
@@ -0,0 +1,34 @@
+package dataset
+
+import (
+	"fmt"
+
+	"github.com/go-gota/gota/dataframe"
+)
+
+// gotaTable reads the columns of a gota DataFrame
+type gotaTable struct {
+	df dataframe.DataFrame
+}
+
+// Gota returns a Table to read the columns of a gota DataFrame
+func Gota(df dataframe.DataFrame) Table {
+	return gotaTable{df: df}
+}
+
+// Column implements Table
+func (t gotaTable) Column(name string) ([]interface{}, error) {
+	series := t.df.Col(name)
+	if series.Err != nil {
+		return nil, fmt.Errorf("cannot read column %s, %w", name, series.Err)
+	}
+	values := make([]interface{}, series.Len())
+	for i := range values {
+		elem := series.Elem(i)
+		if elem.IsNA() {
+			continue
+		}
+		values[i] = elem.Val()
+	}
+	return values, nil
+}
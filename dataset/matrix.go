@@ -0,0 +1,81 @@
+package dataset
+
+import (
+	"math"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// Matrix is a matrix of numbers. It is satisfied by gonum mat.Matrix, so gonum matrices can be given directly
+type Matrix interface {
+	// Dims returns the number of rows and columns
+	Dims() (r, c int)
+	// At returns the value at row i and column j
+	At(i, j int) float64
+}
+
+// Z converts the matrix to the z values of plotly, a slice of rows.
+// NaN and infinite values are nil, so plotly leaves them blank
+func Z(m Matrix) [][]interface{} {
+	rows, cols := m.Dims()
+	z := make([][]interface{}, rows)
+	for i := range z {
+		z[i] = make([]interface{}, cols)
+		for j := range z[i] {
+			v := m.At(i, j)
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				continue
+			}
+			z[i][j] = v
+		}
+	}
+	return z
+}
+
+// Heatmap builds a heatmap of the matrix. x are the coordinates of the columns and y of the rows,
+// they are optional and default to the indexes
+func Heatmap(m Matrix, x, y []float64) *grob.Heatmap {
+	trace := &grob.Heatmap{
+		Type: grob.TraceTypeHeatmap,
+		Z:    Z(m),
+	}
+	if x != nil {
+		trace.X = x
+	}
+	if y != nil {
+		trace.Y = y
+	}
+	return trace
+}
+
+// Contour builds a contour plot of the matrix. x are the coordinates of the columns and y of the rows,
+// they are optional and default to the indexes
+func Contour(m Matrix, x, y []float64) *grob.Contour {
+	trace := &grob.Contour{
+		Type: grob.TraceTypeContour,
+		Z:    Z(m),
+	}
+	if x != nil {
+		trace.X = x
+	}
+	if y != nil {
+		trace.Y = y
+	}
+	return trace
+}
+
+// Surface builds a 3D surface with the matrix as heights. x are the coordinates of the columns and y of the rows,
+// they are optional and default to the indexes
+func Surface(m Matrix, x, y []float64) *grob.Surface {
+	trace := &grob.Surface{
+		Type: grob.TraceTypeSurface,
+		Z:    Z(m),
+	}
+	if x != nil {
+		trace.X = x
+	}
+	if y != nil {
+		trace.Y = y
+	}
+	return trace
+}
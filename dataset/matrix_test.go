@@ -0,0 +1,45 @@
+package dataset_test
+
+import (
+	"encoding/json"
+	"math"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"gonum.org/v1/gonum/mat"
+
+	"github.com/MetalBlueberry/go-plotly/dataset"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var _ = Describe("Matrix", func() {
+
+	It("Should convert the rows of gonum matrices to z values", func() {
+		m := mat.NewDense(2, 3, []float64{
+			1, 2, 3,
+			4, math.NaN(), math.Inf(1),
+		})
+		Expect(dataset.Z(m)).To(Equal([][]interface{}{
+			{1.0, 2.0, 3.0},
+			{4.0, nil, nil},
+		}))
+	})
+
+	It("Should build heatmaps, contours and surfaces", func() {
+		m := mat.NewDense(2, 2, []float64{1, 2, 3, 4})
+
+		heatmap := dataset.Heatmap(m, []float64{10, 20}, []float64{0.5, 1.5})
+		Expect(heatmap.X).To(Equal([]float64{10, 20}))
+		Expect(heatmap.Y).To(Equal([]float64{0.5, 1.5}))
+
+		contour := dataset.Contour(m.T(), nil, nil)
+		Expect(contour.Type).To(Equal(grob.TraceTypeContour))
+		Expect(contour.Z).To(Equal([][]interface{}{{1.0, 3.0}, {2.0, 4.0}}))
+		Expect(contour.X).To(BeNil())
+
+		surface := dataset.Surface(m, nil, nil)
+		surfaceBytes, err := json.Marshal(surface)
+		Expect(err).To(BeNil())
+		Expect(string(surfaceBytes)).To(Equal(`{"type":"surface","z":[[1,2],[3,4]]}`))
+	})
+})
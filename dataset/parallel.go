@@ -0,0 +1,339 @@
+package dataset
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/MetalBlueberry/go-plotly/colors"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// Dimension is an axis of a parallel coordinates or parallel categories trace
+// https://plotly.com/javascript/reference/parcoords/#parcoords-dimensions
+type Dimension struct {
+	Label  string      `json:"label,omitempty"`
+	Values interface{} `json:"values"`
+	// Range of the numeric axes of parallel coordinates
+	Range []float64 `json:"range,omitempty"`
+	// Tickvals and Ticktext name the categories of the text axes of parallel coordinates, drawn at their indexes
+	Tickvals []float64 `json:"tickvals,omitempty"`
+	Ticktext []string  `json:"ticktext,omitempty"`
+}
+
+// ParallelOptions configure ParallelCoordinates and ParallelCategories
+type ParallelOptions struct {
+	// Fields are the names of the fields plotted as dimensions, in order.
+	// Defaults to the exported fields of numeric, string or bool type, except those tagged plot:"-"
+	Fields []string
+	// Color is the name of the field that colors the lines, defaults to the field tagged plot:"color" if any
+	Color string
+	// Colorscale colors numeric color fields, defaults to colors.DefaultColorscale
+	Colorscale grob.ColorScale
+	// Palette colors the categories of text and bool color fields, in alphabetical order. Defaults to colors.Plotly
+	Palette colors.Palette
+}
+
+func computeParallelOptions(def ParallelOptions, opt ...ParallelOptions) ParallelOptions {
+	if len(opt) == 1 {
+		opts := opt[0]
+		if opts.Fields != nil {
+			def.Fields = opts.Fields
+		}
+		if opts.Color != "" {
+			def.Color = opts.Color
+		}
+		if opts.Colorscale != nil {
+			def.Colorscale = opts.Colorscale
+		}
+		if opts.Palette != nil {
+			def.Palette = opts.Palette
+		}
+	}
+	return def
+}
+
+// parallelColumn are the values of a field of the structs
+type parallelColumn struct {
+	label string
+	// numbers are the values of numeric fields, or the indexes of the categories of the other fields
+	numbers []float64
+	// values are the values of the field, as given to parallel categories
+	values []interface{}
+	// categories of text and bool fields in alphabetical order, nil for numeric fields
+	categories []string
+}
+
+// ParallelCoordinates builds a parallel coordinates trace from a slice of structs, or of pointers to structs, with an axis per field.
+// Numeric fields span the range of their values, text and bool fields have a tick per category in alphabetical order.
+// The axes are named after the fields, or the name option of their plot tag, like Structs. The lines are colored by the Color field,
+// with a colorscale if it is numeric or a color per category otherwise. Nil pointers are skipped and NaN values are missing.
+//
+//	type Cell struct {
+//		Capacity   float64 `plot:",name=Capacity (Ah)"`
+//		Resistance float64
+//		Supplier   string `plot:"color"`
+//	}
+//
+//	trace, err := dataset.ParallelCoordinates(cells)
+func ParallelCoordinates(slice interface{}, opt ...ParallelOptions) (*grob.Parcoords, error) {
+	opts := computeParallelOptions(ParallelOptions{
+		Colorscale: colors.DefaultColorscale,
+		Palette:    colors.Plotly,
+	}, opt...)
+	columns, color, err := parallelColumns(slice, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	dimensions := make([]Dimension, len(columns))
+	for i, column := range columns {
+		dimension := Dimension{
+			Label:  column.label,
+			Values: nullable(column.numbers),
+		}
+		if column.categories != nil {
+			dimension.Tickvals = make([]float64, len(column.categories))
+			for j := range column.categories {
+				dimension.Tickvals[j] = float64(j)
+			}
+			dimension.Ticktext = column.categories
+			dimension.Range = []float64{0, math.Max(float64(len(column.categories)-1), 1)}
+		} else {
+			dimension.Range = numberRange(column.numbers)
+		}
+		dimensions[i] = dimension
+	}
+
+	trace := &grob.Parcoords{
+		Type:       grob.TraceTypeParcoords,
+		Dimensions: dimensions,
+	}
+	if color != nil {
+		trace.Line = &grob.ParcoordsLine{}
+		trace.Line.Color, trace.Line.Colorscale, trace.Line.Showscale = lineColors(color, opts)
+	}
+	return trace, nil
+}
+
+// ParallelCategories builds a parallel categories trace from a slice of structs, or of pointers to structs, with an axis per field.
+// Every value of a field is a category, so numeric fields should have few values. The fields are selected and named like
+// ParallelCoordinates, and the ribbons are colored by the Color field.
+//
+//	trace, err := dataset.ParallelCategories(cells, dataset.ParallelOptions{Fields: []string{"Supplier", "Line", "Grade"}, Color: "Grade"})
+func ParallelCategories(slice interface{}, opt ...ParallelOptions) (*grob.Parcats, error) {
+	opts := computeParallelOptions(ParallelOptions{
+		Colorscale: colors.DefaultColorscale,
+		Palette:    colors.Plotly,
+	}, opt...)
+	columns, color, err := parallelColumns(slice, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	dimensions := make([]Dimension, len(columns))
+	for i, column := range columns {
+		dimensions[i] = Dimension{
+			Label:  column.label,
+			Values: column.values,
+		}
+	}
+	trace := &grob.Parcats{
+		Type:       grob.TraceTypeParcats,
+		Dimensions: dimensions,
+	}
+	if color != nil {
+		trace.Line = &grob.ParcatsLine{
+			Shape: grob.ParcatsLineShapeHspline,
+		}
+		trace.Line.Color, trace.Line.Colorscale, trace.Line.Showscale = lineColors(color, opts)
+	}
+	return trace, nil
+}
+
+// lineColors returns the colors of the lines by the column: the values and the colorscale for numeric columns,
+// or the indexes of the categories and a colorscale with a band per category
+func lineColors(column *parallelColumn, opts ParallelOptions) (grob.Color, grob.ColorScale, grob.Bool) {
+	if column.categories == nil {
+		return nullable(column.numbers), opts.Colorscale, grob.True
+	}
+	n := len(column.categories)
+	if n == 1 {
+		return column.numbers, [][]interface{}{{0, opts.Palette.Color(0)}, {1, opts.Palette.Color(0)}}, grob.False
+	}
+	// the indexes go from 0 to n-1, every band is centered on the index of its category
+	scale := [][]interface{}{}
+	for i := 0; i < n; i++ {
+		start := math.Max(0, (float64(i)-0.5)/float64(n-1))
+		end := math.Min(1, (float64(i)+0.5)/float64(n-1))
+		scale = append(scale, []interface{}{start, opts.Palette.Color(i)}, []interface{}{end, opts.Palette.Color(i)})
+	}
+	return column.numbers, scale, grob.False
+}
+
+// nullable converts the numbers to values where NaN is nil, so they can be marshaled
+func nullable(numbers []float64) []interface{} {
+	values := make([]interface{}, len(numbers))
+	for i, v := range numbers {
+		if !math.IsNaN(v) && !math.IsInf(v, 0) {
+			values[i] = v
+		}
+	}
+	return values
+}
+
+// numberRange returns the min and the max of the numbers ignoring NaN
+func numberRange(numbers []float64) []float64 {
+	min, max := math.Inf(1), math.Inf(-1)
+	for _, v := range numbers {
+		if !math.IsNaN(v) {
+			min, max = math.Min(min, v), math.Max(max, v)
+		}
+	}
+	if min > max {
+		return nil
+	}
+	return []float64{min, max}
+}
+
+// parallelColumns reads the fields of the structs selected by the options and the color field, which is nil if there is none
+func parallelColumns(slice interface{}, opts ParallelOptions) ([]*parallelColumn, *parallelColumn, error) {
+	value := reflect.ValueOf(slice)
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return nil, nil, fmt.Errorf("cannot plot %T, it must be a slice of structs", slice)
+	}
+	elem := value.Type().Elem()
+	if elem.Kind() == reflect.Ptr {
+		elem = elem.Elem()
+	}
+	if elem.Kind() != reflect.Struct {
+		return nil, nil, fmt.Errorf("cannot plot %T, it must be a slice of structs", slice)
+	}
+	// nil pointers have no values, they are skipped
+	items := make([]reflect.Value, 0, value.Len())
+	for i := 0; i < value.Len(); i++ {
+		item := value.Index(i)
+		if item.Kind() == reflect.Ptr {
+			if item.IsNil() {
+				continue
+			}
+			item = item.Elem()
+		}
+		items = append(items, item)
+	}
+
+	// labels of the exported fields by name, and the field tagged color
+	labels := map[string]string{}
+	defaults := []string{}
+	color := opts.Color
+	for i := 0; i < elem.NumField(); i++ {
+		f := elem.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+		tag := f.Tag.Get("plot")
+		if tag == "-" {
+			continue
+		}
+		labels[f.Name] = f.Name
+		parts := strings.Split(tag, ",")
+		if strings.TrimSpace(parts[0]) == "color" && opts.Color == "" {
+			color = f.Name
+		}
+		for _, option := range parts[1:] {
+			if strings.HasPrefix(option, "name=") {
+				labels[f.Name] = strings.TrimPrefix(option, "name=")
+			}
+		}
+		if parallelKind(f.Type.Kind()) {
+			defaults = append(defaults, f.Name)
+		}
+	}
+	fields := opts.Fields
+	if fields == nil {
+		fields = defaults
+	}
+	if len(fields) == 0 {
+		return nil, nil, fmt.Errorf("%s has no field to plot", elem)
+	}
+
+	read := func(name string) (*parallelColumn, error) {
+		label, ok := labels[name]
+		if !ok {
+			return nil, fmt.Errorf("%s has no exported field %s", elem, name)
+		}
+		f, _ := elem.FieldByName(name)
+		if !parallelKind(f.Type.Kind()) {
+			return nil, fmt.Errorf("field %s of %s has the type %s, it must be a number, a string or a bool", name, elem, f.Type)
+		}
+		column := &parallelColumn{
+			label:   label,
+			numbers: make([]float64, len(items)),
+			values:  make([]interface{}, len(items)),
+		}
+		texts := make([]string, len(items))
+		for i, item := range items {
+			field := item.FieldByIndex(f.Index)
+			column.values[i] = field.Interface()
+			switch field.Kind() {
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+				column.numbers[i] = float64(field.Int())
+			case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+				column.numbers[i] = float64(field.Uint())
+			case reflect.Float32, reflect.Float64:
+				column.numbers[i] = field.Float()
+			default:
+				texts[i] = fmt.Sprint(field.Interface())
+			}
+		}
+		switch f.Type.Kind() {
+		case reflect.String, reflect.Bool:
+			column.categories = []string{}
+			indexes := map[string]int{}
+			for _, text := range texts {
+				if _, ok := indexes[text]; !ok {
+					indexes[text] = 0
+					column.categories = append(column.categories, text)
+				}
+			}
+			sort.Strings(column.categories)
+			for i, category := range column.categories {
+				indexes[category] = i
+			}
+			for i, text := range texts {
+				column.numbers[i] = float64(indexes[text])
+			}
+		}
+		return column, nil
+	}
+
+	columns := make([]*parallelColumn, len(fields))
+	for i, name := range fields {
+		column, err := read(name)
+		if err != nil {
+			return nil, nil, err
+		}
+		columns[i] = column
+	}
+	if color == "" {
+		return columns, nil, nil
+	}
+	colorColumn, err := read(color)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid color field, %w", err)
+	}
+	return columns, colorColumn, nil
+}
+
+// parallelKind tells if fields of the kind can be plotted as dimensions
+func parallelKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64, reflect.String, reflect.Bool:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,111 @@
+package dataset_test
+
+import (
+	"encoding/json"
+	"math"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/MetalBlueberry/go-plotly/colors"
+	"github.com/MetalBlueberry/go-plotly/dataset"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+type cell struct {
+	Capacity   float64 `plot:",name=Capacity (Ah)"`
+	Cycles     int
+	Supplier   string `plot:"color"`
+	Passed     bool
+	Produced   time.Time
+	Internal   string `plot:"-"`
+	unexported int
+}
+
+var _ = Describe("Parallel", func() {
+
+	cells := []*cell{
+		{Capacity: 4.9, Cycles: 800, Supplier: "B", Passed: true},
+		{Capacity: 5.1, Cycles: 1200, Supplier: "A", Passed: true},
+		nil,
+		{Capacity: math.NaN(), Cycles: 500, Supplier: "C", Passed: false},
+	}
+
+	It("Should build an axis per numeric, text and bool field", func() {
+		trace, err := dataset.ParallelCoordinates(cells)
+		Expect(err).To(BeNil())
+		Expect(trace.Type).To(Equal(grob.TraceTypeParcoords))
+		dimensions := trace.Dimensions.([]dataset.Dimension)
+		Expect(dimensions).To(HaveLen(4))
+
+		Expect(dimensions[0].Label).To(Equal("Capacity (Ah)"))
+		Expect(dimensions[0].Range).To(Equal([]float64{4.9, 5.1}))
+		Expect(dimensions[0].Values).To(Equal([]interface{}{4.9, 5.1, nil}))
+		Expect(dimensions[1].Label).To(Equal("Cycles"))
+		Expect(dimensions[1].Range).To(Equal([]float64{500, 1200}))
+
+		supplier := dimensions[2]
+		Expect(supplier.Ticktext).To(Equal([]string{"A", "B", "C"}))
+		Expect(supplier.Tickvals).To(Equal([]float64{0, 1, 2}))
+		Expect(supplier.Values).To(Equal([]interface{}{1.0, 0.0, 2.0}))
+		Expect(dimensions[3].Ticktext).To(Equal([]string{"false", "true"}))
+	})
+
+	It("Should color the lines by category", func() {
+		trace, err := dataset.ParallelCoordinates(cells, dataset.ParallelOptions{Palette: colors.D3})
+		Expect(err).To(BeNil())
+		Expect(trace.Line.Showscale).To(Equal(grob.False))
+		Expect(trace.Line.Colorscale).To(Equal([][]interface{}{
+			{0.0, colors.D3[0]}, {0.25, colors.D3[0]},
+			{0.25, colors.D3[1]}, {0.75, colors.D3[1]},
+			{0.75, colors.D3[2]}, {1.0, colors.D3[2]},
+		}))
+
+		_, err = json.Marshal(trace)
+		Expect(err).To(BeNil())
+	})
+
+	It("Should color the lines with a colorscale by a numeric field", func() {
+		trace, err := dataset.ParallelCoordinates(cells[:2], dataset.ParallelOptions{
+			Fields: []string{"Supplier", "Cycles"},
+			Color:  "Capacity",
+		})
+		Expect(err).To(BeNil())
+		dimensions := trace.Dimensions.([]dataset.Dimension)
+		Expect(dimensions).To(HaveLen(2))
+		Expect(dimensions[0].Label).To(Equal("Supplier"))
+		Expect(trace.Line.Color).To(Equal([]interface{}{4.9, 5.1}))
+		Expect(trace.Line.Colorscale).To(Equal(colors.DefaultColorscale))
+		Expect(trace.Line.Showscale).To(Equal(grob.True))
+
+		data, err := json.Marshal(trace)
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(ContainSubstring(`"dimensions":[{"label":"Supplier","values":[1,0],"range":[0,1],"tickvals":[0,1],"ticktext":["A","B"]}`))
+	})
+
+	It("Should build parallel categories", func() {
+		trace, err := dataset.ParallelCategories(cells[:2], dataset.ParallelOptions{Fields: []string{"Supplier", "Passed"}})
+		Expect(err).To(BeNil())
+		Expect(trace.Type).To(Equal(grob.TraceTypeParcats))
+		dimensions := trace.Dimensions.([]dataset.Dimension)
+		Expect(dimensions[0].Values).To(Equal([]interface{}{"B", "A"}))
+		Expect(dimensions[1].Values).To(Equal([]interface{}{true, true}))
+		Expect(trace.Line.Shape).To(Equal(grob.ParcatsLineShapeHspline))
+		Expect(trace.Line.Color).To(Equal([]float64{1, 0}))
+	})
+
+	It("Should reject invalid fields", func() {
+		_, err := dataset.ParallelCoordinates(cells, dataset.ParallelOptions{Fields: []string{"Produced"}})
+		Expect(err).NotTo(BeNil())
+
+		_, err = dataset.ParallelCoordinates(cells, dataset.ParallelOptions{Fields: []string{"Internal"}})
+		Expect(err).NotTo(BeNil())
+
+		_, err = dataset.ParallelCategories(cells, dataset.ParallelOptions{Color: "Missing"})
+		Expect(err).NotTo(BeNil())
+
+		_, err = dataset.ParallelCategories([]int{1})
+		Expect(err).NotTo(BeNil())
+	})
+})
@@ -0,0 +1,167 @@
+package dataset
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// structField is a field of a struct tagged with plot
+type structField struct {
+	index int
+	// column is the name of the field, used as the column of the table
+	column string
+	role   string
+	// name of the y values in the legend, defaults to the name of the field
+	name string
+}
+
+// Structs builds traces from a slice of structs, or of pointers to structs, with the builder, such as Scatter or Bar.
+// The fields are mapped by their plot tag: x, y, color, size and text, like the columns of a Mapping.
+// Every y field is plotted with the same x, named after the field or the name option of its tag. With a color field,
+// the traces of a y field are named after the categories, prefixed by the name of the field if there are several y fields.
+//
+//	type Reading struct {
+//		Time        time.Time `plot:"x"`
+//		Temperature float64   `plot:"y,name=Temperature (°C)"`
+//		Sensor      string    `plot:"color"`
+//	}
+//
+//	traces, err := dataset.Structs(readings, dataset.Scatter)
+func Structs(slice interface{}, build Builder) (grob.Traces, error) {
+	value := reflect.ValueOf(slice)
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return nil, fmt.Errorf("cannot plot %T, it must be a slice of structs", slice)
+	}
+	elem := value.Type().Elem()
+	if elem.Kind() == reflect.Ptr {
+		elem = elem.Elem()
+	}
+	if elem.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("cannot plot %T, it must be a slice of structs", slice)
+	}
+
+	fields, err := structFields(elem)
+	if err != nil {
+		return nil, err
+	}
+	columns := Columns{}
+	m := Mapping{}
+	ys := []structField{}
+	for _, field := range fields {
+		values := make([]interface{}, value.Len())
+		for i := range values {
+			item := value.Index(i)
+			if item.Kind() == reflect.Ptr {
+				if item.IsNil() {
+					continue
+				}
+				item = item.Elem()
+			}
+			values[i] = item.Field(field.index).Interface()
+		}
+		columns[field.column] = values
+
+		switch field.role {
+		case "x":
+			m.X = field.column
+		case "y":
+			ys = append(ys, field)
+		case "color":
+			m.Color = field.column
+		case "size":
+			m.Size = field.column
+		case "text":
+			m.Text = field.column
+		}
+	}
+	if len(ys) == 0 {
+		return nil, fmt.Errorf("%s has no field tagged plot:\"y\"", elem)
+	}
+
+	traces := grob.Traces{}
+	for _, y := range ys {
+		m.Y = y.column
+		built, err := build(columns, m)
+		if err != nil {
+			return nil, err
+		}
+		for _, trace := range built {
+			name := y.name
+			if category := traceName(trace); m.Color != "" && category != "" {
+				name = category
+				if len(ys) > 1 {
+					name = y.name + " " + category
+				}
+			}
+			err := grob.SetTrace("name", name)(trace)
+			if err != nil {
+				return nil, fmt.Errorf("cannot name the trace of %s, %w", y.column, err)
+			}
+			traces = append(traces, trace)
+		}
+	}
+	return traces, nil
+}
+
+// structFields returns the fields of the struct tagged with plot. A role can only be given once, but for y
+func structFields(t reflect.Type) ([]structField, error) {
+	fields := []structField{}
+	roles := map[string]string{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, ok := f.Tag.Lookup("plot")
+		if !ok || tag == "-" {
+			continue
+		}
+		if f.PkgPath != "" {
+			return nil, fmt.Errorf("field %s of %s is tagged but not exported", f.Name, t)
+		}
+		parts := strings.Split(tag, ",")
+		field := structField{
+			index:  i,
+			column: f.Name,
+			role:   strings.TrimSpace(parts[0]),
+			name:   f.Name,
+		}
+		switch field.role {
+		case "x", "color", "size", "text":
+			if other, ok := roles[field.role]; ok {
+				return nil, fmt.Errorf("fields %s and %s of %s are both tagged %s", other, f.Name, t, field.role)
+			}
+			roles[field.role] = f.Name
+		case "y":
+		default:
+			return nil, fmt.Errorf("field %s of %s is tagged %s, it must be x, y, color, size or text", f.Name, t, field.role)
+		}
+		for _, option := range parts[1:] {
+			key, value := option, ""
+			if j := strings.Index(option, "="); j >= 0 {
+				key, value = option[:j], option[j+1:]
+			}
+			switch strings.TrimSpace(key) {
+			case "name":
+				field.name = value
+			default:
+				return nil, fmt.Errorf("field %s of %s has the unknown plot option %s", f.Name, t, option)
+			}
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// traceName returns the name of a trace built from a table, the category of its rows
+func traceName(trace grob.Trace) string {
+	value := reflect.ValueOf(trace)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return ""
+	}
+	field := value.Elem().FieldByName("Name")
+	if !field.IsValid() || field.Kind() != reflect.Interface || field.IsNil() {
+		return ""
+	}
+	return fmt.Sprint(field.Interface())
+}
@@ -0,0 +1,111 @@
+package dataset_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/MetalBlueberry/go-plotly/dataset"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+type reading struct {
+	Time        time.Time `plot:"x"`
+	Temperature float64   `plot:"y,name=Temperature"`
+	Pressure    float64   `plot:"y"`
+	Sensor      string    `plot:"color"`
+	Note        string
+}
+
+var _ = Describe("Structs", func() {
+
+	day := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	It("Should plot the tagged fields", func() {
+		readings := []reading{
+			{Time: day, Temperature: 20, Pressure: 1013},
+			{Time: day.Add(time.Hour), Temperature: 21, Pressure: 1012},
+		}
+		type point struct {
+			Time        time.Time `plot:"x"`
+			Temperature float64   `plot:"y,name=Temperature"`
+		}
+		traces, err := dataset.Structs([]*point{{day, 20}, {day.Add(time.Hour), 21}}, dataset.Scatter)
+		Expect(err).To(BeNil())
+		Expect(traces).To(HaveLen(1))
+		trace := traces[0].(*grob.Scatter)
+		Expect(trace.Name).To(Equal("Temperature"))
+		Expect(trace.X).To(Equal([]interface{}{day, day.Add(time.Hour)}))
+		Expect(trace.Y).To(Equal([]interface{}{20.0, 21.0}))
+
+		traces, err = dataset.Structs(readings, dataset.Bar)
+		Expect(err).To(BeNil())
+		Expect(traces).To(HaveLen(2))
+		Expect(traces[0].(*grob.Bar).Name).To(Equal("Temperature"))
+		Expect(traces[1].(*grob.Bar).Name).To(Equal("Pressure"))
+		Expect(traces[1].(*grob.Bar).Y).To(Equal([]interface{}{1013.0, 1012.0}))
+	})
+
+	It("Should split the rows by the color field", func() {
+		readings := []reading{
+			{Time: day, Temperature: 20, Pressure: 1013, Sensor: "a"},
+			{Time: day, Temperature: 22, Pressure: 1010, Sensor: "b"},
+			{Time: day.Add(time.Hour), Temperature: 21, Pressure: 1012, Sensor: "a"},
+		}
+		traces, err := dataset.Structs(readings, dataset.Scatter)
+		Expect(err).To(BeNil())
+		Expect(traces).To(HaveLen(4))
+		names := []interface{}{}
+		for _, trace := range traces {
+			names = append(names, trace.(*grob.Scatter).Name)
+		}
+		Expect(names).To(Equal([]interface{}{"Temperature a", "Temperature b", "Pressure a", "Pressure b"}))
+		Expect(traces[0].(*grob.Scatter).Y).To(Equal([]interface{}{20.0, 21.0}))
+	})
+
+	It("Should size the markers by the size field", func() {
+		type city struct {
+			Area       float64 `plot:"x"`
+			Density    float64 `plot:"y"`
+			Population float64 `plot:"size"`
+		}
+		traces, err := dataset.Structs([]city{{10, 100, 1000}, {20, 50, 1000}, {5, 400, 2000}}, dataset.Scatter)
+		Expect(err).To(BeNil())
+		Expect(traces).To(HaveLen(1))
+		trace := traces[0].(*dataset.SizedScatter)
+		Expect(trace.Name).To(Equal("Density"))
+		Expect(trace.Sizes).To(Equal([]interface{}{1000.0, 1000.0, 2000.0}))
+	})
+
+	It("Should fail with invalid tags", func() {
+		_, err := dataset.Structs([]int{1}, dataset.Scatter)
+		Expect(err).NotTo(BeNil())
+
+		type noY struct {
+			X float64 `plot:"x"`
+		}
+		_, err = dataset.Structs([]noY{}, dataset.Scatter)
+		Expect(err).NotTo(BeNil())
+
+		type unknown struct {
+			Y float64 `plot:"z"`
+		}
+		_, err = dataset.Structs([]unknown{}, dataset.Scatter)
+		Expect(err).NotTo(BeNil())
+
+		type twice struct {
+			A float64 `plot:"x"`
+			B float64 `plot:"x"`
+			Y float64 `plot:"y"`
+		}
+		_, err = dataset.Structs([]twice{}, dataset.Scatter)
+		Expect(err).NotTo(BeNil())
+
+		type option struct {
+			Y float64 `plot:"y,label=Y"`
+		}
+		_, err = dataset.Structs([]option{}, dataset.Scatter)
+		Expect(err).NotTo(BeNil())
+	})
+})
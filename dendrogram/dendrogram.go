@@ -0,0 +1,284 @@
+// Package dendrogram plots the result of a hierarchical clustering as a dendrogram, alone or along a clustered heatmap.
+//
+// The clustering is a tree of Cluster, built by hand or converted from the linkage matrix of scipy with FromLinkage.
+// Every merge is drawn as a line joining its children at the distance of the merge, and the leaves are placed
+// every LeafSpacing units in the order of the tree, like the dendrograms of plotly.py.
+//
+//	root, err := dendrogram.FromLinkage(linkage, []string{"a", "b", "c", "d"})
+//	d, err := dendrogram.New(root, dendrogram.Options{ColorThreshold: 1.5})
+//	offline.Show(d.Figure())
+package dendrogram
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/MetalBlueberry/go-plotly/colors"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+const (
+	// LeafSpacing is the distance between two leaves, the first leaf is at LeafSpacing / 2
+	LeafSpacing = 10.0
+	// DefaultColor is the color of the links above the color threshold
+	DefaultColor = "#444"
+)
+
+// Orientation is the direction of the dendrogram
+type Orientation string
+
+const (
+	// Vertical places the leaves along the x axis with the root above them
+	Vertical Orientation = "vertical"
+	// Horizontal places the leaves along the y axis with the root on their left, to face the rows of a heatmap on the right
+	Horizontal Orientation = "horizontal"
+)
+
+// Cluster is a node of the tree of a hierarchical clustering
+type Cluster struct {
+	// Label of a leaf, displayed as its tick label
+	Label string
+	// Index of a leaf in the clustered data, such as its row in the matrix of a heatmap
+	Index int
+	// Distance between the children when they were merged, usually 0 for the leaves
+	Distance float64
+	// Children are the merged clusters, a leaf has no children
+	Children []*Cluster
+}
+
+// Options configure the dendrogram
+type Options struct {
+	// Orientation defaults to Vertical
+	Orientation Orientation
+	// Color of the links, or of the links above the color threshold. Defaults to DefaultColor
+	Color string
+	// ColorThreshold colors every cluster merged below this distance with its own color of the palette, like the dendrograms of scipy.
+	// If 0, all the links have the same color
+	ColorThreshold float64
+	// Palette colors the clusters below the color threshold, defaults to colors.Plotly
+	Palette colors.Palette
+	// Colorscale of the heatmap of Heatmap, defaults to plotly.js default
+	Colorscale string
+	// Title of the figure
+	Title string
+}
+
+func computeOptions(def Options, opt ...Options) Options {
+	if len(opt) == 1 {
+		opts := opt[0]
+		if opts.Orientation != "" {
+			def.Orientation = opts.Orientation
+		}
+		if opts.Color != "" {
+			def.Color = opts.Color
+		}
+		if opts.ColorThreshold != 0 {
+			def.ColorThreshold = opts.ColorThreshold
+		}
+		if opts.Palette != nil {
+			def.Palette = opts.Palette
+		}
+		if opts.Colorscale != "" {
+			def.Colorscale = opts.Colorscale
+		}
+		if opts.Title != "" {
+			def.Title = opts.Title
+		}
+	}
+	return def
+}
+
+// FromLinkage converts a linkage matrix, as returned by scipy.cluster.hierarchy.linkage, into a tree of clusters.
+// The n leaves are numbered 0 to n-1 and the ith row merges the clusters of its first two columns, at the distance
+// of its third column, into the cluster n+i. The number of leaves in the fourth column is not used.
+// Labels are the labels of the leaves, the leaves are labeled with their index if nil.
+func FromLinkage(linkage [][4]float64, labels []string) (*Cluster, error) {
+	n := len(linkage) + 1
+	if labels != nil && len(labels) != n {
+		return nil, fmt.Errorf("%d labels given for a linkage of %d leaves", len(labels), n)
+	}
+	clusters := make([]*Cluster, n, n+len(linkage))
+	for i := range clusters {
+		label := strconv.Itoa(i)
+		if labels != nil {
+			label = labels[i]
+		}
+		clusters[i] = &Cluster{Label: label, Index: i}
+	}
+
+	merged := make([]bool, n+len(linkage))
+	for i, row := range linkage {
+		if math.IsNaN(row[2]) || row[2] < 0 {
+			return nil, fmt.Errorf("row %d of the linkage has an invalid distance %g", i, row[2])
+		}
+		merge := &Cluster{Distance: row[2]}
+		for _, child := range row[:2] {
+			c := int(child)
+			if float64(c) != child || c < 0 || c >= len(clusters) {
+				return nil, fmt.Errorf("row %d of the linkage merges an unknown cluster %g", i, child)
+			}
+			if merged[c] {
+				return nil, fmt.Errorf("row %d of the linkage merges the cluster %d a second time", i, c)
+			}
+			merged[c] = true
+			merge.Children = append(merge.Children, clusters[c])
+		}
+		clusters = append(clusters, merge)
+	}
+	return clusters[len(clusters)-1], nil
+}
+
+// Dendrogram are the traces and the leaves of a dendrogram
+type Dendrogram struct {
+	// Traces are the links, a scatter trace per color
+	Traces []*grob.Scatter
+	// Labels of the leaves in plot order
+	Labels []string
+	// Order is the Index of the leaves in plot order, to reorder the clustered data
+	Order []int
+	// Positions of the leaves along the leaf axis, in plot order
+	Positions []float64
+
+	orientation Orientation
+}
+
+// New computes the links of the dendrogram of the tree.
+// The children of a cluster are joined by a line at the distance of the cluster, in the order of the children.
+func New(root *Cluster, opt ...Options) (*Dendrogram, error) {
+	opts := computeOptions(Options{
+		Orientation: Vertical,
+		Color:       DefaultColor,
+		Palette:     colors.Plotly,
+	}, opt...)
+	if root == nil {
+		return nil, errors.New("the dendrogram has no cluster")
+	}
+	if opts.Orientation != Vertical && opts.Orientation != Horizontal {
+		return nil, fmt.Errorf("unknown orientation %s", opts.Orientation)
+	}
+
+	d := &Dendrogram{
+		Labels:      []string{},
+		Order:       []int{},
+		Positions:   []float64{},
+		orientation: opts.Orientation,
+	}
+	traces := map[string]*grob.Scatter{}
+	clusters := 0
+	// draw returns the position and the distance of the top of the cluster, where its parent joins it
+	var draw func(c *Cluster, color string) (float64, float64)
+	draw = func(c *Cluster, color string) (float64, float64) {
+		if len(c.Children) == 0 {
+			position := LeafSpacing*float64(len(d.Positions)) + LeafSpacing/2
+			d.Labels = append(d.Labels, c.Label)
+			d.Order = append(d.Order, c.Index)
+			d.Positions = append(d.Positions, position)
+			return position, c.Distance
+		}
+		if color == "" && c.Distance < opts.ColorThreshold {
+			color = opts.Palette.Color(clusters)
+			clusters++
+		}
+		linkColor := color
+		if linkColor == "" {
+			linkColor = opts.Color
+		}
+
+		positions := make([]float64, len(c.Children))
+		distances := make([]float64, len(c.Children))
+		for i, child := range c.Children {
+			positions[i], distances[i] = draw(child, color)
+		}
+		trace, ok := traces[linkColor]
+		if !ok {
+			trace = &grob.Scatter{
+				Type:       grob.TraceTypeScatter,
+				Mode:       grob.ScatterModeLines,
+				Line:       &grob.ScatterLine{Color: linkColor},
+				Showlegend: grob.False,
+			}
+			traces[linkColor] = trace
+			d.Traces = append(d.Traces, trace)
+		}
+		// the U shape going up from the first child and down to the next ones, the nil ends the line
+		leaves := []interface{}{positions[0], positions[0]}
+		heights := []interface{}{distances[0], c.Distance}
+		for i := 1; i < len(c.Children); i++ {
+			leaves = append(leaves, positions[i], positions[i])
+			heights = append(heights, c.Distance, distances[i])
+			if i < len(c.Children)-1 {
+				leaves = append(leaves, positions[i])
+				heights = append(heights, c.Distance)
+			}
+		}
+		d.link(trace, append(leaves, nil), append(heights, nil))
+
+		first, last := positions[0], positions[len(positions)-1]
+		return (first + last) / 2, c.Distance
+	}
+	draw(root, "")
+	return d, nil
+}
+
+// link appends the points of a link to the trace, the distance is hovered
+func (d *Dendrogram) link(trace *grob.Scatter, leaves, heights []interface{}) {
+	if d.orientation == Horizontal {
+		x, _ := trace.X.([]interface{})
+		y, _ := trace.Y.([]interface{})
+		trace.X, trace.Y = append(x, heights...), append(y, leaves...)
+		trace.Hoverinfo = grob.ScatterHoverinfoX
+		return
+	}
+	x, _ := trace.X.([]interface{})
+	y, _ := trace.Y.([]interface{})
+	trace.X, trace.Y = append(x, leaves...), append(y, heights...)
+	trace.Hoverinfo = grob.ScatterHoverinfoY
+}
+
+// Figure returns a figure with the dendrogram alone, the leaves are labeled on the leaf axis
+func (d *Dendrogram) Figure(opt ...Options) *grob.Fig {
+	opts := computeOptions(Options{}, opt...)
+	fig := &grob.Fig{
+		Layout: &grob.Layout{
+			Xaxis:      &grob.LayoutXaxis{},
+			Yaxis:      &grob.LayoutYaxis{},
+			Showlegend: grob.False,
+			Hovermode:  grob.LayoutHovermodeClosest,
+		},
+	}
+	for _, trace := range d.Traces {
+		fig.AddTraces(trace)
+	}
+	d.axes(fig.Layout.Xaxis, fig.Layout.Yaxis)
+	if d.orientation == Horizontal {
+		fig.Layout.Yaxis.Ticktext = d.Labels
+		fig.Layout.Yaxis.Side = grob.LayoutYaxisSideRight
+	} else {
+		fig.Layout.Xaxis.Ticktext = d.Labels
+	}
+	if opts.Title != "" {
+		fig.Layout.Title = &grob.LayoutTitle{
+			Text: opts.Title,
+		}
+	}
+	return fig
+}
+
+// axes places the ticks of the leaves on the leaf axis and hides the grid.
+// The root of a horizontal dendrogram is on the left, so the distance axis is reversed.
+func (d *Dendrogram) axes(xaxis *grob.LayoutXaxis, yaxis *grob.LayoutYaxis) {
+	xaxis.Showgrid = grob.False
+	xaxis.Zeroline = grob.False
+	yaxis.Showgrid = grob.False
+	yaxis.Zeroline = grob.False
+	if d.orientation == Horizontal {
+		xaxis.Autorange = grob.LayoutXaxisAutorangeReversed
+		yaxis.Tickmode = grob.LayoutYaxisTickmodeArray
+		yaxis.Tickvals = d.Positions
+		return
+	}
+	xaxis.Tickmode = grob.LayoutXaxisTickmodeArray
+	xaxis.Tickvals = d.Positions
+}
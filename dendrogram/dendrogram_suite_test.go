@@ -0,0 +1,13 @@
+package dendrogram_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestDendrogram(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Dendrogram Suite")
+}
@@ -0,0 +1,167 @@
+package dendrogram_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/MetalBlueberry/go-plotly/dendrogram"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var _ = Describe("Dendrogram", func() {
+
+	linkage := [][4]float64{
+		{0, 1, 1, 2},
+		{2, 3, 2, 2},
+		{4, 5, 3, 4},
+	}
+
+	It("Should convert a linkage matrix into a tree", func() {
+		root, err := dendrogram.FromLinkage([][4]float64{{1, 2, 0.5, 2}, {0, 3, 2, 3}}, []string{"a", "b", "c"})
+		Expect(err).To(BeNil())
+		Expect(root).To(Equal(&dendrogram.Cluster{
+			Distance: 2,
+			Children: []*dendrogram.Cluster{
+				{Label: "a", Index: 0},
+				{Distance: 0.5, Children: []*dendrogram.Cluster{
+					{Label: "b", Index: 1},
+					{Label: "c", Index: 2},
+				}},
+			},
+		}))
+	})
+
+	It("Should reject invalid linkage matrices", func() {
+		_, err := dendrogram.FromLinkage(linkage, []string{"a", "b"})
+		Expect(err).To(HaveOccurred())
+		_, err = dendrogram.FromLinkage([][4]float64{{0, 2, 1, 2}}, nil)
+		Expect(err).To(MatchError(ContainSubstring("unknown cluster 2")))
+		_, err = dendrogram.FromLinkage([][4]float64{{0, 1, 1, 2}, {0, 2, 1, 2}}, nil)
+		Expect(err).To(MatchError(ContainSubstring("second time")))
+		_, err = dendrogram.FromLinkage([][4]float64{{0, 1.5, 1, 2}}, nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Should draw a link per merge with the leaves in tree order", func() {
+		root, err := dendrogram.FromLinkage(linkage, []string{"a", "b", "c", "d"})
+		Expect(err).To(BeNil())
+		d, err := dendrogram.New(root)
+		Expect(err).To(BeNil())
+
+		Expect(d.Labels).To(Equal([]string{"a", "b", "c", "d"}))
+		Expect(d.Order).To(Equal([]int{0, 1, 2, 3}))
+		Expect(d.Positions).To(Equal([]float64{5, 15, 25, 35}))
+		Expect(d.Traces).To(HaveLen(1))
+		Expect(d.Traces[0].Line.Color).To(Equal(dendrogram.DefaultColor))
+		Expect(d.Traces[0].X).To(Equal([]interface{}{
+			5.0, 5.0, 15.0, 15.0, nil,
+			25.0, 25.0, 35.0, 35.0, nil,
+			10.0, 10.0, 30.0, 30.0, nil,
+		}))
+		Expect(d.Traces[0].Y).To(Equal([]interface{}{
+			0.0, 1.0, 1.0, 0.0, nil,
+			0.0, 2.0, 2.0, 0.0, nil,
+			1.0, 3.0, 3.0, 2.0, nil,
+		}))
+	})
+
+	It("Should color the clusters below the threshold", func() {
+		root, err := dendrogram.FromLinkage(linkage, nil)
+		Expect(err).To(BeNil())
+		d, err := dendrogram.New(root, dendrogram.Options{ColorThreshold: 2.5, Palette: []string{"red", "blue"}})
+		Expect(err).To(BeNil())
+
+		Expect(d.Traces).To(HaveLen(3))
+		Expect(d.Traces[0].Line.Color).To(Equal("red"))
+		Expect(d.Traces[1].Line.Color).To(Equal("blue"))
+		Expect(d.Traces[2].Line.Color).To(Equal(dendrogram.DefaultColor))
+		Expect(d.Traces[2].X).To(Equal([]interface{}{10.0, 10.0, 30.0, 30.0, nil}))
+	})
+
+	It("Should swap the axes of horizontal dendrograms", func() {
+		root, err := dendrogram.FromLinkage([][4]float64{{0, 1, 1, 2}}, []string{"a", "b"})
+		Expect(err).To(BeNil())
+		d, err := dendrogram.New(root, dendrogram.Options{Orientation: dendrogram.Horizontal})
+		Expect(err).To(BeNil())
+		Expect(d.Traces[0].X).To(Equal([]interface{}{0.0, 1.0, 1.0, 0.0, nil}))
+		Expect(d.Traces[0].Y).To(Equal([]interface{}{5.0, 5.0, 15.0, 15.0, nil}))
+
+		fig := d.Figure()
+		Expect(fig.Data).To(HaveLen(1))
+		Expect(fig.Layout.Xaxis.Autorange).To(Equal(grob.LayoutXaxisAutorangeReversed))
+		Expect(fig.Layout.Yaxis.Tickvals).To(Equal([]float64{5, 15}))
+		Expect(fig.Layout.Yaxis.Ticktext).To(Equal([]string{"a", "b"}))
+	})
+
+	It("Should join every child of n-ary clusters", func() {
+		d, err := dendrogram.New(&dendrogram.Cluster{
+			Distance: 1,
+			Children: []*dendrogram.Cluster{{Label: "a"}, {Label: "b"}, {Label: "c"}},
+		})
+		Expect(err).To(BeNil())
+		Expect(d.Traces[0].X).To(Equal([]interface{}{5.0, 5.0, 15.0, 15.0, 15.0, 25.0, 25.0, nil}))
+		Expect(d.Traces[0].Y).To(Equal([]interface{}{0.0, 1.0, 1.0, 0.0, 1.0, 1.0, 0.0, nil}))
+	})
+
+	It("Should reject unknown orientations", func() {
+		_, err := dendrogram.New(&dendrogram.Cluster{}, dendrogram.Options{Orientation: "diagonal"})
+		Expect(err).To(HaveOccurred())
+		_, err = dendrogram.New(nil)
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("Heatmap", func() {
+
+	z := [][]float64{
+		{1, 2, 3},
+		{4, 5, 6},
+	}
+
+	It("Should reorder the rows of the matrix like their clustering", func() {
+		rows, err := dendrogram.FromLinkage([][4]float64{{1, 0, 1, 2}}, []string{"r0", "r1"})
+		Expect(err).To(BeNil())
+		fig, err := dendrogram.Heatmap(z, rows, nil, dendrogram.Options{Colorscale: "Viridis"})
+		Expect(err).To(BeNil())
+
+		Expect(fig.Data).To(HaveLen(2))
+		heatmap := fig.Data[0].(*grob.Heatmap)
+		Expect(heatmap.Z).To(Equal([][]float64{{4, 5, 6}, {1, 2, 3}}))
+		Expect(heatmap.Y).To(Equal([]float64{5, 15}))
+		Expect(heatmap.Xaxis).To(Equal(grob.String("x2")))
+		Expect(heatmap.Colorscale).To(Equal(grob.ColorScale("Viridis")))
+		Expect(fig.Layout.YAxis2.Ticktext).To(Equal([]string{"r1", "r0"}))
+		Expect(fig.Layout.XAxis2.Ticktext).To(Equal([]string{"0", "1", "2"}))
+
+		links := fig.Data[1].(*grob.Scatter)
+		Expect(links.Xaxis).To(Equal(grob.String("x")))
+		Expect(fig.Layout.Yaxis.Matches).To(Equal(grob.LayoutYaxisMatches("y2")))
+		Expect(fig.Layout.Xaxis.Autorange).To(Equal(grob.LayoutXaxisAutorangeReversed))
+	})
+
+	It("Should place the dendrograms around the heatmap", func() {
+		rows, err := dendrogram.FromLinkage([][4]float64{{0, 1, 1, 2}}, nil)
+		Expect(err).To(BeNil())
+		cols, err := dendrogram.FromLinkage([][4]float64{{0, 2, 1, 2}, {1, 3, 2, 3}}, nil)
+		Expect(err).To(BeNil())
+		fig, err := dendrogram.Heatmap(z, rows, cols)
+		Expect(err).To(BeNil())
+
+		Expect(fig.Data).To(HaveLen(3))
+		heatmap := fig.Data[0].(*grob.Heatmap)
+		Expect(heatmap.Xaxis).To(Equal(grob.String("x4")))
+		Expect(heatmap.Z).To(Equal([][]float64{{2, 1, 3}, {5, 4, 6}}))
+		Expect(fig.Layout.XAxis2.Matches).To(Equal(grob.LayoutXaxisMatches("x4")))
+		Expect(fig.Layout.YAxis3.Matches).To(Equal(grob.LayoutYaxisMatches("y4")))
+		Expect(fig.Layout.Xaxis.Visible).To(Equal(grob.False))
+	})
+
+	It("Should reject clusterings that do not match the matrix", func() {
+		cols, err := dendrogram.FromLinkage([][4]float64{{0, 1, 1, 2}}, nil)
+		Expect(err).To(BeNil())
+		_, err = dendrogram.Heatmap(z, nil, cols)
+		Expect(err).To(MatchError(ContainSubstring("has 2 leaves")))
+		_, err = dendrogram.Heatmap([][]float64{{1}, {1, 2}}, nil, nil)
+		Expect(err).To(HaveOccurred())
+	})
+})
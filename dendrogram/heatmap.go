@@ -0,0 +1,162 @@
+package dendrogram
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/subplots"
+)
+
+// Heatmap plots the matrix as a heatmap with its rows and columns in the order of their clusterings,
+// the dendrogram of the columns above the heatmap and the dendrogram of the rows on its left.
+// The leaves of the clusterings are the rows and the columns of the matrix by Index, rows or cols can be nil
+// to keep the matrix order without dendrogram. The Orientation option is ignored.
+//
+//	fig, err := dendrogram.Heatmap(expression, genes, samples, dendrogram.Options{Colorscale: "RdBu"})
+func Heatmap(z [][]float64, rows, cols *Cluster, opt ...Options) (*grob.Fig, error) {
+	opts := computeOptions(Options{}, opt...)
+	if len(z) == 0 || len(z[0]) == 0 {
+		return nil, errors.New("the matrix is empty")
+	}
+	for i, row := range z {
+		if len(row) != len(z[0]) {
+			return nil, fmt.Errorf("row %d has %d values, the first row has %d", i, len(row), len(z[0]))
+		}
+	}
+
+	rowOpts, colOpts := opts, opts
+	rowOpts.Orientation, colOpts.Orientation = Horizontal, Vertical
+	rowD, err := clustering(rows, len(z), "rows", rowOpts)
+	if err != nil {
+		return nil, err
+	}
+	colD, err := clustering(cols, len(z[0]), "columns", colOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	// the heatmap is in the bottom right cell, the dendrograms take the top row and the left column
+	gridRows, gridCols := 1, 1
+	var heights, widths []float64
+	if cols != nil {
+		gridRows, heights = 2, []float64{1, 4}
+	}
+	if rows != nil {
+		gridCols, widths = 2, []float64{1, 4}
+	}
+	sp := subplots.New(gridRows, gridCols, subplots.Options{
+		HorizontalSpacing: 0.01,
+		VerticalSpacing:   0.01,
+		RowHeights:        heights,
+		ColumnWidths:      widths,
+	})
+
+	values := make([][]float64, len(z))
+	text := make([][]string, len(z))
+	for i, row := range rowD.Order {
+		values[i] = make([]float64, len(z[0]))
+		text[i] = make([]string, len(z[0]))
+		for j, col := range colD.Order {
+			values[i][j] = z[row][col]
+			text[i][j] = rowD.Labels[i] + ", " + colD.Labels[j]
+		}
+	}
+	heatmap := &grob.Heatmap{
+		Type:          grob.TraceTypeHeatmap,
+		X:             colD.Positions,
+		Y:             rowD.Positions,
+		Z:             values,
+		Text:          text,
+		Hovertemplate: "%{text}<br>%{z}<extra></extra>",
+	}
+	if opts.Colorscale != "" {
+		heatmap.Colorscale = opts.Colorscale
+	}
+	err = sp.Add(heatmap, gridRows, gridCols)
+	if err != nil {
+		return nil, err
+	}
+	xaxis, yaxis := sp.XAxis(gridRows, gridCols), sp.YAxis(gridRows, gridCols)
+	xaxis.Tickmode = grob.LayoutXaxisTickmodeArray
+	xaxis.Tickvals = colD.Positions
+	xaxis.Ticktext = colD.Labels
+	yaxis.Tickmode = grob.LayoutYaxisTickmodeArray
+	yaxis.Tickvals = rowD.Positions
+	yaxis.Ticktext = rowD.Labels
+	yaxis.Side = grob.LayoutYaxisSideRight
+	// the leaf axes of the dendrograms follow the zoom of the heatmap
+	heatmapX := grob.LayoutXaxisMatches(fmt.Sprintf("x%d", gridRows*gridCols))
+	heatmapY := grob.LayoutYaxisMatches(fmt.Sprintf("y%d", gridRows*gridCols))
+
+	if cols != nil {
+		for _, trace := range colD.Traces {
+			err = sp.Add(trace, 1, gridCols)
+			if err != nil {
+				return nil, err
+			}
+		}
+		xaxis, yaxis := sp.XAxis(1, gridCols), sp.YAxis(1, gridCols)
+		colD.axes(xaxis, yaxis)
+		xaxis.Matches = heatmapX
+		xaxis.Showticklabels = grob.False
+		yaxis.Showticklabels = grob.False
+	}
+	if rows != nil {
+		for _, trace := range rowD.Traces {
+			err = sp.Add(trace, gridRows, 1)
+			if err != nil {
+				return nil, err
+			}
+		}
+		xaxis, yaxis := sp.XAxis(gridRows, 1), sp.YAxis(gridRows, 1)
+		rowD.axes(xaxis, yaxis)
+		yaxis.Matches = heatmapY
+		xaxis.Showticklabels = grob.False
+		yaxis.Showticklabels = grob.False
+	}
+	if rows != nil && cols != nil {
+		sp.XAxis(1, 1).Visible = grob.False
+		sp.YAxis(1, 1).Visible = grob.False
+	}
+
+	fig := sp.Figure()
+	fig.Layout.Showlegend = grob.False
+	fig.Layout.Hovermode = grob.LayoutHovermodeClosest
+	if opts.Title != "" {
+		fig.Layout.Title = &grob.LayoutTitle{
+			Text: opts.Title,
+		}
+	}
+	return fig, nil
+}
+
+// clustering returns the dendrogram of the clusters of the n rows or columns of the matrix,
+// or their positions in the matrix order if there are no clusters
+func clustering(root *Cluster, n int, name string, opts Options) (*Dendrogram, error) {
+	if root == nil {
+		d := &Dendrogram{orientation: opts.Orientation}
+		for i := 0; i < n; i++ {
+			d.Labels = append(d.Labels, strconv.Itoa(i))
+			d.Order = append(d.Order, i)
+			d.Positions = append(d.Positions, LeafSpacing*float64(i)+LeafSpacing/2)
+		}
+		return d, nil
+	}
+	d, err := New(root, opts)
+	if err != nil {
+		return nil, fmt.Errorf("cannot plot the dendrogram of the %s, %w", name, err)
+	}
+	if len(d.Order) != n {
+		return nil, fmt.Errorf("the clustering of the %s has %d leaves, the matrix has %d %s", name, len(d.Order), n, name)
+	}
+	seen := make([]bool, n)
+	for _, i := range d.Order {
+		if i < 0 || i >= n || seen[i] {
+			return nil, fmt.Errorf("the clustering of the %s has a leaf with an invalid or duplicated index %d", name, i)
+		}
+		seen[i] = true
+	}
+	return d, nil
+}
@@ -0,0 +1,296 @@
+// Package downsample reduces the number of points of large series, such as high frequency sensor data,
+// so interactive figures stay responsive while keeping the shape of the series.
+//
+//	trace := &grob.Scatter{X: timestamps, Y: values}
+//	err := downsample.Trace(trace, 2000)
+package downsample
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"time"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// Method is the algorithm that selects the points
+type Method string
+
+const (
+	// LTTB is Largest-Triangle-Three-Buckets, it keeps the points that preserve the visual shape of the series
+	LTTB Method = "lttb"
+	// MinMax keeps the minimum and the maximum of every bucket, so peaks are never lost
+	MinMax Method = "minmax"
+)
+
+// Options configure the downsampling
+type Options struct {
+	// Method selects the points, defaults to LTTB
+	Method Method
+}
+
+func computeOptions(def Options, opt ...Options) Options {
+	if len(opt) == 1 {
+		opts := opt[0]
+		if opts.Method != "" {
+			def.Method = opts.Method
+		}
+	}
+	return def
+}
+
+// LargestTriangleThreeBuckets returns the indexes of the points kept by the LTTB algorithm, in order.
+// The points must be sorted by x. The first and last points are always kept and the series is split in buckets
+// of equal size, keeping from every bucket the point that forms the largest triangle with the point kept from the previous bucket
+// and the average of the next one. NaN values are only kept if a bucket has nothing else.
+// All the indexes are returned if there are not more points than the threshold.
+func LargestTriangleThreeBuckets(x, y []float64, threshold int) ([]int, error) {
+	if len(x) != len(y) {
+		return nil, fmt.Errorf("x has %d values but y has %d", len(x), len(y))
+	}
+	n := len(y)
+	if threshold >= n {
+		return allIndexes(n), nil
+	}
+	if threshold < 3 {
+		return nil, fmt.Errorf("threshold %d must be at least 3", threshold)
+	}
+
+	every := float64(n-2) / float64(threshold-2)
+	indexes := make([]int, 0, threshold)
+	indexes = append(indexes, 0)
+	a := 0
+	for i := 0; i < threshold-2; i++ {
+		// average of the next bucket, the last point for the last bucket
+		avgStart := int(math.Floor(float64(i+1)*every)) + 1
+		avgEnd := int(math.Floor(float64(i+2)*every)) + 1
+		if avgEnd > n {
+			avgEnd = n
+		}
+		avgX, avgY, count := 0.0, 0.0, 0
+		for j := avgStart; j < avgEnd; j++ {
+			if !math.IsNaN(y[j]) {
+				avgX += x[j]
+				avgY += y[j]
+				count++
+			}
+		}
+		if count > 0 {
+			avgX /= float64(count)
+			avgY /= float64(count)
+		} else {
+			avgX, avgY = x[n-1], y[n-1]
+		}
+
+		start := int(math.Floor(float64(i)*every)) + 1
+		end := int(math.Floor(float64(i+1)*every)) + 1
+		selected, maxArea := start, -1.0
+		for j := start; j < end; j++ {
+			area := math.Abs((x[a]-avgX)*(y[j]-y[a])-(x[a]-x[j])*(avgY-y[a])) / 2
+			if area > maxArea {
+				selected, maxArea = j, area
+			}
+		}
+		indexes = append(indexes, selected)
+		a = selected
+	}
+	return append(indexes, n-1), nil
+}
+
+// MinMaxDecimation returns the indexes of the minimum and the maximum of every bucket, in order.
+// The series is split in threshold/2 buckets of equal size, so at most threshold points are kept. NaN values are ignored.
+// All the indexes are returned if there are not more points than the threshold.
+func MinMaxDecimation(y []float64, threshold int) ([]int, error) {
+	n := len(y)
+	if threshold >= n {
+		return allIndexes(n), nil
+	}
+	if threshold < 2 {
+		return nil, fmt.Errorf("threshold %d must be at least 2", threshold)
+	}
+
+	buckets := threshold / 2
+	indexes := make([]int, 0, threshold)
+	for b := 0; b < buckets; b++ {
+		start, end := b*n/buckets, (b+1)*n/buckets
+		min, max := -1, -1
+		for j := start; j < end; j++ {
+			if math.IsNaN(y[j]) {
+				continue
+			}
+			if min < 0 || y[j] < y[min] {
+				min = j
+			}
+			if max < 0 || y[j] > y[max] {
+				max = j
+			}
+		}
+		switch {
+		case min < 0:
+		case min == max:
+			indexes = append(indexes, min)
+		case min < max:
+			indexes = append(indexes, min, max)
+		default:
+			indexes = append(indexes, max, min)
+		}
+	}
+	return indexes, nil
+}
+
+// Trace reduces the points of the trace to at most targetPoints. The values are taken from y, or from x if the
+// orientation of the trace is horizontal, and the positions from the other axis, that must be sorted.
+// Positions can be numbers or times, other values like categories are considered equally spaced.
+// Every array of the trace with a value per point, like text, customdata or marker.color, keeps the values of the selected points.
+// Traces with fewer points are not modified.
+//
+//	err := downsample.Trace(trace, 2000, downsample.Options{Method: downsample.MinMax})
+func Trace(trace grob.Trace, targetPoints int, opt ...Options) error {
+	opts := computeOptions(Options{
+		Method: LTTB,
+	}, opt...)
+
+	value := reflect.ValueOf(trace)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("cannot downsample %T, the trace must be a pointer to a struct", trace)
+	}
+	object := value.Elem()
+	xField, yField := object.FieldByName("X"), object.FieldByName("Y")
+	if !xField.IsValid() || !yField.IsValid() {
+		return fmt.Errorf("cannot downsample %s traces, they have no x and y", trace.GetType())
+	}
+	positionAxis, positionField, valueField := "X", xField, yField
+	if orientation := object.FieldByName("Orientation"); orientation.IsValid() && fmt.Sprint(orientation.Interface()) == "h" {
+		positionAxis, positionField, valueField = "Y", yField, xField
+	}
+
+	values, ok := floats(valueField.Interface())
+	if !ok {
+		return fmt.Errorf("cannot downsample %s, the values must be numbers", trace.GetType())
+	}
+	n := len(values)
+	if n <= targetPoints {
+		return nil
+	}
+
+	positions, ok := floats(positionField.Interface())
+	if positionField.IsNil() {
+		// plotly.js places the points from x0 every dx, the positions are set so they do not move
+		var err error
+		positions, err = implicitPositions(object, positionAxis, n)
+		if err != nil {
+			return err
+		}
+		positionField.Set(reflect.ValueOf(positions))
+	} else if !ok || len(positions) != n {
+		positions, _ = implicitPositions(object, "", n)
+	}
+
+	var indexes []int
+	var err error
+	switch opts.Method {
+	case LTTB:
+		indexes, err = LargestTriangleThreeBuckets(positions, values, targetPoints)
+	case MinMax:
+		indexes, err = MinMaxDecimation(values, targetPoints)
+	default:
+		err = fmt.Errorf("unknown method %s", opts.Method)
+	}
+	if err != nil {
+		return fmt.Errorf("cannot downsample, %w", err)
+	}
+	selectPoints(object, n, indexes)
+	return nil
+}
+
+// selectPoints replaces the arrays of the object with n values by the values at the indexes, including those of nested objects
+func selectPoints(object reflect.Value, n int, indexes []int) {
+	for i := 0; i < object.NumField(); i++ {
+		field := object.Field(i)
+		if !field.CanSet() || object.Type().Field(i).Name == "Colorbar" {
+			continue
+		}
+		switch field.Kind() {
+		case reflect.Ptr:
+			if !field.IsNil() && field.Elem().Kind() == reflect.Struct {
+				selectPoints(field.Elem(), n, indexes)
+			}
+		case reflect.Interface, reflect.Slice:
+			array := field
+			if array.Kind() == reflect.Interface {
+				array = array.Elem()
+			}
+			if array.Kind() != reflect.Slice || array.Len() != n {
+				continue
+			}
+			selected := reflect.MakeSlice(array.Type(), len(indexes), len(indexes))
+			for j, index := range indexes {
+				selected.Index(j).Set(array.Index(index))
+			}
+			field.Set(selected)
+		}
+	}
+}
+
+// floats converts a slice of numbers or times to float64, it returns false for other values
+func floats(values interface{}) ([]float64, bool) {
+	value := reflect.ValueOf(values)
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return nil, false
+	}
+	result := make([]float64, value.Len())
+	for i := range result {
+		item := value.Index(i)
+		for item.Kind() == reflect.Interface && !item.IsNil() {
+			item = item.Elem()
+		}
+		switch item.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			result[i] = float64(item.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			result[i] = float64(item.Uint())
+		case reflect.Float32, reflect.Float64:
+			result[i] = item.Float()
+		case reflect.Interface:
+			// nil values are gaps
+			result[i] = math.NaN()
+		default:
+			t, ok := item.Interface().(time.Time)
+			if !ok {
+				return nil, false
+			}
+			result[i] = float64(t.UnixNano())
+		}
+	}
+	return result, true
+}
+
+func allIndexes(n int) []int {
+	indexes := make([]int, n)
+	for i := range indexes {
+		indexes[i] = i
+	}
+	return indexes
+}
+
+// implicitPositions returns the positions of the points of a trace without coordinates on the axis, using its x0 and dx or y0 and dy
+func implicitPositions(object reflect.Value, axis string, n int) ([]float64, error) {
+	start, step := 0.0, 1.0
+	if origin := object.FieldByName(axis + "0"); axis != "" && origin.IsValid() && !origin.IsZero() {
+		values, ok := floats([]interface{}{origin.Interface()})
+		if _, isTime := origin.Interface().(time.Time); !ok || isTime {
+			return nil, fmt.Errorf("cannot downsample, %s0 must be a number, set the positions instead", strings.ToLower(axis))
+		}
+		start = values[0]
+	}
+	if delta := object.FieldByName("D" + strings.ToLower(axis)); axis != "" && delta.Kind() == reflect.Float64 && delta.Float() != 0 {
+		step = delta.Float()
+	}
+	positions := make([]float64, n)
+	for i := range positions {
+		positions[i] = start + float64(i)*step
+	}
+	return positions, nil
+}
@@ -0,0 +1,125 @@
+package downsample_test
+
+import (
+	"math"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/MetalBlueberry/go-plotly/downsample"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var _ = Describe("Downsample", func() {
+
+	Describe("LargestTriangleThreeBuckets", func() {
+
+		It("Should keep the peaks and the ends", func() {
+			x := []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+			y := []float64{0, 0, 0, 10, 0, 0, 0, -10, 0, 0}
+			indexes, err := downsample.LargestTriangleThreeBuckets(x, y, 4)
+			Expect(err).To(BeNil())
+			Expect(indexes).To(Equal([]int{0, 3, 7, 9}))
+		})
+
+		It("Should keep every point below the threshold", func() {
+			indexes, err := downsample.LargestTriangleThreeBuckets([]float64{0, 1}, []float64{0, 1}, 3)
+			Expect(err).To(BeNil())
+			Expect(indexes).To(Equal([]int{0, 1}))
+		})
+
+		It("Should fail on invalid input", func() {
+			_, err := downsample.LargestTriangleThreeBuckets([]float64{0}, []float64{0, 1}, 3)
+			Expect(err).ToNot(BeNil())
+			_, err = downsample.LargestTriangleThreeBuckets([]float64{0, 1, 2}, []float64{0, 1, 2}, 2)
+			Expect(err).ToNot(BeNil())
+		})
+	})
+
+	Describe("MinMaxDecimation", func() {
+
+		It("Should keep the minimum and maximum of every bucket in order", func() {
+			indexes, err := downsample.MinMaxDecimation([]float64{1, 5, 3, 2, math.NaN(), 9, -1, 4}, 4)
+			Expect(err).To(BeNil())
+			Expect(indexes).To(Equal([]int{0, 1, 5, 6}))
+		})
+	})
+
+	Describe("Trace", func() {
+
+		It("Should downsample every array with a value per point", func() {
+			n := 1000
+			x := make([]time.Time, n)
+			y := make([]float64, n)
+			text := make([]string, n)
+			start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+			for i := range x {
+				x[i] = start.Add(time.Duration(i) * time.Second)
+				y[i] = math.Sin(float64(i) / 50)
+				text[i] = x[i].Format(time.RFC3339)
+			}
+			trace := &grob.Scatter{
+				X:          x,
+				Y:          y,
+				Text:       text,
+				Marker:     &grob.ScatterMarker{Color: y, Colorscale: [][]interface{}{{0, "red"}, {1, "blue"}}},
+				Customdata: y,
+				Name:       "sensor",
+			}
+			Expect(downsample.Trace(trace, 100)).To(Succeed())
+
+			Expect(trace.X).To(HaveLen(100))
+			Expect(trace.Y).To(HaveLen(100))
+			Expect(trace.Text).To(HaveLen(100))
+			Expect(trace.Customdata).To(HaveLen(100))
+			Expect(trace.Marker.Color).To(HaveLen(100))
+			Expect(trace.Marker.Colorscale).To(HaveLen(2))
+			Expect(trace.X.([]time.Time)[0]).To(Equal(start))
+			Expect(trace.X.([]time.Time)[99]).To(Equal(x[n-1]))
+			Expect(trace.Text.([]string)[50]).To(Equal(trace.X.([]time.Time)[50].Format(time.RFC3339)))
+		})
+
+		It("Should keep the peaks with min max decimation", func() {
+			y := make([]interface{}, 100)
+			for i := range y {
+				y[i] = 0
+			}
+			y[42] = 7
+			trace := &grob.Bar{Y: y}
+			Expect(downsample.Trace(trace, 10, downsample.Options{Method: downsample.MinMax})).To(Succeed())
+			Expect(trace.Y).To(ContainElement(7))
+			Expect(trace.X).To(ContainElement(42.0))
+		})
+
+		It("Should set the positions of traces without them", func() {
+			y := make([]float64, 10)
+			y[5] = 1
+			trace := &grob.Scatter{Y: y, X0: 100, Dx: 10}
+			Expect(downsample.Trace(trace, 3)).To(Succeed())
+			Expect(trace.X).To(Equal([]float64{100, 150, 190}))
+			Expect(trace.Y).To(Equal([]float64{0, 1, 0}))
+
+			trace = &grob.Scatter{Y: y, X0: "2021-01-01"}
+			Expect(downsample.Trace(trace, 3)).NotTo(Succeed())
+		})
+
+		It("Should downsample horizontal traces along y", func() {
+			trace := &grob.Bar{Orientation: grob.BarOrientationH, X: []float64{0, 0, 5, 0, 0}, Y: []string{"a", "b", "c", "d", "e"}}
+			Expect(downsample.Trace(trace, 3)).To(Succeed())
+			Expect(trace.Y).To(Equal([]string{"a", "c", "e"}))
+		})
+
+		It("Should not modify small traces", func() {
+			trace := &grob.Scatter{Y: []float64{1, 2, 3}}
+			Expect(downsample.Trace(trace, 3)).To(Succeed())
+			Expect(trace.X).To(BeNil())
+		})
+
+		It("Should fail on traces without values", func() {
+			Expect(downsample.Trace(&grob.Pie{}, 3)).NotTo(Succeed())
+			Expect(downsample.Trace(&grob.Scatter{Y: []string{"a", "b", "c", "d"}}, 3)).NotTo(Succeed())
+			Expect(downsample.Trace(&grob.Scatter{Y: []float64{1, 2, 3, 4}}, 3, downsample.Options{Method: "median"})).NotTo(Succeed())
+		})
+	})
+})
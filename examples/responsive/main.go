@@ -32,6 +32,6 @@ func main() {
 		},
 	}
 
-	offline.ToHtml(fig, "bar.html")
+	offline.ToHtml(fig, "bar.html", offline.Options{Responsive: true})
 	offline.Show(fig)
 }
@@ -0,0 +1,34 @@
+package main
+
+import (
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/offline"
+	"github.com/MetalBlueberry/go-plotly/subplots"
+)
+
+func main() {
+	sp := subplots.New(2, 2, subplots.Options{
+		SharedXAxes: true,
+		Titles:      []string{"Temperature", "Share", "Humidity"},
+	})
+
+	sp.Add(&grob.Scatter{
+		X: []float64{1, 2, 3, 4},
+		Y: []float64{20, 22, 21, 24},
+	}, 1, 1)
+	sp.Add(&grob.Pie{
+		Labels: []string{"North", "South"},
+		Values: []float64{40, 60},
+	}, 1, 2)
+	sp.Add(&grob.Bar{
+		X: []float64{1, 2, 3, 4},
+		Y: []float64{60, 65, 58, 70},
+	}, 2, 1)
+	sp.YAxis(2, 1).Title = &grob.LayoutYaxisTitle{
+		Text: "%",
+	}
+
+	fig := sp.Figure()
+	offline.ToHtml(fig, "subplots.html")
+	offline.Show(fig)
+}
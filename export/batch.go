@@ -0,0 +1,102 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// BatchOptions configure Batch
+type BatchOptions struct {
+	// Options are used to start the exporter and to export every figure
+	Options
+	// Format of the images, defaults to FormatPNG
+	Format Format
+	// Workers is the number of figures processed concurrently, defaults to the number of CPUs
+	Workers int
+	// Exporter renders the figures. It is not closed by Batch.
+	// If not given, NewExporter starts one for the batch
+	Exporter Exporter
+}
+
+// BatchError contains the errors of the figures that could not be exported, by index
+type BatchError map[int]error
+
+func (e BatchError) Error() string {
+	indexes := make([]int, 0, len(e))
+	for i := range e {
+		indexes = append(indexes, i)
+	}
+	sort.Ints(indexes)
+
+	messages := make([]string, len(indexes))
+	for j, i := range indexes {
+		messages[j] = fmt.Sprintf("figure %d: %s", i, e[i])
+	}
+	return fmt.Sprintf("%d figures failed to export, %s", len(e), strings.Join(messages, "; "))
+}
+
+// Batch exports many figures concurrently with a single exporter and returns the images in the same order as figs.
+// Figures that fail have a nil image and their error is reported in a BatchError, the rest are still exported.
+// Once ctx is done, the figures not yet exported fail with the context error.
+func Batch(ctx context.Context, figs []*grob.Fig, opts BatchOptions) ([][]byte, error) {
+	if opts.Format == "" {
+		opts.Format = FormatPNG
+	}
+	if opts.Workers <= 0 {
+		opts.Workers = runtime.NumCPU()
+	}
+	exporter := opts.Exporter
+	if exporter == nil {
+		e, err := NewExporter(opts.Options)
+		if err != nil {
+			return nil, err
+		}
+		defer e.Close()
+		exporter = e
+	}
+
+	images := make([][]byte, len(figs))
+	errs := BatchError{}
+	mu := sync.Mutex{}
+
+	jobs := make(chan int)
+	wg := sync.WaitGroup{}
+	for w := 0; w < opts.Workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				buf := &bytes.Buffer{}
+				err := ctx.Err()
+				if err == nil {
+					err = exporter.Export(figs[i], buf, opts.Format, opts.Options)
+				}
+
+				mu.Lock()
+				if err != nil {
+					errs[i] = err
+				} else {
+					images[i] = buf.Bytes()
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	for i := range figs {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return images, errs
+	}
+	return images, nil
+}
@@ -0,0 +1,80 @@
+package export_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/MetalBlueberry/go-plotly/export"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var _ = Describe("Batch", func() {
+
+	var (
+		dir  string
+		opts export.Options
+		figs []*grob.Fig
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "batch")
+		Expect(err).To(BeNil())
+
+		path := filepath.Join(dir, "kaleido")
+		err = ioutil.WriteFile(path, []byte(fakeKaleido), 0755)
+		Expect(err).To(BeNil())
+
+		opts = export.Options{Kaleido: path}
+		figs = make([]*grob.Fig, 20)
+		for i := range figs {
+			figs[i] = &grob.Fig{}
+		}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("Should export every figure in order", func() {
+		images, err := export.Batch(context.Background(), figs, export.BatchOptions{
+			Options: opts,
+			Workers: 4,
+		})
+		Expect(err).To(BeNil())
+		Expect(images).To(HaveLen(20))
+		for _, img := range images {
+			Expect(string(img)).To(Equal("PNG"))
+		}
+	})
+
+	It("Should report the figures that failed", func() {
+		k, err := export.NewKaleido(opts)
+		Expect(err).To(BeNil())
+		defer k.Close()
+
+		images, err := export.Batch(context.Background(), figs[:3], export.BatchOptions{
+			Format:   export.FormatPDF,
+			Exporter: k,
+		})
+		Expect(err).To(BeAssignableToTypeOf(export.BatchError{}))
+		Expect(err.(export.BatchError)).To(HaveLen(3))
+		Expect(err).To(MatchError(ContainSubstring("figure 0: kaleido error 525")))
+		Expect(images).To(Equal([][]byte{nil, nil, nil}))
+	})
+
+	It("Should stop once the context is done", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := export.Batch(ctx, figs, export.BatchOptions{
+			Options: opts,
+		})
+		Expect(err).To(MatchError(ContainSubstring("context canceled")))
+	})
+})
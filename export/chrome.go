@@ -0,0 +1,173 @@
+package export
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/chromedp/cdproto/runtime"
+	"github.com/chromedp/chromedp"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/offline"
+)
+
+// Chrome is a headless Chrome that exports figures with Plotly.toImage.
+// It is an alternative for environments where kaleido is not available. Only PNG, JPEG, WEBP and SVG are supported.
+// It is safe for concurrent use, but exports are processed one at a time.
+type Chrome struct {
+	mu          sync.Mutex
+	ctx         context.Context
+	cancel      context.CancelFunc
+	allocCancel context.CancelFunc
+}
+
+type toImageOptions struct {
+	Format Format  `json:"format"`
+	Width  int     `json:"width,omitempty"`
+	Height int     `json:"height,omitempty"`
+	Scale  float64 `json:"scale,omitempty"`
+}
+
+// NewChrome starts a headless Chrome and loads plotly.js. Only Options.Chrome and Options.PlotlyJS are used.
+// plotly.js is loaded from the CDN unless Options.PlotlyJS is given, local files are read and injected into the page.
+func NewChrome(opt ...Options) (*Chrome, error) {
+	opts := computeOptions(Options{
+		PlotlyJS: "https://cdn.plot.ly/plotly-" + offline.DefaultPlotlyJSVersion + ".min.js",
+	}, opt...)
+
+	allocOpts := chromedp.DefaultExecAllocatorOptions[:]
+	if opts.Chrome != "" {
+		allocOpts = append(allocOpts, chromedp.ExecPath(opts.Chrome))
+	}
+	allocCtx, allocCancel := chromedp.NewExecAllocator(context.Background(), allocOpts...)
+	ctx, cancel := chromedp.NewContext(allocCtx)
+
+	c := &Chrome{
+		ctx:         ctx,
+		cancel:      cancel,
+		allocCancel: allocCancel,
+	}
+
+	load, err := loadPlotlyJS(opts.PlotlyJS)
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+	err = chromedp.Run(ctx,
+		chromedp.Navigate("about:blank"),
+		chromedp.Evaluate(load, nil, awaitPromise),
+	)
+	if err != nil {
+		c.Close()
+		return nil, fmt.Errorf("cannot load plotly.js in chrome, %w", err)
+	}
+	return c, nil
+}
+
+// loadPlotlyJS returns the script that loads plotly.js from a URL or a local file
+func loadPlotlyJS(plotlyJS string) (string, error) {
+	if strings.HasPrefix(plotlyJS, "http://") || strings.HasPrefix(plotlyJS, "https://") {
+		src, err := json.Marshal(plotlyJS)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf(`new Promise((resolve, reject) => {
+	const script = document.createElement("script");
+	script.src = %s;
+	script.onload = () => resolve(true);
+	script.onerror = () => reject(new Error("cannot load plotly.js"));
+	document.head.appendChild(script);
+})`, src), nil
+	}
+	bundle, err := ioutil.ReadFile(plotlyJS)
+	if err != nil {
+		return "", fmt.Errorf("cannot read plotly.js bundle, %w", err)
+	}
+	return string(bundle), nil
+}
+
+func awaitPromise(p *runtime.EvaluateParams) *runtime.EvaluateParams {
+	return p.WithAwaitPromise(true)
+}
+
+// Export writes the figure as an image in the given format. Only Options.Width, Options.Height and Options.Scale are used.
+func (c *Chrome) Export(fig *grob.Fig, w io.Writer, format Format, opt ...Options) error {
+	opts := computeOptions(Options{}, opt...)
+
+	switch format {
+	case FormatPNG, FormatJPEG, FormatWEBP, FormatSVG:
+	default:
+		return fmt.Errorf("format %s is not supported by chrome", format)
+	}
+
+	fig, err := fig.WithDefaultTemplate()
+	if err != nil {
+		return err
+	}
+	figBytes, err := json.Marshal(fig)
+	if err != nil {
+		return fmt.Errorf("cannot marshal figure, %w", err)
+	}
+	imageOpts, err := json.Marshal(toImageOptions{
+		Format: format,
+		Width:  opts.Width,
+		Height: opts.Height,
+		Scale:  opts.Scale,
+	})
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var dataURL string
+	err = chromedp.Run(c.ctx,
+		chromedp.Evaluate(fmt.Sprintf("Plotly.toImage(%s, %s)", figBytes, imageOpts), &dataURL, awaitPromise),
+	)
+	if err != nil {
+		return fmt.Errorf("cannot export figure, %w", err)
+	}
+
+	img, err := decodeDataURL(dataURL)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(img)
+	return err
+}
+
+// decodeDataURL returns the content of data URLs such as data:image/png;base64,iVBOR... or data:image/svg+xml,%3Csvg...
+func decodeDataURL(dataURL string) ([]byte, error) {
+	i := strings.Index(dataURL, ",")
+	if !strings.HasPrefix(dataURL, "data:") || i < 0 {
+		return nil, errors.New("invalid data URL")
+	}
+	header, data := dataURL[:i], dataURL[i+1:]
+	if strings.HasSuffix(header, ";base64") {
+		return base64.StdEncoding.DecodeString(data)
+	}
+	text, err := url.PathUnescape(data)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode data URL, %w", err)
+	}
+	return []byte(text), nil
+}
+
+// Close stops chrome
+func (c *Chrome) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.cancel()
+	c.allocCancel()
+	return nil
+}
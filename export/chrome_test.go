@@ -0,0 +1,46 @@
+package export_test
+
+import (
+	"bytes"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/MetalBlueberry/go-plotly/export"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var _ = Describe("Chrome", func() {
+
+	It("Should export svg images", func() {
+		chrome := ""
+		for _, name := range []string{"chromium", "chromium-browser", "google-chrome", "headless-shell"} {
+			if path, err := exec.LookPath(name); err == nil {
+				chrome = path
+				break
+			}
+		}
+		if chrome == "" {
+			Skip("chrome is not installed")
+		}
+
+		c, err := export.NewChrome(export.Options{Chrome: chrome})
+		Expect(err).To(BeNil())
+		defer c.Close()
+
+		fig := &grob.Fig{
+			Data: grob.Traces{
+				&grob.Bar{
+					Type: grob.TraceTypeBar,
+					X:    []float64{1, 2, 3},
+					Y:    []float64{1, 2, 3},
+				},
+			},
+		}
+		buf := &bytes.Buffer{}
+		err = c.Export(fig, buf, export.FormatSVG, export.Options{Width: 400, Height: 300})
+		Expect(err).To(BeNil())
+		Expect(buf.String()).To(HavePrefix("<svg"))
+	})
+})
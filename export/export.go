@@ -0,0 +1,164 @@
+// Package export renders figures to static images such as PNG, SVG or PDF.
+//
+// Images are rendered by one of the supported backends, found in this order:
+// kaleido (https://github.com/plotly/Kaleido), searched in the PATH unless Options.Kaleido is given,
+// an orca server (https://github.com/plotly/orca) listening at Options.Orca or DefaultOrcaURL,
+// and a headless Chrome, found by chromedp unless Options.Chrome is given.
+package export
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// Format is the format of the exported image
+type Format string
+
+const (
+	FormatPNG  Format = "png"
+	FormatJPEG Format = "jpeg"
+	FormatWEBP Format = "webp"
+	FormatSVG  Format = "svg"
+	FormatPDF  Format = "pdf"
+	FormatEPS  Format = "eps"
+)
+
+// isText tells if the image is returned as text instead of base64 encoded
+func (f Format) isText() bool {
+	return f == FormatSVG || f == FormatEPS
+}
+
+// Exporter renders figures to images, it is implemented by Kaleido, Orca and Chrome.
+// The figures without template are rendered with the default template, see grob.SetDefaultTemplate
+type Exporter interface {
+	Export(fig *grob.Fig, w io.Writer, format Format, opt ...Options) error
+	Close() error
+}
+
+var (
+	_ Exporter = &Kaleido{}
+	_ Exporter = &Orca{}
+	_ Exporter = &Chrome{}
+)
+
+// Options for the exported images
+type Options struct {
+	// Width and Height of the image in pixels, the figure layout size is used if not set
+	Width  int
+	Height int
+	// Scale multiplies the resolution of the image, 2 doubles width and height
+	Scale float64
+
+	// Kaleido is the path to the kaleido executable, defaults to kaleido
+	Kaleido string
+	// Orca is the URL of the orca server used by NewOrca, defaults to DefaultOrcaURL
+	Orca string
+	// Chrome is the path to the Chrome executable used by NewChrome, defaults to the one found by chromedp
+	Chrome string
+	// PlotlyJS is the path or URL of the plotly.js bundle.
+	// Defaults to the bundle distributed with kaleido, or the CDN for Chrome
+	PlotlyJS string
+}
+
+// ToPNG writes the figure as PNG image
+func ToPNG(fig *grob.Fig, w io.Writer, opt ...Options) error {
+	return ToImage(fig, w, FormatPNG, opt...)
+}
+
+// ToSVG writes the figure as SVG image
+func ToSVG(fig *grob.Fig, w io.Writer, opt ...Options) error {
+	return ToImage(fig, w, FormatSVG, opt...)
+}
+
+// ToPDF writes the figure as PDF document
+func ToPDF(fig *grob.Fig, w io.Writer, opt ...Options) error {
+	return ToImage(fig, w, FormatPDF, opt...)
+}
+
+// ToImage writes the figure in the given format.
+// It starts a new exporter on every call, use NewExporter to export multiple figures.
+func ToImage(fig *grob.Fig, w io.Writer, format Format, opt ...Options) error {
+	e, err := NewExporter(opt...)
+	if err != nil {
+		return err
+	}
+	defer e.Close()
+
+	return e.Export(fig, w, format, opt...)
+}
+
+// NewExporter starts the first available backend.
+// If Options.Kaleido, Options.Orca or Options.Chrome is given, that backend is used without trying the others.
+func NewExporter(opt ...Options) (Exporter, error) {
+	opts := computeOptions(Options{}, opt...)
+
+	switch {
+	case opts.Kaleido != "":
+		return NewKaleido(opts)
+	case opts.Orca != "":
+		return NewOrca(opts)
+	case opts.Chrome != "":
+		return NewChrome(opts)
+	}
+
+	failures := []string{}
+	if _, err := exec.LookPath("kaleido"); err == nil {
+		k, err := NewKaleido(opts)
+		if err == nil {
+			return k, nil
+		}
+		failures = append(failures, err.Error())
+	} else {
+		failures = append(failures, "kaleido is not installed")
+	}
+
+	o, err := NewOrca(opts)
+	if err == nil {
+		return o, nil
+	}
+	failures = append(failures, err.Error())
+
+	c, err := NewChrome(opts)
+	if err == nil {
+		return c, nil
+	}
+	failures = append(failures, err.Error())
+
+	return nil, fmt.Errorf("no exporter available, %w: %s", ErrNoExporter, strings.Join(failures, "; "))
+}
+
+// ErrNoExporter is returned when none of the backends can be started
+var ErrNoExporter = errors.New("install kaleido, start an orca server or install chrome")
+
+func computeOptions(def Options, opt ...Options) Options {
+	if len(opt) == 1 {
+		opts := opt[0]
+		if opts.Width != 0 {
+			def.Width = opts.Width
+		}
+		if opts.Height != 0 {
+			def.Height = opts.Height
+		}
+		if opts.Scale != 0 {
+			def.Scale = opts.Scale
+		}
+		if opts.Kaleido != "" {
+			def.Kaleido = opts.Kaleido
+		}
+		if opts.Orca != "" {
+			def.Orca = opts.Orca
+		}
+		if opts.Chrome != "" {
+			def.Chrome = opts.Chrome
+		}
+		if opts.PlotlyJS != "" {
+			def.PlotlyJS = opts.PlotlyJS
+		}
+	}
+	return def
+}
@@ -0,0 +1,155 @@
+package export
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os/exec"
+	"sync"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// Kaleido is a running kaleido process.
+// Starting kaleido is slow, reuse the same instance to export multiple figures.
+// It is safe for concurrent use, but exports are processed one at a time.
+type Kaleido struct {
+	mu     sync.Mutex
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser
+	stdout *bufio.Reader
+}
+
+type kaleidoRequest struct {
+	Data   *grob.Fig `json:"data"`
+	Format Format    `json:"format"`
+	Width  int       `json:"width,omitempty"`
+	Height int       `json:"height,omitempty"`
+	Scale  float64   `json:"scale,omitempty"`
+}
+
+type kaleidoResponse struct {
+	Code    int     `json:"code"`
+	Message string  `json:"message"`
+	Result  *string `json:"result"`
+}
+
+// NewKaleido starts a kaleido process. Only Options.Kaleido and Options.PlotlyJS are used.
+func NewKaleido(opt ...Options) (*Kaleido, error) {
+	opts := computeOptions(Options{
+		Kaleido: "kaleido",
+	}, opt...)
+
+	args := []string{
+		"plotly",
+		"--disable-gpu",
+		"--allow-file-access-from-files",
+		"--disable-breakpad",
+		"--disable-dev-shm-usage",
+	}
+	if opts.PlotlyJS != "" {
+		args = append(args, "--plotlyjs="+opts.PlotlyJS)
+	}
+
+	cmd := exec.Command(opts.Kaleido, args...)
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open stdin, %w", err)
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open stdout, %w", err)
+	}
+	err = cmd.Start()
+	if err != nil {
+		return nil, fmt.Errorf("failed to start kaleido, %w", err)
+	}
+
+	k := &Kaleido{
+		cmd:    cmd,
+		stdin:  stdin,
+		stdout: bufio.NewReader(stdout),
+	}
+
+	// kaleido writes a message once it is ready
+	_, err = k.readResponse()
+	if err != nil {
+		k.Close()
+		return nil, fmt.Errorf("kaleido failed to start, %w", err)
+	}
+	return k, nil
+}
+
+// Export writes the figure as an image in the given format. Only Options.Width, Options.Height and Options.Scale are used.
+func (k *Kaleido) Export(fig *grob.Fig, w io.Writer, format Format, opt ...Options) error {
+	opts := computeOptions(Options{}, opt...)
+
+	fig, err := fig.WithDefaultTemplate()
+	if err != nil {
+		return err
+	}
+	request, err := json.Marshal(kaleidoRequest{
+		Data:   fig,
+		Format: format,
+		Width:  opts.Width,
+		Height: opts.Height,
+		Scale:  opts.Scale,
+	})
+	if err != nil {
+		return fmt.Errorf("cannot marshal figure, %w", err)
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	_, err = k.stdin.Write(append(request, '\n'))
+	if err != nil {
+		return fmt.Errorf("cannot write to kaleido, %w", err)
+	}
+	result, err := k.readResponse()
+	if err != nil {
+		return err
+	}
+
+	if format.isText() {
+		_, err = io.WriteString(w, result)
+		return err
+	}
+	img, err := base64.StdEncoding.DecodeString(result)
+	if err != nil {
+		return fmt.Errorf("cannot decode image, %w", err)
+	}
+	_, err = w.Write(img)
+	return err
+}
+
+func (k *Kaleido) readResponse() (string, error) {
+	line, err := k.stdout.ReadBytes('\n')
+	if err != nil {
+		return "", fmt.Errorf("cannot read from kaleido, %w", err)
+	}
+	response := kaleidoResponse{}
+	err = json.Unmarshal(line, &response)
+	if err != nil {
+		return "", fmt.Errorf("invalid response from kaleido, %w", err)
+	}
+	if response.Code != 0 {
+		return "", fmt.Errorf("kaleido error %d, %s", response.Code, response.Message)
+	}
+	if response.Result == nil {
+		return "", nil
+	}
+	return *response.Result, nil
+}
+
+// Close stops the kaleido process
+func (k *Kaleido) Close() error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	// kaleido exits once stdin is closed
+	k.stdin.Close()
+	return k.cmd.Wait()
+}
@@ -0,0 +1,101 @@
+package export_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/MetalBlueberry/go-plotly/export"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// fakeKaleido emulates the kaleido protocol, png images contain the text PNG
+var fakeKaleido = `#!/bin/sh
+echo '{"code": 0, "message": "Success", "result": null, "version": "0.2.1"}'
+while read line; do
+	case "$line" in
+	*'"format":"svg"'*) echo '{"code": 0, "message": "Success", "format": "svg", "result": "<svg></svg>"}' ;;
+	*'"format":"pdf"'*) echo '{"code": 525, "message": "error"}' ;;
+	*'"width":800'*) echo '{"code": 0, "message": "Success", "format": "png", "result": "ODAw"}' ;;
+	*) echo '{"code": 0, "message": "Success", "format": "png", "result": "UE5H"}' ;;
+	esac
+done
+`
+
+var _ = Describe("Kaleido", func() {
+
+	var (
+		dir  string
+		opts export.Options
+		fig  *grob.Fig
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "kaleido")
+		Expect(err).To(BeNil())
+
+		path := filepath.Join(dir, "kaleido")
+		err = ioutil.WriteFile(path, []byte(fakeKaleido), 0755)
+		Expect(err).To(BeNil())
+
+		opts = export.Options{Kaleido: path}
+		fig = &grob.Fig{
+			Data: grob.Traces{
+				&grob.Bar{
+					Type: grob.TraceTypeBar,
+					X:    []float64{1, 2, 3},
+					Y:    []float64{1, 2, 3},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("Should decode binary images", func() {
+		buf := &bytes.Buffer{}
+		err := export.ToPNG(fig, buf, opts)
+		Expect(err).To(BeNil())
+		Expect(buf.String()).To(Equal("PNG"))
+	})
+
+	It("Should write text images", func() {
+		buf := &bytes.Buffer{}
+		err := export.ToSVG(fig, buf, opts)
+		Expect(err).To(BeNil())
+		Expect(buf.String()).To(Equal("<svg></svg>"))
+	})
+
+	It("Should send the image size", func() {
+		buf := &bytes.Buffer{}
+		opts.Width = 800
+		err := export.ToPNG(fig, buf, opts)
+		Expect(err).To(BeNil())
+		Expect(buf.String()).To(Equal("800"))
+	})
+
+	It("Should report errors", func() {
+		err := export.ToPDF(fig, &bytes.Buffer{}, opts)
+		Expect(err).To(MatchError(ContainSubstring("kaleido error 525")))
+	})
+
+	It("Should reuse the process", func() {
+		k, err := export.NewKaleido(opts)
+		Expect(err).To(BeNil())
+		defer k.Close()
+
+		for i := 0; i < 3; i++ {
+			buf := &bytes.Buffer{}
+			err = k.Export(fig, buf, export.FormatPNG)
+			Expect(err).To(BeNil())
+			Expect(buf.String()).To(Equal("PNG"))
+		}
+	})
+})
@@ -0,0 +1,96 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// DefaultOrcaURL is the address of an orca server started with orca serve --port 9091
+const DefaultOrcaURL = "http://localhost:9091"
+
+// Orca exports figures with an orca server (https://github.com/plotly/orca).
+// Orca is deprecated in favour of kaleido, but it is still found in many installations.
+// It is safe for concurrent use.
+type Orca struct {
+	url    string
+	client *http.Client
+}
+
+type orcaRequest struct {
+	Figure *grob.Fig `json:"figure"`
+	Format Format    `json:"format"`
+	Width  int       `json:"width,omitempty"`
+	Height int       `json:"height,omitempty"`
+	Scale  float64   `json:"scale,omitempty"`
+}
+
+// NewOrca connects to the orca server at Options.Orca, defaults to DefaultOrcaURL.
+// It fails if the server does not answer to ping.
+func NewOrca(opt ...Options) (*Orca, error) {
+	opts := computeOptions(Options{
+		Orca: DefaultOrcaURL,
+	}, opt...)
+
+	o := &Orca{
+		url: strings.TrimSuffix(opts.Orca, "/"),
+		client: &http.Client{
+			Timeout: time.Minute,
+		},
+	}
+	resp, err := o.client.Get(o.url + "/ping")
+	if err != nil {
+		return nil, fmt.Errorf("cannot reach orca, %w", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("orca ping failed, status %s", resp.Status)
+	}
+	return o, nil
+}
+
+// Export writes the figure as an image in the given format. Only Options.Width, Options.Height and Options.Scale are used.
+func (o *Orca) Export(fig *grob.Fig, w io.Writer, format Format, opt ...Options) error {
+	opts := computeOptions(Options{}, opt...)
+
+	fig, err := fig.WithDefaultTemplate()
+	if err != nil {
+		return err
+	}
+	request, err := json.Marshal(orcaRequest{
+		Figure: fig,
+		Format: format,
+		Width:  opts.Width,
+		Height: opts.Height,
+		Scale:  opts.Scale,
+	})
+	if err != nil {
+		return fmt.Errorf("cannot marshal figure, %w", err)
+	}
+
+	resp, err := o.client.Post(o.url+"/", "application/json", bytes.NewReader(request))
+	if err != nil {
+		return fmt.Errorf("cannot send figure to orca, %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		message, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("orca error %d, %s", resp.StatusCode, strings.TrimSpace(string(message)))
+	}
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
+// Close releases the idle connections to the server, the server keeps running
+func (o *Orca) Close() error {
+	o.client.CloseIdleConnections()
+	return nil
+}
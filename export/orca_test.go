@@ -0,0 +1,60 @@
+package export_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/MetalBlueberry/go-plotly/export"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var _ = Describe("Orca", func() {
+
+	var ts *httptest.Server
+
+	BeforeEach(func() {
+		ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/ping" {
+				return
+			}
+			request := struct {
+				Format string `json:"format"`
+				Width  int    `json:"width"`
+			}{}
+			json.NewDecoder(r.Body).Decode(&request)
+			if request.Format == "pdf" {
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				w.Write([]byte("invalid figure"))
+				return
+			}
+			w.Write([]byte(request.Format))
+		}))
+	})
+
+	AfterEach(func() {
+		ts.Close()
+	})
+
+	It("Should export through the orca server", func() {
+		buf := &bytes.Buffer{}
+		err := export.ToPNG(&grob.Fig{}, buf, export.Options{Orca: ts.URL})
+		Expect(err).To(BeNil())
+		Expect(buf.String()).To(Equal("png"))
+	})
+
+	It("Should report errors", func() {
+		err := export.ToPDF(&grob.Fig{}, &bytes.Buffer{}, export.Options{Orca: ts.URL})
+		Expect(err).To(MatchError("orca error 422, invalid figure"))
+	})
+
+	It("Should fail if the server is not running", func() {
+		ts.Close()
+		_, err := export.NewOrca(export.Options{Orca: ts.URL})
+		Expect(err).To(MatchError(ContainSubstring("cannot reach orca")))
+	})
+})
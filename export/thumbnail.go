@@ -0,0 +1,147 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// thumbnailMargin is the margin in pixels around the plot area of thumbnails
+const thumbnailMargin = 5
+
+// Thumbnail returns a small PNG preview of the figure that fits in maxW x maxH pixels, keeping the aspect ratio of the layout size if defined.
+// The layout is simplified to remove the title, the legend and the margins, and traces are downsampled to about one point per pixel.
+// The figure is not modified. Like ToPNG, it uses the first available backend.
+func Thumbnail(fig *grob.Fig, maxW, maxH int, opt ...Options) ([]byte, error) {
+	thumb, err := thumbnailFig(fig, maxW, maxH)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := computeOptions(Options{}, opt...)
+	opts.Width, opts.Height = thumbnailSize(fig, maxW, maxH)
+	opts.Scale = 1
+
+	buf := &bytes.Buffer{}
+	err = ToPNG(thumb, buf, opts)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// thumbnailSize fits the layout size, if any, into maxW x maxH
+func thumbnailSize(fig *grob.Fig, maxW, maxH int) (int, int) {
+	if fig.Layout == nil || fig.Layout.Width == 0 || fig.Layout.Height == 0 {
+		return maxW, maxH
+	}
+	ratio := math.Min(float64(maxW)/fig.Layout.Width, float64(maxH)/fig.Layout.Height)
+	return int(fig.Layout.Width * ratio), int(fig.Layout.Height * ratio)
+}
+
+// thumbnailFig returns a simplified copy of the figure. The copy is done through JSON to downsample any data array
+func thumbnailFig(fig *grob.Fig, maxW, maxH int) (*grob.Fig, error) {
+	figBytes, err := json.Marshal(fig)
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal figure, %w", err)
+	}
+	figMap := map[string]interface{}{}
+	err = json.Unmarshal(figBytes, &figMap)
+	if err != nil {
+		return nil, err
+	}
+
+	maxPoints := maxW
+	if maxH > maxPoints {
+		maxPoints = maxH
+	}
+	if data, ok := figMap["data"].([]interface{}); ok {
+		for _, trace := range data {
+			if trace, ok := trace.(map[string]interface{}); ok {
+				downsampleTrace(trace, maxPoints)
+			}
+		}
+	}
+	delete(figMap, "frames")
+
+	layout, ok := figMap["layout"].(map[string]interface{})
+	if !ok {
+		layout = map[string]interface{}{}
+		figMap["layout"] = layout
+	}
+	delete(layout, "title")
+	delete(layout, "width")
+	delete(layout, "height")
+	layout["showlegend"] = false
+	layout["margin"] = map[string]interface{}{
+		"l":   thumbnailMargin,
+		"r":   thumbnailMargin,
+		"t":   thumbnailMargin,
+		"b":   thumbnailMargin,
+		"pad": 0,
+	}
+	figMap["config"] = map[string]interface{}{
+		"displayModeBar": false,
+		"staticPlot":     true,
+	}
+
+	figBytes, err = json.Marshal(figMap)
+	if err != nil {
+		return nil, err
+	}
+	thumb := &grob.Fig{}
+	err = json.Unmarshal(figBytes, thumb)
+	if err != nil {
+		return nil, fmt.Errorf("cannot build thumbnail, %w", err)
+	}
+	return thumb, nil
+}
+
+// downsampleTrace keeps every nth point of the longest one dimensional arrays of the trace and its marker,
+// so that they have at most maxPoints. Arrays with other lengths, such as colorscales, are kept.
+func downsampleTrace(trace map[string]interface{}, maxPoints int) {
+	objects := []map[string]interface{}{trace}
+	if marker, ok := trace["marker"].(map[string]interface{}); ok {
+		objects = append(objects, marker)
+	}
+
+	length := 0
+	for _, object := range objects {
+		for _, value := range object {
+			if array, ok := value.([]interface{}); ok && isFlat(array) && len(array) > length {
+				length = len(array)
+			}
+		}
+	}
+	if length <= maxPoints || maxPoints <= 0 {
+		return
+	}
+	step := int(math.Ceil(float64(length) / float64(maxPoints)))
+
+	for _, object := range objects {
+		for key, value := range object {
+			array, ok := value.([]interface{})
+			if !ok || len(array) != length || !isFlat(array) {
+				continue
+			}
+			sampled := make([]interface{}, 0, length/step+1)
+			for i := 0; i < length; i += step {
+				sampled = append(sampled, array[i])
+			}
+			object[key] = sampled
+		}
+	}
+}
+
+// isFlat tells if the array does not contain other arrays, such as the z values of a heatmap
+func isFlat(array []interface{}) bool {
+	for _, value := range array {
+		if _, ok := value.([]interface{}); ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,98 @@
+package export_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/MetalBlueberry/go-plotly/export"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// recordingKaleido saves the last request next to the executable
+var recordingKaleido = `#!/bin/sh
+echo '{"code": 0, "message": "Success", "result": null, "version": "0.2.1"}'
+while read line; do
+	echo "$line" > "$(dirname "$0")/request.json"
+	echo '{"code": 0, "message": "Success", "format": "png", "result": "UE5H"}'
+done
+`
+
+var _ = Describe("Thumbnail", func() {
+
+	var (
+		dir  string
+		opts export.Options
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "thumbnail")
+		Expect(err).To(BeNil())
+
+		path := filepath.Join(dir, "kaleido")
+		err = ioutil.WriteFile(path, []byte(recordingKaleido), 0755)
+		Expect(err).To(BeNil())
+		opts = export.Options{Kaleido: path}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("Should render a simplified figure", func() {
+		x := make([]float64, 1000)
+		for i := range x {
+			x[i] = float64(i)
+		}
+		fig := &grob.Fig{
+			Data: grob.Traces{
+				&grob.Scatter{
+					Type: grob.TraceTypeScatter,
+					X:    x,
+					Y:    x,
+					Marker: &grob.ScatterMarker{
+						Color: x,
+					},
+				},
+			},
+			Layout: &grob.Layout{
+				Title:  &grob.LayoutTitle{Text: "big"},
+				Width:  1000,
+				Height: 500,
+			},
+		}
+
+		img, err := export.Thumbnail(fig, 200, 200, opts)
+		Expect(err).To(BeNil())
+		Expect(string(img)).To(Equal("PNG"))
+
+		content, err := ioutil.ReadFile(filepath.Join(dir, "request.json"))
+		Expect(err).To(BeNil())
+		request := struct {
+			Data   *grob.Fig `json:"data"`
+			Width  int       `json:"width"`
+			Height int       `json:"height"`
+		}{}
+		err = json.Unmarshal(content, &request)
+		Expect(err).To(BeNil())
+
+		Expect(request.Width).To(Equal(200))
+		Expect(request.Height).To(Equal(100))
+		Expect(request.Data.Layout.Title).To(BeNil())
+		Expect(*request.Data.Layout.Showlegend).To(BeFalse())
+
+		scatter := request.Data.Data[0].(*grob.Scatter)
+		Expect(scatter.X).To(HaveLen(200))
+		Expect(scatter.Y).To(HaveLen(200))
+		Expect(scatter.Marker.Color).To(HaveLen(200))
+
+		// the original figure is kept
+		Expect(fig.Layout.Title).NotTo(BeNil())
+		Expect(fig.Data[0].(*grob.Scatter).X).To(HaveLen(1000))
+	})
+})
@@ -0,0 +1,188 @@
+package express
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/subplots"
+)
+
+// DefaultCalendarColorscale is the colorscale of Calendar
+const DefaultCalendarColorscale = "Greens"
+
+// weekdays are the rows of Calendar, weeks start on Monday like ISO weeks
+var weekdays = []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}
+
+// Calendar plots daily values as a heatmap per year, like the contribution graph of GitHub, with a column per week,
+// a row per weekday and a line between the months. The values of the same day, in the location of their time, are added.
+// The days without value are blank and the hover label shows the date and the value.
+// The Color option is the colorscale, shared by all the years.
+//
+// It fails if the values span more than subplots.MaxCells years.
+//
+//	fig, err := express.Calendar(map[time.Time]float64{day: commits}, express.Options{Title: "Commits"})
+func Calendar(values map[time.Time]float64, opt ...Options) (*grob.Fig, error) {
+	opts := computeOptions(Options{
+		Color: DefaultCalendarColorscale,
+	}, opt...)
+
+	// values by year and day of the year
+	days := map[int]map[int]float64{}
+	years := []int{}
+	min, max := math.Inf(1), math.Inf(-1)
+	for t, value := range values {
+		year := t.Year()
+		if days[year] == nil {
+			days[year] = map[int]float64{}
+			years = append(years, year)
+		}
+		days[year][t.YearDay()] += value
+	}
+	sort.Ints(years)
+	for _, year := range years {
+		for _, value := range days[year] {
+			min, max = math.Min(min, value), math.Max(max, value)
+		}
+	}
+	if len(years) == 0 {
+		years = []int{time.Now().Year()}
+	}
+	if len(years) > subplots.MaxCells {
+		return nil, fmt.Errorf("a calendar of %d years is not supported, at most %d years can be plotted", len(years), subplots.MaxCells)
+	}
+
+	titles := make([]string, len(years))
+	for i, year := range years {
+		titles[i] = strconv.Itoa(year)
+	}
+	sp := subplots.New(len(years), 1, subplots.Options{
+		Titles:          titles,
+		VerticalSpacing: 0.3 / float64(len(years)),
+	})
+	shapes := []map[string]interface{}{}
+	for i, year := range years {
+		row := i + 1
+		trace, months := calendarYear(year, days[year])
+		trace.Colorscale = opts.Color
+		if min <= max {
+			trace.Zmin, trace.Zmax = min, max
+		}
+		// the colorscale is shared, it is displayed once
+		trace.Showscale = grob.False
+		if i == 0 {
+			trace.Showscale = grob.True
+		}
+		trace.Name = opts.Name
+		err := sp.Add(trace, row, 1)
+		if err != nil {
+			return nil, fmt.Errorf("cannot add the calendar of %d, %w", year, err)
+		}
+
+		xaxis, yaxis := sp.XAxis(row, 1), sp.YAxis(row, 1)
+		xaxis.Tickmode = grob.LayoutXaxisTickmodeArray
+		xaxis.Tickvals = months.ticks
+		xaxis.Ticktext = months.names
+		xaxis.Showgrid = grob.False
+		xaxis.Zeroline = grob.False
+		xaxis.Side = grob.LayoutXaxisSideTop
+		yaxis.Tickmode = grob.LayoutYaxisTickmodeArray
+		yaxis.Tickvals = []int{0, 1, 2, 3, 4, 5, 6}
+		yaxis.Ticktext = weekdays
+		yaxis.Autorange = grob.LayoutYaxisAutorangeReversed
+		yaxis.Showgrid = grob.False
+		yaxis.Zeroline = grob.False
+
+		xref, yref := "x", "y"
+		if row > 1 {
+			xref, yref = fmt.Sprintf("x%d", row), fmt.Sprintf("y%d", row)
+		}
+		for _, path := range months.separators {
+			shapes = append(shapes, map[string]interface{}{
+				"type": "path",
+				"path": path,
+				"xref": xref,
+				"yref": yref,
+				"line": map[string]interface{}{"color": "#444", "width": 1},
+			})
+		}
+	}
+
+	fig := sp.Figure()
+	fig.Layout.Shapes = shapes
+	fig.Layout.Height = float64(60 + 180*len(years))
+	if opts.Title != "" {
+		fig.Layout.Title = &grob.LayoutTitle{
+			Text: opts.Title,
+		}
+	}
+	if opts.Template != nil {
+		fig.Layout.Template = opts.Template
+	}
+	return fig, nil
+}
+
+// calendarMonths are the ticks of the months and the paths of the lines between them
+type calendarMonths struct {
+	ticks      []float64
+	names      []string
+	separators []string
+}
+
+// calendarYear returns the heatmap of the values of the year by day of the year, and its months
+func calendarYear(year int, values map[int]float64) (*grob.Heatmap, calendarMonths) {
+	first := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	last := time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC)
+	// the column of a day is the number of weeks since the Monday before the first day of the year
+	offset := (int(first.Weekday()) + 6) % 7
+	position := func(t time.Time) (int, int) {
+		return (t.YearDay() - 1 + offset) / 7, (int(t.Weekday()) + 6) % 7
+	}
+	lastCol, _ := position(last)
+
+	z := make([][]interface{}, 7)
+	text := make([][]string, 7)
+	for i := range z {
+		z[i] = make([]interface{}, lastCol+1)
+		text[i] = make([]string, lastCol+1)
+	}
+	for t := first; !t.After(last); t = t.AddDate(0, 0, 1) {
+		col, row := position(t)
+		text[row][col] = t.Format("Mon 2 Jan 2006")
+		if value, ok := values[t.YearDay()]; ok {
+			z[row][col] = value
+		}
+	}
+
+	months := calendarMonths{}
+	for month := time.January; month <= time.December; month++ {
+		start := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+		col, row := position(start)
+		end, _ := position(start.AddDate(0, 1, -1))
+		months.ticks = append(months.ticks, float64(col+end)/2)
+		months.names = append(months.names, month.String()[:3])
+		if month == time.January {
+			continue
+		}
+		// the line goes down the left of the first day of the month from its row, and down the right of the previous week above it
+		x, y := float64(col)-0.5, float64(row)-0.5
+		if row == 0 {
+			months.separators = append(months.separators, fmt.Sprintf("M %g -0.5 V 6.5", x))
+			continue
+		}
+		months.separators = append(months.separators, fmt.Sprintf("M %g -0.5 V %g H %g V 6.5", x+1, y, x))
+	}
+
+	return &grob.Heatmap{
+		Type:          grob.TraceTypeHeatmap,
+		Z:             z,
+		Text:          text,
+		Xgap:          2,
+		Ygap:          2,
+		Hoverongaps:   grob.False,
+		Hovertemplate: "%{text}<br>%{z}<extra></extra>",
+	}, months
+}
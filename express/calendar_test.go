@@ -0,0 +1,97 @@
+package express_test
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/MetalBlueberry/go-plotly/express"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/subplots"
+)
+
+var _ = Describe("Calendar", func() {
+
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 12, 0, 0, 0, time.UTC)
+	}
+
+	It("Should place the days by week and weekday", func() {
+		fig, err := express.Calendar(map[time.Time]float64{
+			date(2024, time.January, 1):   3,
+			date(2024, time.February, 1):  5,
+			date(2024, time.December, 31): 1,
+		}, express.Options{Title: "Commits"})
+		Expect(err).To(BeNil())
+		Expect(fig.Data).To(HaveLen(1))
+		trace := fig.Data[0].(*grob.Heatmap)
+		Expect(trace.Type).To(Equal(grob.TraceTypeHeatmap))
+		Expect(trace.Colorscale).To(Equal(express.DefaultCalendarColorscale))
+		Expect(trace.Zmin).To(Equal(1.0))
+		Expect(trace.Zmax).To(Equal(5.0))
+
+		z := trace.Z.([][]interface{})
+		Expect(z).To(HaveLen(7))
+		Expect(z[0]).To(HaveLen(53))
+		// 2024 starts on a Monday, the 1st of February is the Thursday of the 5th week
+		Expect(z[0][0]).To(Equal(3.0))
+		Expect(z[3][4]).To(Equal(5.0))
+		Expect(z[1][52]).To(Equal(1.0))
+		Expect(z[2][0]).To(BeNil())
+		Expect(trace.Text.([][]string)[3][4]).To(Equal("Thu 1 Feb 2024"))
+
+		shapes := fig.Layout.Shapes.([]map[string]interface{})
+		Expect(shapes).To(HaveLen(11))
+		Expect(shapes[0]["path"]).To(Equal("M 4.5 -0.5 V 2.5 H 3.5 V 6.5"))
+		// the 1st of April 2024 is a Monday
+		Expect(shapes[2]["path"]).To(Equal("M 12.5 -0.5 V 6.5"))
+
+		Expect(fig.Layout.Yaxis.Ticktext).To(Equal([]string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}))
+		Expect(fig.Layout.Yaxis.Autorange).To(Equal(grob.LayoutYaxisAutorangeReversed))
+		Expect(fig.Layout.Xaxis.Ticktext).To(HaveLen(12))
+		Expect(fig.Layout.Title.Text).To(Equal("Commits"))
+
+		_, err = json.Marshal(fig)
+		Expect(err).To(BeNil())
+	})
+
+	It("Should add the values of the same day and plot a year per row", func() {
+		fig, err := express.Calendar(map[time.Time]float64{
+			time.Date(2023, time.January, 1, 8, 0, 0, 0, time.UTC):  1,
+			time.Date(2023, time.January, 1, 18, 0, 0, 0, time.UTC): 2,
+			date(2024, time.March, 3):                               4,
+		}, express.Options{Color: "Blues"})
+		Expect(err).To(BeNil())
+		Expect(fig.Data).To(HaveLen(2))
+		first := fig.Data[0].(*grob.Heatmap)
+		// 2023 starts on a Sunday
+		Expect(first.Z.([][]interface{})[6][0]).To(Equal(3.0))
+		Expect(first.Colorscale).To(Equal("Blues"))
+		Expect(first.Showscale).To(Equal(grob.True))
+
+		second := fig.Data[1].(*grob.Heatmap)
+		Expect(second.Xaxis).To(Equal("x2"))
+		Expect(second.Showscale).To(Equal(grob.False))
+		Expect(second.Zmax).To(Equal(4.0))
+		Expect(fig.Layout.Shapes.([]map[string]interface{})[11]["xref"]).To(Equal("x2"))
+	})
+
+	It("Should plot an empty calendar", func() {
+		fig, err := express.Calendar(nil)
+		Expect(err).To(BeNil())
+		Expect(fig.Data).To(HaveLen(1))
+		_, err = json.Marshal(fig)
+		Expect(err).To(BeNil())
+	})
+
+	It("Should fail with more years than subplots", func() {
+		values := map[time.Time]float64{}
+		for year := 2000; year <= 2000+subplots.MaxCells; year++ {
+			values[date(year, time.June, 1)] = 1
+		}
+		_, err := express.Calendar(values)
+		Expect(err).To(MatchError(ContainSubstring("years is not supported")))
+	})
+})
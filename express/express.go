@@ -0,0 +1,314 @@
+// Package express builds complete figures in a single call, in the spirit of plotly express.
+//
+// The figures have a title, axis titles, a hover label that names the values and the plotly colors.
+// They are regular figures, they can be modified before they are displayed.
+//
+//	fig := express.Line(days, temperatures, express.Options{Title: "Temperature", XTitle: "day", YTitle: "°C"})
+//	offline.Show(fig)
+package express
+
+import (
+	"fmt"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/themes"
+)
+
+// DefaultColorscale is the colorscale of heatmaps
+const DefaultColorscale = "Viridis"
+
+// Options customize the figures
+type Options struct {
+	// Title of the figure
+	Title string
+	// XTitle is the title of the x axis, also used in the hover label. Defaults to x
+	XTitle string
+	// YTitle is the title of the y axis, also used in the hover label. Defaults to y, or count for histograms
+	YTitle string
+	// Name of the trace, displayed in the hover label
+	Name string
+	// Color of the trace, defaults to the first color of the plotly colorway.
+	// For heatmaps it is the colorscale and for pies the list of colors of the slices
+	Color interface{}
+	// Template styles the figure, such as the themes package templates
+	Template *grob.Template
+	// Trendline draws a regression over scatter plots
+	Trendline Trendline
+	// LowessFrac is the fraction of the points used by each local regression of the Lowess trendline, defaults to DefaultLowessFrac
+	LowessFrac float64
+	// WebGLThreshold draws the traces with more points with WebGL, see grob.Fig.OptimizeWebGL. Defaults to 0, never
+	WebGLThreshold int
+	// ATitle, BTitle and CTitle are the titles of the axes of ternary plots. Default to a, b and c
+	ATitle string
+	BTitle string
+	CTitle string
+	// Sum of the components of every point of ternary plots, defaults to 1
+	Sum float64
+	// Target draws a line across gauges at its value
+	Target float64
+}
+
+func computeOptions(def Options, opt ...Options) Options {
+	if len(opt) == 1 {
+		opts := opt[0]
+		if opts.Title != "" {
+			def.Title = opts.Title
+		}
+		if opts.XTitle != "" {
+			def.XTitle = opts.XTitle
+		}
+		if opts.YTitle != "" {
+			def.YTitle = opts.YTitle
+		}
+		if opts.Name != "" {
+			def.Name = opts.Name
+		}
+		if opts.Color != nil {
+			def.Color = opts.Color
+		}
+		if opts.Template != nil {
+			def.Template = opts.Template
+		}
+		if opts.Trendline != "" {
+			def.Trendline = opts.Trendline
+		}
+		if opts.LowessFrac != 0 {
+			def.LowessFrac = opts.LowessFrac
+		}
+		if opts.WebGLThreshold != 0 {
+			def.WebGLThreshold = opts.WebGLThreshold
+		}
+		if opts.ATitle != "" {
+			def.ATitle = opts.ATitle
+		}
+		if opts.BTitle != "" {
+			def.BTitle = opts.BTitle
+		}
+		if opts.CTitle != "" {
+			def.CTitle = opts.CTitle
+		}
+		if opts.Sum != 0 {
+			def.Sum = opts.Sum
+		}
+		if opts.Target != 0 {
+			def.Target = opts.Target
+		}
+	}
+	return def
+}
+
+// Line plots y against x joined by lines
+func Line(x, y interface{}, opt ...Options) *grob.Fig {
+	opts := computeOptions(cartesianOptions("y"), opt...)
+	return figure(&grob.Scatter{
+		Type:          grob.TraceTypeScatter,
+		Mode:          grob.ScatterModeLines,
+		X:             x,
+		Y:             y,
+		Name:          opts.Name,
+		Hovertemplate: hover(opts, fmt.Sprintf("%s=%%{x}<br>%s=%%{y}", opts.XTitle, opts.YTitle)),
+		Line: &grob.ScatterLine{
+			Color: opts.Color,
+		},
+	}, opts)
+}
+
+// Scatter plots y against x with markers.
+//
+// With a Trendline, the numeric points are fitted in Go and a line of the fitted values is drawn over them, like the trendlines
+// of plotly express. The Fit is stored in the meta of the layout under the trendline key. Points with non numeric values are
+// not fitted and if the fit is not possible, such as with less than 2 points, there is no trendline.
+//
+//	fig := express.Scatter(height, weight, express.Options{Trendline: express.OLS})
+func Scatter(x, y interface{}, opt ...Options) *grob.Fig {
+	opts := computeOptions(cartesianOptions("y"), opt...)
+	fig := figure(&grob.Scatter{
+		Type:          grob.TraceTypeScatter,
+		Mode:          grob.ScatterModeMarkers,
+		X:             x,
+		Y:             y,
+		Name:          opts.Name,
+		Hovertemplate: hover(opts, fmt.Sprintf("%s=%%{x}<br>%s=%%{y}", opts.XTitle, opts.YTitle)),
+		Marker: &grob.ScatterMarker{
+			Color: opts.Color,
+		},
+	}, opts)
+	if opts.Trendline != "" {
+		addTrendline(fig, x, y, opts)
+	}
+	return fig
+}
+
+// addTrendline fits the points and adds the line of the fitted values to the figure
+func addTrendline(fig *grob.Fig, x, y interface{}, opts Options) {
+	var fit Fit
+	var err error
+	var title string
+	switch opts.Trendline {
+	case OLS:
+		fit, err = FitOLS(floats(x), floats(y))
+		title = fmt.Sprintf("<b>OLS trendline</b><br>%s = %g * %s + %g<br>R<sup>2</sup>=%f", opts.YTitle, fit.Slope, opts.XTitle, fit.Intercept, fit.RSquared)
+	case Lowess:
+		frac := opts.LowessFrac
+		if frac == 0 {
+			frac = DefaultLowessFrac
+		}
+		fit, err = FitLowess(floats(x), floats(y), frac)
+		title = "<b>LOWESS trendline</b>"
+	default:
+		return
+	}
+	if err != nil {
+		return
+	}
+	fig.AddTraces(&grob.Scatter{
+		Type:          grob.TraceTypeScatter,
+		Mode:          grob.ScatterModeLines,
+		X:             fit.X,
+		Y:             fit.Y,
+		Name:          opts.Name,
+		Hovertemplate: fmt.Sprintf("%s<br><br>%s=%%{x}<br>%s=%%{y} <b>(trend)</b><extra></extra>", title, opts.XTitle, opts.YTitle),
+		Line: &grob.ScatterLine{
+			Color: opts.Color,
+		},
+	})
+	fig.Layout.Meta = map[string]interface{}{
+		"trendline": fit,
+	}
+}
+
+// Bar plots a bar of height y at each x
+func Bar(x, y interface{}, opt ...Options) *grob.Fig {
+	opts := computeOptions(cartesianOptions("y"), opt...)
+	return figure(&grob.Bar{
+		Type:          grob.TraceTypeBar,
+		X:             x,
+		Y:             y,
+		Name:          opts.Name,
+		Hovertemplate: hover(opts, fmt.Sprintf("%s=%%{x}<br>%s=%%{y}", opts.XTitle, opts.YTitle)),
+		Marker: &grob.BarMarker{
+			Color: opts.Color,
+		},
+	}, opts)
+}
+
+// Histogram counts the occurrences of the x values in automatically sized bins
+func Histogram(x interface{}, opt ...Options) *grob.Fig {
+	opts := computeOptions(cartesianOptions("count"), opt...)
+	return figure(&grob.Histogram{
+		Type:          grob.TraceTypeHistogram,
+		X:             x,
+		Name:          opts.Name,
+		Hovertemplate: hover(opts, fmt.Sprintf("%s=%%{x}<br>%s=%%{y}", opts.XTitle, opts.YTitle)),
+		Marker: &grob.HistogramMarker{
+			Color: opts.Color,
+		},
+	}, opts)
+}
+
+// Box summarizes the distribution of the y values with its quartiles and outliers
+func Box(y interface{}, opt ...Options) *grob.Fig {
+	opts := computeOptions(cartesianOptions("y"), opt...)
+	return figure(&grob.Box{
+		Type:      grob.TraceTypeBox,
+		Y:         y,
+		Name:      opts.Name,
+		Boxpoints: grob.BoxBoxpointsOutliers,
+		Marker: &grob.BoxMarker{
+			Color: opts.Color,
+		},
+	}, opts)
+}
+
+// Heatmap displays the z matrix, given as rows of values, as colored cells
+func Heatmap(z interface{}, opt ...Options) *grob.Fig {
+	opts := computeOptions(Options{
+		XTitle: "x",
+		YTitle: "y",
+		Color:  DefaultColorscale,
+	}, opt...)
+	return figure(&grob.Heatmap{
+		Type:          grob.TraceTypeHeatmap,
+		Z:             z,
+		Name:          opts.Name,
+		Colorscale:    opts.Color,
+		Hovertemplate: hover(opts, fmt.Sprintf("%s=%%{x}<br>%s=%%{y}<br>z=%%{z}", opts.XTitle, opts.YTitle)),
+	}, opts)
+}
+
+// Pie displays the share of each value in the total, the slices are named after the labels
+func Pie(labels, values interface{}, opt ...Options) *grob.Fig {
+	opts := computeOptions(Options{
+		Color: themes.PlotlyColorway,
+	}, opt...)
+	return figure(&grob.Pie{
+		Type:          grob.TraceTypePie,
+		Labels:        labels,
+		Values:        values,
+		Name:          opts.Name,
+		Hovertemplate: hover(opts, "%{label}<br>%{value} (%{percent})"),
+		Marker: &grob.PieMarker{
+			Colors: opts.Color,
+		},
+	}, opts)
+}
+
+// cartesianOptions are the default options of charts with x and y axes
+func cartesianOptions(yTitle string) Options {
+	return Options{
+		XTitle: "x",
+		YTitle: yTitle,
+		Color:  themes.PlotlyColorway[0],
+	}
+}
+
+// hover completes the hover template, the secondary box with the trace name is only displayed if the trace has a name
+func hover(opts Options, template string) string {
+	if opts.Name == "" {
+		return template + "<extra></extra>"
+	}
+	return template
+}
+
+// figure wraps the trace with the layout given by the options
+func figure(trace grob.Trace, opts Options) *grob.Fig {
+	layout := &grob.Layout{
+		Hovermode: grob.LayoutHovermodeClosest,
+	}
+	if opts.Title != "" {
+		layout.Title = &grob.LayoutTitle{
+			Text: opts.Title,
+		}
+	}
+	if opts.Template != nil {
+		layout.Template = opts.Template
+	}
+	switch trace.(type) {
+	case *grob.Pie, *grob.Funnelarea:
+		// pies and funnel areas use the legend for the slices
+	case *grob.Scatterpolar, *grob.Barpolar, *grob.Scatterternary, *grob.Table, *grob.Indicator:
+		// polar and ternary subplots have their own axes, set by the callers, and tables and indicators have none
+		layout.Showlegend = grob.False
+	default:
+		// the legend of a single trace is not useful
+		layout.Showlegend = grob.False
+		layout.Xaxis = &grob.LayoutXaxis{
+			Title: &grob.LayoutXaxisTitle{
+				Text: opts.XTitle,
+			},
+		}
+		layout.Yaxis = &grob.LayoutYaxis{
+			Title: &grob.LayoutYaxisTitle{
+				Text: opts.YTitle,
+			},
+		}
+	}
+	fig := &grob.Fig{
+		Data:   grob.Traces{trace},
+		Layout: layout,
+	}
+	if opts.WebGLThreshold > 0 {
+		fig.OptimizeWebGL(opts.WebGLThreshold)
+	}
+	return fig
+}
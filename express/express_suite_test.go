@@ -0,0 +1,13 @@
+package express_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestExpress(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Express Suite")
+}
@@ -0,0 +1,116 @@
+package express_test
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/MetalBlueberry/go-plotly/express"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/themes"
+)
+
+var _ = Describe("Express", func() {
+
+	It("Should build a line chart with titles and hover labels", func() {
+		fig := express.Line([]int{1, 2}, []float64{3, 4}, express.Options{
+			Title:  "Temperature",
+			XTitle: "day",
+			YTitle: "°C",
+		})
+
+		Expect(fig.Data).To(HaveLen(1))
+		line := fig.Data[0].(*grob.Scatter)
+		Expect(line.Type).To(Equal(grob.TraceTypeScatter))
+		Expect(line.Mode).To(Equal(grob.ScatterModeLines))
+		Expect(line.Line.Color).To(Equal(themes.PlotlyColorway[0]))
+		Expect(line.Hovertemplate).To(Equal("day=%{x}<br>°C=%{y}<extra></extra>"))
+
+		Expect(fig.Layout.Title.Text).To(Equal("Temperature"))
+		Expect(fig.Layout.Xaxis.Title.Text).To(Equal("day"))
+		Expect(fig.Layout.Yaxis.Title.Text).To(Equal("°C"))
+		Expect(fig.Layout.Showlegend).To(Equal(grob.False))
+	})
+
+	It("Should keep the trace name in the hover label", func() {
+		fig := express.Bar([]string{"a"}, []int{1}, express.Options{Name: "sales", Color: "red"})
+		bar := fig.Data[0].(*grob.Bar)
+		Expect(bar.Name).To(Equal("sales"))
+		Expect(bar.Marker.Color).To(Equal("red"))
+		Expect(bar.Hovertemplate).To(Equal("x=%{x}<br>y=%{y}"))
+	})
+
+	It("Should count the values of histograms", func() {
+		fig := express.Histogram([]float64{1, 1, 2})
+		Expect(fig.Data[0].(*grob.Histogram).X).To(Equal([]float64{1, 1, 2}))
+		Expect(fig.Layout.Yaxis.Title.Text).To(Equal("count"))
+	})
+
+	It("Should show the outliers of box plots", func() {
+		fig := express.Box([]float64{1, 2, 30})
+		Expect(fig.Data[0].(*grob.Box).Boxpoints).To(Equal(grob.BoxBoxpointsOutliers))
+	})
+
+	It("Should use a colorscale for heatmaps", func() {
+		fig := express.Heatmap([][]float64{{1, 2}, {3, 4}})
+		heatmap := fig.Data[0].(*grob.Heatmap)
+		Expect(heatmap.Colorscale).To(Equal(express.DefaultColorscale))
+		Expect(heatmap.Hovertemplate).To(ContainSubstring("z=%{z}"))
+	})
+
+	It("Should keep the legend and skip the axes of pies", func() {
+		fig := express.Pie([]string{"a", "b"}, []int{1, 2}, express.Options{Template: themes.Dark})
+		pie := fig.Data[0].(*grob.Pie)
+		Expect(pie.Marker.Colors).To(Equal(themes.PlotlyColorway))
+		Expect(fig.Layout.Showlegend).To(BeNil())
+		Expect(fig.Layout.Xaxis).To(BeNil())
+		Expect(fig.Layout.Template).To(Equal(themes.Dark))
+	})
+
+	It("Should draw large series with WebGL", func() {
+		fig := express.Line([]int{1, 2, 3}, []int{1, 2, 3}, express.Options{WebGLThreshold: 2, YTitle: "value"})
+		line := fig.Data[0].(*grob.Scattergl)
+		Expect(line.Mode).To(Equal(grob.ScatterglModeLines))
+		Expect(line.Hovertemplate).To(Equal("x=%{x}<br>value=%{y}<extra></extra>"))
+
+		fig = express.Line([]int{1, 2, 3}, []int{1, 2, 3}, express.Options{WebGLThreshold: 3})
+		Expect(fig.Data[0]).To(BeAssignableToTypeOf(&grob.Scatter{}))
+	})
+})
+
+var _ = Describe("TimeSeries", func() {
+
+	It("Should plot the series sorted by name with a range slider", func() {
+		t := []time.Time{
+			time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+		}
+		fig := express.TimeSeries(t, map[string][]float64{
+			"temperature": {20, 21},
+			"humidity":    {60, 65},
+		}, express.Options{Title: "Weather"})
+
+		Expect(fig.Data).To(HaveLen(2))
+		Expect(fig.Data[0].(*grob.Scatter).Name).To(Equal("humidity"))
+		Expect(fig.Data[1].(*grob.Scatter).Name).To(Equal("temperature"))
+		Expect(fig.Data[1].(*grob.Scatter).X).To(Equal(t))
+
+		Expect(fig.Layout.Title.Text).To(Equal("Weather"))
+		Expect(fig.Layout.Xaxis.Type).To(Equal(grob.LayoutXaxisTypeDate))
+		Expect(fig.Layout.Xaxis.Rangeslider.Visible).To(Equal(grob.True))
+		Expect(fig.Layout.Xaxis.Rangeselector.Buttons).To(Equal(express.DefaultRangeButtons))
+
+		figBytes, err := json.Marshal(fig)
+		Expect(err).To(BeNil())
+		Expect(string(figBytes)).To(ContainSubstring(`"x":["2021-01-01T00:00:00Z","2021-01-02T00:00:00Z"]`))
+		Expect(string(figBytes)).To(ContainSubstring(`{"count":1,"label":"YTD","step":"year","stepmode":"todate"}`))
+	})
+
+	It("Should draw long series with WebGL", func() {
+		t := []time.Time{time.Now(), time.Now()}
+		fig := express.TimeSeries(t, map[string][]float64{"a": {1, 2}}, express.Options{WebGLThreshold: 1})
+		Expect(fig.Data[0].(*grob.Scattergl).X).To(Equal(t))
+	})
+})
@@ -0,0 +1,118 @@
+package express
+
+import (
+	"fmt"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/themes"
+)
+
+// StagePercents returns the fraction of the initial value and of the previous value of every stage of a funnel.
+// The fractions are nil if the initial or previous value is 0
+func StagePercents(values []float64) (initial, previous []interface{}) {
+	initial = make([]interface{}, len(values))
+	previous = make([]interface{}, len(values))
+	for i, value := range values {
+		if values[0] != 0 {
+			initial[i] = value / values[0]
+		}
+		switch {
+		case i == 0:
+			previous[i] = 1.0
+		case values[i-1] != 0:
+			previous[i] = value / values[i-1]
+		}
+	}
+	return initial, previous
+}
+
+// stageTexts returns the text of every stage with its percent of the initial and of the previous stage, and the fractions
+// as custom data for the hover labels
+func stageTexts(values []float64) ([]string, [][]interface{}) {
+	initial, previous := StagePercents(values)
+	texts := make([]string, len(values))
+	customdata := make([][]interface{}, len(values))
+	for i := range values {
+		customdata[i] = []interface{}{initial[i], previous[i]}
+		if i == 0 {
+			continue
+		}
+		text := ""
+		if initial[i] != nil {
+			text = fmt.Sprintf("%.1f%% of initial", 100*initial[i].(float64))
+		}
+		if previous[i] != nil {
+			if text != "" {
+				text += ", "
+			}
+			text += fmt.Sprintf("%.1f%% of previous", 100*previous[i].(float64))
+		}
+		texts[i] = text
+	}
+	return texts, customdata
+}
+
+// Funnel plots the stages, in order from the top, as centered bars as wide as their values. The bars display the value and its percent
+// of the initial and of the previous stage, computed in Go.
+//
+// It fails if stages and values have different lengths.
+//
+//	fig, err := express.Funnel([]string{"Visits", "Sign ups", "Orders"}, []float64{1000, 300, 120})
+func Funnel(stages []string, values []float64, opt ...Options) (*grob.Fig, error) {
+	if len(stages) != len(values) {
+		return nil, fmt.Errorf("%d stages given for %d values", len(stages), len(values))
+	}
+	opts := computeOptions(Options{
+		Color: themes.PlotlyColorway[0],
+	}, opt...)
+	texts, customdata := stageTexts(values)
+	fig := figure(&grob.Funnel{
+		Type:          grob.TraceTypeFunnel,
+		Y:             stages,
+		X:             values,
+		Name:          opts.Name,
+		Text:          texts,
+		Customdata:    customdata,
+		Texttemplate:  "%{x}<br>%{text}",
+		Textposition:  grob.FunnelTextpositionInside,
+		Hovertemplate: hover(opts, "%{y}<br>%{x}<br>%{customdata[0]:.1%} of initial<br>%{customdata[1]:.1%} of previous"),
+		Marker: &grob.FunnelMarker{
+			Color: opts.Color,
+		},
+	}, opts)
+	if opts.XTitle == "" {
+		fig.Layout.Xaxis = nil
+	}
+	if opts.YTitle == "" {
+		fig.Layout.Yaxis = nil
+	}
+	return fig, nil
+}
+
+// FunnelArea plots the stages, in order from the top, as the slices of a funnel of constant height, like a pie chart.
+// The slices display the stage, its value and its percent of the initial and of the previous stage, computed in Go.
+// The Color option is the list of colors of the slices.
+//
+// It fails if stages and values have different lengths.
+func FunnelArea(stages []string, values []float64, opt ...Options) (*grob.Fig, error) {
+	if len(stages) != len(values) {
+		return nil, fmt.Errorf("%d stages given for %d values", len(stages), len(values))
+	}
+	opts := computeOptions(Options{
+		Color: themes.PlotlyColorway,
+	}, opt...)
+	texts, customdata := stageTexts(values)
+	return figure(&grob.Funnelarea{
+		Type:          grob.TraceTypeFunnelarea,
+		Labels:        stages,
+		Values:        values,
+		Name:          opts.Name,
+		Text:          texts,
+		Customdata:    customdata,
+		Texttemplate:  "%{label}<br>%{value}<br>%{text}",
+		Hovertemplate: hover(opts, "%{label}<br>%{value}<br>%{customdata[0]:.1%} of initial<br>%{customdata[1]:.1%} of previous"),
+		Marker: &grob.FunnelareaMarker{
+			Colors: opts.Color,
+		},
+	}, opts), nil
+}
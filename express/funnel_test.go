@@ -0,0 +1,59 @@
+package express_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/MetalBlueberry/go-plotly/express"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/themes"
+)
+
+var _ = Describe("Funnel", func() {
+
+	stages := []string{"Visits", "Sign ups", "Orders"}
+	values := []float64{1000, 400, 100}
+
+	It("Should compute the percents of the initial and previous stages", func() {
+		initial, previous := express.StagePercents([]float64{200, 100, 0, 0})
+		Expect(initial).To(Equal([]interface{}{1.0, 0.5, 0.0, 0.0}))
+		Expect(previous).To(Equal([]interface{}{1.0, 0.5, 0.0, nil}))
+	})
+
+	It("Should display the percents on the bars", func() {
+		fig, err := express.Funnel(stages, values)
+		Expect(err).To(BeNil())
+		trace := fig.Data[0].(*grob.Funnel)
+		Expect(trace.Type).To(Equal(grob.TraceTypeFunnel))
+		Expect(trace.Y).To(Equal(stages))
+		Expect(trace.X).To(Equal(values))
+		Expect(trace.Text).To(Equal([]string{"", "40.0% of initial, 40.0% of previous", "10.0% of initial, 25.0% of previous"}))
+		Expect(trace.Customdata).To(Equal([][]interface{}{{1.0, 1.0}, {0.4, 0.4}, {0.1, 0.25}}))
+		Expect(trace.Marker.Color).To(Equal(themes.PlotlyColorway[0]))
+		Expect(fig.Layout.Xaxis).To(BeNil())
+
+		_, err = json.Marshal(fig)
+		Expect(err).To(BeNil())
+	})
+
+	It("Should build a funnel area with a color per stage", func() {
+		fig, err := express.FunnelArea(stages, values, express.Options{Title: "Conversion"})
+		Expect(err).To(BeNil())
+		trace := fig.Data[0].(*grob.Funnelarea)
+		Expect(trace.Type).To(Equal(grob.TraceTypeFunnelarea))
+		Expect(trace.Labels).To(Equal(stages))
+		Expect(trace.Marker.Colors).To(Equal(themes.PlotlyColorway))
+		Expect(trace.Texttemplate).To(Equal("%{label}<br>%{value}<br>%{text}"))
+		Expect(fig.Layout.Showlegend).To(BeNil())
+		Expect(fig.Layout.Title.Text).To(Equal("Conversion"))
+	})
+
+	It("Should fail if the stages do not match the values", func() {
+		_, err := express.Funnel([]string{"a"}, nil)
+		Expect(err).To(MatchError("1 stages given for 0 values"))
+		_, err = express.FunnelArea(nil, []float64{1})
+		Expect(err).To(MatchError("0 stages given for 1 values"))
+	})
+})
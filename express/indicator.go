@@ -0,0 +1,131 @@
+package express
+
+import (
+	"fmt"
+	"sort"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/themes"
+)
+
+// Threshold is the upper limit of a band of a gauge, the band starts at the previous threshold or at the min of the gauge
+type Threshold struct {
+	Value float64
+	Color string
+}
+
+// Delta is the difference between a KPI and its reference
+type Delta string
+
+const (
+	// NoDelta only displays the value
+	NoDelta Delta = ""
+	// AbsoluteDelta displays value - reference
+	AbsoluteDelta Delta = "absolute"
+	// RelativeDelta displays (value - reference) / reference as a percentage
+	RelativeDelta Delta = "relative"
+)
+
+// Gauge displays the value on an angular gauge from rng[0] to rng[1], for dashboard KPI tiles. The thresholds color the bands
+// of the gauge, in increasing order, and the Target option draws a line across the gauge. The title of the figure is displayed
+// above the gauge and the Color option is the color of the bar of the value.
+//
+// The generated types omit the numbers equal to 0, so a value or a target of 0 is not displayed.
+//
+//	fig := express.Gauge(cpu, [2]float64{0, 100}, []express.Threshold{{60, "#d9f2d9"}, {85, "#fff2cc"}, {100, "#f8cbad"}})
+func Gauge(value float64, rng [2]float64, thresholds []Threshold, opt ...Options) *grob.Fig {
+	opts := computeOptions(Options{
+		Color: themes.PlotlyColorway[0],
+	}, opt...)
+
+	sorted := append([]Threshold{}, thresholds...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Value < sorted[j].Value })
+	steps := make([]map[string]interface{}, len(sorted))
+	start := rng[0]
+	for i, threshold := range sorted {
+		steps[i] = map[string]interface{}{
+			"range": []float64{start, threshold.Value},
+			"color": threshold.Color,
+		}
+		start = threshold.Value
+	}
+
+	trace := &grob.Indicator{
+		Type:  grob.TraceTypeIndicator,
+		Mode:  grob.IndicatorModeGauge + "+" + grob.IndicatorModeNumber,
+		Value: value,
+		Name:  opts.Name,
+		Gauge: &grob.IndicatorGauge{
+			Shape: grob.IndicatorGaugeShapeAngular,
+			Axis: &grob.IndicatorGaugeAxis{
+				Range: []float64{rng[0], rng[1]},
+			},
+			Bar: &grob.IndicatorGaugeBar{
+				Color: opts.Color,
+			},
+			Steps: steps,
+		},
+	}
+	if opts.Target != 0 {
+		trace.Gauge.Threshold = &grob.IndicatorGaugeThreshold{
+			Value:     opts.Target,
+			Thickness: 0.75,
+			Line: &grob.IndicatorGaugeThresholdLine{
+				Color: "red",
+				Width: 4,
+			},
+		}
+	}
+	return indicator(trace, opts)
+}
+
+// KPI displays the value as a big number with its difference to the reference ref, in green if it increased or red otherwise,
+// for dashboard KPI tiles. The title of the figure is displayed above the number.
+//
+// The generated types omit the numbers equal to 0, so a value or a reference of 0 is not displayed.
+// It fails if the delta is unknown.
+//
+//	fig, err := express.KPI(revenue, express.RelativeDelta, lastMonth, express.Options{Title: "Revenue"})
+func KPI(value float64, delta Delta, ref float64, opt ...Options) (*grob.Fig, error) {
+	opts := computeOptions(Options{}, opt...)
+	trace := &grob.Indicator{
+		Type:  grob.TraceTypeIndicator,
+		Mode:  grob.IndicatorModeNumber,
+		Value: value,
+		Name:  opts.Name,
+	}
+	if opts.Color != nil {
+		trace.Number = &grob.IndicatorNumber{
+			Font: &grob.IndicatorNumberFont{
+				Color: opts.Color,
+			},
+		}
+	}
+	switch delta {
+	case NoDelta:
+	case AbsoluteDelta, RelativeDelta:
+		trace.Mode += "+" + grob.IndicatorModeDelta
+		trace.Delta = &grob.IndicatorDelta{
+			Reference: ref,
+			Position:  grob.IndicatorDeltaPositionBottom,
+		}
+		if delta == RelativeDelta {
+			trace.Delta.Relative = grob.True
+			trace.Delta.Valueformat = ".1%"
+		}
+	default:
+		return nil, fmt.Errorf("unknown delta %s", delta)
+	}
+	return indicator(trace, opts), nil
+}
+
+// indicator returns the figure of the indicator, the title of the figure is the title of the indicator
+func indicator(trace *grob.Indicator, opts Options) *grob.Fig {
+	if opts.Title != "" {
+		trace.Title = &grob.IndicatorTitle{
+			Text: opts.Title,
+		}
+		opts.Title = ""
+	}
+	return figure(trace, opts)
+}
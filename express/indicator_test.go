@@ -0,0 +1,71 @@
+package express_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/MetalBlueberry/go-plotly/express"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/themes"
+)
+
+var _ = Describe("Indicator", func() {
+
+	It("Should color the bands of the gauge", func() {
+		fig := express.Gauge(72, [2]float64{0, 100}, []express.Threshold{{100, "red"}, {60, "green"}, {85, "yellow"}},
+			express.Options{Title: "CPU", Target: 90})
+		trace := fig.Data[0].(*grob.Indicator)
+		Expect(trace.Type).To(Equal(grob.TraceTypeIndicator))
+		Expect(trace.Mode).To(Equal(grob.IndicatorMode("gauge+number")))
+		Expect(trace.Value).To(Equal(72.0))
+		Expect(trace.Title.Text).To(Equal("CPU"))
+		Expect(trace.Gauge.Axis.Range).To(Equal([]float64{0, 100}))
+		Expect(trace.Gauge.Bar.Color).To(Equal(themes.PlotlyColorway[0]))
+		Expect(trace.Gauge.Steps).To(Equal([]map[string]interface{}{
+			{"range": []float64{0, 60}, "color": "green"},
+			{"range": []float64{60, 85}, "color": "yellow"},
+			{"range": []float64{85, 100}, "color": "red"},
+		}))
+		Expect(trace.Gauge.Threshold.Value).To(Equal(90.0))
+		Expect(fig.Layout.Title).To(BeNil())
+		Expect(fig.Layout.Xaxis).To(BeNil())
+
+		_, err := json.Marshal(fig)
+		Expect(err).To(BeNil())
+	})
+
+	It("Should not draw a target by default", func() {
+		fig := express.Gauge(5, [2]float64{0, 10}, nil)
+		Expect(fig.Data[0].(*grob.Indicator).Gauge.Threshold).To(BeNil())
+	})
+
+	It("Should display the relative delta of the KPI", func() {
+		fig, err := express.KPI(120, express.RelativeDelta, 100, express.Options{Title: "Revenue"})
+		Expect(err).To(BeNil())
+		trace := fig.Data[0].(*grob.Indicator)
+		Expect(trace.Mode).To(Equal(grob.IndicatorMode("number+delta")))
+		Expect(trace.Delta.Reference).To(Equal(100.0))
+		Expect(trace.Delta.Relative).To(Equal(grob.True))
+		Expect(trace.Delta.Valueformat).To(Equal(".1%"))
+		Expect(trace.Title.Text).To(Equal("Revenue"))
+	})
+
+	It("Should display the absolute delta or only the number", func() {
+		fig, err := express.KPI(120, express.AbsoluteDelta, 100)
+		Expect(err).To(BeNil())
+		trace := fig.Data[0].(*grob.Indicator)
+		Expect(trace.Delta.Relative).To(BeNil())
+
+		fig, err = express.KPI(120, express.NoDelta, 100, express.Options{Color: "grey"})
+		Expect(err).To(BeNil())
+		trace = fig.Data[0].(*grob.Indicator)
+		Expect(trace.Mode).To(Equal(grob.IndicatorModeNumber))
+		Expect(trace.Delta).To(BeNil())
+		Expect(trace.Number.Font.Color).To(Equal("grey"))
+
+		_, err = express.KPI(1, "percent", 2)
+		Expect(err).To(MatchError("unknown delta percent"))
+	})
+})
@@ -0,0 +1,198 @@
+package express
+
+import (
+	"fmt"
+	"sort"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/themes"
+)
+
+// CompassDirections are the 16 points of the compass, clockwise from north, in the order of the sectors of WindRose
+var CompassDirections = []string{"N", "NNE", "NE", "ENE", "E", "ESE", "SE", "SSE", "S", "SSW", "SW", "WSW", "W", "WNW", "NW", "NNW"}
+
+// Polar plots the points at radius r and angle theta, in degrees, joined by lines.
+// The angles go clockwise from north like a compass, the XTitle option names theta and the YTitle option names r in the hover label.
+func Polar(r, theta interface{}, opt ...Options) *grob.Fig {
+	opts := computeOptions(polarOptions(), opt...)
+	fig := figure(&grob.Scatterpolar{
+		Type:          grob.TraceTypeScatterpolar,
+		Mode:          grob.ScatterpolarModeLines,
+		R:             r,
+		Theta:         theta,
+		Name:          opts.Name,
+		Hovertemplate: hover(opts, fmt.Sprintf("%s=%%{theta}<br>%s=%%{r}", opts.XTitle, opts.YTitle)),
+		Line: &grob.ScatterpolarLine{
+			Color: opts.Color,
+		},
+	}, opts)
+	fig.Layout.Polar = compass(nil)
+	return fig
+}
+
+// Radar plots a radar chart, a closed and filled line with an axis per value, the axes are sorted by name.
+// The radial axis starts at 0 so the areas can be compared.
+//
+//	fig := express.Radar(map[string]float64{"capacity": 0.9, "power": 0.6, "cost": 0.4, "lifetime": 0.8})
+func Radar(values map[string]float64, opt ...Options) *grob.Fig {
+	opts := computeOptions(polarOptions(), opt...)
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// the first point is repeated to close the line
+	theta := make([]string, 0, len(names)+1)
+	r := make([]float64, 0, len(names)+1)
+	for _, name := range names {
+		theta = append(theta, name)
+		r = append(r, values[name])
+	}
+	if len(names) > 0 {
+		theta = append(theta, names[0])
+		r = append(r, values[names[0]])
+	}
+
+	fig := figure(&grob.Scatterpolar{
+		Type:          grob.TraceTypeScatterpolar,
+		Mode:          grob.ScatterpolarModeLines + "+" + grob.ScatterpolarModeMarkers,
+		R:             r,
+		Theta:         theta,
+		Fill:          grob.ScatterpolarFillToself,
+		Name:          opts.Name,
+		Hovertemplate: hover(opts, "%{theta}=%{r}"),
+		Line: &grob.ScatterpolarLine{
+			Color: opts.Color,
+		},
+		Marker: &grob.ScatterpolarMarker{
+			Color: opts.Color,
+		},
+	}, opts)
+	fig.Layout.Polar = compass(names)
+	return fig
+}
+
+// WindRose plots the frequency of every direction of the compass as a bar, such as the share of the time the wind blows from it.
+// The directions are the names of CompassDirections, in any subset, and they are placed clockwise from north.
+// Other names are placed after them, sorted by name.
+//
+//	fig := express.WindRose(map[string]float64{"N": 12, "E": 30, "S": 8, "W": 50})
+func WindRose(frequencies map[string]float64, opt ...Options) *grob.Fig {
+	opts := computeOptions(Options{
+		XTitle: "direction",
+		YTitle: "frequency",
+		Color:  themes.PlotlyColorway[0],
+	}, opt...)
+	directions := make([]string, 0, len(frequencies))
+	for _, direction := range CompassDirections {
+		if _, ok := frequencies[direction]; ok {
+			directions = append(directions, direction)
+		}
+	}
+	others := []string{}
+	for direction := range frequencies {
+		if compassIndex(direction) < 0 {
+			others = append(others, direction)
+		}
+	}
+	sort.Strings(others)
+	directions = append(directions, others...)
+
+	r := make([]float64, len(directions))
+	for i, direction := range directions {
+		r[i] = frequencies[direction]
+	}
+	fig := figure(&grob.Barpolar{
+		Type:          grob.TraceTypeBarpolar,
+		R:             r,
+		Theta:         directions,
+		Name:          opts.Name,
+		Hovertemplate: hover(opts, fmt.Sprintf("%s=%%{theta}<br>%s=%%{r}", opts.XTitle, opts.YTitle)),
+		Marker: &grob.BarpolarMarker{
+			Color: opts.Color,
+		},
+	}, opts)
+	fig.Layout.Polar = compass(directions)
+	return fig
+}
+
+// compassIndex returns the position of the direction in CompassDirections, or -1
+func compassIndex(direction string) int {
+	for i, d := range CompassDirections {
+		if d == direction {
+			return i
+		}
+	}
+	return -1
+}
+
+// polarOptions are the default options of charts with polar axes
+func polarOptions() Options {
+	return Options{
+		XTitle: "theta",
+		YTitle: "r",
+		Color:  themes.PlotlyColorway[0],
+	}
+}
+
+// compass returns a polar subplot whose angles go clockwise from the top, with the categories in the given order if any.
+// The radial axis starts at 0
+func compass(categories []string) *grob.LayoutPolar {
+	polar := &grob.LayoutPolar{
+		Angularaxis: &grob.LayoutPolarAngularaxis{
+			Direction: grob.LayoutPolarAngularaxisDirectionClockwise,
+			Rotation:  90,
+		},
+		Radialaxis: &grob.LayoutPolarRadialaxis{
+			Rangemode: grob.LayoutPolarRadialaxisRangemodeTozero,
+		},
+	}
+	if categories != nil {
+		polar.Angularaxis.Type = grob.LayoutPolarAngularaxisTypeCategory
+		polar.Angularaxis.Categoryorder = grob.LayoutPolarAngularaxisCategoryorderArray
+		polar.Angularaxis.Categoryarray = categories
+	}
+	return polar
+}
+
+// Ternary plots compositions of three components a, b and c with markers, such as the shares of the elements of an alloy.
+// Every point is normalized by plotly.js so its components add up to the Sum option, 1 by default, and the axes are named
+// after the ATitle, BTitle and CTitle options.
+//
+//	fig := express.Ternary(nickel, manganese, cobalt, express.Options{ATitle: "Ni", BTitle: "Mn", CTitle: "Co", Sum: 100})
+func Ternary(a, b, c interface{}, opt ...Options) *grob.Fig {
+	opts := computeOptions(Options{
+		ATitle: "a",
+		BTitle: "b",
+		CTitle: "c",
+		Sum:    1,
+		Color:  themes.PlotlyColorway[0],
+	}, opt...)
+	fig := figure(&grob.Scatterternary{
+		Type:          grob.TraceTypeScatterternary,
+		Mode:          grob.ScatterternaryModeMarkers,
+		A:             a,
+		B:             b,
+		C:             c,
+		Sum:           opts.Sum,
+		Name:          opts.Name,
+		Hovertemplate: hover(opts, fmt.Sprintf("%s=%%{a}<br>%s=%%{b}<br>%s=%%{c}", opts.ATitle, opts.BTitle, opts.CTitle)),
+		Marker: &grob.ScatterternaryMarker{
+			Color: opts.Color,
+		},
+	}, opts)
+	fig.Layout.Ternary = &grob.LayoutTernary{
+		Sum: opts.Sum,
+		Aaxis: &grob.LayoutTernaryAaxis{
+			Title: &grob.LayoutTernaryAaxisTitle{Text: opts.ATitle},
+		},
+		Baxis: &grob.LayoutTernaryBaxis{
+			Title: &grob.LayoutTernaryBaxisTitle{Text: opts.BTitle},
+		},
+		Caxis: &grob.LayoutTernaryCaxis{
+			Title: &grob.LayoutTernaryCaxisTitle{Text: opts.CTitle},
+		},
+	}
+	return fig
+}
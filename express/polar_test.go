@@ -0,0 +1,69 @@
+package express_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/MetalBlueberry/go-plotly/express"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var _ = Describe("Polar", func() {
+
+	It("Should plot lines on a compass", func() {
+		fig := express.Polar([]float64{1, 2, 3}, []float64{0, 90, 180}, express.Options{Title: "Heading"})
+		trace := fig.Data[0].(*grob.Scatterpolar)
+		Expect(trace.Type).To(Equal(grob.TraceTypeScatterpolar))
+		Expect(trace.Hovertemplate).To(Equal("theta=%{theta}<br>r=%{r}<extra></extra>"))
+		Expect(fig.Layout.Polar.Angularaxis.Direction).To(Equal(grob.LayoutPolarAngularaxisDirectionClockwise))
+		Expect(fig.Layout.Polar.Angularaxis.Rotation).To(Equal(90.0))
+		Expect(fig.Layout.Xaxis).To(BeNil())
+		Expect(fig.Layout.Title.Text).To(Equal("Heading"))
+	})
+
+	It("Should close and fill the radar chart", func() {
+		fig := express.Radar(map[string]float64{"power": 0.6, "capacity": 0.9, "cost": 0.4})
+		trace := fig.Data[0].(*grob.Scatterpolar)
+		Expect(trace.Theta).To(Equal([]string{"capacity", "cost", "power", "capacity"}))
+		Expect(trace.R).To(Equal([]float64{0.9, 0.4, 0.6, 0.9}))
+		Expect(trace.Fill).To(Equal(grob.ScatterpolarFillToself))
+		Expect(fig.Layout.Polar.Angularaxis.Categoryarray).To(Equal([]string{"capacity", "cost", "power"}))
+		Expect(fig.Layout.Polar.Radialaxis.Rangemode).To(Equal(grob.LayoutPolarRadialaxisRangemodeTozero))
+
+		data, err := json.Marshal(fig)
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(ContainSubstring(`"mode":"lines+markers"`))
+	})
+
+	It("Should order the wind rose like a compass", func() {
+		fig := express.WindRose(map[string]float64{"W": 50, "calm": 2, "N": 12, "SE": 8})
+		trace := fig.Data[0].(*grob.Barpolar)
+		Expect(trace.Type).To(Equal(grob.TraceTypeBarpolar))
+		Expect(trace.Theta).To(Equal([]string{"N", "SE", "W", "calm"}))
+		Expect(trace.R).To(Equal([]float64{12, 8, 50, 2}))
+		Expect(fig.Layout.Polar.Angularaxis.Categoryarray).To(Equal([]string{"N", "SE", "W", "calm"}))
+	})
+})
+
+var _ = Describe("Ternary", func() {
+
+	It("Should name the axes and set the sum", func() {
+		fig := express.Ternary([]float64{80}, []float64{10}, []float64{10}, express.Options{ATitle: "Ni", BTitle: "Mn", CTitle: "Co", Sum: 100})
+		trace := fig.Data[0].(*grob.Scatterternary)
+		Expect(trace.Type).To(Equal(grob.TraceTypeScatterternary))
+		Expect(trace.Sum).To(Equal(100.0))
+		Expect(trace.Hovertemplate).To(Equal("Ni=%{a}<br>Mn=%{b}<br>Co=%{c}<extra></extra>"))
+		Expect(fig.Layout.Ternary.Sum).To(Equal(100.0))
+		Expect(fig.Layout.Ternary.Aaxis.Title.Text).To(Equal("Ni"))
+		Expect(fig.Layout.Ternary.Caxis.Title.Text).To(Equal("Co"))
+		Expect(fig.Layout.Yaxis).To(BeNil())
+	})
+
+	It("Should default to fractions", func() {
+		fig := express.Ternary([]float64{0.5}, []float64{0.3}, []float64{0.2})
+		Expect(fig.Layout.Ternary.Sum).To(Equal(1.0))
+		Expect(fig.Layout.Ternary.Baxis.Title.Text).To(Equal("b"))
+	})
+})
@@ -0,0 +1,204 @@
+package express
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// DefaultDateFormat is the layout of the dates of Table
+const DefaultDateFormat = "2006-01-02 15:04:05"
+
+// TableOptions format the cells of TableWithOptions
+type TableOptions struct {
+	Options
+	// NumberFormat is the d3 format of the numbers, such as ",.2f". Defaults to the format of plotly.js
+	NumberFormat string
+	// Formats are the d3 formats of the numbers of some columns by name, they take precedence over NumberFormat
+	Formats map[string]string
+	// DateFormat is the Go layout of the time.Time values, defaults to DefaultDateFormat
+	DateFormat string
+}
+
+// Table builds a table of the rows, given as a slice of structs, of pointers to structs or of map[string]interface{}.
+// cols are the field names or the keys of the columns, in order. Without cols, the columns are the exported fields
+// not tagged plot:"-", named after the name option of their plot tag if any, or the sorted keys of all the maps.
+//
+// It fails if rows is not a slice of structs or maps, or if a column is not a field of the structs.
+//
+//	fig, err := express.Table(cells, "Serial", "Capacity", "Produced")
+func Table(rows interface{}, cols ...string) (*grob.Fig, error) {
+	return TableWithOptions(rows, TableOptions{}, cols...)
+}
+
+// TableWithOptions builds a table like Table with formatted cells. The numbers are formatted by plotly.js with the d3 formats
+// of the options and the dates are formatted in Go, since the tables of plotly.js do not format dates.
+//
+//	fig, err := express.TableWithOptions(cells, express.TableOptions{
+//		Options:      express.Options{Title: "Cells"},
+//		NumberFormat: ",.2f",
+//		Formats:      map[string]string{"Cycles": "d"},
+//	})
+func TableWithOptions(rows interface{}, opts TableOptions, cols ...string) (*grob.Fig, error) {
+	if opts.DateFormat == "" {
+		opts.DateFormat = DefaultDateFormat
+	}
+	headers, columns, err := tableColumns(rows, cols)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([][]interface{}, len(columns))
+	formats := make([]string, len(columns))
+	for i, column := range columns {
+		numeric := false
+		values[i] = make([]interface{}, len(column))
+		for j, value := range column {
+			switch v := value.(type) {
+			case time.Time:
+				values[i][j] = v.Format(opts.DateFormat)
+			case *time.Time:
+				if v != nil {
+					values[i][j] = v.Format(opts.DateFormat)
+				}
+			case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+				numeric = true
+				values[i][j] = v
+			default:
+				values[i][j] = v
+			}
+		}
+		if format, ok := opts.Formats[headers[i].key]; ok {
+			formats[i] = format
+		} else if numeric {
+			formats[i] = opts.NumberFormat
+		}
+	}
+
+	names := make([]string, len(headers))
+	for i, header := range headers {
+		names[i] = "<b>" + header.name + "</b>"
+	}
+	trace := &grob.Table{
+		Type: grob.TraceTypeTable,
+		Header: &grob.TableHeader{
+			Values: names,
+		},
+		Cells: &grob.TableCells{
+			Values: values,
+		},
+	}
+	for _, format := range formats {
+		if format != "" {
+			trace.Cells.Format = formats
+			break
+		}
+	}
+	return figure(trace, computeOptions(Options{}, opts.Options)), nil
+}
+
+// tableHeader is a column of a table
+type tableHeader struct {
+	// key is the name of the field or the key of the maps
+	key string
+	// name is displayed in the header
+	name string
+}
+
+// tableColumns returns the columns of the rows, as a list of values per column
+func tableColumns(rows interface{}, cols []string) ([]tableHeader, [][]interface{}, error) {
+	value := reflect.ValueOf(rows)
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return nil, nil, fmt.Errorf("cannot build a table from %T, it must be a slice of structs or maps", rows)
+	}
+	elem := value.Type().Elem()
+	if elem.Kind() == reflect.Ptr {
+		elem = elem.Elem()
+	}
+
+	headers := []tableHeader{}
+	for _, col := range cols {
+		headers = append(headers, tableHeader{key: col, name: col})
+	}
+	var cell func(row reflect.Value, key string) interface{}
+	switch {
+	case elem.Kind() == reflect.Struct:
+		names := map[string]string{}
+		exported := []string{}
+		for i := 0; i < elem.NumField(); i++ {
+			f := elem.Field(i)
+			tag := f.Tag.Get("plot")
+			if f.PkgPath != "" || tag == "-" {
+				continue
+			}
+			names[f.Name] = f.Name
+			for _, option := range strings.Split(tag, ",")[1:] {
+				if strings.HasPrefix(option, "name=") {
+					names[f.Name] = strings.TrimPrefix(option, "name=")
+				}
+			}
+			exported = append(exported, f.Name)
+		}
+		if cols == nil {
+			for _, name := range exported {
+				headers = append(headers, tableHeader{key: name, name: names[name]})
+			}
+		}
+		for i, header := range headers {
+			name, ok := names[header.key]
+			if !ok {
+				return nil, nil, fmt.Errorf("%s has no exported field %s", elem, header.key)
+			}
+			headers[i].name = name
+		}
+		cell = func(row reflect.Value, key string) interface{} {
+			return row.FieldByName(key).Interface()
+		}
+	case elem.Kind() == reflect.Map && elem.Key().Kind() == reflect.String:
+		if cols == nil {
+			keys := map[string]bool{}
+			for i := 0; i < value.Len(); i++ {
+				for _, key := range value.Index(i).MapKeys() {
+					keys[key.String()] = true
+				}
+			}
+			names := make([]string, 0, len(keys))
+			for key := range keys {
+				names = append(names, key)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				headers = append(headers, tableHeader{key: name, name: name})
+			}
+		}
+		cell = func(row reflect.Value, key string) interface{} {
+			v := row.MapIndex(reflect.ValueOf(key).Convert(elem.Key()))
+			if !v.IsValid() {
+				return nil
+			}
+			return v.Interface()
+		}
+	default:
+		return nil, nil, fmt.Errorf("cannot build a table from %T, it must be a slice of structs or maps", rows)
+	}
+
+	columns := make([][]interface{}, len(headers))
+	for i, header := range headers {
+		columns[i] = make([]interface{}, value.Len())
+		for j := range columns[i] {
+			row := value.Index(j)
+			if row.Kind() == reflect.Ptr {
+				if row.IsNil() {
+					continue
+				}
+				row = row.Elem()
+			}
+			columns[i][j] = cell(row, header.key)
+		}
+	}
+	return headers, columns, nil
+}
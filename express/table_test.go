@@ -0,0 +1,89 @@
+package express_test
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/MetalBlueberry/go-plotly/express"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+type tableCell struct {
+	Serial   string
+	Capacity float64 `plot:",name=Capacity (Ah)"`
+	Cycles   int
+	Produced time.Time
+	Internal string `plot:"-"`
+}
+
+var _ = Describe("Table", func() {
+
+	day := time.Date(2024, 3, 1, 8, 30, 0, 0, time.UTC)
+	cells := []*tableCell{
+		{Serial: "A1", Capacity: 4.95, Cycles: 800, Produced: day},
+		{Serial: "A2", Capacity: 5.1, Cycles: 1200, Produced: day.Add(time.Hour)},
+	}
+
+	It("Should build a column per exported field", func() {
+		fig, err := express.Table(cells)
+		Expect(err).To(BeNil())
+		trace := fig.Data[0].(*grob.Table)
+		Expect(trace.Type).To(Equal(grob.TraceTypeTable))
+		Expect(trace.Header.Values).To(Equal([]string{"<b>Serial</b>", "<b>Capacity (Ah)</b>", "<b>Cycles</b>", "<b>Produced</b>"}))
+		Expect(trace.Cells.Values).To(Equal([][]interface{}{
+			{"A1", "A2"},
+			{4.95, 5.1},
+			{800, 1200},
+			{"2024-03-01 08:30:00", "2024-03-01 09:30:00"},
+		}))
+		Expect(trace.Cells.Format).To(BeNil())
+		Expect(fig.Layout.Xaxis).To(BeNil())
+
+		_, err = json.Marshal(fig)
+		Expect(err).To(BeNil())
+	})
+
+	It("Should select the columns and format the cells", func() {
+		fig, err := express.TableWithOptions(cells, express.TableOptions{
+			Options:      express.Options{Title: "Cells"},
+			NumberFormat: ".1f",
+			Formats:      map[string]string{"Cycles": ","},
+			DateFormat:   "2 Jan",
+		}, "Produced", "Capacity", "Cycles")
+		Expect(err).To(BeNil())
+		trace := fig.Data[0].(*grob.Table)
+		Expect(trace.Header.Values).To(Equal([]string{"<b>Produced</b>", "<b>Capacity (Ah)</b>", "<b>Cycles</b>"}))
+		Expect(trace.Cells.Values.([][]interface{})[0]).To(Equal([]interface{}{"1 Mar", "1 Mar"}))
+		Expect(trace.Cells.Format).To(Equal([]string{"", ".1f", ","}))
+		Expect(fig.Layout.Title.Text).To(Equal("Cells"))
+	})
+
+	It("Should build a table of maps", func() {
+		rows := []map[string]interface{}{
+			{"name": "a", "value": 1.5},
+			{"name": "b", "unit": "V"},
+		}
+		fig, err := express.Table(rows)
+		Expect(err).To(BeNil())
+		trace := fig.Data[0].(*grob.Table)
+		Expect(trace.Header.Values).To(Equal([]string{"<b>name</b>", "<b>unit</b>", "<b>value</b>"}))
+		Expect(trace.Cells.Values).To(Equal([][]interface{}{{"a", "b"}, {nil, "V"}, {1.5, nil}}))
+
+		fig, err = express.Table(rows, "value")
+		Expect(err).To(BeNil())
+		trace = fig.Data[0].(*grob.Table)
+		Expect(trace.Cells.Values).To(Equal([][]interface{}{{1.5, nil}}))
+	})
+
+	It("Should fail on invalid rows", func() {
+		_, err := express.Table([]int{1})
+		Expect(err).To(MatchError("cannot build a table from []int, it must be a slice of structs or maps"))
+		_, err = express.Table(cells, "Internal")
+		Expect(err).To(MatchError("express_test.tableCell has no exported field Internal"))
+		_, err = express.Table(3)
+		Expect(err).To(MatchError("cannot build a table from int, it must be a slice of structs or maps"))
+	})
+})
@@ -0,0 +1,89 @@
+package express
+
+import (
+	"time"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/themes"
+)
+
+// Task is a bar of a Timeline, from Start to End
+type Task struct {
+	Name       string
+	Start, End time.Time
+	// Group colors the task, tasks of the same group share the same color and legend entry
+	Group string
+}
+
+// timelineFormat is the format of the dates of the hover labels of Timeline
+const timelineFormat = "2006-01-02 15:04"
+
+// Timeline plots the tasks as horizontal bars on a date axis, like a Gantt chart. There is a row per task name, in order of appearance
+// from the top, and a trace per group. Without groups, the bars have the Color option and there is no legend.
+//
+//	fig := express.Timeline([]express.Task{{Name: "design", Start: start, End: start.AddDate(0, 0, 5), Group: "alice"}})
+func Timeline(tasks []Task, opt ...Options) *grob.Fig {
+	opts := computeOptions(Options{
+		Color: themes.PlotlyColorway[0],
+	}, opt...)
+
+	groups := []string{}
+	byGroup := map[string]*grob.Bar{}
+	for _, task := range tasks {
+		bar, ok := byGroup[task.Group]
+		if !ok {
+			bar = &grob.Bar{
+				Type:          grob.TraceTypeBar,
+				Orientation:   grob.BarOrientationH,
+				X:             []float64{},
+				Y:             []string{},
+				Base:          []time.Time{},
+				Customdata:    [][]string{},
+				Hovertemplate: "%{y}<br>%{customdata[0]} - %{customdata[1]}<extra></extra>",
+			}
+			if task.Group != "" {
+				bar.Name = task.Group
+				bar.Hovertemplate = "%{y}<br>%{customdata[0]} - %{customdata[1]}<extra>%{fullData.name}</extra>"
+			}
+			byGroup[task.Group] = bar
+			groups = append(groups, task.Group)
+		}
+		// the length of bars on date axes is in milliseconds
+		bar.X = append(bar.X.([]float64), float64(task.End.Sub(task.Start).Milliseconds()))
+		bar.Y = append(bar.Y.([]string), task.Name)
+		bar.Base = append(bar.Base.([]time.Time), task.Start)
+		bar.Customdata = append(bar.Customdata.([][]string), []string{task.Start.Format(timelineFormat), task.End.Format(timelineFormat)})
+	}
+
+	traces := grob.Traces{}
+	for i, group := range groups {
+		color := opts.Color
+		if len(groups) > 1 || group != "" {
+			color = themes.PlotlyColorway[i%len(themes.PlotlyColorway)]
+		}
+		byGroup[group].Marker = &grob.BarMarker{
+			Color: color,
+		}
+		traces = append(traces, byGroup[group])
+	}
+	if len(traces) == 0 {
+		traces = append(traces, &grob.Bar{Type: grob.TraceTypeBar, Orientation: grob.BarOrientationH})
+	}
+
+	fig := figure(traces[0], opts)
+	fig.AddTraces(traces[1:]...)
+	fig.Layout.Barmode = grob.BarBarmodeOverlay
+	fig.Layout.Xaxis.Type = grob.LayoutXaxisTypeDate
+	fig.Layout.Yaxis.Type = grob.LayoutYaxisTypeCategory
+	fig.Layout.Yaxis.Autorange = grob.LayoutYaxisAutorangeReversed
+	if len(groups) > 1 || (len(groups) == 1 && groups[0] != "") {
+		fig.Layout.Showlegend = grob.True
+	}
+	if opts.XTitle == "" {
+		fig.Layout.Xaxis.Title = nil
+	}
+	if opts.YTitle == "" {
+		fig.Layout.Yaxis.Title = nil
+	}
+	return fig
+}
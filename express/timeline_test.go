@@ -0,0 +1,60 @@
+package express_test
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/MetalBlueberry/go-plotly/express"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/themes"
+)
+
+var _ = Describe("Timeline", func() {
+
+	start := time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	It("Should plot a trace per group with bars from start to end", func() {
+		fig := express.Timeline([]express.Task{
+			{Name: "design", Start: start, End: start.Add(48 * time.Hour), Group: "alice"},
+			{Name: "build", Start: start.Add(48 * time.Hour), End: start.Add(120 * time.Hour), Group: "bob"},
+			{Name: "test", Start: start.Add(96 * time.Hour), End: start.Add(144 * time.Hour), Group: "alice"},
+		}, express.Options{Title: "Plan"})
+
+		Expect(fig.Data).To(HaveLen(2))
+		alice := fig.Data[0].(*grob.Bar)
+		Expect(alice.Name).To(Equal("alice"))
+		Expect(alice.Orientation).To(Equal(grob.BarOrientationH))
+		Expect(alice.Y).To(Equal([]string{"design", "test"}))
+		Expect(alice.X).To(Equal([]float64{48 * 3600000, 48 * 3600000}))
+		Expect(alice.Base).To(Equal([]time.Time{start, start.Add(96 * time.Hour)}))
+		Expect(alice.Customdata).To(Equal([][]string{{"2021-03-01 00:00", "2021-03-03 00:00"}, {"2021-03-05 00:00", "2021-03-07 00:00"}}))
+		Expect(alice.Marker.Color).To(Equal(themes.PlotlyColorway[0]))
+		Expect(fig.Data[1].(*grob.Bar).Marker.Color).To(Equal(themes.PlotlyColorway[1]))
+
+		Expect(fig.Layout.Showlegend).To(Equal(grob.True))
+		Expect(fig.Layout.Barmode).To(Equal(grob.BarBarmodeOverlay))
+		Expect(fig.Layout.Xaxis.Type).To(Equal(grob.LayoutXaxisTypeDate))
+		Expect(fig.Layout.Xaxis.Title).To(BeNil())
+		Expect(fig.Layout.Yaxis.Type).To(Equal(grob.LayoutYaxisTypeCategory))
+		Expect(fig.Layout.Yaxis.Autorange).To(Equal(grob.LayoutYaxisAutorangeReversed))
+		Expect(fig.Layout.Title.Text).To(Equal("Plan"))
+
+		_, err := json.Marshal(fig)
+		Expect(err).To(BeNil())
+	})
+
+	It("Should use the color option without groups", func() {
+		fig := express.Timeline([]express.Task{{Name: "a", Start: start, End: start.Add(time.Hour)}}, express.Options{Color: "red"})
+		Expect(fig.Data).To(HaveLen(1))
+		Expect(fig.Data[0].(*grob.Bar).Marker.Color).To(Equal("red"))
+		Expect(fig.Layout.Showlegend).To(Equal(grob.False))
+	})
+
+	It("Should build an empty timeline", func() {
+		fig := express.Timeline(nil)
+		Expect(fig.Data).To(HaveLen(1))
+	})
+})
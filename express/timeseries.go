@@ -0,0 +1,99 @@
+package express
+
+import (
+	"sort"
+	"time"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// RangeButton is a button of the range selector of a date axis
+// https://plotly.com/javascript/reference/layout/xaxis/#layout-xaxis-rangeselector-buttons
+type RangeButton struct {
+	Count    int    `json:"count,omitempty"`
+	Label    string `json:"label,omitempty"`
+	Step     string `json:"step,omitempty"`
+	Stepmode string `json:"stepmode,omitempty"`
+}
+
+// DefaultRangeButtons select the last day, week or month, the current year or all the data
+var DefaultRangeButtons = []RangeButton{
+	{Count: 1, Label: "1d", Step: "day", Stepmode: "backward"},
+	{Count: 7, Label: "1w", Step: "day", Stepmode: "backward"},
+	{Count: 1, Label: "1m", Step: "month", Stepmode: "backward"},
+	{Count: 1, Label: "YTD", Step: "year", Stepmode: "todate"},
+	{Label: "all", Step: "all"},
+}
+
+// dateTickFormats show the relevant part of the dates depending on the zoom level, dtickrange is in milliseconds or months
+var dateTickFormats = []map[string]interface{}{
+	{"dtickrange": []interface{}{nil, 1000}, "value": "%H:%M:%S.%L"},
+	{"dtickrange": []interface{}{1000, 60000}, "value": "%H:%M:%S"},
+	{"dtickrange": []interface{}{60000, 86400000}, "value": "%H:%M\n%e %b"},
+	{"dtickrange": []interface{}{86400000, "M1"}, "value": "%e %b"},
+	{"dtickrange": []interface{}{"M1", "M12"}, "value": "%b %Y"},
+	{"dtickrange": []interface{}{"M12", nil}, "value": "%Y"},
+}
+
+// TimeSeries plots every series against the times t with lines, the series are sorted by name.
+// The x axis has a range slider and the DefaultRangeButtons, and the hover label displays all the series at the same time.
+func TimeSeries(t []time.Time, series map[string][]float64, opt ...Options) *grob.Fig {
+	opts := computeOptions(Options{}, opt...)
+
+	names := make([]string, 0, len(series))
+	for name := range series {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fig := &grob.Fig{}
+	for _, name := range names {
+		fig.AddTraces(&grob.Scatter{
+			Type:          grob.TraceTypeScatter,
+			Mode:          grob.ScatterModeLines,
+			Name:          name,
+			X:             t,
+			Y:             series[name],
+			Hovertemplate: "%{y}",
+		})
+	}
+
+	fig.Layout = &grob.Layout{
+		Hovermode: grob.LayoutHovermodeXUnified,
+		Xaxis: &grob.LayoutXaxis{
+			Type:            grob.LayoutXaxisTypeDate,
+			Tickformatstops: dateTickFormats,
+			Hoverformat:     "%Y-%m-%d %H:%M:%S",
+			Rangeslider: &grob.LayoutXaxisRangeslider{
+				Visible: grob.True,
+			},
+			Rangeselector: &grob.LayoutXaxisRangeselector{
+				Buttons: DefaultRangeButtons,
+			},
+		},
+	}
+	if opts.Title != "" {
+		fig.Layout.Title = &grob.LayoutTitle{
+			Text: opts.Title,
+		}
+	}
+	if opts.XTitle != "" {
+		fig.Layout.Xaxis.Title = &grob.LayoutXaxisTitle{
+			Text: opts.XTitle,
+		}
+	}
+	if opts.YTitle != "" {
+		fig.Layout.Yaxis = &grob.LayoutYaxis{
+			Title: &grob.LayoutYaxisTitle{
+				Text: opts.YTitle,
+			},
+		}
+	}
+	if opts.Template != nil {
+		fig.Layout.Template = opts.Template
+	}
+	if opts.WebGLThreshold > 0 {
+		fig.OptimizeWebGL(opts.WebGLThreshold)
+	}
+	return fig
+}
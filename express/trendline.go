@@ -0,0 +1,253 @@
+package express
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+)
+
+// Trendline is the regression drawn over scatter plots
+type Trendline string
+
+const (
+	// OLS is the ordinary least squares line y = slope * x + intercept
+	OLS Trendline = "ols"
+	// Lowess is the locally weighted smoothing of the points, it follows non linear trends
+	Lowess Trendline = "lowess"
+)
+
+// DefaultLowessFrac is the fraction of the points used by each local regression of Lowess, like in plotly express
+const DefaultLowessFrac = 2.0 / 3
+
+// lowessIterations is the number of robustifying iterations of Lowess, that reduce the weight of the outliers
+const lowessIterations = 3
+
+// Fit are the results of a trendline, Scatter stores them in the meta of the layout under the trendline key
+type Fit struct {
+	Method Trendline `json:"method"`
+	// Slope and Intercept of the OLS line
+	Slope     float64 `json:"slope"`
+	Intercept float64 `json:"intercept"`
+	// Frac is the fraction of the points used by each local regression of Lowess
+	Frac float64 `json:"frac,omitempty"`
+	// RSquared is the coefficient of determination of the fitted values
+	RSquared float64 `json:"rsquared"`
+	// N is the number of points of the fit
+	N int `json:"n"`
+	// X and Y are the fitted values, sorted by x
+	X []float64 `json:"-"`
+	Y []float64 `json:"-"`
+}
+
+// FitOLS fits the ordinary least squares line of the points. NaN values are ignored.
+func FitOLS(x, y []float64) (Fit, error) {
+	xs, ys, err := points(x, y)
+	if err != nil {
+		return Fit{}, err
+	}
+	n := float64(len(xs))
+	meanX, meanY := 0.0, 0.0
+	for i := range xs {
+		meanX += xs[i]
+		meanY += ys[i]
+	}
+	meanX /= n
+	meanY /= n
+	sxx, sxy := 0.0, 0.0
+	for i := range xs {
+		sxx += (xs[i] - meanX) * (xs[i] - meanX)
+		sxy += (xs[i] - meanX) * (ys[i] - meanY)
+	}
+	if sxx == 0 {
+		return Fit{}, fmt.Errorf("cannot fit a line, all the x values are equal")
+	}
+	fit := Fit{
+		Method:    OLS,
+		Slope:     sxy / sxx,
+		Intercept: meanY - sxy/sxx*meanX,
+		N:         len(xs),
+		X:         xs,
+		Y:         make([]float64, len(xs)),
+	}
+	for i := range xs {
+		fit.Y[i] = fit.Slope*xs[i] + fit.Intercept
+	}
+	fit.RSquared = rSquared(ys, fit.Y)
+	return fit, nil
+}
+
+// FitLowess smooths the points with locally weighted linear regressions, each one using frac of the points.
+// NaN values are ignored.
+func FitLowess(x, y []float64, frac float64) (Fit, error) {
+	if frac <= 0 || frac > 1 {
+		return Fit{}, fmt.Errorf("frac %g must be between 0 and 1", frac)
+	}
+	xs, ys, err := points(x, y)
+	if err != nil {
+		return Fit{}, err
+	}
+	n := len(xs)
+	k := int(math.Ceil(frac * float64(n)))
+	if k < 2 {
+		k = 2
+	}
+
+	fitted := make([]float64, n)
+	robustness := make([]float64, n)
+	for i := range robustness {
+		robustness[i] = 1
+	}
+	for iteration := 0; iteration <= lowessIterations; iteration++ {
+		for i := range xs {
+			fitted[i] = localRegression(xs, ys, robustness, i, k)
+		}
+		if iteration == lowessIterations {
+			break
+		}
+		// bisquare weights of the residuals, relative to 6 times their median
+		residuals := make([]float64, n)
+		for i := range xs {
+			residuals[i] = math.Abs(ys[i] - fitted[i])
+		}
+		sorted := append([]float64{}, residuals...)
+		sort.Float64s(sorted)
+		median := sorted[n/2]
+		if n%2 == 0 {
+			median = (sorted[n/2-1] + sorted[n/2]) / 2
+		}
+		if median == 0 {
+			// the points are fitted exactly, like in Cleveland's implementation the iterations stop
+			break
+		}
+		for i := range robustness {
+			u := residuals[i] / (6 * median)
+			if u < 1 {
+				robustness[i] = (1 - u*u) * (1 - u*u)
+			} else {
+				robustness[i] = 0
+			}
+		}
+	}
+
+	return Fit{
+		Method:   Lowess,
+		Frac:     frac,
+		RSquared: rSquared(ys, fitted),
+		N:        n,
+		X:        xs,
+		Y:        fitted,
+	}, nil
+}
+
+// localRegression returns the value at xs[i] of the linear regression of its k nearest neighbours, weighted by a tricube
+// of their distance and by their robustness
+func localRegression(xs, ys, robustness []float64, i, k int) float64 {
+	// the neighbours are a window of the sorted x values, moved towards the closest side
+	left, right := i, i
+	for right-left+1 < k {
+		switch {
+		case left == 0:
+			right++
+		case right == len(xs)-1:
+			left--
+		case xs[i]-xs[left-1] <= xs[right+1]-xs[i]:
+			left--
+		default:
+			right++
+		}
+	}
+	radius := math.Max(xs[i]-xs[left], xs[right]-xs[i])
+
+	sw, swx, swy, swxx, swxy := 0.0, 0.0, 0.0, 0.0, 0.0
+	for j := left; j <= right; j++ {
+		w := robustness[j]
+		if radius > 0 {
+			d := math.Abs(xs[j]-xs[i]) / radius
+			w *= math.Pow(1-d*d*d, 3)
+		}
+		sw += w
+		swx += w * xs[j]
+		swy += w * ys[j]
+		swxx += w * xs[j] * xs[j]
+		swxy += w * xs[j] * ys[j]
+	}
+	if sw == 0 {
+		return ys[i]
+	}
+	meanX, meanY := swx/sw, swy/sw
+	variance := swxx/sw - meanX*meanX
+	if variance <= 1e-12*math.Max(1, meanX*meanX) {
+		return meanY
+	}
+	slope := (swxy/sw - meanX*meanY) / variance
+	return meanY + slope*(xs[i]-meanX)
+}
+
+// points returns the points without NaN values, sorted by x
+func points(x, y []float64) ([]float64, []float64, error) {
+	if len(x) != len(y) {
+		return nil, nil, fmt.Errorf("x has %d values, but y has %d", len(x), len(y))
+	}
+	index := []int{}
+	for i := range x {
+		if !math.IsNaN(x[i]) && !math.IsNaN(y[i]) {
+			index = append(index, i)
+		}
+	}
+	if len(index) < 2 {
+		return nil, nil, fmt.Errorf("at least 2 points are required, got %d", len(index))
+	}
+	sort.SliceStable(index, func(a, b int) bool { return x[index[a]] < x[index[b]] })
+	xs := make([]float64, len(index))
+	ys := make([]float64, len(index))
+	for i, j := range index {
+		xs[i] = x[j]
+		ys[i] = y[j]
+	}
+	return xs, ys, nil
+}
+
+// rSquared is the coefficient of determination of the fitted values
+func rSquared(y, fitted []float64) float64 {
+	mean := 0.0
+	for _, v := range y {
+		mean += v
+	}
+	mean /= float64(len(y))
+	residual, total := 0.0, 0.0
+	for i := range y {
+		residual += (y[i] - fitted[i]) * (y[i] - fitted[i])
+		total += (y[i] - mean) * (y[i] - mean)
+	}
+	if total == 0 {
+		return 1
+	}
+	return 1 - residual/total
+}
+
+// floats converts a slice of numbers to float64, other values are NaN
+func floats(values interface{}) []float64 {
+	value := reflect.ValueOf(values)
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return nil
+	}
+	result := make([]float64, value.Len())
+	for i := range result {
+		item := value.Index(i)
+		for item.Kind() == reflect.Interface && !item.IsNil() {
+			item = item.Elem()
+		}
+		switch item.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			result[i] = float64(item.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			result[i] = float64(item.Uint())
+		case reflect.Float32, reflect.Float64:
+			result[i] = item.Float()
+		default:
+			result[i] = math.NaN()
+		}
+	}
+	return result
+}
@@ -0,0 +1,83 @@
+package express_test
+
+import (
+	"encoding/json"
+	"math"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/MetalBlueberry/go-plotly/express"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var _ = Describe("Trendline", func() {
+
+	It("Should fit an OLS line", func() {
+		fit, err := express.FitOLS([]float64{3, 1, 2, math.NaN()}, []float64{7, 3, 5, 1})
+		Expect(err).To(BeNil())
+		Expect(fit.Slope).To(BeNumerically("~", 2, 1e-12))
+		Expect(fit.Intercept).To(BeNumerically("~", 1, 1e-12))
+		Expect(fit.RSquared).To(BeNumerically("~", 1, 1e-12))
+		Expect(fit.N).To(Equal(3))
+		Expect(fit.X).To(Equal([]float64{1, 2, 3}))
+	})
+
+	It("Should compute the R squared of noisy points", func() {
+		fit, err := express.FitOLS([]float64{1, 2, 3, 4}, []float64{1, 3, 2, 4})
+		Expect(err).To(BeNil())
+		Expect(fit.Slope).To(BeNumerically("~", 0.8, 1e-12))
+		Expect(fit.RSquared).To(BeNumerically("~", 0.64, 1e-12))
+	})
+
+	It("Should not fit invalid points", func() {
+		_, err := express.FitOLS([]float64{1}, []float64{1})
+		Expect(err).ToNot(BeNil())
+		_, err = express.FitOLS([]float64{1, 1}, []float64{1, 2})
+		Expect(err).ToNot(BeNil())
+		_, err = express.FitOLS([]float64{1, 2}, []float64{1})
+		Expect(err).ToNot(BeNil())
+		_, err = express.FitLowess([]float64{1, 2}, []float64{1, 2}, 0)
+		Expect(err).ToNot(BeNil())
+	})
+
+	It("Should follow a line with Lowess", func() {
+		x := []float64{1, 2, 3, 4, 5, 6, 7, 8}
+		y := []float64{3, 5, 7, 9, 11, 13, 15, 17}
+		fit, err := express.FitLowess(x, y, express.DefaultLowessFrac)
+		Expect(err).To(BeNil())
+		Expect(fit.Method).To(Equal(express.Lowess))
+		for i := range x {
+			Expect(fit.Y[i]).To(BeNumerically("~", y[i], 1e-9))
+		}
+	})
+
+	It("Should ignore outliers with Lowess", func() {
+		x := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+		y := []float64{1.1, 1.9, 3.2, 3.8, 50, 6.1, 6.8, 8.2, 9, 10.1}
+		fit, err := express.FitLowess(x, y, express.DefaultLowessFrac)
+		Expect(err).To(BeNil())
+		Expect(fit.Y[4]).To(BeNumerically("~", 5, 0.5))
+	})
+
+	It("Should add the trendline and its fit to a scatter figure", func() {
+		fig := express.Scatter([]int{1, 2, 3}, []float64{3, 5, 7}, express.Options{Trendline: express.OLS, XTitle: "height", YTitle: "weight"})
+		Expect(fig.Data).To(HaveLen(2))
+		Expect(fig.Data[0].(*grob.Scatter).Mode).To(Equal(grob.ScatterModeMarkers))
+
+		line := fig.Data[1].(*grob.Scatter)
+		Expect(line.Mode).To(Equal(grob.ScatterModeLines))
+		Expect(line.Y).To(Equal([]float64{3, 5, 7}))
+		Expect(line.Hovertemplate).To(ContainSubstring("weight = 2 * height + 1"))
+
+		figBytes, err := json.Marshal(fig.Layout.Meta)
+		Expect(err).To(BeNil())
+		Expect(string(figBytes)).To(Equal(`{"trendline":{"method":"ols","slope":2,"intercept":1,"rsquared":1,"n":3}}`))
+	})
+
+	It("Should skip the trendline of non numeric points", func() {
+		fig := express.Scatter([]string{"a", "b"}, []float64{1, 2}, express.Options{Trendline: express.Lowess})
+		Expect(fig.Data).To(HaveLen(1))
+		Expect(fig.Layout.Meta).To(BeNil())
+	})
+})
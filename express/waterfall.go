@@ -0,0 +1,68 @@
+package express
+
+import (
+	"fmt"
+	"math"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/themes"
+)
+
+// TotalLabel is the label of the last bar of Waterfall
+const TotalLabel = "Total"
+
+// Waterfall plots a bridge chart, each delta is a floating bar that starts where the previous one ends.
+// A NaN delta is a subtotal, its bar goes from 0 to the running total. A last bar with the total, labelled TotalLabel,
+// is added unless the last delta is already a subtotal. The Color option is the color of the totals.
+//
+// It fails if labels and deltas have different lengths.
+//
+//	fig, err := express.Waterfall([]string{"Sales", "Costs", "Gross", "Taxes"}, []float64{100, -40, math.NaN(), -15})
+func Waterfall(labels []string, deltas []float64, opt ...Options) (*grob.Fig, error) {
+	if len(labels) != len(deltas) {
+		return nil, fmt.Errorf("%d labels given for %d deltas", len(labels), len(deltas))
+	}
+	opts := computeOptions(Options{
+		XTitle: "x",
+		YTitle: "y",
+		Color:  themes.PlotlyColorway[0],
+	}, opt...)
+
+	x := append([]string{}, labels...)
+	y := make([]interface{}, len(deltas))
+	measure := make([]string, len(deltas))
+	for i, delta := range deltas {
+		if math.IsNaN(delta) {
+			measure[i] = "total"
+			continue
+		}
+		y[i] = delta
+		measure[i] = "relative"
+	}
+	if len(deltas) == 0 || measure[len(measure)-1] != "total" {
+		x = append(x, TotalLabel)
+		y = append(y, nil)
+		measure = append(measure, "total")
+	}
+
+	return figure(&grob.Waterfall{
+		Type:    grob.TraceTypeWaterfall,
+		X:       x,
+		Y:       y,
+		Measure: measure,
+		Name:    opts.Name,
+		Connector: &grob.WaterfallConnector{
+			Mode: grob.WaterfallConnectorModeBetween,
+			Line: &grob.WaterfallConnectorLine{
+				Color: "rgb(63, 63, 63)",
+				Width: 1,
+				Dash:  "dot",
+			},
+		},
+		Totals: &grob.WaterfallTotals{
+			Marker: &grob.WaterfallTotalsMarker{
+				Color: opts.Color,
+			},
+		},
+	}, opts), nil
+}
@@ -0,0 +1,44 @@
+package express_test
+
+import (
+	"encoding/json"
+	"math"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/MetalBlueberry/go-plotly/express"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/themes"
+)
+
+var _ = Describe("Waterfall", func() {
+
+	It("Should add subtotals and the total", func() {
+		fig, err := express.Waterfall([]string{"Sales", "Costs", "Gross", "Taxes"}, []float64{100, -40, math.NaN(), -15})
+		Expect(err).To(BeNil())
+		trace := fig.Data[0].(*grob.Waterfall)
+		Expect(trace.Type).To(Equal(grob.TraceTypeWaterfall))
+		Expect(trace.X).To(Equal([]string{"Sales", "Costs", "Gross", "Taxes", express.TotalLabel}))
+		Expect(trace.Y).To(Equal([]interface{}{100.0, -40.0, nil, -15.0, nil}))
+		Expect(trace.Measure).To(Equal([]string{"relative", "relative", "total", "relative", "total"}))
+		Expect(trace.Connector.Mode).To(Equal(grob.WaterfallConnectorModeBetween))
+		Expect(trace.Totals.Marker.Color).To(Equal(themes.PlotlyColorway[0]))
+
+		_, err = json.Marshal(fig)
+		Expect(err).To(BeNil())
+	})
+
+	It("Should not repeat a final subtotal", func() {
+		fig, err := express.Waterfall([]string{"a", "net"}, []float64{1, math.NaN()}, express.Options{Color: "grey"})
+		Expect(err).To(BeNil())
+		trace := fig.Data[0].(*grob.Waterfall)
+		Expect(trace.X).To(Equal([]string{"a", "net"}))
+		Expect(trace.Totals.Marker.Color).To(Equal("grey"))
+	})
+
+	It("Should fail if the labels do not match the deltas", func() {
+		_, err := express.Waterfall([]string{"a"}, nil)
+		Expect(err).To(MatchError("1 labels given for 0 deltas"))
+	})
+})
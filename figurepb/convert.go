@@ -0,0 +1,97 @@
+// Package figurepb carries figures in protobuf messages, for microservices that pass them over gRPC.
+// The messages are defined in figure.proto, import it in the protos of your services to embed a Figure.
+//
+// The traces, the layout and the config are the bytes of their JSON, written with the encoders of the grob package,
+// so they are neither walked with reflection nor escaped in a string field again, and plotly.js can use them as they are.
+//
+//	msg, err := figurepb.FromFig(fig)
+//	...
+//	fig, err := figurepb.ToFig(msg)
+package figurepb
+
+//go:generate buf generate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// FromFig returns the message of the figure. The attributes preserved when the figure was decoded are kept.
+func FromFig(fig *grob.Fig) (*Figure, error) {
+	figBytes, err := fig.ToPlotlyJSON()
+	if err != nil {
+		return nil, err
+	}
+	parts := struct {
+		Data   []json.RawMessage `json:"data"`
+		Layout json.RawMessage   `json:"layout"`
+		Config json.RawMessage   `json:"config"`
+		Frames []json.RawMessage `json:"frames"`
+	}{}
+	err = json.Unmarshal(figBytes, &parts)
+	if err != nil {
+		return nil, fmt.Errorf("cannot split figure, %w", err)
+	}
+
+	msg := &Figure{
+		Data:   make([]*Trace, len(parts.Data)),
+		Layout: parts.Layout,
+		Config: parts.Config,
+	}
+	for i, trace := range parts.Data {
+		msg.Data[i] = &Trace{
+			Type: string(fig.Data[i].GetType()),
+			Json: trace,
+		}
+	}
+	for _, frame := range parts.Frames {
+		msg.Frames = append(msg.Frames, frame)
+	}
+	return msg, nil
+}
+
+// ToFig decodes the figure of the message. The attributes unknown to the grob package are preserved,
+// see grob.UnmarshalOptions.PreserveUnknownFields.
+func ToFig(msg *Figure) (*grob.Fig, error) {
+	buf := &bytes.Buffer{}
+	buf.WriteString(`{"data":[`)
+	for i, trace := range msg.GetData() {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if len(trace.GetJson()) == 0 {
+			return nil, fmt.Errorf("trace %d is empty", i)
+		}
+		buf.Write(trace.GetJson())
+	}
+	buf.WriteByte(']')
+	if len(msg.GetLayout()) != 0 {
+		buf.WriteString(`,"layout":`)
+		buf.Write(msg.GetLayout())
+	}
+	if len(msg.GetConfig()) != 0 {
+		buf.WriteString(`,"config":`)
+		buf.Write(msg.GetConfig())
+	}
+	if len(msg.GetFrames()) != 0 {
+		buf.WriteString(`,"frames":[`)
+		for i, frame := range msg.GetFrames() {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			buf.Write(frame)
+		}
+		buf.WriteByte(']')
+	}
+	buf.WriteByte('}')
+
+	fig := &grob.Fig{}
+	err := grob.UnmarshalOptions{PreserveUnknownFields: true}.Unmarshal(buf.Bytes(), fig)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode figure, %w", err)
+	}
+	return fig, nil
+}
@@ -0,0 +1,67 @@
+package figurepb_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/MetalBlueberry/go-plotly/figurepb"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var _ = Describe("Figure", func() {
+
+	It("Should pass the figure through protobuf", func() {
+		fig := &grob.Fig{
+			Layout: &grob.Layout{Title: &grob.LayoutTitle{Text: `"quoted" title`}},
+			Config: &grob.Config{Responsive: grob.True},
+			Frames: []grob.Frame{{Name: "first", Data: grob.Traces{&grob.Bar{Type: grob.TraceTypeBar, Y: []float64{2}}}}},
+		}
+		fig.AddScatter([]float64{1, 2}, []float64{3, 4})
+		fig.AddTraces(&grob.Bar{Type: grob.TraceTypeBar, Y: []float64{1}})
+		expected, err := fig.ToPlotlyJSON()
+		Expect(err).To(BeNil())
+
+		msg, err := figurepb.FromFig(fig)
+		Expect(err).To(BeNil())
+		Expect(msg.Data).To(HaveLen(2))
+		Expect(msg.Data[0].Type).To(Equal("scatter"))
+		Expect(msg.Data[1].Type).To(Equal("bar"))
+		Expect(string(msg.Layout)).To(Equal(`{"title":{"text":"\"quoted\" title"}}`))
+		Expect(msg.Frames).To(HaveLen(1))
+
+		wire, err := proto.Marshal(msg)
+		Expect(err).To(BeNil())
+		decoded := &figurepb.Figure{}
+		Expect(proto.Unmarshal(wire, decoded)).To(Succeed())
+
+		again, err := figurepb.ToFig(decoded)
+		Expect(err).To(BeNil())
+		data, err := again.ToPlotlyJSON()
+		Expect(err).To(BeNil())
+		Expect(data).To(MatchJSON(expected))
+	})
+
+	It("Should keep the preserved attributes", func() {
+		fig := &grob.Fig{}
+		err := grob.UnmarshalOptions{PreserveUnknownFields: true}.Unmarshal([]byte(`{"data":[{"type":"bar","newattribute":1}]}`), fig)
+		Expect(err).To(BeNil())
+
+		msg, err := figurepb.FromFig(fig)
+		Expect(err).To(BeNil())
+		Expect(string(msg.Data[0].Json)).To(Equal(`{"type":"bar","newattribute":1}`))
+		again, err := figurepb.ToFig(msg)
+		Expect(err).To(BeNil())
+		data, err := again.ToPlotlyJSON()
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(Equal(`{"data":[{"type":"bar","newattribute":1}],"layout":{}}`))
+	})
+
+	It("Should fail with invalid traces", func() {
+		_, err := figurepb.ToFig(&figurepb.Figure{Data: []*figurepb.Trace{{Type: "bar"}}})
+		Expect(err).To(MatchError("trace 0 is empty"))
+		_, err = figurepb.ToFig(&figurepb.Figure{Data: []*figurepb.Trace{{Type: "bar", Json: []byte(`{"type":`)}}})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,257 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        (unknown)
+// source: figure.proto
+
+// Figures of go-plotly in the envelope of Plotly.newPlot, to pass them between services.
+// The parts of the figure are the bytes of their JSON, so they are not escaped in a string again.
+
+package figurepb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Figure is the data, layout, config and frames of a figure
+type Figure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// data are the traces of the figure
+	Data []*Trace `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	// layout is the JSON object of the layout, empty if the figure has none
+	Layout []byte `protobuf:"bytes,2,opt,name=layout,proto3" json:"layout,omitempty"`
+	// config is the JSON object of the config, empty if the figure has none
+	Config []byte `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	// frames are the JSON objects of the frames of animated figures
+	Frames [][]byte `protobuf:"bytes,4,rep,name=frames,proto3" json:"frames,omitempty"`
+}
+
+func (x *Figure) Reset() {
+	*x = Figure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_figure_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Figure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Figure) ProtoMessage() {}
+
+func (x *Figure) ProtoReflect() protoreflect.Message {
+	mi := &file_figure_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Figure.ProtoReflect.Descriptor instead.
+func (*Figure) Descriptor() ([]byte, []int) {
+	return file_figure_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Figure) GetData() []*Trace {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Figure) GetLayout() []byte {
+	if x != nil {
+		return x.Layout
+	}
+	return nil
+}
+
+func (x *Figure) GetConfig() []byte {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *Figure) GetFrames() [][]byte {
+	if x != nil {
+		return x.Frames
+	}
+	return nil
+}
+
+// Trace is a trace of a figure
+type Trace struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// type is the type of the trace, like scatter or bar, so traces can be routed without decoding them
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// json is the JSON object of the trace, including its type
+	Json []byte `protobuf:"bytes,2,opt,name=json,proto3" json:"json,omitempty"`
+}
+
+func (x *Trace) Reset() {
+	*x = Trace{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_figure_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Trace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Trace) ProtoMessage() {}
+
+func (x *Trace) ProtoReflect() protoreflect.Message {
+	mi := &file_figure_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Trace.ProtoReflect.Descriptor instead.
+func (*Trace) Descriptor() ([]byte, []int) {
+	return file_figure_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Trace) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Trace) GetJson() []byte {
+	if x != nil {
+		return x.Json
+	}
+	return nil
+}
+
+var File_figure_proto protoreflect.FileDescriptor
+
+var file_figure_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10,
+	0x70, 0x6c, 0x6f, 0x74, 0x6c, 0x79, 0x2e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x22, 0x7d, 0x0a, 0x06, 0x46, 0x69, 0x67, 0x75, 0x72, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x6c, 0x6f, 0x74, 0x6c,
+	0x79, 0x2e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63,
+	0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x79, 0x6f, 0x75,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x72, 0x61, 0x6d, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x22,
+	0x2f, 0x0a, 0x05, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x6a, 0x73, 0x6f, 0x6e,
+	0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4d,
+	0x65, 0x74, 0x61, 0x6c, 0x42, 0x6c, 0x75, 0x65, 0x62, 0x65, 0x72, 0x72, 0x79, 0x2f, 0x67, 0x6f,
+	0x2d, 0x70, 0x6c, 0x6f, 0x74, 0x6c, 0x79, 0x2f, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_figure_proto_rawDescOnce sync.Once
+	file_figure_proto_rawDescData = file_figure_proto_rawDesc
+)
+
+func file_figure_proto_rawDescGZIP() []byte {
+	file_figure_proto_rawDescOnce.Do(func() {
+		file_figure_proto_rawDescData = protoimpl.X.CompressGZIP(file_figure_proto_rawDescData)
+	})
+	return file_figure_proto_rawDescData
+}
+
+var file_figure_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_figure_proto_goTypes = []interface{}{
+	(*Figure)(nil), // 0: plotly.figure.v1.Figure
+	(*Trace)(nil),  // 1: plotly.figure.v1.Trace
+}
+var file_figure_proto_depIdxs = []int32{
+	1, // 0: plotly.figure.v1.Figure.data:type_name -> plotly.figure.v1.Trace
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_figure_proto_init() }
+func file_figure_proto_init() {
+	if File_figure_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_figure_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Figure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_figure_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Trace); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_figure_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_figure_proto_goTypes,
+		DependencyIndexes: file_figure_proto_depIdxs,
+		MessageInfos:      file_figure_proto_msgTypes,
+	}.Build()
+	File_figure_proto = out.File
+	file_figure_proto_rawDesc = nil
+	file_figure_proto_goTypes = nil
+	file_figure_proto_depIdxs = nil
+}
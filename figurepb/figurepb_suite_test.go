@@ -0,0 +1,13 @@
+package figurepb_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestFigurepb(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Figurepb Suite")
+}
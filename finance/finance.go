@@ -0,0 +1,243 @@
+// Package finance builds the charts of trading dashboards from OHLCV bars.
+//
+//	fig, err := finance.Candlestick(bars, finance.Options{Title: "ACME"})
+//	offline.Show(fig)
+package finance
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/subplots"
+)
+
+// Default colors of the rising and falling bars
+const (
+	DefaultIncreasing = "#3D9970"
+	DefaultDecreasing = "#FF4136"
+)
+
+// minIntradayGap is the shortest gap hidden between intraday bars, so a few missing bars are still visible
+const minIntradayGap = time.Hour
+
+const day = 24 * time.Hour
+
+// OHLCV is a bar of prices and traded volume starting at Time
+type OHLCV struct {
+	Time   time.Time
+	Open   float64
+	High   float64
+	Low    float64
+	Close  float64
+	Volume float64
+}
+
+// Options configure the chart
+type Options struct {
+	// Title of the figure
+	Title string
+	// Name of the instrument, displayed in the hover label
+	Name string
+	// HideVolume removes the volume subplot
+	HideVolume bool
+	// KeepGaps keeps the periods without bars, such as weekends, on the time axis
+	KeepGaps bool
+	// Increasing and Decreasing are the colors of the bars that close above and below their open,
+	// default to DefaultIncreasing and DefaultDecreasing
+	Increasing, Decreasing string
+}
+
+func computeOptions(def Options, opt ...Options) Options {
+	if len(opt) == 1 {
+		opts := opt[0]
+		if opts.Title != "" {
+			def.Title = opts.Title
+		}
+		if opts.Name != "" {
+			def.Name = opts.Name
+		}
+		if opts.HideVolume {
+			def.HideVolume = opts.HideVolume
+		}
+		if opts.KeepGaps {
+			def.KeepGaps = opts.KeepGaps
+		}
+		if opts.Increasing != "" {
+			def.Increasing = opts.Increasing
+		}
+		if opts.Decreasing != "" {
+			def.Decreasing = opts.Decreasing
+		}
+	}
+	return def
+}
+
+// Candlestick plots the bars as candlesticks with their volume in a subplot below and a range slider at the bottom.
+// The bars are sorted by time. The periods without bars are hidden from the time axis with rangebreaks:
+// weekends and missing days for daily bars, and gaps of more than an hour for intraday bars.
+func Candlestick(bars []OHLCV, opt ...Options) (*grob.Fig, error) {
+	opts := computeOptions(Options{
+		Increasing: DefaultIncreasing,
+		Decreasing: DefaultDecreasing,
+	}, opt...)
+	if len(bars) == 0 {
+		return nil, fmt.Errorf("there are no bars to plot")
+	}
+	bars = append([]OHLCV{}, bars...)
+	sort.SliceStable(bars, func(i, j int) bool { return bars[i].Time.Before(bars[j].Time) })
+
+	n := len(bars)
+	t := make([]time.Time, n)
+	open, high, low, close := make([]float64, n), make([]float64, n), make([]float64, n), make([]float64, n)
+	volume := make([]float64, n)
+	volumeColors := make([]string, n)
+	for i, bar := range bars {
+		if bar.High < bar.Low {
+			return nil, fmt.Errorf("bar at %s has a high %g below its low %g", bar.Time, bar.High, bar.Low)
+		}
+		t[i] = bar.Time
+		open[i], high[i], low[i], close[i] = bar.Open, bar.High, bar.Low, bar.Close
+		volume[i] = bar.Volume
+		volumeColors[i] = opts.Increasing
+		if bar.Close < bar.Open {
+			volumeColors[i] = opts.Decreasing
+		}
+	}
+
+	rows := 2
+	spOpts := subplots.Options{SharedXAxes: true, RowHeights: []float64{0.7, 0.3}, VerticalSpacing: 0.03}
+	if opts.HideVolume {
+		rows = 1
+		spOpts = subplots.Options{}
+	}
+	sp := subplots.New(rows, 1, spOpts)
+
+	candles := &grob.Candlestick{
+		Type:  grob.TraceTypeCandlestick,
+		X:     t,
+		Open:  open,
+		High:  high,
+		Low:   low,
+		Close: close,
+		Increasing: &grob.CandlestickIncreasing{
+			Fillcolor: opts.Increasing,
+			Line:      &grob.CandlestickIncreasingLine{Color: opts.Increasing},
+		},
+		Decreasing: &grob.CandlestickDecreasing{
+			Fillcolor: opts.Decreasing,
+			Line:      &grob.CandlestickDecreasingLine{Color: opts.Decreasing},
+		},
+	}
+	if opts.Name != "" {
+		candles.Name = opts.Name
+	}
+	err := sp.Add(candles, 1, 1)
+	if err != nil {
+		return nil, err
+	}
+	if !opts.HideVolume {
+		err = sp.Add(&grob.Bar{
+			Type:          grob.TraceTypeBar,
+			Name:          "volume",
+			X:             t,
+			Y:             volume,
+			Marker:        &grob.BarMarker{Color: volumeColors},
+			Hovertemplate: "%{y}<extra>volume</extra>",
+		}, 2, 1)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var breaks []map[string]interface{}
+	if !opts.KeepGaps {
+		breaks = rangebreaks(t)
+	}
+	for row := 1; row <= rows; row++ {
+		xaxis := sp.XAxis(row, 1)
+		xaxis.Type = grob.LayoutXaxisTypeDate
+		if breaks != nil {
+			xaxis.Rangebreaks = breaks
+		}
+		// candlesticks add a range slider to their axis, it is moved to the bottom axis
+		xaxis.Rangeslider = &grob.LayoutXaxisRangeslider{Visible: grob.False}
+		if row == rows {
+			xaxis.Rangeslider.Visible = grob.True
+		}
+	}
+
+	fig := sp.Figure()
+	fig.Layout.Showlegend = grob.False
+	fig.Layout.Hovermode = grob.LayoutHovermodeX
+	if opts.Title != "" {
+		fig.Layout.Title = &grob.LayoutTitle{Text: opts.Title}
+	}
+	return fig, nil
+}
+
+// rangebreaks hides the periods without bars. Daily bars hide the weekends if there is no bar on them and the missing days.
+// Intraday bars hide the gaps longer than the interval between bars and minIntradayGap.
+// Bars of more than a day have no rangebreaks.
+func rangebreaks(t []time.Time) []map[string]interface{} {
+	interval := time.Duration(0)
+	for i := 1; i < len(t); i++ {
+		d := t[i].Sub(t[i-1])
+		if d > 0 && (interval == 0 || d < interval) {
+			interval = d
+		}
+	}
+	if interval == 0 || interval > day {
+		return nil
+	}
+
+	breaks := []map[string]interface{}{}
+	if interval < day {
+		for i := 1; i < len(t); i++ {
+			gap := t[i].Sub(t[i-1])
+			if gap > interval && gap >= minIntradayGap {
+				breaks = append(breaks, map[string]interface{}{
+					"bounds": []string{formatTime(t[i-1].Add(interval)), formatTime(t[i])},
+				})
+			}
+		}
+		if len(breaks) == 0 {
+			return nil
+		}
+		return breaks
+	}
+
+	noWeekendBars := true
+	days := map[string]bool{}
+	for _, ti := range t {
+		if ti.Weekday() == time.Saturday || ti.Weekday() == time.Sunday {
+			noWeekendBars = false
+		}
+		days[ti.Format("2006-01-02")] = true
+	}
+	if noWeekendBars {
+		breaks = append(breaks, map[string]interface{}{"bounds": []string{"sat", "mon"}})
+	}
+	missing := []string{}
+	for d := t[0]; d.Before(t[len(t)-1]); d = d.AddDate(0, 0, 1) {
+		if noWeekendBars && (d.Weekday() == time.Saturday || d.Weekday() == time.Sunday) {
+			continue
+		}
+		if date := d.Format("2006-01-02"); !days[date] {
+			missing = append(missing, date)
+		}
+	}
+	if len(missing) > 0 {
+		breaks = append(breaks, map[string]interface{}{"values": missing})
+	}
+	if len(breaks) == 0 {
+		return nil
+	}
+	return breaks
+}
+
+// formatTime formats the time as plotly dates, in the location of the time
+func formatTime(t time.Time) string {
+	return t.Format("2006-01-02 15:04:05")
+}
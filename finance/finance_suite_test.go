@@ -0,0 +1,13 @@
+package finance_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestFinance(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Finance Suite")
+}
@@ -0,0 +1,87 @@
+package finance_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/MetalBlueberry/go-plotly/finance"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var _ = Describe("Candlestick", func() {
+
+	date := func(day int) time.Time {
+		return time.Date(2021, 1, day, 0, 0, 0, 0, time.UTC)
+	}
+	// monday 4th to tuesday 12th, without the weekend and wednesday 6th
+	bars := []finance.OHLCV{
+		{Time: date(5), Open: 11, High: 13, Low: 10, Close: 12, Volume: 200},
+		{Time: date(4), Open: 10, High: 12, Low: 9, Close: 11, Volume: 100},
+		{Time: date(7), Open: 12, High: 12, Low: 8, Close: 9, Volume: 300},
+		{Time: date(8), Open: 9, High: 10, Low: 8, Close: 10, Volume: 100},
+		{Time: date(11), Open: 10, High: 11, Low: 9, Close: 10, Volume: 100},
+		{Time: date(12), Open: 10, High: 11, Low: 9, Close: 11, Volume: 100},
+	}
+
+	It("Should plot candles and volume sorted by time", func() {
+		fig, err := finance.Candlestick(bars, finance.Options{Title: "ACME", Name: "ACME"})
+		Expect(err).To(BeNil())
+		Expect(fig.Data).To(HaveLen(2))
+
+		candles := fig.Data[0].(*grob.Candlestick)
+		Expect(candles.X.([]time.Time)[0]).To(Equal(date(4)))
+		Expect(candles.Open).To(Equal([]float64{10, 11, 12, 9, 10, 10}))
+		Expect(candles.Name).To(Equal("ACME"))
+		Expect(candles.Yaxis).To(Equal("y"))
+
+		volume := fig.Data[1].(*grob.Bar)
+		Expect(volume.Yaxis).To(Equal("y2"))
+		Expect(volume.Y).To(Equal([]float64{100, 200, 300, 100, 100, 100}))
+		Expect(volume.Marker.Color.([]string)[2]).To(Equal(finance.DefaultDecreasing))
+		Expect(volume.Marker.Color.([]string)[0]).To(Equal(finance.DefaultIncreasing))
+
+		Expect(fig.Layout.Title.Text).To(Equal("ACME"))
+		Expect(fig.Layout.Xaxis.Rangeslider.Visible).To(Equal(grob.False))
+		Expect(fig.Layout.XAxis2.Rangeslider.Visible).To(Equal(grob.True))
+		Expect(fig.Layout.Xaxis.Matches).To(Equal(grob.LayoutXaxisMatches("x2")))
+	})
+
+	It("Should hide the weekends and the missing days of daily bars", func() {
+		fig, err := finance.Candlestick(bars)
+		Expect(err).To(BeNil())
+		expected := []map[string]interface{}{
+			{"bounds": []string{"sat", "mon"}},
+			{"values": []string{"2021-01-06"}},
+		}
+		Expect(fig.Layout.Xaxis.Rangebreaks).To(Equal(expected))
+		Expect(fig.Layout.XAxis2.Rangebreaks).To(Equal(expected))
+
+		fig, err = finance.Candlestick(bars, finance.Options{KeepGaps: true})
+		Expect(err).To(BeNil())
+		Expect(fig.Layout.Xaxis.Rangebreaks).To(BeNil())
+	})
+
+	It("Should hide the nights of intraday bars", func() {
+		hour := func(day, hour int) finance.OHLCV {
+			return finance.OHLCV{Time: time.Date(2021, 1, day, hour, 0, 0, 0, time.UTC), Open: 1, High: 1, Low: 1, Close: 1}
+		}
+		fig, err := finance.Candlestick([]finance.OHLCV{hour(4, 9), hour(4, 10), hour(4, 12), hour(5, 9)}, finance.Options{HideVolume: true})
+		Expect(err).To(BeNil())
+		Expect(fig.Data).To(HaveLen(1))
+		Expect(fig.Layout.XAxis2).To(BeNil())
+		Expect(fig.Layout.Xaxis.Rangeslider.Visible).To(Equal(grob.True))
+		Expect(fig.Layout.Xaxis.Rangebreaks).To(Equal([]map[string]interface{}{
+			{"bounds": []string{"2021-01-04 11:00:00", "2021-01-04 12:00:00"}},
+			{"bounds": []string{"2021-01-04 13:00:00", "2021-01-05 09:00:00"}},
+		}))
+	})
+
+	It("Should reject invalid bars", func() {
+		_, err := finance.Candlestick(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = finance.Candlestick([]finance.OHLCV{{High: 1, Low: 2}})
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,170 @@
+// Package flame plots the call tree of Go pprof profiles as treemaps, an interactive flame graph viewer built on this package.
+// Every function is a box in the box of its caller, sized by its samples including those of the functions it calls.
+//
+//	f, err := os.Open("cpu.pprof")
+//	fig, err := flame.Read(f)
+//	offline.Show(fig)
+package flame
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/google/pprof/profile"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/hierarchy"
+)
+
+// DefaultNodeFraction is the fraction of the total below which the calls are merged in their caller, like the nodefraction of pprof
+const DefaultNodeFraction = 0.005
+
+// Options configure the figure of the profile
+type Options struct {
+	// SampleType is the type of the values, like cpu or inuse_space.
+	// Defaults to the default sample type of the profile, or its last one like pprof
+	SampleType string
+	// NodeFraction is the fraction of the total below which the calls are merged in their caller, to keep the figure light.
+	// Defaults to DefaultNodeFraction, a negative value keeps every call
+	NodeFraction float64
+	// Title of the figure, defaults to the sample type and its unit
+	Title string
+}
+
+func computeOptions(def Options, opt ...Options) Options {
+	if len(opt) == 1 {
+		opts := opt[0]
+		if opts.SampleType != "" {
+			def.SampleType = opts.SampleType
+		}
+		if opts.NodeFraction != 0 {
+			def.NodeFraction = opts.NodeFraction
+		}
+		if opts.Title != "" {
+			def.Title = opts.Title
+		}
+	}
+	return def
+}
+
+// Read parses a profile, gzipped or not, as written by runtime/pprof or the /debug/pprof endpoints, and plots it with FromProfile
+func Read(r io.Reader, opt ...Options) (*grob.Fig, error) {
+	p, err := profile.Parse(r)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse profile, %w", err)
+	}
+	return FromProfile(p, opt...)
+}
+
+// FromProfile plots the call tree of the profile as a treemap whose root is the total of the samples.
+// The inlined functions are nodes of their own, and the locations without symbols are named after their address.
+func FromProfile(p *profile.Profile, opt ...Options) (*grob.Fig, error) {
+	opts := computeOptions(Options{
+		SampleType:   p.DefaultSampleType,
+		NodeFraction: DefaultNodeFraction,
+	}, opt...)
+
+	index := len(p.SampleType) - 1
+	if opts.SampleType != "" {
+		var err error
+		index, err = p.SampleIndexByName(opts.SampleType)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if index < 0 {
+		return nil, fmt.Errorf("profile has no sample types")
+	}
+	sampleType := p.SampleType[index]
+
+	root := &call{name: "total"}
+	for _, sample := range p.Sample {
+		value := float64(sample.Value[index])
+		if value == 0 {
+			continue
+		}
+		node := root
+		node.total += value
+		// the locations and their lines go from the leaf to the root
+		for i := len(sample.Location) - 1; i >= 0; i-- {
+			location := sample.Location[i]
+			if len(location.Line) == 0 {
+				node = node.child(fmt.Sprintf("0x%x", location.Address))
+				node.total += value
+				continue
+			}
+			for j := len(location.Line) - 1; j >= 0; j-- {
+				name := "?"
+				if location.Line[j].Function != nil {
+					name = location.Line[j].Function.Name
+				}
+				node = node.child(name)
+				node.total += value
+			}
+		}
+		node.self += value
+	}
+	if root.total < 0 {
+		return nil, fmt.Errorf("total of %s is negative", sampleType.Type)
+	}
+
+	h, err := hierarchy.FromTree(root.node(root.total * opts.NodeFraction))
+	if err != nil {
+		return nil, err
+	}
+	trace := h.Treemap()
+	trace.Hovertemplate = "%{label}<br>%{value} " + sampleType.Unit + "<br>%{percentRoot:.1%} of total<extra></extra>"
+
+	title := opts.Title
+	if title == "" {
+		title = sampleType.Type + " (" + sampleType.Unit + ")"
+	}
+	return &grob.Fig{
+		Data: grob.Traces{trace},
+		Layout: &grob.Layout{
+			Title:  &grob.LayoutTitle{Text: title},
+			Margin: &grob.LayoutMargin{T: 50, L: 10, R: 10, B: 10},
+		},
+	}, nil
+}
+
+// call is a function of the call tree
+type call struct {
+	name     string
+	self     float64
+	total    float64
+	children map[string]*call
+}
+
+// child returns the callee of the given name, creating it if needed
+func (c *call) child(name string) *call {
+	if c.children == nil {
+		c.children = map[string]*call{}
+	}
+	child, ok := c.children[name]
+	if !ok {
+		child = &call{name: name}
+		c.children[name] = child
+	}
+	return child
+}
+
+// node returns the hierarchy node of the call, the callees with a total under the threshold are merged in the call
+func (c *call) node(threshold float64) hierarchy.Node {
+	node := hierarchy.Node{Label: c.name, Value: c.self}
+	names := make([]string, 0, len(c.children))
+	for name := range c.children {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		child := c.children[name]
+		if child.total < threshold {
+			node.Value += child.total
+			continue
+		}
+		node.Children = append(node.Children, child.node(threshold))
+	}
+	return node
+}
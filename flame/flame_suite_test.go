@@ -0,0 +1,13 @@
+package flame_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestFlame(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Flame Suite")
+}
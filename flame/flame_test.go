@@ -0,0 +1,72 @@
+package flame_test
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/google/pprof/profile"
+
+	"github.com/MetalBlueberry/go-plotly/flame"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var _ = Describe("Flame", func() {
+
+	var p *profile.Profile
+
+	BeforeEach(func() {
+		main := &profile.Function{ID: 1, Name: "main.main"}
+		work := &profile.Function{ID: 2, Name: "main.work"}
+		sum := &profile.Function{ID: 3, Name: "main.sum"}
+		mainLocation := &profile.Location{ID: 1, Address: 0x10, Line: []profile.Line{{Function: main}}}
+		// sum is inlined in work
+		workLocation := &profile.Location{ID: 2, Address: 0x20, Line: []profile.Line{{Function: sum}, {Function: work}}}
+		unknownLocation := &profile.Location{ID: 3, Address: 0x30}
+		p = &profile.Profile{
+			SampleType: []*profile.ValueType{{Type: "samples", Unit: "count"}, {Type: "cpu", Unit: "nanoseconds"}},
+			Sample: []*profile.Sample{
+				{Location: []*profile.Location{workLocation, mainLocation}, Value: []int64{3, 300}},
+				{Location: []*profile.Location{mainLocation}, Value: []int64{1, 100}},
+				{Location: []*profile.Location{unknownLocation, mainLocation}, Value: []int64{1, 1}},
+			},
+			Function: []*profile.Function{main, work, sum},
+			Location: []*profile.Location{mainLocation, workLocation, unknownLocation},
+		}
+	})
+
+	It("Should plot the call tree of the last sample type", func() {
+		fig, err := flame.FromProfile(p, flame.Options{NodeFraction: -1})
+		Expect(err).To(BeNil())
+		trace := fig.Data[0].(*grob.Treemap)
+		Expect(trace.Labels).To(Equal([]string{"total", "main.main", "0x30", "main.work", "main.sum"}))
+		Expect(trace.Parents).To(Equal([]string{"", "total", "total/main.main", "total/main.main", "total/main.main/main.work"}))
+		Expect(trace.Values).To(Equal([]float64{401, 401, 1, 300, 300}))
+		Expect(trace.Hovertemplate).To(ContainSubstring("%{value} nanoseconds"))
+		Expect(fig.Layout.Title.Text).To(Equal(grob.String("cpu (nanoseconds)")))
+	})
+
+	It("Should merge the small calls in their caller", func() {
+		fig, err := flame.FromProfile(p, flame.Options{SampleType: "samples", NodeFraction: 0.25})
+		Expect(err).To(BeNil())
+		trace := fig.Data[0].(*grob.Treemap)
+		Expect(trace.Labels).To(Equal([]string{"total", "main.main", "main.work", "main.sum"}))
+		Expect(trace.Values).To(Equal([]float64{5, 5, 3, 3}))
+	})
+
+	It("Should read the profile", func() {
+		buf := &bytes.Buffer{}
+		Expect(p.Write(buf)).To(Succeed())
+		fig, err := flame.Read(buf, flame.Options{Title: "CPU"})
+		Expect(err).To(BeNil())
+		By("merging the calls under the default node fraction")
+		Expect(fig.Data[0].(*grob.Treemap).Labels).To(Equal([]string{"total", "main.main", "main.work", "main.sum"}))
+		Expect(fig.Layout.Title.Text).To(Equal(grob.String("CPU")))
+	})
+
+	It("Should fail with an unknown sample type", func() {
+		_, err := flame.FromProfile(p, flame.Options{SampleType: "alloc_space"})
+		Expect(err).To(HaveOccurred())
+	})
+})
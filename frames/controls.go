@@ -0,0 +1,163 @@
+package frames
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/menus"
+)
+
+// Easing is the easing function of the transitions between frames.
+// The other easings of plotly.js are the grob.AnimationTransitionEasing constants
+type Easing = grob.AnimationTransitionEasing
+
+// Easings of the transitions
+const (
+	Linear     = grob.AnimationTransitionEasingLinear
+	QuadIn     = grob.AnimationTransitionEasingQuadIn
+	QuadOut    = grob.AnimationTransitionEasingQuadOut
+	QuadInOut  = grob.AnimationTransitionEasingQuadInOut
+	CubicIn    = grob.AnimationTransitionEasingCubicIn
+	CubicOut   = grob.AnimationTransitionEasingCubicOut
+	CubicInOut = grob.AnimationTransitionEasingCubicInOut
+	ElasticOut = grob.AnimationTransitionEasingElasticOut
+	BounceOut  = grob.AnimationTransitionEasingBounceOut
+)
+
+// animatable are the traces whose attributes plotly.js 1.58 transitions smoothly, the animatable traces of the schema.
+// The other traces are redrawn at every frame
+var animatable = map[grob.TraceType]bool{
+	grob.TraceTypeScatter:   true,
+	grob.TraceTypeBar:       true,
+	grob.TraceTypeSunburst:  true,
+	grob.TraceTypeTreemap:   true,
+	grob.TraceTypeIndicator: true,
+	grob.TraceTypeCarpet:    true,
+}
+
+// SliderOptions configure the slider
+type SliderOptions struct {
+	// Prefix is displayed before the name of the current frame, like "Year: "
+	Prefix string
+}
+
+// Control is a button or a slider that plays the animation, see Controls
+type Control struct {
+	play     bool
+	duration time.Duration
+	easing   Easing
+	prefix   string
+}
+
+// PlayButton returns play and pause buttons. Play goes through the frames from the current one, every frame is displayed
+// for the duration and reached with a transition of the same duration with the easing.
+// If the duration is 0, the timing of plotly.js is used.
+func PlayButton(duration time.Duration, easing Easing) Control {
+	return Control{
+		play:     true,
+		duration: duration,
+		easing:   easing,
+	}
+}
+
+// Slider returns a slider with a step per frame, moving the slider animates to the frame like the play button.
+// Without play button, the slider jumps to the frames without transition.
+func Slider(opt ...SliderOptions) Control {
+	opts := SliderOptions{}
+	if len(opt) == 1 {
+		opts = opt[0]
+	}
+	return Control{
+		prefix: opts.Prefix,
+	}
+}
+
+// Controls adds the controls to the layout of the figure, with the timing of the first play button.
+// The controls go through the frames added so far, Controls is called once all the frames are added.
+func (a *Animation) Controls(controls ...Control) {
+	var play *Control
+	for i := range controls {
+		if controls[i].play {
+			play = &controls[i]
+			break
+		}
+	}
+
+	for _, control := range controls {
+		if control.play {
+			a.addPlayButton(control)
+			continue
+		}
+		a.addSlider(control, play)
+	}
+}
+
+// animation returns the options of plotly.animate for the control. Traces that cannot transition are redrawn
+func (a *Animation) animation(control Control, mode grob.AnimationMode) *grob.Animation {
+	redraw := grob.False
+	for _, trace := range a.fig.Data {
+		if !animatable[trace.GetType()] {
+			redraw = grob.True
+			break
+		}
+	}
+	duration := float64(control.duration.Milliseconds())
+	return &grob.Animation{
+		Mode: mode,
+		Frame: &grob.AnimationFrame{
+			Duration: duration,
+			Redraw:   redraw,
+		},
+		Transition: &grob.AnimationTransition{
+			Duration: duration,
+			Easing:   control.easing,
+		},
+	}
+}
+
+// addPlayButton adds the play and pause buttons on the left of the sliders, like plotly express
+func (a *Animation) addPlayButton(control Control) {
+	play := a.animation(control, grob.AnimationModeAfterall)
+	play.Fromcurrent = grob.True
+	menu := menus.Buttons(
+		menus.Button("Play", menus.Animate(play)),
+		menus.Button("Pause", menus.Pause()),
+	)
+	menu.Direction = "left"
+	menu.Showactive = grob.False
+	menu.X, menu.Y = 0.1, 0
+	menu.Xanchor, menu.Yanchor = "right", "top"
+	menus.Add(a.fig, menu)
+}
+
+// addSlider adds a slider with a step per frame, animating like the play button if any
+func (a *Animation) addSlider(control Control, play *Control) {
+	var slider *menus.Slider
+	if play == nil {
+		slider = menus.FrameSlider(a.fig, control.prefix)
+	} else {
+		slider = menus.NewSlider()
+		if control.prefix != "" {
+			slider.Currentvalue = &menus.SliderCurrentvalue{Prefix: control.prefix}
+		}
+		for i := range a.fig.Frames {
+			name := a.frameName(i)
+			slider.Steps = append(slider.Steps, menus.Step(name, menus.Animate(a.animation(*play, grob.AnimationModeImmediate), name)))
+		}
+	}
+	slider.X, slider.Y, slider.Len = 0.1, 0, 0.9
+	slider.Xanchor, slider.Yanchor = "left", "top"
+	menus.AddSliders(a.fig, slider)
+}
+
+// frameName returns the name of the frame, frames without name are named after their index like menus.FrameSlider
+func (a *Animation) frameName(i int) string {
+	name := fmt.Sprint(a.fig.Frames[i].Name)
+	if a.fig.Frames[i].Name == nil || name == "" {
+		name = strconv.Itoa(i)
+		a.fig.Frames[i].Name = name
+	}
+	return name
+}
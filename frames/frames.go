@@ -0,0 +1,136 @@
+// Package frames builds the frames of animated figures and the controls that play them.
+//
+// Every frame is named and holds whole traces or deltas that only change some attributes of the traces of the figure.
+// The controls are added to the layout updatemenus and sliders with the same timing, so the slider follows the play button.
+//
+//	anim := frames.New(fig)
+//	for _, year := range years {
+//		err := anim.Add(year, frames.Delta{Trace: 0, Attributes: map[string]interface{}{"y": population[year]}})
+//	}
+//	anim.Controls(frames.PlayButton(500*time.Millisecond, frames.CubicInOut), frames.Slider())
+//	offline.Show(anim.Figure())
+package frames
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// Delta changes the attributes of the trace at index Trace of the figure in a frame, the other attributes keep their values.
+// The attributes are set by the dot separated path of their JSON names, like "marker.color".
+// A Delta is only a placeholder for Add, its type is resolved from the trace of the figure.
+type Delta struct {
+	Trace      int
+	Attributes map[string]interface{}
+}
+
+// GetType is empty, the type of the delta is the type of its trace
+func (d Delta) GetType() grob.TraceType {
+	return ""
+}
+
+// Animation adds frames to a figure
+type Animation struct {
+	fig   *grob.Fig
+	names map[string]bool
+}
+
+// New returns an animation of the figure, the frames already in the figure are kept
+func New(fig *grob.Fig) *Animation {
+	names := map[string]bool{}
+	for _, frame := range fig.Frames {
+		if frame.Name != nil {
+			names[fmt.Sprint(frame.Name)] = true
+		}
+	}
+	return &Animation{
+		fig:   fig,
+		names: names,
+	}
+}
+
+// Figure returns the figure with the frames and controls added so far
+func (a *Animation) Figure() *grob.Fig {
+	return a.fig
+}
+
+// Add appends a frame with the traces. The whole traces replace the traces of the figure in order, starting with the first one,
+// and the deltas change the trace of their index. The names of the frames must be unique, the controls refer to the frames by name.
+func (a *Animation) Add(name string, traces ...grob.Trace) error {
+	return a.AddLayout(name, nil, traces...)
+}
+
+// AddLayout appends a frame with the traces, like Add, and the attributes of the layout that change with the frame, such as the title
+func (a *Animation) AddLayout(name string, layout *grob.Layout, traces ...grob.Trace) error {
+	if name == "" {
+		return errors.New("the frame has no name")
+	}
+	if a.names[name] {
+		return fmt.Errorf("frame %s already exists", name)
+	}
+
+	frame := &grob.Fig{}
+	indexes := []int{}
+	changed := map[int]bool{}
+	next := 0
+	for _, trace := range traces {
+		index := next
+		if delta, ok := trace.(Delta); ok {
+			resolved, err := a.resolve(delta)
+			if err != nil {
+				return fmt.Errorf("cannot add frame %s, %w", name, err)
+			}
+			index, trace = delta.Trace, resolved
+		} else {
+			next++
+		}
+		if changed[index] {
+			return fmt.Errorf("cannot add frame %s, trace %d is changed twice", name, index)
+		}
+		changed[index] = true
+		indexes = append(indexes, index)
+		// AddTraces sets the type of the traces, frames are decoded by type
+		frame.AddTraces(trace)
+	}
+
+	a.names[name] = true
+	a.fig.Frames = append(a.fig.Frames, grob.Frame{
+		Name:   name,
+		Data:   frame.Data,
+		Traces: indexes,
+		Layout: layout,
+	})
+	return nil
+}
+
+// resolve returns a trace of the type of the trace of the delta with only the attributes of the delta
+func (a *Animation) resolve(delta Delta) (grob.Trace, error) {
+	if delta.Trace < 0 || delta.Trace >= len(a.fig.Data) {
+		return nil, fmt.Errorf("delta of trace %d, the figure has %d traces", delta.Trace, len(a.fig.Data))
+	}
+	base := reflect.ValueOf(a.fig.Data[delta.Trace])
+	if base.Kind() != reflect.Ptr || base.IsNil() {
+		return nil, fmt.Errorf("delta of trace %d, the trace %T cannot be changed", delta.Trace, a.fig.Data[delta.Trace])
+	}
+	trace, ok := reflect.New(base.Type().Elem()).Interface().(grob.Trace)
+	if !ok {
+		return nil, fmt.Errorf("delta of trace %d, the trace %T cannot be changed", delta.Trace, a.fig.Data[delta.Trace])
+	}
+
+	paths := make([]string, 0, len(delta.Attributes))
+	for path := range delta.Attributes {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		err := grob.SetTrace(path, delta.Attributes[path])(trace)
+		if err != nil {
+			return nil, fmt.Errorf("delta of trace %d, %w", delta.Trace, err)
+		}
+	}
+	return trace, nil
+}
@@ -0,0 +1,13 @@
+package frames_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestFrames(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Frames Suite")
+}
@@ -0,0 +1,143 @@
+package frames_test
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/MetalBlueberry/go-plotly/frames"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var _ = Describe("Frames", func() {
+
+	var fig *grob.Fig
+
+	BeforeEach(func() {
+		fig = &grob.Fig{}
+		fig.AddTraces(
+			&grob.Scatter{Y: []float64{1, 2}},
+			&grob.Bar{Y: []float64{3, 4}},
+		)
+	})
+
+	It("Should replace the traces in order", func() {
+		anim := frames.New(fig)
+		err := anim.Add("2020", &grob.Scatter{Y: []float64{5, 6}}, &grob.Bar{Y: []float64{7, 8}})
+		Expect(err).To(BeNil())
+
+		Expect(fig.Frames).To(HaveLen(1))
+		Expect(fig.Frames[0].Name).To(Equal("2020"))
+		Expect(fig.Frames[0].Traces).To(Equal([]int{0, 1}))
+		Expect(fig.Frames[0].Data[0].(*grob.Scatter).Type).To(Equal(grob.TraceTypeScatter))
+		Expect(fig.Frames[0].Data[1].(*grob.Bar).Type).To(Equal(grob.TraceTypeBar))
+	})
+
+	It("Should change the attributes of the deltas only", func() {
+		anim := frames.New(fig)
+		err := anim.AddLayout("2021", &grob.Layout{Title: &grob.LayoutTitle{Text: "2021"}},
+			frames.Delta{Trace: 1, Attributes: map[string]interface{}{"y": []float64{9, 10}, "marker.color": "red"}},
+		)
+		Expect(err).To(BeNil())
+
+		frame := fig.Frames[0]
+		Expect(frame.Traces).To(Equal([]int{1}))
+		Expect(frame.Layout.Title.Text).To(Equal(grob.String("2021")))
+		Expect(frame.Data).To(Equal(grob.Traces{&grob.Bar{
+			Type:   grob.TraceTypeBar,
+			Y:      []float64{9, 10},
+			Marker: &grob.BarMarker{Color: "red"},
+		}}))
+
+		data, err := json.Marshal(fig)
+		Expect(err).To(BeNil())
+		decoded := &grob.Fig{}
+		Expect(json.Unmarshal(data, decoded)).To(Succeed())
+		Expect(decoded.Frames[0].Data[0].(*grob.Bar).Marker.Color).To(Equal("red"))
+	})
+
+	It("Should reject invalid frames", func() {
+		anim := frames.New(&grob.Fig{Frames: []grob.Frame{{Name: "2020"}}, Data: fig.Data})
+		Expect(anim.Add("2020")).To(MatchError(ContainSubstring("already exists")))
+		Expect(anim.Add("")).To(HaveOccurred())
+		Expect(anim.Add("a", frames.Delta{Trace: 2})).To(MatchError(ContainSubstring("the figure has 2 traces")))
+		Expect(anim.Add("b", frames.Delta{Trace: 0, Attributes: map[string]interface{}{"unknown": 1}})).To(HaveOccurred())
+		Expect(anim.Add("c", &grob.Scatter{}, frames.Delta{Trace: 0})).To(MatchError(ContainSubstring("changed twice")))
+		Expect(anim.Figure().Frames).To(HaveLen(1))
+	})
+
+	It("Should play the frames with the slider in sync", func() {
+		anim := frames.New(fig)
+		Expect(anim.Add("2020", &grob.Scatter{})).To(Succeed())
+		Expect(anim.Add("2021", &grob.Scatter{})).To(Succeed())
+		anim.Controls(frames.PlayButton(500*time.Millisecond, frames.CubicInOut), frames.Slider(frames.SliderOptions{Prefix: "Year: "}))
+
+		data, err := json.Marshal(fig.Layout.Updatemenus)
+		Expect(err).To(BeNil())
+		Expect(data).To(MatchJSON(`[{
+			"type": "buttons",
+			"direction": "left",
+			"active": 0,
+			"showactive": false,
+			"x": 0.1,
+			"xanchor": "right",
+			"yanchor": "top",
+			"buttons": [
+				{"label": "Play", "method": "animate", "args": [null, {
+					"mode": "afterall",
+					"fromcurrent": true,
+					"frame": {"duration": 500, "redraw": false},
+					"transition": {"duration": 500, "easing": "cubic-in-out"}
+				}]},
+				{"label": "Pause", "method": "animate", "args": [[null], {
+					"mode": "immediate",
+					"frame": {"duration": 0, "redraw": false},
+					"transition": {"duration": 0}
+				}]}
+			]
+		}]`))
+
+		data, err = json.Marshal(fig.Layout.Sliders)
+		Expect(err).To(BeNil())
+		step := func(name string) string {
+			return `{"label": "` + name + `", "method": "animate", "args": [["` + name + `"], {
+				"mode": "immediate",
+				"frame": {"duration": 500, "redraw": false},
+				"transition": {"duration": 500, "easing": "cubic-in-out"}
+			}]}`
+		}
+		Expect(data).To(MatchJSON(`[{
+			"active": 0,
+			"currentvalue": {"prefix": "Year: "},
+			"x": 0.1,
+			"len": 0.9,
+			"xanchor": "left",
+			"yanchor": "top",
+			"steps": [` + step("2020") + `, ` + step("2021") + `]
+		}]`))
+	})
+
+	It("Should redraw the traces that cannot transition", func() {
+		fig.AddTraces(&grob.Heatmap{})
+		anim := frames.New(fig)
+		anim.Controls(frames.PlayButton(time.Second, frames.Linear))
+
+		play := fig.Layout.Updatemenus.([]interface{})[0]
+		data, err := json.Marshal(play)
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(ContainSubstring(`"frame":{"duration":1000,"redraw":true}`))
+	})
+
+	It("Should jump to the frames without play button", func() {
+		anim := frames.New(fig)
+		Expect(anim.Add("2020", &grob.Scatter{})).To(Succeed())
+		anim.Controls(frames.Slider())
+
+		Expect(fig.Layout.Updatemenus).To(BeNil())
+		data, err := json.Marshal(fig.Layout.Sliders)
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(ContainSubstring(`"frame":{"duration":0,"redraw":false}`))
+	})
+})
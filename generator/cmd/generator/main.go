@@ -18,6 +18,7 @@ func (c Creator) Create(name string) (io.WriteCloser, error) {
 func main() {
 	schema := flag.String("schema", "schema.json", "plotly schema")
 	outputDirectory := flag.String("output-directory", "gen/", "output directory, must exist before generation")
+	marshalJSON := flag.Bool("marshal-json", false, "generate MarshalJSON methods with precomputed fields instead of relying on reflection")
 
 	flag.Parse()
 
@@ -31,7 +32,9 @@ func main() {
 		log.Fatalf("unable to load schema, %s", err)
 	}
 
-	r, err := generator.NewRenderer(Creator{}, root)
+	r, err := generator.NewRenderer(Creator{}, root, generator.RendererOptions{
+		MarshalJSON: *marshalJSON,
+	})
 	if err != nil {
 		log.Fatalf("unable to create a new renderer, %s", err)
 		panic(err)
@@ -54,6 +57,23 @@ func main() {
 		log.Fatal("unable to write config, %w", err)
 	}
 
+	err = r.CreateFrames(output)
+	if err != nil {
+		log.Fatal("unable to write frames, %w", err)
+	}
+
+	err = r.CreateRegistry(output)
+	if err != nil {
+		log.Fatal("unable to write registry, %w", err)
+	}
+
+	if *marshalJSON {
+		err = r.CreateMarshal(output)
+		if err != nil {
+			log.Fatal("unable to write marshal, %w", err)
+		}
+	}
+
 	err = r.CreateUnmarshal(output)
 	if err != nil {
 		log.Fatal("unable to write unmarshal, %w", err)
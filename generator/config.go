@@ -0,0 +1,209 @@
+package generator
+
+import (
+	"strings"
+
+	"github.com/huandu/xstrings"
+)
+
+// modeBarButtons are the names of the default mode bar buttons.
+// They are not part of the schema, the list comes from plotly.js src/components/modebar/buttons.js
+var modeBarButtons = []string{
+	"toImage",
+	"sendDataToCloud",
+	"editInChartStudio",
+	"zoom2d",
+	"pan2d",
+	"select2d",
+	"lasso2d",
+	"zoomIn2d",
+	"zoomOut2d",
+	"autoScale2d",
+	"resetScale2d",
+	"hoverClosestCartesian",
+	"hoverCompareCartesian",
+	"zoom3d",
+	"pan3d",
+	"orbitRotation",
+	"tableRotation",
+	"resetCameraDefault3d",
+	"resetCameraLastSave3d",
+	"hoverClosest3d",
+	"zoomInGeo",
+	"zoomOutGeo",
+	"resetGeo",
+	"hoverClosestGeo",
+	"hoverClosestGl2d",
+	"hoverClosestPie",
+	"resetViewSankey",
+	"toggleHover",
+	"resetViews",
+	"toggleSpikelines",
+	"resetViewMapbox",
+	// the shape drawing buttons are not in the mode bar by default, they are added with modeBarButtonsToAdd
+	"drawline",
+	"drawopenpath",
+	"drawclosedpath",
+	"drawcircle",
+	"drawrect",
+	"eraseshape",
+}
+
+// locales are the locales supported by plotly.js.
+// en and en-US are built in, the rest are distributed as dist/plotly-locale-<locale>.js
+// They are not part of the schema, the list comes from plotly.js lib/locales
+var locales = []string{
+	"en",
+	"en-US",
+	"af",
+	"am",
+	"ar",
+	"ar-DZ",
+	"ar-EG",
+	"az",
+	"bg",
+	"bs",
+	"ca",
+	"cs",
+	"cy",
+	"da",
+	"de",
+	"de-CH",
+	"el",
+	"eo",
+	"es",
+	"es-AR",
+	"es-PE",
+	"et",
+	"eu",
+	"fa",
+	"fi",
+	"fo",
+	"fr",
+	"fr-CH",
+	"gl",
+	"he",
+	"hi-IN",
+	"hr",
+	"hu",
+	"hy",
+	"id",
+	"is",
+	"it",
+	"ja",
+	"ka",
+	"km",
+	"ko",
+	"lt",
+	"lv",
+	"me",
+	"me-ME",
+	"mk",
+	"ml",
+	"ms",
+	"mt",
+	"nl",
+	"no",
+	"pl",
+	"pt-BR",
+	"pt-PT",
+	"ro",
+	"ru",
+	"sk",
+	"sl",
+	"sq",
+	"sr",
+	"sr-SR",
+	"sv",
+	"sw",
+	"ta",
+	"th",
+	"tr",
+	"tt",
+	"uk",
+	"ur",
+	"vi",
+	"zh-CN",
+	"zh-HK",
+	"zh-TW",
+}
+
+// configEnums returns the enums that are documented by plotly.js for the config but are free strings in the schema.
+func configEnums() []enumFile {
+	buttons := enumFile{
+		Name:        "ConfigModeBarButton",
+		Description: "The name of a built-in mode bar button, to be used in ModeBarButtons, ModeBarButtonsToAdd and ModeBarButtonsToRemove",
+		Type:        "string",
+		ConstOrVar:  constant,
+		Values:      make([]enumValue, 0, len(modeBarButtons)),
+	}
+	for _, button := range modeBarButtons {
+		buttons.Values = append(buttons.Values, enumValue{
+			Name:  buttons.Name + xstrings.FirstRuneToUpper(button),
+			Value: "\"" + button + "\"",
+		})
+	}
+
+	locale := enumFile{
+		Name:        "ConfigLocale",
+		Description: "A localization supported by plotly.js. Locales other than en and en-US require the plotly-locale-<locale>.js bundle",
+		Type:        "string",
+		ConstOrVar:  constant,
+		Values:      make([]enumValue, 0, len(locales)),
+	}
+	for _, l := range locales {
+		parts := strings.SplitN(l, "-", 2)
+		name := locale.Name + xstrings.FirstRuneToUpper(parts[0])
+		if len(parts) == 2 {
+			name += parts[1]
+		}
+		locale.Values = append(locale.Values, enumValue{
+			Name:  name,
+			Value: "\"" + l + "\"",
+		})
+	}
+
+	return []enumFile{buttons, locale}
+}
+
+// toImageButtonOptions describes the options of the toImage mode bar button, the schema only has an attribute of any type for them.
+// The keys come from plotly.js src/components/modebar/buttons.js
+func toImageButtonOptions(attr *Attribute) *Attribute {
+	object := &Attribute{
+		Role:        RoleObject,
+		Description: attr.Description,
+		Name:        attr.Name,
+		Parent:      attr.Parent,
+		Attributes:  map[string]*Attribute{},
+	}
+	add := func(name string, a *Attribute) {
+		a.Name = name
+		a.Parent = object
+		object.Attributes[name] = a
+	}
+	add("format", &Attribute{
+		ValType:     ValTypeEnum,
+		Values:      []interface{}{"png", "svg", "jpeg", "webp"},
+		Dflt:        "png",
+		Description: "Sets the format of the downloaded image.",
+	})
+	add("filename", &Attribute{
+		ValType:     ValTypeString,
+		Dflt:        "newplot",
+		Description: "Sets the name of the downloaded file, without extension.",
+	})
+	add("width", &Attribute{
+		ValType:     ValTypeNumber,
+		Description: "Sets the width of the image in pixels, defaults to the width of the plot on the page.",
+	})
+	add("height", &Attribute{
+		ValType:     ValTypeNumber,
+		Description: "Sets the height of the image in pixels, defaults to the height of the plot on the page.",
+	})
+	add("scale", &Attribute{
+		ValType:     ValTypeNumber,
+		Dflt:        1,
+		Description: "Multiplies the width and the height of the image, to increase its resolution.",
+	})
+	return object
+}
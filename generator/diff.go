@@ -0,0 +1,293 @@
+package generator
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/huandu/xstrings"
+)
+
+// ChangeKind tells how an attribute changed between two schemas
+type ChangeKind string
+
+const (
+	ChangeAdded   ChangeKind = "added"
+	ChangeRemoved ChangeKind = "removed"
+	ChangeChanged ChangeKind = "changed"
+)
+
+// Change is an attribute that differs between two schemas
+type Change struct {
+	// Path of the attribute, like scatter.marker.color
+	Path string
+	Kind ChangeKind
+	// Details are the differences of a changed attribute, like its type or its enumerated values
+	Details []string
+	// GoAPI are the changes of the generated code, like a field added or the type of a field
+	GoAPI []string
+}
+
+// Diff compares the attributes of two schemas, such as the schemas of two plotly.js versions,
+// and returns the changes sorted by path. The changes of an object are not repeated for its attributes.
+// It fails if the attributes of a schema cannot be registered, see Registry.
+func Diff(before, after *Root) ([]Change, error) {
+	old, err := Registry(before)
+	if err != nil {
+		return nil, fmt.Errorf("cannot register the attributes of the old schema, %w", err)
+	}
+	current, err := Registry(after)
+	if err != nil {
+		return nil, fmt.Errorf("cannot register the attributes of the new schema, %w", err)
+	}
+
+	changes := []Change{}
+	for path, attr := range current {
+		if _, ok := old[path]; !ok && !parentIn(path, current, old) {
+			changes = append(changes, Change{
+				Path:  path,
+				Kind:  ChangeAdded,
+				GoAPI: goAdded(path, attr),
+			})
+		}
+	}
+	for path, attr := range old {
+		if _, ok := current[path]; !ok && !parentIn(path, old, current) {
+			changes = append(changes, Change{
+				Path:  path,
+				Kind:  ChangeRemoved,
+				GoAPI: goRemoved(path, attr),
+			})
+		}
+	}
+	for path, attr := range current {
+		previous, ok := old[path]
+		if !ok {
+			continue
+		}
+		details := attributeDetails(previous, attr)
+		if len(details) == 0 {
+			continue
+		}
+		changes = append(changes, Change{
+			Path:    path,
+			Kind:    ChangeChanged,
+			Details: details,
+			GoAPI:   goChanged(path, previous, attr),
+		})
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+	return changes, nil
+}
+
+// parentIn reports if the parent object of the attribute is in the registry but not in the other,
+// so the attribute is reported with its parent
+func parentIn(path string, registry, other map[string]*Attribute) bool {
+	i := strings.LastIndex(path, ".")
+	if i < 0 {
+		return false
+	}
+	parent := path[:i]
+	_, inRegistry := registry[parent]
+	_, inOther := other[parent]
+	return inRegistry && !inOther
+}
+
+// attributeDetails describes the differences of an attribute, the descriptions are ignored
+func attributeDetails(before, after *Attribute) []string {
+	details := []string{}
+	if before.Role != after.Role {
+		details = append(details, fmt.Sprintf("role %s -> %s", before.Role, after.Role))
+	}
+	if before.ValType != after.ValType {
+		details = append(details, fmt.Sprintf("valType %s -> %s", before.ValType, after.ValType))
+	}
+	if before.ArrayOK != after.ArrayOK {
+		details = append(details, fmt.Sprintf("arrayOk %t -> %t", before.ArrayOK, after.ArrayOK))
+	}
+	if before.Deprecated != after.Deprecated {
+		details = append(details, fmt.Sprintf("deprecated %t -> %t", before.Deprecated, after.Deprecated))
+	}
+	if !reflect.DeepEqual(before.Dflt, after.Dflt) {
+		details = append(details, fmt.Sprintf("default %v -> %v", before.Dflt, after.Dflt))
+	}
+	added, removed := compareValues(before.Values, after.Values)
+	if len(added) > 0 {
+		details = append(details, "values added: "+strings.Join(added, ", "))
+	}
+	if len(removed) > 0 {
+		details = append(details, "values removed: "+strings.Join(removed, ", "))
+	}
+	added, removed = compareValues(stringValues(before.Flags), stringValues(after.Flags))
+	if len(added) > 0 {
+		details = append(details, "flags added: "+strings.Join(added, ", "))
+	}
+	if len(removed) > 0 {
+		details = append(details, "flags removed: "+strings.Join(removed, ", "))
+	}
+	return details
+}
+
+// compareValues returns the values added and removed, formatted
+func compareValues(before, after []interface{}) ([]string, []string) {
+	old := map[string]bool{}
+	for _, v := range before {
+		old[fmt.Sprint(v)] = true
+	}
+	current := map[string]bool{}
+	added := []string{}
+	for _, v := range after {
+		value := fmt.Sprint(v)
+		current[value] = true
+		if !old[value] {
+			added = append(added, value)
+		}
+	}
+	removed := []string{}
+	for _, v := range before {
+		if value := fmt.Sprint(v); !current[value] {
+			removed = append(removed, value)
+		}
+	}
+	return added, removed
+}
+
+func stringValues(values []string) []interface{} {
+	result := make([]interface{}, len(values))
+	for i, v := range values {
+		result[i] = v
+	}
+	return result
+}
+
+// goName is the name of the generated struct or type of the path, like ScatterMarkerColor
+func goName(path string) string {
+	parts := strings.Split(path, ".")
+	for i, part := range parts {
+		parts[i] = xstrings.ToCamelCase(part)
+	}
+	return strings.Join(parts, "")
+}
+
+// goField is the generated field of the path, like Scatter.Marker.Color
+func goField(path string) string {
+	parts := strings.Split(path, ".")
+	for i, part := range parts {
+		parts[i] = xstrings.ToCamelCase(part)
+	}
+	return strings.Join(parts, ".")
+}
+
+// generated reports if the attribute is generated in Go. Deprecated attributes and those of items arrays are not
+func generated(attr *Attribute) bool {
+	if attr.Deprecated {
+		return false
+	}
+	for parent := attr.Parent; parent != nil; parent = parent.Parent {
+		if parent.Role == RoleObject && len(parent.Items) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// goType is the type of the generated field of the attribute
+func goType(path string, attr *Attribute) string {
+	switch {
+	case attr.Role == RoleObject && len(attr.Items) > 0:
+		return "interface{}"
+	case attr.Role == RoleObject && attr.ValType == "":
+		return "*" + goName(path)
+	case attr.ValType == ValTypeFlagList, attr.ValType == ValTypeEnum:
+		return goName(path)
+	case attr.ValType == ValTypeColorscale:
+		return "ColorScale"
+	case attr.ArrayOK && (attr.ValType == ValTypeNumber || attr.ValType == ValTypeInteger || attr.ValType == ValTypeAngle):
+		return "interface{}"
+	}
+	return valTypeMap[attr.ValType]
+}
+
+// constantValues are the values of the constants of enumerated attributes and flag lists
+func constantValues(attr *Attribute) []interface{} {
+	if attr.ValType == ValTypeFlagList {
+		return append(stringValues(attr.Flags), attr.Extras...)
+	}
+	return attr.Values
+}
+
+// goConstants are the names of the generated constants of an enumerated attribute or a flag list
+func goConstants(path string, values []interface{}) []string {
+	prefix := goName(path)
+	constants := []string{}
+	for _, v := range values {
+		switch v := v.(type) {
+		case string:
+			if v == "" {
+				constants = append(constants, prefix+"Empty")
+			} else {
+				constants = append(constants, prefix+xstrings.ToCamelCase(v))
+			}
+		case bool:
+			constants = append(constants, prefix+xstrings.ToCamelCase(fmt.Sprint(v)))
+		}
+	}
+	return constants
+}
+
+func goAdded(path string, attr *Attribute) []string {
+	if !generated(attr) {
+		return nil
+	}
+	api := []string{fmt.Sprintf("field %s %s added", goField(path), goType(path, attr))}
+	if definesType(attr) {
+		api = append(api, fmt.Sprintf("type %s added", goName(path)))
+	}
+	return api
+}
+
+func goRemoved(path string, attr *Attribute) []string {
+	if !generated(attr) {
+		return nil
+	}
+	api := []string{fmt.Sprintf("field %s removed, breaking", goField(path))}
+	if definesType(attr) {
+		api = append(api, fmt.Sprintf("type %s removed, breaking", goName(path)))
+	}
+	return api
+}
+
+// definesType reports if a type is generated for the attribute: objects, enumerated values and flag lists
+func definesType(attr *Attribute) bool {
+	return attr.ValType == ValTypeEnum || attr.ValType == ValTypeFlagList || attr.Role == RoleObject && attr.ValType == "" && len(attr.Items) == 0
+}
+
+func goChanged(path string, before, after *Attribute) []string {
+	wasGenerated, isGenerated := generated(before), generated(after)
+	switch {
+	case !wasGenerated && !isGenerated:
+		return nil
+	case !wasGenerated:
+		return goAdded(path, after)
+	case !isGenerated:
+		return goRemoved(path, before)
+	}
+
+	api := []string{}
+	if oldType, newType := goType(path, before), goType(path, after); oldType != newType {
+		api = append(api, fmt.Sprintf("field %s type %s -> %s, breaking", goField(path), oldType, newType))
+	}
+	if before.ValType == after.ValType && (after.ValType == ValTypeEnum || after.ValType == ValTypeFlagList) {
+		added, removed := compareValues(stringValues(goConstants(path, constantValues(before))), stringValues(goConstants(path, constantValues(after))))
+		for _, name := range added {
+			api = append(api, fmt.Sprintf("constant %s added", name))
+		}
+		for _, name := range removed {
+			api = append(api, fmt.Sprintf("constant %s removed, breaking", name))
+		}
+	}
+	return api
+}
@@ -0,0 +1,83 @@
+package generator_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/MetalBlueberry/go-plotly/generator"
+)
+
+// loadSchema parses a schema with the given traces and layout attributes
+func loadSchema(traces, layout string) *generator.Root {
+	root, err := generator.LoadSchema(strings.NewReader(`{"schema": {
+		"traces": ` + traces + `,
+		"layout": {"layoutAttributes": ` + layout + `}
+	}}`))
+	Expect(err).To(BeNil())
+	return root
+}
+
+var _ = Describe("Diff", func() {
+
+	It("Should report the attributes added, removed and changed", func() {
+		before := loadSchema(`{"scatter": {"type": "scatter", "attributes": {
+			"type": "scatter",
+			"mode": {"valType": "flaglist", "flags": ["lines", "markers"], "role": "info"},
+			"opacity": {"valType": "number", "role": "style", "dflt": 1},
+			"legendrank": {"valType": "number", "role": "info"}
+		}}}`, `{
+			"hovermode": {"valType": "enumerated", "values": ["x", "closest"], "role": "info"}
+		}`)
+		after := loadSchema(`{"scatter": {"type": "scatter", "attributes": {
+			"type": "scatter",
+			"mode": {"valType": "flaglist", "flags": ["lines", "markers", "text"], "role": "info"},
+			"opacity": {"valType": "number", "role": "style", "dflt": 1, "arrayOk": true},
+			"marker": {"role": "object", "size": {"valType": "number", "role": "style"}}
+		}}}`, `{
+			"hovermode": {"valType": "enumerated", "values": ["x", "x unified"], "role": "info"}
+		}`)
+
+		changes, err := generator.Diff(before, after)
+		Expect(err).To(BeNil())
+		Expect(changes).To(Equal([]generator.Change{
+			{
+				Path:    "layout.hovermode",
+				Kind:    generator.ChangeChanged,
+				Details: []string{"values added: x unified", "values removed: closest"},
+				GoAPI:   []string{"constant LayoutHovermodeXUnified added", "constant LayoutHovermodeClosest removed, breaking"},
+			},
+			{
+				Path:  "scatter.legendrank",
+				Kind:  generator.ChangeRemoved,
+				GoAPI: []string{"field Scatter.Legendrank removed, breaking"},
+			},
+			{
+				Path:  "scatter.marker",
+				Kind:  generator.ChangeAdded,
+				GoAPI: []string{"field Scatter.Marker *ScatterMarker added", "type ScatterMarker added"},
+			},
+			{
+				Path:    "scatter.mode",
+				Kind:    generator.ChangeChanged,
+				Details: []string{"flags added: text"},
+				GoAPI:   []string{"constant ScatterModeText added"},
+			},
+			{
+				Path:    "scatter.opacity",
+				Kind:    generator.ChangeChanged,
+				Details: []string{"arrayOk false -> true"},
+				GoAPI:   []string{"field Scatter.Opacity type float64 -> interface{}, breaking"},
+			},
+		}))
+	})
+
+	It("Should find no changes in the same schema", func() {
+		root, err := generator.LoadSchema(strings.NewReader(string(schema)))
+		Expect(err).To(BeNil())
+		changes, err := generator.Diff(root, root)
+		Expect(err).To(BeNil())
+		Expect(changes).To(BeEmpty())
+	})
+})
@@ -33,9 +33,25 @@ type Schema struct {
 	Traces Traces `json:"traces,omitempty"`
 	Layout Layout `json:"layout,omitempty"`
 	// Transforms *Transforms `json:"transforms,omitempty"`
-	// Frames     *Frames     `json:"frames,omitempty"`
-	// Animation  *Animation  `json:"animation,omitempty"`
-	Config *ConfigAttributes `json:"config,omitempty"`
+	Frames    *Frames              `json:"frames,omitempty"`
+	Animation *AnimationAttributes `json:"animation,omitempty"`
+	Config    *ConfigAttributes    `json:"config,omitempty"`
+}
+
+type Frames struct {
+	Items FramesItems `json:"items,omitempty"`
+}
+
+type FramesItems struct {
+	FramesEntry FramesEntry `json:"frames_entry,omitempty"`
+}
+
+type FramesEntry struct {
+	Names map[string]*Attribute `json:"-"`
+}
+
+type AnimationAttributes struct {
+	Names map[string]*Attribute `json:"-"`
 }
 
 type ConfigAttributes struct {
@@ -149,10 +165,67 @@ func (attr *ConfigAttributes) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+func (attr *FramesEntry) UnmarshalJSON(b []byte) error {
+	var err error
+
+	fields := map[string]json.RawMessage{}
+	err = json.Unmarshal(b, &fields)
+	if err != nil {
+		return err
+	}
+
+	names, err := parseFields(fields, nil)
+	if err != nil {
+		return err
+	}
+	attr.Names = names
+	return nil
+}
+
+func (attr *AnimationAttributes) UnmarshalJSON(b []byte) error {
+	var err error
+
+	fields := map[string]json.RawMessage{}
+	err = json.Unmarshal(b, &fields)
+	if err != nil {
+		return err
+	}
+
+	names, err := parseFields(fields, nil)
+	if err != nil {
+		return err
+	}
+	attr.Names = names
+	return nil
+}
+
 func parseFields(fields map[string]json.RawMessage, parent *Attribute) (_ map[string]*Attribute, err error) {
 	attributes := make(map[string]*Attribute)
 	for name, value := range fields {
 
+		if name == "_deprecated" {
+			// deprecated attributes are kept apart, they are not generated but they are part of the registry
+			subFields := map[string]json.RawMessage{}
+			err = json.Unmarshal(value, &subFields)
+			if err != nil {
+				return nil, fmt.Errorf("cannot unmarshal deprecated attributes, %w", err)
+			}
+			attr := &Attribute{
+				Role:   RoleObject,
+				Name:   name,
+				Parent: parent,
+			}
+			attr.Attributes, err = parseFields(subFields, attr)
+			if err != nil {
+				return nil, fmt.Errorf("on %s, %w", name, err)
+			}
+			for _, deprecated := range attr.Attributes {
+				deprecated.Deprecated = true
+			}
+			attributes[name] = attr
+			continue
+		}
+
 		if isMetaKey(name) {
 			// is a metakey and it is not required to
 			// generate the schema
@@ -160,8 +233,9 @@ func parseFields(fields map[string]json.RawMessage, parent *Attribute) (_ map[st
 		}
 
 		role := &struct {
-			Role  Role            `json:"role,omitempty"`
-			Items json.RawMessage `json:"items,omitempty"`
+			Role    Role            `json:"role,omitempty"`
+			Items   json.RawMessage `json:"items,omitempty"`
+			ValType ValType         `json:"valType,omitempty"`
 		}{}
 		err = json.Unmarshal(value, role)
 		if err != nil {
@@ -189,7 +263,7 @@ func parseFields(fields map[string]json.RawMessage, parent *Attribute) (_ map[st
 			attr.Items = subAttr
 			attributes[name] = attr
 
-		case role.Role == RoleObject:
+		case role.Role == RoleObject && role.ValType == "":
 			subFields := map[string]json.RawMessage{}
 
 			err = json.Unmarshal(value, &subFields)
@@ -303,6 +377,8 @@ type Attribute struct {
 	Max     json.Number   `json:"max,omitempty"`
 	ArrayOK bool          `json:"arrayOk,omitempty"`
 	Anim    bool          `json:"anim,omitempty"`
+	// Deprecated attributes are not generated, they come from the _deprecated key of the schema
+	Deprecated bool `json:"deprecated,omitempty"`
 
 	Name       string                `json:"-"`
 	Attributes map[string]*Attribute `json:"-"`
@@ -3,9 +3,11 @@ package generator
 import (
 	"bytes"
 	"embed"
+	"encoding/json"
 	"fmt"
 	"go/format"
 	"io"
+	"log"
 	"path"
 	"sort"
 	"strings"
@@ -23,19 +25,31 @@ type Creator interface {
 type Renderer struct {
 	tmpl *template.Template
 	root *Root
+	opts RendererOptions
 
 	fs Creator
 }
 
+// RendererOptions configures optional features of the generated code
+type RendererOptions struct {
+	// MarshalJSON generates a MarshalJSON method for every struct that writes the fields known at generation time
+	// instead of relying on reflection. The output is the same as encoding/json.
+	// CreateMarshal must be called to create the encoder used by the methods.
+	MarshalJSON bool
+}
+
 //go:embed templates/*.tmpl
 var templates embed.FS
 
 // NewRenderer initializes a renderer
-func NewRenderer(fs Creator, root *Root) (*Renderer, error) {
+func NewRenderer(fs Creator, root *Root, opts ...RendererOptions) (*Renderer, error) {
 	r := &Renderer{
 		root: root,
 		fs:   fs,
 	}
+	if len(opts) == 1 {
+		r.opts = opts[0]
+	}
 	tmpl, err := template.New("base").ParseFS(templates, "templates/*.tmpl")
 	if err != nil {
 		return nil, err
@@ -46,6 +60,9 @@ func NewRenderer(fs Creator, root *Root) (*Renderer, error) {
 
 var doNotEdit = "// Code generated by go-plotly/generator. DO NOT EDIT."
 
+// layoutAxisCount is the number of x and y axes available in the layout, enough for a 4x4 grid of subplots
+const layoutAxisCount = 16
+
 // CreateTrace creates a file with the content of a trace by name
 func (r *Renderer) CreateTrace(dir string, name string) error {
 	src := &bytes.Buffer{}
@@ -110,12 +127,18 @@ var TraceType%s TraceType = "%s"
 func (trace *%s) GetType() TraceType {
 	return TraceType%s
 }
+
+// Validate checks the trace against the constraints of the schema, see ValidateTrace
+func (trace *%s) Validate() error {
+	return ValidateTrace(trace)
+}
 `,
 		doNotEdit,
 		traceFile.MainType.Name,
 		traceName,
 		traceFile.MainType.Name,
 		traceFile.MainType.Name,
+		traceFile.MainType.Name,
 	)
 
 	err = r.tmpl.ExecuteTemplate(w, "trace.tmpl", traceFile.MainType)
@@ -140,7 +163,7 @@ func (trace *%s) GetType() TraceType {
 			return err
 		}
 	}
-	return nil
+	return r.writeMarshalers(w, &traceFile, traceFile.MainType)
 }
 
 // CreateTraces creates all traces in the given directory
@@ -198,37 +221,44 @@ func (r *Renderer) WriteLayout(w io.Writer) error {
 		FlagLists: []flagList{},
 	}
 
-	fields, err := traceFile.parseAttributes(traceFile.MainType.Name, traceFile.MainType.Name, r.root.Schema.Layout.LayoutAttributes.Names)
+	fields, err := traceFile.parseAttributes(traceFile.MainType.Name, traceFile.MainType.Name, layoutAttributes(r.root.Schema))
 	if err != nil {
 		return fmt.Errorf("cannot parse attributes, %w", err)
 	}
-	traceFile.MainType.Fields = append(traceFile.MainType.Fields, fields...)
+	layoutFields := make([]layoutField, 0, len(fields))
+	for _, field := range fields {
+		layoutFields = append(layoutFields, layoutField{Source: "layout", Field: field})
+	}
 
-	for name, trace := range r.root.Schema.Traces {
+	traceNames := make([]string, 0, len(r.root.Schema.Traces))
+	for name := range r.root.Schema.Traces {
+		traceNames = append(traceNames, name)
+	}
+	sort.Strings(traceNames)
+	for _, name := range traceNames {
+		trace := r.root.Schema.Traces[name]
+		if traceSubplot(trace) != "" {
+			continue
+		}
 		fields, err := traceFile.parseAttributes(xstrings.ToCamelCase(name), "Layout", trace.LayoutAttributes.Names)
 		if err != nil {
 			return fmt.Errorf("cannot parse attributes, %w", err)
 		}
-		traceFile.MainType.Fields = append(traceFile.MainType.Fields, fields...)
+		for _, field := range fields {
+			layoutFields = append(layoutFields, layoutField{Source: name, Field: field})
+		}
 	}
 
-	sort.Sort(traceFile.MainType.Fields)
-	sort.Sort(traceFile.Enums)
+	sort.Stable(traceFile.Enums)
 
-	// remove duplicate fields
-	uniqueFields := make([]structField, 0, len(traceFile.MainType.Fields))
-	fieldMap := map[string]int{}
-	for i, field := range traceFile.MainType.Fields {
-		_, ok := fieldMap[field.Name]
-		if !ok {
-			fieldMap[field.Name] = i
-			uniqueFields = append(uniqueFields, field)
-			continue
-		}
+	// merge duplicate fields
+	uniqueFields, report := mergeLayoutFields(layoutFields)
+	for _, line := range report {
+		log.Print(line)
 	}
 	traceFile.MainType.Fields = uniqueFields
 
-	// merge duplicate enums
+	// merge duplicate enums, a value shared by several traces keeps the constant of the first one
 	uniqueEnums := make([]enumFile, 0, len(traceFile.Enums))
 	enumMap := map[string]int{}
 	for _, enum := range traceFile.Enums {
@@ -238,18 +268,23 @@ func (r *Renderer) WriteLayout(w io.Writer) error {
 			enumMap[enum.Name] = len(uniqueEnums) - 1
 			continue
 		}
-		uniqueEnums[previous].Values = append(uniqueEnums[previous].Values, enum.Values...)
+		uniqueEnums[previous].Empty = uniqueEnums[previous].Empty || enum.Empty
+		for _, value := range enum.Values {
+			if !uniqueEnums[previous].hasValue(value.Value) {
+				uniqueEnums[previous].Values = append(uniqueEnums[previous].Values, value)
+			}
+		}
 	}
 	traceFile.Enums = uniqueEnums
 
-	// add multiple x and y axis
+	// add multiple x and y axis, they are pointers so the axis not in use are omitted
 	for _, label := range []string{"X", "Y"} {
-		for i := 2; i < 7; i++ {
+		for i := 2; i <= layoutAxisCount; i++ {
 			traceFile.MainType.Fields = append(traceFile.MainType.Fields, structField{
 				Name:        fmt.Sprintf("%sAxis%d", label, i),
 				Description: []string{fmt.Sprintf("%s Axis number %d", label, i)},
 				JSONName:    strings.ToLower(fmt.Sprintf("%saxis%d", label, i)),
-				Type:        fmt.Sprintf("Layout%saxis", label),
+				Type:        fmt.Sprintf("*Layout%saxis", label),
 			})
 		}
 	}
@@ -280,8 +315,132 @@ func (r *Renderer) WriteLayout(w io.Writer) error {
 			return err
 		}
 	}
-	return nil
+	return r.writeMarshalers(w, &traceFile, traceFile.MainType)
+}
+
+// traceSubplot returns the layout object of the subplot that draws the trace, like polar for barpolar.
+// It is empty for the traces of the cartesian axes and the traces that are not drawn in a subplot
+func traceSubplot(trace *Trace) string {
+	subplot, ok := trace.Attributes.Names["subplot"]
+	if !ok || subplot.ValType != ValTypeSubplotID {
+		return ""
+	}
+	name, _ := subplot.Dflt.(string)
+	return name
+}
+
+// layoutAttributes returns the attributes of the layout with the layout attributes of the traces drawn in a subplot
+// moved into the subplot object, as plotly.js reads the barmode and bargap of barpolar from layout.polar.
+// The layout attributes of the other traces are set in the layout itself, see WriteLayout
+func layoutAttributes(schema *Schema) map[string]*Attribute {
+	attributes := make(map[string]*Attribute, len(schema.Layout.LayoutAttributes.Names))
+	for name, attr := range schema.Layout.LayoutAttributes.Names {
+		attributes[name] = attr
+	}
+	traceNames := make([]string, 0, len(schema.Traces))
+	for name := range schema.Traces {
+		traceNames = append(traceNames, name)
+	}
+	sort.Strings(traceNames)
+	for _, name := range traceNames {
+		trace := schema.Traces[name]
+		subplot := traceSubplot(trace)
+		object, ok := attributes[subplot]
+		if subplot == "" || !ok || len(trace.LayoutAttributes.Names) == 0 {
+			continue
+		}
+		moved := *object
+		moved.Attributes = make(map[string]*Attribute, len(object.Attributes)+len(trace.LayoutAttributes.Names))
+		for name, attr := range object.Attributes {
+			moved.Attributes[name] = attr
+		}
+		for name, attr := range trace.LayoutAttributes.Names {
+			if _, ok := moved.Attributes[name]; !ok {
+				moved.Attributes[name] = attr
+			}
+		}
+		attributes[subplot] = &moved
+	}
+	return attributes
+}
 
+// layoutField is a layout field together with the name of the trace that defines it.
+// Fields defined directly by the layout have "layout" as source.
+type layoutField struct {
+	Source string
+	Field  structField
+}
+
+// mergeLayoutFields removes the duplicated fields that traces define in the layout, as there can be a single value in the JSON output.
+// Fields with the same definition are merged. Fields sharing the name but with a different definition are namespaced by source
+// in the description of the field. Fields with a different type are emitted as interface{}, which accepts the values of every type,
+// with the type of every source in the description.
+// The returned report describes the field emitted for every merged field.
+func mergeLayoutFields(fields []layoutField) (structFields, []string) {
+	sort.SliceStable(fields, func(i, j int) bool { return fields[i].Field.Name < fields[j].Field.Name })
+
+	unique := make(structFields, 0, len(fields))
+	report := []string{}
+	for i := 0; i < len(fields); {
+		j := i + 1
+		for j < len(fields) && fields[j].Field.Name == fields[i].Field.Name {
+			j++
+		}
+		group := fields[i:j]
+		i = j
+
+		field := group[0].Field
+		if len(group) == 1 {
+			unique = append(unique, field)
+			continue
+		}
+
+		definitions := []string{}
+		sources := map[string][]string{}
+		descriptions := map[string][]string{}
+		types := map[string]string{}
+		for _, f := range group {
+			definition := f.Field.Type + "\n" + strings.Join(f.Field.Description, "\n")
+			if _, ok := sources[definition]; !ok {
+				definitions = append(definitions, definition)
+				descriptions[definition] = f.Field.Description
+				types[definition] = f.Field.Type
+			}
+			sources[definition] = append(sources[definition], f.Source)
+		}
+
+		if len(definitions) == 1 {
+			report = append(report, fmt.Sprintf("layout field %s merged from %s", field.Name, strings.Join(sources[definitions[0]], ", ")))
+			unique = append(unique, field)
+			continue
+		}
+
+		union := false
+		for _, definition := range definitions {
+			if types[definition] != field.Type {
+				union = true
+			}
+		}
+		namespaces := make([]string, 0, len(definitions))
+		field.Description = []string{}
+		for _, definition := range definitions {
+			namespace := strings.Join(sources[definition], ", ")
+			if union {
+				namespace += " (" + types[definition] + ")"
+			}
+			namespaces = append(namespaces, namespace)
+			field.Description = append(field.Description, namespace+":")
+			field.Description = append(field.Description, descriptions[definition]...)
+		}
+		if union {
+			field.Type = "interface{}"
+			report = append(report, fmt.Sprintf("layout field %s emitted as interface{} for the conflicting types of %s", field.Name, strings.Join(namespaces, "; ")))
+		} else {
+			report = append(report, fmt.Sprintf("layout field %s emitted as %s with the conflicting definitions of %s in its description", field.Name, field.Type, strings.Join(namespaces, "; ")))
+		}
+		unique = append(unique, field)
+	}
+	return unique, report
 }
 
 // CreateConfig creates the config file in the given director
@@ -322,11 +481,31 @@ func (r *Renderer) WriteConfig(w io.Writer) error {
 		Enums:     []enumFile{},
 		FlagLists: []flagList{},
 	}
-	fields, err := traceFile.parseAttributes(traceFile.MainType.Name, traceFile.MainType.Name, r.root.Schema.Config.Names)
+	names := make(map[string]*Attribute, len(r.root.Schema.Config.Names))
+	for name, attr := range r.root.Schema.Config.Names {
+		names[name] = attr
+		if name == "toImageButtonOptions" && attr.ValType == ValTypeAny {
+			names[name] = toImageButtonOptions(attr)
+		}
+	}
+	fields, err := traceFile.parseAttributes(traceFile.MainType.Name, traceFile.MainType.Name, names)
 	if err != nil {
 		return fmt.Errorf("cannot parse attributes, %w", err)
 	}
+	// values documented by plotly.js but not enumerated in the schema
+	for i := range fields {
+		switch fields[i].JSONName {
+		case "locale":
+			fields[i].Type = "ConfigLocale"
+		case "modeBarButtonsToAdd", "modeBarButtonsToRemove":
+			fields[i].Type = "[]ConfigModeBarButton"
+		case "modeBarButtons":
+			// the groups of buttons, custom buttons need javascript functions that cannot be written as JSON
+			fields[i].Type = "[][]ConfigModeBarButton"
+		}
+	}
 	traceFile.MainType.Fields = append(traceFile.MainType.Fields, fields...)
+	traceFile.Enums = append(traceFile.Enums, configEnums()...)
 
 	fmt.Fprint(w, `package grob
 
@@ -354,9 +533,309 @@ func (r *Renderer) WriteConfig(w io.Writer) error {
 			return err
 		}
 	}
+	return r.writeMarshalers(w, &traceFile, traceFile.MainType)
+}
+
+// CreateFrames creates the frames file in the given directory
+func (r *Renderer) CreateFrames(dir string) error {
+	src := &bytes.Buffer{}
+	err := r.WriteFrames(src)
+	if err != nil {
+		return err
+	}
+
+	fmtsrc, err := format.Source(src.Bytes())
+	if err != nil {
+		return fmt.Errorf("cannot format source, %w", err)
+	}
+
+	file, err := r.fs.Create(path.Join(dir, "frames_gen.go"))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = file.Write(fmtsrc)
+	if err != nil {
+		return fmt.Errorf("cannot write source, %w", err)
+	}
+
+	return nil
+}
+
+// WriteFrames writes frames and animation options to the given writer
+func (r *Renderer) WriteFrames(w io.Writer) error {
+	traceFile := typeFile{
+		MainType: sstruct{
+			Name:        "Frame",
+			Description: "A frame of an animation. It contains the data and layout properties that change on each step of the animation",
+			Fields:      []structField{},
+		},
+		Objects:   []sstruct{},
+		Enums:     []enumFile{},
+		FlagLists: []flagList{},
+	}
+	fields, err := traceFile.parseAttributes(traceFile.MainType.Name, traceFile.MainType.Name, r.root.Schema.Frames.Items.FramesEntry.Names)
+	if err != nil {
+		return fmt.Errorf("cannot parse attributes, %w", err)
+	}
+	// data and layout have the same format as in the figure
+	for i := range fields {
+		switch fields[i].JSONName {
+		case "data":
+			fields[i].Type = "Traces"
+		case "layout":
+			fields[i].Type = "*Layout"
+		}
+	}
+	traceFile.MainType.Fields = append(traceFile.MainType.Fields, fields...)
+
+	animation := sstruct{
+		Name:        "Animation",
+		Description: "Animation options to be used with Plotly.animate, such as the duration of the frames and the transitions",
+		Fields:      []structField{},
+	}
+	fields, err = traceFile.parseAttributes(animation.Name, animation.Name, r.root.Schema.Animation.Names)
+	if err != nil {
+		return fmt.Errorf("cannot parse attributes, %w", err)
+	}
+	animation.Fields = append(animation.Fields, fields...)
+
+	fmt.Fprint(w, `package grob
+
+`, doNotEdit, "\n\n")
+
+	err = r.tmpl.ExecuteTemplate(w, "trace.tmpl", traceFile.MainType)
+	if err != nil {
+		return err
+	}
+	err = r.tmpl.ExecuteTemplate(w, "trace.tmpl", animation)
+	if err != nil {
+		return err
+	}
+	for i := range traceFile.Objects {
+		err := r.tmpl.ExecuteTemplate(w, "trace.tmpl", traceFile.Objects[i])
+		if err != nil {
+			return err
+		}
+	}
+	for i := range traceFile.Enums {
+		err := r.tmpl.ExecuteTemplate(w, "enum.tmpl", traceFile.Enums[i])
+		if err != nil {
+			return err
+		}
+	}
+	for i := range traceFile.FlagLists {
+		err := r.tmpl.ExecuteTemplate(w, "flaglist.tmpl", traceFile.FlagLists[i])
+		if err != nil {
+			return err
+		}
+	}
+	return r.writeMarshalers(w, &traceFile, traceFile.MainType, animation)
+}
+
+// CreateMarshal creates the file with the encoder used by the MarshalJSON methods on the given directory
+func (r *Renderer) CreateMarshal(dir string) error {
+	src := &bytes.Buffer{}
+	err := r.tmpl.ExecuteTemplate(src, "encoder.tmpl", nil)
+	if err != nil {
+		return err
+	}
+
+	fmtsrc, err := format.Source(src.Bytes())
+	if err != nil {
+		return fmt.Errorf("cannot format source, %w", err)
+	}
+
+	file, err := r.fs.Create(path.Join(dir, "marshal_gen.go"))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = file.Write(fmtsrc)
+	if err != nil {
+		return fmt.Errorf("cannot write source, %w", err)
+	}
+
+	return nil
+}
+
+// writeMarshalers writes the MarshalJSON methods for the given structs and the objects of the file, if enabled.
+func (r *Renderer) writeMarshalers(w io.Writer, file *typeFile, structs ...sstruct) error {
+	if !r.opts.MarshalJSON {
+		return nil
+	}
+	structs = append(structs, file.Objects...)
+	for i := range structs {
+		obj, err := file.marshalStruct(structs[i])
+		if err != nil {
+			return fmt.Errorf("cannot render marshaler for %s, %w", structs[i].Name, err)
+		}
+		err = r.tmpl.ExecuteTemplate(w, "marshal.tmpl", obj)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CreateRegistry creates the attribute registry file in the given directory
+func (r *Renderer) CreateRegistry(dir string) error {
+	file, err := r.fs.Create(path.Join(dir, "attributes_gen.json"))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return r.WriteRegistry(file)
+}
+
+// WriteRegistry writes the metadata of every attribute in the schema to the given writer.
+// It is a JSON object where the keys are the attribute paths, like "scatter.marker.color" or "layout.xaxis.type".
+func (r *Renderer) WriteRegistry(w io.Writer) error {
+	registry, err := Registry(r.root)
+	if err != nil {
+		return err
+	}
+
+	paths := make([]string, 0, len(registry))
+	for p := range registry {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	// one attribute per line to keep the diff readable
+	_, err = fmt.Fprint(w, "{\n")
+	if err != nil {
+		return err
+	}
+	for i, p := range paths {
+		key, err := json.Marshal(p)
+		if err != nil {
+			return err
+		}
+		value, err := json.Marshal(registry[p])
+		if err != nil {
+			return fmt.Errorf("cannot marshal attribute %s, %w", p, err)
+		}
+		separator := ","
+		if i == len(paths)-1 {
+			separator = ""
+		}
+		_, err = fmt.Fprintf(w, "%s:%s%s\n", key, value, separator)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprint(w, "}\n")
+	return err
+}
+
+// Registry returns every attribute of the schema by path, like "scatter.marker.color" or "layout.xaxis.type".
+// It fails if traces define the same layout attribute differently, as a path can only be described once
+func Registry(root *Root) (map[string]*Attribute, error) {
+	registry := map[string]*Attribute{}
+
+	traceNames := make([]string, 0, len(root.Schema.Traces))
+	for name := range root.Schema.Traces {
+		traceNames = append(traceNames, name)
+	}
+	sort.Strings(traceNames)
+
+	for _, name := range traceNames {
+		err := registerAttributes(registry, name, root.Schema.Traces[name].Attributes.Names)
+		if err != nil {
+			return nil, fmt.Errorf("cannot register the attributes of %s, %w", name, err)
+		}
+	}
+	err := registerAttributes(registry, "layout", layoutAttributes(root.Schema))
+	if err != nil {
+		return nil, fmt.Errorf("cannot register the layout attributes, %w", err)
+	}
+	for _, name := range traceNames {
+		if traceSubplot(root.Schema.Traces[name]) != "" {
+			continue
+		}
+		err := registerAttributes(registry, "layout", root.Schema.Traces[name].LayoutAttributes.Names)
+		if err != nil {
+			return nil, fmt.Errorf("cannot register the layout attributes of %s, %w", name, err)
+		}
+	}
+	if root.Schema.Config != nil {
+		err := registerAttributes(registry, "config", root.Schema.Config.Names)
+		if err != nil {
+			return nil, fmt.Errorf("cannot register the config attributes, %w", err)
+		}
+	}
+	if root.Schema.Frames != nil {
+		err := registerAttributes(registry, "frames", root.Schema.Frames.Items.FramesEntry.Names)
+		if err != nil {
+			return nil, fmt.Errorf("cannot register the frames attributes, %w", err)
+		}
+	}
+	if root.Schema.Animation != nil {
+		err := registerAttributes(registry, "animation", root.Schema.Animation.Names)
+		if err != nil {
+			return nil, fmt.Errorf("cannot register the animation attributes, %w", err)
+		}
+	}
+	return registry, nil
+}
+
+// registerAttributes adds the attributes and all its children to the registry under the given prefix.
+// An attribute already registered is not replaced, unless it is deprecated. Deprecated attributes are registered
+// along with the others, if their name is not in use, like the title string replaced by the title object.
+// It fails if an attribute is already registered with a different definition, like a layout attribute of two traces.
+func registerAttributes(registry map[string]*Attribute, prefix string, attributes map[string]*Attribute) error {
+	for _, name := range sortKeys(attributes) {
+		attr := attributes[name]
+		if name == "_deprecated" {
+			err := registerAttributes(registry, prefix, attr.Attributes)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		p := prefix + "." + name
+		existing, ok := registry[p]
+		switch {
+		case !ok || existing.Deprecated && !attr.Deprecated:
+			registry[p] = attr
+		case existing.Deprecated == attr.Deprecated && existing != attr:
+			same, err := sameDefinition(existing, attr)
+			if err != nil {
+				return err
+			}
+			if !same {
+				return fmt.Errorf("attribute %s is already registered with a different definition", p)
+			}
+		}
+		err := registerAttributes(registry, p, attr.Attributes)
+		if err != nil {
+			return err
+		}
+		for _, item := range attr.Items {
+			err := registerAttributes(registry, p, item.Attributes)
+			if err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
+// sameDefinition tells if the attributes have the same definition in the schema, regardless of their children
+func sameDefinition(a, b *Attribute) (bool, error) {
+	first, err := json.Marshal(a)
+	if err != nil {
+		return false, err
+	}
+	second, err := json.Marshal(b)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(first, second), nil
+}
+
 // CreateUnmarshal creates the unmarshal file on the given directory
 func (r *Renderer) CreateUnmarshal(dir string) error {
 	src := &bytes.Buffer{}
@@ -2,7 +2,9 @@ package generator_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"go/format"
+	"strings"
 
 	_ "embed"
 
@@ -53,8 +55,212 @@ var _ = Describe("Renderer", func() {
 		Expect(string(formatted)).To(ContainSubstring(`type Scatter struct`))
 		// Implements interface GetType()
 		Expect(string(formatted)).To(ContainSubstring(`func (trace *Scatter) GetType() TraceType`))
+		// Validates against the schema
+		Expect(string(formatted)).To(ContainSubstring(`func (trace *Scatter) Validate() error`))
 
 	})
+
+	It("Should keep conflicting layout fields from traces", func() {
+		root, err := generator.LoadSchema(bytes.NewReader(schema))
+		Expect(err).To(BeNil())
+
+		r, err := generator.NewRenderer(mockCreator, root)
+		Expect(err).To(BeNil())
+
+		first := &bytes.Buffer{}
+		err = r.WriteLayout(first)
+		Expect(err).To(BeNil())
+
+		formatted, err := format.Source(first.Bytes())
+		Expect(err).To(BeNil())
+		// barmode is merged from bar and histogram, barpolar reads its barmode from layout.polar
+		Expect(strings.Count(string(formatted), "Barmode LayoutBarmode `json:\"barmode,omitempty\"`")).To(Equal(1))
+		Expect(string(formatted)).To(ContainSubstring("Barmode LayoutPolarBarmode `json:\"barmode,omitempty\"`"))
+		Expect(string(formatted)).To(MatchRegexp(`LayoutPolarBarmodeStack\s+LayoutPolarBarmode = "stack"`))
+		Expect(string(formatted)).NotTo(ContainSubstring("// barpolar:"))
+		// a value shared by bar and histogram has a single constant
+		Expect(strings.Count(string(formatted), `LayoutBarmode = "stack"`)).To(Equal(1))
+
+		// output must not depend on map iteration order
+		second := &bytes.Buffer{}
+		err = r.WriteLayout(second)
+		Expect(err).To(BeNil())
+		Expect(second.String()).To(Equal(first.String()))
+	})
+
+	It("Should emit layout fields with conflicting types as interface{}", func() {
+		root, err := generator.LoadSchema(strings.NewReader(`{"schema": {
+			"layout": {"layoutAttributes": {}},
+			"traces": {
+				"bar": {"layoutAttributes": {"bargap": {"valType": "number", "description": "Gap of bars."}}},
+				"histogram": {"layoutAttributes": {"bargap": {"valType": "enumerated", "values": ["auto", "none"], "description": "Gap of histogram bars."}}}
+			}
+		}}`))
+		Expect(err).To(BeNil())
+
+		r, err := generator.NewRenderer(mockCreator, root)
+		Expect(err).To(BeNil())
+
+		buf := &bytes.Buffer{}
+		err = r.WriteLayout(buf)
+		Expect(err).To(BeNil())
+
+		formatted, err := format.Source(buf.Bytes())
+		Expect(err).To(BeNil())
+		Expect(string(formatted)).To(ContainSubstring("Bargap interface{} `json:\"bargap,omitempty\"`"))
+		Expect(string(formatted)).To(ContainSubstring("// bar (float64):"))
+		Expect(string(formatted)).To(ContainSubstring("// histogram (LayoutBargap):"))
+		Expect(string(formatted)).To(ContainSubstring(`HistogramBargapAuto LayoutBargap = "auto"`))
+	})
+
+	It("Should create frames", func() {
+		buf := NopWriterCloser{&bytes.Buffer{}}
+
+		mockCreator.EXPECT().Create(gomock.Eq("frames_gen.go")).Return(buf, nil).Times(1)
+
+		root, err := generator.LoadSchema(bytes.NewReader(schema))
+		Expect(err).To(BeNil())
+
+		r, err := generator.NewRenderer(mockCreator, root)
+		Expect(err).To(BeNil())
+
+		err = r.CreateFrames(".")
+		Expect(err).To(BeNil())
+
+		Expect(buf.String()).To(ContainSubstring(`type Frame struct`))
+		Expect(buf.String()).To(ContainSubstring("Data Traces `json:\"data,omitempty\"`"))
+		Expect(buf.String()).To(ContainSubstring(`type Animation struct`))
+		Expect(buf.String()).To(ContainSubstring(`AnimationTransitionEasingCubicInOut`))
+	})
+
+	It("Should type config locale and mode bar buttons", func() {
+		root, err := generator.LoadSchema(bytes.NewReader(schema))
+		Expect(err).To(BeNil())
+
+		r, err := generator.NewRenderer(mockCreator, root)
+		Expect(err).To(BeNil())
+
+		buf := &bytes.Buffer{}
+		err = r.WriteConfig(buf)
+		Expect(err).To(BeNil())
+
+		formatted, err := format.Source(buf.Bytes())
+		Expect(err).To(BeNil())
+
+		Expect(string(formatted)).To(ContainSubstring("Locale ConfigLocale `json:\"locale,omitempty\"`"))
+		Expect(string(formatted)).To(ContainSubstring(`ConfigLocaleDeCH ConfigLocale = "de-CH"`))
+		Expect(string(formatted)).To(ContainSubstring("Modebarbuttonstoremove []ConfigModeBarButton `json:\"modeBarButtonsToRemove,omitempty\"`"))
+		Expect(string(formatted)).To(ContainSubstring("Modebarbuttons [][]ConfigModeBarButton `json:\"modeBarButtons,omitempty\"`"))
+		Expect(string(formatted)).To(ContainSubstring("Toimagebuttonoptions *ConfigToimagebuttonoptions `json:\"toImageButtonOptions,omitempty\"`"))
+		Expect(string(formatted)).To(ContainSubstring(`ConfigToimagebuttonoptionsFormatSvg  ConfigToimagebuttonoptionsFormat = "svg"`))
+		Expect(string(formatted)).To(ContainSubstring(`ConfigModeBarButtonLasso2d`))
+	})
+
+	It("Should generate marshalers when enabled", func() {
+		root, err := generator.LoadSchema(bytes.NewReader(schema))
+		Expect(err).To(BeNil())
+
+		r, err := generator.NewRenderer(mockCreator, root)
+		Expect(err).To(BeNil())
+
+		buf := &bytes.Buffer{}
+		err = r.WriteTrace("scatter", buf)
+		Expect(err).To(BeNil())
+		Expect(buf.String()).ToNot(ContainSubstring(`func (obj *Scatter) MarshalJSON`))
+
+		r, err = generator.NewRenderer(mockCreator, root, generator.RendererOptions{MarshalJSON: true})
+		Expect(err).To(BeNil())
+
+		buf = &bytes.Buffer{}
+		err = r.WriteTrace("scatter", buf)
+		Expect(err).To(BeNil())
+
+		formatted, err := format.Source(buf.Bytes())
+		Expect(err).To(BeNil())
+		Expect(string(formatted)).To(ContainSubstring(`func (obj *Scatter) MarshalJSON() ([]byte, error)`))
+		Expect(string(formatted)).To(ContainSubstring(`func (obj *ScatterMarker) encodeJSON(e *encoder)`))
+
+		encoder := NopWriterCloser{&bytes.Buffer{}}
+		mockCreator.EXPECT().Create(gomock.Eq("marshal_gen.go")).Return(encoder, nil).Times(1)
+		err = r.CreateMarshal(".")
+		Expect(err).To(BeNil())
+		Expect(encoder.String()).To(ContainSubstring(`type encoder struct`))
+	})
+
+	It("Should tell apart unset and empty enumerated values", func() {
+		root, err := generator.LoadSchema(bytes.NewReader(schema))
+		Expect(err).To(BeNil())
+
+		r, err := generator.NewRenderer(mockCreator, root)
+		Expect(err).To(BeNil())
+
+		layout := &bytes.Buffer{}
+		err = r.WriteLayout(layout)
+		Expect(err).To(BeNil())
+		formatted, err := format.Source(layout.Bytes())
+		Expect(err).To(BeNil())
+
+		// the empty value would be omitted with a string type, it has its own constant marshaled as ""
+		Expect(string(formatted)).To(ContainSubstring("type LayoutXaxisTicks string"))
+		Expect(string(formatted)).To(MatchRegexp(`LayoutXaxisTicksEmpty\s+LayoutXaxisTicks = emptyEnum`))
+		Expect(string(formatted)).To(ContainSubstring("func (v LayoutXaxisTicks) MarshalJSON() ([]byte, error)"))
+		Expect(string(formatted)).To(ContainSubstring("func (v *LayoutXaxisTicks) UnmarshalJSON(data []byte) error"))
+		Expect(string(formatted)).NotTo(ContainSubstring("func (v LayoutXaxisType) MarshalJSON"))
+	})
+
+	It("Should create the attribute registry", func() {
+		buf := NopWriterCloser{&bytes.Buffer{}}
+
+		mockCreator.EXPECT().Create(gomock.Eq("attributes_gen.json")).Return(buf, nil).Times(1)
+
+		root, err := generator.LoadSchema(bytes.NewReader(schema))
+		Expect(err).To(BeNil())
+
+		r, err := generator.NewRenderer(mockCreator, root)
+		Expect(err).To(BeNil())
+
+		err = r.CreateRegistry(".")
+		Expect(err).To(BeNil())
+
+		registry := map[string]generator.Attribute{}
+		err = json.Unmarshal(buf.Bytes(), &registry)
+		Expect(err).To(BeNil())
+
+		Expect(registry).To(HaveKey("scatter.mode"))
+		Expect(registry["scatter.mode"].ValType).To(Equal(generator.ValTypeFlagList))
+		Expect(registry).To(HaveKey("layout.xaxis.type"))
+		Expect(registry).To(HaveKey("layout.annotations.text"))
+		Expect(registry).To(HaveKey("config.locale"))
+
+		// deprecated attributes are registered, unless the name is in use
+		Expect(registry).To(HaveKey("bar.bardir"))
+		Expect(registry["bar.bardir"].Deprecated).To(BeTrue())
+		Expect(registry["layout.xaxis.titlefont"].Deprecated).To(BeTrue())
+		Expect(registry["layout.xaxis.title"].Deprecated).To(BeFalse())
+		Expect(registry["layout.xaxis.title"].Role).To(Equal(generator.RoleObject))
+	})
+
+	It("Should fail to register conflicting layout attributes of traces", func() {
+		root, err := generator.LoadSchema(strings.NewReader(`{"schema": {
+			"layout": {"layoutAttributes": {}},
+			"traces": {
+				"bar": {"layoutAttributes": {"bargap": {"valType": "number", "description": "Gap of bars."}}},
+				"box": {"layoutAttributes": {"boxgap": {"valType": "number", "description": "Gap of boxes."}}},
+				"histogram": {"layoutAttributes": {"bargap": {"valType": "number", "description": "Gap of histogram bars."}}},
+				"candlestick": {"layoutAttributes": {"boxgap": {"valType": "number", "description": "Gap of boxes."}}}
+			}
+		}}`))
+		Expect(err).To(BeNil())
+
+		_, err = generator.Registry(root)
+		Expect(err).To(MatchError("cannot register the layout attributes of histogram, attribute layout.bargap is already registered with a different definition"))
+
+		delete(root.Schema.Traces, "histogram")
+		registry, err := generator.Registry(root)
+		Expect(err).To(BeNil())
+		Expect(registry).To(HaveKey("layout.bargap"))
+		Expect(registry).To(HaveKey("layout.boxgap"))
+	})
 })
 
 type NopWriterCloser struct {
@@ -47,6 +47,8 @@ type enumFile struct {
 	Type        string
 	ConstOrVar  costOrVar
 	Values      []enumValue
+	// Empty tells if a string enum has an empty value, it is marshaled as "" while the zero value is unset
+	Empty bool
 }
 
 type enumValue struct {
@@ -54,6 +56,16 @@ type enumValue struct {
 	Value interface{}
 }
 
+// hasValue tells if a constant of the enum has the value
+func (enum enumFile) hasValue(value interface{}) bool {
+	for _, v := range enum.Values {
+		if v.Value == value {
+			return true
+		}
+	}
+	return false
+}
+
 type flagList struct {
 	Name        string
 	Description string
@@ -68,6 +80,19 @@ type flagListValue struct {
 	Value interface{}
 }
 
+// marshalStruct is used to render marshal.tmpl
+type marshalStruct struct {
+	Name   string
+	Fields []marshalField
+}
+
+// marshalField is a struct field with the kind that determines how it is encoded and when it is empty
+type marshalField struct {
+	Name     string
+	JSONName string
+	Kind     string
+}
+
 type costOrVar string
 
 const (
@@ -100,7 +125,7 @@ func (file *typeFile) parseAttributes(namePrefix string, typePrefix string, attr
 				},
 			})
 
-		case attr.Role == RoleObject:
+		case attr.Role == RoleObject && attr.ValType == "":
 			name := namePrefix + xstrings.ToCamelCase(attr.Name)
 			err := file.parseObject(name, attr)
 			if err != nil {
@@ -250,6 +275,17 @@ func (file *typeFile) parseEnum(typeName string, valuePrefix string, attr *Attri
 		}
 	}
 
+	// with a string type, the empty value would be omitted as if it was unset, so it has its own constant written as ""
+	empty := false
+	if Type == "string" {
+		for i := range values {
+			if values[i].Value == "\"\"" {
+				values[i].Value = "emptyEnum"
+				empty = true
+			}
+		}
+	}
+
 	duplicated := map[string]int{}
 	for i := range values {
 		_, ok := duplicated[values[i].Name]
@@ -276,6 +312,7 @@ func (file *typeFile) parseEnum(typeName string, valuePrefix string, attr *Attri
 		Values:      values,
 		ConstOrVar:  ConstOrVar,
 		Type:        Type,
+		Empty:       empty,
 	}
 
 	file.Enums = append(file.Enums, enum)
@@ -360,6 +397,73 @@ func (file *typeFile) parseFlaglist(name string, attr *Attribute) error {
 	return nil
 }
 
+// marshalStruct returns the fields of the given struct classified by the way they are encoded.
+func (file *typeFile) marshalStruct(obj sstruct) (marshalStruct, error) {
+	m := marshalStruct{
+		Name:   obj.Name,
+		Fields: make([]marshalField, 0, len(obj.Fields)),
+	}
+	for _, field := range obj.Fields {
+		kind, err := file.marshalKind(field.Type)
+		if err != nil {
+			return m, fmt.Errorf("field %s, %w", field.Name, err)
+		}
+		m.Fields = append(m.Fields, marshalField{
+			Name:     field.Name,
+			JSONName: field.JSONName,
+			Kind:     kind,
+		})
+	}
+	return m, nil
+}
+
+func (file *typeFile) marshalKind(ty string) (string, error) {
+	switch {
+	case strings.HasPrefix(ty, "*"):
+		return "pointer", nil
+	case strings.HasPrefix(ty, "[]"), ty == "ColorList", ty == "Traces":
+		return "slice", nil
+	}
+	switch ty {
+	case "Bool":
+		return "bool", nil
+	case "float64":
+		return "float", nil
+	case "int64":
+		return "int", nil
+	case "TraceType":
+		return "string", nil
+	case "String", "Color", "ColorScale", "interface{}":
+		return "any", nil
+	}
+	for _, obj := range file.Objects {
+		if obj.Name == ty {
+			return "struct", nil
+		}
+	}
+	for _, enum := range file.Enums {
+		if enum.Name == ty {
+			if enum.Empty {
+				return "enum", nil
+			}
+			return kindOf(enum.Type), nil
+		}
+	}
+	for _, flaglist := range file.FlagLists {
+		if flaglist.Name == ty {
+			return kindOf(flaglist.Type), nil
+		}
+	}
+	return "", fmt.Errorf("unknown type %s", ty)
+}
+
+func kindOf(ty string) string {
+	if ty == "string" {
+		return "string"
+	}
+	return "any"
+}
+
 func sortKeys(attr map[string]*Attribute) []string {
 	keys := make([]string, 0, len(attr))
 	for k := range attr {
@@ -0,0 +1,228 @@
+// Package geo builds maps of values by country or US state and tile maps of points.
+//
+// The locations are given by name or code and converted to the ISO 3166-1 alpha-3 codes or the USPS state codes
+// understood by plotly.js, so the data does not need to be prepared in the exact format of the locationmode.
+//
+//	fig, err := geo.Choropleth(map[string]float64{"France": 67, "DE": 83, "ESP": 47}, geo.Options{ColorbarTitle: "millions"})
+//
+// Tile maps are centered and zoomed on their points. Mapbox styles use the token given to SetMapboxToken
+// or the MAPBOX_ACCESS_TOKEN environment variable, other styles do not need a token.
+//
+//	fig, err := geo.ScatterMap(lat, lon, geo.MapOptions{Style: geo.StyleCartoPositron})
+package geo
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// DefaultColorscale is the colorscale of Choropleth if none is given
+const DefaultColorscale = "Viridis"
+
+// Mode is the kind of locations of the keys
+type Mode string
+
+const (
+	// Auto uses USStates if all the keys are US states, Countries otherwise
+	Auto Mode = ""
+	// Countries are ISO 3166-1 alpha-2 or alpha-3 codes or country names
+	Countries Mode = "countries"
+	// USStates are USPS codes or names of the US states
+	USStates Mode = "states"
+)
+
+var (
+	countryIndex = map[string]int{}
+	stateIndex   = map[string]int{}
+)
+
+func init() {
+	for i, c := range countries {
+		countryIndex[normalize(c.alpha2)] = i
+		countryIndex[normalize(c.alpha3)] = i
+		countryIndex[normalize(c.name)] = i
+	}
+	for alias, alpha3 := range countryAliases {
+		countryIndex[normalize(alias)] = countryIndex[normalize(alpha3)]
+	}
+	for i, s := range states {
+		stateIndex[normalize(s.code)] = i
+		stateIndex[normalize(s.name)] = i
+	}
+}
+
+func normalize(key string) string {
+	return strings.ToLower(strings.TrimSpace(key))
+}
+
+// CountryCode returns the ISO 3166-1 alpha-3 code and the name of a country given by code or name, ignoring the case
+func CountryCode(key string) (string, string, bool) {
+	i, ok := countryIndex[normalize(key)]
+	if !ok {
+		return "", "", false
+	}
+	return countries[i].alpha3, countries[i].name, true
+}
+
+// StateCode returns the USPS code and the name of a US state given by code or name, ignoring the case
+func StateCode(key string) (string, string, bool) {
+	i, ok := stateIndex[normalize(key)]
+	if !ok {
+		return "", "", false
+	}
+	return states[i].code, states[i].name, true
+}
+
+// Locations converts the keys to the codes of the mode and returns the codes, the names of the locations and
+// the locationmode of plotly.js. Codes such as CA are countries unless the mode is USStates or all the keys are states.
+func Locations(keys []string, mode Mode) ([]string, []string, grob.ChoroplethLocationmode, error) {
+	if mode == Auto {
+		mode = USStates
+		for _, key := range keys {
+			if _, _, ok := StateCode(key); !ok {
+				mode = Countries
+				break
+			}
+		}
+	}
+
+	var lookup func(string) (string, string, bool)
+	var locationmode grob.ChoroplethLocationmode
+	switch mode {
+	case Countries:
+		lookup, locationmode = CountryCode, grob.ChoroplethLocationmodeIso3
+	case USStates:
+		lookup, locationmode = StateCode, grob.ChoroplethLocationmodeUsaStates
+	default:
+		return nil, nil, "", fmt.Errorf("unknown mode %s", mode)
+	}
+
+	codes := make([]string, len(keys))
+	names := make([]string, len(keys))
+	unknown := []string{}
+	for i, key := range keys {
+		code, name, ok := lookup(key)
+		if !ok {
+			unknown = append(unknown, key)
+		}
+		codes[i], names[i] = code, name
+	}
+	if len(unknown) > 0 {
+		return nil, nil, "", fmt.Errorf("unknown %s: %s", mode, strings.Join(unknown, ", "))
+	}
+	return codes, names, locationmode, nil
+}
+
+// Options configure the map
+type Options struct {
+	// Mode is the kind of locations of the keys, defaults to Auto
+	Mode Mode
+	// Title of the figure
+	Title string
+	// ColorbarTitle is the title of the colorbar, usually the unit of the values
+	ColorbarTitle string
+	// Colorscale is the name of a plotly colorscale or a list of [level, color] pairs, defaults to DefaultColorscale
+	Colorscale grob.ColorScale
+	// Projection of the world maps, defaults to natural earth. US maps use the albers usa projection
+	Projection grob.LayoutGeoProjectionType
+}
+
+func computeOptions(def Options, opt ...Options) Options {
+	if len(opt) == 1 {
+		opts := opt[0]
+		if opts.Mode != "" {
+			def.Mode = opts.Mode
+		}
+		if opts.Title != "" {
+			def.Title = opts.Title
+		}
+		if opts.ColorbarTitle != "" {
+			def.ColorbarTitle = opts.ColorbarTitle
+		}
+		if opts.Colorscale != nil {
+			def.Colorscale = opts.Colorscale
+		}
+		if opts.Projection != "" {
+			def.Projection = opts.Projection
+		}
+	}
+	return def
+}
+
+// Choropleth colors the countries or the US states by value. The keys are names or codes of the locations,
+// they are sorted by code. The map shows the whole world for countries and the US for states.
+func Choropleth(values map[string]float64, opt ...Options) (*grob.Fig, error) {
+	opts := computeOptions(Options{
+		Colorscale: DefaultColorscale,
+		Projection: grob.LayoutGeoProjectionTypeNaturalEarth,
+	}, opt...)
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	codes, names, locationmode, err := Locations(keys, opts.Mode)
+	if err != nil {
+		return nil, err
+	}
+
+	order := make([]int, len(keys))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(a, b int) bool { return codes[order[a]] < codes[order[b]] })
+	locations := make([]string, len(keys))
+	text := make([]string, len(keys))
+	z := make([]float64, len(keys))
+	seen := map[string]string{}
+	for i, j := range order {
+		if other, ok := seen[codes[j]]; ok {
+			return nil, fmt.Errorf("%s and %s are the same location %s", other, keys[j], codes[j])
+		}
+		seen[codes[j]] = keys[j]
+		locations[i], text[i], z[i] = codes[j], names[j], values[keys[j]]
+	}
+
+	trace := &grob.Choropleth{
+		Type:          grob.TraceTypeChoropleth,
+		Locations:     locations,
+		Locationmode:  locationmode,
+		Z:             z,
+		Text:          text,
+		Colorscale:    opts.Colorscale,
+		Hovertemplate: "%{text}<br>%{z}<extra></extra>",
+	}
+	if opts.ColorbarTitle != "" {
+		trace.Colorbar = &grob.ChoroplethColorbar{
+			Title: &grob.ChoroplethColorbarTitle{
+				Text: opts.ColorbarTitle,
+			},
+		}
+	}
+
+	layout := &grob.Layout{
+		Geo: &grob.LayoutGeo{
+			Showframe:      grob.False,
+			Showcoastlines: grob.True,
+			Projection: &grob.LayoutGeoProjection{
+				Type: opts.Projection,
+			},
+		},
+	}
+	if locationmode == grob.ChoroplethLocationmodeUsaStates {
+		layout.Geo.Scope = grob.LayoutGeoScopeUsa
+		layout.Geo.Projection.Type = grob.LayoutGeoProjectionTypeAlbersUsa
+	}
+	if opts.Title != "" {
+		layout.Title = &grob.LayoutTitle{
+			Text: opts.Title,
+		}
+	}
+	return &grob.Fig{
+		Data:   grob.Traces{trace},
+		Layout: layout,
+	}, nil
+}
@@ -0,0 +1,13 @@
+package geo_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestGeo(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Geo Suite")
+}
@@ -0,0 +1,85 @@
+package geo_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/MetalBlueberry/go-plotly/geo"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var _ = Describe("Geo", func() {
+
+	It("Should find countries by code, name and alias", func() {
+		for _, key := range []string{"FR", "fra", "France", " france "} {
+			code, name, ok := geo.CountryCode(key)
+			Expect(ok).To(BeTrue(), key)
+			Expect(code).To(Equal("FRA"))
+			Expect(name).To(Equal("France"))
+		}
+		code, _, ok := geo.CountryCode("United States of America")
+		Expect(ok).To(BeTrue())
+		Expect(code).To(Equal("USA"))
+		_, _, ok = geo.CountryCode("Atlantis")
+		Expect(ok).To(BeFalse())
+	})
+
+	It("Should find states by code and name", func() {
+		code, name, ok := geo.StateCode("new york")
+		Expect(ok).To(BeTrue())
+		Expect(code).To(Equal("NY"))
+		Expect(name).To(Equal("New York"))
+	})
+
+	It("Should choose states only if all the keys are states", func() {
+		codes, _, mode, err := geo.Locations([]string{"CA", "Texas"}, geo.Auto)
+		Expect(err).To(BeNil())
+		Expect(codes).To(Equal([]string{"CA", "TX"}))
+		Expect(mode).To(Equal(grob.ChoroplethLocationmodeUsaStates))
+
+		codes, _, mode, err = geo.Locations([]string{"CA", "Mexico"}, geo.Auto)
+		Expect(err).To(BeNil())
+		Expect(codes).To(Equal([]string{"CAN", "MEX"}))
+		Expect(mode).To(Equal(grob.ChoroplethLocationmodeIso3))
+
+		codes, _, _, err = geo.Locations([]string{"CA"}, geo.Countries)
+		Expect(err).To(BeNil())
+		Expect(codes).To(Equal([]string{"CAN"}))
+	})
+
+	It("Should report the unknown locations", func() {
+		_, _, _, err := geo.Locations([]string{"France", "Atlantis", "Lemuria"}, geo.Auto)
+		Expect(err).To(MatchError("unknown countries: Atlantis, Lemuria"))
+		_, _, _, err = geo.Locations([]string{"France"}, "cities")
+		Expect(err).ToNot(BeNil())
+	})
+
+	It("Should build a world choropleth", func() {
+		fig, err := geo.Choropleth(map[string]float64{"France": 67, "DE": 83, "ESP": 47}, geo.Options{ColorbarTitle: "millions", Title: "Population"})
+		Expect(err).To(BeNil())
+		trace := fig.Data[0].(*grob.Choropleth)
+		Expect(trace.Type).To(Equal(grob.TraceTypeChoropleth))
+		Expect(trace.Locations).To(Equal([]string{"DEU", "ESP", "FRA"}))
+		Expect(trace.Z).To(Equal([]float64{83, 47, 67}))
+		Expect(trace.Text).To(Equal([]string{"Germany", "Spain", "France"}))
+		Expect(trace.Locationmode).To(Equal(grob.ChoroplethLocationmodeIso3))
+		Expect(trace.Colorscale).To(Equal(geo.DefaultColorscale))
+		Expect(trace.Colorbar.Title.Text).To(Equal("millions"))
+		Expect(fig.Layout.Geo.Projection.Type).To(Equal(grob.LayoutGeoProjectionTypeNaturalEarth))
+		Expect(fig.Layout.Geo.Scope).To(BeEmpty())
+		Expect(fig.Layout.Title.Text).To(Equal("Population"))
+	})
+
+	It("Should build a US choropleth", func() {
+		fig, err := geo.Choropleth(map[string]float64{"California": 39, "TX": 29})
+		Expect(err).To(BeNil())
+		Expect(fig.Data[0].(*grob.Choropleth).Locationmode).To(Equal(grob.ChoroplethLocationmodeUsaStates))
+		Expect(fig.Layout.Geo.Scope).To(Equal(grob.LayoutGeoScopeUsa))
+		Expect(fig.Layout.Geo.Projection.Type).To(Equal(grob.LayoutGeoProjectionTypeAlbersUsa))
+	})
+
+	It("Should reject the same location given twice", func() {
+		_, err := geo.Choropleth(map[string]float64{"France": 1, "FR": 2})
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,196 @@
+package geo
+
+import (
+	"fmt"
+	"math"
+	"os"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// TokenEnv is the environment variable of the Mapbox access token
+const TokenEnv = "MAPBOX_ACCESS_TOKEN"
+
+// Map styles that do not require a Mapbox access token
+const (
+	StyleOpenStreetMap   = "open-street-map"
+	StyleCartoPositron   = "carto-positron"
+	StyleCartoDarkmatter = "carto-darkmatter"
+	StyleStamenTerrain   = "stamen-terrain"
+	StyleStamenToner     = "stamen-toner"
+	StyleWhiteBg         = "white-bg"
+)
+
+// Map styles of Mapbox, they require an access token
+const (
+	StyleBasic            = "basic"
+	StyleStreets          = "streets"
+	StyleOutdoors         = "outdoors"
+	StyleLight            = "light"
+	StyleDark             = "dark"
+	StyleSatellite        = "satellite"
+	StyleSatelliteStreets = "satellite-streets"
+)
+
+var mapboxStyles = map[string]bool{
+	StyleBasic: true, StyleStreets: true, StyleOutdoors: true, StyleLight: true,
+	StyleDark: true, StyleSatellite: true, StyleSatelliteStreets: true,
+}
+
+// maxZoom is the zoom of maps of a single point
+const maxZoom = 15
+
+var mapboxToken string
+
+// SetMapboxToken sets the access token of the maps built afterwards, it takes precedence over the TokenEnv environment variable
+func SetMapboxToken(token string) {
+	mapboxToken = token
+}
+
+// MapOptions configure the maps
+type MapOptions struct {
+	// Title of the figure
+	Title string
+	// Style of the map, defaults to StyleLight if there is an access token and StyleOpenStreetMap otherwise
+	Style string
+	// Token is the Mapbox access token, defaults to the token given to SetMapboxToken or the TokenEnv environment variable
+	Token string
+	// Center of the map, defaults to the center of the points
+	Center *grob.LayoutMapboxCenter
+	// Zoom of the map, defaults to a zoom that shows all the points
+	Zoom float64
+}
+
+func computeMapOptions(def MapOptions, opt ...MapOptions) MapOptions {
+	if len(opt) == 1 {
+		opts := opt[0]
+		if opts.Title != "" {
+			def.Title = opts.Title
+		}
+		if opts.Style != "" {
+			def.Style = opts.Style
+		}
+		if opts.Token != "" {
+			def.Token = opts.Token
+		}
+		if opts.Center != nil {
+			def.Center = opts.Center
+		}
+		if opts.Zoom != 0 {
+			def.Zoom = opts.Zoom
+		}
+	}
+	return def
+}
+
+// Mapbox returns the mapbox layout of the points, with the token, the style and the center and zoom that show all the points.
+// It fails if the style requires a token and there is none.
+func Mapbox(lat, lon []float64, opt ...MapOptions) (*grob.LayoutMapbox, error) {
+	token := mapboxToken
+	if token == "" {
+		token = os.Getenv(TokenEnv)
+	}
+	opts := computeMapOptions(MapOptions{Token: token}, opt...)
+	if opts.Style == "" {
+		opts.Style = StyleOpenStreetMap
+		if opts.Token != "" {
+			opts.Style = StyleLight
+		}
+	}
+	if mapboxStyles[opts.Style] && opts.Token == "" {
+		return nil, fmt.Errorf("style %s requires a mapbox access token, call SetMapboxToken or set %s", opts.Style, TokenEnv)
+	}
+	if len(lat) != len(lon) {
+		return nil, fmt.Errorf("lat has %d values, but lon has %d", len(lat), len(lon))
+	}
+
+	center, zoom := AutoView(lat, lon)
+	if opts.Center != nil {
+		center = opts.Center
+	}
+	if opts.Zoom != 0 {
+		zoom = opts.Zoom
+	}
+	mapbox := &grob.LayoutMapbox{
+		Style:  opts.Style,
+		Center: center,
+		Zoom:   zoom,
+	}
+	if opts.Token != "" {
+		mapbox.Accesstoken = opts.Token
+	}
+	return mapbox, nil
+}
+
+// AutoView returns the center of the bounding box of the points and the zoom that fits it, like plotly express.
+// NaN values are ignored. Without points, it returns the center of the world with zoom 0.
+func AutoView(lat, lon []float64) (*grob.LayoutMapboxCenter, float64) {
+	minLat, maxLat := math.Inf(1), math.Inf(-1)
+	minLon, maxLon := math.Inf(1), math.Inf(-1)
+	for i := range lat {
+		if i >= len(lon) || math.IsNaN(lat[i]) || math.IsNaN(lon[i]) {
+			continue
+		}
+		minLat, maxLat = math.Min(minLat, lat[i]), math.Max(maxLat, lat[i])
+		minLon, maxLon = math.Min(minLon, lon[i]), math.Max(maxLon, lon[i])
+	}
+	if math.IsInf(minLat, 1) {
+		return &grob.LayoutMapboxCenter{}, 0
+	}
+	center := &grob.LayoutMapboxCenter{
+		Lat: (minLat + maxLat) / 2,
+		Lon: (minLon + maxLon) / 2,
+	}
+	// the size of the bounding box in km, a degree is about 111 km
+	size := math.Max(maxLat-minLat, maxLon-minLon) * 111
+	if size == 0 {
+		return center, maxZoom
+	}
+	zoom := 11.5 - math.Log(size)
+	return center, math.Max(0, math.Min(maxZoom, zoom))
+}
+
+// ScatterMap plots the points with markers on a map
+func ScatterMap(lat, lon []float64, opt ...MapOptions) (*grob.Fig, error) {
+	return mapFigure(&grob.Scattermapbox{
+		Type: grob.TraceTypeScattermapbox,
+		Mode: grob.ScattermapboxModeMarkers,
+		Lat:  lat,
+		Lon:  lon,
+	}, lat, lon, opt...)
+}
+
+// DensityMap plots the density of the points on a map, weighted by z. If z is nil, all the points have the same weight
+func DensityMap(lat, lon, z []float64, opt ...MapOptions) (*grob.Fig, error) {
+	if z != nil && len(z) != len(lat) {
+		return nil, fmt.Errorf("z has %d values, but lat has %d", len(z), len(lat))
+	}
+	trace := &grob.Densitymapbox{
+		Type: grob.TraceTypeDensitymapbox,
+		Lat:  lat,
+		Lon:  lon,
+	}
+	if z != nil {
+		trace.Z = z
+	}
+	return mapFigure(trace, lat, lon, opt...)
+}
+
+func mapFigure(trace grob.Trace, lat, lon []float64, opt ...MapOptions) (*grob.Fig, error) {
+	mapbox, err := Mapbox(lat, lon, opt...)
+	if err != nil {
+		return nil, err
+	}
+	layout := &grob.Layout{
+		Mapbox: mapbox,
+	}
+	if len(opt) == 1 && opt[0].Title != "" {
+		layout.Title = &grob.LayoutTitle{
+			Text: opt[0].Title,
+		}
+	}
+	return &grob.Fig{
+		Data:   grob.Traces{trace},
+		Layout: layout,
+	}, nil
+}
@@ -0,0 +1,87 @@
+package geo_test
+
+import (
+	"math"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/MetalBlueberry/go-plotly/geo"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var _ = Describe("Mapbox", func() {
+
+	lat := []float64{40, 42, math.NaN()}
+	lon := []float64{-4, 2, 100}
+
+	BeforeEach(func() {
+		geo.SetMapboxToken("")
+		os.Unsetenv(geo.TokenEnv)
+	})
+
+	AfterEach(func() {
+		geo.SetMapboxToken("")
+		os.Unsetenv(geo.TokenEnv)
+	})
+
+	It("Should center and zoom on the points", func() {
+		center, zoom := geo.AutoView(lat, lon)
+		Expect(center).To(Equal(&grob.LayoutMapboxCenter{Lat: 41, Lon: -1}))
+		Expect(zoom).To(BeNumerically("~", 11.5-math.Log(6*111), 1e-9))
+
+		_, zoom = geo.AutoView([]float64{1}, []float64{2})
+		Expect(zoom).To(BeNumerically("==", 15))
+
+		center, zoom = geo.AutoView(nil, nil)
+		Expect(center).To(Equal(&grob.LayoutMapboxCenter{}))
+		Expect(zoom).To(BeNumerically("==", 0))
+	})
+
+	It("Should use open street map without token", func() {
+		mapbox, err := geo.Mapbox(lat, lon)
+		Expect(err).To(BeNil())
+		Expect(mapbox.Style).To(Equal(geo.StyleOpenStreetMap))
+		Expect(mapbox.Accesstoken).To(BeNil())
+
+		_, err = geo.Mapbox(lat, lon, geo.MapOptions{Style: geo.StyleSatellite})
+		Expect(err).ToNot(BeNil())
+	})
+
+	It("Should read the token from the environment or the options", func() {
+		os.Setenv(geo.TokenEnv, "env")
+		mapbox, err := geo.Mapbox(lat, lon)
+		Expect(err).To(BeNil())
+		Expect(mapbox.Style).To(Equal(geo.StyleLight))
+		Expect(mapbox.Accesstoken).To(Equal("env"))
+
+		geo.SetMapboxToken("global")
+		mapbox, err = geo.Mapbox(lat, lon)
+		Expect(err).To(BeNil())
+		Expect(mapbox.Accesstoken).To(Equal("global"))
+
+		mapbox, err = geo.Mapbox(lat, lon, geo.MapOptions{Token: "option", Style: geo.StyleDark, Zoom: 3})
+		Expect(err).To(BeNil())
+		Expect(mapbox.Accesstoken).To(Equal("option"))
+		Expect(mapbox.Style).To(Equal(geo.StyleDark))
+		Expect(mapbox.Zoom).To(BeNumerically("==", 3))
+	})
+
+	It("Should build scatter and density maps", func() {
+		fig, err := geo.ScatterMap(lat, lon, geo.MapOptions{Title: "Stations"})
+		Expect(err).To(BeNil())
+		Expect(fig.Data[0].(*grob.Scattermapbox).Lat).To(Equal(lat))
+		Expect(fig.Layout.Mapbox.Center.Lat).To(BeNumerically("==", 41))
+		Expect(fig.Layout.Title.Text).To(Equal("Stations"))
+
+		fig, err = geo.DensityMap(lat, lon, []float64{1, 2, 3})
+		Expect(err).To(BeNil())
+		Expect(fig.Data[0].(*grob.Densitymapbox).Z).To(Equal([]float64{1, 2, 3}))
+
+		_, err = geo.DensityMap(lat, lon, []float64{1})
+		Expect(err).ToNot(BeNil())
+		_, err = geo.ScatterMap(lat, lon[:1])
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -3,9 +3,24 @@ module github.com/MetalBlueberry/go-plotly
 go 1.16
 
 require (
+	github.com/andybalholm/brotli v1.0.4
+	github.com/apache/arrow/go/v9 v9.0.0
+	github.com/chromedp/cdproto v0.0.0-20210713064928-7d28b402946a
+	github.com/chromedp/chromedp v0.7.4
+	github.com/go-gota/gota v0.10.1
 	github.com/golang/mock v1.5.0
+	github.com/google/pprof v0.0.0-20210720184732-4bb14d4b1be1
 	github.com/huandu/xstrings v1.3.2
+	github.com/influxdata/influxdb-client-go/v2 v2.6.0
+	github.com/jung-kurt/gofpdf v1.16.2
 	github.com/onsi/ginkgo v1.16.2
 	github.com/onsi/gomega v1.12.0
 	github.com/pkg/browser v0.0.0-20180916011732-0a3d74bf9ce4
+	github.com/prometheus/common v0.7.0
+	github.com/xuri/excelize/v2 v2.6.1
+	go.opentelemetry.io/proto/otlp v0.19.0
+	golang.org/x/net v0.0.0-20220812174116-3211cb980234
+	gonum.org/v1/gonum v0.9.3
+	google.golang.org/protobuf v1.28.1
+	gopkg.in/yaml.v2 v2.4.0
 )
@@ -8,6 +8,11 @@ func (trace *Area) GetType() TraceType {
 	return TraceTypeArea
 }
 
+// Validate checks the trace against the constraints of the schema, see ValidateTrace
+func (trace *Area) Validate() error {
+	return ValidateTrace(trace)
+}
+
 // Area
 type Area struct {
 
@@ -826,3 +831,279 @@ const (
 	AreaHoverinfoNone AreaHoverinfo = "none"
 	AreaHoverinfoSkip AreaHoverinfo = "skip"
 )
+
+// MarshalJSON encodes Area with precomputed fields. The output is the same as encoding/json.
+func (obj *Area) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *Area) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Type != "" {
+		e.key(`type`)
+		e.string(string(obj.Type))
+	}
+	if obj.Customdata != nil {
+		e.key(`customdata`)
+		e.any(obj.Customdata)
+	}
+	if obj.Customdatasrc != nil {
+		e.key(`customdatasrc`)
+		e.any(obj.Customdatasrc)
+	}
+	if obj.Hoverinfo != "" {
+		e.key(`hoverinfo`)
+		e.string(string(obj.Hoverinfo))
+	}
+	if obj.Hoverinfosrc != nil {
+		e.key(`hoverinfosrc`)
+		e.any(obj.Hoverinfosrc)
+	}
+	if obj.Hoverlabel != nil {
+		e.key(`hoverlabel`)
+		obj.Hoverlabel.encodeJSON(e)
+	}
+	if obj.Ids != nil {
+		e.key(`ids`)
+		e.any(obj.Ids)
+	}
+	if obj.Idssrc != nil {
+		e.key(`idssrc`)
+		e.any(obj.Idssrc)
+	}
+	if obj.Legendgroup != nil {
+		e.key(`legendgroup`)
+		e.any(obj.Legendgroup)
+	}
+	if obj.Marker != nil {
+		e.key(`marker`)
+		obj.Marker.encodeJSON(e)
+	}
+	if obj.Meta != nil {
+		e.key(`meta`)
+		e.any(obj.Meta)
+	}
+	if obj.Metasrc != nil {
+		e.key(`metasrc`)
+		e.any(obj.Metasrc)
+	}
+	if obj.Name != nil {
+		e.key(`name`)
+		e.any(obj.Name)
+	}
+	if obj.Opacity != 0 {
+		e.key(`opacity`)
+		e.float(float64(obj.Opacity))
+	}
+	if obj.R != nil {
+		e.key(`r`)
+		e.any(obj.R)
+	}
+	if obj.Rsrc != nil {
+		e.key(`rsrc`)
+		e.any(obj.Rsrc)
+	}
+	if obj.Showlegend != nil {
+		e.key(`showlegend`)
+		e.bool(*obj.Showlegend)
+	}
+	if obj.Stream != nil {
+		e.key(`stream`)
+		obj.Stream.encodeJSON(e)
+	}
+	if obj.T != nil {
+		e.key(`t`)
+		e.any(obj.T)
+	}
+	if obj.Transforms != nil {
+		e.key(`transforms`)
+		e.any(obj.Transforms)
+	}
+	if obj.Tsrc != nil {
+		e.key(`tsrc`)
+		e.any(obj.Tsrc)
+	}
+	if obj.Uid != nil {
+		e.key(`uid`)
+		e.any(obj.Uid)
+	}
+	if obj.Uirevision != nil {
+		e.key(`uirevision`)
+		e.any(obj.Uirevision)
+	}
+	if obj.Visible != nil {
+		e.key(`visible`)
+		e.any(obj.Visible)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes AreaHoverlabelFont with precomputed fields. The output is the same as encoding/json.
+func (obj *AreaHoverlabelFont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *AreaHoverlabelFont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Colorsrc != nil {
+		e.key(`colorsrc`)
+		e.any(obj.Colorsrc)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Familysrc != nil {
+		e.key(`familysrc`)
+		e.any(obj.Familysrc)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	if obj.Sizesrc != nil {
+		e.key(`sizesrc`)
+		e.any(obj.Sizesrc)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes AreaHoverlabel with precomputed fields. The output is the same as encoding/json.
+func (obj *AreaHoverlabel) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *AreaHoverlabel) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Align != "" {
+		e.key(`align`)
+		e.string(string(obj.Align))
+	}
+	if obj.Alignsrc != nil {
+		e.key(`alignsrc`)
+		e.any(obj.Alignsrc)
+	}
+	if obj.Bgcolor != nil {
+		e.key(`bgcolor`)
+		e.any(obj.Bgcolor)
+	}
+	if obj.Bgcolorsrc != nil {
+		e.key(`bgcolorsrc`)
+		e.any(obj.Bgcolorsrc)
+	}
+	if obj.Bordercolor != nil {
+		e.key(`bordercolor`)
+		e.any(obj.Bordercolor)
+	}
+	if obj.Bordercolorsrc != nil {
+		e.key(`bordercolorsrc`)
+		e.any(obj.Bordercolorsrc)
+	}
+	if obj.Font != nil {
+		e.key(`font`)
+		obj.Font.encodeJSON(e)
+	}
+	if obj.Namelength != 0 {
+		e.key(`namelength`)
+		e.int(int64(obj.Namelength))
+	}
+	if obj.Namelengthsrc != nil {
+		e.key(`namelengthsrc`)
+		e.any(obj.Namelengthsrc)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes AreaMarker with precomputed fields. The output is the same as encoding/json.
+func (obj *AreaMarker) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *AreaMarker) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Colorsrc != nil {
+		e.key(`colorsrc`)
+		e.any(obj.Colorsrc)
+	}
+	if obj.Opacity != 0 {
+		e.key(`opacity`)
+		e.float(float64(obj.Opacity))
+	}
+	if obj.Opacitysrc != nil {
+		e.key(`opacitysrc`)
+		e.any(obj.Opacitysrc)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	if obj.Sizesrc != nil {
+		e.key(`sizesrc`)
+		e.any(obj.Sizesrc)
+	}
+	if obj.Symbol != nil {
+		e.key(`symbol`)
+		e.any(obj.Symbol)
+	}
+	if obj.Symbolsrc != nil {
+		e.key(`symbolsrc`)
+		e.any(obj.Symbolsrc)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes AreaStream with precomputed fields. The output is the same as encoding/json.
+func (obj *AreaStream) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *AreaStream) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Maxpoints != 0 {
+		e.key(`maxpoints`)
+		e.float(float64(obj.Maxpoints))
+	}
+	if obj.Token != nil {
+		e.key(`token`)
+		e.any(obj.Token)
+	}
+	e.objectEnd()
+}
@@ -0,0 +1,108 @@
+package grob
+
+import (
+	_ "embed"
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync"
+)
+
+//go:embed attributes_gen.json
+var attributesJSON []byte
+
+// AttributeInfo describes an attribute of the plotly schema.
+type AttributeInfo struct {
+	// Path identifies the attribute, for example scatter.marker.color or layout.xaxis.type
+	Path string `json:"-"`
+
+	// Role is object for attributes that contain other attributes
+	Role        string `json:"role,omitempty"`
+	Description string `json:"description,omitempty"`
+	EditType    string `json:"editType,omitempty"`
+
+	// ValType is the type of the value, such as enumerated, number or flaglist.
+	ValType string `json:"valType,omitempty"`
+	// Values are the allowed values of an enumerated attribute
+	Values []interface{} `json:"values,omitempty"`
+	// Flags and Extras are the allowed values of a flaglist attribute
+	Flags   []string      `json:"flags,omitempty"`
+	Extras  []interface{} `json:"extras,omitempty"`
+	Default interface{}   `json:"dflt,omitempty"`
+	Min     *float64      `json:"min,omitempty"`
+	Max     *float64      `json:"max,omitempty"`
+	ArrayOK bool          `json:"arrayOk,omitempty"`
+	// Deprecated attributes still work in plotly.js, but they will be removed. The description tells what replaces them
+	Deprecated bool `json:"deprecated,omitempty"`
+}
+
+var (
+	attributesOnce sync.Once
+	attributes     map[string]*AttributeInfo
+	attributePaths []string
+)
+
+func loadAttributes() {
+	attributes = map[string]*AttributeInfo{}
+	err := json.Unmarshal(attributesJSON, &attributes)
+	if err != nil {
+		panic(err)
+	}
+	attributePaths = make([]string, 0, len(attributes))
+	for path, info := range attributes {
+		info.Path = path
+		attributePaths = append(attributePaths, path)
+	}
+	sort.Strings(attributePaths)
+}
+
+// Describe returns the schema information of the attribute at the given path.
+// Paths start with the trace type, layout, config, frames or animation followed by the attribute names in the JSON representation,
+// for example scatter.mode, layout.xaxis.type or layout.annotations.text for items of an array.
+// The information is a copy, it can be modified by the caller.
+func Describe(path string) (AttributeInfo, bool) {
+	attributesOnce.Do(loadAttributes)
+	info, ok := attributes[path]
+	if !ok {
+		return AttributeInfo{}, false
+	}
+	return info.copy(), true
+}
+
+// copy returns the information with its own slices and limits, so the registry is not modified through it
+func (info *AttributeInfo) copy() AttributeInfo {
+	c := *info
+	if info.Values != nil {
+		c.Values = append([]interface{}{}, info.Values...)
+	}
+	if info.Flags != nil {
+		c.Flags = append([]string{}, info.Flags...)
+	}
+	if info.Extras != nil {
+		c.Extras = append([]interface{}{}, info.Extras...)
+	}
+	if info.Min != nil {
+		min := *info.Min
+		c.Min = &min
+	}
+	if info.Max != nil {
+		max := *info.Max
+		c.Max = &max
+	}
+	return c
+}
+
+// AttributePaths returns the sorted paths of all the attributes that start with the given prefix.
+// An empty prefix returns all of them.
+func AttributePaths(prefix string) []string {
+	attributesOnce.Do(loadAttributes)
+	start := sort.SearchStrings(attributePaths, prefix)
+	paths := []string{}
+	for _, path := range attributePaths[start:] {
+		if !strings.HasPrefix(path, prefix) {
+			break
+		}
+		paths = append(paths, path)
+	}
+	return paths
+}
@@ -0,0 +1,49 @@
+package grob_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var _ = Describe("Attributes", func() {
+
+	It("Should describe the attributes by path", func() {
+		info, ok := grob.Describe("layout.polar.barmode")
+		Expect(ok).To(BeTrue())
+		Expect(info.Path).To(Equal("layout.polar.barmode"))
+		Expect(info.ValType).To(Equal("enumerated"))
+		Expect(info.Values).To(Equal([]interface{}{"stack", "overlay"}))
+
+		_, ok = grob.Describe("scatter.markr")
+		Expect(ok).To(BeFalse())
+	})
+
+	It("Should return a copy of the attribute", func() {
+		info, ok := grob.Describe("scatter.mode")
+		Expect(ok).To(BeTrue())
+		flags := append([]string{}, info.Flags...)
+		info.Flags[0] = "changed"
+
+		info, ok = grob.Describe("layout.polar.barmode")
+		Expect(ok).To(BeTrue())
+		info.Values[0] = "changed"
+
+		opacity, ok := grob.Describe("scatter.opacity")
+		Expect(ok).To(BeTrue())
+		*opacity.Max = 2
+
+		info, _ = grob.Describe("scatter.mode")
+		Expect(info.Flags).To(Equal(flags))
+		info, _ = grob.Describe("layout.polar.barmode")
+		Expect(info.Values).To(Equal([]interface{}{"stack", "overlay"}))
+		opacity, _ = grob.Describe("scatter.opacity")
+		Expect(*opacity.Max).To(Equal(1.0))
+	})
+
+	It("Should list the paths with a prefix", func() {
+		paths := grob.AttributePaths("layout.polar.bar")
+		Expect(paths).To(Equal([]string{"layout.polar.bargap", "layout.polar.barmode"}))
+	})
+})
@@ -8,6 +8,11 @@ func (trace *Bar) GetType() TraceType {
 	return TraceTypeBar
 }
 
+// Validate checks the trace against the constraints of the schema, see ValidateTrace
+func (trace *Bar) Validate() error {
+	return ValidateTrace(trace)
+}
+
 // Bar The data visualized by the span of the bars is set in `y` if `orientation` is set th *v* (the default) and the labels are set in `x`. By setting `orientation` to *h*, the roles are interchanged.
 type Bar struct {
 
@@ -1533,9 +1538,21 @@ type BarMarkerColorbarTicks string
 const (
 	BarMarkerColorbarTicksOutside BarMarkerColorbarTicks = "outside"
 	BarMarkerColorbarTicksInside  BarMarkerColorbarTicks = "inside"
-	BarMarkerColorbarTicksEmpty   BarMarkerColorbarTicks = ""
+	BarMarkerColorbarTicksEmpty   BarMarkerColorbarTicks = emptyEnum
 )
 
+// MarshalJSON writes the empty value as "", the zero value is unset and omitted
+func (v BarMarkerColorbarTicks) MarshalJSON() ([]byte, error) {
+	return marshalEnum(string(v))
+}
+
+// UnmarshalJSON reads "" as the empty value
+func (v *BarMarkerColorbarTicks) UnmarshalJSON(data []byte) error {
+	s, err := unmarshalEnum(data)
+	*v = BarMarkerColorbarTicks(s)
+	return err
+}
+
 // BarMarkerColorbarTitleSide Determines the location of color bar's title with respect to the color bar. Note that the title's location used to be set by the now deprecated `titleside` attribute.
 type BarMarkerColorbarTitleSide string
 
@@ -1668,3 +1685,1239 @@ const (
 	BarHoverinfoNone BarHoverinfo = "none"
 	BarHoverinfoSkip BarHoverinfo = "skip"
 )
+
+// MarshalJSON encodes Bar with precomputed fields. The output is the same as encoding/json.
+func (obj *Bar) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *Bar) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Type != "" {
+		e.key(`type`)
+		e.string(string(obj.Type))
+	}
+	if obj.Alignmentgroup != nil {
+		e.key(`alignmentgroup`)
+		e.any(obj.Alignmentgroup)
+	}
+	if obj.Base != nil {
+		e.key(`base`)
+		e.any(obj.Base)
+	}
+	if obj.Basesrc != nil {
+		e.key(`basesrc`)
+		e.any(obj.Basesrc)
+	}
+	if obj.Cliponaxis != nil {
+		e.key(`cliponaxis`)
+		e.bool(*obj.Cliponaxis)
+	}
+	if obj.Constraintext != "" {
+		e.key(`constraintext`)
+		e.string(string(obj.Constraintext))
+	}
+	if obj.Customdata != nil {
+		e.key(`customdata`)
+		e.any(obj.Customdata)
+	}
+	if obj.Customdatasrc != nil {
+		e.key(`customdatasrc`)
+		e.any(obj.Customdatasrc)
+	}
+	if obj.Dx != 0 {
+		e.key(`dx`)
+		e.float(float64(obj.Dx))
+	}
+	if obj.Dy != 0 {
+		e.key(`dy`)
+		e.float(float64(obj.Dy))
+	}
+	if obj.ErrorX != nil {
+		e.key(`error_x`)
+		obj.ErrorX.encodeJSON(e)
+	}
+	if obj.ErrorY != nil {
+		e.key(`error_y`)
+		obj.ErrorY.encodeJSON(e)
+	}
+	if obj.Hoverinfo != "" {
+		e.key(`hoverinfo`)
+		e.string(string(obj.Hoverinfo))
+	}
+	if obj.Hoverinfosrc != nil {
+		e.key(`hoverinfosrc`)
+		e.any(obj.Hoverinfosrc)
+	}
+	if obj.Hoverlabel != nil {
+		e.key(`hoverlabel`)
+		obj.Hoverlabel.encodeJSON(e)
+	}
+	if obj.Hovertemplate != nil {
+		e.key(`hovertemplate`)
+		e.any(obj.Hovertemplate)
+	}
+	if obj.Hovertemplatesrc != nil {
+		e.key(`hovertemplatesrc`)
+		e.any(obj.Hovertemplatesrc)
+	}
+	if obj.Hovertext != nil {
+		e.key(`hovertext`)
+		e.any(obj.Hovertext)
+	}
+	if obj.Hovertextsrc != nil {
+		e.key(`hovertextsrc`)
+		e.any(obj.Hovertextsrc)
+	}
+	if obj.Ids != nil {
+		e.key(`ids`)
+		e.any(obj.Ids)
+	}
+	if obj.Idssrc != nil {
+		e.key(`idssrc`)
+		e.any(obj.Idssrc)
+	}
+	if obj.Insidetextanchor != "" {
+		e.key(`insidetextanchor`)
+		e.string(string(obj.Insidetextanchor))
+	}
+	if obj.Insidetextfont != nil {
+		e.key(`insidetextfont`)
+		obj.Insidetextfont.encodeJSON(e)
+	}
+	if obj.Legendgroup != nil {
+		e.key(`legendgroup`)
+		e.any(obj.Legendgroup)
+	}
+	if obj.Marker != nil {
+		e.key(`marker`)
+		obj.Marker.encodeJSON(e)
+	}
+	if obj.Meta != nil {
+		e.key(`meta`)
+		e.any(obj.Meta)
+	}
+	if obj.Metasrc != nil {
+		e.key(`metasrc`)
+		e.any(obj.Metasrc)
+	}
+	if obj.Name != nil {
+		e.key(`name`)
+		e.any(obj.Name)
+	}
+	if obj.Offset != 0 {
+		e.key(`offset`)
+		e.float(float64(obj.Offset))
+	}
+	if obj.Offsetgroup != nil {
+		e.key(`offsetgroup`)
+		e.any(obj.Offsetgroup)
+	}
+	if obj.Offsetsrc != nil {
+		e.key(`offsetsrc`)
+		e.any(obj.Offsetsrc)
+	}
+	if obj.Opacity != 0 {
+		e.key(`opacity`)
+		e.float(float64(obj.Opacity))
+	}
+	if obj.Orientation != "" {
+		e.key(`orientation`)
+		e.string(string(obj.Orientation))
+	}
+	if obj.Outsidetextfont != nil {
+		e.key(`outsidetextfont`)
+		obj.Outsidetextfont.encodeJSON(e)
+	}
+	if obj.R != nil {
+		e.key(`r`)
+		e.any(obj.R)
+	}
+	if obj.Rsrc != nil {
+		e.key(`rsrc`)
+		e.any(obj.Rsrc)
+	}
+	if obj.Selected != nil {
+		e.key(`selected`)
+		obj.Selected.encodeJSON(e)
+	}
+	if obj.Selectedpoints != nil {
+		e.key(`selectedpoints`)
+		e.any(obj.Selectedpoints)
+	}
+	if obj.Showlegend != nil {
+		e.key(`showlegend`)
+		e.bool(*obj.Showlegend)
+	}
+	if obj.Stream != nil {
+		e.key(`stream`)
+		obj.Stream.encodeJSON(e)
+	}
+	if obj.T != nil {
+		e.key(`t`)
+		e.any(obj.T)
+	}
+	if obj.Text != nil {
+		e.key(`text`)
+		e.any(obj.Text)
+	}
+	if obj.Textangle != 0 {
+		e.key(`textangle`)
+		e.float(float64(obj.Textangle))
+	}
+	if obj.Textfont != nil {
+		e.key(`textfont`)
+		obj.Textfont.encodeJSON(e)
+	}
+	if obj.Textposition != "" {
+		e.key(`textposition`)
+		e.string(string(obj.Textposition))
+	}
+	if obj.Textpositionsrc != nil {
+		e.key(`textpositionsrc`)
+		e.any(obj.Textpositionsrc)
+	}
+	if obj.Textsrc != nil {
+		e.key(`textsrc`)
+		e.any(obj.Textsrc)
+	}
+	if obj.Texttemplate != nil {
+		e.key(`texttemplate`)
+		e.any(obj.Texttemplate)
+	}
+	if obj.Texttemplatesrc != nil {
+		e.key(`texttemplatesrc`)
+		e.any(obj.Texttemplatesrc)
+	}
+	if obj.Transforms != nil {
+		e.key(`transforms`)
+		e.any(obj.Transforms)
+	}
+	if obj.Tsrc != nil {
+		e.key(`tsrc`)
+		e.any(obj.Tsrc)
+	}
+	if obj.Uid != nil {
+		e.key(`uid`)
+		e.any(obj.Uid)
+	}
+	if obj.Uirevision != nil {
+		e.key(`uirevision`)
+		e.any(obj.Uirevision)
+	}
+	if obj.Unselected != nil {
+		e.key(`unselected`)
+		obj.Unselected.encodeJSON(e)
+	}
+	if obj.Visible != nil {
+		e.key(`visible`)
+		e.any(obj.Visible)
+	}
+	if obj.Width != 0 {
+		e.key(`width`)
+		e.float(float64(obj.Width))
+	}
+	if obj.Widthsrc != nil {
+		e.key(`widthsrc`)
+		e.any(obj.Widthsrc)
+	}
+	if obj.X != nil {
+		e.key(`x`)
+		e.any(obj.X)
+	}
+	if obj.X0 != nil {
+		e.key(`x0`)
+		e.any(obj.X0)
+	}
+	if obj.Xaxis != nil {
+		e.key(`xaxis`)
+		e.any(obj.Xaxis)
+	}
+	if obj.Xcalendar != "" {
+		e.key(`xcalendar`)
+		e.string(string(obj.Xcalendar))
+	}
+	if obj.Xperiod != nil {
+		e.key(`xperiod`)
+		e.any(obj.Xperiod)
+	}
+	if obj.Xperiod0 != nil {
+		e.key(`xperiod0`)
+		e.any(obj.Xperiod0)
+	}
+	if obj.Xperiodalignment != "" {
+		e.key(`xperiodalignment`)
+		e.string(string(obj.Xperiodalignment))
+	}
+	if obj.Xsrc != nil {
+		e.key(`xsrc`)
+		e.any(obj.Xsrc)
+	}
+	if obj.Y != nil {
+		e.key(`y`)
+		e.any(obj.Y)
+	}
+	if obj.Y0 != nil {
+		e.key(`y0`)
+		e.any(obj.Y0)
+	}
+	if obj.Yaxis != nil {
+		e.key(`yaxis`)
+		e.any(obj.Yaxis)
+	}
+	if obj.Ycalendar != "" {
+		e.key(`ycalendar`)
+		e.string(string(obj.Ycalendar))
+	}
+	if obj.Yperiod != nil {
+		e.key(`yperiod`)
+		e.any(obj.Yperiod)
+	}
+	if obj.Yperiod0 != nil {
+		e.key(`yperiod0`)
+		e.any(obj.Yperiod0)
+	}
+	if obj.Yperiodalignment != "" {
+		e.key(`yperiodalignment`)
+		e.string(string(obj.Yperiodalignment))
+	}
+	if obj.Ysrc != nil {
+		e.key(`ysrc`)
+		e.any(obj.Ysrc)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BarErrorX with precomputed fields. The output is the same as encoding/json.
+func (obj *BarErrorX) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BarErrorX) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Array != nil {
+		e.key(`array`)
+		e.any(obj.Array)
+	}
+	if obj.Arrayminus != nil {
+		e.key(`arrayminus`)
+		e.any(obj.Arrayminus)
+	}
+	if obj.Arrayminussrc != nil {
+		e.key(`arrayminussrc`)
+		e.any(obj.Arrayminussrc)
+	}
+	if obj.Arraysrc != nil {
+		e.key(`arraysrc`)
+		e.any(obj.Arraysrc)
+	}
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.CopyYstyle != nil {
+		e.key(`copy_ystyle`)
+		e.bool(*obj.CopyYstyle)
+	}
+	if obj.Symmetric != nil {
+		e.key(`symmetric`)
+		e.bool(*obj.Symmetric)
+	}
+	if obj.Thickness != 0 {
+		e.key(`thickness`)
+		e.float(float64(obj.Thickness))
+	}
+	if obj.Traceref != 0 {
+		e.key(`traceref`)
+		e.int(int64(obj.Traceref))
+	}
+	if obj.Tracerefminus != 0 {
+		e.key(`tracerefminus`)
+		e.int(int64(obj.Tracerefminus))
+	}
+	if obj.Type != "" {
+		e.key(`type`)
+		e.string(string(obj.Type))
+	}
+	if obj.Value != 0 {
+		e.key(`value`)
+		e.float(float64(obj.Value))
+	}
+	if obj.Valueminus != 0 {
+		e.key(`valueminus`)
+		e.float(float64(obj.Valueminus))
+	}
+	if obj.Visible != nil {
+		e.key(`visible`)
+		e.bool(*obj.Visible)
+	}
+	if obj.Width != 0 {
+		e.key(`width`)
+		e.float(float64(obj.Width))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BarErrorY with precomputed fields. The output is the same as encoding/json.
+func (obj *BarErrorY) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BarErrorY) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Array != nil {
+		e.key(`array`)
+		e.any(obj.Array)
+	}
+	if obj.Arrayminus != nil {
+		e.key(`arrayminus`)
+		e.any(obj.Arrayminus)
+	}
+	if obj.Arrayminussrc != nil {
+		e.key(`arrayminussrc`)
+		e.any(obj.Arrayminussrc)
+	}
+	if obj.Arraysrc != nil {
+		e.key(`arraysrc`)
+		e.any(obj.Arraysrc)
+	}
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Symmetric != nil {
+		e.key(`symmetric`)
+		e.bool(*obj.Symmetric)
+	}
+	if obj.Thickness != 0 {
+		e.key(`thickness`)
+		e.float(float64(obj.Thickness))
+	}
+	if obj.Traceref != 0 {
+		e.key(`traceref`)
+		e.int(int64(obj.Traceref))
+	}
+	if obj.Tracerefminus != 0 {
+		e.key(`tracerefminus`)
+		e.int(int64(obj.Tracerefminus))
+	}
+	if obj.Type != "" {
+		e.key(`type`)
+		e.string(string(obj.Type))
+	}
+	if obj.Value != 0 {
+		e.key(`value`)
+		e.float(float64(obj.Value))
+	}
+	if obj.Valueminus != 0 {
+		e.key(`valueminus`)
+		e.float(float64(obj.Valueminus))
+	}
+	if obj.Visible != nil {
+		e.key(`visible`)
+		e.bool(*obj.Visible)
+	}
+	if obj.Width != 0 {
+		e.key(`width`)
+		e.float(float64(obj.Width))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BarHoverlabelFont with precomputed fields. The output is the same as encoding/json.
+func (obj *BarHoverlabelFont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BarHoverlabelFont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Colorsrc != nil {
+		e.key(`colorsrc`)
+		e.any(obj.Colorsrc)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Familysrc != nil {
+		e.key(`familysrc`)
+		e.any(obj.Familysrc)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	if obj.Sizesrc != nil {
+		e.key(`sizesrc`)
+		e.any(obj.Sizesrc)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BarHoverlabel with precomputed fields. The output is the same as encoding/json.
+func (obj *BarHoverlabel) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BarHoverlabel) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Align != "" {
+		e.key(`align`)
+		e.string(string(obj.Align))
+	}
+	if obj.Alignsrc != nil {
+		e.key(`alignsrc`)
+		e.any(obj.Alignsrc)
+	}
+	if obj.Bgcolor != nil {
+		e.key(`bgcolor`)
+		e.any(obj.Bgcolor)
+	}
+	if obj.Bgcolorsrc != nil {
+		e.key(`bgcolorsrc`)
+		e.any(obj.Bgcolorsrc)
+	}
+	if obj.Bordercolor != nil {
+		e.key(`bordercolor`)
+		e.any(obj.Bordercolor)
+	}
+	if obj.Bordercolorsrc != nil {
+		e.key(`bordercolorsrc`)
+		e.any(obj.Bordercolorsrc)
+	}
+	if obj.Font != nil {
+		e.key(`font`)
+		obj.Font.encodeJSON(e)
+	}
+	if obj.Namelength != 0 {
+		e.key(`namelength`)
+		e.int(int64(obj.Namelength))
+	}
+	if obj.Namelengthsrc != nil {
+		e.key(`namelengthsrc`)
+		e.any(obj.Namelengthsrc)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BarInsidetextfont with precomputed fields. The output is the same as encoding/json.
+func (obj *BarInsidetextfont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BarInsidetextfont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Colorsrc != nil {
+		e.key(`colorsrc`)
+		e.any(obj.Colorsrc)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Familysrc != nil {
+		e.key(`familysrc`)
+		e.any(obj.Familysrc)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	if obj.Sizesrc != nil {
+		e.key(`sizesrc`)
+		e.any(obj.Sizesrc)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BarMarkerColorbarTickfont with precomputed fields. The output is the same as encoding/json.
+func (obj *BarMarkerColorbarTickfont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BarMarkerColorbarTickfont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BarMarkerColorbarTitleFont with precomputed fields. The output is the same as encoding/json.
+func (obj *BarMarkerColorbarTitleFont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BarMarkerColorbarTitleFont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BarMarkerColorbarTitle with precomputed fields. The output is the same as encoding/json.
+func (obj *BarMarkerColorbarTitle) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BarMarkerColorbarTitle) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Font != nil {
+		e.key(`font`)
+		obj.Font.encodeJSON(e)
+	}
+	if obj.Side != "" {
+		e.key(`side`)
+		e.string(string(obj.Side))
+	}
+	if obj.Text != nil {
+		e.key(`text`)
+		e.any(obj.Text)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BarMarkerColorbar with precomputed fields. The output is the same as encoding/json.
+func (obj *BarMarkerColorbar) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BarMarkerColorbar) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Bgcolor != nil {
+		e.key(`bgcolor`)
+		e.any(obj.Bgcolor)
+	}
+	if obj.Bordercolor != nil {
+		e.key(`bordercolor`)
+		e.any(obj.Bordercolor)
+	}
+	if obj.Borderwidth != 0 {
+		e.key(`borderwidth`)
+		e.float(float64(obj.Borderwidth))
+	}
+	if obj.Dtick != nil {
+		e.key(`dtick`)
+		e.any(obj.Dtick)
+	}
+	if obj.Exponentformat != "" {
+		e.key(`exponentformat`)
+		e.string(string(obj.Exponentformat))
+	}
+	if obj.Len != 0 {
+		e.key(`len`)
+		e.float(float64(obj.Len))
+	}
+	if obj.Lenmode != "" {
+		e.key(`lenmode`)
+		e.string(string(obj.Lenmode))
+	}
+	if obj.Minexponent != 0 {
+		e.key(`minexponent`)
+		e.float(float64(obj.Minexponent))
+	}
+	if obj.Nticks != 0 {
+		e.key(`nticks`)
+		e.int(int64(obj.Nticks))
+	}
+	if obj.Outlinecolor != nil {
+		e.key(`outlinecolor`)
+		e.any(obj.Outlinecolor)
+	}
+	if obj.Outlinewidth != 0 {
+		e.key(`outlinewidth`)
+		e.float(float64(obj.Outlinewidth))
+	}
+	if obj.Separatethousands != nil {
+		e.key(`separatethousands`)
+		e.bool(*obj.Separatethousands)
+	}
+	if obj.Showexponent != "" {
+		e.key(`showexponent`)
+		e.string(string(obj.Showexponent))
+	}
+	if obj.Showticklabels != nil {
+		e.key(`showticklabels`)
+		e.bool(*obj.Showticklabels)
+	}
+	if obj.Showtickprefix != "" {
+		e.key(`showtickprefix`)
+		e.string(string(obj.Showtickprefix))
+	}
+	if obj.Showticksuffix != "" {
+		e.key(`showticksuffix`)
+		e.string(string(obj.Showticksuffix))
+	}
+	if obj.Thickness != 0 {
+		e.key(`thickness`)
+		e.float(float64(obj.Thickness))
+	}
+	if obj.Thicknessmode != "" {
+		e.key(`thicknessmode`)
+		e.string(string(obj.Thicknessmode))
+	}
+	if obj.Tick0 != nil {
+		e.key(`tick0`)
+		e.any(obj.Tick0)
+	}
+	if obj.Tickangle != 0 {
+		e.key(`tickangle`)
+		e.float(float64(obj.Tickangle))
+	}
+	if obj.Tickcolor != nil {
+		e.key(`tickcolor`)
+		e.any(obj.Tickcolor)
+	}
+	if obj.Tickfont != nil {
+		e.key(`tickfont`)
+		obj.Tickfont.encodeJSON(e)
+	}
+	if obj.Tickformat != nil {
+		e.key(`tickformat`)
+		e.any(obj.Tickformat)
+	}
+	if obj.Tickformatstops != nil {
+		e.key(`tickformatstops`)
+		e.any(obj.Tickformatstops)
+	}
+	if obj.Ticklabelposition != "" {
+		e.key(`ticklabelposition`)
+		e.string(string(obj.Ticklabelposition))
+	}
+	if obj.Ticklen != 0 {
+		e.key(`ticklen`)
+		e.float(float64(obj.Ticklen))
+	}
+	if obj.Tickmode != "" {
+		e.key(`tickmode`)
+		e.string(string(obj.Tickmode))
+	}
+	if obj.Tickprefix != nil {
+		e.key(`tickprefix`)
+		e.any(obj.Tickprefix)
+	}
+	if obj.Ticks != "" {
+		e.key(`ticks`)
+		e.enum(string(obj.Ticks))
+	}
+	if obj.Ticksuffix != nil {
+		e.key(`ticksuffix`)
+		e.any(obj.Ticksuffix)
+	}
+	if obj.Ticktext != nil {
+		e.key(`ticktext`)
+		e.any(obj.Ticktext)
+	}
+	if obj.Ticktextsrc != nil {
+		e.key(`ticktextsrc`)
+		e.any(obj.Ticktextsrc)
+	}
+	if obj.Tickvals != nil {
+		e.key(`tickvals`)
+		e.any(obj.Tickvals)
+	}
+	if obj.Tickvalssrc != nil {
+		e.key(`tickvalssrc`)
+		e.any(obj.Tickvalssrc)
+	}
+	if obj.Tickwidth != 0 {
+		e.key(`tickwidth`)
+		e.float(float64(obj.Tickwidth))
+	}
+	if obj.Title != nil {
+		e.key(`title`)
+		obj.Title.encodeJSON(e)
+	}
+	if obj.X != 0 {
+		e.key(`x`)
+		e.float(float64(obj.X))
+	}
+	if obj.Xanchor != "" {
+		e.key(`xanchor`)
+		e.string(string(obj.Xanchor))
+	}
+	if obj.Xpad != 0 {
+		e.key(`xpad`)
+		e.float(float64(obj.Xpad))
+	}
+	if obj.Y != 0 {
+		e.key(`y`)
+		e.float(float64(obj.Y))
+	}
+	if obj.Yanchor != "" {
+		e.key(`yanchor`)
+		e.string(string(obj.Yanchor))
+	}
+	if obj.Ypad != 0 {
+		e.key(`ypad`)
+		e.float(float64(obj.Ypad))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BarMarkerLine with precomputed fields. The output is the same as encoding/json.
+func (obj *BarMarkerLine) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BarMarkerLine) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Autocolorscale != nil {
+		e.key(`autocolorscale`)
+		e.bool(*obj.Autocolorscale)
+	}
+	if obj.Cauto != nil {
+		e.key(`cauto`)
+		e.bool(*obj.Cauto)
+	}
+	if obj.Cmax != 0 {
+		e.key(`cmax`)
+		e.float(float64(obj.Cmax))
+	}
+	if obj.Cmid != 0 {
+		e.key(`cmid`)
+		e.float(float64(obj.Cmid))
+	}
+	if obj.Cmin != 0 {
+		e.key(`cmin`)
+		e.float(float64(obj.Cmin))
+	}
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Coloraxis != nil {
+		e.key(`coloraxis`)
+		e.any(obj.Coloraxis)
+	}
+	if obj.Colorscale != nil {
+		e.key(`colorscale`)
+		e.any(obj.Colorscale)
+	}
+	if obj.Colorsrc != nil {
+		e.key(`colorsrc`)
+		e.any(obj.Colorsrc)
+	}
+	if obj.Reversescale != nil {
+		e.key(`reversescale`)
+		e.bool(*obj.Reversescale)
+	}
+	if obj.Width != 0 {
+		e.key(`width`)
+		e.float(float64(obj.Width))
+	}
+	if obj.Widthsrc != nil {
+		e.key(`widthsrc`)
+		e.any(obj.Widthsrc)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BarMarker with precomputed fields. The output is the same as encoding/json.
+func (obj *BarMarker) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BarMarker) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Autocolorscale != nil {
+		e.key(`autocolorscale`)
+		e.bool(*obj.Autocolorscale)
+	}
+	if obj.Cauto != nil {
+		e.key(`cauto`)
+		e.bool(*obj.Cauto)
+	}
+	if obj.Cmax != 0 {
+		e.key(`cmax`)
+		e.float(float64(obj.Cmax))
+	}
+	if obj.Cmid != 0 {
+		e.key(`cmid`)
+		e.float(float64(obj.Cmid))
+	}
+	if obj.Cmin != 0 {
+		e.key(`cmin`)
+		e.float(float64(obj.Cmin))
+	}
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Coloraxis != nil {
+		e.key(`coloraxis`)
+		e.any(obj.Coloraxis)
+	}
+	if obj.Colorbar != nil {
+		e.key(`colorbar`)
+		obj.Colorbar.encodeJSON(e)
+	}
+	if obj.Colorscale != nil {
+		e.key(`colorscale`)
+		e.any(obj.Colorscale)
+	}
+	if obj.Colorsrc != nil {
+		e.key(`colorsrc`)
+		e.any(obj.Colorsrc)
+	}
+	if obj.Line != nil {
+		e.key(`line`)
+		obj.Line.encodeJSON(e)
+	}
+	if obj.Opacity != 0 {
+		e.key(`opacity`)
+		e.float(float64(obj.Opacity))
+	}
+	if obj.Opacitysrc != nil {
+		e.key(`opacitysrc`)
+		e.any(obj.Opacitysrc)
+	}
+	if obj.Reversescale != nil {
+		e.key(`reversescale`)
+		e.bool(*obj.Reversescale)
+	}
+	if obj.Showscale != nil {
+		e.key(`showscale`)
+		e.bool(*obj.Showscale)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BarOutsidetextfont with precomputed fields. The output is the same as encoding/json.
+func (obj *BarOutsidetextfont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BarOutsidetextfont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Colorsrc != nil {
+		e.key(`colorsrc`)
+		e.any(obj.Colorsrc)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Familysrc != nil {
+		e.key(`familysrc`)
+		e.any(obj.Familysrc)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	if obj.Sizesrc != nil {
+		e.key(`sizesrc`)
+		e.any(obj.Sizesrc)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BarSelectedMarker with precomputed fields. The output is the same as encoding/json.
+func (obj *BarSelectedMarker) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BarSelectedMarker) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Opacity != 0 {
+		e.key(`opacity`)
+		e.float(float64(obj.Opacity))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BarSelectedTextfont with precomputed fields. The output is the same as encoding/json.
+func (obj *BarSelectedTextfont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BarSelectedTextfont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BarSelected with precomputed fields. The output is the same as encoding/json.
+func (obj *BarSelected) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BarSelected) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Marker != nil {
+		e.key(`marker`)
+		obj.Marker.encodeJSON(e)
+	}
+	if obj.Textfont != nil {
+		e.key(`textfont`)
+		obj.Textfont.encodeJSON(e)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BarStream with precomputed fields. The output is the same as encoding/json.
+func (obj *BarStream) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BarStream) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Maxpoints != 0 {
+		e.key(`maxpoints`)
+		e.float(float64(obj.Maxpoints))
+	}
+	if obj.Token != nil {
+		e.key(`token`)
+		e.any(obj.Token)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BarTextfont with precomputed fields. The output is the same as encoding/json.
+func (obj *BarTextfont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BarTextfont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Colorsrc != nil {
+		e.key(`colorsrc`)
+		e.any(obj.Colorsrc)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Familysrc != nil {
+		e.key(`familysrc`)
+		e.any(obj.Familysrc)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	if obj.Sizesrc != nil {
+		e.key(`sizesrc`)
+		e.any(obj.Sizesrc)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BarUnselectedMarker with precomputed fields. The output is the same as encoding/json.
+func (obj *BarUnselectedMarker) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BarUnselectedMarker) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Opacity != 0 {
+		e.key(`opacity`)
+		e.float(float64(obj.Opacity))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BarUnselectedTextfont with precomputed fields. The output is the same as encoding/json.
+func (obj *BarUnselectedTextfont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BarUnselectedTextfont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BarUnselected with precomputed fields. The output is the same as encoding/json.
+func (obj *BarUnselected) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BarUnselected) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Marker != nil {
+		e.key(`marker`)
+		obj.Marker.encodeJSON(e)
+	}
+	if obj.Textfont != nil {
+		e.key(`textfont`)
+		obj.Textfont.encodeJSON(e)
+	}
+	e.objectEnd()
+}
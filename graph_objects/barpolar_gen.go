@@ -8,6 +8,11 @@ func (trace *Barpolar) GetType() TraceType {
 	return TraceTypeBarpolar
 }
 
+// Validate checks the trace against the constraints of the schema, see ValidateTrace
+func (trace *Barpolar) Validate() error {
+	return ValidateTrace(trace)
+}
+
 // Barpolar The data visualized by the radial span of the bars is set in `r`
 type Barpolar struct {
 
@@ -1034,9 +1039,21 @@ type BarpolarMarkerColorbarTicks string
 const (
 	BarpolarMarkerColorbarTicksOutside BarpolarMarkerColorbarTicks = "outside"
 	BarpolarMarkerColorbarTicksInside  BarpolarMarkerColorbarTicks = "inside"
-	BarpolarMarkerColorbarTicksEmpty   BarpolarMarkerColorbarTicks = ""
+	BarpolarMarkerColorbarTicksEmpty   BarpolarMarkerColorbarTicks = emptyEnum
 )
 
+// MarshalJSON writes the empty value as "", the zero value is unset and omitted
+func (v BarpolarMarkerColorbarTicks) MarshalJSON() ([]byte, error) {
+	return marshalEnum(string(v))
+}
+
+// UnmarshalJSON reads "" as the empty value
+func (v *BarpolarMarkerColorbarTicks) UnmarshalJSON(data []byte) error {
+	s, err := unmarshalEnum(data)
+	*v = BarpolarMarkerColorbarTicks(s)
+	return err
+}
+
 // BarpolarMarkerColorbarTitleSide Determines the location of color bar's title with respect to the color bar. Note that the title's location used to be set by the now deprecated `titleside` attribute.
 type BarpolarMarkerColorbarTitleSide string
 
@@ -1097,3 +1114,859 @@ const (
 	BarpolarHoverinfoNone BarpolarHoverinfo = "none"
 	BarpolarHoverinfoSkip BarpolarHoverinfo = "skip"
 )
+
+// MarshalJSON encodes Barpolar with precomputed fields. The output is the same as encoding/json.
+func (obj *Barpolar) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *Barpolar) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Type != "" {
+		e.key(`type`)
+		e.string(string(obj.Type))
+	}
+	if obj.Base != nil {
+		e.key(`base`)
+		e.any(obj.Base)
+	}
+	if obj.Basesrc != nil {
+		e.key(`basesrc`)
+		e.any(obj.Basesrc)
+	}
+	if obj.Customdata != nil {
+		e.key(`customdata`)
+		e.any(obj.Customdata)
+	}
+	if obj.Customdatasrc != nil {
+		e.key(`customdatasrc`)
+		e.any(obj.Customdatasrc)
+	}
+	if obj.Dr != 0 {
+		e.key(`dr`)
+		e.float(float64(obj.Dr))
+	}
+	if obj.Dtheta != 0 {
+		e.key(`dtheta`)
+		e.float(float64(obj.Dtheta))
+	}
+	if obj.Hoverinfo != "" {
+		e.key(`hoverinfo`)
+		e.string(string(obj.Hoverinfo))
+	}
+	if obj.Hoverinfosrc != nil {
+		e.key(`hoverinfosrc`)
+		e.any(obj.Hoverinfosrc)
+	}
+	if obj.Hoverlabel != nil {
+		e.key(`hoverlabel`)
+		obj.Hoverlabel.encodeJSON(e)
+	}
+	if obj.Hovertemplate != nil {
+		e.key(`hovertemplate`)
+		e.any(obj.Hovertemplate)
+	}
+	if obj.Hovertemplatesrc != nil {
+		e.key(`hovertemplatesrc`)
+		e.any(obj.Hovertemplatesrc)
+	}
+	if obj.Hovertext != nil {
+		e.key(`hovertext`)
+		e.any(obj.Hovertext)
+	}
+	if obj.Hovertextsrc != nil {
+		e.key(`hovertextsrc`)
+		e.any(obj.Hovertextsrc)
+	}
+	if obj.Ids != nil {
+		e.key(`ids`)
+		e.any(obj.Ids)
+	}
+	if obj.Idssrc != nil {
+		e.key(`idssrc`)
+		e.any(obj.Idssrc)
+	}
+	if obj.Legendgroup != nil {
+		e.key(`legendgroup`)
+		e.any(obj.Legendgroup)
+	}
+	if obj.Marker != nil {
+		e.key(`marker`)
+		obj.Marker.encodeJSON(e)
+	}
+	if obj.Meta != nil {
+		e.key(`meta`)
+		e.any(obj.Meta)
+	}
+	if obj.Metasrc != nil {
+		e.key(`metasrc`)
+		e.any(obj.Metasrc)
+	}
+	if obj.Name != nil {
+		e.key(`name`)
+		e.any(obj.Name)
+	}
+	if obj.Offset != 0 {
+		e.key(`offset`)
+		e.float(float64(obj.Offset))
+	}
+	if obj.Offsetsrc != nil {
+		e.key(`offsetsrc`)
+		e.any(obj.Offsetsrc)
+	}
+	if obj.Opacity != 0 {
+		e.key(`opacity`)
+		e.float(float64(obj.Opacity))
+	}
+	if obj.R != nil {
+		e.key(`r`)
+		e.any(obj.R)
+	}
+	if obj.R0 != nil {
+		e.key(`r0`)
+		e.any(obj.R0)
+	}
+	if obj.Rsrc != nil {
+		e.key(`rsrc`)
+		e.any(obj.Rsrc)
+	}
+	if obj.Selected != nil {
+		e.key(`selected`)
+		obj.Selected.encodeJSON(e)
+	}
+	if obj.Selectedpoints != nil {
+		e.key(`selectedpoints`)
+		e.any(obj.Selectedpoints)
+	}
+	if obj.Showlegend != nil {
+		e.key(`showlegend`)
+		e.bool(*obj.Showlegend)
+	}
+	if obj.Stream != nil {
+		e.key(`stream`)
+		obj.Stream.encodeJSON(e)
+	}
+	if obj.Subplot != nil {
+		e.key(`subplot`)
+		e.any(obj.Subplot)
+	}
+	if obj.Text != nil {
+		e.key(`text`)
+		e.any(obj.Text)
+	}
+	if obj.Textsrc != nil {
+		e.key(`textsrc`)
+		e.any(obj.Textsrc)
+	}
+	if obj.Theta != nil {
+		e.key(`theta`)
+		e.any(obj.Theta)
+	}
+	if obj.Theta0 != nil {
+		e.key(`theta0`)
+		e.any(obj.Theta0)
+	}
+	if obj.Thetasrc != nil {
+		e.key(`thetasrc`)
+		e.any(obj.Thetasrc)
+	}
+	if obj.Thetaunit != "" {
+		e.key(`thetaunit`)
+		e.string(string(obj.Thetaunit))
+	}
+	if obj.Transforms != nil {
+		e.key(`transforms`)
+		e.any(obj.Transforms)
+	}
+	if obj.Uid != nil {
+		e.key(`uid`)
+		e.any(obj.Uid)
+	}
+	if obj.Uirevision != nil {
+		e.key(`uirevision`)
+		e.any(obj.Uirevision)
+	}
+	if obj.Unselected != nil {
+		e.key(`unselected`)
+		obj.Unselected.encodeJSON(e)
+	}
+	if obj.Visible != nil {
+		e.key(`visible`)
+		e.any(obj.Visible)
+	}
+	if obj.Width != 0 {
+		e.key(`width`)
+		e.float(float64(obj.Width))
+	}
+	if obj.Widthsrc != nil {
+		e.key(`widthsrc`)
+		e.any(obj.Widthsrc)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BarpolarHoverlabelFont with precomputed fields. The output is the same as encoding/json.
+func (obj *BarpolarHoverlabelFont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BarpolarHoverlabelFont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Colorsrc != nil {
+		e.key(`colorsrc`)
+		e.any(obj.Colorsrc)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Familysrc != nil {
+		e.key(`familysrc`)
+		e.any(obj.Familysrc)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	if obj.Sizesrc != nil {
+		e.key(`sizesrc`)
+		e.any(obj.Sizesrc)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BarpolarHoverlabel with precomputed fields. The output is the same as encoding/json.
+func (obj *BarpolarHoverlabel) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BarpolarHoverlabel) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Align != "" {
+		e.key(`align`)
+		e.string(string(obj.Align))
+	}
+	if obj.Alignsrc != nil {
+		e.key(`alignsrc`)
+		e.any(obj.Alignsrc)
+	}
+	if obj.Bgcolor != nil {
+		e.key(`bgcolor`)
+		e.any(obj.Bgcolor)
+	}
+	if obj.Bgcolorsrc != nil {
+		e.key(`bgcolorsrc`)
+		e.any(obj.Bgcolorsrc)
+	}
+	if obj.Bordercolor != nil {
+		e.key(`bordercolor`)
+		e.any(obj.Bordercolor)
+	}
+	if obj.Bordercolorsrc != nil {
+		e.key(`bordercolorsrc`)
+		e.any(obj.Bordercolorsrc)
+	}
+	if obj.Font != nil {
+		e.key(`font`)
+		obj.Font.encodeJSON(e)
+	}
+	if obj.Namelength != 0 {
+		e.key(`namelength`)
+		e.int(int64(obj.Namelength))
+	}
+	if obj.Namelengthsrc != nil {
+		e.key(`namelengthsrc`)
+		e.any(obj.Namelengthsrc)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BarpolarMarkerColorbarTickfont with precomputed fields. The output is the same as encoding/json.
+func (obj *BarpolarMarkerColorbarTickfont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BarpolarMarkerColorbarTickfont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BarpolarMarkerColorbarTitleFont with precomputed fields. The output is the same as encoding/json.
+func (obj *BarpolarMarkerColorbarTitleFont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BarpolarMarkerColorbarTitleFont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BarpolarMarkerColorbarTitle with precomputed fields. The output is the same as encoding/json.
+func (obj *BarpolarMarkerColorbarTitle) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BarpolarMarkerColorbarTitle) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Font != nil {
+		e.key(`font`)
+		obj.Font.encodeJSON(e)
+	}
+	if obj.Side != "" {
+		e.key(`side`)
+		e.string(string(obj.Side))
+	}
+	if obj.Text != nil {
+		e.key(`text`)
+		e.any(obj.Text)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BarpolarMarkerColorbar with precomputed fields. The output is the same as encoding/json.
+func (obj *BarpolarMarkerColorbar) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BarpolarMarkerColorbar) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Bgcolor != nil {
+		e.key(`bgcolor`)
+		e.any(obj.Bgcolor)
+	}
+	if obj.Bordercolor != nil {
+		e.key(`bordercolor`)
+		e.any(obj.Bordercolor)
+	}
+	if obj.Borderwidth != 0 {
+		e.key(`borderwidth`)
+		e.float(float64(obj.Borderwidth))
+	}
+	if obj.Dtick != nil {
+		e.key(`dtick`)
+		e.any(obj.Dtick)
+	}
+	if obj.Exponentformat != "" {
+		e.key(`exponentformat`)
+		e.string(string(obj.Exponentformat))
+	}
+	if obj.Len != 0 {
+		e.key(`len`)
+		e.float(float64(obj.Len))
+	}
+	if obj.Lenmode != "" {
+		e.key(`lenmode`)
+		e.string(string(obj.Lenmode))
+	}
+	if obj.Minexponent != 0 {
+		e.key(`minexponent`)
+		e.float(float64(obj.Minexponent))
+	}
+	if obj.Nticks != 0 {
+		e.key(`nticks`)
+		e.int(int64(obj.Nticks))
+	}
+	if obj.Outlinecolor != nil {
+		e.key(`outlinecolor`)
+		e.any(obj.Outlinecolor)
+	}
+	if obj.Outlinewidth != 0 {
+		e.key(`outlinewidth`)
+		e.float(float64(obj.Outlinewidth))
+	}
+	if obj.Separatethousands != nil {
+		e.key(`separatethousands`)
+		e.bool(*obj.Separatethousands)
+	}
+	if obj.Showexponent != "" {
+		e.key(`showexponent`)
+		e.string(string(obj.Showexponent))
+	}
+	if obj.Showticklabels != nil {
+		e.key(`showticklabels`)
+		e.bool(*obj.Showticklabels)
+	}
+	if obj.Showtickprefix != "" {
+		e.key(`showtickprefix`)
+		e.string(string(obj.Showtickprefix))
+	}
+	if obj.Showticksuffix != "" {
+		e.key(`showticksuffix`)
+		e.string(string(obj.Showticksuffix))
+	}
+	if obj.Thickness != 0 {
+		e.key(`thickness`)
+		e.float(float64(obj.Thickness))
+	}
+	if obj.Thicknessmode != "" {
+		e.key(`thicknessmode`)
+		e.string(string(obj.Thicknessmode))
+	}
+	if obj.Tick0 != nil {
+		e.key(`tick0`)
+		e.any(obj.Tick0)
+	}
+	if obj.Tickangle != 0 {
+		e.key(`tickangle`)
+		e.float(float64(obj.Tickangle))
+	}
+	if obj.Tickcolor != nil {
+		e.key(`tickcolor`)
+		e.any(obj.Tickcolor)
+	}
+	if obj.Tickfont != nil {
+		e.key(`tickfont`)
+		obj.Tickfont.encodeJSON(e)
+	}
+	if obj.Tickformat != nil {
+		e.key(`tickformat`)
+		e.any(obj.Tickformat)
+	}
+	if obj.Tickformatstops != nil {
+		e.key(`tickformatstops`)
+		e.any(obj.Tickformatstops)
+	}
+	if obj.Ticklabelposition != "" {
+		e.key(`ticklabelposition`)
+		e.string(string(obj.Ticklabelposition))
+	}
+	if obj.Ticklen != 0 {
+		e.key(`ticklen`)
+		e.float(float64(obj.Ticklen))
+	}
+	if obj.Tickmode != "" {
+		e.key(`tickmode`)
+		e.string(string(obj.Tickmode))
+	}
+	if obj.Tickprefix != nil {
+		e.key(`tickprefix`)
+		e.any(obj.Tickprefix)
+	}
+	if obj.Ticks != "" {
+		e.key(`ticks`)
+		e.enum(string(obj.Ticks))
+	}
+	if obj.Ticksuffix != nil {
+		e.key(`ticksuffix`)
+		e.any(obj.Ticksuffix)
+	}
+	if obj.Ticktext != nil {
+		e.key(`ticktext`)
+		e.any(obj.Ticktext)
+	}
+	if obj.Ticktextsrc != nil {
+		e.key(`ticktextsrc`)
+		e.any(obj.Ticktextsrc)
+	}
+	if obj.Tickvals != nil {
+		e.key(`tickvals`)
+		e.any(obj.Tickvals)
+	}
+	if obj.Tickvalssrc != nil {
+		e.key(`tickvalssrc`)
+		e.any(obj.Tickvalssrc)
+	}
+	if obj.Tickwidth != 0 {
+		e.key(`tickwidth`)
+		e.float(float64(obj.Tickwidth))
+	}
+	if obj.Title != nil {
+		e.key(`title`)
+		obj.Title.encodeJSON(e)
+	}
+	if obj.X != 0 {
+		e.key(`x`)
+		e.float(float64(obj.X))
+	}
+	if obj.Xanchor != "" {
+		e.key(`xanchor`)
+		e.string(string(obj.Xanchor))
+	}
+	if obj.Xpad != 0 {
+		e.key(`xpad`)
+		e.float(float64(obj.Xpad))
+	}
+	if obj.Y != 0 {
+		e.key(`y`)
+		e.float(float64(obj.Y))
+	}
+	if obj.Yanchor != "" {
+		e.key(`yanchor`)
+		e.string(string(obj.Yanchor))
+	}
+	if obj.Ypad != 0 {
+		e.key(`ypad`)
+		e.float(float64(obj.Ypad))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BarpolarMarkerLine with precomputed fields. The output is the same as encoding/json.
+func (obj *BarpolarMarkerLine) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BarpolarMarkerLine) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Autocolorscale != nil {
+		e.key(`autocolorscale`)
+		e.bool(*obj.Autocolorscale)
+	}
+	if obj.Cauto != nil {
+		e.key(`cauto`)
+		e.bool(*obj.Cauto)
+	}
+	if obj.Cmax != 0 {
+		e.key(`cmax`)
+		e.float(float64(obj.Cmax))
+	}
+	if obj.Cmid != 0 {
+		e.key(`cmid`)
+		e.float(float64(obj.Cmid))
+	}
+	if obj.Cmin != 0 {
+		e.key(`cmin`)
+		e.float(float64(obj.Cmin))
+	}
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Coloraxis != nil {
+		e.key(`coloraxis`)
+		e.any(obj.Coloraxis)
+	}
+	if obj.Colorscale != nil {
+		e.key(`colorscale`)
+		e.any(obj.Colorscale)
+	}
+	if obj.Colorsrc != nil {
+		e.key(`colorsrc`)
+		e.any(obj.Colorsrc)
+	}
+	if obj.Reversescale != nil {
+		e.key(`reversescale`)
+		e.bool(*obj.Reversescale)
+	}
+	if obj.Width != 0 {
+		e.key(`width`)
+		e.float(float64(obj.Width))
+	}
+	if obj.Widthsrc != nil {
+		e.key(`widthsrc`)
+		e.any(obj.Widthsrc)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BarpolarMarker with precomputed fields. The output is the same as encoding/json.
+func (obj *BarpolarMarker) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BarpolarMarker) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Autocolorscale != nil {
+		e.key(`autocolorscale`)
+		e.bool(*obj.Autocolorscale)
+	}
+	if obj.Cauto != nil {
+		e.key(`cauto`)
+		e.bool(*obj.Cauto)
+	}
+	if obj.Cmax != 0 {
+		e.key(`cmax`)
+		e.float(float64(obj.Cmax))
+	}
+	if obj.Cmid != 0 {
+		e.key(`cmid`)
+		e.float(float64(obj.Cmid))
+	}
+	if obj.Cmin != 0 {
+		e.key(`cmin`)
+		e.float(float64(obj.Cmin))
+	}
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Coloraxis != nil {
+		e.key(`coloraxis`)
+		e.any(obj.Coloraxis)
+	}
+	if obj.Colorbar != nil {
+		e.key(`colorbar`)
+		obj.Colorbar.encodeJSON(e)
+	}
+	if obj.Colorscale != nil {
+		e.key(`colorscale`)
+		e.any(obj.Colorscale)
+	}
+	if obj.Colorsrc != nil {
+		e.key(`colorsrc`)
+		e.any(obj.Colorsrc)
+	}
+	if obj.Line != nil {
+		e.key(`line`)
+		obj.Line.encodeJSON(e)
+	}
+	if obj.Opacity != 0 {
+		e.key(`opacity`)
+		e.float(float64(obj.Opacity))
+	}
+	if obj.Opacitysrc != nil {
+		e.key(`opacitysrc`)
+		e.any(obj.Opacitysrc)
+	}
+	if obj.Reversescale != nil {
+		e.key(`reversescale`)
+		e.bool(*obj.Reversescale)
+	}
+	if obj.Showscale != nil {
+		e.key(`showscale`)
+		e.bool(*obj.Showscale)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BarpolarSelectedMarker with precomputed fields. The output is the same as encoding/json.
+func (obj *BarpolarSelectedMarker) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BarpolarSelectedMarker) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Opacity != 0 {
+		e.key(`opacity`)
+		e.float(float64(obj.Opacity))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BarpolarSelectedTextfont with precomputed fields. The output is the same as encoding/json.
+func (obj *BarpolarSelectedTextfont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BarpolarSelectedTextfont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BarpolarSelected with precomputed fields. The output is the same as encoding/json.
+func (obj *BarpolarSelected) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BarpolarSelected) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Marker != nil {
+		e.key(`marker`)
+		obj.Marker.encodeJSON(e)
+	}
+	if obj.Textfont != nil {
+		e.key(`textfont`)
+		obj.Textfont.encodeJSON(e)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BarpolarStream with precomputed fields. The output is the same as encoding/json.
+func (obj *BarpolarStream) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BarpolarStream) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Maxpoints != 0 {
+		e.key(`maxpoints`)
+		e.float(float64(obj.Maxpoints))
+	}
+	if obj.Token != nil {
+		e.key(`token`)
+		e.any(obj.Token)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BarpolarUnselectedMarker with precomputed fields. The output is the same as encoding/json.
+func (obj *BarpolarUnselectedMarker) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BarpolarUnselectedMarker) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Opacity != 0 {
+		e.key(`opacity`)
+		e.float(float64(obj.Opacity))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BarpolarUnselectedTextfont with precomputed fields. The output is the same as encoding/json.
+func (obj *BarpolarUnselectedTextfont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BarpolarUnselectedTextfont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BarpolarUnselected with precomputed fields. The output is the same as encoding/json.
+func (obj *BarpolarUnselected) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BarpolarUnselected) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Marker != nil {
+		e.key(`marker`)
+		obj.Marker.encodeJSON(e)
+	}
+	if obj.Textfont != nil {
+		e.key(`textfont`)
+		obj.Textfont.encodeJSON(e)
+	}
+	e.objectEnd()
+}
@@ -0,0 +1,132 @@
+package grob_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"testing"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// The benchmarks measure the encoding of representative figures, run them with
+//
+//	go test ./graph_objects -run xxx -bench . -benchmem
+//
+// and compare the results before and after a change with benchstat. The throughput is the size of the JSON.
+
+// scatterFig returns a figure with a line of n points
+func scatterFig(n int) *grob.Fig {
+	x := make([]float64, n)
+	y := make([]float64, n)
+	for i := range x {
+		x[i] = float64(i)
+		y[i] = math.Sin(float64(i) / 100)
+	}
+	return &grob.Fig{
+		Data: grob.Traces{&grob.Scattergl{Type: grob.TraceTypeScattergl, X: x, Y: y, Mode: grob.ScatterglModeLines}},
+	}
+}
+
+// dashboardFig returns a figure with 50 small traces with some styling, like the figures of a dashboard
+func dashboardFig() *grob.Fig {
+	fig := &grob.Fig{
+		Layout: &grob.Layout{
+			Title:  &grob.LayoutTitle{Text: "Dashboard"},
+			Xaxis:  &grob.LayoutXaxis{Title: &grob.LayoutXaxisTitle{Text: "time"}, Showgrid: grob.True},
+			Yaxis:  &grob.LayoutYaxis{Title: &grob.LayoutYaxisTitle{Text: "value"}, Showgrid: grob.True},
+			Legend: &grob.LayoutLegend{Orientation: grob.LayoutLegendOrientationH},
+		},
+	}
+	for i := 0; i < 50; i++ {
+		x := make([]float64, 100)
+		y := make([]float64, 100)
+		for j := range x {
+			x[j] = float64(j)
+			y[j] = float64(i*j) / 7
+		}
+		fig.AddTraces(&grob.Scatter{
+			X:             x,
+			Y:             y,
+			Name:          fmt.Sprintf("series %d", i),
+			Mode:          grob.ScatterModeLines,
+			Line:          &grob.ScatterLine{Width: 1.5, Color: "#1f77b4"},
+			Hovertemplate: "%{x}: %{y}<extra></extra>",
+		})
+	}
+	return fig
+}
+
+// heatmapFig returns a heatmap of rows x cols stored as a flat matrix
+func heatmapFig(rows, cols int, typedArray bool) *grob.Fig {
+	z := grob.NewMatrix(rows, cols)
+	for i := range z.Data {
+		z.Data[i] = float64(i%cols) * 0.5
+	}
+	z.TypedArray = typedArray
+	return &grob.Fig{Data: grob.Traces{&grob.Heatmap{Type: grob.TraceTypeHeatmap, Z: z}}}
+}
+
+func benchmarkEncode(b *testing.B, fig *grob.Fig) {
+	data, err := fig.ToPlotlyJSON()
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := fig.ToPlotlyJSON()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkToPlotlyJSON(b *testing.B) {
+	for _, n := range []int{1e4, 1e6, 1e7} {
+		if n > 1e6 && testing.Short() {
+			continue
+		}
+		b.Run(fmt.Sprintf("points=%.0e", float64(n)), func(b *testing.B) {
+			benchmarkEncode(b, scatterFig(n))
+		})
+	}
+	b.Run("dashboard", func(b *testing.B) {
+		benchmarkEncode(b, dashboardFig())
+	})
+	b.Run("heatmap=1000x1000", func(b *testing.B) {
+		benchmarkEncode(b, heatmapFig(1000, 1000, false))
+	})
+	b.Run("heatmap=1000x1000/typedarray", func(b *testing.B) {
+		benchmarkEncode(b, heatmapFig(1000, 1000, true))
+	})
+}
+
+func BenchmarkMarshalJSON(b *testing.B) {
+	fig := dashboardFig()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, err := json.Marshal(fig)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkUnmarshalJSON(b *testing.B) {
+	data, err := dashboardFig().ToPlotlyJSON()
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.SetBytes(int64(len(data)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		fig := &grob.Fig{}
+		err := json.Unmarshal(data, fig)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -8,6 +8,11 @@ func (trace *Box) GetType() TraceType {
 	return TraceTypeBox
 }
 
+// Validate checks the trace against the constraints of the schema, see ValidateTrace
+func (trace *Box) Validate() error {
+	return ValidateTrace(trace)
+}
+
 // Box Each box spans from quartile 1 (Q1) to quartile 3 (Q3). The second quartile (Q2, i.e. the median) is marked by a line inside the box. The fences grow outward from the boxes' edges, by default they span +/- 1.5 times the interquartile range (IQR: Q3-Q1), The sample mean and standard deviation as well as notches and the sample, outlier and suspected outliers points can be optionally added to the box plot. The values and positions corresponding to each boxes can be input using two signatures. The first signature expects users to supply the sample values in the `y` data array for vertical boxes (`x` for horizontal boxes). By supplying an `x` (`y`) array, one box per distinct `x` (`y`) value is drawn If no `x` (`y`) {array} is provided, a single box is drawn. In this case, the box is positioned with the trace `name` or with `x0` (`y0`) if provided. The second signature expects users to supply the boxes corresponding Q1, median and Q3 statistics in the `q1`, `median` and `q3` data arrays respectively. Other box features relying on statistics namely `lowerfence`, `upperfence`, `notchspan` can be set directly by the users. To have plotly compute them or to show sample points besides the boxes, users can set the `y` data array for vertical boxes (`x` for horizontal boxes) to a 2D array with the outer length corresponding to the number of boxes in the traces and the inner length corresponding the sample size.
 type Box struct {
 
@@ -1344,3 +1349,639 @@ const (
 	// Extra
 
 )
+
+// MarshalJSON encodes Box with precomputed fields. The output is the same as encoding/json.
+func (obj *Box) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *Box) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Type != "" {
+		e.key(`type`)
+		e.string(string(obj.Type))
+	}
+	if obj.Alignmentgroup != nil {
+		e.key(`alignmentgroup`)
+		e.any(obj.Alignmentgroup)
+	}
+	if obj.Boxmean != nil {
+		e.key(`boxmean`)
+		e.any(obj.Boxmean)
+	}
+	if obj.Boxpoints != nil {
+		e.key(`boxpoints`)
+		e.any(obj.Boxpoints)
+	}
+	if obj.Customdata != nil {
+		e.key(`customdata`)
+		e.any(obj.Customdata)
+	}
+	if obj.Customdatasrc != nil {
+		e.key(`customdatasrc`)
+		e.any(obj.Customdatasrc)
+	}
+	if obj.Dx != 0 {
+		e.key(`dx`)
+		e.float(float64(obj.Dx))
+	}
+	if obj.Dy != 0 {
+		e.key(`dy`)
+		e.float(float64(obj.Dy))
+	}
+	if obj.Fillcolor != nil {
+		e.key(`fillcolor`)
+		e.any(obj.Fillcolor)
+	}
+	if obj.Hoverinfo != "" {
+		e.key(`hoverinfo`)
+		e.string(string(obj.Hoverinfo))
+	}
+	if obj.Hoverinfosrc != nil {
+		e.key(`hoverinfosrc`)
+		e.any(obj.Hoverinfosrc)
+	}
+	if obj.Hoverlabel != nil {
+		e.key(`hoverlabel`)
+		obj.Hoverlabel.encodeJSON(e)
+	}
+	if obj.Hoveron != "" {
+		e.key(`hoveron`)
+		e.string(string(obj.Hoveron))
+	}
+	if obj.Hovertemplate != nil {
+		e.key(`hovertemplate`)
+		e.any(obj.Hovertemplate)
+	}
+	if obj.Hovertemplatesrc != nil {
+		e.key(`hovertemplatesrc`)
+		e.any(obj.Hovertemplatesrc)
+	}
+	if obj.Hovertext != nil {
+		e.key(`hovertext`)
+		e.any(obj.Hovertext)
+	}
+	if obj.Hovertextsrc != nil {
+		e.key(`hovertextsrc`)
+		e.any(obj.Hovertextsrc)
+	}
+	if obj.Ids != nil {
+		e.key(`ids`)
+		e.any(obj.Ids)
+	}
+	if obj.Idssrc != nil {
+		e.key(`idssrc`)
+		e.any(obj.Idssrc)
+	}
+	if obj.Jitter != 0 {
+		e.key(`jitter`)
+		e.float(float64(obj.Jitter))
+	}
+	if obj.Legendgroup != nil {
+		e.key(`legendgroup`)
+		e.any(obj.Legendgroup)
+	}
+	if obj.Line != nil {
+		e.key(`line`)
+		obj.Line.encodeJSON(e)
+	}
+	if obj.Lowerfence != nil {
+		e.key(`lowerfence`)
+		e.any(obj.Lowerfence)
+	}
+	if obj.Lowerfencesrc != nil {
+		e.key(`lowerfencesrc`)
+		e.any(obj.Lowerfencesrc)
+	}
+	if obj.Marker != nil {
+		e.key(`marker`)
+		obj.Marker.encodeJSON(e)
+	}
+	if obj.Mean != nil {
+		e.key(`mean`)
+		e.any(obj.Mean)
+	}
+	if obj.Meansrc != nil {
+		e.key(`meansrc`)
+		e.any(obj.Meansrc)
+	}
+	if obj.Median != nil {
+		e.key(`median`)
+		e.any(obj.Median)
+	}
+	if obj.Mediansrc != nil {
+		e.key(`mediansrc`)
+		e.any(obj.Mediansrc)
+	}
+	if obj.Meta != nil {
+		e.key(`meta`)
+		e.any(obj.Meta)
+	}
+	if obj.Metasrc != nil {
+		e.key(`metasrc`)
+		e.any(obj.Metasrc)
+	}
+	if obj.Name != nil {
+		e.key(`name`)
+		e.any(obj.Name)
+	}
+	if obj.Notched != nil {
+		e.key(`notched`)
+		e.bool(*obj.Notched)
+	}
+	if obj.Notchspan != nil {
+		e.key(`notchspan`)
+		e.any(obj.Notchspan)
+	}
+	if obj.Notchspansrc != nil {
+		e.key(`notchspansrc`)
+		e.any(obj.Notchspansrc)
+	}
+	if obj.Notchwidth != 0 {
+		e.key(`notchwidth`)
+		e.float(float64(obj.Notchwidth))
+	}
+	if obj.Offsetgroup != nil {
+		e.key(`offsetgroup`)
+		e.any(obj.Offsetgroup)
+	}
+	if obj.Opacity != 0 {
+		e.key(`opacity`)
+		e.float(float64(obj.Opacity))
+	}
+	if obj.Orientation != "" {
+		e.key(`orientation`)
+		e.string(string(obj.Orientation))
+	}
+	if obj.Pointpos != 0 {
+		e.key(`pointpos`)
+		e.float(float64(obj.Pointpos))
+	}
+	if obj.Q1 != nil {
+		e.key(`q1`)
+		e.any(obj.Q1)
+	}
+	if obj.Q1src != nil {
+		e.key(`q1src`)
+		e.any(obj.Q1src)
+	}
+	if obj.Q3 != nil {
+		e.key(`q3`)
+		e.any(obj.Q3)
+	}
+	if obj.Q3src != nil {
+		e.key(`q3src`)
+		e.any(obj.Q3src)
+	}
+	if obj.Quartilemethod != "" {
+		e.key(`quartilemethod`)
+		e.string(string(obj.Quartilemethod))
+	}
+	if obj.Sd != nil {
+		e.key(`sd`)
+		e.any(obj.Sd)
+	}
+	if obj.Sdsrc != nil {
+		e.key(`sdsrc`)
+		e.any(obj.Sdsrc)
+	}
+	if obj.Selected != nil {
+		e.key(`selected`)
+		obj.Selected.encodeJSON(e)
+	}
+	if obj.Selectedpoints != nil {
+		e.key(`selectedpoints`)
+		e.any(obj.Selectedpoints)
+	}
+	if obj.Showlegend != nil {
+		e.key(`showlegend`)
+		e.bool(*obj.Showlegend)
+	}
+	if obj.Stream != nil {
+		e.key(`stream`)
+		obj.Stream.encodeJSON(e)
+	}
+	if obj.Text != nil {
+		e.key(`text`)
+		e.any(obj.Text)
+	}
+	if obj.Textsrc != nil {
+		e.key(`textsrc`)
+		e.any(obj.Textsrc)
+	}
+	if obj.Transforms != nil {
+		e.key(`transforms`)
+		e.any(obj.Transforms)
+	}
+	if obj.Uid != nil {
+		e.key(`uid`)
+		e.any(obj.Uid)
+	}
+	if obj.Uirevision != nil {
+		e.key(`uirevision`)
+		e.any(obj.Uirevision)
+	}
+	if obj.Unselected != nil {
+		e.key(`unselected`)
+		obj.Unselected.encodeJSON(e)
+	}
+	if obj.Upperfence != nil {
+		e.key(`upperfence`)
+		e.any(obj.Upperfence)
+	}
+	if obj.Upperfencesrc != nil {
+		e.key(`upperfencesrc`)
+		e.any(obj.Upperfencesrc)
+	}
+	if obj.Visible != nil {
+		e.key(`visible`)
+		e.any(obj.Visible)
+	}
+	if obj.Whiskerwidth != 0 {
+		e.key(`whiskerwidth`)
+		e.float(float64(obj.Whiskerwidth))
+	}
+	if obj.Width != 0 {
+		e.key(`width`)
+		e.float(float64(obj.Width))
+	}
+	if obj.X != nil {
+		e.key(`x`)
+		e.any(obj.X)
+	}
+	if obj.X0 != nil {
+		e.key(`x0`)
+		e.any(obj.X0)
+	}
+	if obj.Xaxis != nil {
+		e.key(`xaxis`)
+		e.any(obj.Xaxis)
+	}
+	if obj.Xcalendar != "" {
+		e.key(`xcalendar`)
+		e.string(string(obj.Xcalendar))
+	}
+	if obj.Xperiod != nil {
+		e.key(`xperiod`)
+		e.any(obj.Xperiod)
+	}
+	if obj.Xperiod0 != nil {
+		e.key(`xperiod0`)
+		e.any(obj.Xperiod0)
+	}
+	if obj.Xperiodalignment != "" {
+		e.key(`xperiodalignment`)
+		e.string(string(obj.Xperiodalignment))
+	}
+	if obj.Xsrc != nil {
+		e.key(`xsrc`)
+		e.any(obj.Xsrc)
+	}
+	if obj.Y != nil {
+		e.key(`y`)
+		e.any(obj.Y)
+	}
+	if obj.Y0 != nil {
+		e.key(`y0`)
+		e.any(obj.Y0)
+	}
+	if obj.Yaxis != nil {
+		e.key(`yaxis`)
+		e.any(obj.Yaxis)
+	}
+	if obj.Ycalendar != "" {
+		e.key(`ycalendar`)
+		e.string(string(obj.Ycalendar))
+	}
+	if obj.Yperiod != nil {
+		e.key(`yperiod`)
+		e.any(obj.Yperiod)
+	}
+	if obj.Yperiod0 != nil {
+		e.key(`yperiod0`)
+		e.any(obj.Yperiod0)
+	}
+	if obj.Yperiodalignment != "" {
+		e.key(`yperiodalignment`)
+		e.string(string(obj.Yperiodalignment))
+	}
+	if obj.Ysrc != nil {
+		e.key(`ysrc`)
+		e.any(obj.Ysrc)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BoxHoverlabelFont with precomputed fields. The output is the same as encoding/json.
+func (obj *BoxHoverlabelFont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BoxHoverlabelFont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Colorsrc != nil {
+		e.key(`colorsrc`)
+		e.any(obj.Colorsrc)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Familysrc != nil {
+		e.key(`familysrc`)
+		e.any(obj.Familysrc)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	if obj.Sizesrc != nil {
+		e.key(`sizesrc`)
+		e.any(obj.Sizesrc)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BoxHoverlabel with precomputed fields. The output is the same as encoding/json.
+func (obj *BoxHoverlabel) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BoxHoverlabel) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Align != "" {
+		e.key(`align`)
+		e.string(string(obj.Align))
+	}
+	if obj.Alignsrc != nil {
+		e.key(`alignsrc`)
+		e.any(obj.Alignsrc)
+	}
+	if obj.Bgcolor != nil {
+		e.key(`bgcolor`)
+		e.any(obj.Bgcolor)
+	}
+	if obj.Bgcolorsrc != nil {
+		e.key(`bgcolorsrc`)
+		e.any(obj.Bgcolorsrc)
+	}
+	if obj.Bordercolor != nil {
+		e.key(`bordercolor`)
+		e.any(obj.Bordercolor)
+	}
+	if obj.Bordercolorsrc != nil {
+		e.key(`bordercolorsrc`)
+		e.any(obj.Bordercolorsrc)
+	}
+	if obj.Font != nil {
+		e.key(`font`)
+		obj.Font.encodeJSON(e)
+	}
+	if obj.Namelength != 0 {
+		e.key(`namelength`)
+		e.int(int64(obj.Namelength))
+	}
+	if obj.Namelengthsrc != nil {
+		e.key(`namelengthsrc`)
+		e.any(obj.Namelengthsrc)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BoxLine with precomputed fields. The output is the same as encoding/json.
+func (obj *BoxLine) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BoxLine) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Width != 0 {
+		e.key(`width`)
+		e.float(float64(obj.Width))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BoxMarkerLine with precomputed fields. The output is the same as encoding/json.
+func (obj *BoxMarkerLine) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BoxMarkerLine) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Outliercolor != nil {
+		e.key(`outliercolor`)
+		e.any(obj.Outliercolor)
+	}
+	if obj.Outlierwidth != 0 {
+		e.key(`outlierwidth`)
+		e.float(float64(obj.Outlierwidth))
+	}
+	if obj.Width != 0 {
+		e.key(`width`)
+		e.float(float64(obj.Width))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BoxMarker with precomputed fields. The output is the same as encoding/json.
+func (obj *BoxMarker) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BoxMarker) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Line != nil {
+		e.key(`line`)
+		obj.Line.encodeJSON(e)
+	}
+	if obj.Opacity != 0 {
+		e.key(`opacity`)
+		e.float(float64(obj.Opacity))
+	}
+	if obj.Outliercolor != nil {
+		e.key(`outliercolor`)
+		e.any(obj.Outliercolor)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	if obj.Symbol != nil {
+		e.key(`symbol`)
+		e.any(obj.Symbol)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BoxSelectedMarker with precomputed fields. The output is the same as encoding/json.
+func (obj *BoxSelectedMarker) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BoxSelectedMarker) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Opacity != 0 {
+		e.key(`opacity`)
+		e.float(float64(obj.Opacity))
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BoxSelected with precomputed fields. The output is the same as encoding/json.
+func (obj *BoxSelected) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BoxSelected) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Marker != nil {
+		e.key(`marker`)
+		obj.Marker.encodeJSON(e)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BoxStream with precomputed fields. The output is the same as encoding/json.
+func (obj *BoxStream) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BoxStream) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Maxpoints != 0 {
+		e.key(`maxpoints`)
+		e.float(float64(obj.Maxpoints))
+	}
+	if obj.Token != nil {
+		e.key(`token`)
+		e.any(obj.Token)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BoxUnselectedMarker with precomputed fields. The output is the same as encoding/json.
+func (obj *BoxUnselectedMarker) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BoxUnselectedMarker) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Opacity != 0 {
+		e.key(`opacity`)
+		e.float(float64(obj.Opacity))
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes BoxUnselected with precomputed fields. The output is the same as encoding/json.
+func (obj *BoxUnselected) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *BoxUnselected) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Marker != nil {
+		e.key(`marker`)
+		obj.Marker.encodeJSON(e)
+	}
+	e.objectEnd()
+}
@@ -8,6 +8,11 @@ func (trace *Candlestick) GetType() TraceType {
 	return TraceTypeCandlestick
 }
 
+// Validate checks the trace against the constraints of the schema, see ValidateTrace
+func (trace *Candlestick) Validate() error {
+	return ValidateTrace(trace)
+}
+
 // Candlestick The candlestick is a style of financial chart describing open, high, low and close for a given `x` coordinate (most likely time). The boxes represent the spread between the `open` and `close` values and the lines represent the spread between the `low` and `high` values Sample points where the close value is higher (lower) then the open value are called increasing (decreasing). By default, increasing candles are drawn in green whereas decreasing are drawn in red.
 type Candlestick struct {
 
@@ -517,3 +522,431 @@ const (
 	CandlestickHoverinfoNone CandlestickHoverinfo = "none"
 	CandlestickHoverinfoSkip CandlestickHoverinfo = "skip"
 )
+
+// MarshalJSON encodes Candlestick with precomputed fields. The output is the same as encoding/json.
+func (obj *Candlestick) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *Candlestick) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Type != "" {
+		e.key(`type`)
+		e.string(string(obj.Type))
+	}
+	if obj.Close != nil {
+		e.key(`close`)
+		e.any(obj.Close)
+	}
+	if obj.Closesrc != nil {
+		e.key(`closesrc`)
+		e.any(obj.Closesrc)
+	}
+	if obj.Customdata != nil {
+		e.key(`customdata`)
+		e.any(obj.Customdata)
+	}
+	if obj.Customdatasrc != nil {
+		e.key(`customdatasrc`)
+		e.any(obj.Customdatasrc)
+	}
+	if obj.Decreasing != nil {
+		e.key(`decreasing`)
+		obj.Decreasing.encodeJSON(e)
+	}
+	if obj.High != nil {
+		e.key(`high`)
+		e.any(obj.High)
+	}
+	if obj.Highsrc != nil {
+		e.key(`highsrc`)
+		e.any(obj.Highsrc)
+	}
+	if obj.Hoverinfo != "" {
+		e.key(`hoverinfo`)
+		e.string(string(obj.Hoverinfo))
+	}
+	if obj.Hoverinfosrc != nil {
+		e.key(`hoverinfosrc`)
+		e.any(obj.Hoverinfosrc)
+	}
+	if obj.Hoverlabel != nil {
+		e.key(`hoverlabel`)
+		obj.Hoverlabel.encodeJSON(e)
+	}
+	if obj.Hovertext != nil {
+		e.key(`hovertext`)
+		e.any(obj.Hovertext)
+	}
+	if obj.Hovertextsrc != nil {
+		e.key(`hovertextsrc`)
+		e.any(obj.Hovertextsrc)
+	}
+	if obj.Ids != nil {
+		e.key(`ids`)
+		e.any(obj.Ids)
+	}
+	if obj.Idssrc != nil {
+		e.key(`idssrc`)
+		e.any(obj.Idssrc)
+	}
+	if obj.Increasing != nil {
+		e.key(`increasing`)
+		obj.Increasing.encodeJSON(e)
+	}
+	if obj.Legendgroup != nil {
+		e.key(`legendgroup`)
+		e.any(obj.Legendgroup)
+	}
+	if obj.Line != nil {
+		e.key(`line`)
+		obj.Line.encodeJSON(e)
+	}
+	if obj.Low != nil {
+		e.key(`low`)
+		e.any(obj.Low)
+	}
+	if obj.Lowsrc != nil {
+		e.key(`lowsrc`)
+		e.any(obj.Lowsrc)
+	}
+	if obj.Meta != nil {
+		e.key(`meta`)
+		e.any(obj.Meta)
+	}
+	if obj.Metasrc != nil {
+		e.key(`metasrc`)
+		e.any(obj.Metasrc)
+	}
+	if obj.Name != nil {
+		e.key(`name`)
+		e.any(obj.Name)
+	}
+	if obj.Opacity != 0 {
+		e.key(`opacity`)
+		e.float(float64(obj.Opacity))
+	}
+	if obj.Open != nil {
+		e.key(`open`)
+		e.any(obj.Open)
+	}
+	if obj.Opensrc != nil {
+		e.key(`opensrc`)
+		e.any(obj.Opensrc)
+	}
+	if obj.Selectedpoints != nil {
+		e.key(`selectedpoints`)
+		e.any(obj.Selectedpoints)
+	}
+	if obj.Showlegend != nil {
+		e.key(`showlegend`)
+		e.bool(*obj.Showlegend)
+	}
+	if obj.Stream != nil {
+		e.key(`stream`)
+		obj.Stream.encodeJSON(e)
+	}
+	if obj.Text != nil {
+		e.key(`text`)
+		e.any(obj.Text)
+	}
+	if obj.Textsrc != nil {
+		e.key(`textsrc`)
+		e.any(obj.Textsrc)
+	}
+	if obj.Transforms != nil {
+		e.key(`transforms`)
+		e.any(obj.Transforms)
+	}
+	if obj.Uid != nil {
+		e.key(`uid`)
+		e.any(obj.Uid)
+	}
+	if obj.Uirevision != nil {
+		e.key(`uirevision`)
+		e.any(obj.Uirevision)
+	}
+	if obj.Visible != nil {
+		e.key(`visible`)
+		e.any(obj.Visible)
+	}
+	if obj.Whiskerwidth != 0 {
+		e.key(`whiskerwidth`)
+		e.float(float64(obj.Whiskerwidth))
+	}
+	if obj.X != nil {
+		e.key(`x`)
+		e.any(obj.X)
+	}
+	if obj.Xaxis != nil {
+		e.key(`xaxis`)
+		e.any(obj.Xaxis)
+	}
+	if obj.Xcalendar != "" {
+		e.key(`xcalendar`)
+		e.string(string(obj.Xcalendar))
+	}
+	if obj.Xperiod != nil {
+		e.key(`xperiod`)
+		e.any(obj.Xperiod)
+	}
+	if obj.Xperiod0 != nil {
+		e.key(`xperiod0`)
+		e.any(obj.Xperiod0)
+	}
+	if obj.Xperiodalignment != "" {
+		e.key(`xperiodalignment`)
+		e.string(string(obj.Xperiodalignment))
+	}
+	if obj.Xsrc != nil {
+		e.key(`xsrc`)
+		e.any(obj.Xsrc)
+	}
+	if obj.Yaxis != nil {
+		e.key(`yaxis`)
+		e.any(obj.Yaxis)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes CandlestickDecreasingLine with precomputed fields. The output is the same as encoding/json.
+func (obj *CandlestickDecreasingLine) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *CandlestickDecreasingLine) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Width != 0 {
+		e.key(`width`)
+		e.float(float64(obj.Width))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes CandlestickDecreasing with precomputed fields. The output is the same as encoding/json.
+func (obj *CandlestickDecreasing) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *CandlestickDecreasing) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Fillcolor != nil {
+		e.key(`fillcolor`)
+		e.any(obj.Fillcolor)
+	}
+	if obj.Line != nil {
+		e.key(`line`)
+		obj.Line.encodeJSON(e)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes CandlestickHoverlabelFont with precomputed fields. The output is the same as encoding/json.
+func (obj *CandlestickHoverlabelFont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *CandlestickHoverlabelFont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Colorsrc != nil {
+		e.key(`colorsrc`)
+		e.any(obj.Colorsrc)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Familysrc != nil {
+		e.key(`familysrc`)
+		e.any(obj.Familysrc)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	if obj.Sizesrc != nil {
+		e.key(`sizesrc`)
+		e.any(obj.Sizesrc)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes CandlestickHoverlabel with precomputed fields. The output is the same as encoding/json.
+func (obj *CandlestickHoverlabel) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *CandlestickHoverlabel) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Align != "" {
+		e.key(`align`)
+		e.string(string(obj.Align))
+	}
+	if obj.Alignsrc != nil {
+		e.key(`alignsrc`)
+		e.any(obj.Alignsrc)
+	}
+	if obj.Bgcolor != nil {
+		e.key(`bgcolor`)
+		e.any(obj.Bgcolor)
+	}
+	if obj.Bgcolorsrc != nil {
+		e.key(`bgcolorsrc`)
+		e.any(obj.Bgcolorsrc)
+	}
+	if obj.Bordercolor != nil {
+		e.key(`bordercolor`)
+		e.any(obj.Bordercolor)
+	}
+	if obj.Bordercolorsrc != nil {
+		e.key(`bordercolorsrc`)
+		e.any(obj.Bordercolorsrc)
+	}
+	if obj.Font != nil {
+		e.key(`font`)
+		obj.Font.encodeJSON(e)
+	}
+	if obj.Namelength != 0 {
+		e.key(`namelength`)
+		e.int(int64(obj.Namelength))
+	}
+	if obj.Namelengthsrc != nil {
+		e.key(`namelengthsrc`)
+		e.any(obj.Namelengthsrc)
+	}
+	if obj.Split != nil {
+		e.key(`split`)
+		e.bool(*obj.Split)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes CandlestickIncreasingLine with precomputed fields. The output is the same as encoding/json.
+func (obj *CandlestickIncreasingLine) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *CandlestickIncreasingLine) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Width != 0 {
+		e.key(`width`)
+		e.float(float64(obj.Width))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes CandlestickIncreasing with precomputed fields. The output is the same as encoding/json.
+func (obj *CandlestickIncreasing) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *CandlestickIncreasing) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Fillcolor != nil {
+		e.key(`fillcolor`)
+		e.any(obj.Fillcolor)
+	}
+	if obj.Line != nil {
+		e.key(`line`)
+		obj.Line.encodeJSON(e)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes CandlestickLine with precomputed fields. The output is the same as encoding/json.
+func (obj *CandlestickLine) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *CandlestickLine) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Width != 0 {
+		e.key(`width`)
+		e.float(float64(obj.Width))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes CandlestickStream with precomputed fields. The output is the same as encoding/json.
+func (obj *CandlestickStream) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *CandlestickStream) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Maxpoints != 0 {
+		e.key(`maxpoints`)
+		e.float(float64(obj.Maxpoints))
+	}
+	if obj.Token != nil {
+		e.key(`token`)
+		e.any(obj.Token)
+	}
+	e.objectEnd()
+}
@@ -8,6 +8,11 @@ func (trace *Carpet) GetType() TraceType {
 	return TraceTypeCarpet
 }
 
+// Validate checks the trace against the constraints of the schema, see ValidateTrace
+func (trace *Carpet) Validate() error {
+	return ValidateTrace(trace)
+}
+
 // Carpet The data describing carpet axis layout is set in `y` and (optionally) also `x`. If only `y` is present, `x` the plot is interpreted as a cheater plot and is filled in using the `y` values. `x` and `y` may either be 2D arrays matching with each dimension matching that of `a` and `b`, or they may be 1D arrays with total length equal to that of `a` and `b`.
 type Carpet struct {
 
@@ -1250,3 +1255,835 @@ var (
 	CarpetVisibleFalse      CarpetVisible = false
 	CarpetVisibleLegendonly CarpetVisible = "legendonly"
 )
+
+// MarshalJSON encodes Carpet with precomputed fields. The output is the same as encoding/json.
+func (obj *Carpet) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *Carpet) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Type != "" {
+		e.key(`type`)
+		e.string(string(obj.Type))
+	}
+	if obj.A != nil {
+		e.key(`a`)
+		e.any(obj.A)
+	}
+	if obj.A0 != 0 {
+		e.key(`a0`)
+		e.float(float64(obj.A0))
+	}
+	if obj.Aaxis != nil {
+		e.key(`aaxis`)
+		obj.Aaxis.encodeJSON(e)
+	}
+	if obj.Asrc != nil {
+		e.key(`asrc`)
+		e.any(obj.Asrc)
+	}
+	if obj.B != nil {
+		e.key(`b`)
+		e.any(obj.B)
+	}
+	if obj.B0 != 0 {
+		e.key(`b0`)
+		e.float(float64(obj.B0))
+	}
+	if obj.Baxis != nil {
+		e.key(`baxis`)
+		obj.Baxis.encodeJSON(e)
+	}
+	if obj.Bsrc != nil {
+		e.key(`bsrc`)
+		e.any(obj.Bsrc)
+	}
+	if obj.Carpet != nil {
+		e.key(`carpet`)
+		e.any(obj.Carpet)
+	}
+	if obj.Cheaterslope != 0 {
+		e.key(`cheaterslope`)
+		e.float(float64(obj.Cheaterslope))
+	}
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Customdata != nil {
+		e.key(`customdata`)
+		e.any(obj.Customdata)
+	}
+	if obj.Customdatasrc != nil {
+		e.key(`customdatasrc`)
+		e.any(obj.Customdatasrc)
+	}
+	if obj.Da != 0 {
+		e.key(`da`)
+		e.float(float64(obj.Da))
+	}
+	if obj.Db != 0 {
+		e.key(`db`)
+		e.float(float64(obj.Db))
+	}
+	if obj.Font != nil {
+		e.key(`font`)
+		obj.Font.encodeJSON(e)
+	}
+	if obj.Ids != nil {
+		e.key(`ids`)
+		e.any(obj.Ids)
+	}
+	if obj.Idssrc != nil {
+		e.key(`idssrc`)
+		e.any(obj.Idssrc)
+	}
+	if obj.Meta != nil {
+		e.key(`meta`)
+		e.any(obj.Meta)
+	}
+	if obj.Metasrc != nil {
+		e.key(`metasrc`)
+		e.any(obj.Metasrc)
+	}
+	if obj.Name != nil {
+		e.key(`name`)
+		e.any(obj.Name)
+	}
+	if obj.Opacity != 0 {
+		e.key(`opacity`)
+		e.float(float64(obj.Opacity))
+	}
+	if obj.Stream != nil {
+		e.key(`stream`)
+		obj.Stream.encodeJSON(e)
+	}
+	if obj.Uid != nil {
+		e.key(`uid`)
+		e.any(obj.Uid)
+	}
+	if obj.Uirevision != nil {
+		e.key(`uirevision`)
+		e.any(obj.Uirevision)
+	}
+	if obj.Visible != nil {
+		e.key(`visible`)
+		e.any(obj.Visible)
+	}
+	if obj.X != nil {
+		e.key(`x`)
+		e.any(obj.X)
+	}
+	if obj.Xaxis != nil {
+		e.key(`xaxis`)
+		e.any(obj.Xaxis)
+	}
+	if obj.Xsrc != nil {
+		e.key(`xsrc`)
+		e.any(obj.Xsrc)
+	}
+	if obj.Y != nil {
+		e.key(`y`)
+		e.any(obj.Y)
+	}
+	if obj.Yaxis != nil {
+		e.key(`yaxis`)
+		e.any(obj.Yaxis)
+	}
+	if obj.Ysrc != nil {
+		e.key(`ysrc`)
+		e.any(obj.Ysrc)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes CarpetAaxisTickfont with precomputed fields. The output is the same as encoding/json.
+func (obj *CarpetAaxisTickfont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *CarpetAaxisTickfont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes CarpetAaxisTitleFont with precomputed fields. The output is the same as encoding/json.
+func (obj *CarpetAaxisTitleFont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *CarpetAaxisTitleFont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes CarpetAaxisTitle with precomputed fields. The output is the same as encoding/json.
+func (obj *CarpetAaxisTitle) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *CarpetAaxisTitle) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Font != nil {
+		e.key(`font`)
+		obj.Font.encodeJSON(e)
+	}
+	if obj.Offset != 0 {
+		e.key(`offset`)
+		e.float(float64(obj.Offset))
+	}
+	if obj.Text != nil {
+		e.key(`text`)
+		e.any(obj.Text)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes CarpetAaxis with precomputed fields. The output is the same as encoding/json.
+func (obj *CarpetAaxis) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *CarpetAaxis) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Arraydtick != 0 {
+		e.key(`arraydtick`)
+		e.int(int64(obj.Arraydtick))
+	}
+	if obj.Arraytick0 != 0 {
+		e.key(`arraytick0`)
+		e.int(int64(obj.Arraytick0))
+	}
+	if obj.Autorange != nil {
+		e.key(`autorange`)
+		e.any(obj.Autorange)
+	}
+	if obj.Autotypenumbers != "" {
+		e.key(`autotypenumbers`)
+		e.string(string(obj.Autotypenumbers))
+	}
+	if obj.Categoryarray != nil {
+		e.key(`categoryarray`)
+		e.any(obj.Categoryarray)
+	}
+	if obj.Categoryarraysrc != nil {
+		e.key(`categoryarraysrc`)
+		e.any(obj.Categoryarraysrc)
+	}
+	if obj.Categoryorder != "" {
+		e.key(`categoryorder`)
+		e.string(string(obj.Categoryorder))
+	}
+	if obj.Cheatertype != "" {
+		e.key(`cheatertype`)
+		e.string(string(obj.Cheatertype))
+	}
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Dtick != 0 {
+		e.key(`dtick`)
+		e.float(float64(obj.Dtick))
+	}
+	if obj.Endline != nil {
+		e.key(`endline`)
+		e.bool(*obj.Endline)
+	}
+	if obj.Endlinecolor != nil {
+		e.key(`endlinecolor`)
+		e.any(obj.Endlinecolor)
+	}
+	if obj.Endlinewidth != 0 {
+		e.key(`endlinewidth`)
+		e.float(float64(obj.Endlinewidth))
+	}
+	if obj.Exponentformat != "" {
+		e.key(`exponentformat`)
+		e.string(string(obj.Exponentformat))
+	}
+	if obj.Fixedrange != nil {
+		e.key(`fixedrange`)
+		e.bool(*obj.Fixedrange)
+	}
+	if obj.Gridcolor != nil {
+		e.key(`gridcolor`)
+		e.any(obj.Gridcolor)
+	}
+	if obj.Gridwidth != 0 {
+		e.key(`gridwidth`)
+		e.float(float64(obj.Gridwidth))
+	}
+	if obj.Labelpadding != 0 {
+		e.key(`labelpadding`)
+		e.int(int64(obj.Labelpadding))
+	}
+	if obj.Labelprefix != nil {
+		e.key(`labelprefix`)
+		e.any(obj.Labelprefix)
+	}
+	if obj.Labelsuffix != nil {
+		e.key(`labelsuffix`)
+		e.any(obj.Labelsuffix)
+	}
+	if obj.Linecolor != nil {
+		e.key(`linecolor`)
+		e.any(obj.Linecolor)
+	}
+	if obj.Linewidth != 0 {
+		e.key(`linewidth`)
+		e.float(float64(obj.Linewidth))
+	}
+	if obj.Minexponent != 0 {
+		e.key(`minexponent`)
+		e.float(float64(obj.Minexponent))
+	}
+	if obj.Minorgridcolor != nil {
+		e.key(`minorgridcolor`)
+		e.any(obj.Minorgridcolor)
+	}
+	if obj.Minorgridcount != 0 {
+		e.key(`minorgridcount`)
+		e.int(int64(obj.Minorgridcount))
+	}
+	if obj.Minorgridwidth != 0 {
+		e.key(`minorgridwidth`)
+		e.float(float64(obj.Minorgridwidth))
+	}
+	if obj.Nticks != 0 {
+		e.key(`nticks`)
+		e.int(int64(obj.Nticks))
+	}
+	if obj.Range != nil {
+		e.key(`range`)
+		e.any(obj.Range)
+	}
+	if obj.Rangemode != "" {
+		e.key(`rangemode`)
+		e.string(string(obj.Rangemode))
+	}
+	if obj.Separatethousands != nil {
+		e.key(`separatethousands`)
+		e.bool(*obj.Separatethousands)
+	}
+	if obj.Showexponent != "" {
+		e.key(`showexponent`)
+		e.string(string(obj.Showexponent))
+	}
+	if obj.Showgrid != nil {
+		e.key(`showgrid`)
+		e.bool(*obj.Showgrid)
+	}
+	if obj.Showline != nil {
+		e.key(`showline`)
+		e.bool(*obj.Showline)
+	}
+	if obj.Showticklabels != "" {
+		e.key(`showticklabels`)
+		e.string(string(obj.Showticklabels))
+	}
+	if obj.Showtickprefix != "" {
+		e.key(`showtickprefix`)
+		e.string(string(obj.Showtickprefix))
+	}
+	if obj.Showticksuffix != "" {
+		e.key(`showticksuffix`)
+		e.string(string(obj.Showticksuffix))
+	}
+	if obj.Smoothing != 0 {
+		e.key(`smoothing`)
+		e.float(float64(obj.Smoothing))
+	}
+	if obj.Startline != nil {
+		e.key(`startline`)
+		e.bool(*obj.Startline)
+	}
+	if obj.Startlinecolor != nil {
+		e.key(`startlinecolor`)
+		e.any(obj.Startlinecolor)
+	}
+	if obj.Startlinewidth != 0 {
+		e.key(`startlinewidth`)
+		e.float(float64(obj.Startlinewidth))
+	}
+	if obj.Tick0 != 0 {
+		e.key(`tick0`)
+		e.float(float64(obj.Tick0))
+	}
+	if obj.Tickangle != 0 {
+		e.key(`tickangle`)
+		e.float(float64(obj.Tickangle))
+	}
+	if obj.Tickfont != nil {
+		e.key(`tickfont`)
+		obj.Tickfont.encodeJSON(e)
+	}
+	if obj.Tickformat != nil {
+		e.key(`tickformat`)
+		e.any(obj.Tickformat)
+	}
+	if obj.Tickformatstops != nil {
+		e.key(`tickformatstops`)
+		e.any(obj.Tickformatstops)
+	}
+	if obj.Tickmode != "" {
+		e.key(`tickmode`)
+		e.string(string(obj.Tickmode))
+	}
+	if obj.Tickprefix != nil {
+		e.key(`tickprefix`)
+		e.any(obj.Tickprefix)
+	}
+	if obj.Ticksuffix != nil {
+		e.key(`ticksuffix`)
+		e.any(obj.Ticksuffix)
+	}
+	if obj.Ticktext != nil {
+		e.key(`ticktext`)
+		e.any(obj.Ticktext)
+	}
+	if obj.Ticktextsrc != nil {
+		e.key(`ticktextsrc`)
+		e.any(obj.Ticktextsrc)
+	}
+	if obj.Tickvals != nil {
+		e.key(`tickvals`)
+		e.any(obj.Tickvals)
+	}
+	if obj.Tickvalssrc != nil {
+		e.key(`tickvalssrc`)
+		e.any(obj.Tickvalssrc)
+	}
+	if obj.Title != nil {
+		e.key(`title`)
+		obj.Title.encodeJSON(e)
+	}
+	if obj.Type != "" {
+		e.key(`type`)
+		e.string(string(obj.Type))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes CarpetBaxisTickfont with precomputed fields. The output is the same as encoding/json.
+func (obj *CarpetBaxisTickfont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *CarpetBaxisTickfont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes CarpetBaxisTitleFont with precomputed fields. The output is the same as encoding/json.
+func (obj *CarpetBaxisTitleFont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *CarpetBaxisTitleFont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes CarpetBaxisTitle with precomputed fields. The output is the same as encoding/json.
+func (obj *CarpetBaxisTitle) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *CarpetBaxisTitle) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Font != nil {
+		e.key(`font`)
+		obj.Font.encodeJSON(e)
+	}
+	if obj.Offset != 0 {
+		e.key(`offset`)
+		e.float(float64(obj.Offset))
+	}
+	if obj.Text != nil {
+		e.key(`text`)
+		e.any(obj.Text)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes CarpetBaxis with precomputed fields. The output is the same as encoding/json.
+func (obj *CarpetBaxis) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *CarpetBaxis) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Arraydtick != 0 {
+		e.key(`arraydtick`)
+		e.int(int64(obj.Arraydtick))
+	}
+	if obj.Arraytick0 != 0 {
+		e.key(`arraytick0`)
+		e.int(int64(obj.Arraytick0))
+	}
+	if obj.Autorange != nil {
+		e.key(`autorange`)
+		e.any(obj.Autorange)
+	}
+	if obj.Autotypenumbers != "" {
+		e.key(`autotypenumbers`)
+		e.string(string(obj.Autotypenumbers))
+	}
+	if obj.Categoryarray != nil {
+		e.key(`categoryarray`)
+		e.any(obj.Categoryarray)
+	}
+	if obj.Categoryarraysrc != nil {
+		e.key(`categoryarraysrc`)
+		e.any(obj.Categoryarraysrc)
+	}
+	if obj.Categoryorder != "" {
+		e.key(`categoryorder`)
+		e.string(string(obj.Categoryorder))
+	}
+	if obj.Cheatertype != "" {
+		e.key(`cheatertype`)
+		e.string(string(obj.Cheatertype))
+	}
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Dtick != 0 {
+		e.key(`dtick`)
+		e.float(float64(obj.Dtick))
+	}
+	if obj.Endline != nil {
+		e.key(`endline`)
+		e.bool(*obj.Endline)
+	}
+	if obj.Endlinecolor != nil {
+		e.key(`endlinecolor`)
+		e.any(obj.Endlinecolor)
+	}
+	if obj.Endlinewidth != 0 {
+		e.key(`endlinewidth`)
+		e.float(float64(obj.Endlinewidth))
+	}
+	if obj.Exponentformat != "" {
+		e.key(`exponentformat`)
+		e.string(string(obj.Exponentformat))
+	}
+	if obj.Fixedrange != nil {
+		e.key(`fixedrange`)
+		e.bool(*obj.Fixedrange)
+	}
+	if obj.Gridcolor != nil {
+		e.key(`gridcolor`)
+		e.any(obj.Gridcolor)
+	}
+	if obj.Gridwidth != 0 {
+		e.key(`gridwidth`)
+		e.float(float64(obj.Gridwidth))
+	}
+	if obj.Labelpadding != 0 {
+		e.key(`labelpadding`)
+		e.int(int64(obj.Labelpadding))
+	}
+	if obj.Labelprefix != nil {
+		e.key(`labelprefix`)
+		e.any(obj.Labelprefix)
+	}
+	if obj.Labelsuffix != nil {
+		e.key(`labelsuffix`)
+		e.any(obj.Labelsuffix)
+	}
+	if obj.Linecolor != nil {
+		e.key(`linecolor`)
+		e.any(obj.Linecolor)
+	}
+	if obj.Linewidth != 0 {
+		e.key(`linewidth`)
+		e.float(float64(obj.Linewidth))
+	}
+	if obj.Minexponent != 0 {
+		e.key(`minexponent`)
+		e.float(float64(obj.Minexponent))
+	}
+	if obj.Minorgridcolor != nil {
+		e.key(`minorgridcolor`)
+		e.any(obj.Minorgridcolor)
+	}
+	if obj.Minorgridcount != 0 {
+		e.key(`minorgridcount`)
+		e.int(int64(obj.Minorgridcount))
+	}
+	if obj.Minorgridwidth != 0 {
+		e.key(`minorgridwidth`)
+		e.float(float64(obj.Minorgridwidth))
+	}
+	if obj.Nticks != 0 {
+		e.key(`nticks`)
+		e.int(int64(obj.Nticks))
+	}
+	if obj.Range != nil {
+		e.key(`range`)
+		e.any(obj.Range)
+	}
+	if obj.Rangemode != "" {
+		e.key(`rangemode`)
+		e.string(string(obj.Rangemode))
+	}
+	if obj.Separatethousands != nil {
+		e.key(`separatethousands`)
+		e.bool(*obj.Separatethousands)
+	}
+	if obj.Showexponent != "" {
+		e.key(`showexponent`)
+		e.string(string(obj.Showexponent))
+	}
+	if obj.Showgrid != nil {
+		e.key(`showgrid`)
+		e.bool(*obj.Showgrid)
+	}
+	if obj.Showline != nil {
+		e.key(`showline`)
+		e.bool(*obj.Showline)
+	}
+	if obj.Showticklabels != "" {
+		e.key(`showticklabels`)
+		e.string(string(obj.Showticklabels))
+	}
+	if obj.Showtickprefix != "" {
+		e.key(`showtickprefix`)
+		e.string(string(obj.Showtickprefix))
+	}
+	if obj.Showticksuffix != "" {
+		e.key(`showticksuffix`)
+		e.string(string(obj.Showticksuffix))
+	}
+	if obj.Smoothing != 0 {
+		e.key(`smoothing`)
+		e.float(float64(obj.Smoothing))
+	}
+	if obj.Startline != nil {
+		e.key(`startline`)
+		e.bool(*obj.Startline)
+	}
+	if obj.Startlinecolor != nil {
+		e.key(`startlinecolor`)
+		e.any(obj.Startlinecolor)
+	}
+	if obj.Startlinewidth != 0 {
+		e.key(`startlinewidth`)
+		e.float(float64(obj.Startlinewidth))
+	}
+	if obj.Tick0 != 0 {
+		e.key(`tick0`)
+		e.float(float64(obj.Tick0))
+	}
+	if obj.Tickangle != 0 {
+		e.key(`tickangle`)
+		e.float(float64(obj.Tickangle))
+	}
+	if obj.Tickfont != nil {
+		e.key(`tickfont`)
+		obj.Tickfont.encodeJSON(e)
+	}
+	if obj.Tickformat != nil {
+		e.key(`tickformat`)
+		e.any(obj.Tickformat)
+	}
+	if obj.Tickformatstops != nil {
+		e.key(`tickformatstops`)
+		e.any(obj.Tickformatstops)
+	}
+	if obj.Tickmode != "" {
+		e.key(`tickmode`)
+		e.string(string(obj.Tickmode))
+	}
+	if obj.Tickprefix != nil {
+		e.key(`tickprefix`)
+		e.any(obj.Tickprefix)
+	}
+	if obj.Ticksuffix != nil {
+		e.key(`ticksuffix`)
+		e.any(obj.Ticksuffix)
+	}
+	if obj.Ticktext != nil {
+		e.key(`ticktext`)
+		e.any(obj.Ticktext)
+	}
+	if obj.Ticktextsrc != nil {
+		e.key(`ticktextsrc`)
+		e.any(obj.Ticktextsrc)
+	}
+	if obj.Tickvals != nil {
+		e.key(`tickvals`)
+		e.any(obj.Tickvals)
+	}
+	if obj.Tickvalssrc != nil {
+		e.key(`tickvalssrc`)
+		e.any(obj.Tickvalssrc)
+	}
+	if obj.Title != nil {
+		e.key(`title`)
+		obj.Title.encodeJSON(e)
+	}
+	if obj.Type != "" {
+		e.key(`type`)
+		e.string(string(obj.Type))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes CarpetFont with precomputed fields. The output is the same as encoding/json.
+func (obj *CarpetFont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *CarpetFont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes CarpetStream with precomputed fields. The output is the same as encoding/json.
+func (obj *CarpetStream) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *CarpetStream) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Maxpoints != 0 {
+		e.key(`maxpoints`)
+		e.float(float64(obj.Maxpoints))
+	}
+	if obj.Token != nil {
+		e.key(`token`)
+		e.any(obj.Token)
+	}
+	e.objectEnd()
+}
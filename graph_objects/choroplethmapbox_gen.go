@@ -8,6 +8,11 @@ func (trace *Choroplethmapbox) GetType() TraceType {
 	return TraceTypeChoroplethmapbox
 }
 
+// Validate checks the trace against the constraints of the schema, see ValidateTrace
+func (trace *Choroplethmapbox) Validate() error {
+	return ValidateTrace(trace)
+}
+
 // Choroplethmapbox GeoJSON features to be filled are set in `geojson` The data that describes the choropleth value-to-color mapping is set in `locations` and `z`.
 type Choroplethmapbox struct {
 
@@ -871,9 +876,21 @@ type ChoroplethmapboxColorbarTicks string
 const (
 	ChoroplethmapboxColorbarTicksOutside ChoroplethmapboxColorbarTicks = "outside"
 	ChoroplethmapboxColorbarTicksInside  ChoroplethmapboxColorbarTicks = "inside"
-	ChoroplethmapboxColorbarTicksEmpty   ChoroplethmapboxColorbarTicks = ""
+	ChoroplethmapboxColorbarTicksEmpty   ChoroplethmapboxColorbarTicks = emptyEnum
 )
 
+// MarshalJSON writes the empty value as "", the zero value is unset and omitted
+func (v ChoroplethmapboxColorbarTicks) MarshalJSON() ([]byte, error) {
+	return marshalEnum(string(v))
+}
+
+// UnmarshalJSON reads "" as the empty value
+func (v *ChoroplethmapboxColorbarTicks) UnmarshalJSON(data []byte) error {
+	s, err := unmarshalEnum(data)
+	*v = ChoroplethmapboxColorbarTicks(s)
+	return err
+}
+
 // ChoroplethmapboxColorbarTitleSide Determines the location of color bar's title with respect to the color bar. Note that the title's location used to be set by the now deprecated `titleside` attribute.
 type ChoroplethmapboxColorbarTitleSide string
 
@@ -934,3 +951,727 @@ const (
 	ChoroplethmapboxHoverinfoNone ChoroplethmapboxHoverinfo = "none"
 	ChoroplethmapboxHoverinfoSkip ChoroplethmapboxHoverinfo = "skip"
 )
+
+// MarshalJSON encodes Choroplethmapbox with precomputed fields. The output is the same as encoding/json.
+func (obj *Choroplethmapbox) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *Choroplethmapbox) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Type != "" {
+		e.key(`type`)
+		e.string(string(obj.Type))
+	}
+	if obj.Autocolorscale != nil {
+		e.key(`autocolorscale`)
+		e.bool(*obj.Autocolorscale)
+	}
+	if obj.Below != nil {
+		e.key(`below`)
+		e.any(obj.Below)
+	}
+	if obj.Coloraxis != nil {
+		e.key(`coloraxis`)
+		e.any(obj.Coloraxis)
+	}
+	if obj.Colorbar != nil {
+		e.key(`colorbar`)
+		obj.Colorbar.encodeJSON(e)
+	}
+	if obj.Colorscale != nil {
+		e.key(`colorscale`)
+		e.any(obj.Colorscale)
+	}
+	if obj.Customdata != nil {
+		e.key(`customdata`)
+		e.any(obj.Customdata)
+	}
+	if obj.Customdatasrc != nil {
+		e.key(`customdatasrc`)
+		e.any(obj.Customdatasrc)
+	}
+	if obj.Featureidkey != nil {
+		e.key(`featureidkey`)
+		e.any(obj.Featureidkey)
+	}
+	if obj.Geojson != nil {
+		e.key(`geojson`)
+		e.any(obj.Geojson)
+	}
+	if obj.Hoverinfo != "" {
+		e.key(`hoverinfo`)
+		e.string(string(obj.Hoverinfo))
+	}
+	if obj.Hoverinfosrc != nil {
+		e.key(`hoverinfosrc`)
+		e.any(obj.Hoverinfosrc)
+	}
+	if obj.Hoverlabel != nil {
+		e.key(`hoverlabel`)
+		obj.Hoverlabel.encodeJSON(e)
+	}
+	if obj.Hovertemplate != nil {
+		e.key(`hovertemplate`)
+		e.any(obj.Hovertemplate)
+	}
+	if obj.Hovertemplatesrc != nil {
+		e.key(`hovertemplatesrc`)
+		e.any(obj.Hovertemplatesrc)
+	}
+	if obj.Hovertext != nil {
+		e.key(`hovertext`)
+		e.any(obj.Hovertext)
+	}
+	if obj.Hovertextsrc != nil {
+		e.key(`hovertextsrc`)
+		e.any(obj.Hovertextsrc)
+	}
+	if obj.Ids != nil {
+		e.key(`ids`)
+		e.any(obj.Ids)
+	}
+	if obj.Idssrc != nil {
+		e.key(`idssrc`)
+		e.any(obj.Idssrc)
+	}
+	if obj.Legendgroup != nil {
+		e.key(`legendgroup`)
+		e.any(obj.Legendgroup)
+	}
+	if obj.Locations != nil {
+		e.key(`locations`)
+		e.any(obj.Locations)
+	}
+	if obj.Locationssrc != nil {
+		e.key(`locationssrc`)
+		e.any(obj.Locationssrc)
+	}
+	if obj.Marker != nil {
+		e.key(`marker`)
+		obj.Marker.encodeJSON(e)
+	}
+	if obj.Meta != nil {
+		e.key(`meta`)
+		e.any(obj.Meta)
+	}
+	if obj.Metasrc != nil {
+		e.key(`metasrc`)
+		e.any(obj.Metasrc)
+	}
+	if obj.Name != nil {
+		e.key(`name`)
+		e.any(obj.Name)
+	}
+	if obj.Reversescale != nil {
+		e.key(`reversescale`)
+		e.bool(*obj.Reversescale)
+	}
+	if obj.Selected != nil {
+		e.key(`selected`)
+		obj.Selected.encodeJSON(e)
+	}
+	if obj.Selectedpoints != nil {
+		e.key(`selectedpoints`)
+		e.any(obj.Selectedpoints)
+	}
+	if obj.Showlegend != nil {
+		e.key(`showlegend`)
+		e.bool(*obj.Showlegend)
+	}
+	if obj.Showscale != nil {
+		e.key(`showscale`)
+		e.bool(*obj.Showscale)
+	}
+	if obj.Stream != nil {
+		e.key(`stream`)
+		obj.Stream.encodeJSON(e)
+	}
+	if obj.Subplot != nil {
+		e.key(`subplot`)
+		e.any(obj.Subplot)
+	}
+	if obj.Text != nil {
+		e.key(`text`)
+		e.any(obj.Text)
+	}
+	if obj.Textsrc != nil {
+		e.key(`textsrc`)
+		e.any(obj.Textsrc)
+	}
+	if obj.Transforms != nil {
+		e.key(`transforms`)
+		e.any(obj.Transforms)
+	}
+	if obj.Uid != nil {
+		e.key(`uid`)
+		e.any(obj.Uid)
+	}
+	if obj.Uirevision != nil {
+		e.key(`uirevision`)
+		e.any(obj.Uirevision)
+	}
+	if obj.Unselected != nil {
+		e.key(`unselected`)
+		obj.Unselected.encodeJSON(e)
+	}
+	if obj.Visible != nil {
+		e.key(`visible`)
+		e.any(obj.Visible)
+	}
+	if obj.Z != nil {
+		e.key(`z`)
+		e.any(obj.Z)
+	}
+	if obj.Zauto != nil {
+		e.key(`zauto`)
+		e.bool(*obj.Zauto)
+	}
+	if obj.Zmax != 0 {
+		e.key(`zmax`)
+		e.float(float64(obj.Zmax))
+	}
+	if obj.Zmid != 0 {
+		e.key(`zmid`)
+		e.float(float64(obj.Zmid))
+	}
+	if obj.Zmin != 0 {
+		e.key(`zmin`)
+		e.float(float64(obj.Zmin))
+	}
+	if obj.Zsrc != nil {
+		e.key(`zsrc`)
+		e.any(obj.Zsrc)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes ChoroplethmapboxColorbarTickfont with precomputed fields. The output is the same as encoding/json.
+func (obj *ChoroplethmapboxColorbarTickfont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *ChoroplethmapboxColorbarTickfont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes ChoroplethmapboxColorbarTitleFont with precomputed fields. The output is the same as encoding/json.
+func (obj *ChoroplethmapboxColorbarTitleFont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *ChoroplethmapboxColorbarTitleFont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes ChoroplethmapboxColorbarTitle with precomputed fields. The output is the same as encoding/json.
+func (obj *ChoroplethmapboxColorbarTitle) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *ChoroplethmapboxColorbarTitle) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Font != nil {
+		e.key(`font`)
+		obj.Font.encodeJSON(e)
+	}
+	if obj.Side != "" {
+		e.key(`side`)
+		e.string(string(obj.Side))
+	}
+	if obj.Text != nil {
+		e.key(`text`)
+		e.any(obj.Text)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes ChoroplethmapboxColorbar with precomputed fields. The output is the same as encoding/json.
+func (obj *ChoroplethmapboxColorbar) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *ChoroplethmapboxColorbar) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Bgcolor != nil {
+		e.key(`bgcolor`)
+		e.any(obj.Bgcolor)
+	}
+	if obj.Bordercolor != nil {
+		e.key(`bordercolor`)
+		e.any(obj.Bordercolor)
+	}
+	if obj.Borderwidth != 0 {
+		e.key(`borderwidth`)
+		e.float(float64(obj.Borderwidth))
+	}
+	if obj.Dtick != nil {
+		e.key(`dtick`)
+		e.any(obj.Dtick)
+	}
+	if obj.Exponentformat != "" {
+		e.key(`exponentformat`)
+		e.string(string(obj.Exponentformat))
+	}
+	if obj.Len != 0 {
+		e.key(`len`)
+		e.float(float64(obj.Len))
+	}
+	if obj.Lenmode != "" {
+		e.key(`lenmode`)
+		e.string(string(obj.Lenmode))
+	}
+	if obj.Minexponent != 0 {
+		e.key(`minexponent`)
+		e.float(float64(obj.Minexponent))
+	}
+	if obj.Nticks != 0 {
+		e.key(`nticks`)
+		e.int(int64(obj.Nticks))
+	}
+	if obj.Outlinecolor != nil {
+		e.key(`outlinecolor`)
+		e.any(obj.Outlinecolor)
+	}
+	if obj.Outlinewidth != 0 {
+		e.key(`outlinewidth`)
+		e.float(float64(obj.Outlinewidth))
+	}
+	if obj.Separatethousands != nil {
+		e.key(`separatethousands`)
+		e.bool(*obj.Separatethousands)
+	}
+	if obj.Showexponent != "" {
+		e.key(`showexponent`)
+		e.string(string(obj.Showexponent))
+	}
+	if obj.Showticklabels != nil {
+		e.key(`showticklabels`)
+		e.bool(*obj.Showticklabels)
+	}
+	if obj.Showtickprefix != "" {
+		e.key(`showtickprefix`)
+		e.string(string(obj.Showtickprefix))
+	}
+	if obj.Showticksuffix != "" {
+		e.key(`showticksuffix`)
+		e.string(string(obj.Showticksuffix))
+	}
+	if obj.Thickness != 0 {
+		e.key(`thickness`)
+		e.float(float64(obj.Thickness))
+	}
+	if obj.Thicknessmode != "" {
+		e.key(`thicknessmode`)
+		e.string(string(obj.Thicknessmode))
+	}
+	if obj.Tick0 != nil {
+		e.key(`tick0`)
+		e.any(obj.Tick0)
+	}
+	if obj.Tickangle != 0 {
+		e.key(`tickangle`)
+		e.float(float64(obj.Tickangle))
+	}
+	if obj.Tickcolor != nil {
+		e.key(`tickcolor`)
+		e.any(obj.Tickcolor)
+	}
+	if obj.Tickfont != nil {
+		e.key(`tickfont`)
+		obj.Tickfont.encodeJSON(e)
+	}
+	if obj.Tickformat != nil {
+		e.key(`tickformat`)
+		e.any(obj.Tickformat)
+	}
+	if obj.Tickformatstops != nil {
+		e.key(`tickformatstops`)
+		e.any(obj.Tickformatstops)
+	}
+	if obj.Ticklabelposition != "" {
+		e.key(`ticklabelposition`)
+		e.string(string(obj.Ticklabelposition))
+	}
+	if obj.Ticklen != 0 {
+		e.key(`ticklen`)
+		e.float(float64(obj.Ticklen))
+	}
+	if obj.Tickmode != "" {
+		e.key(`tickmode`)
+		e.string(string(obj.Tickmode))
+	}
+	if obj.Tickprefix != nil {
+		e.key(`tickprefix`)
+		e.any(obj.Tickprefix)
+	}
+	if obj.Ticks != "" {
+		e.key(`ticks`)
+		e.enum(string(obj.Ticks))
+	}
+	if obj.Ticksuffix != nil {
+		e.key(`ticksuffix`)
+		e.any(obj.Ticksuffix)
+	}
+	if obj.Ticktext != nil {
+		e.key(`ticktext`)
+		e.any(obj.Ticktext)
+	}
+	if obj.Ticktextsrc != nil {
+		e.key(`ticktextsrc`)
+		e.any(obj.Ticktextsrc)
+	}
+	if obj.Tickvals != nil {
+		e.key(`tickvals`)
+		e.any(obj.Tickvals)
+	}
+	if obj.Tickvalssrc != nil {
+		e.key(`tickvalssrc`)
+		e.any(obj.Tickvalssrc)
+	}
+	if obj.Tickwidth != 0 {
+		e.key(`tickwidth`)
+		e.float(float64(obj.Tickwidth))
+	}
+	if obj.Title != nil {
+		e.key(`title`)
+		obj.Title.encodeJSON(e)
+	}
+	if obj.X != 0 {
+		e.key(`x`)
+		e.float(float64(obj.X))
+	}
+	if obj.Xanchor != "" {
+		e.key(`xanchor`)
+		e.string(string(obj.Xanchor))
+	}
+	if obj.Xpad != 0 {
+		e.key(`xpad`)
+		e.float(float64(obj.Xpad))
+	}
+	if obj.Y != 0 {
+		e.key(`y`)
+		e.float(float64(obj.Y))
+	}
+	if obj.Yanchor != "" {
+		e.key(`yanchor`)
+		e.string(string(obj.Yanchor))
+	}
+	if obj.Ypad != 0 {
+		e.key(`ypad`)
+		e.float(float64(obj.Ypad))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes ChoroplethmapboxHoverlabelFont with precomputed fields. The output is the same as encoding/json.
+func (obj *ChoroplethmapboxHoverlabelFont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *ChoroplethmapboxHoverlabelFont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Colorsrc != nil {
+		e.key(`colorsrc`)
+		e.any(obj.Colorsrc)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Familysrc != nil {
+		e.key(`familysrc`)
+		e.any(obj.Familysrc)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	if obj.Sizesrc != nil {
+		e.key(`sizesrc`)
+		e.any(obj.Sizesrc)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes ChoroplethmapboxHoverlabel with precomputed fields. The output is the same as encoding/json.
+func (obj *ChoroplethmapboxHoverlabel) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *ChoroplethmapboxHoverlabel) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Align != "" {
+		e.key(`align`)
+		e.string(string(obj.Align))
+	}
+	if obj.Alignsrc != nil {
+		e.key(`alignsrc`)
+		e.any(obj.Alignsrc)
+	}
+	if obj.Bgcolor != nil {
+		e.key(`bgcolor`)
+		e.any(obj.Bgcolor)
+	}
+	if obj.Bgcolorsrc != nil {
+		e.key(`bgcolorsrc`)
+		e.any(obj.Bgcolorsrc)
+	}
+	if obj.Bordercolor != nil {
+		e.key(`bordercolor`)
+		e.any(obj.Bordercolor)
+	}
+	if obj.Bordercolorsrc != nil {
+		e.key(`bordercolorsrc`)
+		e.any(obj.Bordercolorsrc)
+	}
+	if obj.Font != nil {
+		e.key(`font`)
+		obj.Font.encodeJSON(e)
+	}
+	if obj.Namelength != 0 {
+		e.key(`namelength`)
+		e.int(int64(obj.Namelength))
+	}
+	if obj.Namelengthsrc != nil {
+		e.key(`namelengthsrc`)
+		e.any(obj.Namelengthsrc)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes ChoroplethmapboxMarkerLine with precomputed fields. The output is the same as encoding/json.
+func (obj *ChoroplethmapboxMarkerLine) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *ChoroplethmapboxMarkerLine) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Colorsrc != nil {
+		e.key(`colorsrc`)
+		e.any(obj.Colorsrc)
+	}
+	if obj.Width != 0 {
+		e.key(`width`)
+		e.float(float64(obj.Width))
+	}
+	if obj.Widthsrc != nil {
+		e.key(`widthsrc`)
+		e.any(obj.Widthsrc)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes ChoroplethmapboxMarker with precomputed fields. The output is the same as encoding/json.
+func (obj *ChoroplethmapboxMarker) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *ChoroplethmapboxMarker) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Line != nil {
+		e.key(`line`)
+		obj.Line.encodeJSON(e)
+	}
+	if obj.Opacity != 0 {
+		e.key(`opacity`)
+		e.float(float64(obj.Opacity))
+	}
+	if obj.Opacitysrc != nil {
+		e.key(`opacitysrc`)
+		e.any(obj.Opacitysrc)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes ChoroplethmapboxSelectedMarker with precomputed fields. The output is the same as encoding/json.
+func (obj *ChoroplethmapboxSelectedMarker) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *ChoroplethmapboxSelectedMarker) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Opacity != 0 {
+		e.key(`opacity`)
+		e.float(float64(obj.Opacity))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes ChoroplethmapboxSelected with precomputed fields. The output is the same as encoding/json.
+func (obj *ChoroplethmapboxSelected) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *ChoroplethmapboxSelected) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Marker != nil {
+		e.key(`marker`)
+		obj.Marker.encodeJSON(e)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes ChoroplethmapboxStream with precomputed fields. The output is the same as encoding/json.
+func (obj *ChoroplethmapboxStream) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *ChoroplethmapboxStream) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Maxpoints != 0 {
+		e.key(`maxpoints`)
+		e.float(float64(obj.Maxpoints))
+	}
+	if obj.Token != nil {
+		e.key(`token`)
+		e.any(obj.Token)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes ChoroplethmapboxUnselectedMarker with precomputed fields. The output is the same as encoding/json.
+func (obj *ChoroplethmapboxUnselectedMarker) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *ChoroplethmapboxUnselectedMarker) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Opacity != 0 {
+		e.key(`opacity`)
+		e.float(float64(obj.Opacity))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes ChoroplethmapboxUnselected with precomputed fields. The output is the same as encoding/json.
+func (obj *ChoroplethmapboxUnselected) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *ChoroplethmapboxUnselected) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Marker != nil {
+		e.key(`marker`)
+		obj.Marker.encodeJSON(e)
+	}
+	e.objectEnd()
+}
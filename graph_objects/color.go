@@ -0,0 +1,327 @@
+package grob
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// RGBA is a color parsed by ParseColor. A is the opacity between 0 and 1
+type RGBA struct {
+	R, G, B uint8
+	A       float64
+}
+
+// String returns the color in the rgba format, accepted by every color attribute
+func (c RGBA) String() string {
+	return fmt.Sprintf("rgba(%d, %d, %d, %s)", c.R, c.G, c.B, strconv.FormatFloat(c.A, 'g', -1, 64))
+}
+
+// ParseColor parses a color in any of the formats accepted by plotly.js: hex (#d3d3d3 or #ddd, with optional alpha),
+// rgb, rgba, hsl, hsla, hsv, hsva and the CSS named colors, such as lightblue or transparent.
+// It fails on typos such as ligthblue, that plotly.js would render as black.
+func ParseColor(color string) (RGBA, error) {
+	s := strings.ToLower(strings.TrimSpace(color))
+	if s == "transparent" {
+		return RGBA{}, nil
+	}
+	if value, ok := namedColors[s]; ok {
+		return RGBA{R: uint8(value >> 16), G: uint8(value >> 8), B: uint8(value), A: 1}, nil
+	}
+	if c, ok := parseHex(s); ok {
+		return c, nil
+	}
+
+	open := strings.Index(s, "(")
+	if open < 0 || !strings.HasSuffix(s, ")") {
+		return RGBA{}, fmt.Errorf("invalid color %q", color)
+	}
+	function := strings.TrimSpace(s[:open])
+	args := strings.FieldsFunc(s[open+1:len(s)-1], func(r rune) bool { return r == ',' || r == ' ' || r == '/' })
+
+	alpha := len(function) == 4 && strings.HasSuffix(function, "a")
+	expected := 3
+	if alpha {
+		expected = 4
+	}
+	if len(args) != expected {
+		return RGBA{}, fmt.Errorf("invalid color %q, %s needs %d values", color, function, expected)
+	}
+	c := RGBA{A: 1}
+	if alpha {
+		a, err := parseComponent(args[3], 1)
+		if err != nil {
+			return RGBA{}, fmt.Errorf("invalid alpha of color %q, %w", color, err)
+		}
+		c.A = a
+	}
+
+	switch strings.TrimSuffix(function, "a") {
+	case "rgb":
+		var rgb [3]float64
+		for i := range rgb {
+			value, err := parseComponent(args[i], 255)
+			if err != nil {
+				return RGBA{}, fmt.Errorf("invalid color %q, %w", color, err)
+			}
+			rgb[i] = value / 255
+		}
+		c.R, c.G, c.B = channel(rgb[0]), channel(rgb[1]), channel(rgb[2])
+	case "hsl", "hsv":
+		h, err := strconv.ParseFloat(strings.TrimSuffix(args[0], "deg"), 64)
+		if err != nil {
+			return RGBA{}, fmt.Errorf("invalid hue of color %q, %w", color, err)
+		}
+		var sv [2]float64
+		for i := range sv {
+			value, err := parseComponent(args[i+1], 100)
+			if err != nil {
+				return RGBA{}, fmt.Errorf("invalid color %q, %w", color, err)
+			}
+			sv[i] = value / 100
+		}
+		if strings.HasPrefix(function, "hsl") {
+			c.R, c.G, c.B = hslToRGB(h, sv[0], sv[1])
+		} else {
+			c.R, c.G, c.B = hsvToRGB(h, sv[0], sv[1])
+		}
+	default:
+		return RGBA{}, fmt.Errorf("invalid color %q, unknown function %s", color, function)
+	}
+	return c, nil
+}
+
+// parseHex parses #rgb, #rgba, #rrggbb and #rrggbbaa, the # is optional like in plotly.js
+func parseHex(s string) (RGBA, bool) {
+	s = strings.TrimPrefix(s, "#")
+	if len(s) == 3 || len(s) == 4 {
+		expanded := make([]byte, 0, 2*len(s))
+		for i := range s {
+			expanded = append(expanded, s[i], s[i])
+		}
+		s = string(expanded)
+	}
+	if len(s) != 6 && len(s) != 8 {
+		return RGBA{}, false
+	}
+	value, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		return RGBA{}, false
+	}
+	c := RGBA{A: 1}
+	if len(s) == 8 {
+		c.A = float64(value&0xff) / 255
+		value >>= 8
+	}
+	c.R, c.G, c.B = uint8(value>>16), uint8(value>>8), uint8(value)
+	return c, true
+}
+
+// parseComponent parses a number or a percentage of max and checks it is between 0 and max
+func parseComponent(s string, max float64) (float64, error) {
+	percent := strings.HasSuffix(s, "%")
+	value, err := strconv.ParseFloat(strings.TrimSuffix(s, "%"), 64)
+	if err != nil {
+		return 0, err
+	}
+	if percent {
+		value = value * max / 100
+	}
+	if value < 0 || value > max {
+		return 0, fmt.Errorf("%v is not between 0 and %v", value, max)
+	}
+	return value, nil
+}
+
+func channel(value float64) uint8 {
+	return uint8(math.Round(math.Max(0, math.Min(1, value)) * 255))
+}
+
+func hslToRGB(h, s, l float64) (uint8, uint8, uint8) {
+	chroma := (1 - math.Abs(2*l-1)) * s
+	return hueToRGB(h, chroma, l-chroma/2)
+}
+
+func hsvToRGB(h, s, v float64) (uint8, uint8, uint8) {
+	chroma := v * s
+	return hueToRGB(h, chroma, v-chroma)
+}
+
+// hueToRGB returns the color of the hue in degrees with the given chroma, adding m to every channel
+func hueToRGB(h, chroma, m float64) (uint8, uint8, uint8) {
+	h = math.Mod(h, 360)
+	if h < 0 {
+		h += 360
+	}
+	h /= 60
+	x := chroma * (1 - math.Abs(math.Mod(h, 2)-1))
+	var r, g, b float64
+	switch {
+	case h < 1:
+		r, g, b = chroma, x, 0
+	case h < 2:
+		r, g, b = x, chroma, 0
+	case h < 3:
+		r, g, b = 0, chroma, x
+	case h < 4:
+		r, g, b = 0, x, chroma
+	case h < 5:
+		r, g, b = x, 0, chroma
+	default:
+		r, g, b = chroma, 0, x
+	}
+	return channel(r + m), channel(g + m), channel(b + m)
+}
+
+// namedColors are the CSS named colors
+var namedColors = map[string]uint32{
+	"aliceblue":            0xf0f8ff,
+	"antiquewhite":         0xfaebd7,
+	"aqua":                 0x00ffff,
+	"aquamarine":           0x7fffd4,
+	"azure":                0xf0ffff,
+	"beige":                0xf5f5dc,
+	"bisque":               0xffe4c4,
+	"black":                0x000000,
+	"blanchedalmond":       0xffebcd,
+	"blue":                 0x0000ff,
+	"blueviolet":           0x8a2be2,
+	"brown":                0xa52a2a,
+	"burlywood":            0xdeb887,
+	"cadetblue":            0x5f9ea0,
+	"chartreuse":           0x7fff00,
+	"chocolate":            0xd2691e,
+	"coral":                0xff7f50,
+	"cornflowerblue":       0x6495ed,
+	"cornsilk":             0xfff8dc,
+	"crimson":              0xdc143c,
+	"cyan":                 0x00ffff,
+	"darkblue":             0x00008b,
+	"darkcyan":             0x008b8b,
+	"darkgoldenrod":        0xb8860b,
+	"darkgray":             0xa9a9a9,
+	"darkgreen":            0x006400,
+	"darkgrey":             0xa9a9a9,
+	"darkkhaki":            0xbdb76b,
+	"darkmagenta":          0x8b008b,
+	"darkolivegreen":       0x556b2f,
+	"darkorange":           0xff8c00,
+	"darkorchid":           0x9932cc,
+	"darkred":              0x8b0000,
+	"darksalmon":           0xe9967a,
+	"darkseagreen":         0x8fbc8f,
+	"darkslateblue":        0x483d8b,
+	"darkslategray":        0x2f4f4f,
+	"darkslategrey":        0x2f4f4f,
+	"darkturquoise":        0x00ced1,
+	"darkviolet":           0x9400d3,
+	"deeppink":             0xff1493,
+	"deepskyblue":          0x00bfff,
+	"dimgray":              0x696969,
+	"dimgrey":              0x696969,
+	"dodgerblue":           0x1e90ff,
+	"firebrick":            0xb22222,
+	"floralwhite":          0xfffaf0,
+	"forestgreen":          0x228b22,
+	"fuchsia":              0xff00ff,
+	"gainsboro":            0xdcdcdc,
+	"ghostwhite":           0xf8f8ff,
+	"gold":                 0xffd700,
+	"goldenrod":            0xdaa520,
+	"gray":                 0x808080,
+	"green":                0x008000,
+	"greenyellow":          0xadff2f,
+	"grey":                 0x808080,
+	"honeydew":             0xf0fff0,
+	"hotpink":              0xff69b4,
+	"indianred":            0xcd5c5c,
+	"indigo":               0x4b0082,
+	"ivory":                0xfffff0,
+	"khaki":                0xf0e68c,
+	"lavender":             0xe6e6fa,
+	"lavenderblush":        0xfff0f5,
+	"lawngreen":            0x7cfc00,
+	"lemonchiffon":         0xfffacd,
+	"lightblue":            0xadd8e6,
+	"lightcoral":           0xf08080,
+	"lightcyan":            0xe0ffff,
+	"lightgoldenrodyellow": 0xfafad2,
+	"lightgray":            0xd3d3d3,
+	"lightgreen":           0x90ee90,
+	"lightgrey":            0xd3d3d3,
+	"lightpink":            0xffb6c1,
+	"lightsalmon":          0xffa07a,
+	"lightseagreen":        0x20b2aa,
+	"lightskyblue":         0x87cefa,
+	"lightslategray":       0x778899,
+	"lightslategrey":       0x778899,
+	"lightsteelblue":       0xb0c4de,
+	"lightyellow":          0xffffe0,
+	"lime":                 0x00ff00,
+	"limegreen":            0x32cd32,
+	"linen":                0xfaf0e6,
+	"magenta":              0xff00ff,
+	"maroon":               0x800000,
+	"mediumaquamarine":     0x66cdaa,
+	"mediumblue":           0x0000cd,
+	"mediumorchid":         0xba55d3,
+	"mediumpurple":         0x9370db,
+	"mediumseagreen":       0x3cb371,
+	"mediumslateblue":      0x7b68ee,
+	"mediumspringgreen":    0x00fa9a,
+	"mediumturquoise":      0x48d1cc,
+	"mediumvioletred":      0xc71585,
+	"midnightblue":         0x191970,
+	"mintcream":            0xf5fffa,
+	"mistyrose":            0xffe4e1,
+	"moccasin":             0xffe4b5,
+	"navajowhite":          0xffdead,
+	"navy":                 0x000080,
+	"oldlace":              0xfdf5e6,
+	"olive":                0x808000,
+	"olivedrab":            0x6b8e23,
+	"orange":               0xffa500,
+	"orangered":            0xff4500,
+	"orchid":               0xda70d6,
+	"palegoldenrod":        0xeee8aa,
+	"palegreen":            0x98fb98,
+	"paleturquoise":        0xafeeee,
+	"palevioletred":        0xdb7093,
+	"papayawhip":           0xffefd5,
+	"peachpuff":            0xffdab9,
+	"peru":                 0xcd853f,
+	"pink":                 0xffc0cb,
+	"plum":                 0xdda0dd,
+	"powderblue":           0xb0e0e6,
+	"purple":               0x800080,
+	"rebeccapurple":        0x663399,
+	"red":                  0xff0000,
+	"rosybrown":            0xbc8f8f,
+	"royalblue":            0x4169e1,
+	"saddlebrown":          0x8b4513,
+	"salmon":               0xfa8072,
+	"sandybrown":           0xf4a460,
+	"seagreen":             0x2e8b57,
+	"seashell":             0xfff5ee,
+	"sienna":               0xa0522d,
+	"silver":               0xc0c0c0,
+	"skyblue":              0x87ceeb,
+	"slateblue":            0x6a5acd,
+	"slategray":            0x708090,
+	"slategrey":            0x708090,
+	"snow":                 0xfffafa,
+	"springgreen":          0x00ff7f,
+	"steelblue":            0x4682b4,
+	"tan":                  0xd2b48c,
+	"teal":                 0x008080,
+	"thistle":              0xd8bfd8,
+	"tomato":               0xff6347,
+	"turquoise":            0x40e0d0,
+	"violet":               0xee82ee,
+	"wheat":                0xf5deb3,
+	"white":                0xffffff,
+	"whitesmoke":           0xf5f5f5,
+	"yellow":               0xffff00,
+	"yellowgreen":          0x9acd32,
+}
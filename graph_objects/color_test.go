@@ -0,0 +1,67 @@
+package grob_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var _ = Describe("ParseColor", func() {
+
+	DescribeTable("valid colors",
+		func(color string, expected grob.RGBA) {
+			c, err := grob.ParseColor(color)
+			Expect(err).To(BeNil())
+			Expect(c.R).To(Equal(expected.R))
+			Expect(c.G).To(Equal(expected.G))
+			Expect(c.B).To(Equal(expected.B))
+			Expect(c.A).To(BeNumerically("~", expected.A, 0.01))
+		},
+		Entry("hex", "#d3d3d3", grob.RGBA{R: 211, G: 211, B: 211, A: 1}),
+		Entry("short hex", "#FfF", grob.RGBA{R: 255, G: 255, B: 255, A: 1}),
+		Entry("hex with alpha", "#ff000080", grob.RGBA{R: 255, A: 0.5}),
+		Entry("hex without #", "00ff00", grob.RGBA{G: 255, A: 1}),
+		Entry("rgb", "rgb(255, 0, 0)", grob.RGBA{R: 255, A: 1}),
+		Entry("rgb percentages", "rgb(100%, 50%, 0%)", grob.RGBA{R: 255, G: 128, A: 1}),
+		Entry("rgba", "rgba(0,0,255,0.3)", grob.RGBA{B: 255, A: 0.3}),
+		Entry("hsl", "hsl(120, 100%, 50%)", grob.RGBA{G: 255, A: 1}),
+		Entry("hsla", "hsla(240, 100%, 25%, 0.5)", grob.RGBA{B: 128, A: 0.5}),
+		Entry("hsv", "hsv(0, 100%, 100%)", grob.RGBA{R: 255, A: 1}),
+		Entry("named", "LightBlue", grob.RGBA{R: 173, G: 216, B: 230, A: 1}),
+		Entry("transparent", "transparent", grob.RGBA{}),
+	)
+
+	DescribeTable("invalid colors",
+		func(color string) {
+			_, err := grob.ParseColor(color)
+			Expect(err).NotTo(BeNil())
+		},
+		Entry("typo", "ligthblue"),
+		Entry("hex", "#12345"),
+		Entry("out of range", "rgb(256, 0, 0)"),
+		Entry("missing value", "rgba(0, 0, 0)"),
+		Entry("unknown function", "cmyk(0, 0, 0, 1)"),
+		Entry("empty", ""),
+	)
+
+	It("Should format as rgba", func() {
+		Expect(grob.RGBA{R: 1, G: 2, B: 3, A: 0.5}.String()).To(Equal("rgba(1, 2, 3, 0.5)"))
+	})
+
+	It("Should be checked by Validate", func() {
+		trace := &grob.Scatter{
+			Type: grob.TraceTypeScatter,
+			Marker: &grob.ScatterMarker{
+				Color:      []interface{}{"red", "ligthblue", 3},
+				Colorscale: [][]interface{}{{0, "white"}, {1, "bleu"}},
+				Line:       &grob.ScatterMarkerLine{Color: "rgb(0, 0, 0)"},
+			},
+		}
+		err := trace.Validate()
+		Expect(err).To(MatchError(ContainSubstring(`marker.color[1]: invalid color "ligthblue"`)))
+		Expect(err).To(MatchError(ContainSubstring(`marker.colorscale[1][1]: invalid color "bleu"`)))
+		Expect(err.(grob.ValidationErrors)).To(HaveLen(2))
+	})
+})
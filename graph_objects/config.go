@@ -0,0 +1,90 @@
+package grob
+
+// minimalModeBar are the buttons removed by MinimalModeBar, all the default buttons but the image download and the view resets
+var minimalModeBar = []ConfigModeBarButton{
+	ConfigModeBarButtonSendDataToCloud,
+	ConfigModeBarButtonEditInChartStudio,
+	ConfigModeBarButtonZoom2d,
+	ConfigModeBarButtonPan2d,
+	ConfigModeBarButtonSelect2d,
+	ConfigModeBarButtonLasso2d,
+	ConfigModeBarButtonZoomIn2d,
+	ConfigModeBarButtonZoomOut2d,
+	ConfigModeBarButtonAutoScale2d,
+	ConfigModeBarButtonHoverClosestCartesian,
+	ConfigModeBarButtonHoverCompareCartesian,
+	ConfigModeBarButtonZoom3d,
+	ConfigModeBarButtonPan3d,
+	ConfigModeBarButtonOrbitRotation,
+	ConfigModeBarButtonTableRotation,
+	ConfigModeBarButtonResetCameraLastSave3d,
+	ConfigModeBarButtonHoverClosest3d,
+	ConfigModeBarButtonZoomInGeo,
+	ConfigModeBarButtonZoomOutGeo,
+	ConfigModeBarButtonHoverClosestGeo,
+	ConfigModeBarButtonHoverClosestGl2d,
+	ConfigModeBarButtonHoverClosestPie,
+	ConfigModeBarButtonToggleHover,
+	ConfigModeBarButtonToggleSpikelines,
+}
+
+// DrawingModeBarButtons are the buttons to draw and erase shapes, which are not in the mode bar by default
+var DrawingModeBarButtons = []ConfigModeBarButton{
+	ConfigModeBarButtonDrawline,
+	ConfigModeBarButtonDrawopenpath,
+	ConfigModeBarButtonDrawclosedpath,
+	ConfigModeBarButtonDrawcircle,
+	ConfigModeBarButtonDrawrect,
+	ConfigModeBarButtonEraseshape,
+}
+
+// MinimalModeBar keeps only the buttons to download the image and to reset the view in the mode bar, and hides the plotly logo
+func (c *Config) MinimalModeBar() {
+	c.RemoveModeBarButtons(minimalModeBar...)
+	c.Displaylogo = False
+}
+
+// AddModeBarButtons adds built-in buttons that are not in the mode bar by default, like DrawingModeBarButtons.
+// The buttons already added are skipped.
+func (c *Config) AddModeBarButtons(buttons ...ConfigModeBarButton) {
+	c.Modebarbuttonstoadd = appendButtons(c.Modebarbuttonstoadd, buttons)
+}
+
+// RemoveModeBarButtons removes buttons from the mode bar, the buttons already removed are skipped
+func (c *Config) RemoveModeBarButtons(buttons ...ConfigModeBarButton) {
+	c.Modebarbuttonstoremove = appendButtons(c.Modebarbuttonstoremove, buttons)
+}
+
+// DownloadAs sets the image downloaded with the camera button of the mode bar, such as an svg of 1920x1080 pixels.
+// A width or height of 0 is the size of the plot on the page, and the scale multiplies both to increase the resolution.
+//
+//	config.DownloadAs(grob.ConfigToimagebuttonoptionsFormatSvg, "report", 1920, 1080, 2)
+func (c *Config) DownloadAs(format ConfigToimagebuttonoptionsFormat, filename string, width, height, scale float64) {
+	options := &ConfigToimagebuttonoptions{
+		Format: format,
+		Width:  width,
+		Height: height,
+		Scale:  scale,
+	}
+	if filename != "" {
+		options.Filename = filename
+	}
+	c.Toimagebuttonoptions = options
+}
+
+// appendButtons appends the buttons that are not in the list yet
+func appendButtons(list, buttons []ConfigModeBarButton) []ConfigModeBarButton {
+	for _, button := range buttons {
+		found := false
+		for _, b := range list {
+			if b == button {
+				found = true
+				break
+			}
+		}
+		if !found {
+			list = append(list, button)
+		}
+	}
+	return list
+}
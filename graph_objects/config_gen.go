@@ -71,7 +71,7 @@ type Config struct {
 	// arrayOK: false
 	// type: string
 	// Which localization should we use? Should be a string like 'en' or 'en-US'.
-	Locale String `json:"locale,omitempty"`
+	Locale ConfigLocale `json:"locale,omitempty"`
 
 	// Locales
 	// arrayOK: false
@@ -95,19 +95,19 @@ type Config struct {
 	// arrayOK: false
 	// type: any
 	// Define fully custom mode bar buttons as nested array, where the outer arrays represents button groups, and the inner arrays have buttons config objects or names of default buttons See ./components/modebar/buttons.js for more info.
-	Modebarbuttons interface{} `json:"modeBarButtons,omitempty"`
+	Modebarbuttons [][]ConfigModeBarButton `json:"modeBarButtons,omitempty"`
 
 	// Modebarbuttonstoadd
 	// arrayOK: false
 	// type: any
 	// Add mode bar button using config objects See ./components/modebar/buttons.js for list of arguments.
-	Modebarbuttonstoadd interface{} `json:"modeBarButtonsToAdd,omitempty"`
+	Modebarbuttonstoadd []ConfigModeBarButton `json:"modeBarButtonsToAdd,omitempty"`
 
 	// Modebarbuttonstoremove
 	// arrayOK: false
 	// type: any
 	// Remove mode bar buttons by name. See ./components/modebar/buttons.js for the list of names.
-	Modebarbuttonstoremove interface{} `json:"modeBarButtonsToRemove,omitempty"`
+	Modebarbuttonstoremove []ConfigModeBarButton `json:"modeBarButtonsToRemove,omitempty"`
 
 	// Notifyonlogging
 	// arrayOK: false
@@ -206,10 +206,8 @@ type Config struct {
 	Staticplot Bool `json:"staticPlot,omitempty"`
 
 	// Toimagebuttonoptions
-	// arrayOK: false
-	// type: any
-	// Statically override options for toImage modebar button allowed keys are format, filename, width, height, scale see ../components/modebar/buttons.js
-	Toimagebuttonoptions interface{} `json:"toImageButtonOptions,omitempty"`
+	// role: Object
+	Toimagebuttonoptions *ConfigToimagebuttonoptions `json:"toImageButtonOptions,omitempty"`
 
 	// Topojsonurl
 	// arrayOK: false
@@ -288,6 +286,40 @@ type ConfigEdits struct {
 	Titletext Bool `json:"titleText,omitempty"`
 }
 
+// ConfigToimagebuttonoptions Statically override options for toImage modebar button allowed keys are format, filename, width, height, scale see ../components/modebar/buttons.js
+type ConfigToimagebuttonoptions struct {
+
+	// Filename
+	// arrayOK: false
+	// type: string
+	// Sets the name of the downloaded file, without extension.
+	Filename String `json:"filename,omitempty"`
+
+	// Format
+	// default: png
+	// type: enumerated
+	// Sets the format of the downloaded image.
+	Format ConfigToimagebuttonoptionsFormat `json:"format,omitempty"`
+
+	// Height
+	// arrayOK: false
+	// type: number
+	// Sets the height of the image in pixels, defaults to the height of the plot on the page.
+	Height float64 `json:"height,omitempty"`
+
+	// Scale
+	// arrayOK: false
+	// type: number
+	// Multiplies the width and the height of the image, to increase its resolution.
+	Scale float64 `json:"scale,omitempty"`
+
+	// Width
+	// arrayOK: false
+	// type: number
+	// Sets the width of the image in pixels, defaults to the width of the plot on the page.
+	Width float64 `json:"width,omitempty"`
+}
+
 // ConfigDisplaymodebar Determines the mode bar display mode. If *true*, the mode bar is always visible. If *false*, the mode bar is always hidden. If *hover*, the mode bar is visible while the mouse cursor is on the graph container.
 type ConfigDisplaymodebar interface{}
 
@@ -307,6 +339,138 @@ var (
 	ConfigDoubleclickResetPlusautosize ConfigDoubleclick = "reset+autosize"
 )
 
+// ConfigToimagebuttonoptionsFormat Sets the format of the downloaded image.
+type ConfigToimagebuttonoptionsFormat string
+
+const (
+	ConfigToimagebuttonoptionsFormatPng  ConfigToimagebuttonoptionsFormat = "png"
+	ConfigToimagebuttonoptionsFormatSvg  ConfigToimagebuttonoptionsFormat = "svg"
+	ConfigToimagebuttonoptionsFormatJpeg ConfigToimagebuttonoptionsFormat = "jpeg"
+	ConfigToimagebuttonoptionsFormatWebp ConfigToimagebuttonoptionsFormat = "webp"
+)
+
+// ConfigModeBarButton The name of a built-in mode bar button, to be used in ModeBarButtons, ModeBarButtonsToAdd and ModeBarButtonsToRemove
+type ConfigModeBarButton string
+
+const (
+	ConfigModeBarButtonToImage               ConfigModeBarButton = "toImage"
+	ConfigModeBarButtonSendDataToCloud       ConfigModeBarButton = "sendDataToCloud"
+	ConfigModeBarButtonEditInChartStudio     ConfigModeBarButton = "editInChartStudio"
+	ConfigModeBarButtonZoom2d                ConfigModeBarButton = "zoom2d"
+	ConfigModeBarButtonPan2d                 ConfigModeBarButton = "pan2d"
+	ConfigModeBarButtonSelect2d              ConfigModeBarButton = "select2d"
+	ConfigModeBarButtonLasso2d               ConfigModeBarButton = "lasso2d"
+	ConfigModeBarButtonZoomIn2d              ConfigModeBarButton = "zoomIn2d"
+	ConfigModeBarButtonZoomOut2d             ConfigModeBarButton = "zoomOut2d"
+	ConfigModeBarButtonAutoScale2d           ConfigModeBarButton = "autoScale2d"
+	ConfigModeBarButtonResetScale2d          ConfigModeBarButton = "resetScale2d"
+	ConfigModeBarButtonHoverClosestCartesian ConfigModeBarButton = "hoverClosestCartesian"
+	ConfigModeBarButtonHoverCompareCartesian ConfigModeBarButton = "hoverCompareCartesian"
+	ConfigModeBarButtonZoom3d                ConfigModeBarButton = "zoom3d"
+	ConfigModeBarButtonPan3d                 ConfigModeBarButton = "pan3d"
+	ConfigModeBarButtonOrbitRotation         ConfigModeBarButton = "orbitRotation"
+	ConfigModeBarButtonTableRotation         ConfigModeBarButton = "tableRotation"
+	ConfigModeBarButtonResetCameraDefault3d  ConfigModeBarButton = "resetCameraDefault3d"
+	ConfigModeBarButtonResetCameraLastSave3d ConfigModeBarButton = "resetCameraLastSave3d"
+	ConfigModeBarButtonHoverClosest3d        ConfigModeBarButton = "hoverClosest3d"
+	ConfigModeBarButtonZoomInGeo             ConfigModeBarButton = "zoomInGeo"
+	ConfigModeBarButtonZoomOutGeo            ConfigModeBarButton = "zoomOutGeo"
+	ConfigModeBarButtonResetGeo              ConfigModeBarButton = "resetGeo"
+	ConfigModeBarButtonHoverClosestGeo       ConfigModeBarButton = "hoverClosestGeo"
+	ConfigModeBarButtonHoverClosestGl2d      ConfigModeBarButton = "hoverClosestGl2d"
+	ConfigModeBarButtonHoverClosestPie       ConfigModeBarButton = "hoverClosestPie"
+	ConfigModeBarButtonResetViewSankey       ConfigModeBarButton = "resetViewSankey"
+	ConfigModeBarButtonToggleHover           ConfigModeBarButton = "toggleHover"
+	ConfigModeBarButtonResetViews            ConfigModeBarButton = "resetViews"
+	ConfigModeBarButtonToggleSpikelines      ConfigModeBarButton = "toggleSpikelines"
+	ConfigModeBarButtonResetViewMapbox       ConfigModeBarButton = "resetViewMapbox"
+	ConfigModeBarButtonDrawline              ConfigModeBarButton = "drawline"
+	ConfigModeBarButtonDrawopenpath          ConfigModeBarButton = "drawopenpath"
+	ConfigModeBarButtonDrawclosedpath        ConfigModeBarButton = "drawclosedpath"
+	ConfigModeBarButtonDrawcircle            ConfigModeBarButton = "drawcircle"
+	ConfigModeBarButtonDrawrect              ConfigModeBarButton = "drawrect"
+	ConfigModeBarButtonEraseshape            ConfigModeBarButton = "eraseshape"
+)
+
+// ConfigLocale A localization supported by plotly.js. Locales other than en and en-US require the plotly-locale-<locale>.js bundle
+type ConfigLocale string
+
+const (
+	ConfigLocaleEn   ConfigLocale = "en"
+	ConfigLocaleEnUS ConfigLocale = "en-US"
+	ConfigLocaleAf   ConfigLocale = "af"
+	ConfigLocaleAm   ConfigLocale = "am"
+	ConfigLocaleAr   ConfigLocale = "ar"
+	ConfigLocaleArDZ ConfigLocale = "ar-DZ"
+	ConfigLocaleArEG ConfigLocale = "ar-EG"
+	ConfigLocaleAz   ConfigLocale = "az"
+	ConfigLocaleBg   ConfigLocale = "bg"
+	ConfigLocaleBs   ConfigLocale = "bs"
+	ConfigLocaleCa   ConfigLocale = "ca"
+	ConfigLocaleCs   ConfigLocale = "cs"
+	ConfigLocaleCy   ConfigLocale = "cy"
+	ConfigLocaleDa   ConfigLocale = "da"
+	ConfigLocaleDe   ConfigLocale = "de"
+	ConfigLocaleDeCH ConfigLocale = "de-CH"
+	ConfigLocaleEl   ConfigLocale = "el"
+	ConfigLocaleEo   ConfigLocale = "eo"
+	ConfigLocaleEs   ConfigLocale = "es"
+	ConfigLocaleEsAR ConfigLocale = "es-AR"
+	ConfigLocaleEsPE ConfigLocale = "es-PE"
+	ConfigLocaleEt   ConfigLocale = "et"
+	ConfigLocaleEu   ConfigLocale = "eu"
+	ConfigLocaleFa   ConfigLocale = "fa"
+	ConfigLocaleFi   ConfigLocale = "fi"
+	ConfigLocaleFo   ConfigLocale = "fo"
+	ConfigLocaleFr   ConfigLocale = "fr"
+	ConfigLocaleFrCH ConfigLocale = "fr-CH"
+	ConfigLocaleGl   ConfigLocale = "gl"
+	ConfigLocaleHe   ConfigLocale = "he"
+	ConfigLocaleHiIN ConfigLocale = "hi-IN"
+	ConfigLocaleHr   ConfigLocale = "hr"
+	ConfigLocaleHu   ConfigLocale = "hu"
+	ConfigLocaleHy   ConfigLocale = "hy"
+	ConfigLocaleId   ConfigLocale = "id"
+	ConfigLocaleIs   ConfigLocale = "is"
+	ConfigLocaleIt   ConfigLocale = "it"
+	ConfigLocaleJa   ConfigLocale = "ja"
+	ConfigLocaleKa   ConfigLocale = "ka"
+	ConfigLocaleKm   ConfigLocale = "km"
+	ConfigLocaleKo   ConfigLocale = "ko"
+	ConfigLocaleLt   ConfigLocale = "lt"
+	ConfigLocaleLv   ConfigLocale = "lv"
+	ConfigLocaleMe   ConfigLocale = "me"
+	ConfigLocaleMeME ConfigLocale = "me-ME"
+	ConfigLocaleMk   ConfigLocale = "mk"
+	ConfigLocaleMl   ConfigLocale = "ml"
+	ConfigLocaleMs   ConfigLocale = "ms"
+	ConfigLocaleMt   ConfigLocale = "mt"
+	ConfigLocaleNl   ConfigLocale = "nl"
+	ConfigLocaleNo   ConfigLocale = "no"
+	ConfigLocalePl   ConfigLocale = "pl"
+	ConfigLocalePtBR ConfigLocale = "pt-BR"
+	ConfigLocalePtPT ConfigLocale = "pt-PT"
+	ConfigLocaleRo   ConfigLocale = "ro"
+	ConfigLocaleRu   ConfigLocale = "ru"
+	ConfigLocaleSk   ConfigLocale = "sk"
+	ConfigLocaleSl   ConfigLocale = "sl"
+	ConfigLocaleSq   ConfigLocale = "sq"
+	ConfigLocaleSr   ConfigLocale = "sr"
+	ConfigLocaleSrSR ConfigLocale = "sr-SR"
+	ConfigLocaleSv   ConfigLocale = "sv"
+	ConfigLocaleSw   ConfigLocale = "sw"
+	ConfigLocaleTa   ConfigLocale = "ta"
+	ConfigLocaleTh   ConfigLocale = "th"
+	ConfigLocaleTr   ConfigLocale = "tr"
+	ConfigLocaleTt   ConfigLocale = "tt"
+	ConfigLocaleUk   ConfigLocale = "uk"
+	ConfigLocaleUr   ConfigLocale = "ur"
+	ConfigLocaleVi   ConfigLocale = "vi"
+	ConfigLocaleZhCN ConfigLocale = "zh-CN"
+	ConfigLocaleZhHK ConfigLocale = "zh-HK"
+	ConfigLocaleZhTW ConfigLocale = "zh-TW"
+)
+
 // ConfigScrollzoom Determines whether mouse wheel or two-finger scroll zooms is enable. Turned on by default for gl3d, geo and mapbox subplots (as these subplot types do not have zoombox via pan), but turned off by default for cartesian subplots. Set `scrollZoom` to *false* to disable scrolling for all subplots.
 type ConfigScrollzoom interface{}
 
@@ -321,3 +485,259 @@ var (
 	ConfigScrollzoomTrue  ConfigScrollzoom = true
 	ConfigScrollzoomFalse ConfigScrollzoom = false
 )
+
+// MarshalJSON encodes Config with precomputed fields. The output is the same as encoding/json.
+func (obj *Config) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *Config) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Autosizable != nil {
+		e.key(`autosizable`)
+		e.bool(*obj.Autosizable)
+	}
+	if obj.Displaymodebar != nil {
+		e.key(`displayModeBar`)
+		e.any(obj.Displaymodebar)
+	}
+	if obj.Displaylogo != nil {
+		e.key(`displaylogo`)
+		e.bool(*obj.Displaylogo)
+	}
+	if obj.Doubleclick != nil {
+		e.key(`doubleClick`)
+		e.any(obj.Doubleclick)
+	}
+	if obj.Doubleclickdelay != 0 {
+		e.key(`doubleClickDelay`)
+		e.float(float64(obj.Doubleclickdelay))
+	}
+	if obj.Editable != nil {
+		e.key(`editable`)
+		e.bool(*obj.Editable)
+	}
+	if obj.Edits != nil {
+		e.key(`edits`)
+		obj.Edits.encodeJSON(e)
+	}
+	if obj.Fillframe != nil {
+		e.key(`fillFrame`)
+		e.bool(*obj.Fillframe)
+	}
+	if obj.Framemargins != 0 {
+		e.key(`frameMargins`)
+		e.float(float64(obj.Framemargins))
+	}
+	if obj.Globaltransforms != nil {
+		e.key(`globalTransforms`)
+		e.any(obj.Globaltransforms)
+	}
+	if obj.Linktext != nil {
+		e.key(`linkText`)
+		e.any(obj.Linktext)
+	}
+	if obj.Locale != "" {
+		e.key(`locale`)
+		e.string(string(obj.Locale))
+	}
+	if obj.Locales != nil {
+		e.key(`locales`)
+		e.any(obj.Locales)
+	}
+	if obj.Logging != 0 {
+		e.key(`logging`)
+		e.int(int64(obj.Logging))
+	}
+	if obj.Mapboxaccesstoken != nil {
+		e.key(`mapboxAccessToken`)
+		e.any(obj.Mapboxaccesstoken)
+	}
+	if len(obj.Modebarbuttons) != 0 {
+		e.key(`modeBarButtons`)
+		e.any(obj.Modebarbuttons)
+	}
+	if len(obj.Modebarbuttonstoadd) != 0 {
+		e.key(`modeBarButtonsToAdd`)
+		e.any(obj.Modebarbuttonstoadd)
+	}
+	if len(obj.Modebarbuttonstoremove) != 0 {
+		e.key(`modeBarButtonsToRemove`)
+		e.any(obj.Modebarbuttonstoremove)
+	}
+	if obj.Notifyonlogging != 0 {
+		e.key(`notifyOnLogging`)
+		e.int(int64(obj.Notifyonlogging))
+	}
+	if obj.Plotglpixelratio != 0 {
+		e.key(`plotGlPixelRatio`)
+		e.float(float64(obj.Plotglpixelratio))
+	}
+	if obj.Plotlyserverurl != nil {
+		e.key(`plotlyServerURL`)
+		e.any(obj.Plotlyserverurl)
+	}
+	if obj.Queuelength != 0 {
+		e.key(`queueLength`)
+		e.int(int64(obj.Queuelength))
+	}
+	if obj.Responsive != nil {
+		e.key(`responsive`)
+		e.bool(*obj.Responsive)
+	}
+	if obj.Scrollzoom != nil {
+		e.key(`scrollZoom`)
+		e.any(obj.Scrollzoom)
+	}
+	if obj.Senddata != nil {
+		e.key(`sendData`)
+		e.bool(*obj.Senddata)
+	}
+	if obj.Setbackground != nil {
+		e.key(`setBackground`)
+		e.any(obj.Setbackground)
+	}
+	if obj.Showaxisdraghandles != nil {
+		e.key(`showAxisDragHandles`)
+		e.bool(*obj.Showaxisdraghandles)
+	}
+	if obj.Showaxisrangeentryboxes != nil {
+		e.key(`showAxisRangeEntryBoxes`)
+		e.bool(*obj.Showaxisrangeentryboxes)
+	}
+	if obj.Showeditinchartstudio != nil {
+		e.key(`showEditInChartStudio`)
+		e.bool(*obj.Showeditinchartstudio)
+	}
+	if obj.Showlink != nil {
+		e.key(`showLink`)
+		e.bool(*obj.Showlink)
+	}
+	if obj.Showsendtocloud != nil {
+		e.key(`showSendToCloud`)
+		e.bool(*obj.Showsendtocloud)
+	}
+	if obj.Showsources != nil {
+		e.key(`showSources`)
+		e.any(obj.Showsources)
+	}
+	if obj.Showtips != nil {
+		e.key(`showTips`)
+		e.bool(*obj.Showtips)
+	}
+	if obj.Staticplot != nil {
+		e.key(`staticPlot`)
+		e.bool(*obj.Staticplot)
+	}
+	if obj.Toimagebuttonoptions != nil {
+		e.key(`toImageButtonOptions`)
+		obj.Toimagebuttonoptions.encodeJSON(e)
+	}
+	if obj.Topojsonurl != nil {
+		e.key(`topojsonURL`)
+		e.any(obj.Topojsonurl)
+	}
+	if obj.Watermark != nil {
+		e.key(`watermark`)
+		e.bool(*obj.Watermark)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes ConfigEdits with precomputed fields. The output is the same as encoding/json.
+func (obj *ConfigEdits) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *ConfigEdits) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Annotationposition != nil {
+		e.key(`annotationPosition`)
+		e.bool(*obj.Annotationposition)
+	}
+	if obj.Annotationtail != nil {
+		e.key(`annotationTail`)
+		e.bool(*obj.Annotationtail)
+	}
+	if obj.Annotationtext != nil {
+		e.key(`annotationText`)
+		e.bool(*obj.Annotationtext)
+	}
+	if obj.Axistitletext != nil {
+		e.key(`axisTitleText`)
+		e.bool(*obj.Axistitletext)
+	}
+	if obj.Colorbarposition != nil {
+		e.key(`colorbarPosition`)
+		e.bool(*obj.Colorbarposition)
+	}
+	if obj.Colorbartitletext != nil {
+		e.key(`colorbarTitleText`)
+		e.bool(*obj.Colorbartitletext)
+	}
+	if obj.Legendposition != nil {
+		e.key(`legendPosition`)
+		e.bool(*obj.Legendposition)
+	}
+	if obj.Legendtext != nil {
+		e.key(`legendText`)
+		e.bool(*obj.Legendtext)
+	}
+	if obj.Shapeposition != nil {
+		e.key(`shapePosition`)
+		e.bool(*obj.Shapeposition)
+	}
+	if obj.Titletext != nil {
+		e.key(`titleText`)
+		e.bool(*obj.Titletext)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes ConfigToimagebuttonoptions with precomputed fields. The output is the same as encoding/json.
+func (obj *ConfigToimagebuttonoptions) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *ConfigToimagebuttonoptions) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Filename != nil {
+		e.key(`filename`)
+		e.any(obj.Filename)
+	}
+	if obj.Format != "" {
+		e.key(`format`)
+		e.string(string(obj.Format))
+	}
+	if obj.Height != 0 {
+		e.key(`height`)
+		e.float(float64(obj.Height))
+	}
+	if obj.Scale != 0 {
+		e.key(`scale`)
+		e.float(float64(obj.Scale))
+	}
+	if obj.Width != 0 {
+		e.key(`width`)
+		e.float(float64(obj.Width))
+	}
+	e.objectEnd()
+}
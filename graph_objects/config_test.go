@@ -0,0 +1,59 @@
+package grob_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var _ = Describe("Config", func() {
+
+	It("Should keep the image download and the view resets in the minimal mode bar", func() {
+		config := &grob.Config{}
+		config.MinimalModeBar()
+		Expect(config.Modebarbuttonstoremove).To(ContainElement(grob.ConfigModeBarButtonZoom2d))
+		Expect(config.Modebarbuttonstoremove).NotTo(ContainElement(grob.ConfigModeBarButtonToImage))
+		Expect(config.Modebarbuttonstoremove).NotTo(ContainElement(grob.ConfigModeBarButtonResetScale2d))
+		Expect(config.Displaylogo).To(Equal(grob.False))
+	})
+
+	It("Should add and remove buttons once", func() {
+		config := &grob.Config{}
+		config.AddModeBarButtons(grob.DrawingModeBarButtons...)
+		config.AddModeBarButtons(grob.ConfigModeBarButtonDrawrect)
+		config.RemoveModeBarButtons(grob.ConfigModeBarButtonLasso2d, grob.ConfigModeBarButtonLasso2d)
+
+		data, err := json.Marshal(config)
+		Expect(err).To(BeNil())
+		Expect(data).To(MatchJSON(`{
+			"modeBarButtonsToAdd": ["drawline", "drawopenpath", "drawclosedpath", "drawcircle", "drawrect", "eraseshape"],
+			"modeBarButtonsToRemove": ["lasso2d"]
+		}`))
+	})
+
+	It("Should write the groups of buttons", func() {
+		config := &grob.Config{Modebarbuttons: [][]grob.ConfigModeBarButton{
+			{grob.ConfigModeBarButtonToImage},
+			{grob.ConfigModeBarButtonZoom2d, grob.ConfigModeBarButtonResetScale2d},
+		}}
+		data, err := json.Marshal(config)
+		Expect(err).To(BeNil())
+		Expect(data).To(MatchJSON(`{"modeBarButtons": [["toImage"], ["zoom2d", "resetScale2d"]]}`))
+	})
+
+	It("Should set the image downloaded by the camera button", func() {
+		config := &grob.Config{}
+		config.DownloadAs("svg", "report", 1920, 1080, 2)
+		data, err := json.Marshal(config)
+		Expect(err).To(BeNil())
+		Expect(data).To(MatchJSON(`{"toImageButtonOptions": {"format": "svg", "filename": "report", "width": 1920, "height": 1080, "scale": 2}}`))
+
+		config.DownloadAs(grob.ConfigToimagebuttonoptionsFormatPng, "", 0, 0, 0)
+		data, err = json.Marshal(config)
+		Expect(err).To(BeNil())
+		Expect(data).To(MatchJSON(`{"toImageButtonOptions": {"format": "png"}}`))
+	})
+})
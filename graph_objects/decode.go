@@ -0,0 +1,148 @@
+package grob
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// UnmarshalOptions configure the decoding of figures and traces.
+// The zero value decodes like json.Unmarshal.
+type UnmarshalOptions struct {
+	// DisallowUnknownFields reports the attributes of the JSON that have no field in the figure, such as attributes
+	// added by plotly.js versions newer than the schema of this package, instead of silently dropping them.
+	// Attributes without a schema, like the layout template, accept any field.
+	DisallowUnknownFields bool
+	// PreserveUnknownFields keeps the attributes of the JSON that have no field in the figure, such as those written by
+	// newer plotly.py versions, and writes them back unchanged with MarshalJSON and ToPlotlyJSON. The fields of the traces stay with
+	// their trace when the traces are reordered, and are dropped with the trace or the object that contains them, like the marker,
+	// when it is removed from the figure. Only figures keep them, not traces decoded alone.
+	PreserveUnknownFields bool
+}
+
+// Unmarshal decodes the figure. If unknown fields are disallowed and there are any, the figure is still decoded
+// and the error is ValidationErrors with the path of every unknown field, like data[0].marker.newattribute.
+//
+//	fig := &grob.Fig{}
+//	err := grob.UnmarshalOptions{DisallowUnknownFields: true}.Unmarshal(data, fig)
+func (opts UnmarshalOptions) Unmarshal(data []byte, fig *Fig) error {
+	err := json.Unmarshal(data, fig)
+	if err != nil {
+		return err
+	}
+	fig.unknown = nil
+	if opts.PreserveUnknownFields {
+		fig.unknown = preservedFields(data, fig)
+	}
+	if !opts.DisallowUnknownFields {
+		return nil
+	}
+	return unknownFields(data, reflect.ValueOf(fig), "")
+}
+
+// FromPlotlyJSON decodes a figure written by plotly.py with fig.to_json() or fig.write_json(), or by plotly.js, so it can be modified
+// and written back with ToPlotlyJSON without breaking it. The attributes unknown to this package are preserved like with
+// UnmarshalOptions.PreserveUnknownFields, and so are the attributes set to a value that is omitted when encoding, such as
+// the tracegroupgap of 0 that plotly express sets in the legend.
+//
+//	fig, err := grob.FromPlotlyJSON(data)
+//	err = fig.UpdateLayout(grob.WithTitle("Sales"))
+//	data, err = fig.ToPlotlyJSON()
+func FromPlotlyJSON(data []byte) (*Fig, error) {
+	fig := &Fig{}
+	err := UnmarshalOptions{PreserveUnknownFields: true}.Unmarshal(data, fig)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode figure, %w", err)
+	}
+	e := newEncoder()
+	fig.encodeJSON(e, true)
+	encoded, err := e.result()
+	if err == nil {
+		encoded, err = restoreFields(encoded, fig, fig.unknown)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot encode figure, %w", err)
+	}
+
+	dropped := map[fieldsKey][]rawField{}
+	droppedFields(data, encoded, reflect.ValueOf(fig), fieldsKey{}, dropped)
+	if len(dropped) == 0 {
+		return fig, nil
+	}
+	if fig.unknown == nil {
+		fig.unknown = map[fieldsKey][]rawField{}
+	}
+	for key, fields := range dropped {
+		object := append(fig.unknown[key], fields...)
+		sort.Slice(object, func(i, j int) bool { return object[i].name < object[j].name })
+		fig.unknown[key] = object
+	}
+	return fig, nil
+}
+
+// UnmarshalTrace decodes a trace like the UnmarshalTrace function. If unknown fields are disallowed and there are any,
+// the trace is returned together with ValidationErrors with the path of every unknown field.
+func (opts UnmarshalOptions) UnmarshalTrace(data []byte) (Trace, error) {
+	trace, err := UnmarshalTrace(data)
+	if err != nil {
+		return nil, err
+	}
+	if !opts.DisallowUnknownFields {
+		return trace, nil
+	}
+	return trace, unknownFields(data, reflect.ValueOf(trace), "")
+}
+
+// unknownFields compares the JSON with the value decoded from it and returns the attributes that were dropped
+func unknownFields(data []byte, value reflect.Value, path string) error {
+	v := &validator{}
+	walkUnknown(data, value, path, fieldsKey{}, func(path string, key fieldsKey, name string, raw json.RawMessage) {
+		v.fail(join(path, name), "unknown field")
+	})
+	return v.result()
+}
+
+// walkUnknown walks the JSON and the decoded value together and calls found with every attribute that was dropped,
+// its raw JSON and the path and the key of the object that contains it. Generic values, such as interface{} fields holding maps, accept any attribute.
+func walkUnknown(data json.RawMessage, value reflect.Value, path string, key fieldsKey, found func(path string, key fieldsKey, name string, raw json.RawMessage)) {
+	value, key = key.enter(value)
+	if !value.IsValid() {
+		return
+	}
+
+	switch value.Kind() {
+	case reflect.Struct:
+		object := map[string]json.RawMessage{}
+		if json.Unmarshal(data, &object) != nil {
+			return
+		}
+		for name, item := range object {
+			field, ok := fieldByJSONName(value, name)
+			if !ok {
+				found(path, key, name, item)
+				continue
+			}
+			walkUnknown(item, field, join(path, name), key.attribute(name), found)
+		}
+	case reflect.Slice, reflect.Array:
+		array := []json.RawMessage{}
+		if json.Unmarshal(data, &array) != nil {
+			return
+		}
+		for i := 0; i < len(array) && i < value.Len(); i++ {
+			walkUnknown(array[i], value.Index(i), fmt.Sprintf("%s[%d]", path, i), key.item(i), found)
+		}
+	case reflect.Map:
+		object := map[string]json.RawMessage{}
+		if json.Unmarshal(data, &object) != nil || value.Type().Key().Kind() != reflect.String {
+			return
+		}
+		for name, item := range object {
+			field := value.MapIndex(reflect.ValueOf(name).Convert(value.Type().Key()))
+			if field.IsValid() {
+				walkUnknown(item, field, join(path, name), key.attribute(name), found)
+			}
+		}
+	}
+}
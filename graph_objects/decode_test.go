@@ -0,0 +1,209 @@
+package grob_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var _ = Describe("UnmarshalOptions", func() {
+
+	data := []byte(`{
+		"data": [
+			{"type": "scatter", "x": [1, 2], "marker": {"size": 3, "newsize": 4}, "newattribute": true},
+			{"type": "bar", "customdata": {"anything": 1}}
+		],
+		"layout": {
+			"xaxis2": {"type": "log", "newaxisattribute": 1},
+			"template": {"data": {"scatter": [{"mode": "lines", "newmode": 1}]}},
+			"annotations": [{"text": "any"}]
+		},
+		"frames": [{"name": "first", "data": [{"type": "scatter", "newframe": 1}]}]
+	}`)
+
+	It("Should decode unknown fields like json.Unmarshal by default", func() {
+		fig := &grob.Fig{}
+		Expect(grob.UnmarshalOptions{}.Unmarshal(data, fig)).To(Succeed())
+		Expect(fig.Data).To(HaveLen(2))
+	})
+
+	It("Should report every unknown field", func() {
+		fig := &grob.Fig{}
+		err := grob.UnmarshalOptions{DisallowUnknownFields: true}.Unmarshal(data, fig)
+		Expect(err).NotTo(BeNil())
+
+		errs, ok := err.(grob.ValidationErrors)
+		Expect(ok).To(BeTrue())
+		paths := []string{}
+		for _, e := range errs {
+			paths = append(paths, e.Path)
+		}
+		Expect(paths).To(Equal([]string{
+			"data[0].marker.newsize",
+			"data[0].newattribute",
+			"frames[0].data[0].newframe",
+			"layout.xaxis2.newaxisattribute",
+		}))
+
+		By("decoding the figure anyway")
+		Expect(fig.Data[0].(*grob.Scatter).Marker.Size).To(Equal(3.0))
+		Expect(fig.Layout.XAxis2.Type).To(Equal(grob.LayoutXaxisTypeLog))
+	})
+
+	It("Should accept known fields", func() {
+		fig := &grob.Fig{
+			Data:   grob.Traces{&grob.Scatter{Type: grob.TraceTypeScatter, Marker: &grob.ScatterMarker{Size: 3}}},
+			Layout: &grob.Layout{Title: &grob.LayoutTitle{Text: "title"}},
+		}
+		data, err := fig.ToPlotlyJSON()
+		Expect(err).To(BeNil())
+		Expect(grob.UnmarshalOptions{DisallowUnknownFields: true}.Unmarshal(data, &grob.Fig{})).To(Succeed())
+	})
+
+	It("Should report unknown fields of a trace", func() {
+		trace, err := grob.UnmarshalOptions{DisallowUnknownFields: true}.UnmarshalTrace([]byte(`{"type": "pie", "values": [1], "hole2": 0.5}`))
+		Expect(err).To(MatchError("1 invalid attributes:\nhole2: unknown field"))
+		Expect(trace.(*grob.Pie).Values).To(Equal([]interface{}{1.0}))
+	})
+
+	Describe("PreserveUnknownFields", func() {
+
+		python := []byte(`{"data":[{"type":"scatter","x":[1,2],"marker":{"size":3,"newsize":{"a": [1,  2]}},"newattribute":"<b>new</b>"}],` +
+			`"layout":{"xaxis2":{"type":"log","newaxisattribute":1e400},"newlayout":{}},"newroot":null}`)
+
+		It("Should write back the unknown fields unchanged", func() {
+			fig := &grob.Fig{}
+			Expect(grob.UnmarshalOptions{PreserveUnknownFields: true}.Unmarshal(python, fig)).To(Succeed())
+
+			data, err := fig.ToPlotlyJSON()
+			Expect(err).To(BeNil())
+			Expect(string(data)).To(ContainSubstring(`"newsize":{"a": [1,  2]}`))
+			Expect(string(data)).To(ContainSubstring(`"newattribute":"<b>new</b>"`))
+			Expect(string(data)).To(ContainSubstring(`"newaxisattribute":1e400`))
+			Expect(string(data)).To(ContainSubstring(`"newlayout":{}`))
+			Expect(string(data)).To(HaveSuffix(`,"newroot":null}`))
+
+			By("keeping them when the figure is modified")
+			fig.Data[0].(*grob.Scatter).Name = "renamed"
+			data, err = fig.MarshalJSON()
+			Expect(err).To(BeNil())
+			Expect(string(data)).To(ContainSubstring(`"newsize":{"a": [1,  2]}`))
+			Expect(string(data)).To(ContainSubstring(`"name":"renamed"`))
+
+			By("decoding it again with the same fields")
+			again := &grob.Fig{}
+			Expect(grob.UnmarshalOptions{PreserveUnknownFields: true}.Unmarshal(data, again)).To(Succeed())
+			dataAgain, err := again.MarshalJSON()
+			Expect(err).To(BeNil())
+			Expect(dataAgain).To(Equal(data))
+		})
+
+		It("Should add the fields to objects without known fields", func() {
+			fig := &grob.Fig{}
+			Expect(grob.UnmarshalOptions{PreserveUnknownFields: true}.Unmarshal([]byte(`{"data":[{"type":"bar","marker":{"new":1}}]}`), fig)).To(Succeed())
+			data, err := json.Marshal(fig)
+			Expect(err).To(BeNil())
+			Expect(string(data)).To(Equal(`{"data":[{"type":"bar","marker":{"new":1}}]}`))
+		})
+
+		It("Should keep the fields with their trace when the traces are reordered or removed", func() {
+			fig := &grob.Fig{}
+			Expect(grob.UnmarshalOptions{PreserveUnknownFields: true}.Unmarshal([]byte(`{"data":[`+
+				`{"type":"scatter","name":"a","marker":{"newa":1}},`+
+				`{"type":"bar","name":"b","newb":2},`+
+				`{"type":"pie","name":"c","marker":{"newc":3}}],`+
+				`"layout":{"xaxis":{"newaxis":4}}}`), fig)).To(Succeed())
+
+			fig.Data = grob.Traces{fig.Data[2], fig.Data[0]}
+			data, err := json.Marshal(fig)
+			Expect(err).To(BeNil())
+			Expect(string(data)).To(Equal(`{"data":[` +
+				`{"type":"pie","marker":{"newc":3},"name":"c"},` +
+				`{"type":"scatter","marker":{"newa":1},"name":"a"}],` +
+				`"layout":{"xaxis":{"newaxis":4}}}`))
+
+			By("keeping the fields of the layout in copies of the figure")
+			layout := *fig.Layout
+			copied := *fig
+			copied.Layout = &layout
+			data, err = json.Marshal(&copied)
+			Expect(err).To(BeNil())
+			Expect(string(data)).To(ContainSubstring(`"layout":{"xaxis":{"newaxis":4}}`))
+		})
+
+		It("Should drop them by default", func() {
+			fig := &grob.Fig{}
+			Expect(grob.UnmarshalOptions{}.Unmarshal(python, fig)).To(Succeed())
+			data, err := fig.ToPlotlyJSON()
+			Expect(err).To(BeNil())
+			Expect(string(data)).NotTo(ContainSubstring("new"))
+		})
+	})
+})
+
+var _ = Describe("FromPlotlyJSON", func() {
+
+	// the corpus has figures in the format of fig.to_json() of plotly.py, with plotly express traces, templates,
+	// typed arrays, subplots and frames
+	corpus, err := filepath.Glob(filepath.Join("testdata", "plotlypy", "*.json"))
+	if err != nil {
+		panic(err)
+	}
+
+	for _, path := range corpus {
+		path := path
+
+		It("Should write back "+filepath.Base(path)+" unchanged", func() {
+			data, err := ioutil.ReadFile(path)
+			Expect(err).To(BeNil())
+			fig, err := grob.FromPlotlyJSON(data)
+			Expect(err).To(BeNil())
+			Expect(fig.Data).NotTo(BeEmpty())
+
+			written, err := fig.ToPlotlyJSON()
+			Expect(err).To(BeNil())
+			Expect(written).To(MatchJSON(data))
+
+			By("keeping the rest of the figure when it is modified")
+			Expect(fig.UpdateLayout(grob.WithTitle("Modified in Go"))).To(Succeed())
+			written, err = fig.ToPlotlyJSON()
+			Expect(err).To(BeNil())
+			expected := map[string]interface{}{}
+			Expect(json.Unmarshal(data, &expected)).To(Succeed())
+			layout := expected["layout"].(map[string]interface{})
+			title, _ := layout["title"].(map[string]interface{})
+			if title == nil {
+				title = map[string]interface{}{}
+				layout["title"] = title
+			}
+			title["text"] = "Modified in Go"
+			expectedData, err := json.Marshal(expected)
+			Expect(err).To(BeNil())
+			Expect(written).To(MatchJSON(expectedData))
+		})
+	}
+
+	It("Should keep the zero values set by plotly.py", func() {
+		fig, err := grob.FromPlotlyJSON([]byte(`{"data":[{"type":"bar","marker":{"opacity":0}}],"layout":{"legend":{"tracegroupgap":0}}}`))
+		Expect(err).To(BeNil())
+		data, err := fig.ToPlotlyJSON()
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(Equal(`{"data":[{"type":"bar","marker":{"opacity":0}}],"layout":{"legend":{"tracegroupgap":0}}}`))
+
+		By("writing the values set later instead")
+		fig.Layout.Legend.Tracegroupgap = 10
+		data, err = fig.ToPlotlyJSON()
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(ContainSubstring(`"legend":{"tracegroupgap":10}`))
+	})
+
+	It("Should fail with invalid JSON", func() {
+		_, err := grob.FromPlotlyJSON([]byte(`{"data":`))
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,148 @@
+package grob
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Delta are the changes of a figure since the previous delta, written by MarshalDelta.
+// A client applies it to its copy of the figure, sets layout.datarevision and calls Plotly.react, which redraws the changed arrays.
+type Delta struct {
+	// Base is the datarevision the delta applies to, a client with another revision missed a delta and needs the whole figure
+	Base interface{} `json:"base"`
+	// Datarevision is the new layout.datarevision of the figure
+	Datarevision int `json:"datarevision"`
+	// Length is the number of traces of the figure, the traces after it were removed
+	Length int `json:"length"`
+	// Traces are the changed traces, by index
+	Traces []TraceDelta `json:"traces"`
+}
+
+// TraceDelta is a trace changed since the previous delta
+type TraceDelta struct {
+	Index int `json:"index"`
+	// Trace is the whole trace, if it was marked without attributes
+	Trace Trace `json:"trace,omitempty"`
+	// Attributes are the values of the changed attributes by path, such as marker.color
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+}
+
+// UnmarshalJSON is a custom unmarshal function to decode the trace.
+func (delta *TraceDelta) UnmarshalJSON(data []byte) error {
+	type traceDeltaAlias TraceDelta
+	tmp := struct {
+		*traceDeltaAlias
+		Trace json.RawMessage `json:"trace,omitempty"`
+	}{
+		traceDeltaAlias: (*traceDeltaAlias)(delta),
+	}
+	err := json.Unmarshal(data, &tmp)
+	if err != nil {
+		return err
+	}
+
+	delta.Trace = nil
+	if tmp.Trace != nil {
+		delta.Trace, err = UnmarshalTrace(tmp.Trace)
+	}
+	return err
+}
+
+// MarkDirty marks the attributes of the trace at the index as changed, such as y or marker.color, so they are sent by the next MarshalDelta.
+// Without attributes the whole trace is sent, which is needed for new traces.
+//
+//	trace.Y = append(trace.Y.([]float64), value)
+//	fig.MarkDirty(0, "y")
+//	delta, err := fig.MarshalDelta()
+func (fig *Fig) MarkDirty(index int, attributes ...string) {
+	if fig.dirty == nil {
+		fig.dirty = map[int][]string{}
+	}
+	marked, ok := fig.dirty[index]
+	switch {
+	case ok && len(marked) == 0:
+		// the whole trace is already sent
+	case ok && len(attributes) > 0:
+		fig.dirty[index] = append(marked, attributes...)
+	default:
+		fig.dirty[index] = append([]string{}, attributes...)
+	}
+}
+
+// MarshalDelta returns the JSON of the Delta with the traces and attributes marked by MarkDirty, for servers that push frequent updates
+// with minimal bandwidth. The datarevision of the layout is incremented, so Plotly.react redraws the arrays, and the marks are cleared.
+func (fig *Fig) MarshalDelta() ([]byte, error) {
+	if fig.Layout == nil {
+		fig.Layout = &Layout{}
+	}
+	delta := Delta{
+		Base:   fig.Layout.Datarevision,
+		Length: len(fig.Data),
+		Traces: []TraceDelta{},
+	}
+	switch revision := fig.Layout.Datarevision.(type) {
+	case int:
+		delta.Datarevision = revision + 1
+	case float64:
+		delta.Datarevision = int(revision) + 1
+	default:
+		delta.Datarevision = 1
+	}
+
+	indexes := make([]int, 0, len(fig.dirty))
+	for index := range fig.dirty {
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
+	for _, index := range indexes {
+		if index < 0 || index >= len(fig.Data) {
+			continue
+		}
+		trace := fig.Data[index]
+		if len(fig.dirty[index]) == 0 {
+			delta.Traces = append(delta.Traces, TraceDelta{Index: index, Trace: trace})
+			continue
+		}
+		attributes := map[string]interface{}{}
+		for _, path := range fig.dirty[index] {
+			value, err := getPath(reflect.ValueOf(trace), path)
+			if err != nil {
+				return nil, fmt.Errorf("cannot marshal delta of trace %d, %w", index, err)
+			}
+			attributes[path] = value
+		}
+		delta.Traces = append(delta.Traces, TraceDelta{Index: index, Attributes: attributes})
+	}
+
+	data, err := json.Marshal(delta)
+	if err != nil {
+		return nil, err
+	}
+	fig.Layout.Datarevision = delta.Datarevision
+	fig.dirty = nil
+	return data, nil
+}
+
+// getPath returns the attribute of the struct at the dot separated path of JSON names, nil if an object on the path is not set
+func getPath(object reflect.Value, path string) (interface{}, error) {
+	for _, part := range strings.Split(path, ".") {
+		for object.Kind() == reflect.Ptr || object.Kind() == reflect.Interface {
+			if object.IsNil() {
+				return nil, nil
+			}
+			object = object.Elem()
+		}
+		if object.Kind() != reflect.Struct {
+			return nil, fmt.Errorf("attribute %s is not an object", path)
+		}
+		field, ok := fieldByJSONName(object, part)
+		if !ok {
+			return nil, fmt.Errorf("attribute %s not found in %s", path, object.Type().Name())
+		}
+		object = field
+	}
+	return object.Interface(), nil
+}
@@ -0,0 +1,67 @@
+package grob_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var _ = Describe("Delta", func() {
+
+	It("Should marshal the marked attributes and bump the datarevision", func() {
+		fig := &grob.Fig{}
+		temperature, err := fig.AddScatter([]float64{1, 2}, []float64{20, 21})
+		Expect(err).To(BeNil())
+		fig.AddScatter([]float64{1, 2}, []float64{60, 65})
+
+		temperature.Y = append(temperature.Y.([]float64), 22)
+		temperature.X = append(temperature.X.([]float64), 3)
+		temperature.Marker = &grob.ScatterMarker{Color: "red"}
+		fig.MarkDirty(0, "x", "y")
+		fig.MarkDirty(0, "marker.color", "line.color")
+
+		data, err := fig.MarshalDelta()
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(MatchJSON(`{
+			"base": null,
+			"datarevision": 1,
+			"length": 2,
+			"traces": [{"index": 0, "attributes": {"x": [1, 2, 3], "y": [20, 21, 22], "marker.color": "red", "line.color": null}}]
+		}`))
+		Expect(fig.Layout.Datarevision).To(Equal(1))
+
+		By("sending nothing once the marks are cleared")
+		data, err = fig.MarshalDelta()
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(MatchJSON(`{"base": 1, "datarevision": 2, "length": 2, "traces": []}`))
+	})
+
+	It("Should marshal whole traces", func() {
+		fig := &grob.Fig{Layout: &grob.Layout{Datarevision: 41.0}}
+		fig.AddBar([]string{"a"}, []int{1})
+		fig.MarkDirty(0, "y")
+		fig.MarkDirty(0)
+		fig.MarkDirty(0, "x")
+		fig.MarkDirty(3)
+
+		data, err := fig.MarshalDelta()
+		Expect(err).To(BeNil())
+		delta := grob.Delta{}
+		Expect(json.Unmarshal(data, &delta)).To(Succeed())
+		Expect(delta.Datarevision).To(Equal(42))
+		Expect(delta.Traces).To(HaveLen(1))
+		Expect(delta.Traces[0].Trace.(*grob.Bar).X).To(Equal([]interface{}{"a"}))
+		Expect(string(data)).To(ContainSubstring(`"trace":{"type":"bar","x":["a"],"y":[1]}`))
+	})
+
+	It("Should fail on unknown attributes", func() {
+		fig := &grob.Fig{}
+		fig.AddScatter([]float64{1}, []float64{1})
+		fig.MarkDirty(0, "nothing")
+		_, err := fig.MarshalDelta()
+		Expect(err).NotTo(BeNil())
+	})
+})
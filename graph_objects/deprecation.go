@@ -0,0 +1,32 @@
+package grob
+
+import (
+	"log"
+	"sort"
+)
+
+var deprecationLogger *log.Logger
+
+// SetDeprecationLogger makes ToPlotlyJSON log a warning for every deprecated attribute of the figure, so they can be replaced
+// before plotly.js removes them. A nil logger, the default, disables the warnings.
+func SetDeprecationLogger(logger *log.Logger) {
+	deprecationLogger = logger
+}
+
+// Deprecations returns the deprecated attributes of the figure, such as titlefont or bardir, with the description of their replacement.
+// They are reported by Validate too.
+func (fig *Fig) Deprecations() ValidationErrors {
+	deprecated := fig.validate().deprecated
+	sort.SliceStable(deprecated, func(i, j int) bool { return deprecated[i].Path < deprecated[j].Path })
+	return deprecated
+}
+
+// warnDeprecations logs the deprecated attributes of the figure if there is a deprecation logger
+func (fig *Fig) warnDeprecations() {
+	if deprecationLogger == nil {
+		return
+	}
+	for _, deprecation := range fig.Deprecations() {
+		deprecationLogger.Print(deprecation.Error())
+	}
+}
@@ -0,0 +1,68 @@
+package grob_test
+
+import (
+	"bytes"
+	"log"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// legacyBar is a bar trace written with the attributes of older plotly.js versions
+type legacyBar struct {
+	Type   grob.TraceType `json:"type"`
+	Bardir string         `json:"bardir"`
+}
+
+func (trace *legacyBar) GetType() grob.TraceType {
+	return grob.TraceTypeBar
+}
+
+var _ = Describe("Deprecations", func() {
+
+	var fig *grob.Fig
+	BeforeEach(func() {
+		fig = &grob.Fig{
+			Data: grob.Traces{
+				&legacyBar{Type: grob.TraceTypeBar, Bardir: "h"},
+			},
+			Layout: &grob.Layout{
+				Annotations: []map[string]interface{}{
+					{"text": "note", "ref": "paper"},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		grob.SetDeprecationLogger(nil)
+	})
+
+	It("Should find the deprecated attributes", func() {
+		deprecations := fig.Deprecations()
+		Expect(deprecations).To(HaveLen(2))
+		Expect(deprecations[0].Path).To(Equal("data[0].bardir"))
+		Expect(deprecations[0].Message).To(ContainSubstring("orientation"))
+		Expect(deprecations[1].Path).To(Equal("layout.annotations[0].ref"))
+	})
+
+	It("Should report them in Validate", func() {
+		Expect(fig.Validate()).To(MatchError(ContainSubstring("data[0].bardir: deprecated, Renamed to `orientation`")))
+	})
+
+	It("Should log them when the figure is marshaled", func() {
+		buf := &bytes.Buffer{}
+		grob.SetDeprecationLogger(log.New(buf, "", 0))
+		_, err := fig.ToPlotlyJSON()
+		Expect(err).To(BeNil())
+		Expect(buf.String()).To(Equal("data[0].bardir: deprecated, Renamed to `orientation`.\nlayout.annotations[0].ref: deprecated, Obsolete. Set `xref` and `yref` separately instead.\n"))
+	})
+
+	It("Should not log without logger", func() {
+		Expect((&grob.Fig{}).Deprecations()).To(BeEmpty())
+		_, err := fig.ToPlotlyJSON()
+		Expect(err).To(BeNil())
+	})
+})
@@ -0,0 +1,69 @@
+package grob
+
+import (
+	"time"
+)
+
+// encode returns the JSON of the figure with its unknown attributes and reports it to the encode stats hook
+func (fig *Fig) encode(envelope bool) ([]byte, error) {
+	hook, _ := encodeStatsHook.Load().(func(stats EncodeStats))
+	var start time.Time
+	if hook != nil {
+		start = time.Now()
+	}
+	e := newEncoder()
+	fig.encodeJSON(e, envelope)
+	data, err := e.result()
+	if err == nil {
+		data, err = restoreFields(data, fig, fig.unknown)
+	}
+	if hook != nil {
+		hook(EncodeStats{Traces: len(fig.Data), Bytes: len(data), Duration: time.Since(start)})
+	}
+	return data, err
+}
+
+// encodeJSON writes the figure with the generated encoders of the traces, the layout, the config and the frames,
+// so the figure is not walked with reflection. With envelope, it writes the format of ToPlotlyJSON instead of the fields of Fig.
+func (fig *Fig) encodeJSON(e *encoder, envelope bool) {
+	e.objectStart()
+	if len(fig.Data) != 0 || envelope {
+		e.key(`data`)
+		e.buf = append(e.buf, '[')
+		for i, trace := range fig.Data {
+			if i > 0 {
+				e.buf = append(e.buf, ',')
+			}
+			e.trace(trace)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if fig.Layout != nil {
+		e.key(`layout`)
+		fig.Layout.encodeJSON(e)
+	} else if envelope {
+		e.key(`layout`)
+		e.objectStart()
+		e.objectEnd()
+	}
+	if fig.Config != nil {
+		e.key(`config`)
+		fig.Config.encodeJSON(e)
+	}
+	if len(fig.Frames) != 0 {
+		e.key(`frames`)
+		e.buf = append(e.buf, '[')
+		for i := range fig.Frames {
+			if i > 0 {
+				e.buf = append(e.buf, ',')
+			}
+			fig.Frames[i].encodeJSON(e)
+		}
+		e.buf = append(e.buf, ']')
+	}
+	if fig.Animation != nil && !envelope {
+		e.key(`animation`)
+		e.any(fig.Animation)
+	}
+	e.objectEnd()
+}
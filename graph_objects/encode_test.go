@@ -0,0 +1,152 @@
+package grob_test
+
+import (
+	"encoding/json"
+	"math"
+	"runtime"
+	"sync"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var _ = Describe("Generated MarshalJSON", func() {
+
+	It("Should write the same JSON as encoding/json", func() {
+		trace := &grob.Scatter{
+			Type:          grob.TraceTypeScatter,
+			Name:          "<b>sales</b> & \"costs\"\n",
+			X:             []float64{0, 1e-7, 1e21, -2.5},
+			Y:             []interface{}{1, "two", nil, []string{"a"}},
+			Text:          []string{"ünïcode", " "},
+			Mode:          grob.ScatterModeLines + "+" + grob.ScatterModeMarkers,
+			Cliponaxis:    grob.False,
+			Marker:        &grob.ScatterMarker{Size: 3.0, Color: "red", Colorbar: &grob.ScatterMarkerColorbar{Nticks: 4}},
+			Line:          &grob.ScatterLine{Width: 0.1},
+			Customdata:    map[string]interface{}{"b": 1, "a": [][]float64{{1}}},
+			Hovertemplate: "%{x}",
+		}
+		generated, err := json.Marshal(trace)
+		Expect(err).To(BeNil())
+		// the value is not addressable, encoding/json walks its fields with reflection
+		reflected, err := json.Marshal(*trace)
+		Expect(err).To(BeNil())
+		Expect(string(generated)).To(Equal(string(reflected)))
+	})
+
+	It("Should write the figure without the Fig methods", func() {
+		fig := &grob.Fig{
+			Data:   grob.Traces{&grob.Bar{Type: grob.TraceTypeBar, Y: []float64{1}}, nil},
+			Layout: &grob.Layout{Title: &grob.LayoutTitle{Text: "title"}},
+			Frames: []grob.Frame{{Name: "first", Data: grob.Traces{&grob.Bar{Y: []float64{2}}}}},
+		}
+		figBytes, err := json.Marshal(fig)
+		Expect(err).To(BeNil())
+		Expect(string(figBytes)).To(Equal(`{"data":[{"type":"bar","y":[1]},null],"layout":{"title":{"text":"title"}},"frames":[{"data":[{"y":[2]}],"name":"first"}]}`))
+	})
+
+	It("Should fail with numbers that JSON cannot represent", func() {
+		_, err := (&grob.Fig{Data: grob.Traces{&grob.Bar{Y: []float64{math.NaN()}}}}).ToPlotlyJSON()
+		Expect(err).To(HaveOccurred())
+
+		_, err = json.Marshal(&grob.Layout{Width: math.Inf(1)})
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("SetEncodeStatsHook", func() {
+
+	AfterEach(func() {
+		grob.SetEncodeStatsHook(nil)
+	})
+
+	It("Should report every encoded figure", func() {
+		stats := []grob.EncodeStats{}
+		grob.SetEncodeStatsHook(func(s grob.EncodeStats) {
+			stats = append(stats, s)
+		})
+		fig := &grob.Fig{Data: grob.Traces{&grob.Bar{Y: []float64{1, 2}}, &grob.Bar{}}}
+
+		data, err := fig.ToPlotlyJSON()
+		Expect(err).To(BeNil())
+		_, err = json.Marshal(fig)
+		Expect(err).To(BeNil())
+		_, err = (&grob.Fig{Data: grob.Traces{&grob.Bar{Y: []float64{math.Inf(1)}}}}).ToPlotlyJSON()
+		Expect(err).To(HaveOccurred())
+
+		Expect(stats).To(HaveLen(3))
+		Expect(stats[0].Traces).To(Equal(2))
+		Expect(stats[0].Bytes).To(Equal(len(data)))
+		Expect(stats[1].Bytes).To(BeNumerically(">", 0))
+		Expect(stats[2].Bytes).To(Equal(0))
+	})
+
+	It("Should replace the hook while figures are encoded", func() {
+		fig := &grob.Fig{Data: grob.Traces{&grob.Bar{Y: []float64{1, 2}}}}
+		wg := sync.WaitGroup{}
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 100; j++ {
+					fig.ToPlotlyJSON()
+				}
+			}()
+		}
+		for i := 0; i < 100; i++ {
+			grob.SetEncodeStatsHook(func(s grob.EncodeStats) {})
+			grob.SetEncodeStatsHook(nil)
+		}
+		wg.Wait()
+	})
+})
+
+// allocations returns the average number of allocations and of allocated bytes of f, like testing.AllocsPerRun
+func allocations(runs int, f func()) (allocs, bytes float64) {
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(1))
+	f()
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	for i := 0; i < runs; i++ {
+		f()
+	}
+	runtime.ReadMemStats(&after)
+	return float64(after.Mallocs-before.Mallocs) / float64(runs), float64(after.TotalAlloc-before.TotalAlloc) / float64(runs)
+}
+
+var _ = Describe("Encoding budget", func() {
+
+	BeforeEach(func() {
+		if raceEnabled {
+			Skip("allocations are not representative with the race detector")
+		}
+	})
+
+	// The figures of the benchmarks are encoded in the pooled buffer of the encoder,
+	// so an encoding allocates little more than the copy of the result
+	budget := func(fig *grob.Fig) {
+		data, err := fig.ToPlotlyJSON()
+		Expect(err).To(BeNil())
+
+		allocs, bytes := allocations(20, func() {
+			fig.ToPlotlyJSON()
+		})
+		Expect(allocs).To(BeNumerically("<=", 2), "allocations of ToPlotlyJSON")
+		Expect(bytes).To(BeNumerically("<=", 1.5*float64(len(data))), "bytes allocated by ToPlotlyJSON for %d bytes of JSON", len(data))
+
+		Expect(testing.AllocsPerRun(20, func() {
+			json.Marshal(fig)
+		})).To(BeNumerically("<=", 3), "allocations of MarshalJSON")
+	}
+
+	It("Should encode a line of 1e4 points within the budget", func() {
+		budget(scatterFig(1e4))
+	})
+
+	It("Should encode a dashboard within the budget", func() {
+		budget(dashboardFig())
+	})
+})
@@ -0,0 +1,74 @@
+package grob
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// AddTrace adds a single trace to the figure and returns it.
+// Like AddTraces, the Type of the trace is set if it is empty.
+func (fig *Fig) AddTrace(trace Trace) Trace {
+	fig.AddTraces(trace)
+	return trace
+}
+
+// AddScatter adds a scatter trace with the given x and y values and returns it, so other attributes can be set.
+// The options are applied before the trace is added, which is not added if one of them fails. Without options, the error is always nil.
+//
+//	scatter, err := fig.AddScatter([]float64{1, 2, 3}, []float64{4, 5, 6}, grob.SetTrace("mode", grob.ScatterModeLines))
+func (fig *Fig) AddScatter(x, y interface{}, opts ...TraceOption) (*Scatter, error) {
+	trace := &Scatter{
+		Type: TraceTypeScatter,
+		X:    x,
+		Y:    y,
+	}
+	err := applyTraceOptions(trace, opts)
+	if err != nil {
+		return nil, err
+	}
+	fig.AddTraces(trace)
+	return trace, nil
+}
+
+// AddBar adds a bar trace with the given x and y values and returns it, so other attributes can be set.
+// Like AddScatter, the options are applied before the trace is added.
+func (fig *Fig) AddBar(x, y interface{}, opts ...TraceOption) (*Bar, error) {
+	trace := &Bar{
+		Type: TraceTypeBar,
+		X:    x,
+		Y:    y,
+	}
+	err := applyTraceOptions(trace, opts)
+	if err != nil {
+		return nil, err
+	}
+	fig.AddTraces(trace)
+	return trace, nil
+}
+
+// applyTraceOptions applies the options to the new trace, it stops at the first option that fails
+func applyTraceOptions(trace Trace, opts []TraceOption) error {
+	for _, opt := range opts {
+		err := opt(trace)
+		if err != nil {
+			return fmt.Errorf("cannot add %s trace, %w", trace.GetType(), err)
+		}
+	}
+	return nil
+}
+
+// inferType sets the Type field of the trace from GetType if it is empty, so it is not needed to set it manually.
+// Traces that are not generated structs are not modified.
+func inferType(trace Trace) {
+	value := reflect.ValueOf(trace)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return
+	}
+	field := value.Elem().FieldByName("Type")
+	if !field.IsValid() || !field.CanSet() || field.Type() != reflect.TypeOf(TraceType("")) {
+		return
+	}
+	if field.String() == "" {
+		field.SetString(string(trace.GetType()))
+	}
+}
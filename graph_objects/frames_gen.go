@@ -0,0 +1,306 @@
+package grob
+
+// Code generated by go-plotly/generator. DO NOT EDIT.
+
+// Frame A frame of an animation. It contains the data and layout properties that change on each step of the animation
+type Frame struct {
+
+	// Baseframe
+	// arrayOK: false
+	// type: string
+	// The name of the frame into which this frame's properties are merged before applying. This is used to unify properties and avoid needing to specify the same values for the same properties in multiple frames.
+	Baseframe String `json:"baseframe,omitempty"`
+
+	// Data
+	// arrayOK: false
+	// type: any
+	// A list of traces this frame modifies. The format is identical to the normal trace definition.
+	Data Traces `json:"data,omitempty"`
+
+	// Group
+	// arrayOK: false
+	// type: string
+	// An identifier that specifies the group to which the frame belongs, used by animate to select a subset of frames.
+	Group String `json:"group,omitempty"`
+
+	// Layout
+	// arrayOK: false
+	// type: any
+	// Layout properties which this frame modifies. The format is identical to the normal layout definition.
+	Layout *Layout `json:"layout,omitempty"`
+
+	// Name
+	// arrayOK: false
+	// type: string
+	// A label by which to identify the frame
+	Name String `json:"name,omitempty"`
+
+	// Traces
+	// arrayOK: false
+	// type: any
+	// A list of trace indices that identify the respective traces in the data attribute
+	Traces interface{} `json:"traces,omitempty"`
+}
+
+// Animation Animation options to be used with Plotly.animate, such as the duration of the frames and the transitions
+type Animation struct {
+
+	// Direction
+	// default: forward
+	// type: enumerated
+	// The direction in which to play the frames triggered by the animation call
+	Direction AnimationDirection `json:"direction,omitempty"`
+
+	// Frame
+	// role: Object
+	Frame *AnimationFrame `json:"frame,omitempty"`
+
+	// Fromcurrent
+	// arrayOK: false
+	// type: boolean
+	// Play frames starting at the current frame instead of the beginning.
+	Fromcurrent Bool `json:"fromcurrent,omitempty"`
+
+	// Mode
+	// default: afterall
+	// type: enumerated
+	// Describes how a new animate call interacts with currently-running animations. If `immediate`, current animations are interrupted and the new animation is started. If `next`, the current frame is allowed to complete, after which the new animation is started. If `afterall` all existing frames are animated to completion before the new animation is started.
+	Mode AnimationMode `json:"mode,omitempty"`
+
+	// Transition
+	// role: Object
+	Transition *AnimationTransition `json:"transition,omitempty"`
+}
+
+// AnimationFrame
+type AnimationFrame struct {
+
+	// Duration
+	// arrayOK: false
+	// type: number
+	// The duration in milliseconds of each frame. If greater than the frame duration, it will be limited to the frame duration.
+	Duration float64 `json:"duration,omitempty"`
+
+	// Redraw
+	// arrayOK: false
+	// type: boolean
+	// Redraw the plot at completion of the transition. This is desirable for transitions that include properties that cannot be transitioned, but may significantly slow down updates that do not require a full redraw of the plot
+	Redraw Bool `json:"redraw,omitempty"`
+}
+
+// AnimationTransition
+type AnimationTransition struct {
+
+	// Duration
+	// arrayOK: false
+	// type: number
+	// The duration of the transition, in milliseconds. If equal to zero, updates are synchronous.
+	Duration float64 `json:"duration,omitempty"`
+
+	// Easing
+	// default: cubic-in-out
+	// type: enumerated
+	// The easing function used for the transition
+	Easing AnimationTransitionEasing `json:"easing,omitempty"`
+
+	// Ordering
+	// default: layout first
+	// type: enumerated
+	// Determines whether the figure's layout or traces smoothly transitions during updates that make both traces and layout change.
+	Ordering AnimationTransitionOrdering `json:"ordering,omitempty"`
+}
+
+// AnimationDirection The direction in which to play the frames triggered by the animation call
+type AnimationDirection string
+
+const (
+	AnimationDirectionForward AnimationDirection = "forward"
+	AnimationDirectionReverse AnimationDirection = "reverse"
+)
+
+// AnimationMode Describes how a new animate call interacts with currently-running animations. If `immediate`, current animations are interrupted and the new animation is started. If `next`, the current frame is allowed to complete, after which the new animation is started. If `afterall` all existing frames are animated to completion before the new animation is started.
+type AnimationMode string
+
+const (
+	AnimationModeImmediate AnimationMode = "immediate"
+	AnimationModeNext      AnimationMode = "next"
+	AnimationModeAfterall  AnimationMode = "afterall"
+)
+
+// AnimationTransitionEasing The easing function used for the transition
+type AnimationTransitionEasing string
+
+const (
+	AnimationTransitionEasingLinear       AnimationTransitionEasing = "linear"
+	AnimationTransitionEasingQuad         AnimationTransitionEasing = "quad"
+	AnimationTransitionEasingCubic        AnimationTransitionEasing = "cubic"
+	AnimationTransitionEasingSin          AnimationTransitionEasing = "sin"
+	AnimationTransitionEasingExp          AnimationTransitionEasing = "exp"
+	AnimationTransitionEasingCircle       AnimationTransitionEasing = "circle"
+	AnimationTransitionEasingElastic      AnimationTransitionEasing = "elastic"
+	AnimationTransitionEasingBack         AnimationTransitionEasing = "back"
+	AnimationTransitionEasingBounce       AnimationTransitionEasing = "bounce"
+	AnimationTransitionEasingLinearIn     AnimationTransitionEasing = "linear-in"
+	AnimationTransitionEasingQuadIn       AnimationTransitionEasing = "quad-in"
+	AnimationTransitionEasingCubicIn      AnimationTransitionEasing = "cubic-in"
+	AnimationTransitionEasingSinIn        AnimationTransitionEasing = "sin-in"
+	AnimationTransitionEasingExpIn        AnimationTransitionEasing = "exp-in"
+	AnimationTransitionEasingCircleIn     AnimationTransitionEasing = "circle-in"
+	AnimationTransitionEasingElasticIn    AnimationTransitionEasing = "elastic-in"
+	AnimationTransitionEasingBackIn       AnimationTransitionEasing = "back-in"
+	AnimationTransitionEasingBounceIn     AnimationTransitionEasing = "bounce-in"
+	AnimationTransitionEasingLinearOut    AnimationTransitionEasing = "linear-out"
+	AnimationTransitionEasingQuadOut      AnimationTransitionEasing = "quad-out"
+	AnimationTransitionEasingCubicOut     AnimationTransitionEasing = "cubic-out"
+	AnimationTransitionEasingSinOut       AnimationTransitionEasing = "sin-out"
+	AnimationTransitionEasingExpOut       AnimationTransitionEasing = "exp-out"
+	AnimationTransitionEasingCircleOut    AnimationTransitionEasing = "circle-out"
+	AnimationTransitionEasingElasticOut   AnimationTransitionEasing = "elastic-out"
+	AnimationTransitionEasingBackOut      AnimationTransitionEasing = "back-out"
+	AnimationTransitionEasingBounceOut    AnimationTransitionEasing = "bounce-out"
+	AnimationTransitionEasingLinearInOut  AnimationTransitionEasing = "linear-in-out"
+	AnimationTransitionEasingQuadInOut    AnimationTransitionEasing = "quad-in-out"
+	AnimationTransitionEasingCubicInOut   AnimationTransitionEasing = "cubic-in-out"
+	AnimationTransitionEasingSinInOut     AnimationTransitionEasing = "sin-in-out"
+	AnimationTransitionEasingExpInOut     AnimationTransitionEasing = "exp-in-out"
+	AnimationTransitionEasingCircleInOut  AnimationTransitionEasing = "circle-in-out"
+	AnimationTransitionEasingElasticInOut AnimationTransitionEasing = "elastic-in-out"
+	AnimationTransitionEasingBackInOut    AnimationTransitionEasing = "back-in-out"
+	AnimationTransitionEasingBounceInOut  AnimationTransitionEasing = "bounce-in-out"
+)
+
+// AnimationTransitionOrdering Determines whether the figure's layout or traces smoothly transitions during updates that make both traces and layout change.
+type AnimationTransitionOrdering string
+
+const (
+	AnimationTransitionOrderingLayoutFirst AnimationTransitionOrdering = "layout first"
+	AnimationTransitionOrderingTracesFirst AnimationTransitionOrdering = "traces first"
+)
+
+// MarshalJSON encodes Frame with precomputed fields. The output is the same as encoding/json.
+func (obj *Frame) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *Frame) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Baseframe != nil {
+		e.key(`baseframe`)
+		e.any(obj.Baseframe)
+	}
+	if len(obj.Data) != 0 {
+		e.key(`data`)
+		e.any(obj.Data)
+	}
+	if obj.Group != nil {
+		e.key(`group`)
+		e.any(obj.Group)
+	}
+	if obj.Layout != nil {
+		e.key(`layout`)
+		obj.Layout.encodeJSON(e)
+	}
+	if obj.Name != nil {
+		e.key(`name`)
+		e.any(obj.Name)
+	}
+	if obj.Traces != nil {
+		e.key(`traces`)
+		e.any(obj.Traces)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes Animation with precomputed fields. The output is the same as encoding/json.
+func (obj *Animation) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *Animation) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Direction != "" {
+		e.key(`direction`)
+		e.string(string(obj.Direction))
+	}
+	if obj.Frame != nil {
+		e.key(`frame`)
+		obj.Frame.encodeJSON(e)
+	}
+	if obj.Fromcurrent != nil {
+		e.key(`fromcurrent`)
+		e.bool(*obj.Fromcurrent)
+	}
+	if obj.Mode != "" {
+		e.key(`mode`)
+		e.string(string(obj.Mode))
+	}
+	if obj.Transition != nil {
+		e.key(`transition`)
+		obj.Transition.encodeJSON(e)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes AnimationFrame with precomputed fields. The output is the same as encoding/json.
+func (obj *AnimationFrame) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *AnimationFrame) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Duration != 0 {
+		e.key(`duration`)
+		e.float(float64(obj.Duration))
+	}
+	if obj.Redraw != nil {
+		e.key(`redraw`)
+		e.bool(*obj.Redraw)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes AnimationTransition with precomputed fields. The output is the same as encoding/json.
+func (obj *AnimationTransition) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *AnimationTransition) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Duration != 0 {
+		e.key(`duration`)
+		e.float(float64(obj.Duration))
+	}
+	if obj.Easing != "" {
+		e.key(`easing`)
+		e.string(string(obj.Easing))
+	}
+	if obj.Ordering != "" {
+		e.key(`ordering`)
+		e.string(string(obj.Ordering))
+	}
+	e.objectEnd()
+}
@@ -0,0 +1,13 @@
+package grob_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestGraphObjects(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Graph Objects Suite")
+}
@@ -8,6 +8,11 @@ func (trace *Histogram) GetType() TraceType {
 	return TraceTypeHistogram
 }
 
+// Validate checks the trace against the constraints of the schema, see ValidateTrace
+func (trace *Histogram) Validate() error {
+	return ValidateTrace(trace)
+}
+
 // Histogram The sample data from which statistics are computed is set in `x` for vertically spanning histograms and in `y` for horizontally spanning histograms. Binning options are set `xbins` and `ybins` respectively if no aggregation data is provided.
 type Histogram struct {
 
@@ -1272,13 +1277,25 @@ const (
 type HistogramHistnorm string
 
 const (
-	HistogramHistnormEmpty              HistogramHistnorm = ""
+	HistogramHistnormEmpty              HistogramHistnorm = emptyEnum
 	HistogramHistnormPercent            HistogramHistnorm = "percent"
 	HistogramHistnormProbability        HistogramHistnorm = "probability"
 	HistogramHistnormDensity            HistogramHistnorm = "density"
 	HistogramHistnormProbabilityDensity HistogramHistnorm = "probability density"
 )
 
+// MarshalJSON writes the empty value as "", the zero value is unset and omitted
+func (v HistogramHistnorm) MarshalJSON() ([]byte, error) {
+	return marshalEnum(string(v))
+}
+
+// UnmarshalJSON reads "" as the empty value
+func (v *HistogramHistnorm) UnmarshalJSON(data []byte) error {
+	s, err := unmarshalEnum(data)
+	*v = HistogramHistnorm(s)
+	return err
+}
+
 // HistogramHoverlabelAlign Sets the horizontal alignment of the text content within hover label box. Has an effect only if the hover label text spans more two or more lines
 type HistogramHoverlabelAlign string
 
@@ -1373,9 +1390,21 @@ type HistogramMarkerColorbarTicks string
 const (
 	HistogramMarkerColorbarTicksOutside HistogramMarkerColorbarTicks = "outside"
 	HistogramMarkerColorbarTicksInside  HistogramMarkerColorbarTicks = "inside"
-	HistogramMarkerColorbarTicksEmpty   HistogramMarkerColorbarTicks = ""
+	HistogramMarkerColorbarTicksEmpty   HistogramMarkerColorbarTicks = emptyEnum
 )
 
+// MarshalJSON writes the empty value as "", the zero value is unset and omitted
+func (v HistogramMarkerColorbarTicks) MarshalJSON() ([]byte, error) {
+	return marshalEnum(string(v))
+}
+
+// UnmarshalJSON reads "" as the empty value
+func (v *HistogramMarkerColorbarTicks) UnmarshalJSON(data []byte) error {
+	s, err := unmarshalEnum(data)
+	*v = HistogramMarkerColorbarTicks(s)
+	return err
+}
+
 // HistogramMarkerColorbarTitleSide Determines the location of color bar's title with respect to the color bar. Note that the title's location used to be set by the now deprecated `titleside` attribute.
 type HistogramMarkerColorbarTitleSide string
 
@@ -1480,3 +1509,1119 @@ const (
 	HistogramHoverinfoNone HistogramHoverinfo = "none"
 	HistogramHoverinfoSkip HistogramHoverinfo = "skip"
 )
+
+// MarshalJSON encodes Histogram with precomputed fields. The output is the same as encoding/json.
+func (obj *Histogram) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *Histogram) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Type != "" {
+		e.key(`type`)
+		e.string(string(obj.Type))
+	}
+	if obj.Alignmentgroup != nil {
+		e.key(`alignmentgroup`)
+		e.any(obj.Alignmentgroup)
+	}
+	if obj.Autobinx != nil {
+		e.key(`autobinx`)
+		e.bool(*obj.Autobinx)
+	}
+	if obj.Autobiny != nil {
+		e.key(`autobiny`)
+		e.bool(*obj.Autobiny)
+	}
+	if obj.Bingroup != nil {
+		e.key(`bingroup`)
+		e.any(obj.Bingroup)
+	}
+	if obj.Cumulative != nil {
+		e.key(`cumulative`)
+		obj.Cumulative.encodeJSON(e)
+	}
+	if obj.Customdata != nil {
+		e.key(`customdata`)
+		e.any(obj.Customdata)
+	}
+	if obj.Customdatasrc != nil {
+		e.key(`customdatasrc`)
+		e.any(obj.Customdatasrc)
+	}
+	if obj.ErrorX != nil {
+		e.key(`error_x`)
+		obj.ErrorX.encodeJSON(e)
+	}
+	if obj.ErrorY != nil {
+		e.key(`error_y`)
+		obj.ErrorY.encodeJSON(e)
+	}
+	if obj.Histfunc != "" {
+		e.key(`histfunc`)
+		e.string(string(obj.Histfunc))
+	}
+	if obj.Histnorm != "" {
+		e.key(`histnorm`)
+		e.enum(string(obj.Histnorm))
+	}
+	if obj.Hoverinfo != "" {
+		e.key(`hoverinfo`)
+		e.string(string(obj.Hoverinfo))
+	}
+	if obj.Hoverinfosrc != nil {
+		e.key(`hoverinfosrc`)
+		e.any(obj.Hoverinfosrc)
+	}
+	if obj.Hoverlabel != nil {
+		e.key(`hoverlabel`)
+		obj.Hoverlabel.encodeJSON(e)
+	}
+	if obj.Hovertemplate != nil {
+		e.key(`hovertemplate`)
+		e.any(obj.Hovertemplate)
+	}
+	if obj.Hovertemplatesrc != nil {
+		e.key(`hovertemplatesrc`)
+		e.any(obj.Hovertemplatesrc)
+	}
+	if obj.Hovertext != nil {
+		e.key(`hovertext`)
+		e.any(obj.Hovertext)
+	}
+	if obj.Hovertextsrc != nil {
+		e.key(`hovertextsrc`)
+		e.any(obj.Hovertextsrc)
+	}
+	if obj.Ids != nil {
+		e.key(`ids`)
+		e.any(obj.Ids)
+	}
+	if obj.Idssrc != nil {
+		e.key(`idssrc`)
+		e.any(obj.Idssrc)
+	}
+	if obj.Legendgroup != nil {
+		e.key(`legendgroup`)
+		e.any(obj.Legendgroup)
+	}
+	if obj.Marker != nil {
+		e.key(`marker`)
+		obj.Marker.encodeJSON(e)
+	}
+	if obj.Meta != nil {
+		e.key(`meta`)
+		e.any(obj.Meta)
+	}
+	if obj.Metasrc != nil {
+		e.key(`metasrc`)
+		e.any(obj.Metasrc)
+	}
+	if obj.Name != nil {
+		e.key(`name`)
+		e.any(obj.Name)
+	}
+	if obj.Nbinsx != 0 {
+		e.key(`nbinsx`)
+		e.int(int64(obj.Nbinsx))
+	}
+	if obj.Nbinsy != 0 {
+		e.key(`nbinsy`)
+		e.int(int64(obj.Nbinsy))
+	}
+	if obj.Offsetgroup != nil {
+		e.key(`offsetgroup`)
+		e.any(obj.Offsetgroup)
+	}
+	if obj.Opacity != 0 {
+		e.key(`opacity`)
+		e.float(float64(obj.Opacity))
+	}
+	if obj.Orientation != "" {
+		e.key(`orientation`)
+		e.string(string(obj.Orientation))
+	}
+	if obj.Selected != nil {
+		e.key(`selected`)
+		obj.Selected.encodeJSON(e)
+	}
+	if obj.Selectedpoints != nil {
+		e.key(`selectedpoints`)
+		e.any(obj.Selectedpoints)
+	}
+	if obj.Showlegend != nil {
+		e.key(`showlegend`)
+		e.bool(*obj.Showlegend)
+	}
+	if obj.Stream != nil {
+		e.key(`stream`)
+		obj.Stream.encodeJSON(e)
+	}
+	if obj.Text != nil {
+		e.key(`text`)
+		e.any(obj.Text)
+	}
+	if obj.Textsrc != nil {
+		e.key(`textsrc`)
+		e.any(obj.Textsrc)
+	}
+	if obj.Transforms != nil {
+		e.key(`transforms`)
+		e.any(obj.Transforms)
+	}
+	if obj.Uid != nil {
+		e.key(`uid`)
+		e.any(obj.Uid)
+	}
+	if obj.Uirevision != nil {
+		e.key(`uirevision`)
+		e.any(obj.Uirevision)
+	}
+	if obj.Unselected != nil {
+		e.key(`unselected`)
+		obj.Unselected.encodeJSON(e)
+	}
+	if obj.Visible != nil {
+		e.key(`visible`)
+		e.any(obj.Visible)
+	}
+	if obj.X != nil {
+		e.key(`x`)
+		e.any(obj.X)
+	}
+	if obj.Xaxis != nil {
+		e.key(`xaxis`)
+		e.any(obj.Xaxis)
+	}
+	if obj.Xbins != nil {
+		e.key(`xbins`)
+		obj.Xbins.encodeJSON(e)
+	}
+	if obj.Xcalendar != "" {
+		e.key(`xcalendar`)
+		e.string(string(obj.Xcalendar))
+	}
+	if obj.Xsrc != nil {
+		e.key(`xsrc`)
+		e.any(obj.Xsrc)
+	}
+	if obj.Y != nil {
+		e.key(`y`)
+		e.any(obj.Y)
+	}
+	if obj.Yaxis != nil {
+		e.key(`yaxis`)
+		e.any(obj.Yaxis)
+	}
+	if obj.Ybins != nil {
+		e.key(`ybins`)
+		obj.Ybins.encodeJSON(e)
+	}
+	if obj.Ycalendar != "" {
+		e.key(`ycalendar`)
+		e.string(string(obj.Ycalendar))
+	}
+	if obj.Ysrc != nil {
+		e.key(`ysrc`)
+		e.any(obj.Ysrc)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes HistogramCumulative with precomputed fields. The output is the same as encoding/json.
+func (obj *HistogramCumulative) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *HistogramCumulative) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Currentbin != "" {
+		e.key(`currentbin`)
+		e.string(string(obj.Currentbin))
+	}
+	if obj.Direction != "" {
+		e.key(`direction`)
+		e.string(string(obj.Direction))
+	}
+	if obj.Enabled != nil {
+		e.key(`enabled`)
+		e.bool(*obj.Enabled)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes HistogramErrorX with precomputed fields. The output is the same as encoding/json.
+func (obj *HistogramErrorX) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *HistogramErrorX) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Array != nil {
+		e.key(`array`)
+		e.any(obj.Array)
+	}
+	if obj.Arrayminus != nil {
+		e.key(`arrayminus`)
+		e.any(obj.Arrayminus)
+	}
+	if obj.Arrayminussrc != nil {
+		e.key(`arrayminussrc`)
+		e.any(obj.Arrayminussrc)
+	}
+	if obj.Arraysrc != nil {
+		e.key(`arraysrc`)
+		e.any(obj.Arraysrc)
+	}
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.CopyYstyle != nil {
+		e.key(`copy_ystyle`)
+		e.bool(*obj.CopyYstyle)
+	}
+	if obj.Symmetric != nil {
+		e.key(`symmetric`)
+		e.bool(*obj.Symmetric)
+	}
+	if obj.Thickness != 0 {
+		e.key(`thickness`)
+		e.float(float64(obj.Thickness))
+	}
+	if obj.Traceref != 0 {
+		e.key(`traceref`)
+		e.int(int64(obj.Traceref))
+	}
+	if obj.Tracerefminus != 0 {
+		e.key(`tracerefminus`)
+		e.int(int64(obj.Tracerefminus))
+	}
+	if obj.Type != "" {
+		e.key(`type`)
+		e.string(string(obj.Type))
+	}
+	if obj.Value != 0 {
+		e.key(`value`)
+		e.float(float64(obj.Value))
+	}
+	if obj.Valueminus != 0 {
+		e.key(`valueminus`)
+		e.float(float64(obj.Valueminus))
+	}
+	if obj.Visible != nil {
+		e.key(`visible`)
+		e.bool(*obj.Visible)
+	}
+	if obj.Width != 0 {
+		e.key(`width`)
+		e.float(float64(obj.Width))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes HistogramErrorY with precomputed fields. The output is the same as encoding/json.
+func (obj *HistogramErrorY) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *HistogramErrorY) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Array != nil {
+		e.key(`array`)
+		e.any(obj.Array)
+	}
+	if obj.Arrayminus != nil {
+		e.key(`arrayminus`)
+		e.any(obj.Arrayminus)
+	}
+	if obj.Arrayminussrc != nil {
+		e.key(`arrayminussrc`)
+		e.any(obj.Arrayminussrc)
+	}
+	if obj.Arraysrc != nil {
+		e.key(`arraysrc`)
+		e.any(obj.Arraysrc)
+	}
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Symmetric != nil {
+		e.key(`symmetric`)
+		e.bool(*obj.Symmetric)
+	}
+	if obj.Thickness != 0 {
+		e.key(`thickness`)
+		e.float(float64(obj.Thickness))
+	}
+	if obj.Traceref != 0 {
+		e.key(`traceref`)
+		e.int(int64(obj.Traceref))
+	}
+	if obj.Tracerefminus != 0 {
+		e.key(`tracerefminus`)
+		e.int(int64(obj.Tracerefminus))
+	}
+	if obj.Type != "" {
+		e.key(`type`)
+		e.string(string(obj.Type))
+	}
+	if obj.Value != 0 {
+		e.key(`value`)
+		e.float(float64(obj.Value))
+	}
+	if obj.Valueminus != 0 {
+		e.key(`valueminus`)
+		e.float(float64(obj.Valueminus))
+	}
+	if obj.Visible != nil {
+		e.key(`visible`)
+		e.bool(*obj.Visible)
+	}
+	if obj.Width != 0 {
+		e.key(`width`)
+		e.float(float64(obj.Width))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes HistogramHoverlabelFont with precomputed fields. The output is the same as encoding/json.
+func (obj *HistogramHoverlabelFont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *HistogramHoverlabelFont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Colorsrc != nil {
+		e.key(`colorsrc`)
+		e.any(obj.Colorsrc)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Familysrc != nil {
+		e.key(`familysrc`)
+		e.any(obj.Familysrc)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	if obj.Sizesrc != nil {
+		e.key(`sizesrc`)
+		e.any(obj.Sizesrc)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes HistogramHoverlabel with precomputed fields. The output is the same as encoding/json.
+func (obj *HistogramHoverlabel) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *HistogramHoverlabel) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Align != "" {
+		e.key(`align`)
+		e.string(string(obj.Align))
+	}
+	if obj.Alignsrc != nil {
+		e.key(`alignsrc`)
+		e.any(obj.Alignsrc)
+	}
+	if obj.Bgcolor != nil {
+		e.key(`bgcolor`)
+		e.any(obj.Bgcolor)
+	}
+	if obj.Bgcolorsrc != nil {
+		e.key(`bgcolorsrc`)
+		e.any(obj.Bgcolorsrc)
+	}
+	if obj.Bordercolor != nil {
+		e.key(`bordercolor`)
+		e.any(obj.Bordercolor)
+	}
+	if obj.Bordercolorsrc != nil {
+		e.key(`bordercolorsrc`)
+		e.any(obj.Bordercolorsrc)
+	}
+	if obj.Font != nil {
+		e.key(`font`)
+		obj.Font.encodeJSON(e)
+	}
+	if obj.Namelength != 0 {
+		e.key(`namelength`)
+		e.int(int64(obj.Namelength))
+	}
+	if obj.Namelengthsrc != nil {
+		e.key(`namelengthsrc`)
+		e.any(obj.Namelengthsrc)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes HistogramMarkerColorbarTickfont with precomputed fields. The output is the same as encoding/json.
+func (obj *HistogramMarkerColorbarTickfont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *HistogramMarkerColorbarTickfont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes HistogramMarkerColorbarTitleFont with precomputed fields. The output is the same as encoding/json.
+func (obj *HistogramMarkerColorbarTitleFont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *HistogramMarkerColorbarTitleFont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes HistogramMarkerColorbarTitle with precomputed fields. The output is the same as encoding/json.
+func (obj *HistogramMarkerColorbarTitle) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *HistogramMarkerColorbarTitle) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Font != nil {
+		e.key(`font`)
+		obj.Font.encodeJSON(e)
+	}
+	if obj.Side != "" {
+		e.key(`side`)
+		e.string(string(obj.Side))
+	}
+	if obj.Text != nil {
+		e.key(`text`)
+		e.any(obj.Text)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes HistogramMarkerColorbar with precomputed fields. The output is the same as encoding/json.
+func (obj *HistogramMarkerColorbar) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *HistogramMarkerColorbar) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Bgcolor != nil {
+		e.key(`bgcolor`)
+		e.any(obj.Bgcolor)
+	}
+	if obj.Bordercolor != nil {
+		e.key(`bordercolor`)
+		e.any(obj.Bordercolor)
+	}
+	if obj.Borderwidth != 0 {
+		e.key(`borderwidth`)
+		e.float(float64(obj.Borderwidth))
+	}
+	if obj.Dtick != nil {
+		e.key(`dtick`)
+		e.any(obj.Dtick)
+	}
+	if obj.Exponentformat != "" {
+		e.key(`exponentformat`)
+		e.string(string(obj.Exponentformat))
+	}
+	if obj.Len != 0 {
+		e.key(`len`)
+		e.float(float64(obj.Len))
+	}
+	if obj.Lenmode != "" {
+		e.key(`lenmode`)
+		e.string(string(obj.Lenmode))
+	}
+	if obj.Minexponent != 0 {
+		e.key(`minexponent`)
+		e.float(float64(obj.Minexponent))
+	}
+	if obj.Nticks != 0 {
+		e.key(`nticks`)
+		e.int(int64(obj.Nticks))
+	}
+	if obj.Outlinecolor != nil {
+		e.key(`outlinecolor`)
+		e.any(obj.Outlinecolor)
+	}
+	if obj.Outlinewidth != 0 {
+		e.key(`outlinewidth`)
+		e.float(float64(obj.Outlinewidth))
+	}
+	if obj.Separatethousands != nil {
+		e.key(`separatethousands`)
+		e.bool(*obj.Separatethousands)
+	}
+	if obj.Showexponent != "" {
+		e.key(`showexponent`)
+		e.string(string(obj.Showexponent))
+	}
+	if obj.Showticklabels != nil {
+		e.key(`showticklabels`)
+		e.bool(*obj.Showticklabels)
+	}
+	if obj.Showtickprefix != "" {
+		e.key(`showtickprefix`)
+		e.string(string(obj.Showtickprefix))
+	}
+	if obj.Showticksuffix != "" {
+		e.key(`showticksuffix`)
+		e.string(string(obj.Showticksuffix))
+	}
+	if obj.Thickness != 0 {
+		e.key(`thickness`)
+		e.float(float64(obj.Thickness))
+	}
+	if obj.Thicknessmode != "" {
+		e.key(`thicknessmode`)
+		e.string(string(obj.Thicknessmode))
+	}
+	if obj.Tick0 != nil {
+		e.key(`tick0`)
+		e.any(obj.Tick0)
+	}
+	if obj.Tickangle != 0 {
+		e.key(`tickangle`)
+		e.float(float64(obj.Tickangle))
+	}
+	if obj.Tickcolor != nil {
+		e.key(`tickcolor`)
+		e.any(obj.Tickcolor)
+	}
+	if obj.Tickfont != nil {
+		e.key(`tickfont`)
+		obj.Tickfont.encodeJSON(e)
+	}
+	if obj.Tickformat != nil {
+		e.key(`tickformat`)
+		e.any(obj.Tickformat)
+	}
+	if obj.Tickformatstops != nil {
+		e.key(`tickformatstops`)
+		e.any(obj.Tickformatstops)
+	}
+	if obj.Ticklabelposition != "" {
+		e.key(`ticklabelposition`)
+		e.string(string(obj.Ticklabelposition))
+	}
+	if obj.Ticklen != 0 {
+		e.key(`ticklen`)
+		e.float(float64(obj.Ticklen))
+	}
+	if obj.Tickmode != "" {
+		e.key(`tickmode`)
+		e.string(string(obj.Tickmode))
+	}
+	if obj.Tickprefix != nil {
+		e.key(`tickprefix`)
+		e.any(obj.Tickprefix)
+	}
+	if obj.Ticks != "" {
+		e.key(`ticks`)
+		e.enum(string(obj.Ticks))
+	}
+	if obj.Ticksuffix != nil {
+		e.key(`ticksuffix`)
+		e.any(obj.Ticksuffix)
+	}
+	if obj.Ticktext != nil {
+		e.key(`ticktext`)
+		e.any(obj.Ticktext)
+	}
+	if obj.Ticktextsrc != nil {
+		e.key(`ticktextsrc`)
+		e.any(obj.Ticktextsrc)
+	}
+	if obj.Tickvals != nil {
+		e.key(`tickvals`)
+		e.any(obj.Tickvals)
+	}
+	if obj.Tickvalssrc != nil {
+		e.key(`tickvalssrc`)
+		e.any(obj.Tickvalssrc)
+	}
+	if obj.Tickwidth != 0 {
+		e.key(`tickwidth`)
+		e.float(float64(obj.Tickwidth))
+	}
+	if obj.Title != nil {
+		e.key(`title`)
+		obj.Title.encodeJSON(e)
+	}
+	if obj.X != 0 {
+		e.key(`x`)
+		e.float(float64(obj.X))
+	}
+	if obj.Xanchor != "" {
+		e.key(`xanchor`)
+		e.string(string(obj.Xanchor))
+	}
+	if obj.Xpad != 0 {
+		e.key(`xpad`)
+		e.float(float64(obj.Xpad))
+	}
+	if obj.Y != 0 {
+		e.key(`y`)
+		e.float(float64(obj.Y))
+	}
+	if obj.Yanchor != "" {
+		e.key(`yanchor`)
+		e.string(string(obj.Yanchor))
+	}
+	if obj.Ypad != 0 {
+		e.key(`ypad`)
+		e.float(float64(obj.Ypad))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes HistogramMarkerLine with precomputed fields. The output is the same as encoding/json.
+func (obj *HistogramMarkerLine) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *HistogramMarkerLine) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Autocolorscale != nil {
+		e.key(`autocolorscale`)
+		e.bool(*obj.Autocolorscale)
+	}
+	if obj.Cauto != nil {
+		e.key(`cauto`)
+		e.bool(*obj.Cauto)
+	}
+	if obj.Cmax != 0 {
+		e.key(`cmax`)
+		e.float(float64(obj.Cmax))
+	}
+	if obj.Cmid != 0 {
+		e.key(`cmid`)
+		e.float(float64(obj.Cmid))
+	}
+	if obj.Cmin != 0 {
+		e.key(`cmin`)
+		e.float(float64(obj.Cmin))
+	}
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Coloraxis != nil {
+		e.key(`coloraxis`)
+		e.any(obj.Coloraxis)
+	}
+	if obj.Colorscale != nil {
+		e.key(`colorscale`)
+		e.any(obj.Colorscale)
+	}
+	if obj.Colorsrc != nil {
+		e.key(`colorsrc`)
+		e.any(obj.Colorsrc)
+	}
+	if obj.Reversescale != nil {
+		e.key(`reversescale`)
+		e.bool(*obj.Reversescale)
+	}
+	if obj.Width != 0 {
+		e.key(`width`)
+		e.float(float64(obj.Width))
+	}
+	if obj.Widthsrc != nil {
+		e.key(`widthsrc`)
+		e.any(obj.Widthsrc)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes HistogramMarker with precomputed fields. The output is the same as encoding/json.
+func (obj *HistogramMarker) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *HistogramMarker) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Autocolorscale != nil {
+		e.key(`autocolorscale`)
+		e.bool(*obj.Autocolorscale)
+	}
+	if obj.Cauto != nil {
+		e.key(`cauto`)
+		e.bool(*obj.Cauto)
+	}
+	if obj.Cmax != 0 {
+		e.key(`cmax`)
+		e.float(float64(obj.Cmax))
+	}
+	if obj.Cmid != 0 {
+		e.key(`cmid`)
+		e.float(float64(obj.Cmid))
+	}
+	if obj.Cmin != 0 {
+		e.key(`cmin`)
+		e.float(float64(obj.Cmin))
+	}
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Coloraxis != nil {
+		e.key(`coloraxis`)
+		e.any(obj.Coloraxis)
+	}
+	if obj.Colorbar != nil {
+		e.key(`colorbar`)
+		obj.Colorbar.encodeJSON(e)
+	}
+	if obj.Colorscale != nil {
+		e.key(`colorscale`)
+		e.any(obj.Colorscale)
+	}
+	if obj.Colorsrc != nil {
+		e.key(`colorsrc`)
+		e.any(obj.Colorsrc)
+	}
+	if obj.Line != nil {
+		e.key(`line`)
+		obj.Line.encodeJSON(e)
+	}
+	if obj.Opacity != 0 {
+		e.key(`opacity`)
+		e.float(float64(obj.Opacity))
+	}
+	if obj.Opacitysrc != nil {
+		e.key(`opacitysrc`)
+		e.any(obj.Opacitysrc)
+	}
+	if obj.Reversescale != nil {
+		e.key(`reversescale`)
+		e.bool(*obj.Reversescale)
+	}
+	if obj.Showscale != nil {
+		e.key(`showscale`)
+		e.bool(*obj.Showscale)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes HistogramSelectedMarker with precomputed fields. The output is the same as encoding/json.
+func (obj *HistogramSelectedMarker) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *HistogramSelectedMarker) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Opacity != 0 {
+		e.key(`opacity`)
+		e.float(float64(obj.Opacity))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes HistogramSelectedTextfont with precomputed fields. The output is the same as encoding/json.
+func (obj *HistogramSelectedTextfont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *HistogramSelectedTextfont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes HistogramSelected with precomputed fields. The output is the same as encoding/json.
+func (obj *HistogramSelected) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *HistogramSelected) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Marker != nil {
+		e.key(`marker`)
+		obj.Marker.encodeJSON(e)
+	}
+	if obj.Textfont != nil {
+		e.key(`textfont`)
+		obj.Textfont.encodeJSON(e)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes HistogramStream with precomputed fields. The output is the same as encoding/json.
+func (obj *HistogramStream) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *HistogramStream) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Maxpoints != 0 {
+		e.key(`maxpoints`)
+		e.float(float64(obj.Maxpoints))
+	}
+	if obj.Token != nil {
+		e.key(`token`)
+		e.any(obj.Token)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes HistogramUnselectedMarker with precomputed fields. The output is the same as encoding/json.
+func (obj *HistogramUnselectedMarker) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *HistogramUnselectedMarker) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Opacity != 0 {
+		e.key(`opacity`)
+		e.float(float64(obj.Opacity))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes HistogramUnselectedTextfont with precomputed fields. The output is the same as encoding/json.
+func (obj *HistogramUnselectedTextfont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *HistogramUnselectedTextfont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes HistogramUnselected with precomputed fields. The output is the same as encoding/json.
+func (obj *HistogramUnselected) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *HistogramUnselected) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Marker != nil {
+		e.key(`marker`)
+		obj.Marker.encodeJSON(e)
+	}
+	if obj.Textfont != nil {
+		e.key(`textfont`)
+		obj.Textfont.encodeJSON(e)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes HistogramXbins with precomputed fields. The output is the same as encoding/json.
+func (obj *HistogramXbins) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *HistogramXbins) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.End != nil {
+		e.key(`end`)
+		e.any(obj.End)
+	}
+	if obj.Size != nil {
+		e.key(`size`)
+		e.any(obj.Size)
+	}
+	if obj.Start != nil {
+		e.key(`start`)
+		e.any(obj.Start)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes HistogramYbins with precomputed fields. The output is the same as encoding/json.
+func (obj *HistogramYbins) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *HistogramYbins) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.End != nil {
+		e.key(`end`)
+		e.any(obj.End)
+	}
+	if obj.Size != nil {
+		e.key(`size`)
+		e.any(obj.Size)
+	}
+	if obj.Start != nil {
+		e.key(`start`)
+		e.any(obj.Start)
+	}
+	e.objectEnd()
+}
@@ -0,0 +1,132 @@
+package grob
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+)
+
+// LayoutImageOptions configure the images added with AddLayoutImage
+type LayoutImageOptions struct {
+	// X and Y are the position of the image, default to the top left corner of the plot area
+	X, Y interface{}
+	// Sizex and Sizey are the size of the box of the image in the units of the axes, default to 1, the whole plot area.
+	// The image keeps its aspect ratio inside the box
+	Sizex, Sizey float64
+	// Xref and Yref are the axes of the coordinates, default to paper, where 0 and 1 are the edges of the plot area
+	Xref, Yref string
+	// Xanchor and Yanchor align the image with its position, default to left and top
+	Xanchor, Yanchor string
+	// Opacity of the image, between 0 and 1
+	Opacity float64
+	// Below draws the image below the traces, like a watermark
+	Below bool
+}
+
+// ImageDataURI encodes the image as a PNG data URI, the source that plotly.js expects for embedded images
+func ImageDataURI(img image.Image) (string, error) {
+	buf := &bytes.Buffer{}
+	err := png.Encode(buf, img)
+	if err != nil {
+		return "", fmt.Errorf("cannot encode image, %w", err)
+	}
+	return "data:image/png;base64," + base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// AddLayoutImage embeds the image in the layout, like add_layout_image in plotly.py, for logos and watermarks.
+// By default it fills the plot area from its top left corner, see LayoutImageOptions to place it.
+//
+//	err := fig.AddLayoutImage(logo, grob.LayoutImageOptions{X: 1, Y: 1, Sizex: 0.2, Sizey: 0.2, Xanchor: "right"})
+func (fig *Fig) AddLayoutImage(img image.Image, opt ...LayoutImageOptions) error {
+	opts := LayoutImageOptions{}
+	if len(opt) == 1 {
+		opts = opt[0]
+	}
+	source, err := ImageDataURI(img)
+	if err != nil {
+		return err
+	}
+	layoutImage := map[string]interface{}{
+		"source":  source,
+		"x":       opts.X,
+		"y":       opts.Y,
+		"sizex":   opts.Sizex,
+		"sizey":   opts.Sizey,
+		"xref":    defaultRef(opts.Xref, "paper"),
+		"yref":    defaultRef(opts.Yref, "paper"),
+		"xanchor": defaultRef(opts.Xanchor, "left"),
+		"yanchor": defaultRef(opts.Yanchor, "top"),
+	}
+	if opts.X == nil {
+		layoutImage["x"] = 0
+	}
+	if opts.Y == nil {
+		layoutImage["y"] = 1
+	}
+	if opts.Sizex == 0 {
+		layoutImage["sizex"] = 1
+	}
+	if opts.Sizey == 0 {
+		layoutImage["sizey"] = 1
+	}
+	if opts.Opacity != 0 {
+		layoutImage["opacity"] = opts.Opacity
+	}
+	if opts.Below {
+		layoutImage["layer"] = "below"
+	}
+	if fig.Layout == nil {
+		fig.Layout = &Layout{}
+	}
+	fig.Layout.Images = appendItem(fig.Layout.Images, layoutImage)
+	return nil
+}
+
+// NewImageTrace returns an image trace with the pixels of the image as z, the first row at the top.
+// Opaque images are rgb, the others rgba256 with the alpha between 0 and 255.
+// For large images, setting Source to ImageDataURI instead of Z is lighter, but the pixel values are not shown on hover.
+func NewImageTrace(img image.Image) *Image {
+	bounds := img.Bounds()
+	opaque := true
+	if o, ok := img.(interface{ Opaque() bool }); ok {
+		opaque = o.Opaque()
+	} else {
+		for y := bounds.Min.Y; y < bounds.Max.Y && opaque; y++ {
+			for x := bounds.Min.X; x < bounds.Max.X; x++ {
+				if _, _, _, a := img.At(x, y).RGBA(); a != 0xffff {
+					opaque = false
+					break
+				}
+			}
+		}
+	}
+
+	trace := &Image{Type: TraceTypeImage}
+	if opaque {
+		z := make([][][3]uint8, bounds.Dy())
+		for y := range z {
+			z[y] = make([][3]uint8, bounds.Dx())
+			for x := range z[y] {
+				c := color.NRGBAModel.Convert(img.At(bounds.Min.X+x, bounds.Min.Y+y)).(color.NRGBA)
+				z[y][x] = [3]uint8{c.R, c.G, c.B}
+			}
+		}
+		trace.Z = z
+		trace.Colormodel = ImageColormodelRgb
+		return trace
+	}
+	z := make([][][4]uint8, bounds.Dy())
+	for y := range z {
+		z[y] = make([][4]uint8, bounds.Dx())
+		for x := range z[y] {
+			c := color.NRGBAModel.Convert(img.At(bounds.Min.X+x, bounds.Min.Y+y)).(color.NRGBA)
+			z[y][x] = [4]uint8{c.R, c.G, c.B, c.A}
+		}
+	}
+	trace.Z = z
+	trace.Colormodel = ImageColormodelRgba256
+	return trace
+}
@@ -0,0 +1,80 @@
+package grob_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var _ = Describe("Images", func() {
+
+	var img *image.NRGBA
+
+	BeforeEach(func() {
+		img = image.NewNRGBA(image.Rect(0, 0, 2, 1))
+		img.Set(0, 0, color.NRGBA{R: 255, A: 255})
+		img.Set(1, 0, color.NRGBA{B: 255, A: 255})
+	})
+
+	It("Should encode the image as a data URI", func() {
+		uri, err := grob.ImageDataURI(img)
+		Expect(err).To(BeNil())
+		Expect(uri).To(HavePrefix("data:image/png;base64,"))
+
+		data, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(uri, "data:image/png;base64,"))
+		Expect(err).To(BeNil())
+		decoded, err := png.Decode(bytes.NewReader(data))
+		Expect(err).To(BeNil())
+		Expect(decoded.Bounds()).To(Equal(img.Bounds()))
+		Expect(color.NRGBAModel.Convert(decoded.At(1, 0))).To(Equal(color.NRGBA{B: 255, A: 255}))
+	})
+
+	It("Should add a layout image filling the plot area", func() {
+		fig := &grob.Fig{}
+		Expect(fig.AddLayoutImage(img, grob.LayoutImageOptions{Opacity: 0.2, Below: true})).To(Succeed())
+		Expect(fig.AddLayoutImage(img, grob.LayoutImageOptions{X: 1, Sizex: 0.1, Sizey: 0.1, Xanchor: "right"})).To(Succeed())
+
+		images := fig.Layout.Images.([]interface{})
+		Expect(images).To(HaveLen(2))
+		watermark := images[0].(map[string]interface{})
+		Expect(watermark["source"]).To(HavePrefix("data:image/png;base64,"))
+		Expect(watermark).To(HaveKeyWithValue("x", 0))
+		Expect(watermark).To(HaveKeyWithValue("y", 1))
+		Expect(watermark).To(HaveKeyWithValue("sizex", 1))
+		Expect(watermark).To(HaveKeyWithValue("xref", "paper"))
+		Expect(watermark).To(HaveKeyWithValue("opacity", 0.2))
+		Expect(watermark).To(HaveKeyWithValue("layer", "below"))
+		logo := images[1].(map[string]interface{})
+		Expect(logo).To(HaveKeyWithValue("x", 1))
+		Expect(logo).To(HaveKeyWithValue("sizex", 0.1))
+		Expect(logo).To(HaveKeyWithValue("xanchor", "right"))
+		Expect(logo).NotTo(HaveKey("layer"))
+	})
+
+	It("Should build an image trace from the pixels", func() {
+		trace := grob.NewImageTrace(img)
+		Expect(trace.Colormodel).To(Equal(grob.ImageColormodelRgb))
+		Expect(trace.Z).To(Equal([][][3]uint8{{{255, 0, 0}, {0, 0, 255}}}))
+
+		fig := &grob.Fig{}
+		fig.AddTraces(trace)
+		data, err := fig.ToPlotlyJSON()
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(ContainSubstring(`"z":[[[255,0,0],[0,0,255]]]`))
+	})
+
+	It("Should keep the alpha of transparent images", func() {
+		img.Set(1, 0, color.NRGBA{G: 255, A: 128})
+		trace := grob.NewImageTrace(img.SubImage(image.Rect(1, 0, 2, 1)))
+		Expect(trace.Colormodel).To(Equal(grob.ImageColormodelRgba256))
+		Expect(trace.Z).To(Equal([][][4]uint8{{{0, 255, 0, 128}}}))
+	})
+})
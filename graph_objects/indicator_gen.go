@@ -8,6 +8,11 @@ func (trace *Indicator) GetType() TraceType {
 	return TraceTypeIndicator
 }
 
+// Validate checks the trace against the constraints of the schema, see ValidateTrace
+func (trace *Indicator) Validate() error {
+	return ValidateTrace(trace)
+}
+
 // Indicator An indicator is used to visualize a single `value` along with some contextual information such as `steps` or a `threshold`, using a combination of three visual elements: a number, a delta, and/or a gauge. Deltas are taken with respect to a `reference`. Gauges can be either angular or bullet (aka linear) gauges.
 type Indicator struct {
 
@@ -732,9 +737,21 @@ type IndicatorGaugeAxisTicks string
 const (
 	IndicatorGaugeAxisTicksOutside IndicatorGaugeAxisTicks = "outside"
 	IndicatorGaugeAxisTicksInside  IndicatorGaugeAxisTicks = "inside"
-	IndicatorGaugeAxisTicksEmpty   IndicatorGaugeAxisTicks = ""
+	IndicatorGaugeAxisTicksEmpty   IndicatorGaugeAxisTicks = emptyEnum
 )
 
+// MarshalJSON writes the empty value as "", the zero value is unset and omitted
+func (v IndicatorGaugeAxisTicks) MarshalJSON() ([]byte, error) {
+	return marshalEnum(string(v))
+}
+
+// UnmarshalJSON reads "" as the empty value
+func (v *IndicatorGaugeAxisTicks) UnmarshalJSON(data []byte) error {
+	s, err := unmarshalEnum(data)
+	*v = IndicatorGaugeAxisTicks(s)
+	return err
+}
+
 // IndicatorGaugeShape Set the shape of the gauge
 type IndicatorGaugeShape string
 
@@ -773,3 +790,699 @@ const (
 	// Extra
 
 )
+
+// MarshalJSON encodes Indicator with precomputed fields. The output is the same as encoding/json.
+func (obj *Indicator) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *Indicator) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Type != "" {
+		e.key(`type`)
+		e.string(string(obj.Type))
+	}
+	if obj.Align != "" {
+		e.key(`align`)
+		e.string(string(obj.Align))
+	}
+	if obj.Customdata != nil {
+		e.key(`customdata`)
+		e.any(obj.Customdata)
+	}
+	if obj.Customdatasrc != nil {
+		e.key(`customdatasrc`)
+		e.any(obj.Customdatasrc)
+	}
+	if obj.Delta != nil {
+		e.key(`delta`)
+		obj.Delta.encodeJSON(e)
+	}
+	if obj.Domain != nil {
+		e.key(`domain`)
+		obj.Domain.encodeJSON(e)
+	}
+	if obj.Gauge != nil {
+		e.key(`gauge`)
+		obj.Gauge.encodeJSON(e)
+	}
+	if obj.Ids != nil {
+		e.key(`ids`)
+		e.any(obj.Ids)
+	}
+	if obj.Idssrc != nil {
+		e.key(`idssrc`)
+		e.any(obj.Idssrc)
+	}
+	if obj.Meta != nil {
+		e.key(`meta`)
+		e.any(obj.Meta)
+	}
+	if obj.Metasrc != nil {
+		e.key(`metasrc`)
+		e.any(obj.Metasrc)
+	}
+	if obj.Mode != "" {
+		e.key(`mode`)
+		e.string(string(obj.Mode))
+	}
+	if obj.Name != nil {
+		e.key(`name`)
+		e.any(obj.Name)
+	}
+	if obj.Number != nil {
+		e.key(`number`)
+		obj.Number.encodeJSON(e)
+	}
+	if obj.Stream != nil {
+		e.key(`stream`)
+		obj.Stream.encodeJSON(e)
+	}
+	if obj.Title != nil {
+		e.key(`title`)
+		obj.Title.encodeJSON(e)
+	}
+	if obj.Transforms != nil {
+		e.key(`transforms`)
+		e.any(obj.Transforms)
+	}
+	if obj.Uid != nil {
+		e.key(`uid`)
+		e.any(obj.Uid)
+	}
+	if obj.Uirevision != nil {
+		e.key(`uirevision`)
+		e.any(obj.Uirevision)
+	}
+	if obj.Value != 0 {
+		e.key(`value`)
+		e.float(float64(obj.Value))
+	}
+	if obj.Visible != nil {
+		e.key(`visible`)
+		e.any(obj.Visible)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes IndicatorDeltaDecreasing with precomputed fields. The output is the same as encoding/json.
+func (obj *IndicatorDeltaDecreasing) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *IndicatorDeltaDecreasing) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Symbol != nil {
+		e.key(`symbol`)
+		e.any(obj.Symbol)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes IndicatorDeltaFont with precomputed fields. The output is the same as encoding/json.
+func (obj *IndicatorDeltaFont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *IndicatorDeltaFont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes IndicatorDeltaIncreasing with precomputed fields. The output is the same as encoding/json.
+func (obj *IndicatorDeltaIncreasing) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *IndicatorDeltaIncreasing) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Symbol != nil {
+		e.key(`symbol`)
+		e.any(obj.Symbol)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes IndicatorDelta with precomputed fields. The output is the same as encoding/json.
+func (obj *IndicatorDelta) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *IndicatorDelta) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Decreasing != nil {
+		e.key(`decreasing`)
+		obj.Decreasing.encodeJSON(e)
+	}
+	if obj.Font != nil {
+		e.key(`font`)
+		obj.Font.encodeJSON(e)
+	}
+	if obj.Increasing != nil {
+		e.key(`increasing`)
+		obj.Increasing.encodeJSON(e)
+	}
+	if obj.Position != "" {
+		e.key(`position`)
+		e.string(string(obj.Position))
+	}
+	if obj.Reference != 0 {
+		e.key(`reference`)
+		e.float(float64(obj.Reference))
+	}
+	if obj.Relative != nil {
+		e.key(`relative`)
+		e.bool(*obj.Relative)
+	}
+	if obj.Valueformat != nil {
+		e.key(`valueformat`)
+		e.any(obj.Valueformat)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes IndicatorDomain with precomputed fields. The output is the same as encoding/json.
+func (obj *IndicatorDomain) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *IndicatorDomain) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Column != 0 {
+		e.key(`column`)
+		e.int(int64(obj.Column))
+	}
+	if obj.Row != 0 {
+		e.key(`row`)
+		e.int(int64(obj.Row))
+	}
+	if obj.X != nil {
+		e.key(`x`)
+		e.any(obj.X)
+	}
+	if obj.Y != nil {
+		e.key(`y`)
+		e.any(obj.Y)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes IndicatorGaugeAxisTickfont with precomputed fields. The output is the same as encoding/json.
+func (obj *IndicatorGaugeAxisTickfont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *IndicatorGaugeAxisTickfont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes IndicatorGaugeAxis with precomputed fields. The output is the same as encoding/json.
+func (obj *IndicatorGaugeAxis) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *IndicatorGaugeAxis) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Dtick != nil {
+		e.key(`dtick`)
+		e.any(obj.Dtick)
+	}
+	if obj.Exponentformat != "" {
+		e.key(`exponentformat`)
+		e.string(string(obj.Exponentformat))
+	}
+	if obj.Minexponent != 0 {
+		e.key(`minexponent`)
+		e.float(float64(obj.Minexponent))
+	}
+	if obj.Nticks != 0 {
+		e.key(`nticks`)
+		e.int(int64(obj.Nticks))
+	}
+	if obj.Range != nil {
+		e.key(`range`)
+		e.any(obj.Range)
+	}
+	if obj.Separatethousands != nil {
+		e.key(`separatethousands`)
+		e.bool(*obj.Separatethousands)
+	}
+	if obj.Showexponent != "" {
+		e.key(`showexponent`)
+		e.string(string(obj.Showexponent))
+	}
+	if obj.Showticklabels != nil {
+		e.key(`showticklabels`)
+		e.bool(*obj.Showticklabels)
+	}
+	if obj.Showtickprefix != "" {
+		e.key(`showtickprefix`)
+		e.string(string(obj.Showtickprefix))
+	}
+	if obj.Showticksuffix != "" {
+		e.key(`showticksuffix`)
+		e.string(string(obj.Showticksuffix))
+	}
+	if obj.Tick0 != nil {
+		e.key(`tick0`)
+		e.any(obj.Tick0)
+	}
+	if obj.Tickangle != 0 {
+		e.key(`tickangle`)
+		e.float(float64(obj.Tickangle))
+	}
+	if obj.Tickcolor != nil {
+		e.key(`tickcolor`)
+		e.any(obj.Tickcolor)
+	}
+	if obj.Tickfont != nil {
+		e.key(`tickfont`)
+		obj.Tickfont.encodeJSON(e)
+	}
+	if obj.Tickformat != nil {
+		e.key(`tickformat`)
+		e.any(obj.Tickformat)
+	}
+	if obj.Tickformatstops != nil {
+		e.key(`tickformatstops`)
+		e.any(obj.Tickformatstops)
+	}
+	if obj.Ticklen != 0 {
+		e.key(`ticklen`)
+		e.float(float64(obj.Ticklen))
+	}
+	if obj.Tickmode != "" {
+		e.key(`tickmode`)
+		e.string(string(obj.Tickmode))
+	}
+	if obj.Tickprefix != nil {
+		e.key(`tickprefix`)
+		e.any(obj.Tickprefix)
+	}
+	if obj.Ticks != "" {
+		e.key(`ticks`)
+		e.enum(string(obj.Ticks))
+	}
+	if obj.Ticksuffix != nil {
+		e.key(`ticksuffix`)
+		e.any(obj.Ticksuffix)
+	}
+	if obj.Ticktext != nil {
+		e.key(`ticktext`)
+		e.any(obj.Ticktext)
+	}
+	if obj.Ticktextsrc != nil {
+		e.key(`ticktextsrc`)
+		e.any(obj.Ticktextsrc)
+	}
+	if obj.Tickvals != nil {
+		e.key(`tickvals`)
+		e.any(obj.Tickvals)
+	}
+	if obj.Tickvalssrc != nil {
+		e.key(`tickvalssrc`)
+		e.any(obj.Tickvalssrc)
+	}
+	if obj.Tickwidth != 0 {
+		e.key(`tickwidth`)
+		e.float(float64(obj.Tickwidth))
+	}
+	if obj.Visible != nil {
+		e.key(`visible`)
+		e.bool(*obj.Visible)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes IndicatorGaugeBarLine with precomputed fields. The output is the same as encoding/json.
+func (obj *IndicatorGaugeBarLine) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *IndicatorGaugeBarLine) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Width != 0 {
+		e.key(`width`)
+		e.float(float64(obj.Width))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes IndicatorGaugeBar with precomputed fields. The output is the same as encoding/json.
+func (obj *IndicatorGaugeBar) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *IndicatorGaugeBar) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Line != nil {
+		e.key(`line`)
+		obj.Line.encodeJSON(e)
+	}
+	if obj.Thickness != 0 {
+		e.key(`thickness`)
+		e.float(float64(obj.Thickness))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes IndicatorGaugeThresholdLine with precomputed fields. The output is the same as encoding/json.
+func (obj *IndicatorGaugeThresholdLine) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *IndicatorGaugeThresholdLine) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Width != 0 {
+		e.key(`width`)
+		e.float(float64(obj.Width))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes IndicatorGaugeThreshold with precomputed fields. The output is the same as encoding/json.
+func (obj *IndicatorGaugeThreshold) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *IndicatorGaugeThreshold) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Line != nil {
+		e.key(`line`)
+		obj.Line.encodeJSON(e)
+	}
+	if obj.Thickness != 0 {
+		e.key(`thickness`)
+		e.float(float64(obj.Thickness))
+	}
+	if obj.Value != 0 {
+		e.key(`value`)
+		e.float(float64(obj.Value))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes IndicatorGauge with precomputed fields. The output is the same as encoding/json.
+func (obj *IndicatorGauge) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *IndicatorGauge) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Axis != nil {
+		e.key(`axis`)
+		obj.Axis.encodeJSON(e)
+	}
+	if obj.Bar != nil {
+		e.key(`bar`)
+		obj.Bar.encodeJSON(e)
+	}
+	if obj.Bgcolor != nil {
+		e.key(`bgcolor`)
+		e.any(obj.Bgcolor)
+	}
+	if obj.Bordercolor != nil {
+		e.key(`bordercolor`)
+		e.any(obj.Bordercolor)
+	}
+	if obj.Borderwidth != 0 {
+		e.key(`borderwidth`)
+		e.float(float64(obj.Borderwidth))
+	}
+	if obj.Shape != "" {
+		e.key(`shape`)
+		e.string(string(obj.Shape))
+	}
+	if obj.Steps != nil {
+		e.key(`steps`)
+		e.any(obj.Steps)
+	}
+	if obj.Threshold != nil {
+		e.key(`threshold`)
+		obj.Threshold.encodeJSON(e)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes IndicatorNumberFont with precomputed fields. The output is the same as encoding/json.
+func (obj *IndicatorNumberFont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *IndicatorNumberFont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes IndicatorNumber with precomputed fields. The output is the same as encoding/json.
+func (obj *IndicatorNumber) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *IndicatorNumber) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Font != nil {
+		e.key(`font`)
+		obj.Font.encodeJSON(e)
+	}
+	if obj.Prefix != nil {
+		e.key(`prefix`)
+		e.any(obj.Prefix)
+	}
+	if obj.Suffix != nil {
+		e.key(`suffix`)
+		e.any(obj.Suffix)
+	}
+	if obj.Valueformat != nil {
+		e.key(`valueformat`)
+		e.any(obj.Valueformat)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes IndicatorStream with precomputed fields. The output is the same as encoding/json.
+func (obj *IndicatorStream) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *IndicatorStream) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Maxpoints != 0 {
+		e.key(`maxpoints`)
+		e.float(float64(obj.Maxpoints))
+	}
+	if obj.Token != nil {
+		e.key(`token`)
+		e.any(obj.Token)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes IndicatorTitleFont with precomputed fields. The output is the same as encoding/json.
+func (obj *IndicatorTitleFont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *IndicatorTitleFont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes IndicatorTitle with precomputed fields. The output is the same as encoding/json.
+func (obj *IndicatorTitle) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *IndicatorTitle) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Align != "" {
+		e.key(`align`)
+		e.string(string(obj.Align))
+	}
+	if obj.Font != nil {
+		e.key(`font`)
+		obj.Font.encodeJSON(e)
+	}
+	if obj.Text != nil {
+		e.key(`text`)
+		e.any(obj.Text)
+	}
+	e.objectEnd()
+}
@@ -8,6 +8,11 @@ func (trace *Isosurface) GetType() TraceType {
 	return TraceTypeIsosurface
 }
 
+// Validate checks the trace against the constraints of the schema, see ValidateTrace
+func (trace *Isosurface) Validate() error {
+	return ValidateTrace(trace)
+}
+
 // Isosurface Draws isosurfaces between iso-min and iso-max values with coordinates given by four 1-dimensional arrays containing the `value`, `x`, `y` and `z` of every vertex of a uniform or non-uniform 3-D grid. Horizontal or vertical slices, caps as well as spaceframe between iso-min and iso-max values could also be drawn using this trace.
 type Isosurface struct {
 
@@ -1119,9 +1124,21 @@ type IsosurfaceColorbarTicks string
 const (
 	IsosurfaceColorbarTicksOutside IsosurfaceColorbarTicks = "outside"
 	IsosurfaceColorbarTicksInside  IsosurfaceColorbarTicks = "inside"
-	IsosurfaceColorbarTicksEmpty   IsosurfaceColorbarTicks = ""
+	IsosurfaceColorbarTicksEmpty   IsosurfaceColorbarTicks = emptyEnum
 )
 
+// MarshalJSON writes the empty value as "", the zero value is unset and omitted
+func (v IsosurfaceColorbarTicks) MarshalJSON() ([]byte, error) {
+	return marshalEnum(string(v))
+}
+
+// UnmarshalJSON reads "" as the empty value
+func (v *IsosurfaceColorbarTicks) UnmarshalJSON(data []byte) error {
+	s, err := unmarshalEnum(data)
+	*v = IsosurfaceColorbarTicks(s)
+	return err
+}
+
 // IsosurfaceColorbarTitleSide Determines the location of color bar's title with respect to the color bar. Note that the title's location used to be set by the now deprecated `titleside` attribute.
 type IsosurfaceColorbarTitleSide string
 
@@ -1200,3 +1217,995 @@ const (
 	IsosurfaceSurfacePatternOdd  IsosurfaceSurfacePattern = "odd"
 	IsosurfaceSurfacePatternEven IsosurfaceSurfacePattern = "even"
 )
+
+// MarshalJSON encodes Isosurface with precomputed fields. The output is the same as encoding/json.
+func (obj *Isosurface) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *Isosurface) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Type != "" {
+		e.key(`type`)
+		e.string(string(obj.Type))
+	}
+	if obj.Autocolorscale != nil {
+		e.key(`autocolorscale`)
+		e.bool(*obj.Autocolorscale)
+	}
+	if obj.Caps != nil {
+		e.key(`caps`)
+		obj.Caps.encodeJSON(e)
+	}
+	if obj.Cauto != nil {
+		e.key(`cauto`)
+		e.bool(*obj.Cauto)
+	}
+	if obj.Cmax != 0 {
+		e.key(`cmax`)
+		e.float(float64(obj.Cmax))
+	}
+	if obj.Cmid != 0 {
+		e.key(`cmid`)
+		e.float(float64(obj.Cmid))
+	}
+	if obj.Cmin != 0 {
+		e.key(`cmin`)
+		e.float(float64(obj.Cmin))
+	}
+	if obj.Coloraxis != nil {
+		e.key(`coloraxis`)
+		e.any(obj.Coloraxis)
+	}
+	if obj.Colorbar != nil {
+		e.key(`colorbar`)
+		obj.Colorbar.encodeJSON(e)
+	}
+	if obj.Colorscale != nil {
+		e.key(`colorscale`)
+		e.any(obj.Colorscale)
+	}
+	if obj.Contour != nil {
+		e.key(`contour`)
+		obj.Contour.encodeJSON(e)
+	}
+	if obj.Customdata != nil {
+		e.key(`customdata`)
+		e.any(obj.Customdata)
+	}
+	if obj.Customdatasrc != nil {
+		e.key(`customdatasrc`)
+		e.any(obj.Customdatasrc)
+	}
+	if obj.Flatshading != nil {
+		e.key(`flatshading`)
+		e.bool(*obj.Flatshading)
+	}
+	if obj.Hoverinfo != "" {
+		e.key(`hoverinfo`)
+		e.string(string(obj.Hoverinfo))
+	}
+	if obj.Hoverinfosrc != nil {
+		e.key(`hoverinfosrc`)
+		e.any(obj.Hoverinfosrc)
+	}
+	if obj.Hoverlabel != nil {
+		e.key(`hoverlabel`)
+		obj.Hoverlabel.encodeJSON(e)
+	}
+	if obj.Hovertemplate != nil {
+		e.key(`hovertemplate`)
+		e.any(obj.Hovertemplate)
+	}
+	if obj.Hovertemplatesrc != nil {
+		e.key(`hovertemplatesrc`)
+		e.any(obj.Hovertemplatesrc)
+	}
+	if obj.Hovertext != nil {
+		e.key(`hovertext`)
+		e.any(obj.Hovertext)
+	}
+	if obj.Hovertextsrc != nil {
+		e.key(`hovertextsrc`)
+		e.any(obj.Hovertextsrc)
+	}
+	if obj.Ids != nil {
+		e.key(`ids`)
+		e.any(obj.Ids)
+	}
+	if obj.Idssrc != nil {
+		e.key(`idssrc`)
+		e.any(obj.Idssrc)
+	}
+	if obj.Isomax != 0 {
+		e.key(`isomax`)
+		e.float(float64(obj.Isomax))
+	}
+	if obj.Isomin != 0 {
+		e.key(`isomin`)
+		e.float(float64(obj.Isomin))
+	}
+	if obj.Legendgroup != nil {
+		e.key(`legendgroup`)
+		e.any(obj.Legendgroup)
+	}
+	if obj.Lighting != nil {
+		e.key(`lighting`)
+		obj.Lighting.encodeJSON(e)
+	}
+	if obj.Lightposition != nil {
+		e.key(`lightposition`)
+		obj.Lightposition.encodeJSON(e)
+	}
+	if obj.Meta != nil {
+		e.key(`meta`)
+		e.any(obj.Meta)
+	}
+	if obj.Metasrc != nil {
+		e.key(`metasrc`)
+		e.any(obj.Metasrc)
+	}
+	if obj.Name != nil {
+		e.key(`name`)
+		e.any(obj.Name)
+	}
+	if obj.Opacity != 0 {
+		e.key(`opacity`)
+		e.float(float64(obj.Opacity))
+	}
+	if obj.Reversescale != nil {
+		e.key(`reversescale`)
+		e.bool(*obj.Reversescale)
+	}
+	if obj.Scene != nil {
+		e.key(`scene`)
+		e.any(obj.Scene)
+	}
+	if obj.Showlegend != nil {
+		e.key(`showlegend`)
+		e.bool(*obj.Showlegend)
+	}
+	if obj.Showscale != nil {
+		e.key(`showscale`)
+		e.bool(*obj.Showscale)
+	}
+	if obj.Slices != nil {
+		e.key(`slices`)
+		obj.Slices.encodeJSON(e)
+	}
+	if obj.Spaceframe != nil {
+		e.key(`spaceframe`)
+		obj.Spaceframe.encodeJSON(e)
+	}
+	if obj.Stream != nil {
+		e.key(`stream`)
+		obj.Stream.encodeJSON(e)
+	}
+	if obj.Surface != nil {
+		e.key(`surface`)
+		obj.Surface.encodeJSON(e)
+	}
+	if obj.Text != nil {
+		e.key(`text`)
+		e.any(obj.Text)
+	}
+	if obj.Textsrc != nil {
+		e.key(`textsrc`)
+		e.any(obj.Textsrc)
+	}
+	if obj.Uid != nil {
+		e.key(`uid`)
+		e.any(obj.Uid)
+	}
+	if obj.Uirevision != nil {
+		e.key(`uirevision`)
+		e.any(obj.Uirevision)
+	}
+	if obj.Value != nil {
+		e.key(`value`)
+		e.any(obj.Value)
+	}
+	if obj.Valuesrc != nil {
+		e.key(`valuesrc`)
+		e.any(obj.Valuesrc)
+	}
+	if obj.Visible != nil {
+		e.key(`visible`)
+		e.any(obj.Visible)
+	}
+	if obj.X != nil {
+		e.key(`x`)
+		e.any(obj.X)
+	}
+	if obj.Xsrc != nil {
+		e.key(`xsrc`)
+		e.any(obj.Xsrc)
+	}
+	if obj.Y != nil {
+		e.key(`y`)
+		e.any(obj.Y)
+	}
+	if obj.Ysrc != nil {
+		e.key(`ysrc`)
+		e.any(obj.Ysrc)
+	}
+	if obj.Z != nil {
+		e.key(`z`)
+		e.any(obj.Z)
+	}
+	if obj.Zsrc != nil {
+		e.key(`zsrc`)
+		e.any(obj.Zsrc)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes IsosurfaceCapsX with precomputed fields. The output is the same as encoding/json.
+func (obj *IsosurfaceCapsX) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *IsosurfaceCapsX) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Fill != 0 {
+		e.key(`fill`)
+		e.float(float64(obj.Fill))
+	}
+	if obj.Show != nil {
+		e.key(`show`)
+		e.bool(*obj.Show)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes IsosurfaceCapsY with precomputed fields. The output is the same as encoding/json.
+func (obj *IsosurfaceCapsY) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *IsosurfaceCapsY) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Fill != 0 {
+		e.key(`fill`)
+		e.float(float64(obj.Fill))
+	}
+	if obj.Show != nil {
+		e.key(`show`)
+		e.bool(*obj.Show)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes IsosurfaceCapsZ with precomputed fields. The output is the same as encoding/json.
+func (obj *IsosurfaceCapsZ) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *IsosurfaceCapsZ) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Fill != 0 {
+		e.key(`fill`)
+		e.float(float64(obj.Fill))
+	}
+	if obj.Show != nil {
+		e.key(`show`)
+		e.bool(*obj.Show)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes IsosurfaceCaps with precomputed fields. The output is the same as encoding/json.
+func (obj *IsosurfaceCaps) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *IsosurfaceCaps) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.X != nil {
+		e.key(`x`)
+		obj.X.encodeJSON(e)
+	}
+	if obj.Y != nil {
+		e.key(`y`)
+		obj.Y.encodeJSON(e)
+	}
+	if obj.Z != nil {
+		e.key(`z`)
+		obj.Z.encodeJSON(e)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes IsosurfaceColorbarTickfont with precomputed fields. The output is the same as encoding/json.
+func (obj *IsosurfaceColorbarTickfont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *IsosurfaceColorbarTickfont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes IsosurfaceColorbarTitleFont with precomputed fields. The output is the same as encoding/json.
+func (obj *IsosurfaceColorbarTitleFont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *IsosurfaceColorbarTitleFont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes IsosurfaceColorbarTitle with precomputed fields. The output is the same as encoding/json.
+func (obj *IsosurfaceColorbarTitle) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *IsosurfaceColorbarTitle) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Font != nil {
+		e.key(`font`)
+		obj.Font.encodeJSON(e)
+	}
+	if obj.Side != "" {
+		e.key(`side`)
+		e.string(string(obj.Side))
+	}
+	if obj.Text != nil {
+		e.key(`text`)
+		e.any(obj.Text)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes IsosurfaceColorbar with precomputed fields. The output is the same as encoding/json.
+func (obj *IsosurfaceColorbar) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *IsosurfaceColorbar) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Bgcolor != nil {
+		e.key(`bgcolor`)
+		e.any(obj.Bgcolor)
+	}
+	if obj.Bordercolor != nil {
+		e.key(`bordercolor`)
+		e.any(obj.Bordercolor)
+	}
+	if obj.Borderwidth != 0 {
+		e.key(`borderwidth`)
+		e.float(float64(obj.Borderwidth))
+	}
+	if obj.Dtick != nil {
+		e.key(`dtick`)
+		e.any(obj.Dtick)
+	}
+	if obj.Exponentformat != "" {
+		e.key(`exponentformat`)
+		e.string(string(obj.Exponentformat))
+	}
+	if obj.Len != 0 {
+		e.key(`len`)
+		e.float(float64(obj.Len))
+	}
+	if obj.Lenmode != "" {
+		e.key(`lenmode`)
+		e.string(string(obj.Lenmode))
+	}
+	if obj.Minexponent != 0 {
+		e.key(`minexponent`)
+		e.float(float64(obj.Minexponent))
+	}
+	if obj.Nticks != 0 {
+		e.key(`nticks`)
+		e.int(int64(obj.Nticks))
+	}
+	if obj.Outlinecolor != nil {
+		e.key(`outlinecolor`)
+		e.any(obj.Outlinecolor)
+	}
+	if obj.Outlinewidth != 0 {
+		e.key(`outlinewidth`)
+		e.float(float64(obj.Outlinewidth))
+	}
+	if obj.Separatethousands != nil {
+		e.key(`separatethousands`)
+		e.bool(*obj.Separatethousands)
+	}
+	if obj.Showexponent != "" {
+		e.key(`showexponent`)
+		e.string(string(obj.Showexponent))
+	}
+	if obj.Showticklabels != nil {
+		e.key(`showticklabels`)
+		e.bool(*obj.Showticklabels)
+	}
+	if obj.Showtickprefix != "" {
+		e.key(`showtickprefix`)
+		e.string(string(obj.Showtickprefix))
+	}
+	if obj.Showticksuffix != "" {
+		e.key(`showticksuffix`)
+		e.string(string(obj.Showticksuffix))
+	}
+	if obj.Thickness != 0 {
+		e.key(`thickness`)
+		e.float(float64(obj.Thickness))
+	}
+	if obj.Thicknessmode != "" {
+		e.key(`thicknessmode`)
+		e.string(string(obj.Thicknessmode))
+	}
+	if obj.Tick0 != nil {
+		e.key(`tick0`)
+		e.any(obj.Tick0)
+	}
+	if obj.Tickangle != 0 {
+		e.key(`tickangle`)
+		e.float(float64(obj.Tickangle))
+	}
+	if obj.Tickcolor != nil {
+		e.key(`tickcolor`)
+		e.any(obj.Tickcolor)
+	}
+	if obj.Tickfont != nil {
+		e.key(`tickfont`)
+		obj.Tickfont.encodeJSON(e)
+	}
+	if obj.Tickformat != nil {
+		e.key(`tickformat`)
+		e.any(obj.Tickformat)
+	}
+	if obj.Tickformatstops != nil {
+		e.key(`tickformatstops`)
+		e.any(obj.Tickformatstops)
+	}
+	if obj.Ticklabelposition != "" {
+		e.key(`ticklabelposition`)
+		e.string(string(obj.Ticklabelposition))
+	}
+	if obj.Ticklen != 0 {
+		e.key(`ticklen`)
+		e.float(float64(obj.Ticklen))
+	}
+	if obj.Tickmode != "" {
+		e.key(`tickmode`)
+		e.string(string(obj.Tickmode))
+	}
+	if obj.Tickprefix != nil {
+		e.key(`tickprefix`)
+		e.any(obj.Tickprefix)
+	}
+	if obj.Ticks != "" {
+		e.key(`ticks`)
+		e.enum(string(obj.Ticks))
+	}
+	if obj.Ticksuffix != nil {
+		e.key(`ticksuffix`)
+		e.any(obj.Ticksuffix)
+	}
+	if obj.Ticktext != nil {
+		e.key(`ticktext`)
+		e.any(obj.Ticktext)
+	}
+	if obj.Ticktextsrc != nil {
+		e.key(`ticktextsrc`)
+		e.any(obj.Ticktextsrc)
+	}
+	if obj.Tickvals != nil {
+		e.key(`tickvals`)
+		e.any(obj.Tickvals)
+	}
+	if obj.Tickvalssrc != nil {
+		e.key(`tickvalssrc`)
+		e.any(obj.Tickvalssrc)
+	}
+	if obj.Tickwidth != 0 {
+		e.key(`tickwidth`)
+		e.float(float64(obj.Tickwidth))
+	}
+	if obj.Title != nil {
+		e.key(`title`)
+		obj.Title.encodeJSON(e)
+	}
+	if obj.X != 0 {
+		e.key(`x`)
+		e.float(float64(obj.X))
+	}
+	if obj.Xanchor != "" {
+		e.key(`xanchor`)
+		e.string(string(obj.Xanchor))
+	}
+	if obj.Xpad != 0 {
+		e.key(`xpad`)
+		e.float(float64(obj.Xpad))
+	}
+	if obj.Y != 0 {
+		e.key(`y`)
+		e.float(float64(obj.Y))
+	}
+	if obj.Yanchor != "" {
+		e.key(`yanchor`)
+		e.string(string(obj.Yanchor))
+	}
+	if obj.Ypad != 0 {
+		e.key(`ypad`)
+		e.float(float64(obj.Ypad))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes IsosurfaceContour with precomputed fields. The output is the same as encoding/json.
+func (obj *IsosurfaceContour) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *IsosurfaceContour) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Show != nil {
+		e.key(`show`)
+		e.bool(*obj.Show)
+	}
+	if obj.Width != 0 {
+		e.key(`width`)
+		e.float(float64(obj.Width))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes IsosurfaceHoverlabelFont with precomputed fields. The output is the same as encoding/json.
+func (obj *IsosurfaceHoverlabelFont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *IsosurfaceHoverlabelFont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Colorsrc != nil {
+		e.key(`colorsrc`)
+		e.any(obj.Colorsrc)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Familysrc != nil {
+		e.key(`familysrc`)
+		e.any(obj.Familysrc)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	if obj.Sizesrc != nil {
+		e.key(`sizesrc`)
+		e.any(obj.Sizesrc)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes IsosurfaceHoverlabel with precomputed fields. The output is the same as encoding/json.
+func (obj *IsosurfaceHoverlabel) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *IsosurfaceHoverlabel) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Align != "" {
+		e.key(`align`)
+		e.string(string(obj.Align))
+	}
+	if obj.Alignsrc != nil {
+		e.key(`alignsrc`)
+		e.any(obj.Alignsrc)
+	}
+	if obj.Bgcolor != nil {
+		e.key(`bgcolor`)
+		e.any(obj.Bgcolor)
+	}
+	if obj.Bgcolorsrc != nil {
+		e.key(`bgcolorsrc`)
+		e.any(obj.Bgcolorsrc)
+	}
+	if obj.Bordercolor != nil {
+		e.key(`bordercolor`)
+		e.any(obj.Bordercolor)
+	}
+	if obj.Bordercolorsrc != nil {
+		e.key(`bordercolorsrc`)
+		e.any(obj.Bordercolorsrc)
+	}
+	if obj.Font != nil {
+		e.key(`font`)
+		obj.Font.encodeJSON(e)
+	}
+	if obj.Namelength != 0 {
+		e.key(`namelength`)
+		e.int(int64(obj.Namelength))
+	}
+	if obj.Namelengthsrc != nil {
+		e.key(`namelengthsrc`)
+		e.any(obj.Namelengthsrc)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes IsosurfaceLighting with precomputed fields. The output is the same as encoding/json.
+func (obj *IsosurfaceLighting) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *IsosurfaceLighting) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Ambient != 0 {
+		e.key(`ambient`)
+		e.float(float64(obj.Ambient))
+	}
+	if obj.Diffuse != 0 {
+		e.key(`diffuse`)
+		e.float(float64(obj.Diffuse))
+	}
+	if obj.Facenormalsepsilon != 0 {
+		e.key(`facenormalsepsilon`)
+		e.float(float64(obj.Facenormalsepsilon))
+	}
+	if obj.Fresnel != 0 {
+		e.key(`fresnel`)
+		e.float(float64(obj.Fresnel))
+	}
+	if obj.Roughness != 0 {
+		e.key(`roughness`)
+		e.float(float64(obj.Roughness))
+	}
+	if obj.Specular != 0 {
+		e.key(`specular`)
+		e.float(float64(obj.Specular))
+	}
+	if obj.Vertexnormalsepsilon != 0 {
+		e.key(`vertexnormalsepsilon`)
+		e.float(float64(obj.Vertexnormalsepsilon))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes IsosurfaceLightposition with precomputed fields. The output is the same as encoding/json.
+func (obj *IsosurfaceLightposition) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *IsosurfaceLightposition) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.X != 0 {
+		e.key(`x`)
+		e.float(float64(obj.X))
+	}
+	if obj.Y != 0 {
+		e.key(`y`)
+		e.float(float64(obj.Y))
+	}
+	if obj.Z != 0 {
+		e.key(`z`)
+		e.float(float64(obj.Z))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes IsosurfaceSlicesX with precomputed fields. The output is the same as encoding/json.
+func (obj *IsosurfaceSlicesX) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *IsosurfaceSlicesX) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Fill != 0 {
+		e.key(`fill`)
+		e.float(float64(obj.Fill))
+	}
+	if obj.Locations != nil {
+		e.key(`locations`)
+		e.any(obj.Locations)
+	}
+	if obj.Locationssrc != nil {
+		e.key(`locationssrc`)
+		e.any(obj.Locationssrc)
+	}
+	if obj.Show != nil {
+		e.key(`show`)
+		e.bool(*obj.Show)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes IsosurfaceSlicesY with precomputed fields. The output is the same as encoding/json.
+func (obj *IsosurfaceSlicesY) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *IsosurfaceSlicesY) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Fill != 0 {
+		e.key(`fill`)
+		e.float(float64(obj.Fill))
+	}
+	if obj.Locations != nil {
+		e.key(`locations`)
+		e.any(obj.Locations)
+	}
+	if obj.Locationssrc != nil {
+		e.key(`locationssrc`)
+		e.any(obj.Locationssrc)
+	}
+	if obj.Show != nil {
+		e.key(`show`)
+		e.bool(*obj.Show)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes IsosurfaceSlicesZ with precomputed fields. The output is the same as encoding/json.
+func (obj *IsosurfaceSlicesZ) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *IsosurfaceSlicesZ) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Fill != 0 {
+		e.key(`fill`)
+		e.float(float64(obj.Fill))
+	}
+	if obj.Locations != nil {
+		e.key(`locations`)
+		e.any(obj.Locations)
+	}
+	if obj.Locationssrc != nil {
+		e.key(`locationssrc`)
+		e.any(obj.Locationssrc)
+	}
+	if obj.Show != nil {
+		e.key(`show`)
+		e.bool(*obj.Show)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes IsosurfaceSlices with precomputed fields. The output is the same as encoding/json.
+func (obj *IsosurfaceSlices) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *IsosurfaceSlices) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.X != nil {
+		e.key(`x`)
+		obj.X.encodeJSON(e)
+	}
+	if obj.Y != nil {
+		e.key(`y`)
+		obj.Y.encodeJSON(e)
+	}
+	if obj.Z != nil {
+		e.key(`z`)
+		obj.Z.encodeJSON(e)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes IsosurfaceSpaceframe with precomputed fields. The output is the same as encoding/json.
+func (obj *IsosurfaceSpaceframe) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *IsosurfaceSpaceframe) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Fill != 0 {
+		e.key(`fill`)
+		e.float(float64(obj.Fill))
+	}
+	if obj.Show != nil {
+		e.key(`show`)
+		e.bool(*obj.Show)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes IsosurfaceStream with precomputed fields. The output is the same as encoding/json.
+func (obj *IsosurfaceStream) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *IsosurfaceStream) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Maxpoints != 0 {
+		e.key(`maxpoints`)
+		e.float(float64(obj.Maxpoints))
+	}
+	if obj.Token != nil {
+		e.key(`token`)
+		e.any(obj.Token)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes IsosurfaceSurface with precomputed fields. The output is the same as encoding/json.
+func (obj *IsosurfaceSurface) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *IsosurfaceSurface) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Count != 0 {
+		e.key(`count`)
+		e.int(int64(obj.Count))
+	}
+	if obj.Fill != 0 {
+		e.key(`fill`)
+		e.float(float64(obj.Fill))
+	}
+	if obj.Pattern != "" {
+		e.key(`pattern`)
+		e.string(string(obj.Pattern))
+	}
+	if obj.Show != nil {
+		e.key(`show`)
+		e.bool(*obj.Show)
+	}
+	e.objectEnd()
+}
@@ -42,9 +42,9 @@ type Layout struct {
 	Bargroupgap float64 `json:"bargroupgap,omitempty"`
 
 	// Barmode
-	// default: stack
+	// default: group
 	// type: enumerated
-	// Determines how bars at the same location coordinate are displayed on the graph. With *stack*, the bars are stacked on top of one another With *overlay*, the bars are plotted over one another, you might need to an *opacity* to see multiple bars.
+	// Determines how bars at the same location coordinate are displayed on the graph. With *stack*, the bars are stacked on top of one another With *relative*, the bars are stacked on top of one another, with negative values below the axis, positive values above With *group*, the bars are plotted next to one another centered around the shared location. With *overlay*, the bars are plotted over one another, you might need to an *opacity* to see multiple bars.
 	Barmode LayoutBarmode `json:"barmode,omitempty"`
 
 	// Barnorm
@@ -441,43 +441,123 @@ type Layout struct {
 
 	// XAxis2
 	// X Axis number 2
-	XAxis2 LayoutXaxis `json:"xaxis2,omitempty"`
+	XAxis2 *LayoutXaxis `json:"xaxis2,omitempty"`
 
 	// XAxis3
 	// X Axis number 3
-	XAxis3 LayoutXaxis `json:"xaxis3,omitempty"`
+	XAxis3 *LayoutXaxis `json:"xaxis3,omitempty"`
 
 	// XAxis4
 	// X Axis number 4
-	XAxis4 LayoutXaxis `json:"xaxis4,omitempty"`
+	XAxis4 *LayoutXaxis `json:"xaxis4,omitempty"`
 
 	// XAxis5
 	// X Axis number 5
-	XAxis5 LayoutXaxis `json:"xaxis5,omitempty"`
+	XAxis5 *LayoutXaxis `json:"xaxis5,omitempty"`
 
 	// XAxis6
 	// X Axis number 6
-	XAxis6 LayoutXaxis `json:"xaxis6,omitempty"`
+	XAxis6 *LayoutXaxis `json:"xaxis6,omitempty"`
+
+	// XAxis7
+	// X Axis number 7
+	XAxis7 *LayoutXaxis `json:"xaxis7,omitempty"`
+
+	// XAxis8
+	// X Axis number 8
+	XAxis8 *LayoutXaxis `json:"xaxis8,omitempty"`
+
+	// XAxis9
+	// X Axis number 9
+	XAxis9 *LayoutXaxis `json:"xaxis9,omitempty"`
+
+	// XAxis10
+	// X Axis number 10
+	XAxis10 *LayoutXaxis `json:"xaxis10,omitempty"`
+
+	// XAxis11
+	// X Axis number 11
+	XAxis11 *LayoutXaxis `json:"xaxis11,omitempty"`
+
+	// XAxis12
+	// X Axis number 12
+	XAxis12 *LayoutXaxis `json:"xaxis12,omitempty"`
+
+	// XAxis13
+	// X Axis number 13
+	XAxis13 *LayoutXaxis `json:"xaxis13,omitempty"`
+
+	// XAxis14
+	// X Axis number 14
+	XAxis14 *LayoutXaxis `json:"xaxis14,omitempty"`
+
+	// XAxis15
+	// X Axis number 15
+	XAxis15 *LayoutXaxis `json:"xaxis15,omitempty"`
+
+	// XAxis16
+	// X Axis number 16
+	XAxis16 *LayoutXaxis `json:"xaxis16,omitempty"`
 
 	// YAxis2
 	// Y Axis number 2
-	YAxis2 LayoutYaxis `json:"yaxis2,omitempty"`
+	YAxis2 *LayoutYaxis `json:"yaxis2,omitempty"`
 
 	// YAxis3
 	// Y Axis number 3
-	YAxis3 LayoutYaxis `json:"yaxis3,omitempty"`
+	YAxis3 *LayoutYaxis `json:"yaxis3,omitempty"`
 
 	// YAxis4
 	// Y Axis number 4
-	YAxis4 LayoutYaxis `json:"yaxis4,omitempty"`
+	YAxis4 *LayoutYaxis `json:"yaxis4,omitempty"`
 
 	// YAxis5
 	// Y Axis number 5
-	YAxis5 LayoutYaxis `json:"yaxis5,omitempty"`
+	YAxis5 *LayoutYaxis `json:"yaxis5,omitempty"`
 
 	// YAxis6
 	// Y Axis number 6
-	YAxis6 LayoutYaxis `json:"yaxis6,omitempty"`
+	YAxis6 *LayoutYaxis `json:"yaxis6,omitempty"`
+
+	// YAxis7
+	// Y Axis number 7
+	YAxis7 *LayoutYaxis `json:"yaxis7,omitempty"`
+
+	// YAxis8
+	// Y Axis number 8
+	YAxis8 *LayoutYaxis `json:"yaxis8,omitempty"`
+
+	// YAxis9
+	// Y Axis number 9
+	YAxis9 *LayoutYaxis `json:"yaxis9,omitempty"`
+
+	// YAxis10
+	// Y Axis number 10
+	YAxis10 *LayoutYaxis `json:"yaxis10,omitempty"`
+
+	// YAxis11
+	// Y Axis number 11
+	YAxis11 *LayoutYaxis `json:"yaxis11,omitempty"`
+
+	// YAxis12
+	// Y Axis number 12
+	YAxis12 *LayoutYaxis `json:"yaxis12,omitempty"`
+
+	// YAxis13
+	// Y Axis number 13
+	YAxis13 *LayoutYaxis `json:"yaxis13,omitempty"`
+
+	// YAxis14
+	// Y Axis number 14
+	YAxis14 *LayoutYaxis `json:"yaxis14,omitempty"`
+
+	// YAxis15
+	// Y Axis number 15
+	YAxis15 *LayoutYaxis `json:"yaxis15,omitempty"`
+
+	// YAxis16
+	// Y Axis number 16
+	YAxis16 *LayoutYaxis `json:"yaxis16,omitempty"`
 }
 
 // LayoutActiveshape
@@ -2557,6 +2637,18 @@ type LayoutPolar struct {
 	// role: Object
 	Angularaxis *LayoutPolarAngularaxis `json:"angularaxis,omitempty"`
 
+	// Bargap
+	// arrayOK: false
+	// type: number
+	// Sets the gap between bars of adjacent location coordinates. Values are unitless, they represent fractions of the minimum difference in bar positions in the data.
+	Bargap float64 `json:"bargap,omitempty"`
+
+	// Barmode
+	// default: stack
+	// type: enumerated
+	// Determines how bars at the same location coordinate are displayed on the graph. With *stack*, the bars are stacked on top of one another With *overlay*, the bars are plotted over one another, you might need to an *opacity* to see multiple bars.
+	Barmode LayoutPolarBarmode `json:"barmode,omitempty"`
+
 	// Bgcolor
 	// arrayOK: false
 	// type: color
@@ -6300,38 +6392,39 @@ const (
 type LayoutBarmode string
 
 const (
-	BarBarmodeStack          LayoutBarmode = "stack"
-	BarBarmodeGroup          LayoutBarmode = "group"
-	BarBarmodeOverlay        LayoutBarmode = "overlay"
-	BarBarmodeRelative       LayoutBarmode = "relative"
-	BarpolarBarmodeStack     LayoutBarmode = "stack"
-	BarpolarBarmodeOverlay   LayoutBarmode = "overlay"
-	HistogramBarmodeStack    LayoutBarmode = "stack"
-	HistogramBarmodeGroup    LayoutBarmode = "group"
-	HistogramBarmodeOverlay  LayoutBarmode = "overlay"
-	HistogramBarmodeRelative LayoutBarmode = "relative"
+	BarBarmodeStack    LayoutBarmode = "stack"
+	BarBarmodeGroup    LayoutBarmode = "group"
+	BarBarmodeOverlay  LayoutBarmode = "overlay"
+	BarBarmodeRelative LayoutBarmode = "relative"
 )
 
 // LayoutBarnorm Sets the normalization for bar traces on the graph. With *fraction*, the value of each bar is divided by the sum of all values at that location coordinate. *percent* is the same but multiplied by 100 to show percentages.
 type LayoutBarnorm string
 
 const (
-	HistogramBarnormEmpty    LayoutBarnorm = ""
-	HistogramBarnormFraction LayoutBarnorm = "fraction"
-	HistogramBarnormPercent  LayoutBarnorm = "percent"
-	BarBarnormEmpty          LayoutBarnorm = ""
-	BarBarnormFraction       LayoutBarnorm = "fraction"
-	BarBarnormPercent        LayoutBarnorm = "percent"
+	BarBarnormEmpty    LayoutBarnorm = emptyEnum
+	BarBarnormFraction LayoutBarnorm = "fraction"
+	BarBarnormPercent  LayoutBarnorm = "percent"
 )
 
+// MarshalJSON writes the empty value as "", the zero value is unset and omitted
+func (v LayoutBarnorm) MarshalJSON() ([]byte, error) {
+	return marshalEnum(string(v))
+}
+
+// UnmarshalJSON reads "" as the empty value
+func (v *LayoutBarnorm) UnmarshalJSON(data []byte) error {
+	s, err := unmarshalEnum(data)
+	*v = LayoutBarnorm(s)
+	return err
+}
+
 // LayoutBoxmode Determines how boxes at the same location coordinate are displayed on the graph. If *group*, the boxes are plotted next to one another centered around the shared location. If *overlay*, the boxes are plotted over one another, you might need to set *opacity* to see them multiple boxes. Has no effect on traces that have *width* set.
 type LayoutBoxmode string
 
 const (
-	BoxBoxmodeGroup           LayoutBoxmode = "group"
-	BoxBoxmodeOverlay         LayoutBoxmode = "overlay"
-	CandlestickBoxmodeGroup   LayoutBoxmode = "group"
-	CandlestickBoxmodeOverlay LayoutBoxmode = "overlay"
+	BoxBoxmodeGroup   LayoutBoxmode = "group"
+	BoxBoxmodeOverlay LayoutBoxmode = "overlay"
 )
 
 // LayoutCalendar Sets the default calendar system to use for interpreting and displaying dates throughout the plot.
@@ -6441,9 +6534,21 @@ type LayoutColoraxisColorbarTicks string
 const (
 	LayoutColoraxisColorbarTicksOutside LayoutColoraxisColorbarTicks = "outside"
 	LayoutColoraxisColorbarTicksInside  LayoutColoraxisColorbarTicks = "inside"
-	LayoutColoraxisColorbarTicksEmpty   LayoutColoraxisColorbarTicks = ""
+	LayoutColoraxisColorbarTicksEmpty   LayoutColoraxisColorbarTicks = emptyEnum
 )
 
+// MarshalJSON writes the empty value as "", the zero value is unset and omitted
+func (v LayoutColoraxisColorbarTicks) MarshalJSON() ([]byte, error) {
+	return marshalEnum(string(v))
+}
+
+// UnmarshalJSON reads "" as the empty value
+func (v *LayoutColoraxisColorbarTicks) UnmarshalJSON(data []byte) error {
+	s, err := unmarshalEnum(data)
+	*v = LayoutColoraxisColorbarTicks(s)
+	return err
+}
+
 // LayoutColoraxisColorbarTitleSide Determines the location of color bar's title with respect to the color bar. Note that the title's location used to be set by the now deprecated `titleside` attribute.
 type LayoutColoraxisColorbarTitleSide string
 
@@ -6838,9 +6943,21 @@ type LayoutPolarAngularaxisTicks string
 const (
 	LayoutPolarAngularaxisTicksOutside LayoutPolarAngularaxisTicks = "outside"
 	LayoutPolarAngularaxisTicksInside  LayoutPolarAngularaxisTicks = "inside"
-	LayoutPolarAngularaxisTicksEmpty   LayoutPolarAngularaxisTicks = ""
+	LayoutPolarAngularaxisTicksEmpty   LayoutPolarAngularaxisTicks = emptyEnum
 )
 
+// MarshalJSON writes the empty value as "", the zero value is unset and omitted
+func (v LayoutPolarAngularaxisTicks) MarshalJSON() ([]byte, error) {
+	return marshalEnum(string(v))
+}
+
+// UnmarshalJSON reads "" as the empty value
+func (v *LayoutPolarAngularaxisTicks) UnmarshalJSON(data []byte) error {
+	s, err := unmarshalEnum(data)
+	*v = LayoutPolarAngularaxisTicks(s)
+	return err
+}
+
 // LayoutPolarAngularaxisType Sets the angular axis type. If *linear*, set `thetaunit` to determine the unit in which axis value are shown. If *category, use `period` to set the number of integer coordinates around polar axis.
 type LayoutPolarAngularaxisType string
 
@@ -6850,6 +6967,14 @@ const (
 	LayoutPolarAngularaxisTypeCategory     LayoutPolarAngularaxisType = "category"
 )
 
+// LayoutPolarBarmode Determines how bars at the same location coordinate are displayed on the graph. With *stack*, the bars are stacked on top of one another With *overlay*, the bars are plotted over one another, you might need to an *opacity* to see multiple bars.
+type LayoutPolarBarmode string
+
+const (
+	LayoutPolarBarmodeStack   LayoutPolarBarmode = "stack"
+	LayoutPolarBarmodeOverlay LayoutPolarBarmode = "overlay"
+)
+
 // LayoutPolarGridshape Determines if the radial axis grid lines and angular axis line are drawn as *circular* sectors or as *linear* (polygon) sectors. Has an effect only when the angular axis has `type` *category*. Note that `radialaxis.angle` is snapped to the angle of the closest vertex when `gridshape` is *circular* (so that radial axis scale is the same as the data scale).
 type LayoutPolarGridshape string
 
@@ -7001,9 +7126,21 @@ type LayoutPolarRadialaxisTicks string
 const (
 	LayoutPolarRadialaxisTicksOutside LayoutPolarRadialaxisTicks = "outside"
 	LayoutPolarRadialaxisTicksInside  LayoutPolarRadialaxisTicks = "inside"
-	LayoutPolarRadialaxisTicksEmpty   LayoutPolarRadialaxisTicks = ""
+	LayoutPolarRadialaxisTicksEmpty   LayoutPolarRadialaxisTicks = emptyEnum
 )
 
+// MarshalJSON writes the empty value as "", the zero value is unset and omitted
+func (v LayoutPolarRadialaxisTicks) MarshalJSON() ([]byte, error) {
+	return marshalEnum(string(v))
+}
+
+// UnmarshalJSON reads "" as the empty value
+func (v *LayoutPolarRadialaxisTicks) UnmarshalJSON(data []byte) error {
+	s, err := unmarshalEnum(data)
+	*v = LayoutPolarRadialaxisTicks(s)
+	return err
+}
+
 // LayoutPolarRadialaxisType Sets the axis type. By default, plotly attempts to determined the axis type by looking into the data of the traces that referenced the axis in question.
 type LayoutPolarRadialaxisType string
 
@@ -7198,9 +7335,21 @@ type LayoutSceneXaxisTicks string
 const (
 	LayoutSceneXaxisTicksOutside LayoutSceneXaxisTicks = "outside"
 	LayoutSceneXaxisTicksInside  LayoutSceneXaxisTicks = "inside"
-	LayoutSceneXaxisTicksEmpty   LayoutSceneXaxisTicks = ""
+	LayoutSceneXaxisTicksEmpty   LayoutSceneXaxisTicks = emptyEnum
 )
 
+// MarshalJSON writes the empty value as "", the zero value is unset and omitted
+func (v LayoutSceneXaxisTicks) MarshalJSON() ([]byte, error) {
+	return marshalEnum(string(v))
+}
+
+// UnmarshalJSON reads "" as the empty value
+func (v *LayoutSceneXaxisTicks) UnmarshalJSON(data []byte) error {
+	s, err := unmarshalEnum(data)
+	*v = LayoutSceneXaxisTicks(s)
+	return err
+}
+
 // LayoutSceneXaxisType Sets the axis type. By default, plotly attempts to determined the axis type by looking into the data of the traces that referenced the axis in question.
 type LayoutSceneXaxisType string
 
@@ -7350,9 +7499,21 @@ type LayoutSceneYaxisTicks string
 const (
 	LayoutSceneYaxisTicksOutside LayoutSceneYaxisTicks = "outside"
 	LayoutSceneYaxisTicksInside  LayoutSceneYaxisTicks = "inside"
-	LayoutSceneYaxisTicksEmpty   LayoutSceneYaxisTicks = ""
+	LayoutSceneYaxisTicksEmpty   LayoutSceneYaxisTicks = emptyEnum
 )
 
+// MarshalJSON writes the empty value as "", the zero value is unset and omitted
+func (v LayoutSceneYaxisTicks) MarshalJSON() ([]byte, error) {
+	return marshalEnum(string(v))
+}
+
+// UnmarshalJSON reads "" as the empty value
+func (v *LayoutSceneYaxisTicks) UnmarshalJSON(data []byte) error {
+	s, err := unmarshalEnum(data)
+	*v = LayoutSceneYaxisTicks(s)
+	return err
+}
+
 // LayoutSceneYaxisType Sets the axis type. By default, plotly attempts to determined the axis type by looking into the data of the traces that referenced the axis in question.
 type LayoutSceneYaxisType string
 
@@ -7502,9 +7663,21 @@ type LayoutSceneZaxisTicks string
 const (
 	LayoutSceneZaxisTicksOutside LayoutSceneZaxisTicks = "outside"
 	LayoutSceneZaxisTicksInside  LayoutSceneZaxisTicks = "inside"
-	LayoutSceneZaxisTicksEmpty   LayoutSceneZaxisTicks = ""
+	LayoutSceneZaxisTicksEmpty   LayoutSceneZaxisTicks = emptyEnum
 )
 
+// MarshalJSON writes the empty value as "", the zero value is unset and omitted
+func (v LayoutSceneZaxisTicks) MarshalJSON() ([]byte, error) {
+	return marshalEnum(string(v))
+}
+
+// UnmarshalJSON reads "" as the empty value
+func (v *LayoutSceneZaxisTicks) UnmarshalJSON(data []byte) error {
+	s, err := unmarshalEnum(data)
+	*v = LayoutSceneZaxisTicks(s)
+	return err
+}
+
 // LayoutSceneZaxisType Sets the axis type. By default, plotly attempts to determined the axis type by looking into the data of the traces that referenced the axis in question.
 type LayoutSceneZaxisType string
 
@@ -7591,9 +7764,21 @@ type LayoutTernaryAaxisTicks string
 const (
 	LayoutTernaryAaxisTicksOutside LayoutTernaryAaxisTicks = "outside"
 	LayoutTernaryAaxisTicksInside  LayoutTernaryAaxisTicks = "inside"
-	LayoutTernaryAaxisTicksEmpty   LayoutTernaryAaxisTicks = ""
+	LayoutTernaryAaxisTicksEmpty   LayoutTernaryAaxisTicks = emptyEnum
 )
 
+// MarshalJSON writes the empty value as "", the zero value is unset and omitted
+func (v LayoutTernaryAaxisTicks) MarshalJSON() ([]byte, error) {
+	return marshalEnum(string(v))
+}
+
+// UnmarshalJSON reads "" as the empty value
+func (v *LayoutTernaryAaxisTicks) UnmarshalJSON(data []byte) error {
+	s, err := unmarshalEnum(data)
+	*v = LayoutTernaryAaxisTicks(s)
+	return err
+}
+
 // LayoutTernaryBaxisExponentformat Determines a formatting rule for the tick exponents. For example, consider the number 1,000,000,000. If *none*, it appears as 1,000,000,000. If *e*, 1e+9. If *E*, 1E+9. If *power*, 1x10^9 (with 9 in a super script). If *SI*, 1G. If *B*, 1B.
 type LayoutTernaryBaxisExponentformat string
 
@@ -7659,9 +7844,21 @@ type LayoutTernaryBaxisTicks string
 const (
 	LayoutTernaryBaxisTicksOutside LayoutTernaryBaxisTicks = "outside"
 	LayoutTernaryBaxisTicksInside  LayoutTernaryBaxisTicks = "inside"
-	LayoutTernaryBaxisTicksEmpty   LayoutTernaryBaxisTicks = ""
+	LayoutTernaryBaxisTicksEmpty   LayoutTernaryBaxisTicks = emptyEnum
 )
 
+// MarshalJSON writes the empty value as "", the zero value is unset and omitted
+func (v LayoutTernaryBaxisTicks) MarshalJSON() ([]byte, error) {
+	return marshalEnum(string(v))
+}
+
+// UnmarshalJSON reads "" as the empty value
+func (v *LayoutTernaryBaxisTicks) UnmarshalJSON(data []byte) error {
+	s, err := unmarshalEnum(data)
+	*v = LayoutTernaryBaxisTicks(s)
+	return err
+}
+
 // LayoutTernaryCaxisExponentformat Determines a formatting rule for the tick exponents. For example, consider the number 1,000,000,000. If *none*, it appears as 1,000,000,000. If *e*, 1e+9. If *E*, 1E+9. If *power*, 1x10^9 (with 9 in a super script). If *SI*, 1G. If *B*, 1B.
 type LayoutTernaryCaxisExponentformat string
 
@@ -7727,9 +7924,21 @@ type LayoutTernaryCaxisTicks string
 const (
 	LayoutTernaryCaxisTicksOutside LayoutTernaryCaxisTicks = "outside"
 	LayoutTernaryCaxisTicksInside  LayoutTernaryCaxisTicks = "inside"
-	LayoutTernaryCaxisTicksEmpty   LayoutTernaryCaxisTicks = ""
+	LayoutTernaryCaxisTicksEmpty   LayoutTernaryCaxisTicks = emptyEnum
 )
 
+// MarshalJSON writes the empty value as "", the zero value is unset and omitted
+func (v LayoutTernaryCaxisTicks) MarshalJSON() ([]byte, error) {
+	return marshalEnum(string(v))
+}
+
+// UnmarshalJSON reads "" as the empty value
+func (v *LayoutTernaryCaxisTicks) UnmarshalJSON(data []byte) error {
+	s, err := unmarshalEnum(data)
+	*v = LayoutTernaryCaxisTicks(s)
+	return err
+}
+
 // LayoutTitleXanchor Sets the title's horizontal alignment with respect to its x position. *left* means that the title starts at x, *right* means that the title ends at x and *center* means that the title's center is at x. *auto* divides `xref` by three and calculates the `xanchor` value automatically based on the value of `x`.
 type LayoutTitleXanchor string
 
@@ -8113,9 +8322,21 @@ type LayoutXaxisTicks string
 const (
 	LayoutXaxisTicksOutside LayoutXaxisTicks = "outside"
 	LayoutXaxisTicksInside  LayoutXaxisTicks = "inside"
-	LayoutXaxisTicksEmpty   LayoutXaxisTicks = ""
+	LayoutXaxisTicksEmpty   LayoutXaxisTicks = emptyEnum
 )
 
+// MarshalJSON writes the empty value as "", the zero value is unset and omitted
+func (v LayoutXaxisTicks) MarshalJSON() ([]byte, error) {
+	return marshalEnum(string(v))
+}
+
+// UnmarshalJSON reads "" as the empty value
+func (v *LayoutXaxisTicks) UnmarshalJSON(data []byte) error {
+	s, err := unmarshalEnum(data)
+	*v = LayoutXaxisTicks(s)
+	return err
+}
+
 // LayoutXaxisTickson Determines where ticks and grid lines are drawn with respect to their corresponding tick labels. Only has an effect for axes of `type` *category* or *multicategory*. When set to *boundaries*, ticks and grid lines are drawn half a category to the left/bottom of labels.
 type LayoutXaxisTickson string
 
@@ -8379,9 +8600,21 @@ type LayoutYaxisTicks string
 const (
 	LayoutYaxisTicksOutside LayoutYaxisTicks = "outside"
 	LayoutYaxisTicksInside  LayoutYaxisTicks = "inside"
-	LayoutYaxisTicksEmpty   LayoutYaxisTicks = ""
+	LayoutYaxisTicksEmpty   LayoutYaxisTicks = emptyEnum
 )
 
+// MarshalJSON writes the empty value as "", the zero value is unset and omitted
+func (v LayoutYaxisTicks) MarshalJSON() ([]byte, error) {
+	return marshalEnum(string(v))
+}
+
+// UnmarshalJSON reads "" as the empty value
+func (v *LayoutYaxisTicks) UnmarshalJSON(data []byte) error {
+	s, err := unmarshalEnum(data)
+	*v = LayoutYaxisTicks(s)
+	return err
+}
+
 // LayoutYaxisTickson Determines where ticks and grid lines are drawn with respect to their corresponding tick labels. Only has an effect for axes of `type` *category* or *multicategory*. When set to *boundaries*, ticks and grid lines are drawn half a category to the left/bottom of labels.
 type LayoutYaxisTickson string
 
@@ -8451,3 +8684,5639 @@ const (
 	// Extra
 
 )
+
+// MarshalJSON encodes Layout with precomputed fields. The output is the same as encoding/json.
+func (obj *Layout) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *Layout) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Activeshape != nil {
+		e.key(`activeshape`)
+		obj.Activeshape.encodeJSON(e)
+	}
+	if obj.Angularaxis != nil {
+		e.key(`angularaxis`)
+		obj.Angularaxis.encodeJSON(e)
+	}
+	if obj.Annotations != nil {
+		e.key(`annotations`)
+		e.any(obj.Annotations)
+	}
+	if obj.Autosize != nil {
+		e.key(`autosize`)
+		e.bool(*obj.Autosize)
+	}
+	if obj.Autotypenumbers != "" {
+		e.key(`autotypenumbers`)
+		e.string(string(obj.Autotypenumbers))
+	}
+	if obj.Bargap != 0 {
+		e.key(`bargap`)
+		e.float(float64(obj.Bargap))
+	}
+	if obj.Bargroupgap != 0 {
+		e.key(`bargroupgap`)
+		e.float(float64(obj.Bargroupgap))
+	}
+	if obj.Barmode != "" {
+		e.key(`barmode`)
+		e.string(string(obj.Barmode))
+	}
+	if obj.Barnorm != "" {
+		e.key(`barnorm`)
+		e.enum(string(obj.Barnorm))
+	}
+	if obj.Boxgap != 0 {
+		e.key(`boxgap`)
+		e.float(float64(obj.Boxgap))
+	}
+	if obj.Boxgroupgap != 0 {
+		e.key(`boxgroupgap`)
+		e.float(float64(obj.Boxgroupgap))
+	}
+	if obj.Boxmode != "" {
+		e.key(`boxmode`)
+		e.string(string(obj.Boxmode))
+	}
+	if obj.Calendar != "" {
+		e.key(`calendar`)
+		e.string(string(obj.Calendar))
+	}
+	if obj.Clickmode != "" {
+		e.key(`clickmode`)
+		e.string(string(obj.Clickmode))
+	}
+	if obj.Coloraxis != nil {
+		e.key(`coloraxis`)
+		obj.Coloraxis.encodeJSON(e)
+	}
+	if obj.Colorscale != nil {
+		e.key(`colorscale`)
+		obj.Colorscale.encodeJSON(e)
+	}
+	if len(obj.Colorway) != 0 {
+		e.key(`colorway`)
+		e.any(obj.Colorway)
+	}
+	if obj.Computed != nil {
+		e.key(`computed`)
+		e.any(obj.Computed)
+	}
+	if obj.Datarevision != nil {
+		e.key(`datarevision`)
+		e.any(obj.Datarevision)
+	}
+	if obj.Direction != "" {
+		e.key(`direction`)
+		e.string(string(obj.Direction))
+	}
+	if obj.Dragmode != nil {
+		e.key(`dragmode`)
+		e.any(obj.Dragmode)
+	}
+	if obj.Editrevision != nil {
+		e.key(`editrevision`)
+		e.any(obj.Editrevision)
+	}
+	if obj.Extendfunnelareacolors != nil {
+		e.key(`extendfunnelareacolors`)
+		e.bool(*obj.Extendfunnelareacolors)
+	}
+	if obj.Extendpiecolors != nil {
+		e.key(`extendpiecolors`)
+		e.bool(*obj.Extendpiecolors)
+	}
+	if obj.Extendsunburstcolors != nil {
+		e.key(`extendsunburstcolors`)
+		e.bool(*obj.Extendsunburstcolors)
+	}
+	if obj.Extendtreemapcolors != nil {
+		e.key(`extendtreemapcolors`)
+		e.bool(*obj.Extendtreemapcolors)
+	}
+	if obj.Font != nil {
+		e.key(`font`)
+		obj.Font.encodeJSON(e)
+	}
+	if len(obj.Funnelareacolorway) != 0 {
+		e.key(`funnelareacolorway`)
+		e.any(obj.Funnelareacolorway)
+	}
+	if obj.Funnelgap != 0 {
+		e.key(`funnelgap`)
+		e.float(float64(obj.Funnelgap))
+	}
+	if obj.Funnelgroupgap != 0 {
+		e.key(`funnelgroupgap`)
+		e.float(float64(obj.Funnelgroupgap))
+	}
+	if obj.Funnelmode != "" {
+		e.key(`funnelmode`)
+		e.string(string(obj.Funnelmode))
+	}
+	if obj.Geo != nil {
+		e.key(`geo`)
+		obj.Geo.encodeJSON(e)
+	}
+	if obj.Grid != nil {
+		e.key(`grid`)
+		obj.Grid.encodeJSON(e)
+	}
+	if obj.Height != 0 {
+		e.key(`height`)
+		e.float(float64(obj.Height))
+	}
+	if obj.Hiddenlabels != nil {
+		e.key(`hiddenlabels`)
+		e.any(obj.Hiddenlabels)
+	}
+	if obj.Hiddenlabelssrc != nil {
+		e.key(`hiddenlabelssrc`)
+		e.any(obj.Hiddenlabelssrc)
+	}
+	if obj.Hidesources != nil {
+		e.key(`hidesources`)
+		e.bool(*obj.Hidesources)
+	}
+	if obj.Hoverdistance != 0 {
+		e.key(`hoverdistance`)
+		e.int(int64(obj.Hoverdistance))
+	}
+	if obj.Hoverlabel != nil {
+		e.key(`hoverlabel`)
+		obj.Hoverlabel.encodeJSON(e)
+	}
+	if obj.Hovermode != nil {
+		e.key(`hovermode`)
+		e.any(obj.Hovermode)
+	}
+	if obj.Images != nil {
+		e.key(`images`)
+		e.any(obj.Images)
+	}
+	if obj.Legend != nil {
+		e.key(`legend`)
+		obj.Legend.encodeJSON(e)
+	}
+	if obj.Mapbox != nil {
+		e.key(`mapbox`)
+		obj.Mapbox.encodeJSON(e)
+	}
+	if obj.Margin != nil {
+		e.key(`margin`)
+		obj.Margin.encodeJSON(e)
+	}
+	if obj.Meta != nil {
+		e.key(`meta`)
+		e.any(obj.Meta)
+	}
+	if obj.Metasrc != nil {
+		e.key(`metasrc`)
+		e.any(obj.Metasrc)
+	}
+	if obj.Modebar != nil {
+		e.key(`modebar`)
+		obj.Modebar.encodeJSON(e)
+	}
+	if obj.Newshape != nil {
+		e.key(`newshape`)
+		obj.Newshape.encodeJSON(e)
+	}
+	if obj.Orientation != 0 {
+		e.key(`orientation`)
+		e.float(float64(obj.Orientation))
+	}
+	if obj.PaperBgcolor != nil {
+		e.key(`paper_bgcolor`)
+		e.any(obj.PaperBgcolor)
+	}
+	if len(obj.Piecolorway) != 0 {
+		e.key(`piecolorway`)
+		e.any(obj.Piecolorway)
+	}
+	if obj.PlotBgcolor != nil {
+		e.key(`plot_bgcolor`)
+		e.any(obj.PlotBgcolor)
+	}
+	if obj.Polar != nil {
+		e.key(`polar`)
+		obj.Polar.encodeJSON(e)
+	}
+	if obj.Radialaxis != nil {
+		e.key(`radialaxis`)
+		obj.Radialaxis.encodeJSON(e)
+	}
+	if obj.Scene != nil {
+		e.key(`scene`)
+		obj.Scene.encodeJSON(e)
+	}
+	if obj.Selectdirection != "" {
+		e.key(`selectdirection`)
+		e.string(string(obj.Selectdirection))
+	}
+	if obj.Selectionrevision != nil {
+		e.key(`selectionrevision`)
+		e.any(obj.Selectionrevision)
+	}
+	if obj.Separators != nil {
+		e.key(`separators`)
+		e.any(obj.Separators)
+	}
+	if obj.Shapes != nil {
+		e.key(`shapes`)
+		e.any(obj.Shapes)
+	}
+	if obj.Showlegend != nil {
+		e.key(`showlegend`)
+		e.bool(*obj.Showlegend)
+	}
+	if obj.Sliders != nil {
+		e.key(`sliders`)
+		e.any(obj.Sliders)
+	}
+	if obj.Spikedistance != 0 {
+		e.key(`spikedistance`)
+		e.int(int64(obj.Spikedistance))
+	}
+	if len(obj.Sunburstcolorway) != 0 {
+		e.key(`sunburstcolorway`)
+		e.any(obj.Sunburstcolorway)
+	}
+	if obj.Template != nil {
+		e.key(`template`)
+		e.any(obj.Template)
+	}
+	if obj.Ternary != nil {
+		e.key(`ternary`)
+		obj.Ternary.encodeJSON(e)
+	}
+	if obj.Title != nil {
+		e.key(`title`)
+		obj.Title.encodeJSON(e)
+	}
+	if obj.Transition != nil {
+		e.key(`transition`)
+		obj.Transition.encodeJSON(e)
+	}
+	if len(obj.Treemapcolorway) != 0 {
+		e.key(`treemapcolorway`)
+		e.any(obj.Treemapcolorway)
+	}
+	if obj.Uirevision != nil {
+		e.key(`uirevision`)
+		e.any(obj.Uirevision)
+	}
+	if obj.Uniformtext != nil {
+		e.key(`uniformtext`)
+		obj.Uniformtext.encodeJSON(e)
+	}
+	if obj.Updatemenus != nil {
+		e.key(`updatemenus`)
+		e.any(obj.Updatemenus)
+	}
+	if obj.Violingap != 0 {
+		e.key(`violingap`)
+		e.float(float64(obj.Violingap))
+	}
+	if obj.Violingroupgap != 0 {
+		e.key(`violingroupgap`)
+		e.float(float64(obj.Violingroupgap))
+	}
+	if obj.Violinmode != "" {
+		e.key(`violinmode`)
+		e.string(string(obj.Violinmode))
+	}
+	if obj.Waterfallgap != 0 {
+		e.key(`waterfallgap`)
+		e.float(float64(obj.Waterfallgap))
+	}
+	if obj.Waterfallgroupgap != 0 {
+		e.key(`waterfallgroupgap`)
+		e.float(float64(obj.Waterfallgroupgap))
+	}
+	if obj.Waterfallmode != "" {
+		e.key(`waterfallmode`)
+		e.string(string(obj.Waterfallmode))
+	}
+	if obj.Width != 0 {
+		e.key(`width`)
+		e.float(float64(obj.Width))
+	}
+	if obj.Xaxis != nil {
+		e.key(`xaxis`)
+		obj.Xaxis.encodeJSON(e)
+	}
+	if obj.Yaxis != nil {
+		e.key(`yaxis`)
+		obj.Yaxis.encodeJSON(e)
+	}
+	if obj.XAxis2 != nil {
+		e.key(`xaxis2`)
+		obj.XAxis2.encodeJSON(e)
+	}
+	if obj.XAxis3 != nil {
+		e.key(`xaxis3`)
+		obj.XAxis3.encodeJSON(e)
+	}
+	if obj.XAxis4 != nil {
+		e.key(`xaxis4`)
+		obj.XAxis4.encodeJSON(e)
+	}
+	if obj.XAxis5 != nil {
+		e.key(`xaxis5`)
+		obj.XAxis5.encodeJSON(e)
+	}
+	if obj.XAxis6 != nil {
+		e.key(`xaxis6`)
+		obj.XAxis6.encodeJSON(e)
+	}
+	if obj.XAxis7 != nil {
+		e.key(`xaxis7`)
+		obj.XAxis7.encodeJSON(e)
+	}
+	if obj.XAxis8 != nil {
+		e.key(`xaxis8`)
+		obj.XAxis8.encodeJSON(e)
+	}
+	if obj.XAxis9 != nil {
+		e.key(`xaxis9`)
+		obj.XAxis9.encodeJSON(e)
+	}
+	if obj.XAxis10 != nil {
+		e.key(`xaxis10`)
+		obj.XAxis10.encodeJSON(e)
+	}
+	if obj.XAxis11 != nil {
+		e.key(`xaxis11`)
+		obj.XAxis11.encodeJSON(e)
+	}
+	if obj.XAxis12 != nil {
+		e.key(`xaxis12`)
+		obj.XAxis12.encodeJSON(e)
+	}
+	if obj.XAxis13 != nil {
+		e.key(`xaxis13`)
+		obj.XAxis13.encodeJSON(e)
+	}
+	if obj.XAxis14 != nil {
+		e.key(`xaxis14`)
+		obj.XAxis14.encodeJSON(e)
+	}
+	if obj.XAxis15 != nil {
+		e.key(`xaxis15`)
+		obj.XAxis15.encodeJSON(e)
+	}
+	if obj.XAxis16 != nil {
+		e.key(`xaxis16`)
+		obj.XAxis16.encodeJSON(e)
+	}
+	if obj.YAxis2 != nil {
+		e.key(`yaxis2`)
+		obj.YAxis2.encodeJSON(e)
+	}
+	if obj.YAxis3 != nil {
+		e.key(`yaxis3`)
+		obj.YAxis3.encodeJSON(e)
+	}
+	if obj.YAxis4 != nil {
+		e.key(`yaxis4`)
+		obj.YAxis4.encodeJSON(e)
+	}
+	if obj.YAxis5 != nil {
+		e.key(`yaxis5`)
+		obj.YAxis5.encodeJSON(e)
+	}
+	if obj.YAxis6 != nil {
+		e.key(`yaxis6`)
+		obj.YAxis6.encodeJSON(e)
+	}
+	if obj.YAxis7 != nil {
+		e.key(`yaxis7`)
+		obj.YAxis7.encodeJSON(e)
+	}
+	if obj.YAxis8 != nil {
+		e.key(`yaxis8`)
+		obj.YAxis8.encodeJSON(e)
+	}
+	if obj.YAxis9 != nil {
+		e.key(`yaxis9`)
+		obj.YAxis9.encodeJSON(e)
+	}
+	if obj.YAxis10 != nil {
+		e.key(`yaxis10`)
+		obj.YAxis10.encodeJSON(e)
+	}
+	if obj.YAxis11 != nil {
+		e.key(`yaxis11`)
+		obj.YAxis11.encodeJSON(e)
+	}
+	if obj.YAxis12 != nil {
+		e.key(`yaxis12`)
+		obj.YAxis12.encodeJSON(e)
+	}
+	if obj.YAxis13 != nil {
+		e.key(`yaxis13`)
+		obj.YAxis13.encodeJSON(e)
+	}
+	if obj.YAxis14 != nil {
+		e.key(`yaxis14`)
+		obj.YAxis14.encodeJSON(e)
+	}
+	if obj.YAxis15 != nil {
+		e.key(`yaxis15`)
+		obj.YAxis15.encodeJSON(e)
+	}
+	if obj.YAxis16 != nil {
+		e.key(`yaxis16`)
+		obj.YAxis16.encodeJSON(e)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutActiveshape with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutActiveshape) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutActiveshape) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Fillcolor != nil {
+		e.key(`fillcolor`)
+		e.any(obj.Fillcolor)
+	}
+	if obj.Opacity != 0 {
+		e.key(`opacity`)
+		e.float(float64(obj.Opacity))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutAngularaxis with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutAngularaxis) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutAngularaxis) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Domain != nil {
+		e.key(`domain`)
+		e.any(obj.Domain)
+	}
+	if obj.Endpadding != 0 {
+		e.key(`endpadding`)
+		e.float(float64(obj.Endpadding))
+	}
+	if obj.Range != nil {
+		e.key(`range`)
+		e.any(obj.Range)
+	}
+	if obj.Showline != nil {
+		e.key(`showline`)
+		e.bool(*obj.Showline)
+	}
+	if obj.Showticklabels != nil {
+		e.key(`showticklabels`)
+		e.bool(*obj.Showticklabels)
+	}
+	if obj.Tickcolor != nil {
+		e.key(`tickcolor`)
+		e.any(obj.Tickcolor)
+	}
+	if obj.Ticklen != 0 {
+		e.key(`ticklen`)
+		e.float(float64(obj.Ticklen))
+	}
+	if obj.Tickorientation != "" {
+		e.key(`tickorientation`)
+		e.string(string(obj.Tickorientation))
+	}
+	if obj.Ticksuffix != nil {
+		e.key(`ticksuffix`)
+		e.any(obj.Ticksuffix)
+	}
+	if obj.Visible != nil {
+		e.key(`visible`)
+		e.bool(*obj.Visible)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutColoraxisColorbarTickfont with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutColoraxisColorbarTickfont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutColoraxisColorbarTickfont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutColoraxisColorbarTitleFont with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutColoraxisColorbarTitleFont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutColoraxisColorbarTitleFont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutColoraxisColorbarTitle with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutColoraxisColorbarTitle) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutColoraxisColorbarTitle) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Font != nil {
+		e.key(`font`)
+		obj.Font.encodeJSON(e)
+	}
+	if obj.Side != "" {
+		e.key(`side`)
+		e.string(string(obj.Side))
+	}
+	if obj.Text != nil {
+		e.key(`text`)
+		e.any(obj.Text)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutColoraxisColorbar with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutColoraxisColorbar) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutColoraxisColorbar) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Bgcolor != nil {
+		e.key(`bgcolor`)
+		e.any(obj.Bgcolor)
+	}
+	if obj.Bordercolor != nil {
+		e.key(`bordercolor`)
+		e.any(obj.Bordercolor)
+	}
+	if obj.Borderwidth != 0 {
+		e.key(`borderwidth`)
+		e.float(float64(obj.Borderwidth))
+	}
+	if obj.Dtick != nil {
+		e.key(`dtick`)
+		e.any(obj.Dtick)
+	}
+	if obj.Exponentformat != "" {
+		e.key(`exponentformat`)
+		e.string(string(obj.Exponentformat))
+	}
+	if obj.Len != 0 {
+		e.key(`len`)
+		e.float(float64(obj.Len))
+	}
+	if obj.Lenmode != "" {
+		e.key(`lenmode`)
+		e.string(string(obj.Lenmode))
+	}
+	if obj.Minexponent != 0 {
+		e.key(`minexponent`)
+		e.float(float64(obj.Minexponent))
+	}
+	if obj.Nticks != 0 {
+		e.key(`nticks`)
+		e.int(int64(obj.Nticks))
+	}
+	if obj.Outlinecolor != nil {
+		e.key(`outlinecolor`)
+		e.any(obj.Outlinecolor)
+	}
+	if obj.Outlinewidth != 0 {
+		e.key(`outlinewidth`)
+		e.float(float64(obj.Outlinewidth))
+	}
+	if obj.Separatethousands != nil {
+		e.key(`separatethousands`)
+		e.bool(*obj.Separatethousands)
+	}
+	if obj.Showexponent != "" {
+		e.key(`showexponent`)
+		e.string(string(obj.Showexponent))
+	}
+	if obj.Showticklabels != nil {
+		e.key(`showticklabels`)
+		e.bool(*obj.Showticklabels)
+	}
+	if obj.Showtickprefix != "" {
+		e.key(`showtickprefix`)
+		e.string(string(obj.Showtickprefix))
+	}
+	if obj.Showticksuffix != "" {
+		e.key(`showticksuffix`)
+		e.string(string(obj.Showticksuffix))
+	}
+	if obj.Thickness != 0 {
+		e.key(`thickness`)
+		e.float(float64(obj.Thickness))
+	}
+	if obj.Thicknessmode != "" {
+		e.key(`thicknessmode`)
+		e.string(string(obj.Thicknessmode))
+	}
+	if obj.Tick0 != nil {
+		e.key(`tick0`)
+		e.any(obj.Tick0)
+	}
+	if obj.Tickangle != 0 {
+		e.key(`tickangle`)
+		e.float(float64(obj.Tickangle))
+	}
+	if obj.Tickcolor != nil {
+		e.key(`tickcolor`)
+		e.any(obj.Tickcolor)
+	}
+	if obj.Tickfont != nil {
+		e.key(`tickfont`)
+		obj.Tickfont.encodeJSON(e)
+	}
+	if obj.Tickformat != nil {
+		e.key(`tickformat`)
+		e.any(obj.Tickformat)
+	}
+	if obj.Tickformatstops != nil {
+		e.key(`tickformatstops`)
+		e.any(obj.Tickformatstops)
+	}
+	if obj.Ticklabelposition != "" {
+		e.key(`ticklabelposition`)
+		e.string(string(obj.Ticklabelposition))
+	}
+	if obj.Ticklen != 0 {
+		e.key(`ticklen`)
+		e.float(float64(obj.Ticklen))
+	}
+	if obj.Tickmode != "" {
+		e.key(`tickmode`)
+		e.string(string(obj.Tickmode))
+	}
+	if obj.Tickprefix != nil {
+		e.key(`tickprefix`)
+		e.any(obj.Tickprefix)
+	}
+	if obj.Ticks != "" {
+		e.key(`ticks`)
+		e.enum(string(obj.Ticks))
+	}
+	if obj.Ticksuffix != nil {
+		e.key(`ticksuffix`)
+		e.any(obj.Ticksuffix)
+	}
+	if obj.Ticktext != nil {
+		e.key(`ticktext`)
+		e.any(obj.Ticktext)
+	}
+	if obj.Ticktextsrc != nil {
+		e.key(`ticktextsrc`)
+		e.any(obj.Ticktextsrc)
+	}
+	if obj.Tickvals != nil {
+		e.key(`tickvals`)
+		e.any(obj.Tickvals)
+	}
+	if obj.Tickvalssrc != nil {
+		e.key(`tickvalssrc`)
+		e.any(obj.Tickvalssrc)
+	}
+	if obj.Tickwidth != 0 {
+		e.key(`tickwidth`)
+		e.float(float64(obj.Tickwidth))
+	}
+	if obj.Title != nil {
+		e.key(`title`)
+		obj.Title.encodeJSON(e)
+	}
+	if obj.X != 0 {
+		e.key(`x`)
+		e.float(float64(obj.X))
+	}
+	if obj.Xanchor != "" {
+		e.key(`xanchor`)
+		e.string(string(obj.Xanchor))
+	}
+	if obj.Xpad != 0 {
+		e.key(`xpad`)
+		e.float(float64(obj.Xpad))
+	}
+	if obj.Y != 0 {
+		e.key(`y`)
+		e.float(float64(obj.Y))
+	}
+	if obj.Yanchor != "" {
+		e.key(`yanchor`)
+		e.string(string(obj.Yanchor))
+	}
+	if obj.Ypad != 0 {
+		e.key(`ypad`)
+		e.float(float64(obj.Ypad))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutColoraxis with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutColoraxis) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutColoraxis) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Autocolorscale != nil {
+		e.key(`autocolorscale`)
+		e.bool(*obj.Autocolorscale)
+	}
+	if obj.Cauto != nil {
+		e.key(`cauto`)
+		e.bool(*obj.Cauto)
+	}
+	if obj.Cmax != 0 {
+		e.key(`cmax`)
+		e.float(float64(obj.Cmax))
+	}
+	if obj.Cmid != 0 {
+		e.key(`cmid`)
+		e.float(float64(obj.Cmid))
+	}
+	if obj.Cmin != 0 {
+		e.key(`cmin`)
+		e.float(float64(obj.Cmin))
+	}
+	if obj.Colorbar != nil {
+		e.key(`colorbar`)
+		obj.Colorbar.encodeJSON(e)
+	}
+	if obj.Colorscale != nil {
+		e.key(`colorscale`)
+		e.any(obj.Colorscale)
+	}
+	if obj.Reversescale != nil {
+		e.key(`reversescale`)
+		e.bool(*obj.Reversescale)
+	}
+	if obj.Showscale != nil {
+		e.key(`showscale`)
+		e.bool(*obj.Showscale)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutColorscale with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutColorscale) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutColorscale) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Diverging != nil {
+		e.key(`diverging`)
+		e.any(obj.Diverging)
+	}
+	if obj.Sequential != nil {
+		e.key(`sequential`)
+		e.any(obj.Sequential)
+	}
+	if obj.Sequentialminus != nil {
+		e.key(`sequentialminus`)
+		e.any(obj.Sequentialminus)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutFont with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutFont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutFont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutGeoCenter with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutGeoCenter) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutGeoCenter) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Lat != 0 {
+		e.key(`lat`)
+		e.float(float64(obj.Lat))
+	}
+	if obj.Lon != 0 {
+		e.key(`lon`)
+		e.float(float64(obj.Lon))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutGeoDomain with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutGeoDomain) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutGeoDomain) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Column != 0 {
+		e.key(`column`)
+		e.int(int64(obj.Column))
+	}
+	if obj.Row != 0 {
+		e.key(`row`)
+		e.int(int64(obj.Row))
+	}
+	if obj.X != nil {
+		e.key(`x`)
+		e.any(obj.X)
+	}
+	if obj.Y != nil {
+		e.key(`y`)
+		e.any(obj.Y)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutGeoLataxis with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutGeoLataxis) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutGeoLataxis) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Dtick != 0 {
+		e.key(`dtick`)
+		e.float(float64(obj.Dtick))
+	}
+	if obj.Gridcolor != nil {
+		e.key(`gridcolor`)
+		e.any(obj.Gridcolor)
+	}
+	if obj.Gridwidth != 0 {
+		e.key(`gridwidth`)
+		e.float(float64(obj.Gridwidth))
+	}
+	if obj.Range != nil {
+		e.key(`range`)
+		e.any(obj.Range)
+	}
+	if obj.Showgrid != nil {
+		e.key(`showgrid`)
+		e.bool(*obj.Showgrid)
+	}
+	if obj.Tick0 != 0 {
+		e.key(`tick0`)
+		e.float(float64(obj.Tick0))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutGeoLonaxis with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutGeoLonaxis) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutGeoLonaxis) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Dtick != 0 {
+		e.key(`dtick`)
+		e.float(float64(obj.Dtick))
+	}
+	if obj.Gridcolor != nil {
+		e.key(`gridcolor`)
+		e.any(obj.Gridcolor)
+	}
+	if obj.Gridwidth != 0 {
+		e.key(`gridwidth`)
+		e.float(float64(obj.Gridwidth))
+	}
+	if obj.Range != nil {
+		e.key(`range`)
+		e.any(obj.Range)
+	}
+	if obj.Showgrid != nil {
+		e.key(`showgrid`)
+		e.bool(*obj.Showgrid)
+	}
+	if obj.Tick0 != 0 {
+		e.key(`tick0`)
+		e.float(float64(obj.Tick0))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutGeoProjectionRotation with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutGeoProjectionRotation) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutGeoProjectionRotation) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Lat != 0 {
+		e.key(`lat`)
+		e.float(float64(obj.Lat))
+	}
+	if obj.Lon != 0 {
+		e.key(`lon`)
+		e.float(float64(obj.Lon))
+	}
+	if obj.Roll != 0 {
+		e.key(`roll`)
+		e.float(float64(obj.Roll))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutGeoProjection with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutGeoProjection) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutGeoProjection) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Parallels != nil {
+		e.key(`parallels`)
+		e.any(obj.Parallels)
+	}
+	if obj.Rotation != nil {
+		e.key(`rotation`)
+		obj.Rotation.encodeJSON(e)
+	}
+	if obj.Scale != 0 {
+		e.key(`scale`)
+		e.float(float64(obj.Scale))
+	}
+	if obj.Type != "" {
+		e.key(`type`)
+		e.string(string(obj.Type))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutGeo with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutGeo) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutGeo) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Bgcolor != nil {
+		e.key(`bgcolor`)
+		e.any(obj.Bgcolor)
+	}
+	if obj.Center != nil {
+		e.key(`center`)
+		obj.Center.encodeJSON(e)
+	}
+	if obj.Coastlinecolor != nil {
+		e.key(`coastlinecolor`)
+		e.any(obj.Coastlinecolor)
+	}
+	if obj.Coastlinewidth != 0 {
+		e.key(`coastlinewidth`)
+		e.float(float64(obj.Coastlinewidth))
+	}
+	if obj.Countrycolor != nil {
+		e.key(`countrycolor`)
+		e.any(obj.Countrycolor)
+	}
+	if obj.Countrywidth != 0 {
+		e.key(`countrywidth`)
+		e.float(float64(obj.Countrywidth))
+	}
+	if obj.Domain != nil {
+		e.key(`domain`)
+		obj.Domain.encodeJSON(e)
+	}
+	if obj.Fitbounds != nil {
+		e.key(`fitbounds`)
+		e.any(obj.Fitbounds)
+	}
+	if obj.Framecolor != nil {
+		e.key(`framecolor`)
+		e.any(obj.Framecolor)
+	}
+	if obj.Framewidth != 0 {
+		e.key(`framewidth`)
+		e.float(float64(obj.Framewidth))
+	}
+	if obj.Lakecolor != nil {
+		e.key(`lakecolor`)
+		e.any(obj.Lakecolor)
+	}
+	if obj.Landcolor != nil {
+		e.key(`landcolor`)
+		e.any(obj.Landcolor)
+	}
+	if obj.Lataxis != nil {
+		e.key(`lataxis`)
+		obj.Lataxis.encodeJSON(e)
+	}
+	if obj.Lonaxis != nil {
+		e.key(`lonaxis`)
+		obj.Lonaxis.encodeJSON(e)
+	}
+	if obj.Oceancolor != nil {
+		e.key(`oceancolor`)
+		e.any(obj.Oceancolor)
+	}
+	if obj.Projection != nil {
+		e.key(`projection`)
+		obj.Projection.encodeJSON(e)
+	}
+	if obj.Resolution != nil {
+		e.key(`resolution`)
+		e.any(obj.Resolution)
+	}
+	if obj.Rivercolor != nil {
+		e.key(`rivercolor`)
+		e.any(obj.Rivercolor)
+	}
+	if obj.Riverwidth != 0 {
+		e.key(`riverwidth`)
+		e.float(float64(obj.Riverwidth))
+	}
+	if obj.Scope != "" {
+		e.key(`scope`)
+		e.string(string(obj.Scope))
+	}
+	if obj.Showcoastlines != nil {
+		e.key(`showcoastlines`)
+		e.bool(*obj.Showcoastlines)
+	}
+	if obj.Showcountries != nil {
+		e.key(`showcountries`)
+		e.bool(*obj.Showcountries)
+	}
+	if obj.Showframe != nil {
+		e.key(`showframe`)
+		e.bool(*obj.Showframe)
+	}
+	if obj.Showlakes != nil {
+		e.key(`showlakes`)
+		e.bool(*obj.Showlakes)
+	}
+	if obj.Showland != nil {
+		e.key(`showland`)
+		e.bool(*obj.Showland)
+	}
+	if obj.Showocean != nil {
+		e.key(`showocean`)
+		e.bool(*obj.Showocean)
+	}
+	if obj.Showrivers != nil {
+		e.key(`showrivers`)
+		e.bool(*obj.Showrivers)
+	}
+	if obj.Showsubunits != nil {
+		e.key(`showsubunits`)
+		e.bool(*obj.Showsubunits)
+	}
+	if obj.Subunitcolor != nil {
+		e.key(`subunitcolor`)
+		e.any(obj.Subunitcolor)
+	}
+	if obj.Subunitwidth != 0 {
+		e.key(`subunitwidth`)
+		e.float(float64(obj.Subunitwidth))
+	}
+	if obj.Uirevision != nil {
+		e.key(`uirevision`)
+		e.any(obj.Uirevision)
+	}
+	if obj.Visible != nil {
+		e.key(`visible`)
+		e.bool(*obj.Visible)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutGridDomain with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutGridDomain) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutGridDomain) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.X != nil {
+		e.key(`x`)
+		e.any(obj.X)
+	}
+	if obj.Y != nil {
+		e.key(`y`)
+		e.any(obj.Y)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutGrid with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutGrid) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutGrid) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Columns != 0 {
+		e.key(`columns`)
+		e.int(int64(obj.Columns))
+	}
+	if obj.Domain != nil {
+		e.key(`domain`)
+		obj.Domain.encodeJSON(e)
+	}
+	if obj.Pattern != "" {
+		e.key(`pattern`)
+		e.string(string(obj.Pattern))
+	}
+	if obj.Roworder != "" {
+		e.key(`roworder`)
+		e.string(string(obj.Roworder))
+	}
+	if obj.Rows != 0 {
+		e.key(`rows`)
+		e.int(int64(obj.Rows))
+	}
+	if obj.Subplots != nil {
+		e.key(`subplots`)
+		e.any(obj.Subplots)
+	}
+	if obj.Xaxes != nil {
+		e.key(`xaxes`)
+		e.any(obj.Xaxes)
+	}
+	if obj.Xgap != 0 {
+		e.key(`xgap`)
+		e.float(float64(obj.Xgap))
+	}
+	if obj.Xside != "" {
+		e.key(`xside`)
+		e.string(string(obj.Xside))
+	}
+	if obj.Yaxes != nil {
+		e.key(`yaxes`)
+		e.any(obj.Yaxes)
+	}
+	if obj.Ygap != 0 {
+		e.key(`ygap`)
+		e.float(float64(obj.Ygap))
+	}
+	if obj.Yside != "" {
+		e.key(`yside`)
+		e.string(string(obj.Yside))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutHoverlabelFont with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutHoverlabelFont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutHoverlabelFont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutHoverlabel with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutHoverlabel) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutHoverlabel) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Align != "" {
+		e.key(`align`)
+		e.string(string(obj.Align))
+	}
+	if obj.Bgcolor != nil {
+		e.key(`bgcolor`)
+		e.any(obj.Bgcolor)
+	}
+	if obj.Bordercolor != nil {
+		e.key(`bordercolor`)
+		e.any(obj.Bordercolor)
+	}
+	if obj.Font != nil {
+		e.key(`font`)
+		obj.Font.encodeJSON(e)
+	}
+	if obj.Namelength != 0 {
+		e.key(`namelength`)
+		e.int(int64(obj.Namelength))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutLegendFont with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutLegendFont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutLegendFont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutLegendTitleFont with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutLegendTitleFont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutLegendTitleFont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutLegendTitle with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutLegendTitle) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutLegendTitle) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Font != nil {
+		e.key(`font`)
+		obj.Font.encodeJSON(e)
+	}
+	if obj.Side != "" {
+		e.key(`side`)
+		e.string(string(obj.Side))
+	}
+	if obj.Text != nil {
+		e.key(`text`)
+		e.any(obj.Text)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutLegend with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutLegend) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutLegend) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Bgcolor != nil {
+		e.key(`bgcolor`)
+		e.any(obj.Bgcolor)
+	}
+	if obj.Bordercolor != nil {
+		e.key(`bordercolor`)
+		e.any(obj.Bordercolor)
+	}
+	if obj.Borderwidth != 0 {
+		e.key(`borderwidth`)
+		e.float(float64(obj.Borderwidth))
+	}
+	if obj.Font != nil {
+		e.key(`font`)
+		obj.Font.encodeJSON(e)
+	}
+	if obj.Itemclick != nil {
+		e.key(`itemclick`)
+		e.any(obj.Itemclick)
+	}
+	if obj.Itemdoubleclick != nil {
+		e.key(`itemdoubleclick`)
+		e.any(obj.Itemdoubleclick)
+	}
+	if obj.Itemsizing != "" {
+		e.key(`itemsizing`)
+		e.string(string(obj.Itemsizing))
+	}
+	if obj.Itemwidth != 0 {
+		e.key(`itemwidth`)
+		e.float(float64(obj.Itemwidth))
+	}
+	if obj.Orientation != "" {
+		e.key(`orientation`)
+		e.string(string(obj.Orientation))
+	}
+	if obj.Title != nil {
+		e.key(`title`)
+		obj.Title.encodeJSON(e)
+	}
+	if obj.Tracegroupgap != 0 {
+		e.key(`tracegroupgap`)
+		e.float(float64(obj.Tracegroupgap))
+	}
+	if obj.Traceorder != "" {
+		e.key(`traceorder`)
+		e.string(string(obj.Traceorder))
+	}
+	if obj.Uirevision != nil {
+		e.key(`uirevision`)
+		e.any(obj.Uirevision)
+	}
+	if obj.Valign != "" {
+		e.key(`valign`)
+		e.string(string(obj.Valign))
+	}
+	if obj.X != 0 {
+		e.key(`x`)
+		e.float(float64(obj.X))
+	}
+	if obj.Xanchor != "" {
+		e.key(`xanchor`)
+		e.string(string(obj.Xanchor))
+	}
+	if obj.Y != 0 {
+		e.key(`y`)
+		e.float(float64(obj.Y))
+	}
+	if obj.Yanchor != "" {
+		e.key(`yanchor`)
+		e.string(string(obj.Yanchor))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutMapboxCenter with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutMapboxCenter) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutMapboxCenter) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Lat != 0 {
+		e.key(`lat`)
+		e.float(float64(obj.Lat))
+	}
+	if obj.Lon != 0 {
+		e.key(`lon`)
+		e.float(float64(obj.Lon))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutMapboxDomain with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutMapboxDomain) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutMapboxDomain) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Column != 0 {
+		e.key(`column`)
+		e.int(int64(obj.Column))
+	}
+	if obj.Row != 0 {
+		e.key(`row`)
+		e.int(int64(obj.Row))
+	}
+	if obj.X != nil {
+		e.key(`x`)
+		e.any(obj.X)
+	}
+	if obj.Y != nil {
+		e.key(`y`)
+		e.any(obj.Y)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutMapbox with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutMapbox) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutMapbox) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Accesstoken != nil {
+		e.key(`accesstoken`)
+		e.any(obj.Accesstoken)
+	}
+	if obj.Bearing != 0 {
+		e.key(`bearing`)
+		e.float(float64(obj.Bearing))
+	}
+	if obj.Center != nil {
+		e.key(`center`)
+		obj.Center.encodeJSON(e)
+	}
+	if obj.Domain != nil {
+		e.key(`domain`)
+		obj.Domain.encodeJSON(e)
+	}
+	if obj.Layers != nil {
+		e.key(`layers`)
+		e.any(obj.Layers)
+	}
+	if obj.Pitch != 0 {
+		e.key(`pitch`)
+		e.float(float64(obj.Pitch))
+	}
+	if obj.Style != nil {
+		e.key(`style`)
+		e.any(obj.Style)
+	}
+	if obj.Uirevision != nil {
+		e.key(`uirevision`)
+		e.any(obj.Uirevision)
+	}
+	if obj.Zoom != 0 {
+		e.key(`zoom`)
+		e.float(float64(obj.Zoom))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutMargin with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutMargin) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutMargin) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Autoexpand != nil {
+		e.key(`autoexpand`)
+		e.bool(*obj.Autoexpand)
+	}
+	if obj.B != 0 {
+		e.key(`b`)
+		e.float(float64(obj.B))
+	}
+	if obj.L != 0 {
+		e.key(`l`)
+		e.float(float64(obj.L))
+	}
+	if obj.Pad != 0 {
+		e.key(`pad`)
+		e.float(float64(obj.Pad))
+	}
+	if obj.R != 0 {
+		e.key(`r`)
+		e.float(float64(obj.R))
+	}
+	if obj.T != 0 {
+		e.key(`t`)
+		e.float(float64(obj.T))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutModebar with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutModebar) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutModebar) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Activecolor != nil {
+		e.key(`activecolor`)
+		e.any(obj.Activecolor)
+	}
+	if obj.Bgcolor != nil {
+		e.key(`bgcolor`)
+		e.any(obj.Bgcolor)
+	}
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Orientation != "" {
+		e.key(`orientation`)
+		e.string(string(obj.Orientation))
+	}
+	if obj.Uirevision != nil {
+		e.key(`uirevision`)
+		e.any(obj.Uirevision)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutNewshapeLine with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutNewshapeLine) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutNewshapeLine) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Dash != nil {
+		e.key(`dash`)
+		e.any(obj.Dash)
+	}
+	if obj.Width != 0 {
+		e.key(`width`)
+		e.float(float64(obj.Width))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutNewshape with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutNewshape) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutNewshape) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Drawdirection != "" {
+		e.key(`drawdirection`)
+		e.string(string(obj.Drawdirection))
+	}
+	if obj.Fillcolor != nil {
+		e.key(`fillcolor`)
+		e.any(obj.Fillcolor)
+	}
+	if obj.Fillrule != "" {
+		e.key(`fillrule`)
+		e.string(string(obj.Fillrule))
+	}
+	if obj.Layer != "" {
+		e.key(`layer`)
+		e.string(string(obj.Layer))
+	}
+	if obj.Line != nil {
+		e.key(`line`)
+		obj.Line.encodeJSON(e)
+	}
+	if obj.Opacity != 0 {
+		e.key(`opacity`)
+		e.float(float64(obj.Opacity))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutPolarAngularaxisTickfont with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutPolarAngularaxisTickfont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutPolarAngularaxisTickfont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutPolarAngularaxis with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutPolarAngularaxis) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutPolarAngularaxis) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Autotypenumbers != "" {
+		e.key(`autotypenumbers`)
+		e.string(string(obj.Autotypenumbers))
+	}
+	if obj.Categoryarray != nil {
+		e.key(`categoryarray`)
+		e.any(obj.Categoryarray)
+	}
+	if obj.Categoryarraysrc != nil {
+		e.key(`categoryarraysrc`)
+		e.any(obj.Categoryarraysrc)
+	}
+	if obj.Categoryorder != "" {
+		e.key(`categoryorder`)
+		e.string(string(obj.Categoryorder))
+	}
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Direction != "" {
+		e.key(`direction`)
+		e.string(string(obj.Direction))
+	}
+	if obj.Dtick != nil {
+		e.key(`dtick`)
+		e.any(obj.Dtick)
+	}
+	if obj.Exponentformat != "" {
+		e.key(`exponentformat`)
+		e.string(string(obj.Exponentformat))
+	}
+	if obj.Gridcolor != nil {
+		e.key(`gridcolor`)
+		e.any(obj.Gridcolor)
+	}
+	if obj.Gridwidth != 0 {
+		e.key(`gridwidth`)
+		e.float(float64(obj.Gridwidth))
+	}
+	if obj.Hoverformat != nil {
+		e.key(`hoverformat`)
+		e.any(obj.Hoverformat)
+	}
+	if obj.Layer != "" {
+		e.key(`layer`)
+		e.string(string(obj.Layer))
+	}
+	if obj.Linecolor != nil {
+		e.key(`linecolor`)
+		e.any(obj.Linecolor)
+	}
+	if obj.Linewidth != 0 {
+		e.key(`linewidth`)
+		e.float(float64(obj.Linewidth))
+	}
+	if obj.Minexponent != 0 {
+		e.key(`minexponent`)
+		e.float(float64(obj.Minexponent))
+	}
+	if obj.Nticks != 0 {
+		e.key(`nticks`)
+		e.int(int64(obj.Nticks))
+	}
+	if obj.Period != 0 {
+		e.key(`period`)
+		e.float(float64(obj.Period))
+	}
+	if obj.Rotation != 0 {
+		e.key(`rotation`)
+		e.float(float64(obj.Rotation))
+	}
+	if obj.Separatethousands != nil {
+		e.key(`separatethousands`)
+		e.bool(*obj.Separatethousands)
+	}
+	if obj.Showexponent != "" {
+		e.key(`showexponent`)
+		e.string(string(obj.Showexponent))
+	}
+	if obj.Showgrid != nil {
+		e.key(`showgrid`)
+		e.bool(*obj.Showgrid)
+	}
+	if obj.Showline != nil {
+		e.key(`showline`)
+		e.bool(*obj.Showline)
+	}
+	if obj.Showticklabels != nil {
+		e.key(`showticklabels`)
+		e.bool(*obj.Showticklabels)
+	}
+	if obj.Showtickprefix != "" {
+		e.key(`showtickprefix`)
+		e.string(string(obj.Showtickprefix))
+	}
+	if obj.Showticksuffix != "" {
+		e.key(`showticksuffix`)
+		e.string(string(obj.Showticksuffix))
+	}
+	if obj.Thetaunit != "" {
+		e.key(`thetaunit`)
+		e.string(string(obj.Thetaunit))
+	}
+	if obj.Tick0 != nil {
+		e.key(`tick0`)
+		e.any(obj.Tick0)
+	}
+	if obj.Tickangle != 0 {
+		e.key(`tickangle`)
+		e.float(float64(obj.Tickangle))
+	}
+	if obj.Tickcolor != nil {
+		e.key(`tickcolor`)
+		e.any(obj.Tickcolor)
+	}
+	if obj.Tickfont != nil {
+		e.key(`tickfont`)
+		obj.Tickfont.encodeJSON(e)
+	}
+	if obj.Tickformat != nil {
+		e.key(`tickformat`)
+		e.any(obj.Tickformat)
+	}
+	if obj.Tickformatstops != nil {
+		e.key(`tickformatstops`)
+		e.any(obj.Tickformatstops)
+	}
+	if obj.Ticklen != 0 {
+		e.key(`ticklen`)
+		e.float(float64(obj.Ticklen))
+	}
+	if obj.Tickmode != "" {
+		e.key(`tickmode`)
+		e.string(string(obj.Tickmode))
+	}
+	if obj.Tickprefix != nil {
+		e.key(`tickprefix`)
+		e.any(obj.Tickprefix)
+	}
+	if obj.Ticks != "" {
+		e.key(`ticks`)
+		e.enum(string(obj.Ticks))
+	}
+	if obj.Ticksuffix != nil {
+		e.key(`ticksuffix`)
+		e.any(obj.Ticksuffix)
+	}
+	if obj.Ticktext != nil {
+		e.key(`ticktext`)
+		e.any(obj.Ticktext)
+	}
+	if obj.Ticktextsrc != nil {
+		e.key(`ticktextsrc`)
+		e.any(obj.Ticktextsrc)
+	}
+	if obj.Tickvals != nil {
+		e.key(`tickvals`)
+		e.any(obj.Tickvals)
+	}
+	if obj.Tickvalssrc != nil {
+		e.key(`tickvalssrc`)
+		e.any(obj.Tickvalssrc)
+	}
+	if obj.Tickwidth != 0 {
+		e.key(`tickwidth`)
+		e.float(float64(obj.Tickwidth))
+	}
+	if obj.Type != "" {
+		e.key(`type`)
+		e.string(string(obj.Type))
+	}
+	if obj.Uirevision != nil {
+		e.key(`uirevision`)
+		e.any(obj.Uirevision)
+	}
+	if obj.Visible != nil {
+		e.key(`visible`)
+		e.bool(*obj.Visible)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutPolarDomain with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutPolarDomain) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutPolarDomain) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Column != 0 {
+		e.key(`column`)
+		e.int(int64(obj.Column))
+	}
+	if obj.Row != 0 {
+		e.key(`row`)
+		e.int(int64(obj.Row))
+	}
+	if obj.X != nil {
+		e.key(`x`)
+		e.any(obj.X)
+	}
+	if obj.Y != nil {
+		e.key(`y`)
+		e.any(obj.Y)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutPolarRadialaxisTickfont with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutPolarRadialaxisTickfont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutPolarRadialaxisTickfont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutPolarRadialaxisTitleFont with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutPolarRadialaxisTitleFont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutPolarRadialaxisTitleFont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutPolarRadialaxisTitle with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutPolarRadialaxisTitle) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutPolarRadialaxisTitle) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Font != nil {
+		e.key(`font`)
+		obj.Font.encodeJSON(e)
+	}
+	if obj.Text != nil {
+		e.key(`text`)
+		e.any(obj.Text)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutPolarRadialaxis with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutPolarRadialaxis) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutPolarRadialaxis) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Angle != 0 {
+		e.key(`angle`)
+		e.float(float64(obj.Angle))
+	}
+	if obj.Autorange != nil {
+		e.key(`autorange`)
+		e.any(obj.Autorange)
+	}
+	if obj.Autotypenumbers != "" {
+		e.key(`autotypenumbers`)
+		e.string(string(obj.Autotypenumbers))
+	}
+	if obj.Calendar != "" {
+		e.key(`calendar`)
+		e.string(string(obj.Calendar))
+	}
+	if obj.Categoryarray != nil {
+		e.key(`categoryarray`)
+		e.any(obj.Categoryarray)
+	}
+	if obj.Categoryarraysrc != nil {
+		e.key(`categoryarraysrc`)
+		e.any(obj.Categoryarraysrc)
+	}
+	if obj.Categoryorder != "" {
+		e.key(`categoryorder`)
+		e.string(string(obj.Categoryorder))
+	}
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Dtick != nil {
+		e.key(`dtick`)
+		e.any(obj.Dtick)
+	}
+	if obj.Exponentformat != "" {
+		e.key(`exponentformat`)
+		e.string(string(obj.Exponentformat))
+	}
+	if obj.Gridcolor != nil {
+		e.key(`gridcolor`)
+		e.any(obj.Gridcolor)
+	}
+	if obj.Gridwidth != 0 {
+		e.key(`gridwidth`)
+		e.float(float64(obj.Gridwidth))
+	}
+	if obj.Hoverformat != nil {
+		e.key(`hoverformat`)
+		e.any(obj.Hoverformat)
+	}
+	if obj.Layer != "" {
+		e.key(`layer`)
+		e.string(string(obj.Layer))
+	}
+	if obj.Linecolor != nil {
+		e.key(`linecolor`)
+		e.any(obj.Linecolor)
+	}
+	if obj.Linewidth != 0 {
+		e.key(`linewidth`)
+		e.float(float64(obj.Linewidth))
+	}
+	if obj.Minexponent != 0 {
+		e.key(`minexponent`)
+		e.float(float64(obj.Minexponent))
+	}
+	if obj.Nticks != 0 {
+		e.key(`nticks`)
+		e.int(int64(obj.Nticks))
+	}
+	if obj.Range != nil {
+		e.key(`range`)
+		e.any(obj.Range)
+	}
+	if obj.Rangemode != "" {
+		e.key(`rangemode`)
+		e.string(string(obj.Rangemode))
+	}
+	if obj.Separatethousands != nil {
+		e.key(`separatethousands`)
+		e.bool(*obj.Separatethousands)
+	}
+	if obj.Showexponent != "" {
+		e.key(`showexponent`)
+		e.string(string(obj.Showexponent))
+	}
+	if obj.Showgrid != nil {
+		e.key(`showgrid`)
+		e.bool(*obj.Showgrid)
+	}
+	if obj.Showline != nil {
+		e.key(`showline`)
+		e.bool(*obj.Showline)
+	}
+	if obj.Showticklabels != nil {
+		e.key(`showticklabels`)
+		e.bool(*obj.Showticklabels)
+	}
+	if obj.Showtickprefix != "" {
+		e.key(`showtickprefix`)
+		e.string(string(obj.Showtickprefix))
+	}
+	if obj.Showticksuffix != "" {
+		e.key(`showticksuffix`)
+		e.string(string(obj.Showticksuffix))
+	}
+	if obj.Side != "" {
+		e.key(`side`)
+		e.string(string(obj.Side))
+	}
+	if obj.Tick0 != nil {
+		e.key(`tick0`)
+		e.any(obj.Tick0)
+	}
+	if obj.Tickangle != 0 {
+		e.key(`tickangle`)
+		e.float(float64(obj.Tickangle))
+	}
+	if obj.Tickcolor != nil {
+		e.key(`tickcolor`)
+		e.any(obj.Tickcolor)
+	}
+	if obj.Tickfont != nil {
+		e.key(`tickfont`)
+		obj.Tickfont.encodeJSON(e)
+	}
+	if obj.Tickformat != nil {
+		e.key(`tickformat`)
+		e.any(obj.Tickformat)
+	}
+	if obj.Tickformatstops != nil {
+		e.key(`tickformatstops`)
+		e.any(obj.Tickformatstops)
+	}
+	if obj.Ticklen != 0 {
+		e.key(`ticklen`)
+		e.float(float64(obj.Ticklen))
+	}
+	if obj.Tickmode != "" {
+		e.key(`tickmode`)
+		e.string(string(obj.Tickmode))
+	}
+	if obj.Tickprefix != nil {
+		e.key(`tickprefix`)
+		e.any(obj.Tickprefix)
+	}
+	if obj.Ticks != "" {
+		e.key(`ticks`)
+		e.enum(string(obj.Ticks))
+	}
+	if obj.Ticksuffix != nil {
+		e.key(`ticksuffix`)
+		e.any(obj.Ticksuffix)
+	}
+	if obj.Ticktext != nil {
+		e.key(`ticktext`)
+		e.any(obj.Ticktext)
+	}
+	if obj.Ticktextsrc != nil {
+		e.key(`ticktextsrc`)
+		e.any(obj.Ticktextsrc)
+	}
+	if obj.Tickvals != nil {
+		e.key(`tickvals`)
+		e.any(obj.Tickvals)
+	}
+	if obj.Tickvalssrc != nil {
+		e.key(`tickvalssrc`)
+		e.any(obj.Tickvalssrc)
+	}
+	if obj.Tickwidth != 0 {
+		e.key(`tickwidth`)
+		e.float(float64(obj.Tickwidth))
+	}
+	if obj.Title != nil {
+		e.key(`title`)
+		obj.Title.encodeJSON(e)
+	}
+	if obj.Type != "" {
+		e.key(`type`)
+		e.string(string(obj.Type))
+	}
+	if obj.Uirevision != nil {
+		e.key(`uirevision`)
+		e.any(obj.Uirevision)
+	}
+	if obj.Visible != nil {
+		e.key(`visible`)
+		e.bool(*obj.Visible)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutPolar with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutPolar) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutPolar) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Angularaxis != nil {
+		e.key(`angularaxis`)
+		obj.Angularaxis.encodeJSON(e)
+	}
+	if obj.Bargap != 0 {
+		e.key(`bargap`)
+		e.float(float64(obj.Bargap))
+	}
+	if obj.Barmode != "" {
+		e.key(`barmode`)
+		e.string(string(obj.Barmode))
+	}
+	if obj.Bgcolor != nil {
+		e.key(`bgcolor`)
+		e.any(obj.Bgcolor)
+	}
+	if obj.Domain != nil {
+		e.key(`domain`)
+		obj.Domain.encodeJSON(e)
+	}
+	if obj.Gridshape != "" {
+		e.key(`gridshape`)
+		e.string(string(obj.Gridshape))
+	}
+	if obj.Hole != 0 {
+		e.key(`hole`)
+		e.float(float64(obj.Hole))
+	}
+	if obj.Radialaxis != nil {
+		e.key(`radialaxis`)
+		obj.Radialaxis.encodeJSON(e)
+	}
+	if obj.Sector != nil {
+		e.key(`sector`)
+		e.any(obj.Sector)
+	}
+	if obj.Uirevision != nil {
+		e.key(`uirevision`)
+		e.any(obj.Uirevision)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutRadialaxis with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutRadialaxis) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutRadialaxis) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Domain != nil {
+		e.key(`domain`)
+		e.any(obj.Domain)
+	}
+	if obj.Endpadding != 0 {
+		e.key(`endpadding`)
+		e.float(float64(obj.Endpadding))
+	}
+	if obj.Orientation != 0 {
+		e.key(`orientation`)
+		e.float(float64(obj.Orientation))
+	}
+	if obj.Range != nil {
+		e.key(`range`)
+		e.any(obj.Range)
+	}
+	if obj.Showline != nil {
+		e.key(`showline`)
+		e.bool(*obj.Showline)
+	}
+	if obj.Showticklabels != nil {
+		e.key(`showticklabels`)
+		e.bool(*obj.Showticklabels)
+	}
+	if obj.Tickcolor != nil {
+		e.key(`tickcolor`)
+		e.any(obj.Tickcolor)
+	}
+	if obj.Ticklen != 0 {
+		e.key(`ticklen`)
+		e.float(float64(obj.Ticklen))
+	}
+	if obj.Tickorientation != "" {
+		e.key(`tickorientation`)
+		e.string(string(obj.Tickorientation))
+	}
+	if obj.Ticksuffix != nil {
+		e.key(`ticksuffix`)
+		e.any(obj.Ticksuffix)
+	}
+	if obj.Visible != nil {
+		e.key(`visible`)
+		e.bool(*obj.Visible)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutSceneAspectratio with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutSceneAspectratio) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutSceneAspectratio) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.X != 0 {
+		e.key(`x`)
+		e.float(float64(obj.X))
+	}
+	if obj.Y != 0 {
+		e.key(`y`)
+		e.float(float64(obj.Y))
+	}
+	if obj.Z != 0 {
+		e.key(`z`)
+		e.float(float64(obj.Z))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutSceneCameraCenter with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutSceneCameraCenter) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutSceneCameraCenter) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.X != 0 {
+		e.key(`x`)
+		e.float(float64(obj.X))
+	}
+	if obj.Y != 0 {
+		e.key(`y`)
+		e.float(float64(obj.Y))
+	}
+	if obj.Z != 0 {
+		e.key(`z`)
+		e.float(float64(obj.Z))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutSceneCameraEye with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutSceneCameraEye) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutSceneCameraEye) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.X != 0 {
+		e.key(`x`)
+		e.float(float64(obj.X))
+	}
+	if obj.Y != 0 {
+		e.key(`y`)
+		e.float(float64(obj.Y))
+	}
+	if obj.Z != 0 {
+		e.key(`z`)
+		e.float(float64(obj.Z))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutSceneCameraProjection with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutSceneCameraProjection) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutSceneCameraProjection) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Type != "" {
+		e.key(`type`)
+		e.string(string(obj.Type))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutSceneCameraUp with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutSceneCameraUp) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutSceneCameraUp) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.X != 0 {
+		e.key(`x`)
+		e.float(float64(obj.X))
+	}
+	if obj.Y != 0 {
+		e.key(`y`)
+		e.float(float64(obj.Y))
+	}
+	if obj.Z != 0 {
+		e.key(`z`)
+		e.float(float64(obj.Z))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutSceneCamera with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutSceneCamera) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutSceneCamera) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Center != nil {
+		e.key(`center`)
+		obj.Center.encodeJSON(e)
+	}
+	if obj.Eye != nil {
+		e.key(`eye`)
+		obj.Eye.encodeJSON(e)
+	}
+	if obj.Projection != nil {
+		e.key(`projection`)
+		obj.Projection.encodeJSON(e)
+	}
+	if obj.Up != nil {
+		e.key(`up`)
+		obj.Up.encodeJSON(e)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutSceneDomain with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutSceneDomain) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutSceneDomain) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Column != 0 {
+		e.key(`column`)
+		e.int(int64(obj.Column))
+	}
+	if obj.Row != 0 {
+		e.key(`row`)
+		e.int(int64(obj.Row))
+	}
+	if obj.X != nil {
+		e.key(`x`)
+		e.any(obj.X)
+	}
+	if obj.Y != nil {
+		e.key(`y`)
+		e.any(obj.Y)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutSceneXaxisTickfont with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutSceneXaxisTickfont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutSceneXaxisTickfont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutSceneXaxisTitleFont with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutSceneXaxisTitleFont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutSceneXaxisTitleFont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutSceneXaxisTitle with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutSceneXaxisTitle) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutSceneXaxisTitle) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Font != nil {
+		e.key(`font`)
+		obj.Font.encodeJSON(e)
+	}
+	if obj.Text != nil {
+		e.key(`text`)
+		e.any(obj.Text)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutSceneXaxis with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutSceneXaxis) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutSceneXaxis) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Autorange != nil {
+		e.key(`autorange`)
+		e.any(obj.Autorange)
+	}
+	if obj.Autotypenumbers != "" {
+		e.key(`autotypenumbers`)
+		e.string(string(obj.Autotypenumbers))
+	}
+	if obj.Backgroundcolor != nil {
+		e.key(`backgroundcolor`)
+		e.any(obj.Backgroundcolor)
+	}
+	if obj.Calendar != "" {
+		e.key(`calendar`)
+		e.string(string(obj.Calendar))
+	}
+	if obj.Categoryarray != nil {
+		e.key(`categoryarray`)
+		e.any(obj.Categoryarray)
+	}
+	if obj.Categoryarraysrc != nil {
+		e.key(`categoryarraysrc`)
+		e.any(obj.Categoryarraysrc)
+	}
+	if obj.Categoryorder != "" {
+		e.key(`categoryorder`)
+		e.string(string(obj.Categoryorder))
+	}
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Dtick != nil {
+		e.key(`dtick`)
+		e.any(obj.Dtick)
+	}
+	if obj.Exponentformat != "" {
+		e.key(`exponentformat`)
+		e.string(string(obj.Exponentformat))
+	}
+	if obj.Gridcolor != nil {
+		e.key(`gridcolor`)
+		e.any(obj.Gridcolor)
+	}
+	if obj.Gridwidth != 0 {
+		e.key(`gridwidth`)
+		e.float(float64(obj.Gridwidth))
+	}
+	if obj.Hoverformat != nil {
+		e.key(`hoverformat`)
+		e.any(obj.Hoverformat)
+	}
+	if obj.Linecolor != nil {
+		e.key(`linecolor`)
+		e.any(obj.Linecolor)
+	}
+	if obj.Linewidth != 0 {
+		e.key(`linewidth`)
+		e.float(float64(obj.Linewidth))
+	}
+	if obj.Minexponent != 0 {
+		e.key(`minexponent`)
+		e.float(float64(obj.Minexponent))
+	}
+	if obj.Mirror != nil {
+		e.key(`mirror`)
+		e.any(obj.Mirror)
+	}
+	if obj.Nticks != 0 {
+		e.key(`nticks`)
+		e.int(int64(obj.Nticks))
+	}
+	if obj.Range != nil {
+		e.key(`range`)
+		e.any(obj.Range)
+	}
+	if obj.Rangemode != "" {
+		e.key(`rangemode`)
+		e.string(string(obj.Rangemode))
+	}
+	if obj.Separatethousands != nil {
+		e.key(`separatethousands`)
+		e.bool(*obj.Separatethousands)
+	}
+	if obj.Showaxeslabels != nil {
+		e.key(`showaxeslabels`)
+		e.bool(*obj.Showaxeslabels)
+	}
+	if obj.Showbackground != nil {
+		e.key(`showbackground`)
+		e.bool(*obj.Showbackground)
+	}
+	if obj.Showexponent != "" {
+		e.key(`showexponent`)
+		e.string(string(obj.Showexponent))
+	}
+	if obj.Showgrid != nil {
+		e.key(`showgrid`)
+		e.bool(*obj.Showgrid)
+	}
+	if obj.Showline != nil {
+		e.key(`showline`)
+		e.bool(*obj.Showline)
+	}
+	if obj.Showspikes != nil {
+		e.key(`showspikes`)
+		e.bool(*obj.Showspikes)
+	}
+	if obj.Showticklabels != nil {
+		e.key(`showticklabels`)
+		e.bool(*obj.Showticklabels)
+	}
+	if obj.Showtickprefix != "" {
+		e.key(`showtickprefix`)
+		e.string(string(obj.Showtickprefix))
+	}
+	if obj.Showticksuffix != "" {
+		e.key(`showticksuffix`)
+		e.string(string(obj.Showticksuffix))
+	}
+	if obj.Spikecolor != nil {
+		e.key(`spikecolor`)
+		e.any(obj.Spikecolor)
+	}
+	if obj.Spikesides != nil {
+		e.key(`spikesides`)
+		e.bool(*obj.Spikesides)
+	}
+	if obj.Spikethickness != 0 {
+		e.key(`spikethickness`)
+		e.float(float64(obj.Spikethickness))
+	}
+	if obj.Tick0 != nil {
+		e.key(`tick0`)
+		e.any(obj.Tick0)
+	}
+	if obj.Tickangle != 0 {
+		e.key(`tickangle`)
+		e.float(float64(obj.Tickangle))
+	}
+	if obj.Tickcolor != nil {
+		e.key(`tickcolor`)
+		e.any(obj.Tickcolor)
+	}
+	if obj.Tickfont != nil {
+		e.key(`tickfont`)
+		obj.Tickfont.encodeJSON(e)
+	}
+	if obj.Tickformat != nil {
+		e.key(`tickformat`)
+		e.any(obj.Tickformat)
+	}
+	if obj.Tickformatstops != nil {
+		e.key(`tickformatstops`)
+		e.any(obj.Tickformatstops)
+	}
+	if obj.Ticklen != 0 {
+		e.key(`ticklen`)
+		e.float(float64(obj.Ticklen))
+	}
+	if obj.Tickmode != "" {
+		e.key(`tickmode`)
+		e.string(string(obj.Tickmode))
+	}
+	if obj.Tickprefix != nil {
+		e.key(`tickprefix`)
+		e.any(obj.Tickprefix)
+	}
+	if obj.Ticks != "" {
+		e.key(`ticks`)
+		e.enum(string(obj.Ticks))
+	}
+	if obj.Ticksuffix != nil {
+		e.key(`ticksuffix`)
+		e.any(obj.Ticksuffix)
+	}
+	if obj.Ticktext != nil {
+		e.key(`ticktext`)
+		e.any(obj.Ticktext)
+	}
+	if obj.Ticktextsrc != nil {
+		e.key(`ticktextsrc`)
+		e.any(obj.Ticktextsrc)
+	}
+	if obj.Tickvals != nil {
+		e.key(`tickvals`)
+		e.any(obj.Tickvals)
+	}
+	if obj.Tickvalssrc != nil {
+		e.key(`tickvalssrc`)
+		e.any(obj.Tickvalssrc)
+	}
+	if obj.Tickwidth != 0 {
+		e.key(`tickwidth`)
+		e.float(float64(obj.Tickwidth))
+	}
+	if obj.Title != nil {
+		e.key(`title`)
+		obj.Title.encodeJSON(e)
+	}
+	if obj.Type != "" {
+		e.key(`type`)
+		e.string(string(obj.Type))
+	}
+	if obj.Visible != nil {
+		e.key(`visible`)
+		e.bool(*obj.Visible)
+	}
+	if obj.Zeroline != nil {
+		e.key(`zeroline`)
+		e.bool(*obj.Zeroline)
+	}
+	if obj.Zerolinecolor != nil {
+		e.key(`zerolinecolor`)
+		e.any(obj.Zerolinecolor)
+	}
+	if obj.Zerolinewidth != 0 {
+		e.key(`zerolinewidth`)
+		e.float(float64(obj.Zerolinewidth))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutSceneYaxisTickfont with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutSceneYaxisTickfont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutSceneYaxisTickfont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutSceneYaxisTitleFont with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutSceneYaxisTitleFont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutSceneYaxisTitleFont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutSceneYaxisTitle with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutSceneYaxisTitle) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutSceneYaxisTitle) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Font != nil {
+		e.key(`font`)
+		obj.Font.encodeJSON(e)
+	}
+	if obj.Text != nil {
+		e.key(`text`)
+		e.any(obj.Text)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutSceneYaxis with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutSceneYaxis) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutSceneYaxis) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Autorange != nil {
+		e.key(`autorange`)
+		e.any(obj.Autorange)
+	}
+	if obj.Autotypenumbers != "" {
+		e.key(`autotypenumbers`)
+		e.string(string(obj.Autotypenumbers))
+	}
+	if obj.Backgroundcolor != nil {
+		e.key(`backgroundcolor`)
+		e.any(obj.Backgroundcolor)
+	}
+	if obj.Calendar != "" {
+		e.key(`calendar`)
+		e.string(string(obj.Calendar))
+	}
+	if obj.Categoryarray != nil {
+		e.key(`categoryarray`)
+		e.any(obj.Categoryarray)
+	}
+	if obj.Categoryarraysrc != nil {
+		e.key(`categoryarraysrc`)
+		e.any(obj.Categoryarraysrc)
+	}
+	if obj.Categoryorder != "" {
+		e.key(`categoryorder`)
+		e.string(string(obj.Categoryorder))
+	}
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Dtick != nil {
+		e.key(`dtick`)
+		e.any(obj.Dtick)
+	}
+	if obj.Exponentformat != "" {
+		e.key(`exponentformat`)
+		e.string(string(obj.Exponentformat))
+	}
+	if obj.Gridcolor != nil {
+		e.key(`gridcolor`)
+		e.any(obj.Gridcolor)
+	}
+	if obj.Gridwidth != 0 {
+		e.key(`gridwidth`)
+		e.float(float64(obj.Gridwidth))
+	}
+	if obj.Hoverformat != nil {
+		e.key(`hoverformat`)
+		e.any(obj.Hoverformat)
+	}
+	if obj.Linecolor != nil {
+		e.key(`linecolor`)
+		e.any(obj.Linecolor)
+	}
+	if obj.Linewidth != 0 {
+		e.key(`linewidth`)
+		e.float(float64(obj.Linewidth))
+	}
+	if obj.Minexponent != 0 {
+		e.key(`minexponent`)
+		e.float(float64(obj.Minexponent))
+	}
+	if obj.Mirror != nil {
+		e.key(`mirror`)
+		e.any(obj.Mirror)
+	}
+	if obj.Nticks != 0 {
+		e.key(`nticks`)
+		e.int(int64(obj.Nticks))
+	}
+	if obj.Range != nil {
+		e.key(`range`)
+		e.any(obj.Range)
+	}
+	if obj.Rangemode != "" {
+		e.key(`rangemode`)
+		e.string(string(obj.Rangemode))
+	}
+	if obj.Separatethousands != nil {
+		e.key(`separatethousands`)
+		e.bool(*obj.Separatethousands)
+	}
+	if obj.Showaxeslabels != nil {
+		e.key(`showaxeslabels`)
+		e.bool(*obj.Showaxeslabels)
+	}
+	if obj.Showbackground != nil {
+		e.key(`showbackground`)
+		e.bool(*obj.Showbackground)
+	}
+	if obj.Showexponent != "" {
+		e.key(`showexponent`)
+		e.string(string(obj.Showexponent))
+	}
+	if obj.Showgrid != nil {
+		e.key(`showgrid`)
+		e.bool(*obj.Showgrid)
+	}
+	if obj.Showline != nil {
+		e.key(`showline`)
+		e.bool(*obj.Showline)
+	}
+	if obj.Showspikes != nil {
+		e.key(`showspikes`)
+		e.bool(*obj.Showspikes)
+	}
+	if obj.Showticklabels != nil {
+		e.key(`showticklabels`)
+		e.bool(*obj.Showticklabels)
+	}
+	if obj.Showtickprefix != "" {
+		e.key(`showtickprefix`)
+		e.string(string(obj.Showtickprefix))
+	}
+	if obj.Showticksuffix != "" {
+		e.key(`showticksuffix`)
+		e.string(string(obj.Showticksuffix))
+	}
+	if obj.Spikecolor != nil {
+		e.key(`spikecolor`)
+		e.any(obj.Spikecolor)
+	}
+	if obj.Spikesides != nil {
+		e.key(`spikesides`)
+		e.bool(*obj.Spikesides)
+	}
+	if obj.Spikethickness != 0 {
+		e.key(`spikethickness`)
+		e.float(float64(obj.Spikethickness))
+	}
+	if obj.Tick0 != nil {
+		e.key(`tick0`)
+		e.any(obj.Tick0)
+	}
+	if obj.Tickangle != 0 {
+		e.key(`tickangle`)
+		e.float(float64(obj.Tickangle))
+	}
+	if obj.Tickcolor != nil {
+		e.key(`tickcolor`)
+		e.any(obj.Tickcolor)
+	}
+	if obj.Tickfont != nil {
+		e.key(`tickfont`)
+		obj.Tickfont.encodeJSON(e)
+	}
+	if obj.Tickformat != nil {
+		e.key(`tickformat`)
+		e.any(obj.Tickformat)
+	}
+	if obj.Tickformatstops != nil {
+		e.key(`tickformatstops`)
+		e.any(obj.Tickformatstops)
+	}
+	if obj.Ticklen != 0 {
+		e.key(`ticklen`)
+		e.float(float64(obj.Ticklen))
+	}
+	if obj.Tickmode != "" {
+		e.key(`tickmode`)
+		e.string(string(obj.Tickmode))
+	}
+	if obj.Tickprefix != nil {
+		e.key(`tickprefix`)
+		e.any(obj.Tickprefix)
+	}
+	if obj.Ticks != "" {
+		e.key(`ticks`)
+		e.enum(string(obj.Ticks))
+	}
+	if obj.Ticksuffix != nil {
+		e.key(`ticksuffix`)
+		e.any(obj.Ticksuffix)
+	}
+	if obj.Ticktext != nil {
+		e.key(`ticktext`)
+		e.any(obj.Ticktext)
+	}
+	if obj.Ticktextsrc != nil {
+		e.key(`ticktextsrc`)
+		e.any(obj.Ticktextsrc)
+	}
+	if obj.Tickvals != nil {
+		e.key(`tickvals`)
+		e.any(obj.Tickvals)
+	}
+	if obj.Tickvalssrc != nil {
+		e.key(`tickvalssrc`)
+		e.any(obj.Tickvalssrc)
+	}
+	if obj.Tickwidth != 0 {
+		e.key(`tickwidth`)
+		e.float(float64(obj.Tickwidth))
+	}
+	if obj.Title != nil {
+		e.key(`title`)
+		obj.Title.encodeJSON(e)
+	}
+	if obj.Type != "" {
+		e.key(`type`)
+		e.string(string(obj.Type))
+	}
+	if obj.Visible != nil {
+		e.key(`visible`)
+		e.bool(*obj.Visible)
+	}
+	if obj.Zeroline != nil {
+		e.key(`zeroline`)
+		e.bool(*obj.Zeroline)
+	}
+	if obj.Zerolinecolor != nil {
+		e.key(`zerolinecolor`)
+		e.any(obj.Zerolinecolor)
+	}
+	if obj.Zerolinewidth != 0 {
+		e.key(`zerolinewidth`)
+		e.float(float64(obj.Zerolinewidth))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutSceneZaxisTickfont with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutSceneZaxisTickfont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutSceneZaxisTickfont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutSceneZaxisTitleFont with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutSceneZaxisTitleFont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutSceneZaxisTitleFont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutSceneZaxisTitle with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutSceneZaxisTitle) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutSceneZaxisTitle) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Font != nil {
+		e.key(`font`)
+		obj.Font.encodeJSON(e)
+	}
+	if obj.Text != nil {
+		e.key(`text`)
+		e.any(obj.Text)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutSceneZaxis with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutSceneZaxis) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutSceneZaxis) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Autorange != nil {
+		e.key(`autorange`)
+		e.any(obj.Autorange)
+	}
+	if obj.Autotypenumbers != "" {
+		e.key(`autotypenumbers`)
+		e.string(string(obj.Autotypenumbers))
+	}
+	if obj.Backgroundcolor != nil {
+		e.key(`backgroundcolor`)
+		e.any(obj.Backgroundcolor)
+	}
+	if obj.Calendar != "" {
+		e.key(`calendar`)
+		e.string(string(obj.Calendar))
+	}
+	if obj.Categoryarray != nil {
+		e.key(`categoryarray`)
+		e.any(obj.Categoryarray)
+	}
+	if obj.Categoryarraysrc != nil {
+		e.key(`categoryarraysrc`)
+		e.any(obj.Categoryarraysrc)
+	}
+	if obj.Categoryorder != "" {
+		e.key(`categoryorder`)
+		e.string(string(obj.Categoryorder))
+	}
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Dtick != nil {
+		e.key(`dtick`)
+		e.any(obj.Dtick)
+	}
+	if obj.Exponentformat != "" {
+		e.key(`exponentformat`)
+		e.string(string(obj.Exponentformat))
+	}
+	if obj.Gridcolor != nil {
+		e.key(`gridcolor`)
+		e.any(obj.Gridcolor)
+	}
+	if obj.Gridwidth != 0 {
+		e.key(`gridwidth`)
+		e.float(float64(obj.Gridwidth))
+	}
+	if obj.Hoverformat != nil {
+		e.key(`hoverformat`)
+		e.any(obj.Hoverformat)
+	}
+	if obj.Linecolor != nil {
+		e.key(`linecolor`)
+		e.any(obj.Linecolor)
+	}
+	if obj.Linewidth != 0 {
+		e.key(`linewidth`)
+		e.float(float64(obj.Linewidth))
+	}
+	if obj.Minexponent != 0 {
+		e.key(`minexponent`)
+		e.float(float64(obj.Minexponent))
+	}
+	if obj.Mirror != nil {
+		e.key(`mirror`)
+		e.any(obj.Mirror)
+	}
+	if obj.Nticks != 0 {
+		e.key(`nticks`)
+		e.int(int64(obj.Nticks))
+	}
+	if obj.Range != nil {
+		e.key(`range`)
+		e.any(obj.Range)
+	}
+	if obj.Rangemode != "" {
+		e.key(`rangemode`)
+		e.string(string(obj.Rangemode))
+	}
+	if obj.Separatethousands != nil {
+		e.key(`separatethousands`)
+		e.bool(*obj.Separatethousands)
+	}
+	if obj.Showaxeslabels != nil {
+		e.key(`showaxeslabels`)
+		e.bool(*obj.Showaxeslabels)
+	}
+	if obj.Showbackground != nil {
+		e.key(`showbackground`)
+		e.bool(*obj.Showbackground)
+	}
+	if obj.Showexponent != "" {
+		e.key(`showexponent`)
+		e.string(string(obj.Showexponent))
+	}
+	if obj.Showgrid != nil {
+		e.key(`showgrid`)
+		e.bool(*obj.Showgrid)
+	}
+	if obj.Showline != nil {
+		e.key(`showline`)
+		e.bool(*obj.Showline)
+	}
+	if obj.Showspikes != nil {
+		e.key(`showspikes`)
+		e.bool(*obj.Showspikes)
+	}
+	if obj.Showticklabels != nil {
+		e.key(`showticklabels`)
+		e.bool(*obj.Showticklabels)
+	}
+	if obj.Showtickprefix != "" {
+		e.key(`showtickprefix`)
+		e.string(string(obj.Showtickprefix))
+	}
+	if obj.Showticksuffix != "" {
+		e.key(`showticksuffix`)
+		e.string(string(obj.Showticksuffix))
+	}
+	if obj.Spikecolor != nil {
+		e.key(`spikecolor`)
+		e.any(obj.Spikecolor)
+	}
+	if obj.Spikesides != nil {
+		e.key(`spikesides`)
+		e.bool(*obj.Spikesides)
+	}
+	if obj.Spikethickness != 0 {
+		e.key(`spikethickness`)
+		e.float(float64(obj.Spikethickness))
+	}
+	if obj.Tick0 != nil {
+		e.key(`tick0`)
+		e.any(obj.Tick0)
+	}
+	if obj.Tickangle != 0 {
+		e.key(`tickangle`)
+		e.float(float64(obj.Tickangle))
+	}
+	if obj.Tickcolor != nil {
+		e.key(`tickcolor`)
+		e.any(obj.Tickcolor)
+	}
+	if obj.Tickfont != nil {
+		e.key(`tickfont`)
+		obj.Tickfont.encodeJSON(e)
+	}
+	if obj.Tickformat != nil {
+		e.key(`tickformat`)
+		e.any(obj.Tickformat)
+	}
+	if obj.Tickformatstops != nil {
+		e.key(`tickformatstops`)
+		e.any(obj.Tickformatstops)
+	}
+	if obj.Ticklen != 0 {
+		e.key(`ticklen`)
+		e.float(float64(obj.Ticklen))
+	}
+	if obj.Tickmode != "" {
+		e.key(`tickmode`)
+		e.string(string(obj.Tickmode))
+	}
+	if obj.Tickprefix != nil {
+		e.key(`tickprefix`)
+		e.any(obj.Tickprefix)
+	}
+	if obj.Ticks != "" {
+		e.key(`ticks`)
+		e.enum(string(obj.Ticks))
+	}
+	if obj.Ticksuffix != nil {
+		e.key(`ticksuffix`)
+		e.any(obj.Ticksuffix)
+	}
+	if obj.Ticktext != nil {
+		e.key(`ticktext`)
+		e.any(obj.Ticktext)
+	}
+	if obj.Ticktextsrc != nil {
+		e.key(`ticktextsrc`)
+		e.any(obj.Ticktextsrc)
+	}
+	if obj.Tickvals != nil {
+		e.key(`tickvals`)
+		e.any(obj.Tickvals)
+	}
+	if obj.Tickvalssrc != nil {
+		e.key(`tickvalssrc`)
+		e.any(obj.Tickvalssrc)
+	}
+	if obj.Tickwidth != 0 {
+		e.key(`tickwidth`)
+		e.float(float64(obj.Tickwidth))
+	}
+	if obj.Title != nil {
+		e.key(`title`)
+		obj.Title.encodeJSON(e)
+	}
+	if obj.Type != "" {
+		e.key(`type`)
+		e.string(string(obj.Type))
+	}
+	if obj.Visible != nil {
+		e.key(`visible`)
+		e.bool(*obj.Visible)
+	}
+	if obj.Zeroline != nil {
+		e.key(`zeroline`)
+		e.bool(*obj.Zeroline)
+	}
+	if obj.Zerolinecolor != nil {
+		e.key(`zerolinecolor`)
+		e.any(obj.Zerolinecolor)
+	}
+	if obj.Zerolinewidth != 0 {
+		e.key(`zerolinewidth`)
+		e.float(float64(obj.Zerolinewidth))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutScene with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutScene) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutScene) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Annotations != nil {
+		e.key(`annotations`)
+		e.any(obj.Annotations)
+	}
+	if obj.Aspectmode != "" {
+		e.key(`aspectmode`)
+		e.string(string(obj.Aspectmode))
+	}
+	if obj.Aspectratio != nil {
+		e.key(`aspectratio`)
+		obj.Aspectratio.encodeJSON(e)
+	}
+	if obj.Bgcolor != nil {
+		e.key(`bgcolor`)
+		e.any(obj.Bgcolor)
+	}
+	if obj.Camera != nil {
+		e.key(`camera`)
+		obj.Camera.encodeJSON(e)
+	}
+	if obj.Domain != nil {
+		e.key(`domain`)
+		obj.Domain.encodeJSON(e)
+	}
+	if obj.Dragmode != nil {
+		e.key(`dragmode`)
+		e.any(obj.Dragmode)
+	}
+	if obj.Hovermode != nil {
+		e.key(`hovermode`)
+		e.any(obj.Hovermode)
+	}
+	if obj.Uirevision != nil {
+		e.key(`uirevision`)
+		e.any(obj.Uirevision)
+	}
+	if obj.Xaxis != nil {
+		e.key(`xaxis`)
+		obj.Xaxis.encodeJSON(e)
+	}
+	if obj.Yaxis != nil {
+		e.key(`yaxis`)
+		obj.Yaxis.encodeJSON(e)
+	}
+	if obj.Zaxis != nil {
+		e.key(`zaxis`)
+		obj.Zaxis.encodeJSON(e)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutTernaryAaxisTickfont with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutTernaryAaxisTickfont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutTernaryAaxisTickfont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutTernaryAaxisTitleFont with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutTernaryAaxisTitleFont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutTernaryAaxisTitleFont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutTernaryAaxisTitle with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutTernaryAaxisTitle) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutTernaryAaxisTitle) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Font != nil {
+		e.key(`font`)
+		obj.Font.encodeJSON(e)
+	}
+	if obj.Text != nil {
+		e.key(`text`)
+		e.any(obj.Text)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutTernaryAaxis with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutTernaryAaxis) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutTernaryAaxis) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Dtick != nil {
+		e.key(`dtick`)
+		e.any(obj.Dtick)
+	}
+	if obj.Exponentformat != "" {
+		e.key(`exponentformat`)
+		e.string(string(obj.Exponentformat))
+	}
+	if obj.Gridcolor != nil {
+		e.key(`gridcolor`)
+		e.any(obj.Gridcolor)
+	}
+	if obj.Gridwidth != 0 {
+		e.key(`gridwidth`)
+		e.float(float64(obj.Gridwidth))
+	}
+	if obj.Hoverformat != nil {
+		e.key(`hoverformat`)
+		e.any(obj.Hoverformat)
+	}
+	if obj.Layer != "" {
+		e.key(`layer`)
+		e.string(string(obj.Layer))
+	}
+	if obj.Linecolor != nil {
+		e.key(`linecolor`)
+		e.any(obj.Linecolor)
+	}
+	if obj.Linewidth != 0 {
+		e.key(`linewidth`)
+		e.float(float64(obj.Linewidth))
+	}
+	if obj.Min != 0 {
+		e.key(`min`)
+		e.float(float64(obj.Min))
+	}
+	if obj.Minexponent != 0 {
+		e.key(`minexponent`)
+		e.float(float64(obj.Minexponent))
+	}
+	if obj.Nticks != 0 {
+		e.key(`nticks`)
+		e.int(int64(obj.Nticks))
+	}
+	if obj.Separatethousands != nil {
+		e.key(`separatethousands`)
+		e.bool(*obj.Separatethousands)
+	}
+	if obj.Showexponent != "" {
+		e.key(`showexponent`)
+		e.string(string(obj.Showexponent))
+	}
+	if obj.Showgrid != nil {
+		e.key(`showgrid`)
+		e.bool(*obj.Showgrid)
+	}
+	if obj.Showline != nil {
+		e.key(`showline`)
+		e.bool(*obj.Showline)
+	}
+	if obj.Showticklabels != nil {
+		e.key(`showticklabels`)
+		e.bool(*obj.Showticklabels)
+	}
+	if obj.Showtickprefix != "" {
+		e.key(`showtickprefix`)
+		e.string(string(obj.Showtickprefix))
+	}
+	if obj.Showticksuffix != "" {
+		e.key(`showticksuffix`)
+		e.string(string(obj.Showticksuffix))
+	}
+	if obj.Tick0 != nil {
+		e.key(`tick0`)
+		e.any(obj.Tick0)
+	}
+	if obj.Tickangle != 0 {
+		e.key(`tickangle`)
+		e.float(float64(obj.Tickangle))
+	}
+	if obj.Tickcolor != nil {
+		e.key(`tickcolor`)
+		e.any(obj.Tickcolor)
+	}
+	if obj.Tickfont != nil {
+		e.key(`tickfont`)
+		obj.Tickfont.encodeJSON(e)
+	}
+	if obj.Tickformat != nil {
+		e.key(`tickformat`)
+		e.any(obj.Tickformat)
+	}
+	if obj.Tickformatstops != nil {
+		e.key(`tickformatstops`)
+		e.any(obj.Tickformatstops)
+	}
+	if obj.Ticklen != 0 {
+		e.key(`ticklen`)
+		e.float(float64(obj.Ticklen))
+	}
+	if obj.Tickmode != "" {
+		e.key(`tickmode`)
+		e.string(string(obj.Tickmode))
+	}
+	if obj.Tickprefix != nil {
+		e.key(`tickprefix`)
+		e.any(obj.Tickprefix)
+	}
+	if obj.Ticks != "" {
+		e.key(`ticks`)
+		e.enum(string(obj.Ticks))
+	}
+	if obj.Ticksuffix != nil {
+		e.key(`ticksuffix`)
+		e.any(obj.Ticksuffix)
+	}
+	if obj.Ticktext != nil {
+		e.key(`ticktext`)
+		e.any(obj.Ticktext)
+	}
+	if obj.Ticktextsrc != nil {
+		e.key(`ticktextsrc`)
+		e.any(obj.Ticktextsrc)
+	}
+	if obj.Tickvals != nil {
+		e.key(`tickvals`)
+		e.any(obj.Tickvals)
+	}
+	if obj.Tickvalssrc != nil {
+		e.key(`tickvalssrc`)
+		e.any(obj.Tickvalssrc)
+	}
+	if obj.Tickwidth != 0 {
+		e.key(`tickwidth`)
+		e.float(float64(obj.Tickwidth))
+	}
+	if obj.Title != nil {
+		e.key(`title`)
+		obj.Title.encodeJSON(e)
+	}
+	if obj.Uirevision != nil {
+		e.key(`uirevision`)
+		e.any(obj.Uirevision)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutTernaryBaxisTickfont with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutTernaryBaxisTickfont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutTernaryBaxisTickfont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutTernaryBaxisTitleFont with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutTernaryBaxisTitleFont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutTernaryBaxisTitleFont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutTernaryBaxisTitle with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutTernaryBaxisTitle) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutTernaryBaxisTitle) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Font != nil {
+		e.key(`font`)
+		obj.Font.encodeJSON(e)
+	}
+	if obj.Text != nil {
+		e.key(`text`)
+		e.any(obj.Text)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutTernaryBaxis with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutTernaryBaxis) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutTernaryBaxis) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Dtick != nil {
+		e.key(`dtick`)
+		e.any(obj.Dtick)
+	}
+	if obj.Exponentformat != "" {
+		e.key(`exponentformat`)
+		e.string(string(obj.Exponentformat))
+	}
+	if obj.Gridcolor != nil {
+		e.key(`gridcolor`)
+		e.any(obj.Gridcolor)
+	}
+	if obj.Gridwidth != 0 {
+		e.key(`gridwidth`)
+		e.float(float64(obj.Gridwidth))
+	}
+	if obj.Hoverformat != nil {
+		e.key(`hoverformat`)
+		e.any(obj.Hoverformat)
+	}
+	if obj.Layer != "" {
+		e.key(`layer`)
+		e.string(string(obj.Layer))
+	}
+	if obj.Linecolor != nil {
+		e.key(`linecolor`)
+		e.any(obj.Linecolor)
+	}
+	if obj.Linewidth != 0 {
+		e.key(`linewidth`)
+		e.float(float64(obj.Linewidth))
+	}
+	if obj.Min != 0 {
+		e.key(`min`)
+		e.float(float64(obj.Min))
+	}
+	if obj.Minexponent != 0 {
+		e.key(`minexponent`)
+		e.float(float64(obj.Minexponent))
+	}
+	if obj.Nticks != 0 {
+		e.key(`nticks`)
+		e.int(int64(obj.Nticks))
+	}
+	if obj.Separatethousands != nil {
+		e.key(`separatethousands`)
+		e.bool(*obj.Separatethousands)
+	}
+	if obj.Showexponent != "" {
+		e.key(`showexponent`)
+		e.string(string(obj.Showexponent))
+	}
+	if obj.Showgrid != nil {
+		e.key(`showgrid`)
+		e.bool(*obj.Showgrid)
+	}
+	if obj.Showline != nil {
+		e.key(`showline`)
+		e.bool(*obj.Showline)
+	}
+	if obj.Showticklabels != nil {
+		e.key(`showticklabels`)
+		e.bool(*obj.Showticklabels)
+	}
+	if obj.Showtickprefix != "" {
+		e.key(`showtickprefix`)
+		e.string(string(obj.Showtickprefix))
+	}
+	if obj.Showticksuffix != "" {
+		e.key(`showticksuffix`)
+		e.string(string(obj.Showticksuffix))
+	}
+	if obj.Tick0 != nil {
+		e.key(`tick0`)
+		e.any(obj.Tick0)
+	}
+	if obj.Tickangle != 0 {
+		e.key(`tickangle`)
+		e.float(float64(obj.Tickangle))
+	}
+	if obj.Tickcolor != nil {
+		e.key(`tickcolor`)
+		e.any(obj.Tickcolor)
+	}
+	if obj.Tickfont != nil {
+		e.key(`tickfont`)
+		obj.Tickfont.encodeJSON(e)
+	}
+	if obj.Tickformat != nil {
+		e.key(`tickformat`)
+		e.any(obj.Tickformat)
+	}
+	if obj.Tickformatstops != nil {
+		e.key(`tickformatstops`)
+		e.any(obj.Tickformatstops)
+	}
+	if obj.Ticklen != 0 {
+		e.key(`ticklen`)
+		e.float(float64(obj.Ticklen))
+	}
+	if obj.Tickmode != "" {
+		e.key(`tickmode`)
+		e.string(string(obj.Tickmode))
+	}
+	if obj.Tickprefix != nil {
+		e.key(`tickprefix`)
+		e.any(obj.Tickprefix)
+	}
+	if obj.Ticks != "" {
+		e.key(`ticks`)
+		e.enum(string(obj.Ticks))
+	}
+	if obj.Ticksuffix != nil {
+		e.key(`ticksuffix`)
+		e.any(obj.Ticksuffix)
+	}
+	if obj.Ticktext != nil {
+		e.key(`ticktext`)
+		e.any(obj.Ticktext)
+	}
+	if obj.Ticktextsrc != nil {
+		e.key(`ticktextsrc`)
+		e.any(obj.Ticktextsrc)
+	}
+	if obj.Tickvals != nil {
+		e.key(`tickvals`)
+		e.any(obj.Tickvals)
+	}
+	if obj.Tickvalssrc != nil {
+		e.key(`tickvalssrc`)
+		e.any(obj.Tickvalssrc)
+	}
+	if obj.Tickwidth != 0 {
+		e.key(`tickwidth`)
+		e.float(float64(obj.Tickwidth))
+	}
+	if obj.Title != nil {
+		e.key(`title`)
+		obj.Title.encodeJSON(e)
+	}
+	if obj.Uirevision != nil {
+		e.key(`uirevision`)
+		e.any(obj.Uirevision)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutTernaryCaxisTickfont with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutTernaryCaxisTickfont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutTernaryCaxisTickfont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutTernaryCaxisTitleFont with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutTernaryCaxisTitleFont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutTernaryCaxisTitleFont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutTernaryCaxisTitle with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutTernaryCaxisTitle) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutTernaryCaxisTitle) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Font != nil {
+		e.key(`font`)
+		obj.Font.encodeJSON(e)
+	}
+	if obj.Text != nil {
+		e.key(`text`)
+		e.any(obj.Text)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutTernaryCaxis with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutTernaryCaxis) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutTernaryCaxis) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Dtick != nil {
+		e.key(`dtick`)
+		e.any(obj.Dtick)
+	}
+	if obj.Exponentformat != "" {
+		e.key(`exponentformat`)
+		e.string(string(obj.Exponentformat))
+	}
+	if obj.Gridcolor != nil {
+		e.key(`gridcolor`)
+		e.any(obj.Gridcolor)
+	}
+	if obj.Gridwidth != 0 {
+		e.key(`gridwidth`)
+		e.float(float64(obj.Gridwidth))
+	}
+	if obj.Hoverformat != nil {
+		e.key(`hoverformat`)
+		e.any(obj.Hoverformat)
+	}
+	if obj.Layer != "" {
+		e.key(`layer`)
+		e.string(string(obj.Layer))
+	}
+	if obj.Linecolor != nil {
+		e.key(`linecolor`)
+		e.any(obj.Linecolor)
+	}
+	if obj.Linewidth != 0 {
+		e.key(`linewidth`)
+		e.float(float64(obj.Linewidth))
+	}
+	if obj.Min != 0 {
+		e.key(`min`)
+		e.float(float64(obj.Min))
+	}
+	if obj.Minexponent != 0 {
+		e.key(`minexponent`)
+		e.float(float64(obj.Minexponent))
+	}
+	if obj.Nticks != 0 {
+		e.key(`nticks`)
+		e.int(int64(obj.Nticks))
+	}
+	if obj.Separatethousands != nil {
+		e.key(`separatethousands`)
+		e.bool(*obj.Separatethousands)
+	}
+	if obj.Showexponent != "" {
+		e.key(`showexponent`)
+		e.string(string(obj.Showexponent))
+	}
+	if obj.Showgrid != nil {
+		e.key(`showgrid`)
+		e.bool(*obj.Showgrid)
+	}
+	if obj.Showline != nil {
+		e.key(`showline`)
+		e.bool(*obj.Showline)
+	}
+	if obj.Showticklabels != nil {
+		e.key(`showticklabels`)
+		e.bool(*obj.Showticklabels)
+	}
+	if obj.Showtickprefix != "" {
+		e.key(`showtickprefix`)
+		e.string(string(obj.Showtickprefix))
+	}
+	if obj.Showticksuffix != "" {
+		e.key(`showticksuffix`)
+		e.string(string(obj.Showticksuffix))
+	}
+	if obj.Tick0 != nil {
+		e.key(`tick0`)
+		e.any(obj.Tick0)
+	}
+	if obj.Tickangle != 0 {
+		e.key(`tickangle`)
+		e.float(float64(obj.Tickangle))
+	}
+	if obj.Tickcolor != nil {
+		e.key(`tickcolor`)
+		e.any(obj.Tickcolor)
+	}
+	if obj.Tickfont != nil {
+		e.key(`tickfont`)
+		obj.Tickfont.encodeJSON(e)
+	}
+	if obj.Tickformat != nil {
+		e.key(`tickformat`)
+		e.any(obj.Tickformat)
+	}
+	if obj.Tickformatstops != nil {
+		e.key(`tickformatstops`)
+		e.any(obj.Tickformatstops)
+	}
+	if obj.Ticklen != 0 {
+		e.key(`ticklen`)
+		e.float(float64(obj.Ticklen))
+	}
+	if obj.Tickmode != "" {
+		e.key(`tickmode`)
+		e.string(string(obj.Tickmode))
+	}
+	if obj.Tickprefix != nil {
+		e.key(`tickprefix`)
+		e.any(obj.Tickprefix)
+	}
+	if obj.Ticks != "" {
+		e.key(`ticks`)
+		e.enum(string(obj.Ticks))
+	}
+	if obj.Ticksuffix != nil {
+		e.key(`ticksuffix`)
+		e.any(obj.Ticksuffix)
+	}
+	if obj.Ticktext != nil {
+		e.key(`ticktext`)
+		e.any(obj.Ticktext)
+	}
+	if obj.Ticktextsrc != nil {
+		e.key(`ticktextsrc`)
+		e.any(obj.Ticktextsrc)
+	}
+	if obj.Tickvals != nil {
+		e.key(`tickvals`)
+		e.any(obj.Tickvals)
+	}
+	if obj.Tickvalssrc != nil {
+		e.key(`tickvalssrc`)
+		e.any(obj.Tickvalssrc)
+	}
+	if obj.Tickwidth != 0 {
+		e.key(`tickwidth`)
+		e.float(float64(obj.Tickwidth))
+	}
+	if obj.Title != nil {
+		e.key(`title`)
+		obj.Title.encodeJSON(e)
+	}
+	if obj.Uirevision != nil {
+		e.key(`uirevision`)
+		e.any(obj.Uirevision)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutTernaryDomain with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutTernaryDomain) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutTernaryDomain) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Column != 0 {
+		e.key(`column`)
+		e.int(int64(obj.Column))
+	}
+	if obj.Row != 0 {
+		e.key(`row`)
+		e.int(int64(obj.Row))
+	}
+	if obj.X != nil {
+		e.key(`x`)
+		e.any(obj.X)
+	}
+	if obj.Y != nil {
+		e.key(`y`)
+		e.any(obj.Y)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutTernary with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutTernary) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutTernary) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Aaxis != nil {
+		e.key(`aaxis`)
+		obj.Aaxis.encodeJSON(e)
+	}
+	if obj.Baxis != nil {
+		e.key(`baxis`)
+		obj.Baxis.encodeJSON(e)
+	}
+	if obj.Bgcolor != nil {
+		e.key(`bgcolor`)
+		e.any(obj.Bgcolor)
+	}
+	if obj.Caxis != nil {
+		e.key(`caxis`)
+		obj.Caxis.encodeJSON(e)
+	}
+	if obj.Domain != nil {
+		e.key(`domain`)
+		obj.Domain.encodeJSON(e)
+	}
+	if obj.Sum != 0 {
+		e.key(`sum`)
+		e.float(float64(obj.Sum))
+	}
+	if obj.Uirevision != nil {
+		e.key(`uirevision`)
+		e.any(obj.Uirevision)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutTitleFont with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutTitleFont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutTitleFont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutTitlePad with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutTitlePad) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutTitlePad) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.B != 0 {
+		e.key(`b`)
+		e.float(float64(obj.B))
+	}
+	if obj.L != 0 {
+		e.key(`l`)
+		e.float(float64(obj.L))
+	}
+	if obj.R != 0 {
+		e.key(`r`)
+		e.float(float64(obj.R))
+	}
+	if obj.T != 0 {
+		e.key(`t`)
+		e.float(float64(obj.T))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutTitle with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutTitle) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutTitle) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Font != nil {
+		e.key(`font`)
+		obj.Font.encodeJSON(e)
+	}
+	if obj.Pad != nil {
+		e.key(`pad`)
+		obj.Pad.encodeJSON(e)
+	}
+	if obj.Text != nil {
+		e.key(`text`)
+		e.any(obj.Text)
+	}
+	if obj.X != 0 {
+		e.key(`x`)
+		e.float(float64(obj.X))
+	}
+	if obj.Xanchor != "" {
+		e.key(`xanchor`)
+		e.string(string(obj.Xanchor))
+	}
+	if obj.Xref != "" {
+		e.key(`xref`)
+		e.string(string(obj.Xref))
+	}
+	if obj.Y != 0 {
+		e.key(`y`)
+		e.float(float64(obj.Y))
+	}
+	if obj.Yanchor != "" {
+		e.key(`yanchor`)
+		e.string(string(obj.Yanchor))
+	}
+	if obj.Yref != "" {
+		e.key(`yref`)
+		e.string(string(obj.Yref))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutTransition with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutTransition) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutTransition) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Duration != 0 {
+		e.key(`duration`)
+		e.float(float64(obj.Duration))
+	}
+	if obj.Easing != "" {
+		e.key(`easing`)
+		e.string(string(obj.Easing))
+	}
+	if obj.Ordering != "" {
+		e.key(`ordering`)
+		e.string(string(obj.Ordering))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutUniformtext with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutUniformtext) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutUniformtext) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Minsize != 0 {
+		e.key(`minsize`)
+		e.float(float64(obj.Minsize))
+	}
+	if obj.Mode != nil {
+		e.key(`mode`)
+		e.any(obj.Mode)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutXaxisRangeselectorFont with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutXaxisRangeselectorFont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutXaxisRangeselectorFont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutXaxisRangeselector with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutXaxisRangeselector) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutXaxisRangeselector) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Activecolor != nil {
+		e.key(`activecolor`)
+		e.any(obj.Activecolor)
+	}
+	if obj.Bgcolor != nil {
+		e.key(`bgcolor`)
+		e.any(obj.Bgcolor)
+	}
+	if obj.Bordercolor != nil {
+		e.key(`bordercolor`)
+		e.any(obj.Bordercolor)
+	}
+	if obj.Borderwidth != 0 {
+		e.key(`borderwidth`)
+		e.float(float64(obj.Borderwidth))
+	}
+	if obj.Buttons != nil {
+		e.key(`buttons`)
+		e.any(obj.Buttons)
+	}
+	if obj.Font != nil {
+		e.key(`font`)
+		obj.Font.encodeJSON(e)
+	}
+	if obj.Visible != nil {
+		e.key(`visible`)
+		e.bool(*obj.Visible)
+	}
+	if obj.X != 0 {
+		e.key(`x`)
+		e.float(float64(obj.X))
+	}
+	if obj.Xanchor != "" {
+		e.key(`xanchor`)
+		e.string(string(obj.Xanchor))
+	}
+	if obj.Y != 0 {
+		e.key(`y`)
+		e.float(float64(obj.Y))
+	}
+	if obj.Yanchor != "" {
+		e.key(`yanchor`)
+		e.string(string(obj.Yanchor))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutXaxisRangesliderYaxis with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutXaxisRangesliderYaxis) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutXaxisRangesliderYaxis) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Range != nil {
+		e.key(`range`)
+		e.any(obj.Range)
+	}
+	if obj.Rangemode != "" {
+		e.key(`rangemode`)
+		e.string(string(obj.Rangemode))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutXaxisRangeslider with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutXaxisRangeslider) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutXaxisRangeslider) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Autorange != nil {
+		e.key(`autorange`)
+		e.bool(*obj.Autorange)
+	}
+	if obj.Bgcolor != nil {
+		e.key(`bgcolor`)
+		e.any(obj.Bgcolor)
+	}
+	if obj.Bordercolor != nil {
+		e.key(`bordercolor`)
+		e.any(obj.Bordercolor)
+	}
+	if obj.Borderwidth != 0 {
+		e.key(`borderwidth`)
+		e.int(int64(obj.Borderwidth))
+	}
+	if obj.Range != nil {
+		e.key(`range`)
+		e.any(obj.Range)
+	}
+	if obj.Thickness != 0 {
+		e.key(`thickness`)
+		e.float(float64(obj.Thickness))
+	}
+	if obj.Visible != nil {
+		e.key(`visible`)
+		e.bool(*obj.Visible)
+	}
+	if obj.Yaxis != nil {
+		e.key(`yaxis`)
+		obj.Yaxis.encodeJSON(e)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutXaxisTickfont with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutXaxisTickfont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutXaxisTickfont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutXaxisTitleFont with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutXaxisTitleFont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutXaxisTitleFont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutXaxisTitle with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutXaxisTitle) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutXaxisTitle) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Font != nil {
+		e.key(`font`)
+		obj.Font.encodeJSON(e)
+	}
+	if obj.Standoff != 0 {
+		e.key(`standoff`)
+		e.float(float64(obj.Standoff))
+	}
+	if obj.Text != nil {
+		e.key(`text`)
+		e.any(obj.Text)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutXaxis with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutXaxis) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutXaxis) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Anchor != "" {
+		e.key(`anchor`)
+		e.string(string(obj.Anchor))
+	}
+	if obj.Automargin != nil {
+		e.key(`automargin`)
+		e.bool(*obj.Automargin)
+	}
+	if obj.Autorange != nil {
+		e.key(`autorange`)
+		e.any(obj.Autorange)
+	}
+	if obj.Autotypenumbers != "" {
+		e.key(`autotypenumbers`)
+		e.string(string(obj.Autotypenumbers))
+	}
+	if obj.Calendar != "" {
+		e.key(`calendar`)
+		e.string(string(obj.Calendar))
+	}
+	if obj.Categoryarray != nil {
+		e.key(`categoryarray`)
+		e.any(obj.Categoryarray)
+	}
+	if obj.Categoryarraysrc != nil {
+		e.key(`categoryarraysrc`)
+		e.any(obj.Categoryarraysrc)
+	}
+	if obj.Categoryorder != "" {
+		e.key(`categoryorder`)
+		e.string(string(obj.Categoryorder))
+	}
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Constrain != "" {
+		e.key(`constrain`)
+		e.string(string(obj.Constrain))
+	}
+	if obj.Constraintoward != "" {
+		e.key(`constraintoward`)
+		e.string(string(obj.Constraintoward))
+	}
+	if obj.Dividercolor != nil {
+		e.key(`dividercolor`)
+		e.any(obj.Dividercolor)
+	}
+	if obj.Dividerwidth != 0 {
+		e.key(`dividerwidth`)
+		e.float(float64(obj.Dividerwidth))
+	}
+	if obj.Domain != nil {
+		e.key(`domain`)
+		e.any(obj.Domain)
+	}
+	if obj.Dtick != nil {
+		e.key(`dtick`)
+		e.any(obj.Dtick)
+	}
+	if obj.Exponentformat != "" {
+		e.key(`exponentformat`)
+		e.string(string(obj.Exponentformat))
+	}
+	if obj.Fixedrange != nil {
+		e.key(`fixedrange`)
+		e.bool(*obj.Fixedrange)
+	}
+	if obj.Gridcolor != nil {
+		e.key(`gridcolor`)
+		e.any(obj.Gridcolor)
+	}
+	if obj.Gridwidth != 0 {
+		e.key(`gridwidth`)
+		e.float(float64(obj.Gridwidth))
+	}
+	if obj.Hoverformat != nil {
+		e.key(`hoverformat`)
+		e.any(obj.Hoverformat)
+	}
+	if obj.Layer != "" {
+		e.key(`layer`)
+		e.string(string(obj.Layer))
+	}
+	if obj.Linecolor != nil {
+		e.key(`linecolor`)
+		e.any(obj.Linecolor)
+	}
+	if obj.Linewidth != 0 {
+		e.key(`linewidth`)
+		e.float(float64(obj.Linewidth))
+	}
+	if obj.Matches != "" {
+		e.key(`matches`)
+		e.string(string(obj.Matches))
+	}
+	if obj.Minexponent != 0 {
+		e.key(`minexponent`)
+		e.float(float64(obj.Minexponent))
+	}
+	if obj.Mirror != nil {
+		e.key(`mirror`)
+		e.any(obj.Mirror)
+	}
+	if obj.Nticks != 0 {
+		e.key(`nticks`)
+		e.int(int64(obj.Nticks))
+	}
+	if obj.Overlaying != "" {
+		e.key(`overlaying`)
+		e.string(string(obj.Overlaying))
+	}
+	if obj.Position != 0 {
+		e.key(`position`)
+		e.float(float64(obj.Position))
+	}
+	if obj.Range != nil {
+		e.key(`range`)
+		e.any(obj.Range)
+	}
+	if obj.Rangebreaks != nil {
+		e.key(`rangebreaks`)
+		e.any(obj.Rangebreaks)
+	}
+	if obj.Rangemode != "" {
+		e.key(`rangemode`)
+		e.string(string(obj.Rangemode))
+	}
+	if obj.Rangeselector != nil {
+		e.key(`rangeselector`)
+		obj.Rangeselector.encodeJSON(e)
+	}
+	if obj.Rangeslider != nil {
+		e.key(`rangeslider`)
+		obj.Rangeslider.encodeJSON(e)
+	}
+	if obj.Scaleanchor != "" {
+		e.key(`scaleanchor`)
+		e.string(string(obj.Scaleanchor))
+	}
+	if obj.Scaleratio != 0 {
+		e.key(`scaleratio`)
+		e.float(float64(obj.Scaleratio))
+	}
+	if obj.Separatethousands != nil {
+		e.key(`separatethousands`)
+		e.bool(*obj.Separatethousands)
+	}
+	if obj.Showdividers != nil {
+		e.key(`showdividers`)
+		e.bool(*obj.Showdividers)
+	}
+	if obj.Showexponent != "" {
+		e.key(`showexponent`)
+		e.string(string(obj.Showexponent))
+	}
+	if obj.Showgrid != nil {
+		e.key(`showgrid`)
+		e.bool(*obj.Showgrid)
+	}
+	if obj.Showline != nil {
+		e.key(`showline`)
+		e.bool(*obj.Showline)
+	}
+	if obj.Showspikes != nil {
+		e.key(`showspikes`)
+		e.bool(*obj.Showspikes)
+	}
+	if obj.Showticklabels != nil {
+		e.key(`showticklabels`)
+		e.bool(*obj.Showticklabels)
+	}
+	if obj.Showtickprefix != "" {
+		e.key(`showtickprefix`)
+		e.string(string(obj.Showtickprefix))
+	}
+	if obj.Showticksuffix != "" {
+		e.key(`showticksuffix`)
+		e.string(string(obj.Showticksuffix))
+	}
+	if obj.Side != "" {
+		e.key(`side`)
+		e.string(string(obj.Side))
+	}
+	if obj.Spikecolor != nil {
+		e.key(`spikecolor`)
+		e.any(obj.Spikecolor)
+	}
+	if obj.Spikedash != nil {
+		e.key(`spikedash`)
+		e.any(obj.Spikedash)
+	}
+	if obj.Spikemode != "" {
+		e.key(`spikemode`)
+		e.string(string(obj.Spikemode))
+	}
+	if obj.Spikesnap != "" {
+		e.key(`spikesnap`)
+		e.string(string(obj.Spikesnap))
+	}
+	if obj.Spikethickness != 0 {
+		e.key(`spikethickness`)
+		e.float(float64(obj.Spikethickness))
+	}
+	if obj.Tick0 != nil {
+		e.key(`tick0`)
+		e.any(obj.Tick0)
+	}
+	if obj.Tickangle != 0 {
+		e.key(`tickangle`)
+		e.float(float64(obj.Tickangle))
+	}
+	if obj.Tickcolor != nil {
+		e.key(`tickcolor`)
+		e.any(obj.Tickcolor)
+	}
+	if obj.Tickfont != nil {
+		e.key(`tickfont`)
+		obj.Tickfont.encodeJSON(e)
+	}
+	if obj.Tickformat != nil {
+		e.key(`tickformat`)
+		e.any(obj.Tickformat)
+	}
+	if obj.Tickformatstops != nil {
+		e.key(`tickformatstops`)
+		e.any(obj.Tickformatstops)
+	}
+	if obj.Ticklabelmode != "" {
+		e.key(`ticklabelmode`)
+		e.string(string(obj.Ticklabelmode))
+	}
+	if obj.Ticklabelposition != "" {
+		e.key(`ticklabelposition`)
+		e.string(string(obj.Ticklabelposition))
+	}
+	if obj.Ticklen != 0 {
+		e.key(`ticklen`)
+		e.float(float64(obj.Ticklen))
+	}
+	if obj.Tickmode != "" {
+		e.key(`tickmode`)
+		e.string(string(obj.Tickmode))
+	}
+	if obj.Tickprefix != nil {
+		e.key(`tickprefix`)
+		e.any(obj.Tickprefix)
+	}
+	if obj.Ticks != "" {
+		e.key(`ticks`)
+		e.enum(string(obj.Ticks))
+	}
+	if obj.Tickson != "" {
+		e.key(`tickson`)
+		e.string(string(obj.Tickson))
+	}
+	if obj.Ticksuffix != nil {
+		e.key(`ticksuffix`)
+		e.any(obj.Ticksuffix)
+	}
+	if obj.Ticktext != nil {
+		e.key(`ticktext`)
+		e.any(obj.Ticktext)
+	}
+	if obj.Ticktextsrc != nil {
+		e.key(`ticktextsrc`)
+		e.any(obj.Ticktextsrc)
+	}
+	if obj.Tickvals != nil {
+		e.key(`tickvals`)
+		e.any(obj.Tickvals)
+	}
+	if obj.Tickvalssrc != nil {
+		e.key(`tickvalssrc`)
+		e.any(obj.Tickvalssrc)
+	}
+	if obj.Tickwidth != 0 {
+		e.key(`tickwidth`)
+		e.float(float64(obj.Tickwidth))
+	}
+	if obj.Title != nil {
+		e.key(`title`)
+		obj.Title.encodeJSON(e)
+	}
+	if obj.Type != "" {
+		e.key(`type`)
+		e.string(string(obj.Type))
+	}
+	if obj.Uirevision != nil {
+		e.key(`uirevision`)
+		e.any(obj.Uirevision)
+	}
+	if obj.Visible != nil {
+		e.key(`visible`)
+		e.bool(*obj.Visible)
+	}
+	if obj.Zeroline != nil {
+		e.key(`zeroline`)
+		e.bool(*obj.Zeroline)
+	}
+	if obj.Zerolinecolor != nil {
+		e.key(`zerolinecolor`)
+		e.any(obj.Zerolinecolor)
+	}
+	if obj.Zerolinewidth != 0 {
+		e.key(`zerolinewidth`)
+		e.float(float64(obj.Zerolinewidth))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutYaxisTickfont with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutYaxisTickfont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutYaxisTickfont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutYaxisTitleFont with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutYaxisTitleFont) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutYaxisTitleFont) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Family != nil {
+		e.key(`family`)
+		e.any(obj.Family)
+	}
+	if obj.Size != 0 {
+		e.key(`size`)
+		e.float(float64(obj.Size))
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutYaxisTitle with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutYaxisTitle) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutYaxisTitle) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Font != nil {
+		e.key(`font`)
+		obj.Font.encodeJSON(e)
+	}
+	if obj.Standoff != 0 {
+		e.key(`standoff`)
+		e.float(float64(obj.Standoff))
+	}
+	if obj.Text != nil {
+		e.key(`text`)
+		e.any(obj.Text)
+	}
+	e.objectEnd()
+}
+
+// MarshalJSON encodes LayoutYaxis with precomputed fields. The output is the same as encoding/json.
+func (obj *LayoutYaxis) MarshalJSON() ([]byte, error) {
+	e := newEncoder()
+	obj.encodeJSON(e)
+	return e.result()
+}
+
+func (obj *LayoutYaxis) encodeJSON(e *encoder) {
+	if obj == nil {
+		e.buf = append(e.buf, "null"...)
+		return
+	}
+	e.objectStart()
+	if obj.Anchor != "" {
+		e.key(`anchor`)
+		e.string(string(obj.Anchor))
+	}
+	if obj.Automargin != nil {
+		e.key(`automargin`)
+		e.bool(*obj.Automargin)
+	}
+	if obj.Autorange != nil {
+		e.key(`autorange`)
+		e.any(obj.Autorange)
+	}
+	if obj.Autotypenumbers != "" {
+		e.key(`autotypenumbers`)
+		e.string(string(obj.Autotypenumbers))
+	}
+	if obj.Calendar != "" {
+		e.key(`calendar`)
+		e.string(string(obj.Calendar))
+	}
+	if obj.Categoryarray != nil {
+		e.key(`categoryarray`)
+		e.any(obj.Categoryarray)
+	}
+	if obj.Categoryarraysrc != nil {
+		e.key(`categoryarraysrc`)
+		e.any(obj.Categoryarraysrc)
+	}
+	if obj.Categoryorder != "" {
+		e.key(`categoryorder`)
+		e.string(string(obj.Categoryorder))
+	}
+	if obj.Color != nil {
+		e.key(`color`)
+		e.any(obj.Color)
+	}
+	if obj.Constrain != "" {
+		e.key(`constrain`)
+		e.string(string(obj.Constrain))
+	}
+	if obj.Constraintoward != "" {
+		e.key(`constraintoward`)
+		e.string(string(obj.Constraintoward))
+	}
+	if obj.Dividercolor != nil {
+		e.key(`dividercolor`)
+		e.any(obj.Dividercolor)
+	}
+	if obj.Dividerwidth != 0 {
+		e.key(`dividerwidth`)
+		e.float(float64(obj.Dividerwidth))
+	}
+	if obj.Domain != nil {
+		e.key(`domain`)
+		e.any(obj.Domain)
+	}
+	if obj.Dtick != nil {
+		e.key(`dtick`)
+		e.any(obj.Dtick)
+	}
+	if obj.Exponentformat != "" {
+		e.key(`exponentformat`)
+		e.string(string(obj.Exponentformat))
+	}
+	if obj.Fixedrange != nil {
+		e.key(`fixedrange`)
+		e.bool(*obj.Fixedrange)
+	}
+	if obj.Gridcolor != nil {
+		e.key(`gridcolor`)
+		e.any(obj.Gridcolor)
+	}
+	if obj.Gridwidth != 0 {
+		e.key(`gridwidth`)
+		e.float(float64(obj.Gridwidth))
+	}
+	if obj.Hoverformat != nil {
+		e.key(`hoverformat`)
+		e.any(obj.Hoverformat)
+	}
+	if obj.Layer != "" {
+		e.key(`layer`)
+		e.string(string(obj.Layer))
+	}
+	if obj.Linecolor != nil {
+		e.key(`linecolor`)
+		e.any(obj.Linecolor)
+	}
+	if obj.Linewidth != 0 {
+		e.key(`linewidth`)
+		e.float(float64(obj.Linewidth))
+	}
+	if obj.Matches != "" {
+		e.key(`matches`)
+		e.string(string(obj.Matches))
+	}
+	if obj.Minexponent != 0 {
+		e.key(`minexponent`)
+		e.float(float64(obj.Minexponent))
+	}
+	if obj.Mirror != nil {
+		e.key(`mirror`)
+		e.any(obj.Mirror)
+	}
+	if obj.Nticks != 0 {
+		e.key(`nticks`)
+		e.int(int64(obj.Nticks))
+	}
+	if obj.Overlaying != "" {
+		e.key(`overlaying`)
+		e.string(string(obj.Overlaying))
+	}
+	if obj.Position != 0 {
+		e.key(`position`)
+		e.float(float64(obj.Position))
+	}
+	if obj.Range != nil {
+		e.key(`range`)
+		e.any(obj.Range)
+	}
+	if obj.Rangebreaks != nil {
+		e.key(`rangebreaks`)
+		e.any(obj.Rangebreaks)
+	}
+	if obj.Rangemode != "" {
+		e.key(`rangemode`)
+		e.string(string(obj.Rangemode))
+	}
+	if obj.Scaleanchor != "" {
+		e.key(`scaleanchor`)
+		e.string(string(obj.Scaleanchor))
+	}
+	if obj.Scaleratio != 0 {
+		e.key(`scaleratio`)
+		e.float(float64(obj.Scaleratio))
+	}
+	if obj.Separatethousands != nil {
+		e.key(`separatethousands`)
+		e.bool(*obj.Separatethousands)
+	}
+	if obj.Showdividers != nil {
+		e.key(`showdividers`)
+		e.bool(*obj.Showdividers)
+	}
+	if obj.Showexponent != "" {
+		e.key(`showexponent`)
+		e.string(string(obj.Showexponent))
+	}
+	if obj.Showgrid != nil {
+		e.key(`showgrid`)
+		e.bool(*obj.Showgrid)
+	}
+	if obj.Showline != nil {
+		e.key(`showline`)
+		e.bool(*obj.Showline)
+	}
+	if obj.Showspikes != nil {
+		e.key(`showspikes`)
+		e.bool(*obj.Showspikes)
+	}
+	if obj.Showticklabels != nil {
+		e.key(`showticklabels`)
+		e.bool(*obj.Showticklabels)
+	}
+	if obj.Showtickprefix != "" {
+		e.key(`showtickprefix`)
+		e.string(string(obj.Showtickprefix))
+	}
+	if obj.Showticksuffix != "" {
+		e.key(`showticksuffix`)
+		e.string(string(obj.Showticksuffix))
+	}
+	if obj.Side != "" {
+		e.key(`side`)
+		e.string(string(obj.Side))
+	}
+	if obj.Spikecolor != nil {
+		e.key(`spikecolor`)
+		e.any(obj.Spikecolor)
+	}
+	if obj.Spikedash != nil {
+		e.key(`spikedash`)
+		e.any(obj.Spikedash)
+	}
+	if obj.Spikemode != "" {
+		e.key(`spikemode`)
+		e.string(string(obj.Spikemode))
+	}
+	if obj.Spikesnap != "" {
+		e.key(`spikesnap`)
+		e.string(string(obj.Spikesnap))
+	}
+	if obj.Spikethickness != 0 {
+		e.key(`spikethickness`)
+		e.float(float64(obj.Spikethickness))
+	}
+	if obj.Tick0 != nil {
+		e.key(`tick0`)
+		e.any(obj.Tick0)
+	}
+	if obj.Tickangle != 0 {
+		e.key(`tickangle`)
+		e.float(float64(obj.Tickangle))
+	}
+	if obj.Tickcolor != nil {
+		e.key(`tickcolor`)
+		e.any(obj.Tickcolor)
+	}
+	if obj.Tickfont != nil {
+		e.key(`tickfont`)
+		obj.Tickfont.encodeJSON(e)
+	}
+	if obj.Tickformat != nil {
+		e.key(`tickformat`)
+		e.any(obj.Tickformat)
+	}
+	if obj.Tickformatstops != nil {
+		e.key(`tickformatstops`)
+		e.any(obj.Tickformatstops)
+	}
+	if obj.Ticklabelmode != "" {
+		e.key(`ticklabelmode`)
+		e.string(string(obj.Ticklabelmode))
+	}
+	if obj.Ticklabelposition != "" {
+		e.key(`ticklabelposition`)
+		e.string(string(obj.Ticklabelposition))
+	}
+	if obj.Ticklen != 0 {
+		e.key(`ticklen`)
+		e.float(float64(obj.Ticklen))
+	}
+	if obj.Tickmode != "" {
+		e.key(`tickmode`)
+		e.string(string(obj.Tickmode))
+	}
+	if obj.Tickprefix != nil {
+		e.key(`tickprefix`)
+		e.any(obj.Tickprefix)
+	}
+	if obj.Ticks != "" {
+		e.key(`ticks`)
+		e.enum(string(obj.Ticks))
+	}
+	if obj.Tickson != "" {
+		e.key(`tickson`)
+		e.string(string(obj.Tickson))
+	}
+	if obj.Ticksuffix != nil {
+		e.key(`ticksuffix`)
+		e.any(obj.Ticksuffix)
+	}
+	if obj.Ticktext != nil {
+		e.key(`ticktext`)
+		e.any(obj.Ticktext)
+	}
+	if obj.Ticktextsrc != nil {
+		e.key(`ticktextsrc`)
+		e.any(obj.Ticktextsrc)
+	}
+	if obj.Tickvals != nil {
+		e.key(`tickvals`)
+		e.any(obj.Tickvals)
+	}
+	if obj.Tickvalssrc != nil {
+		e.key(`tickvalssrc`)
+		e.any(obj.Tickvalssrc)
+	}
+	if obj.Tickwidth != 0 {
+		e.key(`tickwidth`)
+		e.float(float64(obj.Tickwidth))
+	}
+	if obj.Title != nil {
+		e.key(`title`)
+		obj.Title.encodeJSON(e)
+	}
+	if obj.Type != "" {
+		e.key(`type`)
+		e.string(string(obj.Type))
+	}
+	if obj.Uirevision != nil {
+		e.key(`uirevision`)
+		e.any(obj.Uirevision)
+	}
+	if obj.Visible != nil {
+		e.key(`visible`)
+		e.bool(*obj.Visible)
+	}
+	if obj.Zeroline != nil {
+		e.key(`zeroline`)
+		e.bool(*obj.Zeroline)
+	}
+	if obj.Zerolinecolor != nil {
+		e.key(`zerolinecolor`)
+		e.any(obj.Zerolinecolor)
+	}
+	if obj.Zerolinewidth != 0 {
+		e.key(`zerolinewidth`)
+		e.float(float64(obj.Zerolinewidth))
+	}
+	e.objectEnd()
+}
@@ -0,0 +1,96 @@
+package grob
+
+import (
+	"sort"
+)
+
+// pointAttributes are the attributes that define the points of a trace, in order of preference to count the points
+var pointAttributes = []string{"x", "y", "z", "lat", "lon", "locations", "labels", "values", "r", "theta", "a", "b", "c", "open", "high", "low", "close", "u", "v", "w", "value", "parents", "ids"}
+
+// unalignedTraces are the trace types whose arrays are not one value per point, like the x and y of a heatmap that label the columns and rows of z
+var unalignedTraces = map[TraceType]bool{
+	TraceTypeBox:                true,
+	TraceTypeCarpet:             true,
+	TraceTypeContour:            true,
+	TraceTypeContourcarpet:      true,
+	TraceTypeHeatmap:            true,
+	TraceTypeHeatmapgl:          true,
+	TraceTypeHistogram2d:        true,
+	TraceTypeHistogram2dcontour: true,
+	TraceTypeImage:              true,
+	TraceTypeMesh3d:             true,
+	TraceTypeParcats:            true,
+	TraceTypeParcoords:          true,
+	TraceTypePointcloud:         true,
+	TraceTypeSankey:             true,
+	TraceTypeSplom:              true,
+	TraceTypeStreamtube:         true,
+	TraceTypeSurface:            true,
+	TraceTypeTable:              true,
+}
+
+// lengths checks that the arrays of the trace have a value per point. The number of points is the length of the first
+// point attribute. Arrays of arrays, like multicategory coordinates, are not checked, except customdata that has a row per point.
+func (v *validator) lengths(object map[string]interface{}, path string, traceType TraceType) {
+	if unalignedTraces[traceType] {
+		return
+	}
+	reference, points := "", -1
+	for _, name := range pointAttributes {
+		if n, ok := pointCount(object[name], name); ok {
+			reference, points = name, n
+			break
+		}
+	}
+	if points < 0 {
+		return
+	}
+
+	arrays := map[string]int{}
+	perPointArrays(object, "", string(traceType), true, arrays)
+	names := make([]string, 0, len(arrays))
+	for name := range arrays {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if arrays[name] != points {
+			v.fail(join(path, name), "%d values, but %s has %d", arrays[name], reference, points)
+		}
+	}
+}
+
+// perPointArrays adds the length of the arrays of the object that have a value per point: data arrays of the trace
+// and the attributes that accept a value or an array, like marker.size or text.
+func perPointArrays(object map[string]interface{}, path, schema string, top bool, arrays map[string]int) {
+	for name, value := range object {
+		info, ok := attributes[schema+"."+name]
+		if !ok || value == nil {
+			continue
+		}
+		switch {
+		case info.Role == "object":
+			if child, ok := value.(map[string]interface{}); ok && name != "colorbar" {
+				perPointArrays(child, join(path, name), info.Path, false, arrays)
+			}
+		case info.ArrayOK || top && info.ValType == "data_array":
+			if n, ok := pointCount(value, name); ok {
+				arrays[join(path, name)] = n
+			}
+		}
+	}
+}
+
+// pointCount returns the length of the array, or false if it is not an array of values
+func pointCount(value interface{}, name string) (int, bool) {
+	array, ok := value.([]interface{})
+	if !ok {
+		return 0, false
+	}
+	if len(array) > 0 && name != "customdata" {
+		if _, nested := array[0].([]interface{}); nested {
+			return 0, false
+		}
+	}
+	return len(array), true
+}
@@ -0,0 +1,55 @@
+package grob_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var _ = Describe("Array lengths", func() {
+
+	It("Should accept arrays with a value per point and scalars", func() {
+		trace := &grob.Scatter{
+			Type:       grob.TraceTypeScatter,
+			X:          []float64{1, 2, 3},
+			Y:          []float64{4, 5, 6},
+			Text:       "same for all",
+			Customdata: [][]interface{}{{1, "a"}, {2, "b"}, {3, "c"}},
+			Marker:     &grob.ScatterMarker{Color: []string{"red", "green", "blue"}},
+		}
+		Expect(trace.Validate()).To(Succeed())
+	})
+
+	It("Should report the arrays with a different length", func() {
+		trace := &grob.Scatter{
+			Type:       grob.TraceTypeScatter,
+			X:          []float64{1, 2, 3},
+			Y:          []float64{4, 5},
+			Text:       []string{"a", "b", "c", "d"},
+			Customdata: [][]interface{}{{1, "a"}},
+			Marker:     &grob.ScatterMarker{Color: []string{"red", "green", "blue"}},
+		}
+		err := trace.Validate()
+		Expect(err).NotTo(BeNil())
+		errs := err.(grob.ValidationErrors)
+		Expect(errs).To(HaveLen(3))
+		Expect(errs[0].Error()).To(Equal("customdata: 1 values, but x has 3"))
+		Expect(errs[1].Path).To(Equal("text"))
+		Expect(errs[2].Error()).To(Equal("y: 2 values, but x has 3"))
+	})
+
+	It("Should skip multicategory coordinates and traces without a value per point", func() {
+		Expect((&grob.Bar{
+			Type: grob.TraceTypeBar,
+			X:    [][]string{{"2020", "2020", "2021"}, {"q1", "q2", "q1"}},
+			Y:    []float64{1, 2, 3},
+		}).Validate()).To(Succeed())
+		Expect((&grob.Heatmap{
+			Type: grob.TraceTypeHeatmap,
+			X:    []string{"a", "b", "c"},
+			Y:    []string{"d", "e"},
+			Z:    [][]float64{{1, 2, 3}, {4, 5, 6}},
+		}).Validate()).To(Succeed())
+	})
+})
@@ -0,0 +1,143 @@
+package grob
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"runtime"
+	"strconv"
+	"sync"
+)
+
+// Matrix is a matrix of numbers stored in a single slice, to be used as the z of heatmaps, contours and surfaces.
+// It is marshaled as rows of values like [][]float64, but much faster and without allocating a slice per row,
+// so image-sized heatmaps serialize in milliseconds. NaN and infinite values are written as null, plotly leaves them blank.
+//
+//	z := grob.NewMatrix(480, 640)
+//	z.Set(i, j, value)
+//	fig.AddTraces(&grob.Heatmap{Z: z})
+//
+// It also satisfies the dataset.Matrix interface.
+type Matrix struct {
+	Rows int
+	Cols int
+	// Data are the values row after row, the value at row i and column j is Data[i*Cols+j]
+	Data []float64
+	// TypedArray marshals the values as a base64 typed array, {"dtype": "f8", "bdata": "...", "shape": "rows, cols"},
+	// which is smaller and faster to decode. It requires plotly.js 2.28 or later, the default version does not support it.
+	TypedArray bool
+}
+
+// NewMatrix returns a matrix of zeros
+func NewMatrix(rows, cols int) *Matrix {
+	return &Matrix{
+		Rows: rows,
+		Cols: cols,
+		Data: make([]float64, rows*cols),
+	}
+}
+
+// Dims returns the number of rows and columns
+func (m Matrix) Dims() (int, int) {
+	return m.Rows, m.Cols
+}
+
+// At returns the value at row i and column j
+func (m Matrix) At(i, j int) float64 {
+	return m.Data[i*m.Cols+j]
+}
+
+// Set sets the value at row i and column j
+func (m Matrix) Set(i, j int, value float64) {
+	m.Data[i*m.Cols+j] = value
+}
+
+// MarshalJSON implements json.Marshaler
+func (m Matrix) MarshalJSON() ([]byte, error) {
+	if len(m.Data) != m.Rows*m.Cols {
+		return nil, fmt.Errorf("matrix of %dx%d has %d values", m.Rows, m.Cols, len(m.Data))
+	}
+	if m.TypedArray {
+		return m.typedArray(), nil
+	}
+
+	// large matrices are encoded by several goroutines, every one writing a block of rows
+	blocks := runtime.GOMAXPROCS(0)
+	if blocks > m.Rows {
+		blocks = m.Rows
+	}
+	if len(m.Data) < parallelMatrixSize || blocks < 2 {
+		return append(m.appendRows(append(make([]byte, 0, 2+len(m.Data)*8), '['), 0, m.Rows), ']'), nil
+	}
+	parts := make([][]byte, blocks)
+	wg := sync.WaitGroup{}
+	for k := range parts {
+		wg.Add(1)
+		go func(k int) {
+			defer wg.Done()
+			from, to := k*m.Rows/blocks, (k+1)*m.Rows/blocks
+			parts[k] = m.appendRows(make([]byte, 0, 1+(to-from)*m.Cols*8), from, to)
+		}(k)
+	}
+	wg.Wait()
+	b := []byte{'['}
+	for k, part := range parts {
+		if k > 0 {
+			b = append(b, ',')
+		}
+		b = append(b, part...)
+	}
+	return append(b, ']'), nil
+}
+
+// parallelMatrixSize is the number of values above which a matrix is encoded by several goroutines
+const parallelMatrixSize = 1 << 16
+
+// appendRows appends the rows from the first to the last, excluded, separated by commas
+func (m Matrix) appendRows(b []byte, from, to int) []byte {
+	for i := from; i < to; i++ {
+		if i > from {
+			b = append(b, ',')
+		}
+		b = append(b, '[')
+		for j, value := range m.Data[i*m.Cols : (i+1)*m.Cols] {
+			if j > 0 {
+				b = append(b, ',')
+			}
+			b = appendNumber(b, value)
+		}
+		b = append(b, ']')
+	}
+	return b
+}
+
+// typedArray returns the values as a typed array of little endian float64
+func (m Matrix) typedArray() []byte {
+	raw := make([]byte, 8*len(m.Data))
+	for i, value := range m.Data {
+		binary.LittleEndian.PutUint64(raw[8*i:], math.Float64bits(value))
+	}
+	return []byte(fmt.Sprintf(`{"dtype":"f8","bdata":"%s","shape":"%d, %d"}`, base64.StdEncoding.EncodeToString(raw), m.Rows, m.Cols))
+}
+
+// appendNumber appends the number like encoding/json, NaN and infinities are null
+func appendNumber(b []byte, f float64) []byte {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return append(b, "null"...)
+	}
+	format := byte('f')
+	if abs := math.Abs(f); abs != 0 && (abs < 1e-6 || abs >= 1e21) {
+		format = 'e'
+	}
+	b = strconv.AppendFloat(b, f, format, -1, 64)
+	if format == 'e' {
+		// clean up e-09 to e-9
+		n := len(b)
+		if n >= 4 && b[n-4] == 'e' && b[n-3] == '-' && b[n-2] == '0' {
+			b[n-2] = b[n-1]
+			b = b[:n-1]
+		}
+	}
+	return b
+}
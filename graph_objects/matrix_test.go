@@ -0,0 +1,74 @@
+package grob_test
+
+import (
+	"encoding/json"
+	"math"
+	"runtime"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var _ = Describe("Matrix", func() {
+
+	It("Should marshal the rows like nested slices", func() {
+		z := grob.NewMatrix(2, 3)
+		z.Set(0, 1, 0.1)
+		z.Set(0, 2, 1e21)
+		z.Set(1, 0, -1e-7)
+		z.Set(1, 1, math.NaN())
+		z.Set(1, 2, 123456789)
+		Expect(z.At(1, 2)).To(Equal(123456789.0))
+		rows, cols := z.Dims()
+		Expect([]int{rows, cols}).To(Equal([]int{2, 3}))
+
+		data, err := json.Marshal(&grob.Heatmap{Z: z})
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(Equal(`{"z":[[0,0.1,1e+21],[-1e-7,null,123456789]]}`))
+
+		By("writing the numbers like encoding/json")
+		values := []float64{0, 0.1, 1e21, -1e-7, 123456789, 1.5e-300, 3e100, 1e20, 0.000001}
+		expected, err := json.Marshal([][]float64{values})
+		Expect(err).To(BeNil())
+		data, err = json.Marshal(grob.Matrix{Rows: 1, Cols: len(values), Data: values})
+		Expect(err).To(BeNil())
+		Expect(data).To(Equal(expected))
+
+		By("accepting a matrix value")
+		data, err = json.Marshal(grob.Matrix{Rows: 1, Cols: 2, Data: []float64{1, 2}})
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(Equal(`[[1,2]]`))
+	})
+
+	It("Should encode large matrices in parallel", func() {
+		defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(4))
+		z := grob.NewMatrix(301, 299)
+		rows := make([][]float64, z.Rows)
+		for i := range rows {
+			rows[i] = make([]float64, z.Cols)
+			for j := range rows[i] {
+				rows[i][j] = float64(i*j) / 7
+				z.Set(i, j, rows[i][j])
+			}
+		}
+		expected, err := json.Marshal(rows)
+		Expect(err).To(BeNil())
+		data, err := json.Marshal(z)
+		Expect(err).To(BeNil())
+		Expect(data).To(Equal(expected))
+	})
+
+	It("Should marshal a typed array", func() {
+		z := grob.Matrix{Rows: 1, Cols: 2, Data: []float64{1, 2}, TypedArray: true}
+		data, err := json.Marshal(z)
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(Equal(`{"dtype":"f8","bdata":"AAAAAAAA8D8AAAAAAAAAQA==","shape":"1, 2"}`))
+	})
+
+	It("Should fail if the dimensions do not match the data", func() {
+		_, err := json.Marshal(grob.Matrix{Rows: 2, Cols: 2, Data: []float64{1}})
+		Expect(err).NotTo(BeNil())
+	})
+})
@@ -2,10 +2,11 @@ package grob
 
 import (
 	"encoding/json"
+	"reflect"
 )
 
 // Generate the files
-//go:generate go run ../generator/cmd/generator/main.go --schema ../generator/schema.json --output-directory .
+//go:generate go run ../generator/cmd/generator/main.go --schema ../generator/schema.json --output-directory . --marshal-json
 
 // TraceType is the type for the TraceType field on every trace
 type TraceType string
@@ -34,18 +35,45 @@ type Fig struct {
 	// https://plotly.com/javascript/configuration-options
 	Config *Config `json:"config,omitempty"`
 
-	// Animation is not yet implemented, feel free to insert custom a struct
+	// Frames The frames of an animation. Each frame contains the data and layout properties that change during the animation.
+	// https://plotly.com/javascript/animations
+	Frames []Frame `json:"frames,omitempty"`
+
+	// Animation options are not part of the figure for plotly.js, they are given to Plotly.animate. Use the Animation type or insert a custom struct
 	Animation interface{} `json:"animation,omitempty"`
+
+	// unknown are the attributes kept by UnmarshalOptions.PreserveUnknownFields, by object that contains them
+	unknown map[fieldsKey][]rawField
+	// dirty are the attributes of the traces marked by MarkDirty, by trace index. An empty list is the whole trace
+	dirty map[int][]string
 }
 
 // AddTraces Is a shorthand  to add figures to a given figure. It handles the case where the Traces value is nil.
+// The Type of the traces is set if it is empty.
 func (fig *Fig) AddTraces(traces ...Trace) {
 	if fig.Data == nil {
 		fig.Data = make(Traces, 0)
 	}
+	for _, trace := range traces {
+		inferType(trace)
+	}
 	fig.Data = append(fig.Data, traces...)
 }
 
+// ToPlotlyJSON returns the figure in the JSON format used by plotly.js and plotly.py.
+// data and layout are always present, config and frames only if they are defined, and the Animation options are left out.
+// Keys are always written in the same order, so the output can be given to Plotly.newPlot(div, figure) or plotly.io.from_json
+// and compared between runs. The deprecated attributes are logged, see SetDeprecationLogger.
+func (fig *Fig) ToPlotlyJSON() ([]byte, error) {
+	fig.warnDeprecations()
+	return fig.encode(true)
+}
+
+// MarshalJSON marshals the figure with the unknown attributes kept by UnmarshalOptions.PreserveUnknownFields
+func (fig *Fig) MarshalJSON() ([]byte, error) {
+	return fig.encode(false)
+}
+
 // UnmarshalJSON is a custom unmarshal function to properly handle special cases.
 func (fig *Fig) UnmarshalJSON(data []byte) error {
 	var err error
@@ -57,6 +85,7 @@ func (fig *Fig) UnmarshalJSON(data []byte) error {
 
 	fig.Layout = tmp.Layout
 	fig.Config = tmp.Config
+	fig.Frames = tmp.Frames
 
 	for i := range tmp.Data {
 		trace, err := UnmarshalTrace(tmp.Data[i])
@@ -65,13 +94,111 @@ func (fig *Fig) UnmarshalJSON(data []byte) error {
 		}
 		fig.AddTraces(trace)
 	}
+	for i := range fig.Frames {
+		fig.resolveFrame(&fig.Frames[i])
+	}
 	return nil
 }
 
+// resolveFrame decodes the traces of the frame without type as the traces of the figure they change,
+// given by the traces of the frame or by their position. They keep an empty Type, like in the JSON.
+// The traces that change an unknown trace stay RawTrace.
+func (fig *Fig) resolveFrame(frame *Frame) {
+	for j, item := range frame.Data {
+		raw, ok := item.(RawTrace)
+		if !ok {
+			continue
+		}
+		index, ok := frameTrace(frame.Traces, j)
+		if !ok || index < 0 || index >= len(fig.Data) {
+			continue
+		}
+		base := reflect.ValueOf(fig.Data[index])
+		if base.Kind() != reflect.Ptr || base.IsNil() {
+			continue
+		}
+		trace, ok := reflect.New(base.Type().Elem()).Interface().(Trace)
+		if !ok || json.Unmarshal(raw, trace) != nil {
+			continue
+		}
+		frame.Data[j] = trace
+	}
+}
+
+// frameTrace returns the index of the trace of the figure changed by the jth trace of a frame with the given traces
+func frameTrace(traces interface{}, j int) (int, bool) {
+	if traces == nil {
+		return j, true
+	}
+	value := reflect.ValueOf(traces)
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array || j >= value.Len() {
+		return 0, false
+	}
+	index := reflect.ValueOf(value.Index(j).Interface())
+	switch index.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int(index.Int()), true
+	case reflect.Float32, reflect.Float64:
+		f := index.Float()
+		return int(f), f == float64(int(f))
+	}
+	return 0, false
+}
+
 type unmarshalFig struct {
 	Data   []json.RawMessage `json:"data,omitempty"`
 	Layout *Layout           `json:"layout,omitempty"`
 	Config *Config           `json:"config,omitempty"`
+	Frames []Frame           `json:"frames,omitempty"`
+}
+
+// RawTrace is a trace of a frame decoded without type. plotly.js takes the type of the trace of the figure it changes,
+// when that trace is unknown the trace is kept as is and written back unchanged.
+type RawTrace json.RawMessage
+
+// GetType is empty, the type is the type of the trace of the figure
+func (trace RawTrace) GetType() TraceType {
+	return ""
+}
+
+// MarshalJSON returns the JSON the trace was decoded from
+func (trace RawTrace) MarshalJSON() ([]byte, error) {
+	return json.RawMessage(trace).MarshalJSON()
+}
+
+// UnmarshalJSON is a custom unmarshal function to decode the frame traces.
+// The traces without type are RawTrace, Fig.UnmarshalJSON decodes them as the traces of the figure they change.
+func (frame *Frame) UnmarshalJSON(data []byte) error {
+	type frameAlias Frame
+	tmp := struct {
+		*frameAlias
+		Data []json.RawMessage `json:"data,omitempty"`
+	}{
+		frameAlias: (*frameAlias)(frame),
+	}
+	err := json.Unmarshal(data, &tmp)
+	if err != nil {
+		return err
+	}
+
+	frame.Data = nil
+	for i := range tmp.Data {
+		traceType := unmarshalType{}
+		err = json.Unmarshal(tmp.Data[i], &traceType)
+		if err != nil {
+			return err
+		}
+		if traceType.Type == "" {
+			frame.Data = append(frame.Data, RawTrace(tmp.Data[i]))
+			continue
+		}
+		trace, err := UnmarshalTrace(tmp.Data[i])
+		if err != nil {
+			return err
+		}
+		frame.Data = append(frame.Data, trace)
+	}
+	return nil
 }
 
 // Bool represents a *bool value. Needed to tell the differenc between false and nil.
@@ -87,6 +214,31 @@ var (
 	False Bool = &falseValue
 )
 
+// emptyEnum is the value of the Empty constants of the enumerations that accept an empty string, like LayoutXaxisTicksEmpty.
+// The zero value of an enumeration is unset and left out of the JSON, so the empty value needs its own constant
+const emptyEnum = "\x00"
+
+// marshalEnum writes the value of an enumeration as a JSON string, the empty constant is written as ""
+func marshalEnum(value string) ([]byte, error) {
+	if value == emptyEnum {
+		value = ""
+	}
+	return json.Marshal(value)
+}
+
+// unmarshalEnum reads the JSON string of an enumeration, "" is read as the empty constant
+func unmarshalEnum(data []byte) (string, error) {
+	value := ""
+	err := json.Unmarshal(data, &value)
+	if err != nil {
+		return "", err
+	}
+	if value == "" {
+		value = emptyEnum
+	}
+	return value, nil
+}
+
 // String is a string value, can be a []string if arrayOK is true.
 // numeric values are converted to string by plotly, so []<number> can work
 type String interface{}
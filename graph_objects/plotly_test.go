@@ -0,0 +1,200 @@
+package grob_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var _ = Describe("Fig", func() {
+
+	It("Should emit the plotly figure envelope", func() {
+		fig := &grob.Fig{
+			Data: grob.Traces{
+				&grob.Bar{
+					Type: grob.TraceTypeBar,
+					Y:    []float64{1, 2},
+				},
+			},
+			Config: &grob.Config{
+				Responsive: grob.True,
+			},
+			Frames: []grob.Frame{
+				{Name: "first"},
+			},
+			Animation: &grob.Animation{
+				Fromcurrent: grob.True,
+			},
+		}
+
+		figBytes, err := fig.ToPlotlyJSON()
+		Expect(err).To(BeNil())
+		Expect(string(figBytes)).To(HavePrefix(`{"data":[{"type":"bar","y":[1,2]}],"layout":{`))
+		Expect(string(figBytes)).To(HaveSuffix(`,"config":{"responsive":true},"frames":[{"name":"first"}]}`))
+		Expect(string(figBytes)).NotTo(ContainSubstring(`fromcurrent`))
+	})
+
+	It("Should always emit data and layout", func() {
+		figBytes, err := (&grob.Fig{}).ToPlotlyJSON()
+		Expect(err).To(BeNil())
+
+		envelope := map[string]json.RawMessage{}
+		err = json.Unmarshal(figBytes, &envelope)
+		Expect(err).To(BeNil())
+		Expect(envelope).To(HaveKeyWithValue("data", json.RawMessage(`[]`)))
+		Expect(envelope).To(HaveKey("layout"))
+		Expect(envelope).NotTo(HaveKey("config"))
+		Expect(envelope).NotTo(HaveKey("frames"))
+	})
+
+	It("Should emit enumerated values that look empty but leave out unset ones", func() {
+		layout := &grob.Layout{
+			Xaxis: &grob.LayoutXaxis{Ticks: grob.LayoutXaxisTicksEmpty, Autorange: grob.LayoutXaxisAutorangeFalse},
+			Yaxis: &grob.LayoutYaxis{},
+		}
+		layoutBytes, err := json.Marshal(layout)
+		Expect(err).To(BeNil())
+		Expect(string(layoutBytes)).To(ContainSubstring(`"xaxis":{"autorange":false,"ticks":""}`))
+		Expect(string(layoutBytes)).To(ContainSubstring(`"yaxis":{}`))
+	})
+
+	It("Should tell apart unset values and explicit defaults of visible, autorange and showline", func() {
+		fig := &grob.Fig{
+			Data: grob.Traces{
+				&grob.Scatter{Visible: grob.ScatterVisibleTrue},
+				&grob.Scatter{Visible: grob.ScatterVisibleFalse},
+				&grob.Scatter{},
+			},
+			Layout: &grob.Layout{
+				Xaxis:  &grob.LayoutXaxis{Autorange: grob.LayoutXaxisAutorangeTrue, Showline: grob.False},
+				Yaxis:  &grob.LayoutYaxis{Autorange: grob.LayoutYaxisAutorangeReversed, Showline: grob.True},
+				XAxis2: &grob.LayoutXaxis{},
+			},
+		}
+		figBytes, err := json.Marshal(fig)
+		Expect(err).To(BeNil())
+		Expect(string(figBytes)).To(Equal(`{"data":[{"visible":true},{"visible":false},{}],"layout":{` +
+			`"xaxis":{"autorange":true,"showline":false},"yaxis":{"autorange":"reversed","showline":true},"xaxis2":{}}}`))
+	})
+
+	It("Should read and write the empty value of enumerations", func() {
+		fig := &grob.Fig{}
+		err := json.Unmarshal([]byte(`{"layout":{"barnorm":"","xaxis":{"ticks":""},"yaxis":{"ticks":"inside"}}}`), fig)
+		Expect(err).To(BeNil())
+		Expect(fig.Layout.Barnorm).To(Equal(grob.BarBarnormEmpty))
+		Expect(fig.Layout.Xaxis.Ticks).To(Equal(grob.LayoutXaxisTicksEmpty))
+		Expect(fig.Layout.Yaxis.Ticks).To(Equal(grob.LayoutYaxisTicksInside))
+
+		generated, err := json.Marshal(fig.Layout)
+		Expect(err).To(BeNil())
+		Expect(string(generated)).To(Equal(`{"barnorm":"","xaxis":{"ticks":""},"yaxis":{"ticks":"inside"}}`))
+		// the value is not addressable, encoding/json uses the MarshalJSON of the enumeration
+		reflected, err := json.Marshal(*fig.Layout.Xaxis)
+		Expect(err).To(BeNil())
+		Expect(string(reflected)).To(Equal(`{"ticks":""}`))
+	})
+
+	It("Should marshal data arrays of any number type without conversion", func() {
+		traceBytes, err := json.Marshal(&grob.Scattergl{X: []int32{1, 2}, Y: []float32{0.1, 2.5}})
+		Expect(err).To(BeNil())
+		Expect(string(traceBytes)).To(Equal(`{"x":[1,2],"y":[0.1,2.5]}`))
+	})
+})
+
+var _ = Describe("Fig builder", func() {
+
+	It("Should set the type of added traces", func() {
+		fig := &grob.Fig{}
+		fig.AddTraces(&grob.Bar{Y: []float64{1, 2}}, &grob.Pie{Type: grob.TraceTypePie})
+
+		figBytes, err := json.Marshal(fig)
+		Expect(err).To(BeNil())
+		Expect(string(figBytes)).To(Equal(`{"data":[{"type":"bar","y":[1,2]},{"type":"pie"}]}`))
+	})
+
+	It("Should add scatter and bar traces", func() {
+		fig := &grob.Fig{}
+		scatter, err := fig.AddScatter([]float64{1, 2}, []float64{3, 4})
+		Expect(err).To(BeNil())
+		scatter.Mode = grob.ScatterModeLines
+		bar, err := fig.AddBar([]string{"a", "b"}, []int{5, 6})
+		Expect(err).To(BeNil())
+		bar.Name = "bars"
+
+		Expect(fig.Data).To(HaveLen(2))
+		Expect(fig.Data[1]).To(BeIdenticalTo(bar))
+
+		figBytes, err := json.Marshal(fig)
+		Expect(err).To(BeNil())
+		Expect(string(figBytes)).To(Equal(`{"data":[{"type":"scatter","mode":"lines","x":[1,2],"y":[3,4]},{"type":"bar","name":"bars","x":["a","b"],"y":[5,6]}]}`))
+	})
+
+	It("Should apply the trace options to the added traces", func() {
+		fig := &grob.Fig{}
+		scatter, err := fig.AddScatter([]float64{1, 2}, []float64{3, 4}, grob.SetTrace("mode", grob.ScatterModeLines), grob.SetTrace("line.color", "red"))
+		Expect(err).To(BeNil())
+		Expect(scatter.Mode).To(Equal(grob.ScatterModeLines))
+		Expect(scatter.Line.Color).To(Equal("red"))
+		bar, err := fig.AddBar([]string{"a"}, []int{5}, grob.SetTrace("name", "bars"))
+		Expect(err).To(BeNil())
+		Expect(bar.Name).To(Equal("bars"))
+		Expect(fig.Data).To(HaveLen(2))
+
+		_, err = fig.AddBar([]string{"b"}, []int{6}, grob.SetTrace("markr.color", "red"))
+		Expect(err).To(MatchError(ContainSubstring("cannot add bar trace")))
+		Expect(fig.Data).To(HaveLen(2), "the trace is not added if an option fails")
+	})
+
+	It("Should return the trace added with AddTrace", func() {
+		fig := &grob.Fig{}
+		trace := fig.AddTrace(&grob.Heatmap{})
+		Expect(trace.(*grob.Heatmap).Type).To(Equal(grob.TraceTypeHeatmap))
+	})
+})
+
+var _ = Describe("Frame", func() {
+
+	It("Should decode the frame traces without type as the traces they change", func() {
+		data := []byte(`{
+			"data": [{"type": "scatter", "y": [1, 2]}, {"type": "bar", "y": [3, 4]}],
+			"frames": [
+				{"name": "position", "data": [{"y": [5, 6]}, {"type": "bar", "y": [7, 8]}]},
+				{"name": "traces", "traces": [1], "data": [{"marker": {"color": "red"}}]},
+				{"name": "unknown", "traces": [5], "data": [{"y": [9]}]}
+			]
+		}`)
+		fig := &grob.Fig{}
+		Expect(json.Unmarshal(data, fig)).To(Succeed())
+
+		Expect(fig.Frames).To(HaveLen(3))
+		Expect(fig.Frames[0].Data[0]).To(Equal(&grob.Scatter{Y: []interface{}{5.0, 6.0}}))
+		Expect(fig.Frames[0].Data[1].(*grob.Bar).Type).To(Equal(grob.TraceTypeBar))
+		Expect(fig.Frames[1].Data[0]).To(Equal(&grob.Bar{Marker: &grob.BarMarker{Color: "red"}}))
+		Expect(fig.Frames[2].Data[0]).To(Equal(grob.RawTrace(`{"y": [9]}`)))
+
+		By("writing them back without type")
+		written, err := fig.ToPlotlyJSON()
+		Expect(err).To(BeNil())
+		decoded := map[string]interface{}{}
+		Expect(json.Unmarshal(written, &decoded)).To(Succeed())
+		expected := map[string]interface{}{}
+		Expect(json.Unmarshal(data, &expected)).To(Succeed())
+		Expect(decoded["frames"]).To(Equal(expected["frames"]))
+	})
+})
+
+var _ = Describe("Template", func() {
+
+	It("Should decode the trace defaults without type", func() {
+		template := grob.Template{}
+		err := json.Unmarshal([]byte(`{"data": {"scatter": [{"mode": "lines"}, {"mode": "markers"}]}, "layout": {"font": {"color": "red"}}}`), &template)
+		Expect(err).To(BeNil())
+
+		Expect(template.Layout.Font.Color).To(Equal("red"))
+		Expect(template.Data[grob.TraceTypeScatter]).To(HaveLen(2))
+		Expect(template.Data[grob.TraceTypeScatter][1].(*grob.Scatter).Mode).To(Equal(grob.ScatterModeMarkers))
+	})
+})
@@ -0,0 +1,264 @@
+package grob
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// rawField is an attribute unknown to the figure, with the JSON it was decoded from
+type rawField struct {
+	name string
+	raw  json.RawMessage
+}
+
+// fieldsKey identifies the object that contains preserved fields: the trace that contains the object and its path in the trace,
+// or its path in the figure for the objects outside the traces. So the fields of the traces follow them when they are reordered
+// and are dropped with them, while copies of the figure and of its layout, such as those of the renderers, keep the fields of the layout.
+type fieldsKey struct {
+	trace interface{}
+	path  string
+}
+
+var traceInterface = reflect.TypeOf((*Trace)(nil)).Elem()
+
+// enter follows the pointers and interfaces of the value to the object and returns it with its key.
+// The objects of a trace are keyed from the trace. The value is invalid if it is nil.
+func (key fieldsKey) enter(value reflect.Value) (reflect.Value, fieldsKey) {
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return reflect.Value{}, key
+		}
+		if value.Kind() == reflect.Ptr && value.Type().Implements(traceInterface) {
+			key = fieldsKey{trace: value.Interface()}
+		}
+		value = value.Elem()
+	}
+	return value, key
+}
+
+// attribute returns the key of the attribute of the object
+func (key fieldsKey) attribute(name string) fieldsKey {
+	return fieldsKey{trace: key.trace, path: join(key.path, name)}
+}
+
+// item returns the key of the ith item of the array
+func (key fieldsKey) item(i int) fieldsKey {
+	return fieldsKey{trace: key.trace, path: fmt.Sprintf("%s[%d]", key.path, i)}
+}
+
+// attributeValue returns the attribute of the struct or map with the JSON name, the value is invalid if there is none
+func attributeValue(object reflect.Value, name string) reflect.Value {
+	switch object.Kind() {
+	case reflect.Struct:
+		field, _ := fieldByJSONName(object, name)
+		return field
+	case reflect.Map:
+		if object.Type().Key().Kind() == reflect.String {
+			return object.MapIndex(reflect.ValueOf(name).Convert(object.Type().Key()))
+		}
+	}
+	return reflect.Value{}
+}
+
+// itemValue returns the ith item of the slice or array, the value is invalid if there is none
+func itemValue(array reflect.Value, i int) reflect.Value {
+	if (array.Kind() == reflect.Slice || array.Kind() == reflect.Array) && i < array.Len() {
+		return array.Index(i)
+	}
+	return reflect.Value{}
+}
+
+// preservedFields returns the attributes of the JSON dropped when decoding the figure, by object that contains them
+func preservedFields(data []byte, fig *Fig) map[fieldsKey][]rawField {
+	fields := map[fieldsKey][]rawField{}
+	walkUnknown(data, reflect.ValueOf(fig), "", fieldsKey{}, func(path string, key fieldsKey, name string, raw json.RawMessage) {
+		fields[key] = append(fields[key], rawField{name: name, raw: raw})
+	})
+	if len(fields) == 0 {
+		return nil
+	}
+	for _, object := range fields {
+		sort.Slice(object, func(i, j int) bool { return object[i].name < object[j].name })
+	}
+	return fields
+}
+
+// droppedFields returns the attributes of the JSON that are missing from the encoded figure, by object that contains them.
+// Those are the attributes set to the zero value of their field, like a legend tracegroupgap of 0, which are omitted when encoding.
+// value is the decoded value of the JSON.
+func droppedFields(data, encoded json.RawMessage, value reflect.Value, key fieldsKey, fields map[fieldsKey][]rawField) {
+	value, key = key.enter(value)
+	object := map[string]json.RawMessage{}
+	if json.Unmarshal(data, &object) == nil {
+		encodedObject := map[string]json.RawMessage{}
+		if json.Unmarshal(encoded, &encodedObject) != nil {
+			return
+		}
+		names := make([]string, 0, len(object))
+		for name := range object {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			item, ok := encodedObject[name]
+			if !ok {
+				fields[key] = append(fields[key], rawField{name: name, raw: object[name]})
+				continue
+			}
+			droppedFields(object[name], item, attributeValue(value, name), key.attribute(name), fields)
+		}
+		return
+	}
+	array := []json.RawMessage{}
+	encodedArray := []json.RawMessage{}
+	if json.Unmarshal(data, &array) != nil || json.Unmarshal(encoded, &encodedArray) != nil {
+		return
+	}
+	for i := 0; i < len(array) && i < len(encodedArray); i++ {
+		droppedFields(array[i], encodedArray[i], itemValue(value, i), key.item(i), fields)
+	}
+}
+
+// restoreFields appends the fields to the objects of the JSON of the figure. data must be compact, as written by json.Marshal.
+func restoreFields(data []byte, fig *Fig, fields map[fieldsKey][]rawField) ([]byte, error) {
+	if len(fields) == 0 {
+		return data, nil
+	}
+	r := &restorer{data: data, fields: fields}
+	r.out.Grow(len(data))
+	err := r.value(reflect.ValueOf(fig), fieldsKey{})
+	if err != nil {
+		return nil, fmt.Errorf("cannot restore unknown fields, %w", err)
+	}
+	return r.out.Bytes(), nil
+}
+
+// restorer copies JSON written by json.Marshal, inserting the fields at the end of the objects.
+// The JSON is walked together with the value it was written from, to find the key of the objects.
+type restorer struct {
+	data   []byte
+	pos    int
+	out    bytes.Buffer
+	fields map[fieldsKey][]rawField
+}
+
+func (r *restorer) value(value reflect.Value, key fieldsKey) error {
+	if r.pos >= len(r.data) {
+		return fmt.Errorf("unexpected end of JSON")
+	}
+	value, key = key.enter(value)
+	switch r.data[r.pos] {
+	case '{':
+		return r.object(value, key)
+	case '[':
+		return r.array(value, key)
+	case '"':
+		_, err := r.string()
+		return err
+	}
+	start := r.pos
+	for r.pos < len(r.data) && !bytes.ContainsRune([]byte(",]}"), rune(r.data[r.pos])) {
+		r.pos++
+	}
+	r.out.Write(r.data[start:r.pos])
+	return nil
+}
+
+func (r *restorer) object(value reflect.Value, key fieldsKey) error {
+	r.copy('{')
+	empty := true
+	// the keys already written win over the preserved ones, the figure could have set them since it was decoded
+	var written map[string]bool
+	if len(r.fields[key]) > 0 {
+		written = map[string]bool{}
+	}
+	for r.pos < len(r.data) && r.data[r.pos] != '}' {
+		if !empty && !r.copy(',') {
+			return fmt.Errorf("expected , at %d", r.pos)
+		}
+		name, err := r.string()
+		if err != nil {
+			return err
+		}
+		if written != nil {
+			written[name] = true
+		}
+		if !r.copy(':') {
+			return fmt.Errorf("expected : at %d", r.pos)
+		}
+		err = r.value(attributeValue(value, name), key.attribute(name))
+		if err != nil {
+			return err
+		}
+		empty = false
+	}
+	for _, field := range r.fields[key] {
+		if written[field.name] {
+			continue
+		}
+		if !empty {
+			r.out.WriteByte(',')
+		}
+		name, _ := json.Marshal(field.name)
+		r.out.Write(name)
+		r.out.WriteByte(':')
+		r.out.Write(field.raw)
+		empty = false
+	}
+	if !r.copy('}') {
+		return fmt.Errorf("expected } at %d", r.pos)
+	}
+	return nil
+}
+
+func (r *restorer) array(value reflect.Value, key fieldsKey) error {
+	r.copy('[')
+	for i := 0; r.pos < len(r.data) && r.data[r.pos] != ']'; i++ {
+		if i > 0 && !r.copy(',') {
+			return fmt.Errorf("expected , at %d", r.pos)
+		}
+		err := r.value(itemValue(value, i), key.item(i))
+		if err != nil {
+			return err
+		}
+	}
+	if !r.copy(']') {
+		return fmt.Errorf("expected ] at %d", r.pos)
+	}
+	return nil
+}
+
+// string copies a string and returns its value
+func (r *restorer) string() (string, error) {
+	start := r.pos
+	if !r.copy('"') {
+		return "", fmt.Errorf("expected string at %d", r.pos)
+	}
+	for r.pos < len(r.data) && r.data[r.pos] != '"' {
+		if r.data[r.pos] == '\\' {
+			r.pos++
+		}
+		r.pos++
+	}
+	if r.pos >= len(r.data) {
+		return "", fmt.Errorf("unexpected end of JSON")
+	}
+	r.pos++
+	r.out.Write(r.data[start+1 : r.pos])
+	var s string
+	err := json.Unmarshal(r.data[start:r.pos], &s)
+	return s, err
+}
+
+// copy copies the byte if it is next
+func (r *restorer) copy(b byte) bool {
+	if r.pos >= len(r.data) || r.data[r.pos] != b {
+		return false
+	}
+	r.out.WriteByte(b)
+	r.pos++
+	return true
+}
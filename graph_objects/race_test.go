@@ -0,0 +1,7 @@
+//go:build race
+// +build race
+
+package grob_test
+
+// raceEnabled skips the allocation budgets, the race detector allocates and drops pooled buffers
+const raceEnabled = true
@@ -0,0 +1,136 @@
+package grob
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// subplotKey matches the layout attributes that define subplots, with the kind of subplot and its number
+var subplotKey = regexp.MustCompile(`^(xaxis|yaxis|scene|geo|polar|ternary|mapbox|coloraxis)([1-9][0-9]*)?$`)
+
+// subplotID matches the references to subplots, like x2, y2 domain or scene3
+var subplotID = regexp.MustCompile(`^([a-z]+?)([1-9][0-9]*)?( domain)?$`)
+
+// ValidateReferences checks that the subplots used by the traces, such as xaxis: "x3", scene: "scene2" or marker.coloraxis: "coloraxis2",
+// are defined in the layout, as plotly.js draws an empty plot otherwise. The axes referenced by other axes, with anchor, overlaying,
+// matches or scaleanchor, and by the xref and yref of annotations, shapes and images must be defined too.
+// The first subplot of every kind, like x or scene, is created by plotly.js and it is always valid.
+//
+// Numbered subplots of the layout that no trace uses are reported as orphans, they usually come from a typo in the trace.
+// The error is ValidationErrors with the path of every wrong reference and orphan subplot.
+func (fig *Fig) ValidateReferences() error {
+	v := &validator{}
+	layout := map[string]interface{}{}
+	if fig.Layout != nil {
+		object, err := jsonObject(fig.Layout)
+		if err != nil {
+			return err
+		}
+		layout = object
+	}
+
+	used := map[string]bool{}
+	reference := func(id interface{}, path string) {
+		key, ok := subplotLayoutKey(id)
+		if !ok {
+			return
+		}
+		used[key] = true
+		if value, ok := layout[key]; (!ok || value == nil) && subplotKey.FindStringSubmatch(key)[2] != "" {
+			v.fail(path, "%v references layout.%s, which is not defined", id, key)
+		}
+	}
+
+	attributesOnce.Do(loadAttributes)
+	for i, trace := range fig.Data {
+		if trace == nil {
+			continue
+		}
+		object, err := jsonObject(trace)
+		if err != nil {
+			return err
+		}
+		walkSubplotIDs(object, fmt.Sprintf("data[%d]", i), string(trace.GetType()), reference)
+	}
+
+	keys := make([]string, 0, len(layout))
+	for key := range layout {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		match := subplotKey.FindStringSubmatch(key)
+		if match != nil && match[2] != "" && layout[key] != nil && !used[key] {
+			v.fail("layout."+key, "%s is not used by any trace", key)
+		}
+	}
+	for _, key := range keys {
+		axis, ok := layout[key].(map[string]interface{})
+		if !subplotKey.MatchString(key) || !ok {
+			continue
+		}
+		for _, attr := range []string{"anchor", "overlaying", "matches", "scaleanchor"} {
+			if id, ok := axis[attr]; ok {
+				reference(id, "layout."+key+"."+attr)
+			}
+		}
+	}
+
+	for _, name := range []string{"annotations", "shapes", "images"} {
+		items, _ := layout[name].([]interface{})
+		for i, item := range items {
+			object, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for _, attr := range []string{"xref", "yref"} {
+				if id, ok := object[attr]; ok {
+					reference(id, fmt.Sprintf("layout.%s[%d].%s", name, i, attr))
+				}
+			}
+		}
+	}
+	return v.result()
+}
+
+// walkSubplotIDs calls reference with every attribute of the object that references a subplot
+func walkSubplotIDs(object map[string]interface{}, path, schema string, reference func(id interface{}, path string)) {
+	for name, value := range object {
+		info, ok := attributes[schema+"."+name]
+		if !ok || value == nil {
+			continue
+		}
+		switch {
+		case info.ValType == "subplotid":
+			reference(value, path+"."+name)
+		case info.Role == "object":
+			if child, ok := value.(map[string]interface{}); ok {
+				walkSubplotIDs(child, path+"."+name, info.Path, reference)
+			}
+		}
+	}
+}
+
+// subplotLayoutKey returns the layout attribute of a subplot id, like xaxis2 for x2 or x2 domain and scene for scene.
+// It returns false for values that do not reference a subplot, like paper or free.
+func subplotLayoutKey(id interface{}) (string, bool) {
+	s, ok := id.(string)
+	if !ok {
+		return "", false
+	}
+	match := subplotID.FindStringSubmatch(strings.TrimSpace(s))
+	if match == nil {
+		return "", false
+	}
+	kind := match[1]
+	if kind == "x" || kind == "y" {
+		kind += "axis"
+	}
+	key := kind + match[2]
+	if !subplotKey.MatchString(key) {
+		return "", false
+	}
+	return key, true
+}
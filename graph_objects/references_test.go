@@ -0,0 +1,64 @@
+package grob_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var _ = Describe("ValidateReferences", func() {
+
+	paths := func(err error) []string {
+		errs, ok := err.(grob.ValidationErrors)
+		Expect(ok).To(BeTrue(), "%v", err)
+		paths := []string{}
+		for _, e := range errs {
+			paths = append(paths, e.Path)
+		}
+		return paths
+	}
+
+	It("Should accept subplots defined in the layout", func() {
+		fig := &grob.Fig{
+			Data: grob.Traces{
+				&grob.Scatter{Type: grob.TraceTypeScatter},
+				&grob.Scatter{Type: grob.TraceTypeScatter, Xaxis: "x2", Yaxis: "y2", Marker: &grob.ScatterMarker{Coloraxis: "coloraxis"}},
+				&grob.Scatter3d{Type: grob.TraceTypeScatter3d, Scene: "scene"},
+				&grob.Scatterpolar{Type: grob.TraceTypeScatterpolar},
+			},
+			Layout: &grob.Layout{
+				XAxis2: &grob.LayoutXaxis{Anchor: "y2", Overlaying: "x"},
+				YAxis2: &grob.LayoutYaxis{Anchor: "x2"},
+			},
+		}
+		fig.AddHLine(1, grob.ShapeOptions{})
+		Expect(fig.ValidateReferences()).To(Succeed())
+	})
+
+	It("Should report undefined and orphan subplots", func() {
+		fig := &grob.Fig{
+			Data: grob.Traces{
+				&grob.Scatter{Type: grob.TraceTypeScatter, Xaxis: "x3", Yaxis: "y2", Marker: &grob.ScatterMarker{Coloraxis: "coloraxis2"}},
+				&grob.Scatterpolar{Type: grob.TraceTypeScatterpolar, Subplot: "polar2"},
+				&grob.Scatter3d{Type: grob.TraceTypeScatter3d, Scene: "scene2"},
+			},
+			Layout: &grob.Layout{
+				XAxis2: &grob.LayoutXaxis{Anchor: "y2"},
+				YAxis2: &grob.LayoutYaxis{Anchor: "x4"},
+				Annotations: []map[string]interface{}{
+					{"xref": "x5 domain", "yref": "paper"},
+				},
+			},
+		}
+		Expect(paths(fig.ValidateReferences())).To(Equal([]string{
+			"data[0].marker.coloraxis",
+			"data[0].xaxis",
+			"data[1].subplot",
+			"data[2].scene",
+			"layout.annotations[0].xref",
+			"layout.xaxis2",
+			"layout.yaxis2.anchor",
+		}))
+	})
+})
@@ -0,0 +1,218 @@
+package grob
+
+import (
+	"reflect"
+	"strings"
+)
+
+// ShapeOptions configure the lines and rectangles added with AddHLine, AddVLine, AddHRect and AddVRect
+type ShapeOptions struct {
+	// Color of the line, defaults to the color of the axes lines for lines and none for rectangles
+	Color Color
+	// Width of the line in pixels
+	Width float64
+	// Dash is the style of the line, such as dash, dot or dashdot
+	Dash string
+	// Fillcolor is the color of rectangles, defaults to a light grey
+	Fillcolor Color
+	// Opacity of the shape, between 0 and 1
+	Opacity float64
+	// Below draws the shape below the traces
+	Below bool
+	// Xref and Yref are the axes of the data coordinates, default to x and y.
+	// Lines and bands span the domain of the other axis, such as x2 domain for a horizontal line with Xref x2, or the plot area with paper
+	Xref, Yref string
+	// Text adds an annotation next to the shape
+	Text string
+}
+
+// AnnotationOptions configure AddAnnotation
+type AnnotationOptions struct {
+	// Xref and Yref are the axes of the coordinates, default to x and y. Use paper to place the annotation relative to the plot area,
+	// or an axis followed by domain, like x2 domain, relative to its subplot, where 0 and 1 are the edges
+	Xref, Yref string
+	// Arrow draws an arrow from the text to the point
+	Arrow bool
+	// Xanchor and Yanchor align the text with the point, such as left or top
+	Xanchor, Yanchor string
+}
+
+// AddHLine adds a horizontal line at y across the subplot of the x axis of the options, like add_hline in plotly.py
+func (fig *Fig) AddHLine(y interface{}, opt ...ShapeOptions) {
+	opts := shapeOptions(opt...)
+	fig.addShape(map[string]interface{}{
+		"type": "line",
+		"xref": domainRef(opts.Xref),
+		"x0":   0,
+		"x1":   1,
+		"yref": opts.Yref,
+		"y0":   y,
+		"y1":   y,
+	}, opts)
+	if opts.Text != "" {
+		fig.AddAnnotation(1, y, opts.Text, AnnotationOptions{Xref: domainRef(opts.Xref), Yref: opts.Yref, Xanchor: "right", Yanchor: "bottom"})
+	}
+}
+
+// AddVLine adds a vertical line at x across the subplot of the y axis of the options, like add_vline in plotly.py
+func (fig *Fig) AddVLine(x interface{}, opt ...ShapeOptions) {
+	opts := shapeOptions(opt...)
+	fig.addShape(map[string]interface{}{
+		"type": "line",
+		"xref": opts.Xref,
+		"x0":   x,
+		"x1":   x,
+		"yref": domainRef(opts.Yref),
+		"y0":   0,
+		"y1":   1,
+	}, opts)
+	if opts.Text != "" {
+		fig.AddAnnotation(x, 1, opts.Text, AnnotationOptions{Xref: opts.Xref, Yref: domainRef(opts.Yref), Xanchor: "left", Yanchor: "top"})
+	}
+}
+
+// AddHRect adds a band between y0 and y1 across the subplot of the x axis of the options, like add_hrect in plotly.py
+func (fig *Fig) AddHRect(y0, y1 interface{}, opt ...ShapeOptions) {
+	opts := rectOptions(opt...)
+	fig.addShape(map[string]interface{}{
+		"type": "rect",
+		"xref": domainRef(opts.Xref),
+		"x0":   0,
+		"x1":   1,
+		"yref": opts.Yref,
+		"y0":   y0,
+		"y1":   y1,
+	}, opts)
+	if opts.Text != "" {
+		fig.AddAnnotation(0, y1, opts.Text, AnnotationOptions{Xref: domainRef(opts.Xref), Yref: opts.Yref, Xanchor: "left", Yanchor: "top"})
+	}
+}
+
+// AddVRect adds a band between x0 and x1 across the subplot of the y axis of the options, like add_vrect in plotly.py
+func (fig *Fig) AddVRect(x0, x1 interface{}, opt ...ShapeOptions) {
+	opts := rectOptions(opt...)
+	fig.addShape(map[string]interface{}{
+		"type": "rect",
+		"xref": opts.Xref,
+		"x0":   x0,
+		"x1":   x1,
+		"yref": domainRef(opts.Yref),
+		"y0":   0,
+		"y1":   1,
+	}, opts)
+	if opts.Text != "" {
+		fig.AddAnnotation(x0, 1, opts.Text, AnnotationOptions{Xref: opts.Xref, Yref: domainRef(opts.Yref), Xanchor: "left", Yanchor: "top"})
+	}
+}
+
+// AddAnnotation adds a text at the given position, in data coordinates by default
+func (fig *Fig) AddAnnotation(x, y interface{}, text string, opt ...AnnotationOptions) {
+	opts := AnnotationOptions{}
+	if len(opt) == 1 {
+		opts = opt[0]
+	}
+	annotation := map[string]interface{}{
+		"text":      text,
+		"x":         x,
+		"y":         y,
+		"xref":      defaultRef(opts.Xref, "x"),
+		"yref":      defaultRef(opts.Yref, "y"),
+		"showarrow": opts.Arrow,
+	}
+	if opts.Xanchor != "" {
+		annotation["xanchor"] = opts.Xanchor
+	}
+	if opts.Yanchor != "" {
+		annotation["yanchor"] = opts.Yanchor
+	}
+	if fig.Layout == nil {
+		fig.Layout = &Layout{}
+	}
+	fig.Layout.Annotations = appendItem(fig.Layout.Annotations, annotation)
+}
+
+func shapeOptions(opt ...ShapeOptions) ShapeOptions {
+	opts := ShapeOptions{}
+	if len(opt) == 1 {
+		opts = opt[0]
+	}
+	opts.Xref = defaultRef(opts.Xref, "x")
+	opts.Yref = defaultRef(opts.Yref, "y")
+	return opts
+}
+
+func rectOptions(opt ...ShapeOptions) ShapeOptions {
+	opts := shapeOptions(opt...)
+	if opts.Fillcolor == nil {
+		opts.Fillcolor = "lightgrey"
+	}
+	if opts.Opacity == 0 {
+		opts.Opacity = 0.5
+	}
+	return opts
+}
+
+// domainRef returns the reference to the domain of the axis, where 0 and 1 are the edges of its subplot
+func domainRef(ref string) string {
+	if ref == "paper" || strings.HasSuffix(ref, " domain") {
+		return ref
+	}
+	return ref + " domain"
+}
+
+func defaultRef(ref, def string) string {
+	if ref == "" {
+		return def
+	}
+	return ref
+}
+
+// addShape completes the shape with the options and appends it to the layout
+func (fig *Fig) addShape(shape map[string]interface{}, opts ShapeOptions) {
+	line := map[string]interface{}{}
+	if opts.Color != nil {
+		line["color"] = opts.Color
+	}
+	if opts.Width != 0 {
+		line["width"] = opts.Width
+	} else if shape["type"] == "rect" && opts.Color == nil {
+		// rectangles have no border unless it is configured
+		line["width"] = 0
+	}
+	if opts.Dash != "" {
+		line["dash"] = opts.Dash
+	}
+	if len(line) > 0 {
+		shape["line"] = line
+	}
+	if opts.Fillcolor != nil {
+		shape["fillcolor"] = opts.Fillcolor
+	}
+	if opts.Opacity != 0 {
+		shape["opacity"] = opts.Opacity
+	}
+	if opts.Below {
+		shape["layer"] = "below"
+	}
+	if fig.Layout == nil {
+		fig.Layout = &Layout{}
+	}
+	fig.Layout.Shapes = appendItem(fig.Layout.Shapes, shape)
+}
+
+// appendItem appends the item to a list of the layout, such as shapes or annotations.
+// The list can be nil or any kind of slice, the result is a []interface{}
+func appendItem(list interface{}, item interface{}) interface{} {
+	items := []interface{}{}
+	if list != nil {
+		value := reflect.ValueOf(list)
+		if value.Kind() == reflect.Slice || value.Kind() == reflect.Array {
+			for i := 0; i < value.Len(); i++ {
+				items = append(items, value.Index(i).Interface())
+			}
+		} else {
+			items = append(items, list)
+		}
+	}
+	return append(items, item)
+}
@@ -0,0 +1,78 @@
+package grob_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var _ = Describe("Shapes", func() {
+
+	It("Should add reference lines across the subplot", func() {
+		fig := &grob.Fig{}
+		fig.AddHLine(0, grob.ShapeOptions{Color: "red", Dash: "dash"})
+		fig.AddVLine("2021-01-01", grob.ShapeOptions{Xref: "x2", Text: "release"})
+
+		shapesBytes, err := json.Marshal(fig.Layout.Shapes)
+		Expect(err).To(BeNil())
+		Expect(string(shapesBytes)).To(MatchJSON(`[
+			{"type": "line", "xref": "x domain", "x0": 0, "x1": 1, "yref": "y", "y0": 0, "y1": 0, "line": {"color": "red", "dash": "dash"}},
+			{"type": "line", "xref": "x2", "x0": "2021-01-01", "x1": "2021-01-01", "yref": "y domain", "y0": 0, "y1": 1}
+		]`))
+
+		annotationsBytes, err := json.Marshal(fig.Layout.Annotations)
+		Expect(err).To(BeNil())
+		Expect(string(annotationsBytes)).To(MatchJSON(`[
+			{"text": "release", "x": "2021-01-01", "y": 1, "xref": "x2", "yref": "y domain", "showarrow": false, "xanchor": "left", "yanchor": "top"}
+		]`))
+	})
+
+	It("Should add bands", func() {
+		fig := &grob.Fig{}
+		fig.AddVRect(1, 2, grob.ShapeOptions{Below: true})
+		fig.AddHRect(5, 6, grob.ShapeOptions{Fillcolor: "green", Color: "black"})
+
+		shapesBytes, err := json.Marshal(fig.Layout.Shapes)
+		Expect(err).To(BeNil())
+		Expect(string(shapesBytes)).To(MatchJSON(`[
+			{"type": "rect", "xref": "x", "x0": 1, "x1": 2, "yref": "y domain", "y0": 0, "y1": 1, "line": {"width": 0}, "fillcolor": "lightgrey", "opacity": 0.5, "layer": "below"},
+			{"type": "rect", "xref": "x domain", "x0": 0, "x1": 1, "yref": "y", "y0": 5, "y1": 6, "line": {"color": "black"}, "fillcolor": "green", "opacity": 0.5}
+		]`))
+	})
+
+	It("Should span the subplot of the axes of the options", func() {
+		fig := &grob.Fig{}
+		fig.AddHLine(1, grob.ShapeOptions{Xref: "x2", Yref: "y2", Text: "target"})
+		fig.AddVRect(1, 2, grob.ShapeOptions{Xref: "x3", Yref: "y3 domain"})
+		fig.AddHRect(5, 6, grob.ShapeOptions{Xref: "paper"})
+
+		shapes := fig.Layout.Shapes.([]interface{})
+		Expect(shapes[0]).To(HaveKeyWithValue("xref", "x2 domain"))
+		Expect(shapes[0]).To(HaveKeyWithValue("yref", "y2"))
+		Expect(shapes[1]).To(HaveKeyWithValue("xref", "x3"))
+		Expect(shapes[1]).To(HaveKeyWithValue("yref", "y3 domain"))
+		Expect(shapes[2]).To(HaveKeyWithValue("xref", "paper"))
+
+		annotations := fig.Layout.Annotations.([]interface{})
+		Expect(annotations[0]).To(HaveKeyWithValue("xref", "x2 domain"))
+		Expect(annotations[0]).To(HaveKeyWithValue("yref", "y2"))
+	})
+
+	It("Should keep the existing shapes and annotations", func() {
+		fig := &grob.Fig{
+			Layout: &grob.Layout{
+				Annotations: []map[string]interface{}{{"text": "existing"}},
+			},
+		}
+		fig.AddAnnotation(1, 2, "new", grob.AnnotationOptions{Arrow: true})
+
+		annotations := fig.Layout.Annotations.([]interface{})
+		Expect(annotations).To(HaveLen(2))
+		Expect(annotations[0]).To(Equal(map[string]interface{}{"text": "existing"}))
+		Expect(annotations[1]).To(HaveKeyWithValue("showarrow", true))
+		Expect(annotations[1]).To(HaveKeyWithValue("xref", "x"))
+	})
+})
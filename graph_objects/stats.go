@@ -0,0 +1,31 @@
+package grob
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// EncodeStats describes the encoding of a figure by ToPlotlyJSON or MarshalJSON, see SetEncodeStatsHook
+type EncodeStats struct {
+	// Traces is the number of traces of the figure
+	Traces int
+	// Bytes is the size of the JSON written, 0 if the encoding failed
+	Bytes int
+	// Duration is the time spent encoding the figure
+	Duration time.Duration
+}
+
+// encodeStatsHook holds the func(EncodeStats) set by SetEncodeStatsHook, it can be replaced while figures are encoded
+var encodeStatsHook atomic.Value
+
+// SetEncodeStatsHook calls hook after every figure is encoded by ToPlotlyJSON or MarshalJSON, which includes the offline and notebook pages,
+// to monitor the size and the encoding time of the figures served. The hook is called from the goroutine that encodes the figure,
+// so it must be safe for concurrent use. It can be replaced at any time. A nil hook, the default, disables the stats.
+//
+//	grob.SetEncodeStatsHook(func(stats grob.EncodeStats) {
+//		encodeSeconds.Observe(stats.Duration.Seconds())
+//		encodeBytes.Observe(float64(stats.Bytes))
+//	})
+func SetEncodeStatsHook(hook func(stats EncodeStats)) {
+	encodeStatsHook.Store(hook)
+}
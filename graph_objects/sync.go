@@ -0,0 +1,90 @@
+package grob
+
+import (
+	"encoding/json"
+	"sync"
+)
+
+// SyncFig is a figure that can be updated and marshaled from several goroutines, such as a live dashboard
+// updated by the goroutines that receive the data and served to the browsers at the same time.
+// Every operation holds a lock on the figure, the figure must not be modified out of them.
+//
+//	live := grob.NewSyncFig(fig)
+//	go func() {
+//		for value := range values {
+//			live.Update(func(fig *grob.Fig) error {
+//				trace := fig.Data[0].(*grob.Scatter)
+//				trace.Y = append(trace.Y.([]float64), value)
+//				return nil
+//			})
+//		}
+//	}()
+//	data, err := live.ToPlotlyJSON()
+type SyncFig struct {
+	mu  sync.RWMutex
+	fig *Fig
+}
+
+// NewSyncFig wraps the figure, a nil figure starts empty. The figure must not be used directly afterwards.
+func NewSyncFig(fig *Fig) *SyncFig {
+	if fig == nil {
+		fig = &Fig{}
+	}
+	return &SyncFig{fig: fig}
+}
+
+// Update calls f with the figure, that is not used by other goroutines until f returns
+func (s *SyncFig) Update(f func(fig *Fig) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return f(s.fig)
+}
+
+// View calls f with the figure to read it, f must not modify the figure. Other goroutines can read it at the same time.
+func (s *SyncFig) View(f func(fig *Fig) error) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return f(s.fig)
+}
+
+// AddTraces adds the traces to the figure, like Fig.AddTraces. The traces must not be modified afterwards, use Update instead.
+func (s *SyncFig) AddTraces(traces ...Trace) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fig.AddTraces(traces...)
+}
+
+// UpdateLayout applies the options to the layout, like Fig.UpdateLayout
+func (s *SyncFig) UpdateLayout(opts ...LayoutOption) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.fig.UpdateLayout(opts...)
+}
+
+// UpdateTraces applies the options to the selected traces, like Fig.UpdateTraces
+func (s *SyncFig) UpdateTraces(selector Selector, opts ...TraceOption) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.fig.UpdateTraces(selector, opts...)
+}
+
+// ToPlotlyJSON returns the figure in the JSON format of plotly.js, like Fig.ToPlotlyJSON
+func (s *SyncFig) ToPlotlyJSON() ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.fig.ToPlotlyJSON()
+}
+
+// MarshalJSON marshals the figure like a Fig
+func (s *SyncFig) MarshalJSON() ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return json.Marshal(s.fig)
+}
+
+// MarshalDelta returns the changes marked with MarkDirty in Update, like Fig.MarshalDelta
+func (s *SyncFig) MarshalDelta() ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.fig.MarshalDelta()
+}
@@ -0,0 +1,70 @@
+package grob_test
+
+import (
+	"encoding/json"
+	"sync"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var _ = Describe("SyncFig", func() {
+
+	It("Should start with an empty figure", func() {
+		data, err := grob.NewSyncFig(nil).ToPlotlyJSON()
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(HavePrefix(`{"data":[],"layout":{`))
+	})
+
+	It("Should serialize concurrent updates and marshals", func() {
+		fig := &grob.Fig{}
+		fig.AddScatter([]float64{}, []float64{})
+		live := grob.NewSyncFig(fig)
+
+		wg := sync.WaitGroup{}
+		for i := 0; i < 10; i++ {
+			wg.Add(3)
+			go func(i int) {
+				defer wg.Done()
+				defer GinkgoRecover()
+				err := live.Update(func(fig *grob.Fig) error {
+					trace := fig.Data[0].(*grob.Scatter)
+					trace.X = append(trace.X.([]float64), float64(i))
+					trace.Y = append(trace.Y.([]float64), float64(i))
+					return nil
+				})
+				Expect(err).To(BeNil())
+			}(i)
+			go func() {
+				defer wg.Done()
+				defer GinkgoRecover()
+				Expect(live.UpdateLayout(grob.WithTitle("live"))).To(Succeed())
+				live.AddTraces(&grob.Bar{})
+			}()
+			go func() {
+				defer wg.Done()
+				defer GinkgoRecover()
+				_, err := live.ToPlotlyJSON()
+				Expect(err).To(BeNil())
+			}()
+		}
+		wg.Wait()
+
+		err := live.View(func(fig *grob.Fig) error {
+			Expect(fig.Data).To(HaveLen(11))
+			Expect(fig.Data[0].(*grob.Scatter).X).To(HaveLen(10))
+			return nil
+		})
+		Expect(err).To(BeNil())
+
+		n, err := live.UpdateTraces(grob.SelectType(grob.TraceTypeBar), grob.SetTrace("name", "bars"))
+		Expect(err).To(BeNil())
+		Expect(n).To(Equal(10))
+
+		data, err := json.Marshal(live)
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(ContainSubstring(`"name":"bars"`))
+	})
+})
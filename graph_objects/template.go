@@ -0,0 +1,122 @@
+package grob
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+)
+
+// DefaultTemplateEnv is the environment variable of the JSON file of the default template, like those of plotly.py.
+// It overrides the template given to SetDefaultTemplate, so deployments can restyle the figures of a service
+const DefaultTemplateEnv = "PLOTLY_TEMPLATE"
+
+var (
+	defaultTemplateMu sync.Mutex
+	defaultTemplate   *Template
+	// envTemplate is the template read from the file of the environment variable, read once per file
+	envTemplate     *Template
+	envTemplateFile string
+)
+
+// Template defines the default style of a figure, it is the value of Layout.Template.
+// Values defined in the figure take precedence over the template.
+// https://plotly.com/javascript/layout-template
+type Template struct {
+	// Data contains the default attributes of the traces by type.
+	// Traces of a type cycle through the given defaults, for example to alternate the marker symbols of scatter traces
+	Data map[TraceType]Traces `json:"data,omitempty"`
+
+	// Layout contains the default layout attributes
+	Layout *Layout `json:"layout,omitempty"`
+}
+
+// UnmarshalJSON is a custom unmarshal function to decode the trace defaults, that do not need the type attribute.
+func (template *Template) UnmarshalJSON(data []byte) error {
+	tmp := struct {
+		Data   map[TraceType][]map[string]interface{} `json:"data,omitempty"`
+		Layout *Layout                                `json:"layout,omitempty"`
+	}{}
+	err := json.Unmarshal(data, &tmp)
+	if err != nil {
+		return err
+	}
+
+	template.Layout = tmp.Layout
+	template.Data = nil
+	for traceType, traces := range tmp.Data {
+		if template.Data == nil {
+			template.Data = map[TraceType]Traces{}
+		}
+		for _, trace := range traces {
+			trace["type"] = traceType
+			traceBytes, err := json.Marshal(trace)
+			if err != nil {
+				return err
+			}
+			t, err := UnmarshalTrace(traceBytes)
+			if err != nil {
+				return err
+			}
+			template.Data[traceType] = append(template.Data[traceType], t)
+		}
+	}
+	return nil
+}
+
+// SetDefaultTemplate sets the template of the figures without template, so all the charts of a service share the corporate style
+// without code per figure. The offline and export packages apply it when they render the figures, which are not modified.
+// A nil template, the default, removes it.
+//
+//	grob.SetDefaultTemplate(themes.Seaborn)
+func SetDefaultTemplate(template *Template) {
+	defaultTemplateMu.Lock()
+	defer defaultTemplateMu.Unlock()
+	defaultTemplate = template
+}
+
+// DefaultTemplate returns the template of the file of the DefaultTemplateEnv environment variable if it is set,
+// or the template given to SetDefaultTemplate. It is nil if there is none.
+func DefaultTemplate() (*Template, error) {
+	defaultTemplateMu.Lock()
+	defer defaultTemplateMu.Unlock()
+	file := os.Getenv(DefaultTemplateEnv)
+	if file == "" {
+		return defaultTemplate, nil
+	}
+	if file == envTemplateFile {
+		return envTemplate, nil
+	}
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read template of %s, %w", DefaultTemplateEnv, err)
+	}
+	template := &Template{}
+	err = json.Unmarshal(data, template)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode template %s, %w", file, err)
+	}
+	envTemplate, envTemplateFile = template, file
+	return template, nil
+}
+
+// WithDefaultTemplate returns a copy of the figure that uses the default template, or the figure itself
+// if it already has a template or there is no default template
+func (fig *Fig) WithDefaultTemplate() (*Fig, error) {
+	if fig.Layout != nil && fig.Layout.Template != nil {
+		return fig, nil
+	}
+	template, err := DefaultTemplate()
+	if err != nil || template == nil {
+		return fig, err
+	}
+	themed := *fig
+	layout := &Layout{}
+	if fig.Layout != nil {
+		*layout = *fig.Layout
+	}
+	layout.Template = template
+	themed.Layout = layout
+	return &themed, nil
+}
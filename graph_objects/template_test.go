@@ -0,0 +1,65 @@
+package grob_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var _ = Describe("Default template", func() {
+
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "template")
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		grob.SetDefaultTemplate(nil)
+		os.Unsetenv(grob.DefaultTemplateEnv)
+		os.RemoveAll(dir)
+	})
+
+	It("Should apply the default template to a copy of the figures without template", func() {
+		template := &grob.Template{Layout: &grob.Layout{PaperBgcolor: "black"}}
+		grob.SetDefaultTemplate(template)
+
+		fig := &grob.Fig{Layout: &grob.Layout{Title: &grob.LayoutTitle{Text: "Sales"}}}
+		themed, err := fig.WithDefaultTemplate()
+		Expect(err).To(BeNil())
+		Expect(themed.Layout.Template).To(Equal(template))
+		Expect(themed.Layout.Title.Text).To(Equal("Sales"))
+		Expect(fig.Layout.Template).To(BeNil())
+
+		own := &grob.Fig{Layout: &grob.Layout{Template: &grob.Template{}}}
+		Expect(own.WithDefaultTemplate()).To(BeIdenticalTo(own))
+	})
+
+	It("Should keep the figures without default template", func() {
+		fig := &grob.Fig{}
+		Expect(fig.WithDefaultTemplate()).To(BeIdenticalTo(fig))
+	})
+
+	It("Should read the default template from the file of the environment variable", func() {
+		grob.SetDefaultTemplate(&grob.Template{Layout: &grob.Layout{PaperBgcolor: "black"}})
+		file := filepath.Join(dir, "corporate.json")
+		Expect(ioutil.WriteFile(file, []byte(`{"layout": {"paper_bgcolor": "navy"}, "data": {"bar": [{"opacity": 0.8}]}}`), 0644)).To(Succeed())
+		os.Setenv(grob.DefaultTemplateEnv, file)
+
+		template, err := grob.DefaultTemplate()
+		Expect(err).To(BeNil())
+		Expect(template.Layout.PaperBgcolor).To(Equal("navy"))
+		Expect(template.Data[grob.TraceTypeBar][0].(*grob.Bar).Opacity).To(Equal(0.8))
+
+		os.Setenv(grob.DefaultTemplateEnv, filepath.Join(dir, "missing.json"))
+		_, err = (&grob.Fig{}).WithDefaultTemplate()
+		Expect(err).NotTo(BeNil())
+	})
+})
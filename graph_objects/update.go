@@ -0,0 +1,224 @@
+package grob
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// LayoutOption modifies the layout of a figure, see Fig.UpdateLayout
+type LayoutOption func(layout *Layout) error
+
+// TraceOption modifies a trace, see Fig.UpdateTraces
+type TraceOption func(trace Trace) error
+
+// Selector chooses the traces modified by Fig.UpdateTraces, index is the position of the trace in the figure data
+type Selector func(index int, trace Trace) bool
+
+// UpdateLayout applies the options to the layout, which is created if the figure has none.
+// It stops at the first option that fails.
+//
+//	err := fig.UpdateLayout(grob.WithTitle("Sales"), grob.SetLayout("xaxis.type", grob.LayoutXaxisTypeLog))
+func (fig *Fig) UpdateLayout(opts ...LayoutOption) error {
+	if fig.Layout == nil {
+		fig.Layout = &Layout{}
+	}
+	for _, opt := range opts {
+		err := opt(fig.Layout)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateTraces applies the options to the traces chosen by the selector, like update_traces of plotly.py.
+// A nil selector chooses all the traces. It returns the number of traces updated and stops at the first option that fails.
+//
+//	n, err := fig.UpdateTraces(grob.SelectType(grob.TraceTypeScatter), grob.SetTrace("marker.size", 10))
+func (fig *Fig) UpdateTraces(selector Selector, opts ...TraceOption) (int, error) {
+	updated := 0
+	for i, trace := range fig.Data {
+		if selector != nil && !selector(i, trace) {
+			continue
+		}
+		for _, opt := range opts {
+			err := opt(trace)
+			if err != nil {
+				return updated, fmt.Errorf("cannot update trace %d, %w", i, err)
+			}
+		}
+		updated++
+	}
+	return updated, nil
+}
+
+// SelectIndex chooses the traces at the given positions
+func SelectIndex(indexes ...int) Selector {
+	return func(index int, trace Trace) bool {
+		for _, i := range indexes {
+			if i == index {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// SelectType chooses the traces of the given types
+func SelectType(types ...TraceType) Selector {
+	return func(index int, trace Trace) bool {
+		for _, t := range types {
+			if trace.GetType() == t {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// SelectName chooses the traces with the given names
+func SelectName(names ...string) Selector {
+	return func(index int, trace Trace) bool {
+		value := reflect.ValueOf(trace)
+		if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+			return false
+		}
+		field := value.Elem().FieldByName("Name")
+		if !field.IsValid() || field.Kind() != reflect.Interface || field.IsNil() {
+			return false
+		}
+		name := fmt.Sprint(field.Interface())
+		for _, n := range names {
+			if n == name {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// WithTitle sets the title of the figure
+func WithTitle(text string) LayoutOption {
+	return func(layout *Layout) error {
+		if layout.Title == nil {
+			layout.Title = &LayoutTitle{}
+		}
+		layout.Title.Text = text
+		return nil
+	}
+}
+
+// WithSize sets the width and height of the figure in pixels
+func WithSize(width, height float64) LayoutOption {
+	return func(layout *Layout) error {
+		layout.Width = width
+		layout.Height = height
+		return nil
+	}
+}
+
+// WithTemplate sets the template of the figure, such as the themes package templates
+func WithTemplate(template *Template) LayoutOption {
+	return func(layout *Layout) error {
+		layout.Template = template
+		return nil
+	}
+}
+
+// SetLayout sets the layout attribute at the given path, such as xaxis.title.text. The path uses the JSON names of the attributes
+// and the objects on the way are created if needed. Bool attributes accept bool values.
+func SetLayout(path string, value interface{}) LayoutOption {
+	return func(layout *Layout) error {
+		return setPath(reflect.ValueOf(layout).Elem(), path, value)
+	}
+}
+
+// SetTrace sets the trace attribute at the given path, such as marker.color. The path uses the JSON names of the attributes
+// and the objects on the way are created if needed. Bool attributes accept bool values.
+func SetTrace(path string, value interface{}) TraceOption {
+	return func(trace Trace) error {
+		v := reflect.ValueOf(trace)
+		if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+			return fmt.Errorf("cannot set %s on %T", path, trace)
+		}
+		return setPath(v.Elem(), path, value)
+	}
+}
+
+// setPath sets the attribute of the struct at the dot separated path of JSON names
+func setPath(object reflect.Value, path string, value interface{}) error {
+	parts := strings.Split(path, ".")
+	for i, part := range parts {
+		field, ok := fieldByJSONName(object, part)
+		if !ok {
+			return fmt.Errorf("attribute %s not found in %s", strings.Join(parts[:i+1], "."), object.Type().Name())
+		}
+		if i == len(parts)-1 {
+			return assign(field, value, path)
+		}
+
+		switch {
+		case field.Kind() == reflect.Ptr && field.Type().Elem().Kind() == reflect.Struct:
+			if field.IsNil() {
+				field.Set(reflect.New(field.Type().Elem()))
+			}
+			object = field.Elem()
+		case field.Kind() == reflect.Struct:
+			object = field
+		default:
+			return fmt.Errorf("attribute %s is not an object", strings.Join(parts[:i+1], "."))
+		}
+	}
+	return nil
+}
+
+// fieldByJSONName returns the field of the struct with the given JSON name.
+// Like encoding/json, the fields of embedded structs without tag are searched too, such as a trace embedded by another type
+func fieldByJSONName(object reflect.Value, name string) (reflect.Value, bool) {
+	t := object.Type()
+	for i := 0; i < t.NumField(); i++ {
+		tag := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if tag == name {
+			return object.Field(i), true
+		}
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if !t.Field(i).Anonymous || t.Field(i).Tag.Get("json") != "" {
+			continue
+		}
+		embedded := object.Field(i)
+		if embedded.Kind() == reflect.Ptr && !embedded.IsNil() {
+			embedded = embedded.Elem()
+		}
+		if embedded.Kind() != reflect.Struct {
+			continue
+		}
+		if field, ok := fieldByJSONName(embedded, name); ok {
+			return field, true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// assign sets the field to the value, converting it if needed
+func assign(field reflect.Value, value interface{}, path string) error {
+	if value == nil {
+		field.Set(reflect.Zero(field.Type()))
+		return nil
+	}
+	v := reflect.ValueOf(value)
+	if b, ok := value.(bool); ok && field.Type() == reflect.TypeOf(True) {
+		v = reflect.ValueOf(Bool(&b))
+	}
+	switch {
+	case v.Type().AssignableTo(field.Type()):
+		field.Set(v)
+	case v.Type().ConvertibleTo(field.Type()) && (v.Kind() == reflect.String) == (field.Kind() == reflect.String):
+		// numbers are converted between them and strings to enums, but numbers are not converted to strings
+		field.Set(v.Convert(field.Type()))
+	default:
+		return fmt.Errorf("cannot set %s of type %s to %T", path, field.Type(), value)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package grob_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var _ = Describe("Update", func() {
+
+	var fig *grob.Fig
+	BeforeEach(func() {
+		fig = &grob.Fig{}
+		fig.AddTraces(
+			&grob.Scatter{Name: "first"},
+			&grob.Bar{Name: "second"},
+			&grob.Scatter{Name: "third"},
+		)
+	})
+
+	It("Should update the layout", func() {
+		err := fig.UpdateLayout(
+			grob.WithTitle("Sales"),
+			grob.WithSize(800, 600),
+			grob.SetLayout("xaxis.type", "log"),
+			grob.SetLayout("yaxis.title.text", "units"),
+			grob.SetLayout("showlegend", false),
+			grob.SetLayout("xaxis2.domain", []float64{0.5, 1}),
+		)
+		Expect(err).To(BeNil())
+		Expect(fig.Layout.Title.Text).To(Equal("Sales"))
+		Expect(fig.Layout.Width).To(Equal(800.0))
+		Expect(fig.Layout.Xaxis.Type).To(Equal(grob.LayoutXaxisTypeLog))
+		Expect(fig.Layout.Yaxis.Title.Text).To(Equal("units"))
+		Expect(*fig.Layout.Showlegend).To(BeFalse())
+		Expect(fig.Layout.XAxis2.Domain).To(Equal([]float64{0.5, 1}))
+	})
+
+	It("Should report wrong attributes", func() {
+		Expect(fig.UpdateLayout(grob.SetLayout("xaxis.unknown", 1))).NotTo(Succeed())
+		Expect(fig.UpdateLayout(grob.SetLayout("width", "wide"))).NotTo(Succeed())
+		Expect(fig.UpdateLayout(grob.SetLayout("title.text.size", 1))).NotTo(Succeed())
+	})
+
+	It("Should update the selected traces", func() {
+		n, err := fig.UpdateTraces(grob.SelectType(grob.TraceTypeScatter), grob.SetTrace("marker.size", 10), grob.SetTrace("opacity", 0.5))
+		Expect(err).To(BeNil())
+		Expect(n).To(Equal(2))
+		Expect(fig.Data[0].(*grob.Scatter).Marker.Size).To(Equal(10.0))
+		Expect(fig.Data[2].(*grob.Scatter).Opacity).To(Equal(0.5))
+		Expect(fig.Data[1].(*grob.Bar).Opacity).To(Equal(0.0))
+
+		n, err = fig.UpdateTraces(grob.SelectName("second", "third"), grob.SetTrace("visible", grob.ScatterVisibleLegendonly))
+		Expect(err).To(BeNil())
+		Expect(n).To(Equal(2))
+		Expect(fig.Data[1].(*grob.Bar).Visible).To(BeEquivalentTo("legendonly"))
+
+		n, err = fig.UpdateTraces(grob.SelectIndex(0), grob.SetTrace("showlegend", false))
+		Expect(err).To(BeNil())
+		Expect(n).To(Equal(1))
+		Expect(*fig.Data[0].(*grob.Scatter).Showlegend).To(BeFalse())
+
+		n, err = fig.UpdateTraces(nil, grob.SetTrace("name", "all"))
+		Expect(err).To(BeNil())
+		Expect(n).To(Equal(3))
+	})
+})
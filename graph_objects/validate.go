@@ -0,0 +1,311 @@
+package grob
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// ValidationError is an attribute that does not satisfy the schema
+type ValidationError struct {
+	// Path is the JSON path of the attribute in the figure, like data[0].marker.size or layout.xaxis2.type
+	Path    string
+	Message string
+}
+
+func (err ValidationError) Error() string {
+	return err.Path + ": " + err.Message
+}
+
+// ValidationErrors are all the problems found by Validate, sorted by path
+type ValidationErrors []ValidationError
+
+func (errs ValidationErrors) Error() string {
+	lines := make([]string, len(errs))
+	for i, err := range errs {
+		lines[i] = err.Error()
+	}
+	return fmt.Sprintf("%d invalid attributes:\n%s", len(errs), strings.Join(lines, "\n"))
+}
+
+// requiredAttributes are the attributes a trace cannot be drawn without, they are not part of the schema
+var requiredAttributes = map[TraceType][]string{
+	TraceTypePie:        {"values"},
+	TraceTypeFunnelarea: {"values"},
+	TraceTypeHeatmap:    {"z"},
+	TraceTypeContour:    {"z"},
+	TraceTypeSurface:    {"z"},
+	TraceTypeChoropleth: {"locations", "z"},
+}
+
+// Validate checks the traces, the layout and the frames of the figure against the constraints of the plotly schema.
+// See ValidateTrace for the checks. The error is ValidationErrors with the JSON path of every invalid attribute, like data[1].marker.size.
+func (fig *Fig) Validate() error {
+	return fig.validate().result()
+}
+
+// validate checks the figure and returns the validator with the errors found
+func (fig *Fig) validate() *validator {
+	v := &validator{}
+	for i, trace := range fig.Data {
+		v.trace(fmt.Sprintf("data[%d]", i), trace)
+	}
+	if fig.Layout != nil {
+		v.object(fig.Layout, "layout", "layout")
+	}
+	if fig.Config != nil {
+		v.object(fig.Config, "config", "config")
+	}
+	for i, frame := range fig.Frames {
+		for j, trace := range frame.Data {
+			v.trace(fmt.Sprintf("frames[%d].data[%d]", i, j), trace)
+		}
+		if frame.Layout != nil {
+			v.object(frame.Layout, fmt.Sprintf("frames[%d].layout", i), "layout")
+		}
+	}
+	return v
+}
+
+// Validate checks the layout against the constraints of the plotly schema, see ValidateTrace.
+// Numbered subplots such as xaxis2 are checked like xaxis.
+func (layout *Layout) Validate() error {
+	v := &validator{}
+	v.object(layout, "layout", "layout")
+	return v.result()
+}
+
+// ValidateTrace checks the trace against the constraints of the plotly schema:
+// enumerated attributes have one of the allowed values, numbers are within their minimum and maximum,
+// flaglists only combine known flags, colors are understood by ParseColor, the attributes required to draw the trace, such as the values of a pie or the z of a heatmap, are set
+// and the arrays with a value per point, such as x, y, text or marker.size, have the same length.
+// Deprecated attributes are reported with the description of their replacement and attributes unknown to the schema are not checked.
+// The error is ValidationErrors with the JSON path of every invalid attribute.
+func ValidateTrace(trace Trace) error {
+	v := &validator{}
+	v.trace("", trace)
+	return v.result()
+}
+
+// validator collects the errors of the attributes
+type validator struct {
+	errs ValidationErrors
+	// deprecated are the deprecated attributes found, they are reported with the errors
+	deprecated ValidationErrors
+}
+
+func (v *validator) result() error {
+	v.errs = append(v.errs, v.deprecated...)
+	v.deprecated = nil
+	if len(v.errs) == 0 {
+		return nil
+	}
+	sort.SliceStable(v.errs, func(i, j int) bool { return v.errs[i].Path < v.errs[j].Path })
+	return v.errs
+}
+
+func (v *validator) fail(path, format string, args ...interface{}) {
+	v.errs = append(v.errs, ValidationError{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) trace(path string, trace Trace) {
+	if trace == nil || reflect.ValueOf(trace).Kind() == reflect.Ptr && reflect.ValueOf(trace).IsNil() {
+		v.fail(path, "trace is nil")
+		return
+	}
+	object := v.object(trace, path, string(trace.GetType()))
+	for _, name := range requiredAttributes[trace.GetType()] {
+		if value, ok := object[name]; !ok || value == nil {
+			v.fail(join(path, name), "%s traces require %s", trace.GetType(), name)
+		}
+	}
+	v.lengths(object, path, trace.GetType())
+}
+
+// object checks the JSON representation of value and returns it.
+// path is the JSON path of the value in the figure and schema its path in the attribute registry.
+func (v *validator) object(value interface{}, path, schema string) map[string]interface{} {
+	object, err := jsonObject(value)
+	if err != nil {
+		v.fail(path, "%s", err)
+		return nil
+	}
+	v.attributes(object, path, schema)
+	return object
+}
+
+// jsonObject returns the JSON representation of the value as a map
+func jsonObject(value interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal, %w", err)
+	}
+	object := map[string]interface{}{}
+	err = json.Unmarshal(data, &object)
+	if err != nil {
+		return nil, fmt.Errorf("cannot unmarshal, %w", err)
+	}
+	return object, nil
+}
+
+// subplotSuffix matches the number of subplots such as xaxis2 or scene3
+var subplotSuffix = regexp.MustCompile(`^([a-z]+)[1-9][0-9]*$`)
+
+func (v *validator) attributes(object map[string]interface{}, path, schema string) {
+	attributesOnce.Do(loadAttributes)
+	for name, value := range object {
+		info, ok := attributes[schema+"."+name]
+		if !ok {
+			if match := subplotSuffix.FindStringSubmatch(name); match != nil {
+				info, ok = attributes[schema+"."+match[1]]
+			}
+		}
+		if !ok || value == nil {
+			continue
+		}
+		if info.Deprecated {
+			v.deprecated = append(v.deprecated, ValidationError{Path: join(path, name), Message: "deprecated, " + info.Description})
+			continue
+		}
+		v.attribute(value, join(path, name), info)
+	}
+}
+
+func (v *validator) attribute(value interface{}, path string, info *AttributeInfo) {
+	if info.Role == "object" {
+		switch value := value.(type) {
+		case map[string]interface{}:
+			v.attributes(value, path, info.Path)
+		case []interface{}:
+			// arrays of objects, such as annotations, share the attributes of their items
+			for i, item := range value {
+				if item, ok := item.(map[string]interface{}); ok {
+					v.attributes(item, fmt.Sprintf("%s[%d]", path, i), info.Path)
+				}
+			}
+		}
+		return
+	}
+
+	if array, ok := value.([]interface{}); ok && info.ArrayOK {
+		for i, item := range array {
+			if item != nil {
+				v.value(item, fmt.Sprintf("%s[%d]", path, i), info)
+			}
+		}
+		return
+	}
+	v.value(value, path, info)
+}
+
+func (v *validator) value(value interface{}, path string, info *AttributeInfo) {
+	switch info.ValType {
+	case "enumerated":
+		if !enumerated(value, info.Values) {
+			v.fail(path, "%v is not one of %s", value, describeValues(info.Values))
+		}
+	case "number", "integer":
+		number, ok := value.(float64)
+		if !ok {
+			return
+		}
+		if info.Min != nil && number < *info.Min {
+			v.fail(path, "%v is less than the minimum %v", number, *info.Min)
+		}
+		if info.Max != nil && number > *info.Max {
+			v.fail(path, "%v is greater than the maximum %v", number, *info.Max)
+		}
+		if info.ValType == "integer" && number != float64(int64(number)) {
+			v.fail(path, "%v is not an integer", number)
+		}
+	case "color":
+		// numbers are valid, they are mapped to the colorscale
+		if color, ok := value.(string); ok {
+			v.color(color, path)
+		}
+	case "colorlist":
+		colors, _ := value.([]interface{})
+		for i, color := range colors {
+			if color, ok := color.(string); ok {
+				v.color(color, fmt.Sprintf("%s[%d]", path, i))
+			}
+		}
+	case "colorscale":
+		// named colorscales are not checked, only the colors of the [level, color] pairs
+		levels, _ := value.([]interface{})
+		for i, level := range levels {
+			if pair, ok := level.([]interface{}); ok && len(pair) == 2 {
+				if color, ok := pair[1].(string); ok {
+					v.color(color, fmt.Sprintf("%s[%d][1]", path, i))
+				}
+			}
+		}
+	case "flaglist":
+		if enumerated(value, info.Extras) {
+			return
+		}
+		flags, ok := value.(string)
+		if !ok {
+			v.fail(path, "%v is not a string of flags", value)
+			return
+		}
+		for _, flag := range strings.Split(flags, "+") {
+			if !contains(info.Flags, flag) {
+				v.fail(path, "flag %q is not one of %s", flag, strings.Join(info.Flags, ", "))
+			}
+		}
+	}
+}
+
+func (v *validator) color(color, path string) {
+	_, err := ParseColor(color)
+	if err != nil {
+		v.fail(path, "%s", err)
+	}
+}
+
+// enumerated reports if the value is one of the values, that can be regular expressions between slashes
+func enumerated(value interface{}, values []interface{}) bool {
+	for _, allowed := range values {
+		if value == allowed {
+			return true
+		}
+		s, ok := value.(string)
+		pattern, isPattern := allowed.(string)
+		if !ok || !isPattern || len(pattern) < 2 || !strings.HasPrefix(pattern, "/") || !strings.HasSuffix(pattern, "/") {
+			continue
+		}
+		re, err := regexp.Compile(pattern[1 : len(pattern)-1])
+		if err == nil && re.MatchString(s) {
+			return true
+		}
+	}
+	return false
+}
+
+func describeValues(values []interface{}) string {
+	s := make([]string, len(values))
+	for i, value := range values {
+		s[i] = fmt.Sprint(value)
+	}
+	return strings.Join(s, ", ")
+}
+
+func join(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func contains(s []string, e string) bool {
+	for _, a := range s {
+		if a == e {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package grob_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var _ = Describe("Validate", func() {
+
+	paths := func(err error) []string {
+		var errs grob.ValidationErrors
+		Expect(errors.As(err, &errs)).To(BeTrue())
+		paths := []string{}
+		for _, e := range errs {
+			paths = append(paths, e.Path)
+		}
+		return paths
+	}
+
+	It("Should accept a valid figure", func() {
+		fig := &grob.Fig{
+			Data: grob.Traces{
+				&grob.Scatter{
+					Type:      grob.TraceTypeScatter,
+					X:         []float64{1, 2, 3},
+					Y:         []float64{1, 2, 3},
+					Mode:      grob.ScatterModeLines + "+" + grob.ScatterModeMarkers,
+					Hoverinfo: grob.ScatterHoverinfoNone,
+					Xaxis:     "x2",
+					Marker:    &grob.ScatterMarker{Size: 8, Opacity: 0.5},
+				},
+				&grob.Pie{Type: grob.TraceTypePie, Values: []float64{1, 2}},
+			},
+			Layout: &grob.Layout{
+				Xaxis:  &grob.LayoutXaxis{Type: grob.LayoutXaxisTypeLog, Autorange: grob.LayoutXaxisAutorangeReversed},
+				XAxis2: &grob.LayoutXaxis{Matches: "x"},
+				Annotations: []map[string]interface{}{
+					{"text": "note", "opacity": 0.5},
+				},
+			},
+		}
+		Expect(fig.Validate()).To(Succeed())
+	})
+
+	It("Should report every invalid attribute with its path", func() {
+		fig := &grob.Fig{
+			Data: grob.Traces{
+				&grob.Scatter{
+					Type:   grob.TraceTypeScatter,
+					Mode:   "lines+dots",
+					Marker: &grob.ScatterMarker{Size: -2, Opacity: 2},
+				},
+				&grob.Heatmap{Type: grob.TraceTypeHeatmap},
+			},
+			Layout: &grob.Layout{
+				XAxis2: &grob.LayoutXaxis{Type: "logarithmic"},
+				Annotations: []map[string]interface{}{
+					{"text": "ok"},
+					{"opacity": 3},
+				},
+			},
+		}
+		err := fig.Validate()
+		Expect(err).NotTo(BeNil())
+		Expect(paths(err)).To(Equal([]string{
+			"data[0].marker.opacity",
+			"data[0].marker.size",
+			"data[0].mode",
+			"data[1].z",
+			"layout.annotations[1].opacity",
+			"layout.xaxis2.type",
+		}))
+		Expect(err.Error()).To(ContainSubstring(`data[0].mode: flag "dots" is not one of`))
+	})
+
+	It("Should validate traces and layouts on their own", func() {
+		Expect((&grob.Pie{}).Validate()).NotTo(Succeed())
+		Expect(paths(grob.ValidateTrace(&grob.Pie{}))).To(Equal([]string{"values"}))
+		Expect((&grob.Layout{Barmode: "side"}).Validate()).NotTo(Succeed())
+		Expect((&grob.Layout{Barmode: grob.BarBarmodeGroup}).Validate()).To(Succeed())
+	})
+})
@@ -0,0 +1,128 @@
+package grob
+
+import (
+	"reflect"
+	"strings"
+)
+
+// webglTraces are the trace types that have an equivalent drawn with WebGL
+var webglTraces = map[TraceType]TraceType{
+	TraceTypeScatter:      TraceTypeScattergl,
+	TraceTypeScatterpolar: TraceTypeScatterpolargl,
+	TraceTypeHeatmap:      TraceTypeHeatmapgl,
+}
+
+// OptimizeWebGL replaces the traces with more points than the threshold by their WebGL equivalent, which draws
+// hundreds of thousands of points smoothly: scatter by scattergl, scatterpolar by scatterpolargl and heatmap by heatmapgl.
+// The points of a heatmap are its cells. The attributes supported by the WebGL trace are kept, the others are dropped,
+// like the stackgroup of a scatter or the values of an enumerated attribute that the WebGL trace does not accept, like a spline line shape.
+// It returns the number of replaced traces.
+func (fig *Fig) OptimizeWebGL(threshold int) int {
+	attributesOnce.Do(loadAttributes)
+	replaced := 0
+	for i, trace := range fig.Data {
+		if trace == nil {
+			continue
+		}
+		target, ok := webglTraces[trace.GetType()]
+		value := reflect.ValueOf(trace)
+		if !ok || value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct || tracePoints(value.Elem()) <= threshold {
+			continue
+		}
+		webgl, err := UnmarshalTrace([]byte(`{"type":"` + string(target) + `"}`))
+		if err != nil {
+			continue
+		}
+		copyAttributes(reflect.ValueOf(webgl).Elem(), value.Elem(), string(target))
+		fig.Data[i] = webgl
+		replaced++
+	}
+	return replaced
+}
+
+// tracePoints returns the number of points of a trace, the length of its longest coordinates or the number of cells of z
+func tracePoints(trace reflect.Value) int {
+	points := 0
+	for _, name := range []string{"X", "Y", "R", "Theta", "Z"} {
+		field := trace.FieldByName(name)
+		for field.Kind() == reflect.Interface && !field.IsNil() {
+			field = field.Elem()
+		}
+		if field.Kind() != reflect.Slice && field.Kind() != reflect.Array {
+			continue
+		}
+		n := field.Len()
+		if name == "Z" {
+			n = 0
+			for i := 0; i < field.Len(); i++ {
+				row := field.Index(i)
+				for row.Kind() == reflect.Interface && !row.IsNil() {
+					row = row.Elem()
+				}
+				if row.Kind() == reflect.Slice || row.Kind() == reflect.Array {
+					n += row.Len()
+				}
+			}
+		}
+		if n > points {
+			points = n
+		}
+	}
+	return points
+}
+
+// copyAttributes sets the fields of dst to the fields of src with the same JSON name, converting the enumerated and nested types.
+// schema is the path of dst in the attribute registry, used to drop the enumerated values it does not accept.
+func copyAttributes(dst, src reflect.Value, schema string) {
+	for i := 0; i < src.NumField(); i++ {
+		value := src.Field(i)
+		name := strings.Split(src.Type().Field(i).Tag.Get("json"), ",")[0]
+		if name == "" || name == "type" || value.IsZero() {
+			continue
+		}
+		field, ok := fieldByJSONName(dst, name)
+		info := attributes[schema+"."+name]
+		if !ok || !field.CanSet() {
+			continue
+		}
+
+		if value.Kind() == reflect.Interface && field.Kind() != reflect.Interface {
+			value = value.Elem()
+		}
+		switch {
+		case value.Kind() == reflect.Ptr && field.Kind() == reflect.Ptr && value.Elem().Kind() == reflect.Struct && field.Type().Elem().Kind() == reflect.Struct:
+			if value.Type() == field.Type() {
+				field.Set(value)
+				continue
+			}
+			if info == nil {
+				continue
+			}
+			field.Set(reflect.New(field.Type().Elem()))
+			copyAttributes(field.Elem(), value.Elem(), info.Path)
+		case value.Type().ConvertibleTo(field.Type()):
+			if info != nil && info.ValType == "enumerated" && !enumerated(jsonValue(value), info.Values) {
+				continue
+			}
+			field.Set(value.Convert(field.Type()))
+		}
+	}
+}
+
+// jsonValue returns the value as it is decoded from JSON, to compare it with the values of the registry
+func jsonValue(value reflect.Value) interface{} {
+	for value.Kind() == reflect.Interface && !value.IsNil() {
+		value = value.Elem()
+	}
+	switch value.Kind() {
+	case reflect.String:
+		return value.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(value.Int())
+	case reflect.Float32, reflect.Float64:
+		return value.Float()
+	case reflect.Bool:
+		return value.Bool()
+	}
+	return value.Interface()
+}
@@ -0,0 +1,54 @@
+package grob_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var _ = Describe("OptimizeWebGL", func() {
+
+	It("Should replace the traces above the threshold", func() {
+		x := []float64{1, 2, 3, 4}
+		fig := &grob.Fig{
+			Data: grob.Traces{
+				&grob.Scatter{
+					Type:       grob.TraceTypeScatter,
+					X:          x,
+					Y:          x,
+					Name:       "large",
+					Mode:       grob.ScatterModeLines,
+					Stackgroup: "one",
+					Line:       &grob.ScatterLine{Color: "red", Shape: grob.ScatterLineShapeSpline, Dash: "dot"},
+					Marker:     &grob.ScatterMarker{Size: 6},
+					Showlegend: grob.True,
+				},
+				&grob.Scatter{Type: grob.TraceTypeScatter, X: x[:2], Y: x[:2]},
+				&grob.Scatterpolar{Type: grob.TraceTypeScatterpolar, R: x, Theta: x},
+				&grob.Heatmap{Type: grob.TraceTypeHeatmap, Z: [][]float64{{1, 2}, {3, 4}}},
+				&grob.Bar{Type: grob.TraceTypeBar, X: x, Y: x},
+			},
+		}
+		Expect(fig.OptimizeWebGL(3)).To(Equal(3))
+
+		scatter, ok := fig.Data[0].(*grob.Scattergl)
+		Expect(ok).To(BeTrue())
+		Expect(scatter.Type).To(Equal(grob.TraceTypeScattergl))
+		Expect(scatter.X).To(Equal(x))
+		Expect(scatter.Name).To(Equal("large"))
+		Expect(scatter.Mode).To(Equal(grob.ScatterglModeLines))
+		Expect(scatter.Line.Color).To(Equal("red"))
+		Expect(scatter.Line.Dash).To(Equal(grob.ScatterglLineDashDot))
+		Expect(scatter.Line.Shape).To(BeEmpty(), "spline is not supported by scattergl")
+		Expect(scatter.Marker.Size).To(Equal(6.0))
+		Expect(scatter.Showlegend).To(Equal(grob.True))
+
+		Expect(fig.Data[1]).To(BeAssignableToTypeOf(&grob.Scatter{}))
+		Expect(fig.Data[2]).To(BeAssignableToTypeOf(&grob.Scatterpolargl{}))
+		Expect(fig.Data[3]).To(BeAssignableToTypeOf(&grob.Heatmapgl{}))
+		Expect(fig.Data[4]).To(BeAssignableToTypeOf(&grob.Bar{}))
+
+		Expect(fig.Validate()).To(Succeed())
+	})
+})
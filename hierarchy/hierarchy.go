@@ -0,0 +1,190 @@
+// Package hierarchy converts hierarchical data into the ids, labels, parents and values arrays of sunburst and treemap traces.
+//
+// The hierarchy can be a tree of Node, nested maps or "a/b/c" paths. The value of every node is its own value plus the values
+// of its children, so the traces use the total branch values. The icicle trace is not available in plotly.js 1.58.
+//
+//	h, err := hierarchy.FromPaths(map[string]float64{"europe/france": 67, "europe/spain": 47, "asia/japan": 126})
+//	fig := &grob.Fig{Data: grob.Traces{h.Sunburst()}}
+package hierarchy
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// Separator joins the labels of the path of a node into its id
+const Separator = "/"
+
+// Node is a node of a tree
+type Node struct {
+	Label string
+	// Value of the node, without the values of the children
+	Value    float64
+	Children []Node
+}
+
+// Hierarchy are the parallel arrays of the nodes, parents come before their children
+type Hierarchy struct {
+	// IDs are the paths of the nodes, the labels joined by Separator
+	IDs []string
+	// Labels are the names of the nodes
+	Labels []string
+	// Parents are the IDs of the parents, empty for the roots
+	Parents []string
+	// Values are the totals of the nodes, including their children
+	Values []float64
+}
+
+// FromTree returns the hierarchy of the roots and their descendants, in the order of the children
+func FromTree(roots ...Node) (Hierarchy, error) {
+	h := Hierarchy{}
+	seen := map[string]bool{}
+	for _, root := range roots {
+		_, err := h.add(root, "", seen)
+		if err != nil {
+			return Hierarchy{}, err
+		}
+	}
+	return h, nil
+}
+
+// add appends the node and its descendants and returns the total value of the node
+func (h *Hierarchy) add(node Node, parent string, seen map[string]bool) (float64, error) {
+	if node.Label == "" {
+		return 0, fmt.Errorf("node without label in %q", parent)
+	}
+	if node.Value < 0 {
+		return 0, fmt.Errorf("node %s has a negative value %g", node.Label, node.Value)
+	}
+	id := node.Label
+	if parent != "" {
+		id = parent + Separator + node.Label
+	}
+	if seen[id] {
+		return 0, fmt.Errorf("duplicated node %s", id)
+	}
+	seen[id] = true
+
+	i := len(h.IDs)
+	h.IDs = append(h.IDs, id)
+	h.Labels = append(h.Labels, node.Label)
+	h.Parents = append(h.Parents, parent)
+	h.Values = append(h.Values, 0)
+
+	total := node.Value
+	for _, child := range node.Children {
+		value, err := h.add(child, id, seen)
+		if err != nil {
+			return 0, err
+		}
+		total += value
+	}
+	h.Values[i] = total
+	return total, nil
+}
+
+// FromMap returns the hierarchy of nested maps. The values of the maps are numbers for the leaves
+// or maps of the same type for the branches. The nodes are sorted by label.
+//
+//	h, err := hierarchy.FromMap(map[string]interface{}{"europe": map[string]interface{}{"france": 67, "spain": 47}})
+func FromMap(m map[string]interface{}) (Hierarchy, error) {
+	roots, err := mapNodes(m, nil)
+	if err != nil {
+		return Hierarchy{}, err
+	}
+	return FromTree(roots...)
+}
+
+func mapNodes(m map[string]interface{}, path []string) ([]Node, error) {
+	labels := make([]string, 0, len(m))
+	for label := range m {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+
+	nodes := make([]Node, len(labels))
+	for i, label := range labels {
+		nodes[i].Label = label
+		switch v := m[label].(type) {
+		case map[string]interface{}:
+			children, err := mapNodes(v, append(path[:len(path):len(path)], label))
+			if err != nil {
+				return nil, err
+			}
+			nodes[i].Children = children
+		case float64:
+			nodes[i].Value = v
+		case float32:
+			nodes[i].Value = float64(v)
+		case int:
+			nodes[i].Value = float64(v)
+		case int64:
+			nodes[i].Value = float64(v)
+		default:
+			return nil, fmt.Errorf("value of %s must be a number or a map, not %T", strings.Join(append(path, label), Separator), v)
+		}
+	}
+	return nodes, nil
+}
+
+// FromPaths returns the hierarchy of paths of labels separated by Separator, such as europe/france, and their values.
+// The intermediate nodes are created as needed. The nodes are sorted by label.
+func FromPaths(paths map[string]float64) (Hierarchy, error) {
+	root := &Node{}
+	for path, value := range paths {
+		node := root
+		for _, label := range strings.Split(strings.Trim(path, Separator), Separator) {
+			if label == "" {
+				return Hierarchy{}, fmt.Errorf("path %q has an empty label", path)
+			}
+			child := -1
+			for i := range node.Children {
+				if node.Children[i].Label == label {
+					child = i
+				}
+			}
+			if child == -1 {
+				node.Children = append(node.Children, Node{Label: label})
+				child = len(node.Children) - 1
+			}
+			node = &node.Children[child]
+		}
+		node.Value += value
+	}
+	sortNodes(root.Children)
+	return FromTree(root.Children...)
+}
+
+func sortNodes(nodes []Node) {
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Label < nodes[j].Label })
+	for i := range nodes {
+		sortNodes(nodes[i].Children)
+	}
+}
+
+// Sunburst returns a sunburst trace of the hierarchy
+func (h Hierarchy) Sunburst() *grob.Sunburst {
+	return &grob.Sunburst{
+		Type:         grob.TraceTypeSunburst,
+		Ids:          h.IDs,
+		Labels:       h.Labels,
+		Parents:      h.Parents,
+		Values:       h.Values,
+		Branchvalues: grob.SunburstBranchvaluesTotal,
+	}
+}
+
+// Treemap returns a treemap trace of the hierarchy
+func (h Hierarchy) Treemap() *grob.Treemap {
+	return &grob.Treemap{
+		Type:         grob.TraceTypeTreemap,
+		Ids:          h.IDs,
+		Labels:       h.Labels,
+		Parents:      h.Parents,
+		Values:       h.Values,
+		Branchvalues: grob.TreemapBranchvaluesTotal,
+	}
+}
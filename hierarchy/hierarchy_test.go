@@ -0,0 +1,79 @@
+package hierarchy_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/hierarchy"
+)
+
+var _ = Describe("Hierarchy", func() {
+
+	expected := hierarchy.Hierarchy{
+		IDs:     []string{"asia", "asia/japan", "europe", "europe/france", "europe/spain"},
+		Labels:  []string{"asia", "japan", "europe", "france", "spain"},
+		Parents: []string{"", "asia", "", "europe", "europe"},
+		Values:  []float64{126, 126, 114, 67, 47},
+	}
+
+	It("Should aggregate the values of a tree", func() {
+		h, err := hierarchy.FromTree(
+			hierarchy.Node{Label: "asia", Children: []hierarchy.Node{{Label: "japan", Value: 126}}},
+			hierarchy.Node{Label: "europe", Children: []hierarchy.Node{{Label: "france", Value: 67}, {Label: "spain", Value: 47}}},
+		)
+		Expect(err).To(BeNil())
+		Expect(h).To(Equal(expected))
+	})
+
+	It("Should convert nested maps sorted by label", func() {
+		h, err := hierarchy.FromMap(map[string]interface{}{
+			"europe": map[string]interface{}{"spain": 47, "france": 67.0},
+			"asia":   map[string]interface{}{"japan": 126},
+		})
+		Expect(err).To(BeNil())
+		Expect(h).To(Equal(expected))
+	})
+
+	It("Should convert paths and create the intermediate nodes", func() {
+		h, err := hierarchy.FromPaths(map[string]float64{
+			"europe/spain":  47,
+			"/asia/japan/":  126,
+			"europe/france": 67,
+		})
+		Expect(err).To(BeNil())
+		Expect(h).To(Equal(expected))
+	})
+
+	It("Should add the own value of branches", func() {
+		h, err := hierarchy.FromPaths(map[string]float64{"a": 1, "a/b": 2})
+		Expect(err).To(BeNil())
+		Expect(h.Values).To(Equal([]float64{3, 2}))
+	})
+
+	It("Should reject invalid hierarchies", func() {
+		_, err := hierarchy.FromTree(hierarchy.Node{Label: "a"}, hierarchy.Node{Label: "a"})
+		Expect(err).ToNot(BeNil())
+		_, err = hierarchy.FromTree(hierarchy.Node{Label: "a", Children: []hierarchy.Node{{}}})
+		Expect(err).ToNot(BeNil())
+		_, err = hierarchy.FromTree(hierarchy.Node{Label: "a", Value: -1})
+		Expect(err).ToNot(BeNil())
+		_, err = hierarchy.FromMap(map[string]interface{}{"a": map[string]interface{}{"b": "c"}})
+		Expect(err).To(MatchError("value of a/b must be a number or a map, not string"))
+		_, err = hierarchy.FromPaths(map[string]float64{"a//b": 1})
+		Expect(err).ToNot(BeNil())
+	})
+
+	It("Should build sunburst and treemap traces", func() {
+		sunburst := expected.Sunburst()
+		Expect(sunburst.Type).To(Equal(grob.TraceTypeSunburst))
+		Expect(sunburst.Ids).To(Equal(expected.IDs))
+		Expect(sunburst.Parents).To(Equal(expected.Parents))
+		Expect(sunburst.Branchvalues).To(Equal(grob.SunburstBranchvaluesTotal))
+
+		treemap := expected.Treemap()
+		Expect(treemap.Type).To(Equal(grob.TraceTypeTreemap))
+		Expect(treemap.Values).To(Equal(expected.Values))
+		Expect(treemap.Branchvalues).To(Equal(grob.TreemapBranchvaluesTotal))
+	})
+})
@@ -0,0 +1,144 @@
+// Package hover styles the hover labels of a figure and switches it to unified hover.
+//
+//	err := hover.Label(fig, hover.Style{Font: "Roboto Mono", FontSize: 12, Bgcolor: "white", Namelength: -1})
+//	err = hover.Unified(fig)
+package hover
+
+import (
+	"fmt"
+	"reflect"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// Style is the style of the hover labels, the zero values keep the defaults of plotly.js
+type Style struct {
+	// Font is the font family of the labels
+	Font string
+	// FontSize is the font size of the labels
+	FontSize float64
+	// FontColor is the text color of the labels, it defaults to a contrast with the background
+	FontColor grob.Color
+	// Bgcolor is the background color of the labels, it defaults to the color of each trace
+	Bgcolor grob.Color
+	// Bordercolor is the border color of the labels
+	Bordercolor grob.Color
+	// Align is the alignment of the text of multiline labels: left, right or auto
+	Align grob.LayoutHoverlabelAlign
+	// Namelength is the number of characters of the trace names displayed, -1 displays the whole names
+	Namelength int
+}
+
+// Label styles the hover labels of all the traces. The style is set in the layout, which is the default of every trace,
+// and in the traces that have their own hover label so they do not override it.
+func Label(fig *grob.Fig, style Style) error {
+	attributes := style.attributes()
+	for _, attribute := range attributes {
+		err := fig.UpdateLayout(grob.SetLayout("hoverlabel."+attribute.path, attribute.value))
+		if err != nil {
+			return err
+		}
+	}
+	for i, trace := range fig.Data {
+		if !hasHoverlabel(trace) {
+			continue
+		}
+		for _, attribute := range attributes {
+			err := grob.SetTrace("hoverlabel."+attribute.path, attribute.value)(trace)
+			if err != nil {
+				return fmt.Errorf("cannot style the hover label of trace %d, %w", i, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Unified displays a single hover label with the values of all the traces at the x coordinate of the cursor,
+// and a spike line across the plot at that coordinate on every x axis used by the traces.
+func Unified(fig *grob.Fig) error {
+	err := fig.UpdateLayout(grob.SetLayout("hovermode", grob.LayoutHovermodeXUnified))
+	if err != nil {
+		return err
+	}
+	for _, axis := range xAxes(fig) {
+		err := fig.UpdateLayout(
+			grob.SetLayout(axis+".showspikes", true),
+			grob.SetLayout(axis+".spikemode", grob.LayoutXaxisSpikemodeAcross),
+			grob.SetLayout(axis+".spikesnap", grob.LayoutXaxisSpikesnapCursor),
+			grob.SetLayout(axis+".spikedash", "dot"),
+			grob.SetLayout(axis+".spikethickness", 1),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type attribute struct {
+	path  string
+	value interface{}
+}
+
+// attributes are the hoverlabel attributes set by the style
+func (s Style) attributes() []attribute {
+	attributes := []attribute{}
+	if s.Font != "" {
+		attributes = append(attributes, attribute{"font.family", s.Font})
+	}
+	if s.FontSize != 0 {
+		attributes = append(attributes, attribute{"font.size", s.FontSize})
+	}
+	if s.FontColor != nil {
+		attributes = append(attributes, attribute{"font.color", s.FontColor})
+	}
+	if s.Bgcolor != nil {
+		attributes = append(attributes, attribute{"bgcolor", s.Bgcolor})
+	}
+	if s.Bordercolor != nil {
+		attributes = append(attributes, attribute{"bordercolor", s.Bordercolor})
+	}
+	if s.Align != "" {
+		attributes = append(attributes, attribute{"align", string(s.Align)})
+	}
+	if s.Namelength != 0 {
+		attributes = append(attributes, attribute{"namelength", s.Namelength})
+	}
+	return attributes
+}
+
+// hasHoverlabel reports if the trace has its own hover label
+func hasHoverlabel(trace grob.Trace) bool {
+	value := reflect.ValueOf(trace)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return false
+	}
+	field := value.Elem().FieldByName("Hoverlabel")
+	return field.IsValid() && field.Kind() == reflect.Ptr && !field.IsNil()
+}
+
+// xAxes returns the layout names of the x axes used by the traces, like xaxis2. The first x axis is always included
+func xAxes(fig *grob.Fig) []string {
+	names := []string{"xaxis"}
+	seen := map[string]bool{"xaxis": true}
+	for _, trace := range fig.Data {
+		value := reflect.ValueOf(trace)
+		if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+			continue
+		}
+		field := value.Elem().FieldByName("Xaxis")
+		if !field.IsValid() || field.Kind() != reflect.Interface || field.IsNil() {
+			continue
+		}
+		ref := fmt.Sprint(field.Interface())
+		if len(ref) < 2 || ref == "x1" {
+			continue
+		}
+		name := "xaxis" + ref[1:]
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	return names
+}
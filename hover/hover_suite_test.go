@@ -0,0 +1,13 @@
+package hover_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestHover(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Hover Suite")
+}
@@ -0,0 +1,52 @@
+package hover_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/hover"
+)
+
+var _ = Describe("Hover", func() {
+
+	It("Should style the hover labels of all the traces", func() {
+		fig := &grob.Fig{}
+		own := &grob.Scatter{Hoverlabel: &grob.ScatterHoverlabel{Bgcolor: "red", Bordercolor: "black"}}
+		fig.AddTraces(own, &grob.Bar{})
+
+		err := hover.Label(fig, hover.Style{
+			Font:       "Roboto Mono",
+			FontSize:   12,
+			Bgcolor:    "white",
+			Align:      grob.LayoutHoverlabelAlignLeft,
+			Namelength: -1,
+		})
+		Expect(err).To(BeNil())
+
+		data, err := json.Marshal(fig.Layout.Hoverlabel)
+		Expect(err).To(BeNil())
+		Expect(data).To(MatchJSON(`{"align": "left", "bgcolor": "white", "font": {"family": "Roboto Mono", "size": 12}, "namelength": -1}`))
+
+		data, err = json.Marshal(own.Hoverlabel)
+		Expect(err).To(BeNil())
+		Expect(data).To(MatchJSON(`{"align": "left", "bgcolor": "white", "bordercolor": "black", "font": {"family": "Roboto Mono", "size": 12}, "namelength": -1}`))
+		Expect(fig.Data[1].(*grob.Bar).Hoverlabel).To(BeNil())
+	})
+
+	It("Should switch to unified hover with spikes", func() {
+		fig := &grob.Fig{}
+		fig.AddTraces(&grob.Scatter{}, &grob.Scatter{Xaxis: "x2", Yaxis: "y2"}, &grob.Pie{})
+
+		Expect(hover.Unified(fig)).To(Succeed())
+		Expect(fig.Layout.Hovermode).To(Equal(grob.LayoutHovermodeXUnified))
+		for _, axis := range []*grob.LayoutXaxis{fig.Layout.Xaxis, fig.Layout.XAxis2} {
+			Expect(axis.Showspikes).To(Equal(grob.True))
+			Expect(axis.Spikemode).To(Equal(grob.LayoutXaxisSpikemodeAcross))
+			Expect(axis.Spikesnap).To(Equal(grob.LayoutXaxisSpikesnapCursor))
+		}
+		Expect(fig.Layout.XAxis3).To(BeNil())
+	})
+})
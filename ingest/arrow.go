@@ -0,0 +1,197 @@
+package ingest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/apache/arrow/go/v9/arrow"
+	"github.com/apache/arrow/go/v9/arrow/array"
+	"github.com/apache/arrow/go/v9/arrow/ipc"
+	"github.com/apache/arrow/go/v9/arrow/memory"
+	"github.com/apache/arrow/go/v9/parquet"
+	"github.com/apache/arrow/go/v9/parquet/pqarrow"
+
+	"github.com/MetalBlueberry/go-plotly/dataset"
+)
+
+// FromArrowIPC reads an Arrow IPC stream, the format of pyarrow.ipc.new_stream and of Arrow Flight.
+// The record batches are concatenated and dictionary encoded columns, like pandas categoricals, are decoded to their values.
+func FromArrowIPC(r io.Reader, opt ...Options) (*Table, error) {
+	opts := computeOptions(Options{}, opt...)
+	reader, err := ipc.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read arrow stream, %w", err)
+	}
+	defer reader.Release()
+
+	fields := reader.Schema().Fields()
+	values := make([][]interface{}, len(fields))
+	for reader.Next() {
+		record := reader.Record()
+		for i, field := range fields {
+			values[i], err = appendValues(values[i], record.Column(i))
+			if err != nil {
+				return nil, fmt.Errorf("cannot read column %s, %w", field.Name, err)
+			}
+		}
+	}
+	if reader.Err() != nil {
+		return nil, fmt.Errorf("cannot read arrow stream, %w", reader.Err())
+	}
+	return arrowTable(fields, values, opts)
+}
+
+// ReaderAtSeeker is a file that can be read at any position, such as an os.File or a bytes.Reader
+type ReaderAtSeeker interface {
+	io.ReaderAt
+	io.Seeker
+}
+
+// FromParquet reads a Parquet file. Dictionary encoded columns are decoded to their values.
+func FromParquet(r ReaderAtSeeker, opt ...Options) (*Table, error) {
+	opts := computeOptions(Options{}, opt...)
+	mem := memory.NewGoAllocator()
+	table, err := pqarrow.ReadTable(context.Background(), r, parquet.NewReaderProperties(mem), pqarrow.ArrowReadProperties{}, mem)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read parquet file, %w", err)
+	}
+	defer table.Release()
+
+	fields := table.Schema().Fields()
+	values := make([][]interface{}, len(fields))
+	for i, field := range fields {
+		for _, chunk := range table.Column(i).Data().Chunks() {
+			values[i], err = appendValues(values[i], chunk)
+			if err != nil {
+				return nil, fmt.Errorf("cannot read column %s, %w", field.Name, err)
+			}
+		}
+	}
+	return arrowTable(fields, values, opts)
+}
+
+// arrowTable returns the table of the values of every field
+func arrowTable(fields []arrow.Field, values [][]interface{}, opts Options) (*Table, error) {
+	names := make([]string, len(fields))
+	columns := dataset.Columns{}
+	numeric := map[string]bool{}
+	for i, field := range fields {
+		names[i] = field.Name
+		columns[field.Name], numeric[field.Name] = typedColumn(values[i], field.Type)
+	}
+	return newTable(names, columns, numeric, opts)
+}
+
+// appendValues appends the values of the array as float64, time.Time, bool or string, nil for nulls
+func appendValues(values []interface{}, column arrow.Array) ([]interface{}, error) {
+	if dictionary, ok := column.(*array.Dictionary); ok {
+		categories, err := appendValues(nil, dictionary.Dictionary())
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < dictionary.Len(); i++ {
+			if dictionary.IsNull(i) {
+				values = append(values, nil)
+			} else {
+				values = append(values, categories[dictionary.GetValueIndex(i)])
+			}
+		}
+		return values, nil
+	}
+
+	var value func(i int) interface{}
+	switch column := column.(type) {
+	case *array.Float64:
+		value = func(i int) interface{} { return column.Value(i) }
+	case *array.Float32:
+		value = func(i int) interface{} { return float64(column.Value(i)) }
+	case *array.Int64:
+		value = func(i int) interface{} { return float64(column.Value(i)) }
+	case *array.Int32:
+		value = func(i int) interface{} { return float64(column.Value(i)) }
+	case *array.Int16:
+		value = func(i int) interface{} { return float64(column.Value(i)) }
+	case *array.Int8:
+		value = func(i int) interface{} { return float64(column.Value(i)) }
+	case *array.Uint64:
+		value = func(i int) interface{} { return float64(column.Value(i)) }
+	case *array.Uint32:
+		value = func(i int) interface{} { return float64(column.Value(i)) }
+	case *array.Uint16:
+		value = func(i int) interface{} { return float64(column.Value(i)) }
+	case *array.Uint8:
+		value = func(i int) interface{} { return float64(column.Value(i)) }
+	case *array.Boolean:
+		value = func(i int) interface{} { return column.Value(i) }
+	case *array.String:
+		value = func(i int) interface{} { return column.Value(i) }
+	case *array.Timestamp:
+		timestampType := column.DataType().(*arrow.TimestampType)
+		location := time.UTC
+		if timestampType.TimeZone != "" {
+			loaded, err := time.LoadLocation(timestampType.TimeZone)
+			if err != nil {
+				return nil, fmt.Errorf("cannot load time zone, %w", err)
+			}
+			location = loaded
+		}
+		value = func(i int) interface{} { return column.Value(i).ToTime(timestampType.Unit).In(location) }
+	case *array.Date32:
+		value = func(i int) interface{} { return column.Value(i).ToTime() }
+	case *array.Date64:
+		value = func(i int) interface{} { return column.Value(i).ToTime() }
+	default:
+		return nil, fmt.Errorf("type %s is not supported", column.DataType())
+	}
+	for i := 0; i < column.Len(); i++ {
+		if column.IsNull(i) {
+			values = append(values, nil)
+		} else {
+			values = append(values, value(i))
+		}
+	}
+	return values, nil
+}
+
+// typedColumn returns the values in a slice of their type if there are no nulls. It reports if the column is numeric.
+func typedColumn(values []interface{}, dataType arrow.DataType) (interface{}, bool) {
+	if dictionary, ok := dataType.(*arrow.DictionaryType); ok {
+		dataType = dictionary.ValueType
+	}
+	numeric := arrow.IsInteger(dataType.ID()) || dataType.ID() == arrow.FLOAT32 || dataType.ID() == arrow.FLOAT64
+	for _, value := range values {
+		if value == nil {
+			return values, numeric
+		}
+	}
+
+	switch {
+	case numeric:
+		column := make([]float64, len(values))
+		for i, value := range values {
+			column[i] = value.(float64)
+		}
+		return column, true
+	case dataType.ID() == arrow.BOOL:
+		column := make([]bool, len(values))
+		for i, value := range values {
+			column[i] = value.(bool)
+		}
+		return column, false
+	case dataType.ID() == arrow.STRING:
+		column := make([]string, len(values))
+		for i, value := range values {
+			column[i] = value.(string)
+		}
+		return column, false
+	case dataType.ID() == arrow.TIMESTAMP || dataType.ID() == arrow.DATE32 || dataType.ID() == arrow.DATE64:
+		column := make([]time.Time, len(values))
+		for i, value := range values {
+			column[i] = value.(time.Time)
+		}
+		return column, false
+	}
+	return values, false
+}
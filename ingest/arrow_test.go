@@ -0,0 +1,104 @@
+package ingest_test
+
+import (
+	"bytes"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/apache/arrow/go/v9/arrow"
+	"github.com/apache/arrow/go/v9/arrow/array"
+	"github.com/apache/arrow/go/v9/arrow/ipc"
+	"github.com/apache/arrow/go/v9/arrow/memory"
+	"github.com/apache/arrow/go/v9/parquet"
+	"github.com/apache/arrow/go/v9/parquet/pqarrow"
+
+	"github.com/MetalBlueberry/go-plotly/ingest"
+)
+
+// sensorRecord returns a record with a timestamp, a dictionary encoded site and a nullable value
+func sensorRecord(mem memory.Allocator) arrow.Record {
+	siteType := &arrow.DictionaryType{IndexType: arrow.PrimitiveTypes.Int8, ValueType: arrow.BinaryTypes.String}
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "time", Type: &arrow.TimestampType{Unit: arrow.Second}},
+		{Name: "site", Type: siteType},
+		{Name: "value", Type: arrow.PrimitiveTypes.Float32, Nullable: true},
+	}, nil)
+
+	times := array.NewTimestampBuilder(mem, &arrow.TimestampType{Unit: arrow.Second})
+	defer times.Release()
+	times.AppendValues([]arrow.Timestamp{1609459200, 1609459260, 1609459320}, nil)
+	sites := array.NewDictionaryBuilder(mem, siteType).(*array.BinaryDictionaryBuilder)
+	defer sites.Release()
+	for _, site := range []string{"north", "south", "north"} {
+		Expect(sites.AppendString(site)).To(Succeed())
+	}
+	values := array.NewFloat32Builder(mem)
+	defer values.Release()
+	values.AppendValues([]float32{1.5, 0, 3}, []bool{true, false, true})
+
+	return array.NewRecord(schema, []arrow.Array{times.NewArray(), sites.NewArray(), values.NewArray()}, 3)
+}
+
+var _ = Describe("FromArrowIPC", func() {
+
+	It("Should read the record batches with categoricals", func() {
+		mem := memory.NewGoAllocator()
+		record := sensorRecord(mem)
+		defer record.Release()
+		buf := &bytes.Buffer{}
+		writer := ipc.NewWriter(buf, ipc.WithSchema(record.Schema()), ipc.WithAllocator(mem))
+		Expect(writer.Write(record)).To(Succeed())
+		Expect(writer.Write(record)).To(Succeed())
+		Expect(writer.Close()).To(Succeed())
+
+		table, err := ingest.FromArrowIPC(buf, ingest.Options{X: "time"})
+		Expect(err).To(BeNil())
+		Expect(table.Names).To(Equal([]string{"time", "site", "value"}))
+		Expect(table.Columns["site"]).To(Equal([]string{"north", "south", "north", "north", "south", "north"}))
+		Expect(table.Columns["value"]).To(Equal([]interface{}{1.5, nil, 3.0, 1.5, nil, 3.0}))
+		Expect(table.Columns["time"]).To(HaveLen(6))
+		Expect(table.Columns["time"].([]time.Time)[1]).To(Equal(time.Date(2021, 1, 1, 0, 1, 0, 0, time.UTC)))
+		Expect(table.Y).To(Equal([]string{"value"}))
+	})
+
+	It("Should fail with streams that are not arrow", func() {
+		_, err := ingest.FromArrowIPC(bytes.NewBufferString("time,value\n"))
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("FromParquet", func() {
+
+	It("Should read the columns", func() {
+		mem := memory.NewGoAllocator()
+		schema := arrow.NewSchema([]arrow.Field{
+			{Name: "site", Type: arrow.BinaryTypes.String},
+			{Name: "count", Type: arrow.PrimitiveTypes.Int64},
+			{Name: "ok", Type: arrow.FixedWidthTypes.Boolean},
+		}, nil)
+		sites := array.NewStringBuilder(mem)
+		sites.AppendValues([]string{"north", "south", "north"}, nil)
+		counts := array.NewInt64Builder(mem)
+		counts.AppendValues([]int64{1, 2, 3}, nil)
+		oks := array.NewBooleanBuilder(mem)
+		oks.AppendValues([]bool{true, false, true}, nil)
+		record := array.NewRecord(schema, []arrow.Array{sites.NewArray(), counts.NewArray(), oks.NewArray()}, 3)
+		defer record.Release()
+		arrowTable := array.NewTableFromRecords(schema, []arrow.Record{record})
+		defer arrowTable.Release()
+
+		buf := &bytes.Buffer{}
+		// strings are dictionary encoded by default
+		err := pqarrow.WriteTable(arrowTable, buf, 2, parquet.NewWriterProperties(), pqarrow.DefaultWriterProps())
+		Expect(err).To(BeNil())
+
+		table, err := ingest.FromParquet(bytes.NewReader(buf.Bytes()), ingest.Options{X: "site"})
+		Expect(err).To(BeNil())
+		Expect(table.Columns["site"]).To(Equal([]string{"north", "south", "north"}))
+		Expect(table.Columns["count"]).To(Equal([]float64{1, 2, 3}))
+		Expect(table.Columns["ok"]).To(Equal([]bool{true, false, true}))
+		Expect(table.Y).To(Equal([]string{"count"}))
+	})
+})
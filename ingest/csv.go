@@ -0,0 +1,302 @@
+// Package ingest reads data files into traces and figures, for quick plots without parsing code.
+//
+//	table, err := ingest.FromCSV(file, ingest.Options{X: "date", Y: []string{"open", "close"}})
+//	fig := table.Figure(express.Options{Title: "Prices"})
+//
+// The types of the columns of CSV and JSON files are inferred from their values: numbers, dates or text.
+// Arrow IPC streams and Parquet files keep the types of their schema, SQL rows the types of their driver
+// and Excel sheets the dates of their cell formats.
+package ingest
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MetalBlueberry/go-plotly/dataset"
+	"github.com/MetalBlueberry/go-plotly/express"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// DefaultTimeLayouts are the layouts of the dates recognized by default, the dates without time zone are in UTC
+var DefaultTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+	"2006/01/02",
+}
+
+// Options configure the reading of the files
+type Options struct {
+	// X is the column of the x values, if empty the row index is used by plotly
+	X string
+	// Y are the columns plotted against x, a trace each. Defaults to every numeric column but X
+	Y []string
+	// Comma is the field delimiter of CSV files, defaults to ','
+	Comma rune
+	// TimeLayouts are the layouts of the dates of CSV files, a column is a date if all its values have the same layout. Defaults to DefaultTimeLayouts
+	TimeLayouts []string
+	// Sheet is the sheet of Excel files, defaults to the first one
+	Sheet string
+	// Range are the cells of Excel files with the header in the first row, like B2:E100. Defaults to the whole sheet
+	Range string
+}
+
+func computeOptions(def Options, opt ...Options) Options {
+	if len(opt) == 1 {
+		opts := opt[0]
+		if opts.X != "" {
+			def.X = opts.X
+		}
+		if opts.Y != nil {
+			def.Y = opts.Y
+		}
+		if opts.Comma != 0 {
+			def.Comma = opts.Comma
+		}
+		if opts.TimeLayouts != nil {
+			def.TimeLayouts = opts.TimeLayouts
+		}
+		if opts.Sheet != "" {
+			def.Sheet = opts.Sheet
+		}
+		if opts.Range != "" {
+			def.Range = opts.Range
+		}
+	}
+	return def
+}
+
+// Table are the columns of a file and the columns selected to plot them. It implements dataset.Table,
+// so the columns can also be used with the dataset package, for example to split the rows by category.
+type Table struct {
+	// Names are the columns in the order of the header
+	Names []string
+	// Columns are the values by column name: []float64 for numbers, []time.Time for dates, []bool for the booleans of Arrow and Parquet files
+	// and []string for the rest. Columns with missing values are []interface{} with nil for the missing values, plotly displays them as gaps
+	Columns dataset.Columns
+	// X is the column of the x values, empty for the row index
+	X string
+	// Y are the columns plotted against x
+	Y []string
+}
+
+// FromCSV reads a CSV file whose first row is the header with the names of the columns.
+// It fails if the X or Y columns of the options are not in the header.
+func FromCSV(r io.Reader, opt ...Options) (*Table, error) {
+	opts := computeOptions(Options{
+		Comma:       ',',
+		TimeLayouts: DefaultTimeLayouts,
+	}, opt...)
+
+	reader := csv.NewReader(r)
+	reader.Comma = opts.Comma
+	reader.ReuseRecord = true
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, errors.New("cannot read csv, the header is missing")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read csv, %w", err)
+	}
+	names := make([]string, len(header))
+	for i, name := range header {
+		names[i] = strings.TrimSpace(name)
+	}
+
+	cells := make([][]string, len(names))
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cannot read csv, %w", err)
+		}
+		for i, value := range record {
+			cells[i] = append(cells[i], strings.TrimSpace(value))
+		}
+	}
+
+	columns := dataset.Columns{}
+	numeric := map[string]bool{}
+	for i, name := range names {
+		columns[name], numeric[name] = parseColumn(cells[i], opts.TimeLayouts)
+	}
+	return newTable(names, columns, numeric, opts)
+}
+
+// newTable returns the table with the columns selected by the options.
+// The Y columns default to the numeric columns, in the order of the names, but X.
+func newTable(names []string, columns dataset.Columns, numeric map[string]bool, opts Options) (*Table, error) {
+	table := &Table{
+		Names:   names,
+		Columns: columns,
+		X:       opts.X,
+		Y:       opts.Y,
+	}
+	if table.X != "" {
+		if _, ok := table.Columns[table.X]; !ok {
+			return nil, fmt.Errorf("column %s not found", table.X)
+		}
+	}
+	for _, name := range table.Y {
+		if _, ok := table.Columns[name]; !ok {
+			return nil, fmt.Errorf("column %s not found", name)
+		}
+	}
+	if table.Y == nil {
+		table.Y = []string{}
+		for _, name := range names {
+			if numeric[name] && name != table.X {
+				table.Y = append(table.Y, name)
+			}
+		}
+	}
+	return table, nil
+}
+
+// parseColumn returns the values as numbers if they all are numbers, as dates if they all have the same layout, or as text.
+// Empty values are missing values. It reports if the column is numeric.
+func parseColumn(values []string, layouts []string) (interface{}, bool) {
+	missing := 0
+	first := ""
+	for _, value := range values {
+		if value == "" {
+			missing++
+		} else if first == "" {
+			first = value
+		}
+	}
+	if first == "" {
+		return values, false
+	}
+
+	if numbers, ok := parseNumbers(values, missing > 0); ok {
+		return numbers, true
+	}
+	for _, layout := range layouts {
+		if _, err := time.Parse(layout, first); err != nil {
+			continue
+		}
+		if dates, ok := parseDates(values, layout, missing > 0); ok {
+			return dates, false
+		}
+	}
+	return values, false
+}
+
+func parseNumbers(values []string, missing bool) (interface{}, bool) {
+	numbers := make([]float64, len(values))
+	for i, value := range values {
+		if value == "" {
+			continue
+		}
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, false
+		}
+		numbers[i] = number
+	}
+	if !missing {
+		return numbers, true
+	}
+	column := make([]interface{}, len(values))
+	for i, value := range values {
+		if value != "" {
+			column[i] = numbers[i]
+		}
+	}
+	return column, true
+}
+
+func parseDates(values []string, layout string, missing bool) (interface{}, bool) {
+	dates := make([]time.Time, len(values))
+	for i, value := range values {
+		if value == "" {
+			continue
+		}
+		date, err := time.Parse(layout, value)
+		if err != nil {
+			return nil, false
+		}
+		dates[i] = date
+	}
+	if !missing {
+		return dates, true
+	}
+	column := make([]interface{}, len(values))
+	for i, value := range values {
+		if value != "" {
+			column[i] = dates[i]
+		}
+	}
+	return column, true
+}
+
+// Column implements dataset.Table
+func (t *Table) Column(name string) ([]interface{}, error) {
+	return t.Columns.Column(name)
+}
+
+// Traces returns a line per Y column against the X column, named after the column
+func (t *Table) Traces() grob.Traces {
+	traces := make(grob.Traces, len(t.Y))
+	for i, name := range t.Y {
+		trace := &grob.Scatter{
+			Type: grob.TraceTypeScatter,
+			Mode: grob.ScatterModeLines,
+			Name: name,
+			Y:    t.Columns[name],
+		}
+		if t.X != "" {
+			trace.X = t.Columns[t.X]
+		}
+		traces[i] = trace
+	}
+	return traces
+}
+
+// Figure plots the Y columns against the X column with express.Line. The axis titles default to the names of the columns
+// and the Y columns are named in the legend if there are several.
+func (t *Table) Figure(opt ...express.Options) *grob.Fig {
+	opts := express.Options{}
+	if len(opt) == 1 {
+		opts = opt[0]
+	}
+	if opts.XTitle == "" && t.X != "" {
+		opts.XTitle = t.X
+	}
+	if opts.YTitle == "" && len(t.Y) == 1 {
+		opts.YTitle = t.Y[0]
+	}
+
+	var x interface{}
+	if t.X != "" {
+		x = t.Columns[t.X]
+	}
+	if len(t.Y) <= 1 {
+		var y interface{}
+		if len(t.Y) == 1 {
+			y = t.Columns[t.Y[0]]
+		}
+		return express.Line(x, y, opts)
+	}
+
+	opts.Name = t.Y[0]
+	fig := express.Line(x, t.Columns[t.Y[0]], opts)
+	first := fig.Data[0].(*grob.Scatter)
+	for _, trace := range t.Traces()[1:] {
+		trace := trace.(*grob.Scatter)
+		trace.Hovertemplate = first.Hovertemplate
+		fig.AddTraces(trace)
+	}
+	fig.Layout.Showlegend = grob.True
+	return fig
+}
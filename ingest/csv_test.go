@@ -0,0 +1,97 @@
+package ingest_test
+
+import (
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/MetalBlueberry/go-plotly/dataset"
+	"github.com/MetalBlueberry/go-plotly/express"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/ingest"
+)
+
+const prices = `date, ticker, open, close
+2021-01-04,AAPL,133.52,129.41
+2021-01-05,AAPL,128.89,
+2021-01-04,MSFT,222.53,217.69
+`
+
+var _ = Describe("FromCSV", func() {
+
+	It("Should infer the types of the columns", func() {
+		table, err := ingest.FromCSV(strings.NewReader(prices))
+		Expect(err).To(BeNil())
+		Expect(table.Names).To(Equal([]string{"date", "ticker", "open", "close"}))
+		Expect(table.Columns["date"]).To(Equal([]time.Time{
+			time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC),
+			time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC),
+			time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC),
+		}))
+		Expect(table.Columns["ticker"]).To(Equal([]string{"AAPL", "AAPL", "MSFT"}))
+		Expect(table.Columns["open"]).To(Equal([]float64{133.52, 128.89, 222.53}))
+		Expect(table.Columns["close"]).To(Equal([]interface{}{129.41, nil, 217.69}))
+		Expect(table.Y).To(Equal([]string{"open", "close"}))
+	})
+
+	It("Should select the columns by name", func() {
+		table, err := ingest.FromCSV(strings.NewReader(prices), ingest.Options{X: "date", Y: []string{"close"}})
+		Expect(err).To(BeNil())
+		traces := table.Traces()
+		Expect(traces).To(HaveLen(1))
+		trace := traces[0].(*grob.Scatter)
+		Expect(trace.Name).To(Equal(grob.String("close")))
+		Expect(trace.X).To(Equal(table.Columns["date"]))
+		Expect(trace.Y).To(Equal(table.Columns["close"]))
+
+		_, err = ingest.FromCSV(strings.NewReader(prices), ingest.Options{Y: []string{"volume"}})
+		Expect(err).To(MatchError("column volume not found"))
+	})
+
+	It("Should read other delimiters and date layouts", func() {
+		table, err := ingest.FromCSV(strings.NewReader("day;value\n04.01.2021;1\n05.01.2021;2\n"), ingest.Options{
+			X:           "day",
+			Comma:       ';',
+			TimeLayouts: []string{"02.01.2006"},
+		})
+		Expect(err).To(BeNil())
+		Expect(table.Columns["day"]).To(Equal([]time.Time{
+			time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC),
+			time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC),
+		}))
+		Expect(table.Y).To(Equal([]string{"value"}))
+	})
+
+	It("Should fail without header or with rows of different lengths", func() {
+		_, err := ingest.FromCSV(strings.NewReader(""))
+		Expect(err).To(HaveOccurred())
+		_, err = ingest.FromCSV(strings.NewReader("a,b\n1,2\n3\n"))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Should build an express figure with the column names", func() {
+		table, err := ingest.FromCSV(strings.NewReader(prices), ingest.Options{X: "date", Y: []string{"open"}})
+		Expect(err).To(BeNil())
+		fig := table.Figure(express.Options{Title: "Prices"})
+		Expect(fig.Data).To(HaveLen(1))
+		Expect(fig.Layout.Title.Text).To(Equal(grob.String("Prices")))
+		Expect(fig.Layout.Xaxis.Title.Text).To(Equal(grob.String("date")))
+		Expect(fig.Layout.Yaxis.Title.Text).To(Equal(grob.String("open")))
+
+		table.Y = []string{"open", "close"}
+		fig = table.Figure()
+		Expect(fig.Data).To(HaveLen(2))
+		Expect(fig.Data[1].(*grob.Scatter).Name).To(Equal(grob.String("close")))
+		Expect(fig.Layout.Showlegend).To(Equal(grob.True))
+	})
+
+	It("Should be a dataset table", func() {
+		table, err := ingest.FromCSV(strings.NewReader(prices))
+		Expect(err).To(BeNil())
+		traces, err := dataset.Scatter(table, dataset.Mapping{X: "date", Y: "open", Color: "ticker"})
+		Expect(err).To(BeNil())
+		Expect(traces).To(HaveLen(2))
+	})
+})
@@ -0,0 +1,86 @@
+package ingest
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/influxdata/influxdb-client-go/v2/api"
+)
+
+// influxColumns are the columns of the flux records that are not tags
+var influxColumns = map[string]bool{
+	"result":       true,
+	"table":        true,
+	"_start":       true,
+	"_stop":        true,
+	"_time":        true,
+	"_value":       true,
+	"_field":       true,
+	"_measurement": true,
+}
+
+// influxSource reads the records of a flux query one at a time
+type influxSource struct {
+	result *api.QueryTableResult
+	fields map[string]float64
+}
+
+// NewInfluxDBSource returns a TimeSeriesSource that reads the result of a flux query of the InfluxDB client. Every table of the result,
+// which is a field of a measurement with a set of tags, is a series named after the field and its tags, like usage_idle{cpu="cpu0"}.
+// The records with values that are not numbers are skipped.
+//
+//	result, err := client.QueryAPI(org).Query(ctx, `from(bucket: "metrics") |> range(start: -1h) |> filter(fn: (r) => r._measurement == "cpu")`)
+//	fig, err := ingest.FromTimeSeries(ingest.NewInfluxDBSource(result))
+func NewInfluxDBSource(result *api.QueryTableResult) TimeSeriesSource {
+	return &influxSource{
+		result: result,
+		fields: map[string]float64{},
+	}
+}
+
+// Next implements TimeSeriesSource
+func (s *influxSource) Next() (time.Time, map[string]float64, bool) {
+	for s.result.Next() {
+		record := s.result.Record()
+		value, ok := number(record.Value())
+		if !ok {
+			continue
+		}
+		tags := []string{}
+		for name, tag := range record.Values() {
+			if tag, ok := tag.(string); ok && !influxColumns[name] {
+				tags = append(tags, name+"="+`"`+tag+`"`)
+			}
+		}
+		name := record.Field()
+		if len(tags) > 0 {
+			sort.Strings(tags)
+			name += "{" + strings.Join(tags, ", ") + "}"
+		}
+		for field := range s.fields {
+			delete(s.fields, field)
+		}
+		s.fields[name] = value
+		return record.Time(), s.fields, true
+	}
+	return time.Time{}, nil, false
+}
+
+// Err returns the error of the query, if any
+func (s *influxSource) Err() error {
+	return s.result.Err()
+}
+
+// number converts the values of the flux records to float64
+func number(value interface{}) (float64, bool) {
+	switch value := value.(type) {
+	case float64:
+		return value, true
+	case int64:
+		return float64(value), true
+	case uint64:
+		return float64(value), true
+	}
+	return 0, false
+}
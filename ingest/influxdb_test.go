@@ -0,0 +1,69 @@
+package ingest_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	influxdb2 "github.com/influxdata/influxdb-client-go/v2"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/ingest"
+)
+
+// fluxResponse is the annotated CSV of a query of the cpu usage of two cpus and a text field
+const fluxResponse = `#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,double,string,string,string
+#group,false,false,true,true,false,false,true,true,true
+#default,_result,,,,,,,,
+,result,table,_start,_stop,_time,_value,_field,_measurement,cpu
+,,0,2021-01-01T00:00:00Z,2021-01-01T01:00:00Z,2021-01-01T00:00:00Z,90.5,usage_idle,cpu,cpu1
+,,0,2021-01-01T00:00:00Z,2021-01-01T01:00:00Z,2021-01-01T00:01:00Z,91.5,usage_idle,cpu,cpu1
+,,1,2021-01-01T00:00:00Z,2021-01-01T01:00:00Z,2021-01-01T00:00:00Z,80,usage_idle,cpu,cpu0
+,,1,2021-01-01T00:00:00Z,2021-01-01T01:00:00Z,2021-01-01T00:01:00Z,85,usage_idle,cpu,cpu0
+
+#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,string,string,string,string
+#group,false,false,true,true,false,false,true,true,true
+#default,_result,,,,,,,,
+,result,table,_start,_stop,_time,_value,_field,_measurement,cpu
+,,2,2021-01-01T00:00:00Z,2021-01-01T01:00:00Z,2021-01-01T00:00:00Z,ok,status,cpu,cpu0
+
+`
+
+var _ = Describe("NewInfluxDBSource", func() {
+
+	var server *httptest.Server
+
+	BeforeEach(func() {
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/csv")
+			w.Write([]byte(fluxResponse))
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("Should plot a line per field and tags of the query", func() {
+		client := influxdb2.NewClient(server.URL, "token")
+		defer client.Close()
+		result, err := client.QueryAPI("org").Query(context.Background(), `from(bucket: "metrics") |> range(start: -1h)`)
+		Expect(err).To(BeNil())
+
+		fig, err := ingest.FromTimeSeries(ingest.NewInfluxDBSource(result))
+		Expect(err).To(BeNil())
+		Expect(fig.Data).To(HaveLen(2))
+		start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+		cpu0 := fig.Data[0].(*grob.Scatter)
+		Expect(cpu0.Name).To(Equal(grob.String(`usage_idle{cpu="cpu0"}`)))
+		Expect(cpu0.X).To(Equal([]time.Time{start, start.Add(time.Minute)}))
+		Expect(cpu0.Y).To(Equal([]float64{80, 85}))
+		cpu1 := fig.Data[1].(*grob.Scatter)
+		Expect(cpu1.Name).To(Equal(grob.String(`usage_idle{cpu="cpu1"}`)))
+		Expect(cpu1.Y).To(Equal([]float64{90.5, 91.5}))
+	})
+})
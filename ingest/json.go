@@ -0,0 +1,85 @@
+package ingest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/MetalBlueberry/go-plotly/dataset"
+)
+
+// FromJSON reads a JSON array of records, the objects of the array are the rows and their keys the columns,
+// in order of appearance. Keys missing in a record and null values are missing values.
+// The types of the columns are inferred like those of SQL rows: numbers, booleans, dates if all the strings are dates, or text.
+//
+//	[{"time": "2024-01-01 10:00", "temp": 20.5}, {"time": "2024-01-01 11:00", "temp": 21}]
+func FromJSON(r io.Reader, opt ...Options) (*Table, error) {
+	opts := computeOptions(Options{}, opt...)
+
+	decoder := json.NewDecoder(r)
+	err := expectDelim(decoder, '[')
+	if err != nil {
+		return nil, err
+	}
+	names := []string{}
+	values := map[string][]interface{}{}
+	rows := 0
+	for decoder.More() {
+		err := expectDelim(decoder, '{')
+		if err != nil {
+			return nil, err
+		}
+		for decoder.More() {
+			token, err := decoder.Token()
+			if err != nil {
+				return nil, fmt.Errorf("cannot read json, %w", err)
+			}
+			name := token.(string)
+			var value interface{}
+			err = decoder.Decode(&value)
+			if err != nil {
+				return nil, fmt.Errorf("cannot read json, %w", err)
+			}
+			column, ok := values[name]
+			if !ok {
+				names = append(names, name)
+				column = make([]interface{}, rows)
+			}
+			// the key can be repeated in the record, the last value is kept as by encoding/json
+			values[name] = append(column[:rows], value)
+		}
+		err = expectDelim(decoder, '}')
+		if err != nil {
+			return nil, err
+		}
+		rows++
+		for _, name := range names {
+			if len(values[name]) < rows {
+				values[name] = append(values[name], nil)
+			}
+		}
+	}
+	err = expectDelim(decoder, ']')
+	if err != nil {
+		return nil, err
+	}
+
+	columns := dataset.Columns{}
+	numeric := map[string]bool{}
+	for _, name := range names {
+		columns[name], numeric[name] = sqlColumn(values[name])
+	}
+	return newTable(names, columns, numeric, opts)
+}
+
+// expectDelim reads the next token, which must be the delimiter
+func expectDelim(decoder *json.Decoder, delim json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return fmt.Errorf("cannot read json, %w", err)
+	}
+	if token != delim {
+		return fmt.Errorf("cannot read json, expected %s but got %v, the data must be an array of records", delim, token)
+	}
+	return nil
+}
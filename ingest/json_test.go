@@ -0,0 +1,42 @@
+package ingest_test
+
+import (
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/MetalBlueberry/go-plotly/ingest"
+)
+
+var _ = Describe("FromJSON", func() {
+
+	It("Should read the records", func() {
+		table, err := ingest.FromJSON(strings.NewReader(`[
+			{"time": "2024-01-01 10:00", "temp": 20.5, "sensor": "a", "ok": true},
+			{"time": "2024-01-01 11:00", "temp": null, "sensor": "b", "ok": false, "pressure": 1012}
+		]`), ingest.Options{X: "time"})
+		Expect(err).To(BeNil())
+		Expect(table.Names).To(Equal([]string{"time", "temp", "sensor", "ok", "pressure"}))
+		Expect(table.Columns["time"]).To(Equal([]time.Time{
+			time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
+			time.Date(2024, 1, 1, 11, 0, 0, 0, time.UTC),
+		}))
+		Expect(table.Columns["temp"]).To(Equal([]interface{}{20.5, nil}))
+		Expect(table.Columns["sensor"]).To(Equal([]string{"a", "b"}))
+		Expect(table.Columns["ok"]).To(Equal([]bool{true, false}))
+		Expect(table.Columns["pressure"]).To(Equal([]interface{}{nil, 1012.0}))
+		Expect(table.X).To(Equal("time"))
+		Expect(table.Y).To(Equal([]string{"temp", "pressure"}))
+	})
+
+	It("Should fail if the data is not an array of records", func() {
+		_, err := ingest.FromJSON(strings.NewReader(`{"temp": [1, 2]}`))
+		Expect(err).NotTo(BeNil())
+		_, err = ingest.FromJSON(strings.NewReader(`[1, 2]`))
+		Expect(err).NotTo(BeNil())
+		_, err = ingest.FromJSON(strings.NewReader(`[{"temp": 1}`))
+		Expect(err).NotTo(BeNil())
+	})
+})
@@ -0,0 +1,93 @@
+package ingest
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/prometheus/common/model"
+
+	"github.com/MetalBlueberry/go-plotly/express"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// FromPrometheus plots the result of a Prometheus range query, like the model.Matrix returned by the QueryRange of the API client,
+// with a line per series in the layout of express.TimeSeries. The series are named after the labels that are not the same in all of them,
+// like instance="a:9090", and sorted by name. NaN and infinite values, such as the result of a division by zero, are left blank.
+//
+//	result, _, err := api.QueryRange(ctx, `rate(http_requests_total[5m])`, v1.Range{Start: start, End: end, Step: time.Minute})
+//	fig := ingest.FromPrometheus(result.(model.Matrix), express.Options{Title: "Requests per second"})
+func FromPrometheus(matrix model.Matrix, opt ...express.Options) *grob.Fig {
+	names := seriesNames(matrix)
+	order := make([]int, len(matrix))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return names[order[i]] < names[order[j]] })
+
+	traces := make(grob.Traces, 0, len(matrix))
+	for _, i := range order {
+		stream := matrix[i]
+		x := make([]time.Time, len(stream.Values))
+		y := make([]interface{}, len(stream.Values))
+		for j, sample := range stream.Values {
+			x[j] = sample.Timestamp.Time().UTC()
+			value := float64(sample.Value)
+			if !math.IsNaN(value) && !math.IsInf(value, 0) {
+				y[j] = value
+			}
+		}
+		traces = append(traces, &grob.Scatter{
+			Type:          grob.TraceTypeScatter,
+			Mode:          grob.ScatterModeLines,
+			Name:          names[i],
+			X:             x,
+			Y:             y,
+			Hovertemplate: "%{y}",
+		})
+	}
+	return timeSeriesFigure(traces, opt...)
+}
+
+// seriesNames returns the labels of every series that are not the same in all of them, with the metric name first.
+// A single series is named after all its labels.
+func seriesNames(matrix model.Matrix) []string {
+	common := model.LabelSet{}
+	if len(matrix) > 1 {
+		for name, value := range matrix[0].Metric {
+			common[name] = value
+		}
+		for _, stream := range matrix[1:] {
+			for name, value := range common {
+				if stream.Metric[name] != value {
+					delete(common, name)
+				}
+			}
+		}
+	}
+
+	names := make([]string, len(matrix))
+	for i, stream := range matrix {
+		labels := []string{}
+		for name, value := range stream.Metric {
+			if _, ok := common[name]; !ok && name != model.MetricNameLabel {
+				labels = append(labels, string(name)+"="+`"`+string(value)+`"`)
+			}
+		}
+		sort.Strings(labels)
+		name := ""
+		if _, ok := common[model.MetricNameLabel]; !ok {
+			name = string(stream.Metric[model.MetricNameLabel])
+		}
+		switch {
+		case len(labels) == 0:
+		case name == "":
+			name = strings.Join(labels, ", ")
+		default:
+			name += "{" + strings.Join(labels, ", ") + "}"
+		}
+		names[i] = name
+	}
+	return names
+}
@@ -0,0 +1,62 @@
+package ingest_test
+
+import (
+	"math"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/prometheus/common/model"
+
+	"github.com/MetalBlueberry/go-plotly/express"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/ingest"
+)
+
+var _ = Describe("FromPrometheus", func() {
+
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	samples := func(values ...float64) []model.SamplePair {
+		pairs := make([]model.SamplePair, len(values))
+		for i, value := range values {
+			pairs[i] = model.SamplePair{Timestamp: model.TimeFromUnixNano(start.Add(time.Duration(i) * time.Minute).UnixNano()), Value: model.SampleValue(value)}
+		}
+		return pairs
+	}
+
+	It("Should plot a line per series named after the labels that differ", func() {
+		matrix := model.Matrix{
+			{Metric: model.Metric{"__name__": "up", "job": "api", "instance": "b:9090"}, Values: samples(1, 0)},
+			{Metric: model.Metric{"__name__": "up", "job": "api", "instance": "a:9090"}, Values: samples(1, math.NaN(), 1)},
+		}
+		fig := ingest.FromPrometheus(matrix, express.Options{Title: "Up"})
+		Expect(fig.Data).To(HaveLen(2))
+		first := fig.Data[0].(*grob.Scatter)
+		Expect(first.Name).To(Equal(grob.String(`instance="a:9090"`)))
+		Expect(first.X).To(Equal([]time.Time{start, start.Add(time.Minute), start.Add(2 * time.Minute)}))
+		Expect(first.Y).To(Equal([]interface{}{1.0, nil, 1.0}))
+		Expect(fig.Data[1].(*grob.Scatter).Name).To(Equal(grob.String(`instance="b:9090"`)))
+		Expect(fig.Layout.Title.Text).To(Equal(grob.String("Up")))
+		Expect(fig.Layout.Xaxis.Type).To(Equal(grob.LayoutXaxisTypeDate))
+
+		_, err := fig.ToPlotlyJSON()
+		Expect(err).To(BeNil())
+	})
+
+	It("Should name a single series after all its labels", func() {
+		fig := ingest.FromPrometheus(model.Matrix{
+			{Metric: model.Metric{"__name__": "up", "job": "api"}, Values: samples(1)},
+		})
+		Expect(fig.Data[0].(*grob.Scatter).Name).To(Equal(grob.String(`up{job="api"}`)))
+	})
+
+	It("Should keep the metric names if they differ", func() {
+		fig := ingest.FromPrometheus(model.Matrix{
+			{Metric: model.Metric{"__name__": "requests", "job": "api"}, Values: samples(1)},
+			{Metric: model.Metric{"__name__": "errors", "job": "api"}, Values: samples(1)},
+		})
+		Expect(fig.Data[0].(*grob.Scatter).Name).To(Equal(grob.String("errors")))
+		Expect(fig.Data[1].(*grob.Scatter).Name).To(Equal(grob.String("requests")))
+	})
+})
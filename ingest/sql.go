@@ -0,0 +1,143 @@
+package ingest
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/MetalBlueberry/go-plotly/dataset"
+)
+
+// FromRows reads the rows of a SQL query, with the yCols plotted against the xCol like the X and Y options of the files.
+// The yCols default to the numeric columns but xCol. NULL values are missing values, left blank by plotly.
+// Text values, such as the decimals of some drivers, are numbers or dates if they all are, like the values of CSV files.
+// The rows are closed once read.
+//
+//	rows, err := db.QueryContext(ctx, "SELECT day, sum(amount) AS revenue FROM orders GROUP BY day ORDER BY day")
+//	table, err := ingest.FromRows(rows, "day", "revenue")
+//	fig := table.Figure(express.Options{Title: "Revenue"})
+func FromRows(rows *sql.Rows, xCol string, yCols ...string) (*Table, error) {
+	defer rows.Close()
+	opts := Options{X: xCol}
+	if len(yCols) > 0 {
+		opts.Y = yCols
+	}
+
+	names, err := rows.Columns()
+	if err != nil {
+		return nil, fmt.Errorf("cannot read columns, %w", err)
+	}
+	values := make([][]interface{}, len(names))
+	row := make([]interface{}, len(names))
+	dest := make([]interface{}, len(names))
+	for i := range row {
+		dest[i] = &row[i]
+	}
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return nil, fmt.Errorf("cannot scan row, %w", err)
+		}
+		for i, value := range row {
+			values[i] = append(values[i], sqlValue(value))
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("cannot read rows, %w", err)
+	}
+
+	columns := dataset.Columns{}
+	numeric := map[string]bool{}
+	for i, name := range names {
+		columns[name], numeric[name] = sqlColumn(values[i])
+	}
+	return newTable(names, columns, numeric, opts)
+}
+
+// sqlValue converts the values of the drivers to float64, time.Time, bool or string, nil for NULL
+func sqlValue(value interface{}) interface{} {
+	switch value := value.(type) {
+	case int64:
+		return float64(value)
+	case int32:
+		return float64(value)
+	case int:
+		return float64(value)
+	case uint64:
+		return float64(value)
+	case float32:
+		return float64(value)
+	case []byte:
+		// the bytes are reused by the next scan
+		return string(value)
+	}
+	return value
+}
+
+// sqlColumn returns the values in a slice of their type if they all have the same type and there are no NULLs.
+// Text columns are parsed like the columns of CSV files, NULL being a missing value. It reports if the column is numeric.
+func sqlColumn(values []interface{}) (interface{}, bool) {
+	var first interface{}
+	missing := false
+	for _, value := range values {
+		if value == nil {
+			missing = true
+		} else if first == nil {
+			first = value
+		}
+	}
+
+	switch first.(type) {
+	case nil:
+		return values, false
+	case string:
+		column := make([]string, len(values))
+		for i, value := range values {
+			text, ok := value.(string)
+			if !ok && value != nil {
+				return values, false
+			}
+			column[i] = text
+		}
+		return parseColumn(column, DefaultTimeLayouts)
+	case float64:
+		column := make([]float64, len(values))
+		for i, value := range values {
+			number, ok := value.(float64)
+			if !ok && value != nil {
+				return values, false
+			}
+			column[i] = number
+		}
+		if missing {
+			return values, true
+		}
+		return column, true
+	case time.Time:
+		column := make([]time.Time, len(values))
+		for i, value := range values {
+			date, ok := value.(time.Time)
+			if !ok && value != nil {
+				return values, false
+			}
+			column[i] = date
+		}
+		if missing {
+			return values, false
+		}
+		return column, false
+	case bool:
+		column := make([]bool, len(values))
+		for i, value := range values {
+			boolean, ok := value.(bool)
+			if !ok && value != nil {
+				return values, false
+			}
+			column[i] = boolean
+		}
+		if missing {
+			return values, false
+		}
+		return column, false
+	}
+	return values, false
+}
@@ -0,0 +1,120 @@
+package ingest_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/ingest"
+)
+
+// fakeDriver returns the rows of the fakeQueries by query text
+type fakeDriver struct{}
+
+var fakeQueries = map[string]struct {
+	columns []string
+	rows    [][]driver.Value
+}{}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) { return fakeConn{}, nil }
+
+type fakeConn struct{}
+
+func (fakeConn) Prepare(query string) (driver.Stmt, error) { return fakeStmt(query), nil }
+func (fakeConn) Close() error                              { return nil }
+func (fakeConn) Begin() (driver.Tx, error)                 { return nil, errors.New("not supported") }
+
+type fakeStmt string
+
+func (s fakeStmt) Close() error  { return nil }
+func (s fakeStmt) NumInput() int { return -1 }
+func (s fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return nil, errors.New("not supported")
+}
+func (s fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	query := fakeQueries[string(s)]
+	return &fakeRows{columns: query.columns, rows: query.rows}, nil
+}
+
+type fakeRows struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string { return r.columns }
+func (r *fakeRows) Close() error      { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
+
+var fakeDB = func() *sql.DB {
+	sql.Register("ingest-fake", fakeDriver{})
+	db, _ := sql.Open("ingest-fake", "")
+	return db
+}()
+
+var _ = Describe("FromRows", func() {
+
+	day := func(i int) time.Time {
+		return time.Date(2021, 1, i, 0, 0, 0, 0, time.UTC)
+	}
+
+	query := func(columns []string, values ...[]driver.Value) *sql.Rows {
+		name := CurrentGinkgoTestDescription().FullTestText
+		fakeQueries[name] = struct {
+			columns []string
+			rows    [][]driver.Value
+		}{columns, values}
+		rows, err := fakeDB.Query(name)
+		Expect(err).To(BeNil())
+		return rows
+	}
+
+	It("Should read the columns by type and plot the numeric ones", func() {
+		rows := query([]string{"day", "orders", "revenue", "region", "promo"},
+			[]driver.Value{day(1), int64(3), 10.5, "north", true},
+			[]driver.Value{day(2), int64(4), nil, "south", false},
+		)
+		table, err := ingest.FromRows(rows, "day")
+		Expect(err).To(BeNil())
+		Expect(table.Names).To(Equal([]string{"day", "orders", "revenue", "region", "promo"}))
+		Expect(table.Columns["day"]).To(Equal([]time.Time{day(1), day(2)}))
+		Expect(table.Columns["orders"]).To(Equal([]float64{3, 4}))
+		Expect(table.Columns["revenue"]).To(Equal([]interface{}{10.5, nil}))
+		Expect(table.Columns["region"]).To(Equal([]string{"north", "south"}))
+		Expect(table.Columns["promo"]).To(Equal([]bool{true, false}))
+		Expect(table.Y).To(Equal([]string{"orders", "revenue"}))
+
+		fig := table.Figure()
+		Expect(fig.Data).To(HaveLen(2))
+		Expect(fig.Data[1].(*grob.Scatter).Y).To(Equal([]interface{}{10.5, nil}))
+	})
+
+	It("Should parse the text of numbers and dates", func() {
+		rows := query([]string{"day", "amount"},
+			[]driver.Value{[]byte("2021-01-01"), []byte("1.50")},
+			[]driver.Value{[]byte("2021-01-02"), nil},
+		)
+		table, err := ingest.FromRows(rows, "day", "amount")
+		Expect(err).To(BeNil())
+		Expect(table.Columns["day"]).To(Equal([]time.Time{day(1), day(2)}))
+		Expect(table.Columns["amount"]).To(Equal([]interface{}{1.5, nil}))
+	})
+
+	It("Should fail if a column is not in the query", func() {
+		rows := query([]string{"day", "amount"}, []driver.Value{day(1), 1.0})
+		_, err := ingest.FromRows(rows, "day", "price")
+		Expect(err).To(MatchError("column price not found"))
+	})
+})
@@ -0,0 +1,103 @@
+package ingest
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/MetalBlueberry/go-plotly/express"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// TimeSeriesSource iterates the points of time series, such as the results of a time series database query.
+// Next returns the time of the next point and the value of every series at that time, by series name, until ok is false.
+// The series missing in fields have no value at that time. The fields can be reused by the next call.
+//
+// If the source has an Err() error method, like the query results of database clients, it is checked once Next returns false.
+type TimeSeriesSource interface {
+	Next() (t time.Time, fields map[string]float64, ok bool)
+}
+
+// timeSeries are the points of a series
+type timeSeries struct {
+	x   []time.Time
+	y   []float64
+	nan bool
+}
+
+// TimeSeriesTraces reads the source and returns a line per series, sorted by name.
+// The points are appended straight to the arrays of the traces. NaN and infinite values are left blank.
+func TimeSeriesTraces(source TimeSeriesSource) (grob.Traces, error) {
+	series := map[string]*timeSeries{}
+	for {
+		t, fields, ok := source.Next()
+		if !ok {
+			break
+		}
+		for name, value := range fields {
+			s, ok := series[name]
+			if !ok {
+				s = &timeSeries{}
+				series[name] = s
+			}
+			s.x = append(s.x, t)
+			s.y = append(s.y, value)
+			if math.IsNaN(value) || math.IsInf(value, 0) {
+				s.nan = true
+			}
+		}
+	}
+	if source, ok := source.(interface{ Err() error }); ok && source.Err() != nil {
+		return nil, fmt.Errorf("cannot read time series, %w", source.Err())
+	}
+
+	names := make([]string, 0, len(series))
+	for name := range series {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	traces := make(grob.Traces, len(names))
+	for i, name := range names {
+		s := series[name]
+		var y interface{} = s.y
+		if s.nan {
+			values := make([]interface{}, len(s.y))
+			for j, value := range s.y {
+				if !math.IsNaN(value) && !math.IsInf(value, 0) {
+					values[j] = value
+				}
+			}
+			y = values
+		}
+		traces[i] = &grob.Scatter{
+			Type:          grob.TraceTypeScatter,
+			Mode:          grob.ScatterModeLines,
+			Name:          name,
+			X:             s.x,
+			Y:             y,
+			Hovertemplate: "%{y}",
+		}
+	}
+	return traces, nil
+}
+
+// FromTimeSeries plots the series of the source with TimeSeriesTraces in the layout of express.TimeSeries
+func FromTimeSeries(source TimeSeriesSource, opt ...express.Options) (*grob.Fig, error) {
+	traces, err := TimeSeriesTraces(source)
+	if err != nil {
+		return nil, err
+	}
+	return timeSeriesFigure(traces, opt...), nil
+}
+
+// timeSeriesFigure returns a figure with the traces in the layout of express.TimeSeries
+func timeSeriesFigure(traces grob.Traces, opt ...express.Options) *grob.Fig {
+	fig := express.TimeSeries(nil, nil, opt...)
+	fig.AddTraces(traces...)
+	if len(opt) == 1 && opt[0].WebGLThreshold > 0 {
+		// the layout is created without traces, they are promoted once added
+		fig.OptimizeWebGL(opt[0].WebGLThreshold)
+	}
+	return fig
+}
@@ -0,0 +1,80 @@
+package ingest_test
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/MetalBlueberry/go-plotly/express"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/ingest"
+)
+
+type point struct {
+	t      time.Time
+	fields map[string]float64
+}
+
+type fakeSource struct {
+	points []point
+	err    error
+}
+
+func (s *fakeSource) Next() (time.Time, map[string]float64, bool) {
+	if len(s.points) == 0 {
+		return time.Time{}, nil, false
+	}
+	p := s.points[0]
+	s.points = s.points[1:]
+	return p.t, p.fields, true
+}
+
+func (s *fakeSource) Err() error {
+	return s.err
+}
+
+var _ = Describe("TimeSeriesSource", func() {
+
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	minute := func(i int) time.Time {
+		return start.Add(time.Duration(i) * time.Minute)
+	}
+
+	It("Should plot a line per series sorted by name", func() {
+		source := &fakeSource{points: []point{
+			{minute(0), map[string]float64{"temperature": 20, "humidity": 40}},
+			{minute(1), map[string]float64{"temperature": 21}},
+			{minute(2), map[string]float64{"temperature": 22, "humidity": 42}},
+		}}
+		fig, err := ingest.FromTimeSeries(source, express.Options{Title: "Weather"})
+		Expect(err).To(BeNil())
+		Expect(fig.Data).To(HaveLen(2))
+		humidity := fig.Data[0].(*grob.Scatter)
+		Expect(humidity.Name).To(Equal(grob.String("humidity")))
+		Expect(humidity.X).To(Equal([]time.Time{minute(0), minute(2)}))
+		Expect(humidity.Y).To(Equal([]float64{40, 42}))
+		temperature := fig.Data[1].(*grob.Scatter)
+		Expect(temperature.Name).To(Equal(grob.String("temperature")))
+		Expect(temperature.Y).To(Equal([]float64{20, 21, 22}))
+		Expect(fig.Layout.Title.Text).To(Equal(grob.String("Weather")))
+		Expect(fig.Layout.Xaxis.Type).To(Equal(grob.LayoutXaxisTypeDate))
+	})
+
+	It("Should leave NaN values blank", func() {
+		traces, err := ingest.TimeSeriesTraces(&fakeSource{points: []point{
+			{minute(0), map[string]float64{"load": 1}},
+			{minute(1), map[string]float64{"load": math.NaN()}},
+			{minute(2), map[string]float64{"load": math.Inf(1)}},
+		}})
+		Expect(err).To(BeNil())
+		Expect(traces[0].(*grob.Scatter).Y).To(Equal([]interface{}{1.0, nil, nil}))
+	})
+
+	It("Should return the error of the source", func() {
+		_, err := ingest.FromTimeSeries(&fakeSource{err: errors.New("connection reset")})
+		Expect(err).To(MatchError("cannot read time series, connection reset"))
+	})
+})
@@ -0,0 +1,260 @@
+package ingest
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+
+	"github.com/MetalBlueberry/go-plotly/dataset"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// FromXLSX reads a sheet of an Excel file, the first sheet unless Options.Sheet is given. The first row of Options.Range,
+// like B2:E100, is the header with the names of the columns, the whole sheet by default.
+// Numbers formatted as dates by Excel are dates, the other columns are inferred from their values like those of CSV files.
+func FromXLSX(r io.Reader, opt ...Options) (*Table, error) {
+	opts := computeOptions(Options{
+		TimeLayouts: DefaultTimeLayouts,
+	}, opt...)
+
+	f, err := excelize.OpenReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read xlsx, %w", err)
+	}
+	defer f.Close()
+	sheet := opts.Sheet
+	if sheet == "" {
+		sheet = f.GetSheetName(0)
+	}
+	if f.GetSheetIndex(sheet) == -1 {
+		return nil, fmt.Errorf("sheet %s not found", sheet)
+	}
+	rows, err := f.GetRows(sheet, excelize.Options{RawCellValue: true})
+	if err != nil {
+		return nil, fmt.Errorf("cannot read sheet %s, %w", sheet, err)
+	}
+
+	// the bounds of the range, 1-based and inclusive
+	firstCol, firstRow, lastCol, lastRow := 1, 1, 0, len(rows)
+	for _, row := range rows {
+		if len(row) > lastCol {
+			lastCol = len(row)
+		}
+	}
+	if opts.Range != "" {
+		firstCol, firstRow, lastCol, lastRow, err = cellRange(opts.Range)
+		if err != nil {
+			return nil, err
+		}
+	}
+	cell := func(col, row int) string {
+		if row > len(rows) || col > len(rows[row-1]) {
+			return ""
+		}
+		return strings.TrimSpace(rows[row-1][col-1])
+	}
+	if firstRow > lastRow {
+		return nil, fmt.Errorf("cannot read sheet %s, the header is missing", sheet)
+	}
+
+	var date1904 excelize.Date1904
+	err = f.GetWorkbookPrOptions(&date1904)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read xlsx, %w", err)
+	}
+	names := []string{}
+	columns := dataset.Columns{}
+	numeric := map[string]bool{}
+	for col := firstCol; col <= lastCol; col++ {
+		name := cell(col, firstRow)
+		if name == "" {
+			name, _ = excelize.ColumnNumberToName(col)
+		}
+		values := make([]string, 0, lastRow-firstRow)
+		dateCell := ""
+		for row := firstRow + 1; row <= lastRow; row++ {
+			value := cell(col, row)
+			if value != "" && dateCell == "" {
+				dateCell, _ = excelize.CoordinatesToCellName(col, row)
+			}
+			values = append(values, value)
+		}
+		names = append(names, name)
+		if dateCell != "" && isDateCell(f, sheet, dateCell) {
+			if dates, ok := excelDates(values, bool(date1904)); ok {
+				columns[name] = dates
+				continue
+			}
+		}
+		columns[name], numeric[name] = parseColumn(values, opts.TimeLayouts)
+	}
+	return newTable(names, columns, numeric, opts)
+}
+
+// cellRange returns the bounds of a range like B2:E100
+func cellRange(r string) (int, int, int, int, error) {
+	bounds := strings.Split(r, ":")
+	if len(bounds) != 2 {
+		return 0, 0, 0, 0, fmt.Errorf("invalid range %s", r)
+	}
+	firstCol, firstRow, err := excelize.CellNameToCoordinates(bounds[0])
+	if err != nil {
+		return 0, 0, 0, 0, fmt.Errorf("invalid range %s, %w", r, err)
+	}
+	lastCol, lastRow, err := excelize.CellNameToCoordinates(bounds[1])
+	if err != nil {
+		return 0, 0, 0, 0, fmt.Errorf("invalid range %s, %w", r, err)
+	}
+	if firstCol > lastCol {
+		firstCol, lastCol = lastCol, firstCol
+	}
+	if firstRow > lastRow {
+		firstRow, lastRow = lastRow, firstRow
+	}
+	return firstCol, firstRow, lastCol, lastRow, nil
+}
+
+// dateFormat matches the number formats of dates and times, once the literals and colors are removed
+var dateFormat = regexp.MustCompile(`[ymdhs]`)
+
+// formatLiterals are the quoted texts, escaped characters and bracketed colors and conditions of number formats
+var formatLiterals = regexp.MustCompile(`"[^"]*"|\\.|\[[^\]]*\]`)
+
+// isDateCell tells if the number format of the cell is a date or a time
+func isDateCell(f *excelize.File, sheet, cell string) bool {
+	style, err := f.GetCellStyle(sheet, cell)
+	if err != nil || f.Styles == nil || f.Styles.CellXfs == nil || style >= len(f.Styles.CellXfs.Xf) {
+		return false
+	}
+	xf := f.Styles.CellXfs.Xf[style]
+	if xf.NumFmtID == nil {
+		return false
+	}
+	id := *xf.NumFmtID
+	// the built-in date and time formats
+	if (id >= 14 && id <= 22) || (id >= 45 && id <= 47) {
+		return true
+	}
+	if f.Styles.NumFmts == nil {
+		return false
+	}
+	for _, format := range f.Styles.NumFmts.NumFmt {
+		if format.NumFmtID == id {
+			code := formatLiterals.ReplaceAllString(strings.ToLower(format.FormatCode), "")
+			return dateFormat.MatchString(code)
+		}
+	}
+	return false
+}
+
+// excelDates converts the serial numbers of Excel dates, empty values are missing values
+func excelDates(values []string, date1904 bool) (interface{}, bool) {
+	dates := make([]time.Time, len(values))
+	missing := false
+	for i, value := range values {
+		if value == "" {
+			missing = true
+			continue
+		}
+		serial, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, false
+		}
+		dates[i], err = excelize.ExcelDateToTime(serial, date1904)
+		if err != nil {
+			return nil, false
+		}
+	}
+	if !missing {
+		return dates, true
+	}
+	column := make([]interface{}, len(values))
+	for i, value := range values {
+		if value != "" {
+			column[i] = dates[i]
+		}
+	}
+	return column, true
+}
+
+// xlsxArrays are the attributes of the traces with a value per point written by WriteXLSX, by field name
+var xlsxArrays = []string{"X", "Y", "Z", "Labels", "Values", "Open", "High", "Low", "Close", "Lat", "Lon", "Text"}
+
+// WriteXLSX writes the data arrays of the traces of the figure to a sheet of an Excel file, named after Options.Sheet or Data.
+// Every array is a column named after the trace and the attribute, like "revenue y", the unnamed traces are named after their index.
+// Only the arrays with a value per point are written, like x, y, labels or text, the matrices of heatmaps are not.
+func WriteXLSX(fig *grob.Fig, w io.Writer, opt ...Options) error {
+	opts := computeOptions(Options{Sheet: "Data"}, opt...)
+	f := excelize.NewFile()
+	defer f.Close()
+	f.SetSheetName(f.GetSheetName(0), opts.Sheet)
+
+	col := 1
+	for i, trace := range fig.Data {
+		value := reflect.ValueOf(trace)
+		if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+			continue
+		}
+		value = value.Elem()
+		traceName := fmt.Sprintf("trace %d", i)
+		if name := value.FieldByName("Name"); name.IsValid() && name.Kind() == reflect.Interface && !name.IsNil() {
+			traceName = fmt.Sprint(name.Interface())
+		}
+		for _, field := range xlsxArrays {
+			array, ok := pointArray(value.FieldByName(field))
+			if !ok {
+				continue
+			}
+			header, err := excelize.CoordinatesToCellName(col, 1)
+			if err != nil {
+				return err
+			}
+			err = f.SetCellValue(opts.Sheet, header, traceName+" "+strings.ToLower(field))
+			if err != nil {
+				return fmt.Errorf("cannot write xlsx, %w", err)
+			}
+			for row, item := range array {
+				cell, _ := excelize.CoordinatesToCellName(col, row+2)
+				err = f.SetCellValue(opts.Sheet, cell, item)
+				if err != nil {
+					return fmt.Errorf("cannot write xlsx, %w", err)
+				}
+			}
+			col++
+		}
+	}
+	_, err := f.WriteTo(w)
+	if err != nil {
+		return fmt.Errorf("cannot write xlsx, %w", err)
+	}
+	return nil
+}
+
+// pointArray returns the values of a field holding a one dimensional slice, nested slices like the z of heatmaps are not
+func pointArray(field reflect.Value) ([]interface{}, bool) {
+	if !field.IsValid() || field.Kind() != reflect.Interface || field.IsNil() {
+		return nil, false
+	}
+	slice := field.Elem()
+	if slice.Kind() != reflect.Slice || slice.Type().Elem().Kind() == reflect.Slice {
+		return nil, false
+	}
+	values := make([]interface{}, slice.Len())
+	for i := range values {
+		item := slice.Index(i)
+		if item.Kind() == reflect.Interface && !item.IsNil() {
+			item = item.Elem()
+		}
+		if item.Kind() == reflect.Slice {
+			return nil, false
+		}
+		values[i] = item.Interface()
+	}
+	return values, true
+}
@@ -0,0 +1,97 @@
+package ingest_test
+
+import (
+	"bytes"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/xuri/excelize/v2"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/ingest"
+)
+
+// workbook returns an Excel file with the rows in the sheet from the cell, the first column formatted as dates
+func workbook(sheet, from string, rows ...[]interface{}) *bytes.Buffer {
+	f := excelize.NewFile()
+	f.SetSheetName(f.GetSheetName(0), sheet)
+	col, row, err := excelize.CellNameToCoordinates(from)
+	Expect(err).To(BeNil())
+	date, err := f.NewStyle(&excelize.Style{NumFmt: 14})
+	Expect(err).To(BeNil())
+	for i, values := range rows {
+		cell, _ := excelize.CoordinatesToCellName(col, row+i)
+		Expect(f.SetSheetRow(sheet, cell, &values)).To(Succeed())
+		if i > 0 {
+			Expect(f.SetCellStyle(sheet, cell, cell, date)).To(Succeed())
+		}
+	}
+	buf := &bytes.Buffer{}
+	_, err = f.WriteTo(buf)
+	Expect(err).To(BeNil())
+	return buf
+}
+
+var _ = Describe("FromXLSX", func() {
+
+	day := func(d int) time.Time { return time.Date(2021, 1, d, 0, 0, 0, 0, time.UTC) }
+
+	It("Should read the first sheet with date columns", func() {
+		table, err := ingest.FromXLSX(workbook("Prices", "A1",
+			[]interface{}{"date", "ticker", "close"},
+			[]interface{}{day(4), "AAPL", 129.41},
+			[]interface{}{day(5), "AAPL", nil},
+			[]interface{}{day(6), "MSFT", 217.69},
+		))
+		Expect(err).To(BeNil())
+		Expect(table.Names).To(Equal([]string{"date", "ticker", "close"}))
+		Expect(table.Columns["date"]).To(Equal([]time.Time{day(4), day(5), day(6)}))
+		Expect(table.Columns["ticker"]).To(Equal([]string{"AAPL", "AAPL", "MSFT"}))
+		Expect(table.Columns["close"]).To(Equal([]interface{}{129.41, nil, 217.69}))
+		Expect(table.Y).To(Equal([]string{"close"}))
+	})
+
+	It("Should read a range of a sheet", func() {
+		table, err := ingest.FromXLSX(workbook("Report", "B3",
+			[]interface{}{"day", "units", "notes"},
+			[]interface{}{day(4), 3, "a"},
+			[]interface{}{day(5), 5, "b"},
+			[]interface{}{day(6), 8, "c"},
+		), ingest.Options{Sheet: "Report", Range: "B3:C5"})
+		Expect(err).To(BeNil())
+		Expect(table.Names).To(Equal([]string{"day", "units"}))
+		Expect(table.Columns["day"]).To(Equal([]time.Time{day(4), day(5)}))
+		Expect(table.Columns["units"]).To(Equal([]float64{3, 5}))
+	})
+
+	It("Should fail with a missing sheet or an invalid range", func() {
+		_, err := ingest.FromXLSX(workbook("Data", "A1", []interface{}{"x"}), ingest.Options{Sheet: "Other"})
+		Expect(err).To(MatchError("sheet Other not found"))
+
+		_, err = ingest.FromXLSX(workbook("Data", "A1", []interface{}{"x"}), ingest.Options{Range: "A1"})
+		Expect(err).To(MatchError("invalid range A1"))
+	})
+})
+
+var _ = Describe("WriteXLSX", func() {
+
+	It("Should write the arrays of the traces as columns", func() {
+		fig := &grob.Fig{}
+		fig.AddTraces(
+			&grob.Scatter{Type: grob.TraceTypeScatter, Name: "revenue", X: []float64{1, 2, 3}, Y: []float64{10, 20, 30}},
+			&grob.Bar{Type: grob.TraceTypeBar, X: []string{"a", "b"}, Y: []interface{}{1.5, nil}},
+			&grob.Heatmap{Type: grob.TraceTypeHeatmap, Z: [][]float64{{1, 2}, {3, 4}}},
+		)
+		buf := &bytes.Buffer{}
+		Expect(ingest.WriteXLSX(fig, buf)).To(Succeed())
+
+		table, err := ingest.FromXLSX(buf, ingest.Options{Sheet: "Data"})
+		Expect(err).To(BeNil())
+		Expect(table.Names).To(Equal([]string{"revenue x", "revenue y", "trace 1 x", "trace 1 y"}))
+		Expect(table.Columns["revenue x"]).To(Equal([]float64{1, 2, 3}))
+		Expect(table.Columns["revenue y"]).To(Equal([]float64{10, 20, 30}))
+		Expect(table.Columns["trace 1 x"]).To(Equal([]string{"a", "b", ""}))
+		Expect(table.Columns["trace 1 y"]).To(Equal([]interface{}{1.5, nil, nil}))
+	})
+})
@@ -0,0 +1,66 @@
+// Package layouthelpers sets the margins, title and watermark of the layout, which most figures need
+// and which take several nested objects to set by hand.
+//
+//	layouthelpers.Title(fig, "Production", "Line 3, last 24 hours")
+//	layouthelpers.TightMargins(fig)
+//	err := layouthelpers.Watermark(fig, logo, 0.1)
+package layouthelpers
+
+import (
+	"fmt"
+	"image"
+	"strings"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// TightMargins reduces the margins around the plot area, which default to 80 pixels, leaving room for the title if there is one.
+// The margins still grow to fit the tick labels and the legend.
+func TightMargins(fig *grob.Fig) {
+	if fig.Layout == nil {
+		fig.Layout = &grob.Layout{}
+	}
+	top := 20.0
+	if fig.Layout.Title != nil && fig.Layout.Title.Text != nil && fig.Layout.Title.Text != "" {
+		top = 60
+		if strings.Contains(fmt.Sprint(fig.Layout.Title.Text), "<br>") {
+			top = 80
+		}
+	}
+	fig.Layout.Margin = &grob.LayoutMargin{
+		L: 40,
+		R: 20,
+		T: top,
+		B: 40,
+	}
+}
+
+// Title sets the title of the figure, with the subtitle in a smaller font on a second line if it is not empty
+func Title(fig *grob.Fig, text, sub string) {
+	if fig.Layout == nil {
+		fig.Layout = &grob.Layout{}
+	}
+	if sub != "" {
+		text += "<br><sup>" + sub + "</sup>"
+	}
+	fig.Layout.Title = &grob.LayoutTitle{
+		Text: text,
+	}
+}
+
+// Watermark draws the image centered below the traces, over half of the plot area, with the opacity between 0 and 1
+func Watermark(fig *grob.Fig, img image.Image, opacity float64) error {
+	if opacity <= 0 || opacity > 1 {
+		return fmt.Errorf("opacity %g is not valid, it must be between 0 and 1", opacity)
+	}
+	return fig.AddLayoutImage(img, grob.LayoutImageOptions{
+		X:       0.5,
+		Y:       0.5,
+		Sizex:   0.5,
+		Sizey:   0.5,
+		Xanchor: "center",
+		Yanchor: "middle",
+		Opacity: opacity,
+		Below:   true,
+	})
+}
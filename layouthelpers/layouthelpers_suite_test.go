@@ -0,0 +1,13 @@
+package layouthelpers_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestLayouthelpers(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Layouthelpers Suite")
+}
@@ -0,0 +1,57 @@
+package layouthelpers_test
+
+import (
+	"image"
+	"image/color"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/layouthelpers"
+)
+
+var _ = Describe("Layout helpers", func() {
+
+	var fig *grob.Fig
+	BeforeEach(func() {
+		fig = &grob.Fig{}
+	})
+
+	It("Should set the title and the subtitle", func() {
+		layouthelpers.Title(fig, "Production", "Line 3")
+		Expect(fig.Layout.Title.Text).To(Equal("Production<br><sup>Line 3</sup>"))
+
+		layouthelpers.Title(fig, "Production", "")
+		Expect(fig.Layout.Title.Text).To(Equal("Production"))
+	})
+
+	It("Should leave room for the title in tight margins", func() {
+		layouthelpers.TightMargins(fig)
+		Expect(fig.Layout.Margin).To(Equal(&grob.LayoutMargin{L: 40, R: 20, T: 20, B: 40}))
+
+		layouthelpers.Title(fig, "Production", "")
+		layouthelpers.TightMargins(fig)
+		Expect(fig.Layout.Margin.T).To(Equal(60.0))
+
+		layouthelpers.Title(fig, "Production", "Line 3")
+		layouthelpers.TightMargins(fig)
+		Expect(fig.Layout.Margin.T).To(Equal(80.0))
+	})
+
+	It("Should add a watermark below the traces", func() {
+		img := image.NewGray(image.Rect(0, 0, 2, 2))
+		img.Set(0, 0, color.White)
+		Expect(layouthelpers.Watermark(fig, img, 0.2)).To(Succeed())
+
+		images := fig.Layout.Images.([]interface{})
+		Expect(images).To(HaveLen(1))
+		watermark := images[0].(map[string]interface{})
+		Expect(watermark["layer"]).To(Equal("below"))
+		Expect(watermark["opacity"]).To(Equal(0.2))
+		Expect(watermark["xanchor"]).To(Equal("center"))
+		Expect(watermark["yanchor"]).To(Equal("middle"))
+
+		Expect(layouthelpers.Watermark(fig, img, 0)).NotTo(Succeed())
+	})
+})
@@ -0,0 +1,92 @@
+// Package legend groups, orders and deduplicates the legend entries of a figure.
+//
+// The legend of plotly.js 1.58 lists the traces in the order of the figure data, grouped by legendgroup
+// if the legend traceorder is grouped. Group titles and legend ranks are not available in this version.
+package legend
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// Group puts the traces chosen by the selector in the legend group, so they are displayed together
+// and toggled at once when clicking one of them. It returns the number of traces in the group.
+func Group(fig *grob.Fig, group string, selector grob.Selector) (int, error) {
+	n, err := fig.UpdateTraces(selector, grob.SetTrace("legendgroup", group))
+	if err != nil {
+		return n, err
+	}
+	return n, fig.UpdateLayout(grob.SetLayout("legend.traceorder", grob.LayoutLegendTraceorderGrouped))
+}
+
+// Order moves the traces with the given names to the beginning of the figure data, in the given order,
+// so they are the first legend entries. The other traces keep their relative order after them.
+// Traces are also drawn in this order, the last ones on top.
+func Order(fig *grob.Fig, names ...string) {
+	rank := map[string]int{}
+	for i, name := range names {
+		if _, ok := rank[name]; !ok {
+			rank[name] = i
+		}
+	}
+	position := func(trace grob.Trace) int {
+		if r, ok := rank[traceName(trace)]; ok {
+			return r
+		}
+		return len(names)
+	}
+	sort.SliceStable(fig.Data, func(i, j int) bool {
+		return position(fig.Data[i]) < position(fig.Data[j])
+	})
+}
+
+// Dedupe leaves a single legend entry for traces with the same name, as produced by faceting.
+// Traces without legend group are grouped by name, so the entry toggles all of them.
+// It returns the number of legend entries hidden.
+func Dedupe(fig *grob.Fig) (int, error) {
+	seen := map[string]bool{}
+	hidden := 0
+	for i, trace := range fig.Data {
+		name := traceName(trace)
+		if name == "" {
+			continue
+		}
+		if group := traceField(trace, "Legendgroup"); group == "" {
+			err := grob.SetTrace("legendgroup", name)(trace)
+			if err != nil {
+				return hidden, fmt.Errorf("cannot group trace %d, %w", i, err)
+			}
+		}
+		key := traceField(trace, "Legendgroup") + "\x00" + name
+		if !seen[key] {
+			seen[key] = true
+			continue
+		}
+		err := grob.SetTrace("showlegend", false)(trace)
+		if err != nil {
+			return hidden, fmt.Errorf("cannot hide the legend of trace %d, %w", i, err)
+		}
+		hidden++
+	}
+	return hidden, nil
+}
+
+func traceName(trace grob.Trace) string {
+	return traceField(trace, "Name")
+}
+
+// traceField returns the value of a String field of the trace, or an empty string
+func traceField(trace grob.Trace, name string) string {
+	value := reflect.ValueOf(trace)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return ""
+	}
+	field := value.Elem().FieldByName(name)
+	if !field.IsValid() || field.Kind() != reflect.Interface || field.IsNil() {
+		return ""
+	}
+	return fmt.Sprint(field.Interface())
+}
@@ -0,0 +1,13 @@
+package legend_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestLegend(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Legend Suite")
+}
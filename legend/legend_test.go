@@ -0,0 +1,63 @@
+package legend_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/legend"
+)
+
+var _ = Describe("Legend", func() {
+
+	var fig *grob.Fig
+	BeforeEach(func() {
+		fig = &grob.Fig{}
+		fig.AddTraces(
+			&grob.Scatter{Name: "a"},
+			&grob.Bar{Name: "b"},
+			&grob.Scatter{Name: "c"},
+			&grob.Scatter{Name: "a"},
+		)
+	})
+
+	names := func() []interface{} {
+		result := []interface{}{}
+		for _, trace := range fig.Data {
+			switch t := trace.(type) {
+			case *grob.Scatter:
+				result = append(result, t.Name)
+			case *grob.Bar:
+				result = append(result, t.Name)
+			}
+		}
+		return result
+	}
+
+	It("Should group the selected traces", func() {
+		n, err := legend.Group(fig, "lines", grob.SelectType(grob.TraceTypeScatter))
+		Expect(err).To(BeNil())
+		Expect(n).To(Equal(3))
+		Expect(fig.Data[0].(*grob.Scatter).Legendgroup).To(Equal("lines"))
+		Expect(fig.Data[1].(*grob.Bar).Legendgroup).To(BeNil())
+		Expect(fig.Layout.Legend.Traceorder).To(Equal(grob.LayoutLegendTraceorderGrouped))
+	})
+
+	It("Should order the traces", func() {
+		legend.Order(fig, "c", "b")
+		Expect(names()).To(Equal([]interface{}{"c", "b", "a", "a"}))
+	})
+
+	It("Should hide the duplicated entries", func() {
+		n, err := legend.Dedupe(fig)
+		Expect(err).To(BeNil())
+		Expect(n).To(Equal(1))
+
+		first := fig.Data[0].(*grob.Scatter)
+		last := fig.Data[3].(*grob.Scatter)
+		Expect(first.Showlegend).To(BeNil())
+		Expect(first.Legendgroup).To(Equal("a"))
+		Expect(*last.Showlegend).To(BeFalse())
+		Expect(last.Legendgroup).To(Equal("a"))
+	})
+})
@@ -0,0 +1,244 @@
+// Package menus builds the dropdowns and buttons of the layout updatemenus and the steps of the layout sliders,
+// which change the figure with the restyle, relayout, update and animate methods of plotly.js.
+//
+// The actions write the args arrays of those methods, such as wrapping the arrays set on a single trace
+// so plotly.js does not spread them over the traces.
+//
+//	menus.Add(fig, menus.Buttons(
+//		menus.Button("Linear", menus.Relayout("yaxis.type", "linear")),
+//		menus.Button("Log scale", menus.Relayout("yaxis.type", "log")),
+//	))
+package menus
+
+import (
+	"reflect"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// Method is the plotly.js function called by a button
+type Method string
+
+const (
+	// MethodRestyle changes the attributes of traces
+	MethodRestyle Method = "restyle"
+	// MethodRelayout changes the attributes of the layout
+	MethodRelayout Method = "relayout"
+	// MethodUpdate changes the attributes of traces and of the layout
+	MethodUpdate Method = "update"
+	// MethodAnimate animates to frames
+	MethodAnimate Method = "animate"
+	// MethodSkip does nothing, the button only triggers the plotly_buttonclicked event
+	MethodSkip Method = "skip"
+)
+
+// Action is the method called by a button with its arguments
+type Action struct {
+	Method Method
+	Args   []interface{}
+}
+
+// Restyle sets the attribute of the traces, all of them if none is given, like Restyle("visible", false, 1).
+// Slices are set as a whole on every trace, use RestyleEach to give each trace its own value.
+func Restyle(attribute string, value interface{}, traces ...int) Action {
+	return RestyleAll(map[string]interface{}{attribute: value}, traces...)
+}
+
+// RestyleAll sets the attributes of the traces, all of them if none is given. Slices are set as a whole on every trace
+func RestyleAll(attributes map[string]interface{}, traces ...int) Action {
+	return Action{
+		Method: MethodRestyle,
+		Args:   restyleArgs(attributes, traces),
+	}
+}
+
+// RestyleEach sets the attribute of every trace to its own value, in the order of the traces
+// or of the given indices, like RestyleEach("visible", []interface{}{true, false}).
+func RestyleEach(attribute string, values []interface{}, traces ...int) Action {
+	args := []interface{}{map[string]interface{}{attribute: values}}
+	if len(traces) > 0 {
+		args = append(args, traces)
+	}
+	return Action{
+		Method: MethodRestyle,
+		Args:   args,
+	}
+}
+
+// Relayout sets the attribute of the layout, like Relayout("yaxis.type", "log")
+func Relayout(attribute string, value interface{}) Action {
+	return RelayoutAll(map[string]interface{}{attribute: value})
+}
+
+// RelayoutAll sets the attributes of the layout
+func RelayoutAll(attributes map[string]interface{}) Action {
+	return Action{
+		Method: MethodRelayout,
+		Args:   []interface{}{attributes},
+	}
+}
+
+// Update sets the attributes of the traces, all of them if none is given, and those of the layout at once.
+// Slices of the traces are set as a whole on every trace.
+func Update(traceAttributes, layoutAttributes map[string]interface{}, traces ...int) Action {
+	if traceAttributes == nil {
+		traceAttributes = map[string]interface{}{}
+	}
+	if layoutAttributes == nil {
+		layoutAttributes = map[string]interface{}{}
+	}
+	args := restyleArgs(traceAttributes, traces)
+	args = append(args[:1], layoutAttributes)
+	if len(traces) > 0 {
+		args = append(args, traces)
+	}
+	return Action{
+		Method: MethodUpdate,
+		Args:   args,
+	}
+}
+
+// Animate animates to the frames, by name or group, with the animation options. Without frames it plays all of them
+// from the current one, and an empty animation uses the defaults of plotly.js.
+//
+//	menus.Button("Play", menus.Animate(&grob.Animation{Fromcurrent: grob.True}))
+//	menus.Button("2021", menus.Animate(nil, "2021"))
+func Animate(animation *grob.Animation, frames ...string) Action {
+	var target interface{}
+	if len(frames) > 0 {
+		target = frames
+	}
+	args := []interface{}{target}
+	if animation != nil {
+		args = append(args, animation)
+	}
+	return Action{
+		Method: MethodAnimate,
+		Args:   args,
+	}
+}
+
+// Pause stops the running animation
+func Pause() Action {
+	return Action{
+		Method: MethodAnimate,
+		Args: []interface{}{
+			[]interface{}{nil},
+			map[string]interface{}{
+				"mode":       grob.AnimationModeImmediate,
+				"frame":      map[string]interface{}{"duration": 0, "redraw": false},
+				"transition": map[string]interface{}{"duration": 0},
+			},
+		},
+	}
+}
+
+// restyleArgs wraps the slices so restyle sets them as a whole instead of a value per trace
+func restyleArgs(attributes map[string]interface{}, traces []int) []interface{} {
+	wrapped := make(map[string]interface{}, len(attributes))
+	for attribute, value := range attributes {
+		if value != nil {
+			kind := reflect.TypeOf(value).Kind()
+			if kind == reflect.Slice || kind == reflect.Array {
+				value = []interface{}{value}
+			}
+		}
+		wrapped[attribute] = value
+	}
+	args := []interface{}{wrapped}
+	if len(traces) > 0 {
+		args = append(args, traces)
+	}
+	return args
+}
+
+// MenuButton is a button of a menu
+type MenuButton struct {
+	Label  string        `json:"label"`
+	Method Method        `json:"method"`
+	Args   []interface{} `json:"args"`
+	// Args2 are the arguments of a second click, so the button toggles between two states
+	Args2 []interface{} `json:"args2,omitempty"`
+}
+
+// Button returns a button that calls the action
+func Button(label string, action Action) MenuButton {
+	return MenuButton{
+		Label:  label,
+		Method: action.Method,
+		Args:   action.Args,
+	}
+}
+
+// Toggle returns a button that calls the on action and the off action on the next click. Both actions must use the same method
+func Toggle(label string, on, off Action) MenuButton {
+	button := Button(label, on)
+	button.Args2 = off.Args
+	return button
+}
+
+// Menu is a dropdown or a row of buttons of the layout updatemenus
+type Menu struct {
+	// Type is dropdown or buttons
+	Type string `json:"type,omitempty"`
+	// Direction in which the buttons are laid out or the dropdown unfolds, such as down or right
+	Direction string `json:"direction,omitempty"`
+	// Active is the index of the button displayed as active, -1 for none
+	Active int `json:"active"`
+	// Showactive highlights the active button
+	Showactive grob.Bool `json:"showactive,omitempty"`
+	// X and Y place the menu in the coordinates of the plot area, where 0 and 1 are the edges.
+	// Both default to the top left corner of the plot area
+	X float64 `json:"x,omitempty"`
+	Y float64 `json:"y,omitempty"`
+	// Xanchor and Yanchor align the menu with its position, such as left or top
+	Xanchor string `json:"xanchor,omitempty"`
+	Yanchor string `json:"yanchor,omitempty"`
+	// Buttons of the menu
+	Buttons []MenuButton `json:"buttons"`
+}
+
+// Dropdown returns a dropdown with the buttons, the first one is active
+func Dropdown(buttons ...MenuButton) *Menu {
+	return &Menu{
+		Type:    "dropdown",
+		Buttons: buttons,
+	}
+}
+
+// Buttons returns a row of the buttons, the first one is active
+func Buttons(buttons ...MenuButton) *Menu {
+	return &Menu{
+		Type:      "buttons",
+		Direction: "right",
+		Buttons:   buttons,
+	}
+}
+
+// Add appends the menus to the updatemenus of the layout of the figure, after those already defined
+func Add(fig *grob.Fig, menus ...*Menu) {
+	if fig.Layout == nil {
+		fig.Layout = &grob.Layout{}
+	}
+	items := make([]interface{}, len(menus))
+	for i, menu := range menus {
+		items[i] = menu
+	}
+	fig.Layout.Updatemenus = appendItems(fig.Layout.Updatemenus, items)
+}
+
+// appendItems appends the items to a list of the layout, which can be any slice or a single item
+func appendItems(list interface{}, items []interface{}) []interface{} {
+	all := []interface{}{}
+	if list != nil {
+		value := reflect.ValueOf(list)
+		if value.Kind() == reflect.Slice || value.Kind() == reflect.Array {
+			for i := 0; i < value.Len(); i++ {
+				all = append(all, value.Index(i).Interface())
+			}
+		} else {
+			all = append(all, list)
+		}
+	}
+	return append(all, items...)
+}
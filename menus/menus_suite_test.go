@@ -0,0 +1,13 @@
+package menus_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestMenus(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Menus Suite")
+}
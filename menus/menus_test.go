@@ -0,0 +1,92 @@
+package menus_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/menus"
+)
+
+// args returns the JSON of the args of the action
+func args(action menus.Action) string {
+	data, err := json.Marshal(action.Args)
+	Expect(err).To(BeNil())
+	return string(data)
+}
+
+var _ = Describe("Actions", func() {
+
+	It("Should set the attributes of the layout", func() {
+		action := menus.Relayout("yaxis.type", "log")
+		Expect(action.Method).To(Equal(menus.MethodRelayout))
+		Expect(args(action)).To(MatchJSON(`[{"yaxis.type": "log"}]`))
+	})
+
+	It("Should set slices as a whole on the traces", func() {
+		action := menus.Restyle("y", []float64{1, 2, 3}, 0)
+		Expect(action.Method).To(Equal(menus.MethodRestyle))
+		Expect(args(action)).To(MatchJSON(`[{"y": [[1, 2, 3]]}, [0]]`))
+
+		Expect(args(menus.Restyle("type", "bar"))).To(MatchJSON(`[{"type": "bar"}]`))
+	})
+
+	It("Should set a value per trace", func() {
+		action := menus.RestyleEach("visible", []interface{}{true, false})
+		Expect(args(action)).To(MatchJSON(`[{"visible": [true, false]}]`))
+	})
+
+	It("Should update the traces and the layout", func() {
+		action := menus.Update(
+			map[string]interface{}{"visible": true, "x": []int{1, 2}},
+			map[string]interface{}{"title": "Sales"},
+			1, 2,
+		)
+		Expect(action.Method).To(Equal(menus.MethodUpdate))
+		Expect(args(action)).To(MatchJSON(`[{"visible": true, "x": [[1, 2]]}, {"title": "Sales"}, [1, 2]]`))
+
+		Expect(args(menus.Update(nil, map[string]interface{}{"title": "Costs"}))).To(MatchJSON(`[{}, {"title": "Costs"}]`))
+	})
+
+	It("Should animate to frames", func() {
+		action := menus.Animate(&grob.Animation{Mode: grob.AnimationModeImmediate}, "2020", "2021")
+		Expect(action.Method).To(Equal(menus.MethodAnimate))
+		Expect(args(action)).To(MatchJSON(`[["2020", "2021"], {"mode": "immediate"}]`))
+
+		Expect(args(menus.Animate(nil))).To(MatchJSON(`[null]`))
+		Expect(args(menus.Pause())).To(MatchJSON(`[[null], {"mode": "immediate", "frame": {"duration": 0, "redraw": false}, "transition": {"duration": 0}}]`))
+	})
+})
+
+var _ = Describe("Add", func() {
+
+	It("Should append the menus to the layout", func() {
+		fig := &grob.Fig{Layout: &grob.Layout{
+			Updatemenus: []map[string]interface{}{{"type": "buttons"}},
+		}}
+		menus.Add(fig,
+			menus.Dropdown(
+				menus.Button("Linear", menus.Relayout("yaxis.type", "linear")),
+				menus.Button("Log scale", menus.Relayout("yaxis.type", "log")),
+			),
+			menus.Buttons(
+				menus.Toggle("Markers", menus.Restyle("mode", "markers"), menus.Restyle("mode", "lines")),
+			),
+		)
+
+		data, err := json.Marshal(fig.Layout.Updatemenus)
+		Expect(err).To(BeNil())
+		Expect(data).To(MatchJSON(`[
+			{"type": "buttons"},
+			{"type": "dropdown", "active": 0, "buttons": [
+				{"label": "Linear", "method": "relayout", "args": [{"yaxis.type": "linear"}]},
+				{"label": "Log scale", "method": "relayout", "args": [{"yaxis.type": "log"}]}
+			]},
+			{"type": "buttons", "direction": "right", "active": 0, "buttons": [
+				{"label": "Markers", "method": "restyle", "args": [{"mode": "markers"}], "args2": [{"mode": "lines"}]}
+			]}
+		]`))
+	})
+})
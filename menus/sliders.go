@@ -0,0 +1,150 @@
+package menus
+
+import (
+	"fmt"
+	"strconv"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// SliderStep is a step of a slider, the action is called when the slider moves to it
+type SliderStep struct {
+	Label  string        `json:"label"`
+	Method Method        `json:"method"`
+	Args   []interface{} `json:"args"`
+}
+
+// Step returns a step that calls the action
+func Step(label string, action Action) SliderStep {
+	return SliderStep{
+		Label:  label,
+		Method: action.Method,
+		Args:   action.Args,
+	}
+}
+
+// SliderCurrentvalue is the label of the current step displayed above the slider
+type SliderCurrentvalue struct {
+	// Prefix is displayed before the label of the step, like "Year: "
+	Prefix string `json:"prefix,omitempty"`
+	// Visible displays the label, it is displayed by default
+	Visible grob.Bool `json:"visible,omitempty"`
+}
+
+// Slider is a slider of the layout sliders
+type Slider struct {
+	// Active is the index of the current step
+	Active int `json:"active"`
+	// Currentvalue displays the label of the current step
+	Currentvalue *SliderCurrentvalue `json:"currentvalue,omitempty"`
+	// X and Y place the slider in the coordinates of the plot area, where 0 and 1 are the edges.
+	// Both default to the bottom left corner of the plot area
+	X float64 `json:"x,omitempty"`
+	Y float64 `json:"y,omitempty"`
+	// Len is the length of the slider as a fraction of the plot area, defaults to 1
+	Len float64 `json:"len,omitempty"`
+	// Xanchor and Yanchor align the slider with its position, such as left or top
+	Xanchor string `json:"xanchor,omitempty"`
+	Yanchor string `json:"yanchor,omitempty"`
+	// Steps of the slider
+	Steps []SliderStep `json:"steps"`
+}
+
+// NewSlider returns a slider with the steps, the first one is active
+func NewSlider(steps ...SliderStep) *Slider {
+	return &Slider{
+		Steps: steps,
+	}
+}
+
+// SweepStep is a value of a parameter sweep: the traces plotted for that value and the attributes of the layout that change with it
+type SweepStep struct {
+	// Label of the value on the slider
+	Label string
+	// Traces plotted for the value
+	Traces grob.Traces
+	// Layout are the attributes of the layout set when the slider moves to the value, like {"title.text": "Damping 0.5"}
+	Layout map[string]interface{}
+}
+
+// Sweep adds the traces of every value of a parameter to the figure and returns a slider that shows the traces of one value at a time.
+// The traces of the first value are visible and the rest are hidden. Each step sets the visible attribute of the traces of the sweep
+// with the update method, the traces already in the figure are left as they are.
+//
+//	slider, err := menus.Sweep(fig, "Damping: ", steps...)
+//	menus.AddSliders(fig, slider)
+func Sweep(fig *grob.Fig, prefix string, steps ...SweepStep) (*Slider, error) {
+	first := len(fig.Data)
+	indexes := []int{}
+	hidden := []int{}
+	for i, step := range steps {
+		fig.AddTraces(step.Traces...)
+		for range step.Traces {
+			index := first + len(indexes)
+			indexes = append(indexes, index)
+			if i > 0 {
+				hidden = append(hidden, index)
+			}
+		}
+	}
+	if len(hidden) > 0 {
+		_, err := fig.UpdateTraces(grob.SelectIndex(hidden...), grob.SetTrace("visible", false))
+		if err != nil {
+			return nil, fmt.Errorf("cannot hide the traces of the sweep, %w", err)
+		}
+	}
+
+	slider := NewSlider()
+	if prefix != "" {
+		slider.Currentvalue = &SliderCurrentvalue{Prefix: prefix}
+	}
+	start := 0
+	for _, step := range steps {
+		visible := make([]interface{}, len(indexes))
+		for i := range visible {
+			visible[i] = i >= start && i < start+len(step.Traces)
+		}
+		start += len(step.Traces)
+
+		layout := step.Layout
+		if layout == nil {
+			layout = map[string]interface{}{}
+		}
+		slider.Steps = append(slider.Steps, Step(step.Label, Action{
+			Method: MethodUpdate,
+			Args:   []interface{}{map[string]interface{}{"visible": visible}, layout, indexes},
+		}))
+	}
+	return slider, nil
+}
+
+// FrameSlider returns a slider with a step per frame of the figure that jumps to the frame without transition.
+// Frames without name are named after their index, as the steps refer to the frames by name.
+func FrameSlider(fig *grob.Fig, prefix string) *Slider {
+	slider := NewSlider()
+	if prefix != "" {
+		slider.Currentvalue = &SliderCurrentvalue{Prefix: prefix}
+	}
+	for i := range fig.Frames {
+		if fig.Frames[i].Name == nil || fig.Frames[i].Name == "" {
+			fig.Frames[i].Name = strconv.Itoa(i)
+		}
+		name := fmt.Sprint(fig.Frames[i].Name)
+		jump := Pause()
+		jump.Args[0] = []string{name}
+		slider.Steps = append(slider.Steps, Step(name, jump))
+	}
+	return slider
+}
+
+// AddSliders appends the sliders to the sliders of the layout of the figure, after those already defined
+func AddSliders(fig *grob.Fig, sliders ...*Slider) {
+	if fig.Layout == nil {
+		fig.Layout = &grob.Layout{}
+	}
+	items := make([]interface{}, len(sliders))
+	for i, slider := range sliders {
+		items[i] = slider
+	}
+	fig.Layout.Sliders = appendItems(fig.Layout.Sliders, items)
+}
@@ -0,0 +1,79 @@
+package menus_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/menus"
+)
+
+var _ = Describe("Sliders", func() {
+
+	It("Should show the traces of one value of the sweep at a time", func() {
+		fig := &grob.Fig{}
+		fig.AddTraces(&grob.Scatter{Name: "data"})
+
+		slider, err := menus.Sweep(fig, "Damping: ",
+			menus.SweepStep{
+				Label:  "0.1",
+				Traces: grob.Traces{&grob.Scatter{}},
+				Layout: map[string]interface{}{"title.text": "Damping 0.1"},
+			},
+			menus.SweepStep{
+				Label:  "0.5",
+				Traces: grob.Traces{&grob.Scatter{}, &grob.Scatter{}},
+			},
+		)
+		Expect(err).To(BeNil())
+		Expect(fig.Data).To(HaveLen(4))
+		Expect(fig.Data[0].(*grob.Scatter).Visible).To(BeNil())
+		Expect(fig.Data[1].(*grob.Scatter).Visible).To(BeNil())
+		Expect(fig.Data[2].(*grob.Scatter).Visible).To(Equal(false))
+		Expect(fig.Data[3].(*grob.Scatter).Visible).To(Equal(false))
+
+		menus.AddSliders(fig, slider)
+		data, err := json.Marshal(fig.Layout.Sliders)
+		Expect(err).To(BeNil())
+		Expect(data).To(MatchJSON(`[{
+			"active": 0,
+			"currentvalue": {"prefix": "Damping: "},
+			"steps": [
+				{"label": "0.1", "method": "update", "args": [{"visible": [true, false, false]}, {"title.text": "Damping 0.1"}, [1, 2, 3]]},
+				{"label": "0.5", "method": "update", "args": [{"visible": [false, true, true]}, {}, [1, 2, 3]]}
+			]
+		}]`))
+	})
+
+	It("Should jump to the frames", func() {
+		fig := &grob.Fig{
+			Frames: []grob.Frame{{Name: "2020"}, {}},
+		}
+		slider := menus.FrameSlider(fig, "")
+		Expect(fig.Frames[1].Name).To(Equal("1"))
+
+		data, err := json.Marshal(slider)
+		Expect(err).To(BeNil())
+		Expect(data).To(MatchJSON(`{
+			"active": 0,
+			"steps": [
+				{"label": "2020", "method": "animate", "args": [["2020"], {"mode": "immediate", "frame": {"duration": 0, "redraw": false}, "transition": {"duration": 0}}]},
+				{"label": "1", "method": "animate", "args": [["1"], {"mode": "immediate", "frame": {"duration": 0, "redraw": false}, "transition": {"duration": 0}}]}
+			]
+		}`))
+	})
+
+	It("Should keep the sliders already defined", func() {
+		fig := &grob.Fig{
+			Layout: &grob.Layout{
+				Sliders: map[string]interface{}{"active": 1},
+			},
+		}
+		menus.AddSliders(fig, menus.NewSlider(menus.Step("Log", menus.Relayout("yaxis.type", "log"))))
+		data, err := json.Marshal(fig.Layout.Sliders)
+		Expect(err).To(BeNil())
+		Expect(data).To(MatchJSON(`[{"active": 1}, {"active": 0, "steps": [{"label": "Log", "method": "relayout", "args": [{"yaxis.type": "log"}]}]}]`))
+	})
+})
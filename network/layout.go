@@ -0,0 +1,213 @@
+package network
+
+import (
+	"math"
+	"math/rand"
+)
+
+// Layout is an algorithm placing the nodes of a graph
+type Layout string
+
+const (
+	// FruchtermanReingold pushes all the nodes apart and pulls the linked nodes together, cooling down the moves at every iteration.
+	// It is fast and fits large graphs.
+	FruchtermanReingold Layout = "fruchterman-reingold"
+	// KamadaKawai places the nodes so their distances match the shortest paths between them, moving the node
+	// furthest from its place at every step. It is slower but gives regular drawings of small graphs, like trees.
+	KamadaKawai Layout = "kamada-kawai"
+)
+
+// DefaultIterations are the steps of the layouts by default. Kamada-Kawai makes up to this many moves per node
+const DefaultIterations = 300
+
+// minDistance avoids the divisions by zero of nodes at the same position
+const minDistance = 1e-6
+
+// fruchtermanReingold places n nodes starting from random positions in the unit square
+func fruchtermanReingold(n int, edges [][2]int, iterations int, seed int64) ([]float64, []float64) {
+	random := rand.New(rand.NewSource(seed))
+	x := make([]float64, n)
+	y := make([]float64, n)
+	for i := range x {
+		x[i] = random.Float64()
+		y[i] = random.Float64()
+	}
+	if n < 2 {
+		return x, y
+	}
+
+	// k is the ideal distance between the nodes
+	k := math.Sqrt(1 / float64(n))
+	temperature := 0.1
+	cooling := temperature / float64(iterations+1)
+	dx := make([]float64, n)
+	dy := make([]float64, n)
+	for iteration := 0; iteration < iterations; iteration++ {
+		for i := range dx {
+			dx[i], dy[i] = 0, 0
+		}
+		for i := 0; i < n; i++ {
+			for j := i + 1; j < n; j++ {
+				ex, ey := x[i]-x[j], y[i]-y[j]
+				d := math.Max(math.Hypot(ex, ey), minDistance)
+				force := k * k / d / d
+				dx[i] += ex * force
+				dy[i] += ey * force
+				dx[j] -= ex * force
+				dy[j] -= ey * force
+			}
+		}
+		for _, e := range edges {
+			i, j := e[0], e[1]
+			ex, ey := x[i]-x[j], y[i]-y[j]
+			d := math.Hypot(ex, ey)
+			force := d / k
+			dx[i] -= ex * force
+			dy[i] -= ey * force
+			dx[j] += ex * force
+			dy[j] += ey * force
+		}
+		for i := range x {
+			d := math.Max(math.Hypot(dx[i], dy[i]), minDistance)
+			step := math.Min(d, temperature) / d
+			x[i] += dx[i] * step
+			y[i] += dy[i] * step
+		}
+		temperature -= cooling
+	}
+	return x, y
+}
+
+// kamadaKawai places n nodes starting from a circle
+func kamadaKawai(n int, edges [][2]int, iterations int) ([]float64, []float64) {
+	x := make([]float64, n)
+	y := make([]float64, n)
+	for i := range x {
+		angle := 2 * math.Pi * float64(i) / float64(n)
+		x[i] = math.Cos(angle)
+		y[i] = math.Sin(angle)
+	}
+	if n < 2 {
+		return x, y
+	}
+
+	distances := shortestPaths(n, edges)
+	// the strength of the springs between the nodes decreases with their ideal length
+	strength := make([][]float64, n)
+	for i := range strength {
+		strength[i] = make([]float64, n)
+		for j := range strength[i] {
+			if i != j {
+				strength[i][j] = 1 / (distances[i][j] * distances[i][j])
+			}
+		}
+	}
+
+	// gradient returns the derivatives of the energy of the springs of node m
+	gradient := func(m int) (gx, gy, gxx, gxy, gyy float64) {
+		for i := 0; i < n; i++ {
+			if i == m {
+				continue
+			}
+			ex, ey := x[m]-x[i], y[m]-y[i]
+			d := math.Max(math.Hypot(ex, ey), minDistance)
+			l, k := distances[m][i], strength[m][i]
+			gx += k * (ex - l*ex/d)
+			gy += k * (ey - l*ey/d)
+			d3 := d * d * d
+			gxx += k * (1 - l*ey*ey/d3)
+			gxy += k * l * ex * ey / d3
+			gyy += k * (1 - l*ex*ex/d3)
+		}
+		return gx, gy, gxx, gxy, gyy
+	}
+
+	for step := 0; step < iterations*n; step++ {
+		m, largest := -1, 1e-4
+		for i := 0; i < n; i++ {
+			gx, gy, _, _, _ := gradient(i)
+			if g := math.Hypot(gx, gy); g > largest {
+				m, largest = i, g
+			}
+		}
+		if m == -1 {
+			break
+		}
+		// a Newton-Raphson step towards the minimum of the energy of the node
+		gx, gy, gxx, gxy, gyy := gradient(m)
+		det := gxx*gyy - gxy*gxy
+		if det == 0 {
+			break
+		}
+		x[m] += (gxy*gy - gyy*gx) / det
+		y[m] += (gxy*gx - gxx*gy) / det
+	}
+	return x, y
+}
+
+// shortestPaths returns the number of edges of the shortest paths between the nodes, ignoring directions.
+// The nodes that are not connected are one edge further than the longest path.
+func shortestPaths(n int, edges [][2]int) [][]float64 {
+	neighbors := make([][]int, n)
+	for _, e := range edges {
+		neighbors[e[0]] = append(neighbors[e[0]], e[1])
+		neighbors[e[1]] = append(neighbors[e[1]], e[0])
+	}
+	distances := make([][]float64, n)
+	longest := 1.0
+	for source := range distances {
+		d := make([]float64, n)
+		for i := range d {
+			d[i] = math.Inf(1)
+		}
+		d[source] = 0
+		queue := []int{source}
+		for len(queue) > 0 {
+			node := queue[0]
+			queue = queue[1:]
+			for _, next := range neighbors[node] {
+				if math.IsInf(d[next], 1) {
+					d[next] = d[node] + 1
+					longest = math.Max(longest, d[next])
+					queue = append(queue, next)
+				}
+			}
+		}
+		distances[source] = d
+	}
+	for _, d := range distances {
+		for i := range d {
+			if math.IsInf(d[i], 1) {
+				d[i] = longest + 1
+			}
+		}
+	}
+	return distances
+}
+
+// rescale centers the positions and scales them between -1 and 1
+func rescale(x, y []float64) {
+	if len(x) == 0 {
+		return
+	}
+	cx, cy := 0.0, 0.0
+	for i := range x {
+		cx += x[i]
+		cy += y[i]
+	}
+	cx /= float64(len(x))
+	cy /= float64(len(y))
+	largest := 0.0
+	for i := range x {
+		x[i] -= cx
+		y[i] -= cy
+		largest = math.Max(largest, math.Max(math.Abs(x[i]), math.Abs(y[i])))
+	}
+	if largest == 0 {
+		return
+	}
+	for i := range x {
+		x[i] /= largest
+		y[i] /= largest
+	}
+}
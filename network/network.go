@@ -0,0 +1,350 @@
+// Package network plots graphs, such as dependency graphs or network topologies, as nodes and edges placed by a force directed layout.
+//
+// The graph is given as edges between named nodes, adjacency lists or a gonum graph. The nodes are placed with the
+// Fruchterman-Reingold or the Kamada-Kawai layout and drawn as a scatter trace of markers, over a line trace of the edges.
+//
+//	n, err := network.FromAdjacency(map[string][]string{"api": {"db", "cache"}, "worker": {"db"}}, network.Options{Directed: true})
+//	fig := n.Figure()
+package network
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"gonum.org/v1/gonum/graph"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// Edge links the Source node to the Target node
+type Edge struct {
+	Source string
+	Target string
+}
+
+// Options configure the layout and the figure of the graph
+type Options struct {
+	// Layout places the nodes, defaults to FruchtermanReingold
+	Layout Layout
+	// Iterations are the steps of the layout, defaults to DefaultIterations
+	Iterations int
+	// Seed of the random initial positions of the Fruchterman-Reingold layout, the same seed gives the same layout
+	Seed int64
+	// Directed draws the edges as arrows from the source to the target and shows the in and out degrees on hover.
+	// It is set for directed gonum graphs
+	Directed bool
+	// Info is text added to the hover of the nodes, by name
+	Info map[string]string
+	// Title of the figure
+	Title string
+}
+
+func computeOptions(def Options, opt ...Options) Options {
+	if len(opt) == 1 {
+		opts := opt[0]
+		if opts.Layout != "" {
+			def.Layout = opts.Layout
+		}
+		if opts.Iterations != 0 {
+			def.Iterations = opts.Iterations
+		}
+		if opts.Seed != 0 {
+			def.Seed = opts.Seed
+		}
+		if opts.Directed {
+			def.Directed = opts.Directed
+		}
+		if opts.Info != nil {
+			def.Info = opts.Info
+		}
+		if opts.Title != "" {
+			def.Title = opts.Title
+		}
+	}
+	return def
+}
+
+// Network is a graph with the positions of its nodes
+type Network struct {
+	// Nodes are the names of the nodes
+	Nodes []string
+	// X and Y are the positions of the nodes, between -1 and 1
+	X, Y []float64
+	// Edges are the source and target of the edges, by index of the nodes
+	Edges [][2]int
+
+	opts Options
+}
+
+// New places the nodes of the edges, in order of appearance. Repeated edges are drawn once.
+func New(edges []Edge, opt ...Options) (*Network, error) {
+	b := newBuilder()
+	for _, edge := range edges {
+		if edge.Source == "" || edge.Target == "" {
+			return nil, fmt.Errorf("edge from %q to %q without a node name", edge.Source, edge.Target)
+		}
+		b.edge(b.node(edge.Source), b.node(edge.Target))
+	}
+	return b.layout(opt...)
+}
+
+// FromAdjacency places the nodes of adjacency lists, the targets of the edges of every source node.
+// The nodes are sorted by name, the sources with an empty list are nodes without edges.
+func FromAdjacency(adjacency map[string][]string, opt ...Options) (*Network, error) {
+	names := map[string]bool{}
+	for source, targets := range adjacency {
+		names[source] = true
+		for _, target := range targets {
+			names[target] = true
+		}
+	}
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		if name == "" {
+			return nil, fmt.Errorf("node without name in the adjacency lists")
+		}
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+
+	b := newBuilder()
+	for _, name := range sorted {
+		b.node(name)
+	}
+	for _, source := range sorted {
+		for _, target := range adjacency[source] {
+			b.edge(b.node(source), b.node(target))
+		}
+	}
+	return b.layout(opt...)
+}
+
+// FromGraph places the nodes of a gonum graph, sorted by id. The nodes are named after their DOTID, like the nodes of
+// graphs decoded from DOT files, their String method or their id. Directed graphs are drawn with arrows.
+func FromGraph(g graph.Graph, opt ...Options) (*Network, error) {
+	nodes := graph.NodesOf(g.Nodes())
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].ID() < nodes[j].ID() })
+
+	b := newBuilder()
+	index := make(map[int64]int, len(nodes))
+	for _, node := range nodes {
+		name := nodeName(node)
+		if _, ok := b.index[name]; ok {
+			return nil, fmt.Errorf("duplicated node name %s", name)
+		}
+		index[node.ID()] = b.node(name)
+	}
+	_, directed := g.(graph.Directed)
+	for _, node := range nodes {
+		to := graph.NodesOf(g.From(node.ID()))
+		sort.Slice(to, func(i, j int) bool { return to[i].ID() < to[j].ID() })
+		for _, target := range to {
+			if !directed && target.ID() < node.ID() {
+				// undirected edges are visited from both nodes
+				continue
+			}
+			b.edge(index[node.ID()], index[target.ID()])
+		}
+	}
+	opts := Options{}
+	if len(opt) == 1 {
+		opts = opt[0]
+	}
+	opts.Directed = opts.Directed || directed
+	return b.layout(opts)
+}
+
+// nodeName returns the name of a gonum node
+func nodeName(node graph.Node) string {
+	switch node := node.(type) {
+	case interface{ DOTID() string }:
+		if id := node.DOTID(); id != "" {
+			return id
+		}
+	case fmt.Stringer:
+		return node.String()
+	}
+	return strconv.FormatInt(node.ID(), 10)
+}
+
+// builder collects the nodes and the edges of a graph
+type builder struct {
+	index map[string]int
+	nodes []string
+	edges [][2]int
+	seen  map[[2]int]bool
+}
+
+func newBuilder() *builder {
+	return &builder{
+		index: map[string]int{},
+		seen:  map[[2]int]bool{},
+	}
+}
+
+// node returns the index of the node, adding it if new
+func (b *builder) node(name string) int {
+	i, ok := b.index[name]
+	if !ok {
+		i = len(b.nodes)
+		b.index[name] = i
+		b.nodes = append(b.nodes, name)
+	}
+	return i
+}
+
+// edge adds the edge if new
+func (b *builder) edge(source, target int) {
+	e := [2]int{source, target}
+	if b.seen[e] {
+		return
+	}
+	b.seen[e] = true
+	b.edges = append(b.edges, e)
+}
+
+// layout places the nodes with the layout of the options
+func (b *builder) layout(opt ...Options) (*Network, error) {
+	opts := computeOptions(Options{
+		Layout:     FruchtermanReingold,
+		Iterations: DefaultIterations,
+	}, opt...)
+	if opts.Iterations < 0 {
+		return nil, fmt.Errorf("iterations %d must be positive", opts.Iterations)
+	}
+	var x, y []float64
+	switch opts.Layout {
+	case FruchtermanReingold:
+		x, y = fruchtermanReingold(len(b.nodes), b.edges, opts.Iterations, opts.Seed)
+	case KamadaKawai:
+		x, y = kamadaKawai(len(b.nodes), b.edges, opts.Iterations)
+	default:
+		return nil, fmt.Errorf("unknown layout %s", opts.Layout)
+	}
+	rescale(x, y)
+	return &Network{
+		Nodes: b.nodes,
+		X:     x,
+		Y:     y,
+		Edges: b.edges,
+		opts:  opts,
+	}, nil
+}
+
+// Traces returns a line trace with the edges and a marker trace with the nodes, colored by degree.
+// The hover of the nodes shows their name, degree and info.
+func (n *Network) Traces() grob.Traces {
+	x := make([]interface{}, 0, 3*len(n.Edges))
+	y := make([]interface{}, 0, 3*len(n.Edges))
+	in := make([]int, len(n.Nodes))
+	out := make([]int, len(n.Nodes))
+	for _, e := range n.Edges {
+		// the edges are segments of a single line, separated by gaps
+		x = append(x, n.X[e[0]], n.X[e[1]], nil)
+		y = append(y, n.Y[e[0]], n.Y[e[1]], nil)
+		out[e[0]]++
+		in[e[1]]++
+	}
+
+	degrees := make([]int, len(n.Nodes))
+	hover := make([]string, len(n.Nodes))
+	for i, name := range n.Nodes {
+		degrees[i] = in[i] + out[i]
+		hover[i] = fmt.Sprintf("<b>%s</b><br>degree %d", name, degrees[i])
+		if n.opts.Directed {
+			hover[i] = fmt.Sprintf("<b>%s</b><br>in %d, out %d", name, in[i], out[i])
+		}
+		if info := n.opts.Info[name]; info != "" {
+			hover[i] += "<br>" + info
+		}
+	}
+
+	return grob.Traces{
+		&grob.Scatter{
+			Type:       grob.TraceTypeScatter,
+			Name:       "edges",
+			Mode:       grob.ScatterModeLines,
+			X:          x,
+			Y:          y,
+			Hoverinfo:  grob.ScatterHoverinfoNone,
+			Showlegend: grob.False,
+			Line: &grob.ScatterLine{
+				Color: "#888",
+				Width: 1,
+			},
+		},
+		&grob.Scatter{
+			Type:          grob.TraceTypeScatter,
+			Name:          "nodes",
+			Mode:          grob.ScatterModeMarkers,
+			X:             n.X,
+			Y:             n.Y,
+			Text:          n.Nodes,
+			Hovertext:     hover,
+			Hovertemplate: "%{hovertext}<extra></extra>",
+			Showlegend:    grob.False,
+			Marker: &grob.ScatterMarker{
+				Size:         10,
+				Color:        degrees,
+				Colorscale:   "YlGnBu",
+				Reversescale: grob.True,
+				Line: &grob.ScatterMarkerLine{
+					Color: "white",
+					Width: 1,
+				},
+			},
+		},
+	}
+}
+
+// Figure plots the traces on hidden axes with the same scale, so the distances between the nodes are kept.
+// The edges of directed graphs end with arrows.
+func (n *Network) Figure() *grob.Fig {
+	fig := &grob.Fig{
+		Data: n.Traces(),
+		Layout: &grob.Layout{
+			Hovermode: grob.LayoutHovermodeClosest,
+			Xaxis: &grob.LayoutXaxis{
+				Showgrid:       grob.False,
+				Zeroline:       grob.False,
+				Showticklabels: grob.False,
+			},
+			Yaxis: &grob.LayoutYaxis{
+				Showgrid:       grob.False,
+				Zeroline:       grob.False,
+				Showticklabels: grob.False,
+				Scaleanchor:    "x",
+			},
+		},
+	}
+	if n.opts.Title != "" {
+		fig.Layout.Title = &grob.LayoutTitle{Text: n.opts.Title}
+	}
+	if !n.opts.Directed {
+		return fig
+	}
+	arrows := make([]interface{}, 0, len(n.Edges))
+	for _, e := range n.Edges {
+		if e[0] == e[1] {
+			continue
+		}
+		arrows = append(arrows, map[string]interface{}{
+			"x":          n.X[e[1]],
+			"y":          n.Y[e[1]],
+			"ax":         n.X[e[0]],
+			"ay":         n.Y[e[0]],
+			"xref":       "x",
+			"yref":       "y",
+			"axref":      "x",
+			"ayref":      "y",
+			"text":       "",
+			"showarrow":  true,
+			"arrowhead":  2,
+			"arrowcolor": "#888",
+			"standoff":   6,
+		})
+	}
+	fig.Layout.Annotations = arrows
+	return fig
+}
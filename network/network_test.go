@@ -0,0 +1,143 @@
+package network_test
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"gonum.org/v1/gonum/graph/simple"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/network"
+)
+
+// distance returns the distance between two nodes of the network
+func distance(n *network.Network, i, j int) float64 {
+	return math.Hypot(n.X[i]-n.X[j], n.Y[i]-n.Y[j])
+}
+
+var path = []network.Edge{
+	{Source: "a", Target: "b"},
+	{Source: "b", Target: "c"},
+	{Source: "c", Target: "d"},
+}
+
+var _ = Describe("New", func() {
+
+	It("Should collect the nodes and the edges", func() {
+		n, err := network.New(append(path, network.Edge{Source: "a", Target: "b"}))
+		Expect(err).To(BeNil())
+		Expect(n.Nodes).To(Equal([]string{"a", "b", "c", "d"}))
+		Expect(n.Edges).To(Equal([][2]int{{0, 1}, {1, 2}, {2, 3}}))
+		for i := range n.Nodes {
+			Expect(n.X[i]).To(BeNumerically("~", 0, 1))
+			Expect(n.Y[i]).To(BeNumerically("~", 0, 1))
+		}
+	})
+
+	It("Should place the linked nodes closer with both layouts", func() {
+		for _, layout := range []network.Layout{network.FruchtermanReingold, network.KamadaKawai} {
+			n, err := network.New(path, network.Options{Layout: layout})
+			Expect(err).To(BeNil())
+			Expect(distance(n, 0, 1)).To(BeNumerically("<", distance(n, 0, 3)), string(layout))
+			Expect(distance(n, 2, 3)).To(BeNumerically("<", distance(n, 0, 3)), string(layout))
+		}
+	})
+
+	It("Should match the distances to the shortest paths with Kamada-Kawai", func() {
+		n, err := network.New(path, network.Options{Layout: network.KamadaKawai})
+		Expect(err).To(BeNil())
+		step := distance(n, 0, 1)
+		Expect(distance(n, 0, 2)).To(BeNumerically("~", 2*step, 0.01))
+		Expect(distance(n, 0, 3)).To(BeNumerically("~", 3*step, 0.01))
+	})
+
+	It("Should give the same layout with the same seed", func() {
+		first, err := network.New(path, network.Options{Seed: 42})
+		Expect(err).To(BeNil())
+		second, err := network.New(path, network.Options{Seed: 42})
+		Expect(err).To(BeNil())
+		Expect(second.X).To(Equal(first.X))
+		Expect(second.Y).To(Equal(first.Y))
+	})
+
+	It("Should fail with invalid graphs and options", func() {
+		_, err := network.New([]network.Edge{{Source: "a"}})
+		Expect(err).To(MatchError(`edge from "a" to "" without a node name`))
+
+		_, err = network.New(path, network.Options{Layout: "spring"})
+		Expect(err).To(MatchError("unknown layout spring"))
+	})
+})
+
+var _ = Describe("FromAdjacency", func() {
+
+	It("Should sort the nodes by name", func() {
+		n, err := network.FromAdjacency(map[string][]string{
+			"worker": {"db"},
+			"api":    {"db", "cache"},
+			"cron":   nil,
+		})
+		Expect(err).To(BeNil())
+		Expect(n.Nodes).To(Equal([]string{"api", "cache", "cron", "db", "worker"}))
+		Expect(n.Edges).To(Equal([][2]int{{0, 3}, {0, 1}, {4, 3}}))
+	})
+})
+
+var _ = Describe("FromGraph", func() {
+
+	It("Should draw the edges of undirected graphs once", func() {
+		g := simple.NewUndirectedMatrix(4, 0, 0, 0)
+		g.SetEdge(simple.Edge{F: simple.Node(0), T: simple.Node(1)})
+		g.SetEdge(simple.Edge{F: simple.Node(1), T: simple.Node(2)})
+
+		n, err := network.FromGraph(g)
+		Expect(err).To(BeNil())
+		Expect(n.Nodes).To(Equal([]string{"0", "1", "2", "3"}))
+		Expect(n.Edges).To(Equal([][2]int{{0, 1}, {1, 2}}))
+		Expect(n.Figure().Layout.Annotations).To(BeNil())
+	})
+
+	It("Should draw the edges of directed graphs as arrows", func() {
+		g := simple.NewDirectedMatrix(2, 0, 0, 0)
+		g.SetEdge(simple.Edge{F: simple.Node(0), T: simple.Node(1)})
+		g.SetEdge(simple.Edge{F: simple.Node(1), T: simple.Node(0)})
+
+		n, err := network.FromGraph(g)
+		Expect(err).To(BeNil())
+		Expect(n.Edges).To(Equal([][2]int{{0, 1}, {1, 0}}))
+
+		fig := n.Figure()
+		Expect(fig.Layout.Annotations).To(HaveLen(2))
+		arrow := fig.Layout.Annotations.([]interface{})[0].(map[string]interface{})
+		Expect(arrow["x"]).To(Equal(n.X[1]))
+		Expect(arrow["ax"]).To(Equal(n.X[0]))
+		Expect(arrow["showarrow"]).To(BeTrue())
+
+		nodes := fig.Data[1].(*grob.Scatter)
+		Expect(nodes.Hovertext).To(Equal([]string{"<b>0</b><br>in 1, out 1", "<b>1</b><br>in 1, out 1"}))
+	})
+})
+
+var _ = Describe("Traces", func() {
+
+	It("Should draw the edges as a line with gaps and the nodes as markers", func() {
+		n, err := network.New(path[:2], network.Options{Info: map[string]string{"b": "version 1.2"}})
+		Expect(err).To(BeNil())
+		traces := n.Traces()
+		Expect(traces).To(HaveLen(2))
+
+		edges := traces[0].(*grob.Scatter)
+		Expect(edges.Mode).To(Equal(grob.ScatterModeLines))
+		Expect(edges.X).To(Equal([]interface{}{n.X[0], n.X[1], nil, n.X[1], n.X[2], nil}))
+
+		nodes := traces[1].(*grob.Scatter)
+		Expect(nodes.Text).To(Equal([]string{"a", "b", "c"}))
+		Expect(nodes.Marker.Color).To(Equal([]int{1, 2, 1}))
+		Expect(nodes.Hovertext).To(Equal([]string{
+			"<b>a</b><br>degree 1",
+			"<b>b</b><br>degree 2<br>version 1.2",
+			"<b>c</b><br>degree 1",
+		}))
+	})
+})
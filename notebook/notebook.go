@@ -0,0 +1,140 @@
+// Package notebook displays figures inline in Jupyter notebooks running a Go kernel such as gophernotes or gonb.
+//
+// gophernotes displays the value returned by Display when it is the last expression of a cell.
+// gonb displays HTML through gonbui, set DisplayHTML once to enable it:
+//
+//	notebook.DisplayHTML = gonbui.DisplayHTML
+//	notebook.Display(fig)
+package notebook
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/offline"
+)
+
+const (
+	// MIMETypePlotly is the MIME type rendered by the plotly extension of JupyterLab
+	MIMETypePlotly = "application/vnd.plotly.v1+json"
+	// MIMETypeHTML is the MIME type rendered by every notebook frontend
+	MIMETypeHTML = "text/html"
+)
+
+// DisplayHTML is called by Display with the HTML of the figure when set.
+// Kernels without a display protocol based on return values, such as gonb, must set it.
+var DisplayHTML func(html string)
+
+// MIMEBundle maps MIME types to the representation of the figure, as expected by Jupyter display_data messages
+type MIMEBundle map[string]interface{}
+
+// Options for the notebook output
+type Options struct {
+	// PlotlyJSURL is the URL of the plotly.js script loaded by the HTML output when plotly.js is not available in the page.
+	// Defaults to the CDN with offline.DefaultPlotlyJSVersion
+	PlotlyJSURL string
+}
+
+// Data is a figure ready to be displayed by a notebook.
+// It implements the HTML and SimpleRender methods that gophernotes looks for in cell results.
+type Data struct {
+	bundle MIMEBundle
+}
+
+// HTML returns the HTML representation of the figure
+func (d Data) HTML() string {
+	html, _ := d.bundle[MIMETypeHTML].(string)
+	return html
+}
+
+// SimpleRender returns the MIME bundle of the figure
+func (d Data) SimpleRender() map[string]interface{} {
+	return d.bundle
+}
+
+// Display returns the figure ready to be displayed and sends it to DisplayHTML if set.
+// Figures that cannot be marshaled are displayed as an error message.
+func Display(fig *grob.Fig, opt ...Options) Data {
+	bundle, err := Bundle(fig, opt...)
+	if err != nil {
+		bundle = MIMEBundle{
+			"text/plain": err.Error(),
+		}
+	}
+	data := Data{bundle: bundle}
+	if DisplayHTML != nil && err == nil {
+		DisplayHTML(data.HTML())
+	}
+	return data
+}
+
+// plotCount makes div ids unique across the cells of a notebook
+var plotCount int64
+
+// Bundle returns the MIME bundle of the figure with the plotly JSON and HTML representations.
+// Frontends pick the richest representation they support.
+func Bundle(fig *grob.Fig, opt ...Options) (MIMEBundle, error) {
+	opts := computeOptions(Options{
+		PlotlyJSURL: "https://cdn.plot.ly/plotly-" + offline.DefaultPlotlyJSVersion + ".min.js",
+	}, opt...)
+
+	figBytes, err := json.Marshal(fig)
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal figure, %w", err)
+	}
+	var figJSON map[string]interface{}
+	err = json.Unmarshal(figBytes, &figJSON)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode figure, %w", err)
+	}
+
+	divID := fmt.Sprintf("go-plotly-%d", atomic.AddInt64(&plotCount, 1))
+	src, err := json.Marshal(opts.PlotlyJSURL)
+	if err != nil {
+		return nil, err
+	}
+	// avoid closing the script tag from inside the figure
+	figure := strings.ReplaceAll(string(figBytes), "</", "<\\/")
+
+	return MIMEBundle{
+		MIMETypePlotly: figJSON,
+		MIMETypeHTML:   fmt.Sprintf(htmlOutput, divID, divID, figure, src),
+	}, nil
+}
+
+func computeOptions(def Options, opt ...Options) Options {
+	if len(opt) == 1 {
+		opts := opt[0]
+		if opts.PlotlyJSURL != "" {
+			def.PlotlyJSURL = opts.PlotlyJSURL
+		}
+	}
+	return def
+}
+
+// htmlOutput loads plotly.js once per notebook and then draws the figure
+var htmlOutput = `<div id="%s"></div>
+<script>
+	(function() {
+		var plot = function() {
+			Plotly.newPlot("%s", %s);
+		};
+		if (window.Plotly) {
+			plot();
+			return;
+		}
+		if (!window.goPlotlyLoading) {
+			window.goPlotlyLoading = new Promise(function(resolve) {
+				var script = document.createElement("script");
+				script.src = %s;
+				script.onload = resolve;
+				document.head.appendChild(script);
+			});
+		}
+		window.goPlotlyLoading.then(plot);
+	})();
+</script>
+`
@@ -0,0 +1,13 @@
+package notebook_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestNotebook(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Notebook Suite")
+}
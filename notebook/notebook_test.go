@@ -0,0 +1,51 @@
+package notebook_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/notebook"
+)
+
+var _ = Describe("Notebook", func() {
+
+	var fig *grob.Fig
+
+	BeforeEach(func() {
+		fig = &grob.Fig{
+			Layout: &grob.Layout{
+				Title: &grob.LayoutTitle{Text: "notebook"},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		notebook.DisplayHTML = nil
+	})
+
+	It("Should build the MIME bundle", func() {
+		bundle, err := notebook.Bundle(fig, notebook.Options{PlotlyJSURL: "plotly.min.js"})
+		Expect(err).To(BeNil())
+
+		Expect(bundle[notebook.MIMETypePlotly]).To(HaveKeyWithValue("layout", HaveKeyWithValue("title", map[string]interface{}{"text": "notebook"})))
+		Expect(bundle[notebook.MIMETypeHTML]).To(ContainSubstring(`"title":{"text":"notebook"}`))
+		Expect(bundle[notebook.MIMETypeHTML]).To(ContainSubstring(`script.src = "plotly.min.js"`))
+	})
+
+	It("Should use unique div ids", func() {
+		first := notebook.Display(fig).HTML()
+		second := notebook.Display(fig).HTML()
+		Expect(first[:30]).NotTo(Equal(second[:30]))
+	})
+
+	It("Should send the HTML to DisplayHTML", func() {
+		var html string
+		notebook.DisplayHTML = func(h string) {
+			html = h
+		}
+		data := notebook.Display(fig)
+		Expect(html).To(Equal(data.HTML()))
+		Expect(data.SimpleRender()).To(HaveKey(notebook.MIMETypePlotly))
+	})
+})
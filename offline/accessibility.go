@@ -0,0 +1,104 @@
+package offline
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// pointAttributes are the columns of the data tables in this order, the other arrays of values follow sorted by name
+var pointAttributes = []string{"x", "y", "z", "labels", "parents", "values", "lat", "lon", "text"}
+
+// dataTable renders the values of the traces as HTML tables inside a details element, collapsed by default.
+// There is a table per trace, with a column per array of values such as x and y. Arrays of arrays, like the z of heatmaps, are left out
+func dataTable(fig *grob.Fig) (string, error) {
+	b := &strings.Builder{}
+	for i, trace := range fig.Data {
+		traceBytes, err := json.Marshal(trace)
+		if err != nil {
+			return "", fmt.Errorf("cannot marshal trace %d, %w", i, err)
+		}
+		attributes := map[string]interface{}{}
+		err = decodeNumbers(traceBytes, &attributes)
+		if err != nil {
+			return "", fmt.Errorf("cannot decode trace %d, %w", i, err)
+		}
+
+		columns, rows := tableColumns(attributes)
+		if len(columns) == 0 {
+			continue
+		}
+		name, _ := attributes["name"].(string)
+		if name == "" {
+			name = fmt.Sprintf("Trace %d", i+1)
+		}
+		b.WriteString("\n\t\t\t<table>\n\t\t\t\t<caption>" + html.EscapeString(name) + "</caption>\n\t\t\t\t<thead><tr>")
+		for _, column := range columns {
+			b.WriteString(`<th scope="col">` + html.EscapeString(column) + "</th>")
+		}
+		b.WriteString("</tr></thead>\n\t\t\t\t<tbody>")
+		for row := 0; row < rows; row++ {
+			b.WriteString("\n\t\t\t\t\t<tr>")
+			for _, column := range columns {
+				b.WriteString("<td>")
+				if values := attributes[column].([]interface{}); row < len(values) && values[row] != nil {
+					b.WriteString(html.EscapeString(fmt.Sprint(values[row])))
+				}
+				b.WriteString("</td>")
+			}
+			b.WriteString("</tr>")
+		}
+		b.WriteString("\n\t\t\t\t</tbody>\n\t\t\t</table>")
+	}
+	if b.Len() == 0 {
+		return "", nil
+	}
+	return "<details>\n\t\t\t<summary>Data</summary>" + b.String() + "\n\t\t</details>", nil
+}
+
+// tableColumns returns the attributes of the trace that are arrays of values and the length of the longest
+func tableColumns(attributes map[string]interface{}) ([]string, int) {
+	order := map[string]int{}
+	for i, name := range pointAttributes {
+		order[name] = i
+	}
+	columns := []string{}
+	rows := 0
+	for name, value := range attributes {
+		values, ok := value.([]interface{})
+		if !ok || len(values) == 0 || !scalars(values) {
+			continue
+		}
+		columns = append(columns, name)
+		if len(values) > rows {
+			rows = len(values)
+		}
+	}
+	sort.Slice(columns, func(i, j int) bool {
+		oi, iKnown := order[columns[i]]
+		oj, jKnown := order[columns[j]]
+		if iKnown != jKnown {
+			return iKnown
+		}
+		if iKnown {
+			return oi < oj
+		}
+		return columns[i] < columns[j]
+	})
+	return columns, rows
+}
+
+// scalars tells if the values are not arrays or objects
+func scalars(values []interface{}) bool {
+	for _, value := range values {
+		switch value.(type) {
+		case []interface{}, map[string]interface{}:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,106 @@
+package offline
+
+import (
+	"strconv"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// defaultAnimation is used by the play button when the figure has no Animation
+var defaultAnimation = &grob.Animation{
+	Frame: &grob.AnimationFrame{
+		Duration: 500,
+		Redraw:   grob.False,
+	},
+	Fromcurrent: grob.True,
+	Transition: &grob.AnimationTransition{
+		Duration: 300,
+		Easing:   grob.AnimationTransitionEasingQuadInOut,
+	},
+}
+
+// jumpToFrame moves to a frame without transition, used by the pause button and the slider.
+// It is a map because zero durations are omitted by grob.Animation
+var jumpToFrame = map[string]interface{}{
+	"mode":       grob.AnimationModeImmediate,
+	"frame":      map[string]interface{}{"duration": 0, "redraw": false},
+	"transition": map[string]interface{}{"duration": 0},
+}
+
+// withAnimationControls returns a copy of the figure with play and pause buttons and a slider to move between frames.
+// Frames without name are named after their index. Updatemenus and Sliders already defined in the layout are kept.
+func withAnimationControls(fig *grob.Fig) *grob.Fig {
+	if len(fig.Frames) == 0 {
+		return fig
+	}
+
+	animated := *fig
+	animated.Frames = make([]grob.Frame, len(fig.Frames))
+	copy(animated.Frames, fig.Frames)
+
+	layout := &grob.Layout{}
+	if fig.Layout != nil {
+		*layout = *fig.Layout
+	}
+	animated.Layout = layout
+
+	var animation interface{} = defaultAnimation
+	if fig.Animation != nil {
+		animation = fig.Animation
+	}
+
+	steps := make([]map[string]interface{}, len(animated.Frames))
+	for i := range animated.Frames {
+		if animated.Frames[i].Name == nil || animated.Frames[i].Name == "" {
+			animated.Frames[i].Name = strconv.Itoa(i)
+		}
+		name := animated.Frames[i].Name
+		steps[i] = map[string]interface{}{
+			"label":  name,
+			"method": "animate",
+			"args":   []interface{}{[]interface{}{name}, jumpToFrame},
+		}
+	}
+
+	if layout.Updatemenus == nil {
+		layout.Updatemenus = []map[string]interface{}{
+			{
+				"type":       "buttons",
+				"direction":  "left",
+				"showactive": false,
+				"x":          0.1,
+				"y":          0,
+				"xanchor":    "right",
+				"yanchor":    "top",
+				"pad":        map[string]interface{}{"r": 10, "t": 70},
+				"buttons": []map[string]interface{}{
+					{
+						"label":  "Play",
+						"method": "animate",
+						"args":   []interface{}{nil, animation},
+					},
+					{
+						"label":  "Pause",
+						"method": "animate",
+						"args":   []interface{}{[]interface{}{nil}, jumpToFrame},
+					},
+				},
+			},
+		}
+	}
+	if layout.Sliders == nil {
+		layout.Sliders = []map[string]interface{}{
+			{
+				"active":  0,
+				"x":       0.1,
+				"y":       0,
+				"len":     0.9,
+				"xanchor": "left",
+				"yanchor": "top",
+				"pad":     map[string]interface{}{"b": 10, "t": 50},
+				"steps":   steps,
+			},
+		}
+	}
+	return &animated
+}
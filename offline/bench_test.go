@@ -0,0 +1,62 @@
+package offline_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/offline"
+	"github.com/MetalBlueberry/go-plotly/themes"
+)
+
+// The benchmarks measure the rendering of HTML pages, run them with
+//
+//	go test ./offline -run xxx -bench . -benchmem
+
+func benchmarkFig(traces, points int) *grob.Fig {
+	fig := &grob.Fig{Layout: &grob.Layout{Title: &grob.LayoutTitle{Text: "Benchmark"}}}
+	for i := 0; i < traces; i++ {
+		y := make([]float64, points)
+		for j := range y {
+			y[j] = float64(i*j) / 3
+		}
+		fig.AddTraces(&grob.Scatter{Name: fmt.Sprintf("series %d", i), Y: y, Mode: grob.ScatterModeLines})
+	}
+	return fig
+}
+
+func BenchmarkWriteHtml(b *testing.B) {
+	cases := []struct {
+		name string
+		fig  *grob.Fig
+		opts offline.Options
+	}{
+		{name: "points=1e4", fig: benchmarkFig(1, 1e4)},
+		{name: "points=1e6", fig: benchmarkFig(1, 1e6)},
+		{name: "dashboard", fig: benchmarkFig(50, 100)},
+		{name: "dashboard/theme", fig: benchmarkFig(50, 100), opts: offline.Options{Theme: themes.Dark}},
+	}
+	for _, c := range cases {
+		b.Run(c.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				err := offline.WriteHtml(c.fig, ioutil.Discard, c.opts)
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkSnippet(b *testing.B) {
+	fig := benchmarkFig(50, 100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, err := offline.Snippet(fig, "plot")
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
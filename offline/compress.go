@@ -0,0 +1,52 @@
+package offline
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+)
+
+// Compression is the encoding used to compress the output
+type Compression string
+
+const (
+	// CompressionGzip is supported by every browser, files usually end with .gz
+	CompressionGzip Compression = "gzip"
+	// CompressionBrotli compresses better than gzip but it is slower, files usually end with .br
+	CompressionBrotli Compression = "br"
+)
+
+// compressWriter wraps w to write compressed content, the returned writer must be closed to flush the content
+func compressWriter(w io.Writer, c Compression) (io.WriteCloser, error) {
+	switch c {
+	case "":
+		return nopCloser{w}, nil
+	case CompressionGzip:
+		return gzip.NewWriter(w), nil
+	case CompressionBrotli:
+		return brotli.NewWriter(w), nil
+	default:
+		return nil, fmt.Errorf("unknown compression %s", c)
+	}
+}
+
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error { return nil }
+
+// acceptsEncoding tells if the request accepts the content encoding
+func acceptsEncoding(r *http.Request, c Compression) bool {
+	for _, accepted := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		encoding := strings.TrimSpace(strings.SplitN(accepted, ";", 2)[0])
+		if encoding == string(c) || encoding == "*" {
+			return true
+		}
+	}
+	return false
+}
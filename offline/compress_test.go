@@ -0,0 +1,104 @@
+package offline_test
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	"github.com/andybalholm/brotli"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/offline"
+)
+
+var _ = Describe("Compression", func() {
+
+	var (
+		dir string
+		fig *grob.Fig
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "compress")
+		Expect(err).To(BeNil())
+
+		fig = &grob.Fig{
+			Data: grob.Traces{
+				&grob.Scatter{
+					Type: grob.TraceTypeScatter,
+					Y:    make([]float64, 10000),
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("Should write gzip JSON", func() {
+		path := filepath.Join(dir, "fig.json.gz")
+		err := offline.ToJSON(fig, path, offline.Options{Compression: offline.CompressionGzip})
+		Expect(err).To(BeNil())
+
+		f, err := os.Open(path)
+		Expect(err).To(BeNil())
+		defer f.Close()
+		r, err := gzip.NewReader(f)
+		Expect(err).To(BeNil())
+		content, err := ioutil.ReadAll(r)
+		Expect(err).To(BeNil())
+		Expect(string(content)).To(HavePrefix(`{"data":[{"type":"scatter","y":[0,0,`))
+
+		info, err := f.Stat()
+		Expect(err).To(BeNil())
+		Expect(info.Size()).To(BeNumerically("<", len(content)/10))
+	})
+
+	It("Should write brotli HTML", func() {
+		path := filepath.Join(dir, "fig.html.br")
+		err := offline.ToHtml(fig, path, offline.Options{Compression: offline.CompressionBrotli})
+		Expect(err).To(BeNil())
+
+		f, err := os.Open(path)
+		Expect(err).To(BeNil())
+		defer f.Close()
+		content, err := ioutil.ReadAll(brotli.NewReader(f))
+		Expect(err).To(BeNil())
+		Expect(string(content)).To(ContainSubstring(`Plotly.newPlot('plot', data)`))
+	})
+
+	It("Should fail with an unknown compression", func() {
+		path := filepath.Join(dir, "fig.html.zip")
+		err := offline.ToHtml(fig, path, offline.Options{Compression: "zip"})
+		Expect(err).To(MatchError("unknown compression zip"))
+	})
+
+	It("Should serve compressed pages to browsers that accept it", func() {
+		srv, err := offline.NewServer(fig, offline.Options{Compression: offline.CompressionGzip})
+		Expect(err).To(BeNil())
+		ts := httptest.NewServer(srv)
+		defer ts.Close()
+
+		req, err := http.NewRequest(http.MethodGet, ts.URL, nil)
+		Expect(err).To(BeNil())
+		// setting the header disables the transparent decompression of the client
+		req.Header.Set("Accept-Encoding", "gzip, deflate")
+		resp, err := http.DefaultClient.Do(req)
+		Expect(err).To(BeNil())
+		defer resp.Body.Close()
+		Expect(resp.Header.Get("Content-Encoding")).To(Equal("gzip"))
+
+		r, err := gzip.NewReader(resp.Body)
+		Expect(err).To(BeNil())
+		content, err := ioutil.ReadAll(r)
+		Expect(err).To(BeNil())
+		Expect(string(content)).To(ContainSubstring(`Plotly.newPlot('plot', data)`))
+	})
+})
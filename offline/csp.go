@@ -0,0 +1,124 @@
+package offline
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// LoaderJSName is the name of the loader script written by ToHtmlCSP
+const LoaderJSName = "plotly-loader.js"
+
+// LoaderJS draws the figures of the elements with a data-plotly-figure attribute, that contains the URL of the figure JSON.
+// Serve it as a file to display figures on pages with a Content-Security-Policy that forbids inline scripts.
+const LoaderJS = `(function() {
+	function load(el) {
+		fetch(el.getAttribute('data-plotly-figure'))
+			.then(function(response) {
+				if (!response.ok) {
+					throw new Error('cannot load figure, status ' + response.status);
+				}
+				return response.json();
+			})
+			.then(function(fig) {
+				if (el.hasAttribute('data-plotly-responsive')) {
+					fig.config = Object.assign({}, fig.config, {responsive: true});
+				}
+				return Plotly.newPlot(el, fig);
+			})
+			.catch(function(err) {
+				console.error(err);
+			});
+	}
+	function loadAll() {
+		document.querySelectorAll('[data-plotly-figure]').forEach(load);
+	}
+	if (document.readyState === 'loading') {
+		document.addEventListener('DOMContentLoaded', loadAll);
+	} else {
+		loadAll();
+	}
+})();
+`
+
+// ToHtmlCSP saves the figure as an HTML page without inline scripts or styles, so it can be displayed under a strict Content-Security-Policy.
+// Next to the page, the figure is written as JSON with the same name and .json extension, and the loader script as LoaderJSName.
+// plotly.js must be loaded from an allowed source, use Options.PlotlyJSURL to point to it.
+// Options.CSS, Options.Template, Options.Width, Options.Height and Options.Compression are not used.
+func ToHtmlCSP(fig *grob.Fig, path string, opt ...Options) error {
+	opts := computeOptions(Options{}, opt...)
+	err := opts.checkDivID()
+	if err != nil {
+		return err
+	}
+
+	fig, err = opts.prepareFig(fig)
+	if err != nil {
+		return err
+	}
+	figBytes, err := json.Marshal(fig)
+	if err != nil {
+		return fmt.Errorf("cannot marshal figure, %w", err)
+	}
+	figPath := strings.TrimSuffix(path, filepath.Ext(path)) + ".json"
+	err = writeFile(figPath, figBytes, "")
+	if err != nil {
+		return err
+	}
+	err = writeFile(filepath.Join(filepath.Dir(path), LoaderJSName), []byte(LoaderJS), "")
+	if err != nil {
+		return err
+	}
+
+	tmpl, err := parseTemplate("csp", cspHtml)
+	if err != nil {
+		return err
+	}
+	buf := getBuffer()
+	defer putBuffer(buf)
+	err = tmpl.Execute(buf, cspData{
+		Title:       opts.Title,
+		DivID:       opts.divID(),
+		Head:        opts.Head,
+		PlotlyJSURL: opts.plotlyJSURL(),
+		LoaderURL:   LoaderJSName,
+		FigureURL:   filepath.Base(figPath),
+		Responsive:  opts.Responsive,
+	})
+	if err != nil {
+		return err
+	}
+	return writeFile(path, buf.Bytes(), "")
+}
+
+type cspData struct {
+	Title       string
+	DivID       string
+	Head        string
+	PlotlyJSURL string
+	LoaderURL   string
+	FigureURL   string
+	Responsive  bool
+}
+
+var cspHtml = `
+<html>
+	<head>
+		<meta charset="utf-8">
+		{{- if .Title }}
+		<title>{{ .Title | html }}</title>
+		{{- end }}
+		<script src="{{ .PlotlyJSURL }}"></script>
+		<script src="{{ .LoaderURL }}"></script>
+		{{- if .Head }}
+		{{ .Head }}
+		{{- end }}
+	</head>
+	<body>
+		<div id="{{ .DivID }}" data-plotly-figure="{{ .FigureURL | html }}"{{ if .Responsive }} data-plotly-responsive{{ end }}></div>
+	</body>
+</html>
+`
@@ -0,0 +1,57 @@
+package offline_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/offline"
+)
+
+var _ = Describe("CSP", func() {
+
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "csp")
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("Should write the page without inline scripts", func() {
+		err := offline.ToHtmlCSP(&grob.Fig{
+			Layout: &grob.Layout{
+				Title: &grob.LayoutTitle{Text: "secure"},
+			},
+		}, filepath.Join(dir, "secure.html"), offline.Options{
+			PlotlyJSURL: "/static/plotly.min.js",
+			Responsive:  true,
+		})
+		Expect(err).To(BeNil())
+
+		page, err := ioutil.ReadFile(filepath.Join(dir, "secure.html"))
+		Expect(err).To(BeNil())
+		Expect(string(page)).To(ContainSubstring(`<script src="/static/plotly.min.js"></script>`))
+		Expect(string(page)).To(ContainSubstring(`<script src="plotly-loader.js"></script>`))
+		Expect(string(page)).To(ContainSubstring(`<div id="plot" data-plotly-figure="secure.json" data-plotly-responsive></div>`))
+		Expect(strings.Count(string(page), "<script")).To(Equal(strings.Count(string(page), "<script src=")))
+		Expect(string(page)).NotTo(ContainSubstring("<style"))
+
+		figure, err := ioutil.ReadFile(filepath.Join(dir, "secure.json"))
+		Expect(err).To(BeNil())
+		Expect(string(figure)).To(ContainSubstring(`"text":"secure"`))
+
+		loader, err := ioutil.ReadFile(filepath.Join(dir, offline.LoaderJSName))
+		Expect(err).To(BeNil())
+		Expect(string(loader)).To(Equal(offline.LoaderJS))
+	})
+})
@@ -0,0 +1,188 @@
+package offline
+
+import (
+	"bytes"
+	"fmt"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// DashboardLayout describes how the figures of a dashboard are arranged
+type DashboardLayout struct {
+	// Title of the page
+	Title string
+	// Columns is the number of columns of the grid, defaults to 2. The grid collapses to a single column on small screens
+	Columns int
+	// Tabs of the dashboard, the tab bar is hidden if there is only one
+	Tabs []DashboardTab
+}
+
+// DashboardTab is a named grid of panels
+type DashboardTab struct {
+	Title  string
+	Panels []DashboardPanel
+}
+
+// DashboardPanel is a cell of the grid that displays a figure
+type DashboardPanel struct {
+	Fig *grob.Fig
+	// Title is displayed above the figure
+	Title string
+	// ColSpan and RowSpan are the number of columns and rows the panel takes, defaults to 1
+	ColSpan int
+	RowSpan int
+}
+
+// Dashboard saves multiple figures as a single HTML page. plotly.js is loaded only once from the location given by the Options.
+// Figures are displayed in a responsive grid and optionally split in tabs.
+func Dashboard(layout DashboardLayout, path string, opt ...Options) error {
+	opts := computeOptions(Options{}, opt...)
+
+	data := dashboardData{
+		Title:       layout.Title,
+		Columns:     layout.Columns,
+		PlotlyJSURL: opts.plotlyJSURL(),
+	}
+	if data.Columns <= 0 {
+		data.Columns = 2
+	}
+	for i, tab := range layout.Tabs {
+		tabData := dashboardTabData{
+			ID:    fmt.Sprintf("tab-%d", i),
+			Title: tab.Title,
+		}
+		for j, panel := range tab.Panels {
+			fig, err := opts.prepareFig(panel.Fig)
+			if err != nil {
+				return err
+			}
+			figure, err := scriptJSON(fig)
+			if err != nil {
+				return fmt.Errorf("cannot marshal figure %d of tab %d, %w", j, i, err)
+			}
+			tabData.Panels = append(tabData.Panels, dashboardPanelData{
+				ID:      fmt.Sprintf("plot-%d-%d", i, j),
+				Title:   panel.Title,
+				ColSpan: atLeastOne(panel.ColSpan),
+				RowSpan: atLeastOne(panel.RowSpan),
+				Figure:  figure,
+			})
+		}
+		data.Tabs = append(data.Tabs, tabData)
+	}
+
+	tmpl, err := parseTemplate("dashboard", dashboardHtml)
+	if err != nil {
+		return err
+	}
+	buf := getBuffer()
+	defer putBuffer(buf)
+	err = tmpl.Execute(buf, data)
+	if err != nil {
+		return err
+	}
+	return writeFile(path, buf.Bytes(), opts.Compression)
+}
+
+func atLeastOne(n int) int {
+	if n < 1 {
+		return 1
+	}
+	return n
+}
+
+// scriptJSON encodes the value to be used as a literal inside a script tag
+func scriptJSON(v interface{}) (string, error) {
+	buf, err := encodeJSON(v)
+	if err != nil {
+		return "", err
+	}
+	defer putBuffer(buf)
+	if !bytes.Contains(buf.Bytes(), []byte("</")) {
+		return buf.String(), nil
+	}
+	return string(bytes.ReplaceAll(buf.Bytes(), []byte("</"), []byte("<\\/"))), nil
+}
+
+type dashboardData struct {
+	Title       string
+	Columns     int
+	PlotlyJSURL string
+	Tabs        []dashboardTabData
+}
+
+type dashboardTabData struct {
+	ID     string
+	Title  string
+	Panels []dashboardPanelData
+}
+
+type dashboardPanelData struct {
+	ID      string
+	Title   string
+	ColSpan int
+	RowSpan int
+	Figure  string
+}
+
+var dashboardHtml = `
+<html>
+	<head>
+		<meta charset="utf-8">
+		<meta name="viewport" content="width=device-width, initial-scale=1">
+		<title>{{ .Title | html }}</title>
+		<script src="{{ .PlotlyJSURL }}"></script>
+		<style>
+			body { font-family: sans-serif; margin: 16px; }
+			.tabs { display: {{ if gt (len .Tabs) 1 }}flex{{ else }}none{{ end }}; gap: 4px; border-bottom: 1px solid #ccc; margin-bottom: 16px; }
+			.tabs button { border: none; background: none; padding: 8px 16px; cursor: pointer; }
+			.tabs button.active { border-bottom: 2px solid #636efa; }
+			.tab { display: none; }
+			.tab.active { display: grid; grid-template-columns: repeat({{ .Columns }}, minmax(0, 1fr)); grid-auto-rows: 450px; gap: 16px; }
+			.panel { display: flex; flex-direction: column; min-height: 0; }
+			.panel h3 { margin: 0 0 8px 0; }
+			.plot { flex: 1; min-height: 0; }
+			@media (max-width: 800px) {
+				.tab.active { grid-template-columns: minmax(0, 1fr); }
+				.panel { grid-column: auto !important; }
+			}
+		</style>
+	</head>
+	<body>
+		{{ if .Title }}<h1>{{ .Title | html }}</h1>{{ end }}
+		<div class="tabs">
+			{{- range $i, $tab := .Tabs }}
+			<button id="button-{{ $tab.ID }}" onclick="showTab('{{ $tab.ID }}')"{{ if eq $i 0 }} class="active"{{ end }}>{{ $tab.Title | html }}</button>
+			{{- end }}
+		</div>
+		{{- range $i, $tab := .Tabs }}
+		<div id="{{ $tab.ID }}" class="tab{{ if eq $i 0 }} active{{ end }}">
+			{{- range $tab.Panels }}
+			<div class="panel" style="grid-column: span {{ .ColSpan }}; grid-row: span {{ .RowSpan }};">
+				{{ if .Title }}<h3>{{ .Title | html }}</h3>{{ end }}
+				<div id="{{ .ID }}" class="plot"></div>
+			</div>
+			{{- end }}
+		</div>
+		{{- end }}
+		<script>
+			function plot(id, fig) {
+				fig.config = Object.assign({responsive: true}, fig.config);
+				Plotly.newPlot(id, fig);
+			}
+			function showTab(id) {
+				document.querySelectorAll('.tab, .tabs button').forEach(function(el) {
+					el.classList.toggle('active', el.id === id || el.id === 'button-' + id);
+				});
+				// plots rendered in hidden tabs must be resized once visible
+				document.querySelectorAll('#' + id + ' .plot').forEach(function(el) {
+					Plotly.Plots.resize(el);
+				});
+			}
+			{{- range .Tabs }}{{ range .Panels }}
+			plot('{{ .ID }}', {{ .Figure }});
+			{{- end }}{{ end }}
+		</script>
+	</body>
+</html>
+`
@@ -0,0 +1,64 @@
+package offline_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/offline"
+)
+
+var _ = Describe("Dashboard", func() {
+
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "dashboard")
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("Should render every figure in a single page", func() {
+		path := filepath.Join(dir, "dashboard.html")
+		err := offline.Dashboard(offline.DashboardLayout{
+			Title:   "Production",
+			Columns: 3,
+			Tabs: []offline.DashboardTab{
+				{
+					Title: "Temperatures",
+					Panels: []offline.DashboardPanel{
+						{Fig: &grob.Fig{Layout: &grob.Layout{Title: &grob.LayoutTitle{Text: "first"}}}, ColSpan: 2},
+						{Fig: &grob.Fig{Layout: &grob.Layout{Title: &grob.LayoutTitle{Text: "</script>"}}}},
+					},
+				},
+				{
+					Title: "Pressures",
+					Panels: []offline.DashboardPanel{
+						{Fig: &grob.Fig{Layout: &grob.Layout{Title: &grob.LayoutTitle{Text: "third"}}}, Title: "<b>Pressure</b>"},
+					},
+				},
+			},
+		}, path, offline.Options{PlotlyJSVersion: "2.0.0"})
+		Expect(err).To(BeNil())
+
+		content, err := ioutil.ReadFile(path)
+		Expect(err).To(BeNil())
+		html := string(content)
+
+		Expect(html).To(ContainSubstring(`plotly-2.0.0.min.js`))
+		Expect(html).To(ContainSubstring(`repeat(3, minmax(0, 1fr))`))
+		Expect(html).To(ContainSubstring(`grid-column: span 2`))
+		Expect(html).To(ContainSubstring(`plot('plot-0-0', {"layout":{"title":{"text":"first"}`))
+		Expect(html).To(ContainSubstring(`plot('plot-1-0', {"layout":{"title":{"text":"third"}`))
+		Expect(html).To(ContainSubstring(`<h3>&lt;b&gt;Pressure&lt;/b&gt;</h3>`))
+		Expect(html).NotTo(ContainSubstring(`"text":"</script>"`))
+	})
+})
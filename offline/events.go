@@ -0,0 +1,222 @@
+package offline
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/websocket"
+)
+
+// PointEvent is a point of a click, hover or selection event of plotly.js
+type PointEvent struct {
+	// Path of the figure displayed by the browser, like /temperatures
+	Path string `json:"-"`
+	// CurveNumber is the index of the trace of the point
+	CurveNumber int `json:"curveNumber"`
+	// PointNumber is the index of the point in the trace
+	PointNumber int `json:"pointNumber"`
+	// PointNumbers are the indexes of the points aggregated in the point, like the values of a histogram bin
+	PointNumbers []int       `json:"pointNumbers,omitempty"`
+	X            interface{} `json:"x,omitempty"`
+	Y            interface{} `json:"y,omitempty"`
+	Z            interface{} `json:"z,omitempty"`
+	Text         interface{} `json:"text,omitempty"`
+	CustomData   interface{} `json:"customdata,omitempty"`
+	// Label and Value are the slice of pie charts and the node of hierarchical charts
+	Label interface{} `json:"label,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// RelayoutEvent are the attributes of the layout changed in the browser, like the range of an axis after a zoom
+type RelayoutEvent struct {
+	// Path of the figure displayed by the browser, like /temperatures
+	Path string
+	// Changes are the new values by attribute path, like xaxis.range[0]
+	Changes map[string]interface{}
+}
+
+// eventHandlers are the handlers of the events of the browsers
+type eventHandlers struct {
+	click    func(points []PointEvent)
+	selected func(points []PointEvent)
+	hover    func(points []PointEvent)
+	relayout func(event RelayoutEvent)
+}
+
+// kinds returns the events forwarded by the browsers, those with a handler
+func (h eventHandlers) kinds() []string {
+	kinds := []string{}
+	if h.click != nil {
+		kinds = append(kinds, "click")
+	}
+	if h.selected != nil {
+		kinds = append(kinds, "selected")
+	}
+	if h.hover != nil {
+		kinds = append(kinds, "hover")
+	}
+	if h.relayout != nil {
+		kinds = append(kinds, "relayout")
+	}
+	return kinds
+}
+
+// OnClick calls the handler with the points clicked in the browsers, it replaces the previous handler.
+// The browsers forward the events over a WebSocket once their page is reloaded.
+//
+//	srv.OnClick(func(points []offline.PointEvent) {
+//		srv.Handle("/details", detailsChart(points[0].X))
+//	})
+func (s *Server) OnClick(handler func(points []PointEvent)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers.click = handler
+}
+
+// OnSelect calls the handler with the points selected in the browsers with the box or lasso tools,
+// or without points when the selection is cleared. It replaces the previous handler.
+func (s *Server) OnSelect(handler func(points []PointEvent)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers.selected = handler
+}
+
+// OnHover calls the handler with the points hovered in the browsers, it replaces the previous handler.
+// Hover events are frequent, the handler should return quickly.
+func (s *Server) OnHover(handler func(points []PointEvent)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers.hover = handler
+}
+
+// OnRelayout calls the handler with the layout changes made in the browsers, such as zooms and pans.
+// It replaces the previous handler.
+func (s *Server) OnRelayout(handler func(event RelayoutEvent)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers.relayout = handler
+}
+
+// browserEvent is an event sent by a browser
+type browserEvent struct {
+	Event   string                 `json:"event"`
+	Points  []PointEvent           `json:"points"`
+	Changes map[string]interface{} `json:"changes"`
+}
+
+// handleSocket receives the events of a browser displaying the figure at path and calls the handlers,
+// one event at a time in the order they are received
+func (s *Server) handleSocket(w http.ResponseWriter, r *http.Request, path string) {
+	server := websocket.Server{
+		Handshake: checkOrigin,
+		Handler: func(ws *websocket.Conn) {
+			defer ws.Close()
+			// hijacked connections are not closed by Shutdown
+			closed := make(chan struct{})
+			defer close(closed)
+			go func() {
+				select {
+				case <-s.done:
+					ws.Close()
+				case <-closed:
+				}
+			}()
+
+			for {
+				var e browserEvent
+				err := websocket.JSON.Receive(ws, &e)
+				if err != nil {
+					return
+				}
+				s.dispatch(path, e)
+			}
+		},
+	}
+	server.ServeHTTP(w, r)
+}
+
+// dispatch calls the handler of the event
+func (s *Server) dispatch(path string, e browserEvent) {
+	s.mu.Lock()
+	handlers := s.handlers
+	s.mu.Unlock()
+
+	for i := range e.Points {
+		e.Points[i].Path = path
+	}
+	switch {
+	case e.Event == "click" && handlers.click != nil:
+		handlers.click(e.Points)
+	case e.Event == "selected" && handlers.selected != nil:
+		handlers.selected(e.Points)
+	case e.Event == "hover" && handlers.hover != nil:
+		handlers.hover(e.Points)
+	case e.Event == "relayout" && handlers.relayout != nil:
+		handlers.relayout(RelayoutEvent{Path: path, Changes: e.Changes})
+	}
+}
+
+// checkOrigin only accepts the WebSockets of the pages of the server, other sites cannot send events on behalf of the user
+func checkOrigin(config *websocket.Config, r *http.Request) error {
+	origin, err := url.Parse(r.Header.Get("Origin"))
+	if err != nil || !strings.EqualFold(origin.Host, r.Host) {
+		return fmt.Errorf("origin %s is not allowed", r.Header.Get("Origin"))
+	}
+	config.Origin = origin
+	return nil
+}
+
+// eventScript forwards the events of the plot to the server. The WebSocket is served under the path of the page,
+// such as /temperatures/ws, and reconnected if the server restarts
+func eventScript(kinds []string, divID string) string {
+	if len(kinds) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(`
+			(function() {
+				var plot = document.getElementById('%s');
+				var socket;
+				function connect() {
+					socket = new WebSocket((location.protocol === 'https:' ? 'wss://' : 'ws://') + location.host + location.pathname.replace(/\/?$/, '/ws'));
+					socket.onclose = function() {
+						setTimeout(connect, 1000);
+					};
+				}
+				function send(message) {
+					if (socket.readyState === WebSocket.OPEN) {
+						socket.send(JSON.stringify(message));
+					}
+				}
+				// the points reference the traces of the plot, only the values are sent
+				function points(data) {
+					return ((data && data.points) || []).map(function(p) {
+						return {curveNumber: p.curveNumber, pointNumber: p.pointNumber, pointNumbers: p.pointNumbers, x: p.x, y: p.y, z: p.z,
+							text: p.text, customdata: p.customdata, label: p.label, value: p.value};
+					});
+				}
+				connect();
+				%s.forEach(function(kind) {
+					if (kind === 'relayout') {
+						plot.on('plotly_relayout', function(changes) {
+							send({event: kind, changes: changes});
+						});
+						return;
+					}
+					plot.on('plotly_' + kind, function(data) {
+						send({event: kind, points: points(data)});
+					});
+					if (kind === 'selected') {
+						plot.on('plotly_deselect', function() {
+							send({event: kind, points: []});
+						});
+					}
+				});
+			})();`, divID, jsStrings(kinds))
+}
+
+// jsStrings formats the strings, which are not escaped, as a JavaScript array
+func jsStrings(values []string) string {
+	return "['" + strings.Join(values, "', '") + "']"
+}
@@ -0,0 +1,108 @@
+package offline_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/websocket"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/offline"
+)
+
+var _ = Describe("Events", func() {
+
+	var (
+		srv *offline.Server
+		ts  *httptest.Server
+	)
+
+	BeforeEach(func() {
+		var err error
+		srv, err = offline.NewServer(nil)
+		Expect(err).To(BeNil())
+		Expect(srv.Handle("/sales", &grob.Fig{})).To(Succeed())
+		ts = httptest.NewServer(srv)
+	})
+
+	AfterEach(func() {
+		srv.Close()
+		ts.Close()
+	})
+
+	page := func(path string) string {
+		resp, err := http.Get(ts.URL + path)
+		Expect(err).To(BeNil())
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		Expect(err).To(BeNil())
+		return string(body)
+	}
+
+	dial := func(path, origin string) (*websocket.Conn, error) {
+		return websocket.Dial("ws"+strings.TrimPrefix(ts.URL, "http")+path, "", origin)
+	}
+
+	It("Should only forward the events with a handler", func() {
+		Expect(page("/sales")).NotTo(ContainSubstring(`new WebSocket(`))
+
+		srv.OnClick(func(points []offline.PointEvent) {})
+		srv.OnRelayout(func(event offline.RelayoutEvent) {})
+		html := page("/sales")
+		Expect(html).To(ContainSubstring(`new WebSocket(`))
+		Expect(html).To(ContainSubstring(`['click', 'relayout'].forEach(`))
+	})
+
+	It("Should call the handlers with the events of the browsers", func() {
+		clicks := make(chan []offline.PointEvent, 1)
+		selections := make(chan []offline.PointEvent, 1)
+		relayouts := make(chan offline.RelayoutEvent, 1)
+		srv.OnClick(func(points []offline.PointEvent) {
+			clicks <- points
+		})
+		srv.OnSelect(func(points []offline.PointEvent) {
+			selections <- points
+		})
+		srv.OnRelayout(func(event offline.RelayoutEvent) {
+			relayouts <- event
+		})
+
+		ws, err := dial("/sales/ws", ts.URL)
+		Expect(err).To(BeNil())
+		defer ws.Close()
+
+		Expect(websocket.Message.Send(ws, `{"event": "click", "points": [{"curveNumber": 1, "pointNumber": 3, "x": "2024-01-01", "y": 12.5, "customdata": "order-7"}]}`)).To(Succeed())
+		var points []offline.PointEvent
+		Eventually(clicks).Should(Receive(&points))
+		Expect(points).To(Equal([]offline.PointEvent{{
+			Path:        "/sales",
+			CurveNumber: 1,
+			PointNumber: 3,
+			X:           "2024-01-01",
+			Y:           12.5,
+			CustomData:  "order-7",
+		}}))
+
+		Expect(websocket.Message.Send(ws, `{"event": "selected", "points": []}`)).To(Succeed())
+		Eventually(selections).Should(Receive(BeEmpty()))
+
+		Expect(websocket.Message.Send(ws, `{"event": "relayout", "changes": {"xaxis.range[0]": 2}}`)).To(Succeed())
+		Eventually(relayouts).Should(Receive(Equal(offline.RelayoutEvent{
+			Path:    "/sales",
+			Changes: map[string]interface{}{"xaxis.range[0]": 2.0},
+		})))
+	})
+
+	It("Should reject the WebSockets of other sites", func() {
+		_, err := dial("/sales/ws", "http://example.com")
+		Expect(err).NotTo(BeNil())
+	})
+
+	It("Should reserve the paths of the WebSockets", func() {
+		Expect(srv.Handle("/sales/ws", &grob.Fig{})).NotTo(Succeed())
+	})
+})
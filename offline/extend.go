@@ -0,0 +1,156 @@
+package offline
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ExtendOptions configure the points appended by Extend
+type ExtendOptions struct {
+	// MaxPoints keeps only the last points of the trace, 0 keeps them all
+	MaxPoints int
+}
+
+// extension are points appended to a trace
+type extension struct {
+	Trace     int         `json:"trace"`
+	X         interface{} `json:"x,omitempty"`
+	Y         interface{} `json:"y,omitempty"`
+	MaxPoints int         `json:"maxPoints,omitempty"`
+}
+
+// Extend appends the points to the trace of the figure served at /, see HandleExtend
+func (s *Server) Extend(trace int, x, y interface{}, opt ...ExtendOptions) error {
+	return s.HandleExtend("/", trace, x, y, opt...)
+}
+
+// HandleExtend appends the points to the trace of the figure served at the path, the browsers call Plotly.extendTraces
+// instead of rendering the whole figure again, so high rate data can be appended to live charts. x and y are slices of the same length,
+// x can be nil for traces plotted against the index of the points. The points are added to the figure served to the browsers
+// that open the page later, but not to the *grob.Fig given to Handle, the next call to Handle replaces them.
+//
+//	err := srv.Extend(0, []time.Time{t}, []float64{temperature}, offline.ExtendOptions{MaxPoints: 1000})
+func (s *Server) HandleExtend(path string, trace int, x, y interface{}, opt ...ExtendOptions) error {
+	path, err := livePath(path)
+	if err != nil {
+		return err
+	}
+	opts := ExtendOptions{}
+	if len(opt) == 1 {
+		opts = opt[0]
+	}
+
+	length := -1
+	for _, values := range []interface{}{x, y} {
+		if values == nil {
+			continue
+		}
+		value := reflect.ValueOf(values)
+		if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+			return fmt.Errorf("cannot extend trace %d with %T, the values must be a slice", trace, values)
+		}
+		if length >= 0 && value.Len() != length {
+			return fmt.Errorf("cannot extend trace %d with %d x values and %d y values", trace, length, value.Len())
+		}
+		length = value.Len()
+	}
+	if length < 0 {
+		return errors.New("the x or y values are required")
+	}
+	data, err := json.Marshal(extension{
+		Trace:     trace,
+		X:         x,
+		Y:         y,
+		MaxPoints: opts.MaxPoints,
+	})
+	if err != nil {
+		return fmt.Errorf("cannot marshal points, %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	live, ok := s.figures[path]
+	if !ok {
+		return fmt.Errorf("path %s has no figure", path)
+	}
+	if trace < 0 || trace >= live.traces {
+		return fmt.Errorf("cannot extend trace %d, the figure has %d traces", trace, live.traces)
+	}
+	live.pending = append(live.pending, data)
+	for client := range live.clients {
+		select {
+		case client <- event{name: "extend", data: data}:
+		default:
+			// the client is still busy with a previous version, replace it with the whole figure so no point is lost
+			figBytes, err := live.extended()
+			if err != nil {
+				return err
+			}
+			select {
+			case <-client:
+			default:
+			}
+			client <- event{data: figBytes}
+		}
+	}
+	return nil
+}
+
+// extended appends the pending points to the figure and returns it, s.mu must be held.
+// The points are appended when the figure is needed, so the figure is not marshaled on every call to Extend
+func (live *liveFigure) extended() ([]byte, error) {
+	if len(live.pending) == 0 {
+		return live.fig, nil
+	}
+
+	var fig map[string]interface{}
+	err := decodeNumbers(live.fig, &fig)
+	if err != nil {
+		return nil, fmt.Errorf("cannot extend figure, %w", err)
+	}
+	traces, _ := fig["data"].([]interface{})
+	for _, data := range live.pending {
+		var ext struct {
+			Trace     int
+			X, Y      []interface{}
+			MaxPoints int
+		}
+		err := decodeNumbers(data, &ext)
+		if err != nil {
+			return nil, fmt.Errorf("cannot extend figure, %w", err)
+		}
+		trace, ok := traces[ext.Trace].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for key, values := range map[string][]interface{}{"x": ext.X, "y": ext.Y} {
+			if values == nil {
+				continue
+			}
+			points, _ := trace[key].([]interface{})
+			points = append(points, values...)
+			if ext.MaxPoints > 0 && len(points) > ext.MaxPoints {
+				points = points[len(points)-ext.MaxPoints:]
+			}
+			trace[key] = points
+		}
+	}
+
+	figBytes, err := json.Marshal(fig)
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal figure, %w", err)
+	}
+	live.fig = figBytes
+	live.pending = nil
+	return figBytes, nil
+}
+
+// decodeNumbers decodes the JSON keeping the numbers as written, so they are encoded again without loss
+func decodeNumbers(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
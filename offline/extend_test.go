@@ -0,0 +1,100 @@
+package offline_test
+
+import (
+	"bufio"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/offline"
+)
+
+var _ = Describe("Extend", func() {
+
+	var (
+		srv *offline.Server
+		ts  *httptest.Server
+	)
+
+	BeforeEach(func() {
+		fig := &grob.Fig{}
+		fig.AddScatter([]float64{1, 2}, []float64{10, 20})
+		var err error
+		srv, err = offline.NewServer(fig)
+		Expect(err).To(BeNil())
+		ts = httptest.NewServer(srv)
+	})
+
+	AfterEach(func() {
+		ts.Close()
+	})
+
+	It("Should push the points to the browser", func() {
+		resp, err := http.Get(ts.URL + "/events")
+		Expect(err).To(BeNil())
+		defer resp.Body.Close()
+
+		Expect(srv.Extend(0, []float64{3}, []float64{30}, offline.ExtendOptions{MaxPoints: 100})).To(Succeed())
+
+		reader := bufio.NewReader(resp.Body)
+		line, err := reader.ReadString('\n')
+		Expect(err).To(BeNil())
+		Expect(line).To(Equal("event: extend\n"))
+		line, err = reader.ReadString('\n')
+		Expect(err).To(BeNil())
+		Expect(line).To(Equal(`data: {"trace":0,"x":[3],"y":[30],"maxPoints":100}` + "\n"))
+	})
+
+	It("Should serve the extended figure to new browsers", func() {
+		Expect(srv.Extend(0, []float64{3}, []float64{30})).To(Succeed())
+		Expect(srv.Extend(0, []float64{4, 5}, []float64{40, 50}, offline.ExtendOptions{MaxPoints: 3})).To(Succeed())
+
+		resp, err := http.Get(ts.URL)
+		Expect(err).To(BeNil())
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		Expect(err).To(BeNil())
+		Expect(string(body)).To(ContainSubstring(`"x":[3,4,5]`))
+		Expect(string(body)).To(ContainSubstring(`"y":[30,40,50]`))
+		Expect(string(body)).To(ContainSubstring(`addEventListener('extend'`))
+	})
+
+	It("Should extend only y", func() {
+		Expect(srv.Extend(0, nil, []float64{30})).To(Succeed())
+
+		resp, err := http.Get(ts.URL)
+		Expect(err).To(BeNil())
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		Expect(err).To(BeNil())
+		Expect(string(body)).To(ContainSubstring(`"x":[1,2]`))
+		Expect(string(body)).To(ContainSubstring(`"y":[10,20,30]`))
+	})
+
+	It("Should replace the points when the figure is updated", func() {
+		Expect(srv.Extend(0, []float64{3}, []float64{30})).To(Succeed())
+		fig := &grob.Fig{}
+		fig.AddScatter([]float64{7}, []float64{70})
+		Expect(srv.Update(fig)).To(Succeed())
+
+		resp, err := http.Get(ts.URL)
+		Expect(err).To(BeNil())
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		Expect(err).To(BeNil())
+		Expect(string(body)).To(ContainSubstring(`"x":[7]`))
+		Expect(string(body)).To(ContainSubstring(`"y":[70]`))
+	})
+
+	It("Should reject invalid points", func() {
+		Expect(srv.Extend(1, []float64{3}, []float64{30})).NotTo(Succeed())
+		Expect(srv.Extend(0, []float64{3}, []float64{30, 40})).NotTo(Succeed())
+		Expect(srv.Extend(0, 3, 30)).NotTo(Succeed())
+		Expect(srv.Extend(0, nil, nil)).NotTo(Succeed())
+		Expect(srv.HandleExtend("/missing", 0, nil, []float64{30})).NotTo(Succeed())
+	})
+})
@@ -3,63 +3,438 @@ package offline
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
-	"net/http"
 	"os"
+	"regexp"
+	"strings"
 	"text/template"
 
 	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
 	"github.com/pkg/browser"
 )
 
+// DefaultPlotlyJSVersion is the version of plotly.js loaded from the CDN when no other version or URL is given
+const DefaultPlotlyJSVersion = "1.58.4"
+
 type Options struct {
 	Addr string
+
+	// PlotlyJSVersion is the version of plotly.js loaded from the CDN, defaults to DefaultPlotlyJSVersion
+	PlotlyJSVersion string
+	// PlotlyJSURL is the URL of the plotly.js script, such as a corporate mirror or a locally served asset.
+	// It takes precedence over PlotlyJSVersion
+	PlotlyJSURL string
+
+	// PlotlyJSPath is the path to a local plotly.js bundle, for example plotly-1.58.4.min.js, used by ToHtmlStandalone
+	PlotlyJSPath string
+	// PlotlyJS is the content of a plotly.js bundle, used by ToHtmlStandalone. Useful to go:embed the bundle in your binary.
+	// It takes precedence over PlotlyJSPath
+	PlotlyJS []byte
+
+	// Title is the title of the HTML page
+	Title string
+	// DivID is the id of the div that holds the plot, defaults to plot. It is used as is in the HTML, the scripts and the CSS of the page,
+	// so it must start with a letter followed by letters, digits, - or _
+	DivID string
+	// Head is raw HTML added to the head of the page, such as meta, link or script tags
+	Head string
+	// CSS is added to the head of the page inside a style tag
+	CSS string
+	// Template replaces the HTML page. It is parsed with text/template and executed with the fields
+	// Title, DivID, Head, CSS, PlotlyJSURL, PlotlyJS (the bundle inlined by ToHtmlStandalone, empty otherwise), LocaleURL (empty for English),
+	// LocaleJS (the locale bundle inlined by ToHtmlStandalone), Figure (a JavaScript object literal), Style (of the plot div),
+	// Responsive, Script (code that must run after the plot is created), AltText and DataTable (HTML, empty without Options.DataTable)
+	Template string
+
+	// AltText describes the chart to the readers that cannot see it, such as the users of screen readers.
+	// It is the aria-label of the plot and its caption below it
+	AltText string
+	// DataTable adds a collapsible table with the values of every trace after the plot, so the data is accessible without the chart.
+	// Used by ToHtml, WriteHtml, ToHtmlStandalone and Show, not by the live pages of Server
+	DataTable bool
+
+	// Responsive makes the plot fill the div and follow the window size. It sets config.responsive without modifying the figure.
+	// The div fills the whole page unless Width or Height are given
+	Responsive bool
+	// Width and Height of the plot div as CSS values, such as 800px, 100% or 50vh
+	Width  string
+	Height string
+
+	// Compression compresses the files written by ToHtml, ToHtmlStandalone, ToJSON and Dashboard, the path should end with .gz or .br.
+	// Serve compresses the page when the browser accepts the encoding
+	Compression Compression
+
+	// Theme is set as the layout template of figures without template when they are rendered. The figure is not modified.
+	// See the themes package for ready to use templates
+	Theme *grob.Template
+
+	// Print sets a fixed physical size, disables the interactivity and embeds fonts,
+	// so the page prints consistently or can be converted to PDF. The figure is not modified
+	Print *PrintOptions
+
+	// AnimationControls adds play and pause buttons and a slider over the frames of animated figures,
+	// unless the layout already defines updatemenus or sliders. The figure is not modified
+	AnimationControls bool
+
+	// Locale sets config.locale, so numbers, dates and the mode bar are in the language of the locale, and loads
+	// the plotly.js locale bundle after plotly.js. Defaults to the locale of the figure config. The figure is not modified
+	Locale grob.ConfigLocale
+	// LocaleURL is the URL of the locale bundle, such as a corporate mirror. Defaults to the bundle of the plotly.js version on jsDelivr
+	LocaleURL string
+	// LocaleJS is the content of the locale bundle, inlined by ToHtmlStandalone and served by Serve. It takes precedence over LocaleURL
+	LocaleJS []byte
 }
 
 // ToHtml saves the figure as standalone HTML. It still requires internet to load plotly.js from CDN.
-func ToHtml(fig *grob.Fig, path string) {
-	buf := figToBuffer(fig)
-	ioutil.WriteFile(path, buf.Bytes(), os.ModePerm)
+// Use Options.PlotlyJSVersion or Options.PlotlyJSURL to choose where plotly.js is loaded from
+func ToHtml(fig *grob.Fig, path string, opt ...Options) error {
+	opts := computeOptions(Options{}, opt...)
+	buf := getBuffer()
+	defer putBuffer(buf)
+	err := writeHtml(fig, buf, opts, "", false)
+	if err != nil {
+		return err
+	}
+	return writeFile(path, buf.Bytes(), opts.Compression)
+}
+
+// ToJSON saves the figure as JSON, ready to be loaded with Plotly.newPlot
+func ToJSON(fig *grob.Fig, path string, opt ...Options) error {
+	opts := computeOptions(Options{}, opt...)
+	fig, err := opts.prepareFig(fig)
+	if err != nil {
+		return err
+	}
+	figBytes, err := json.Marshal(fig)
+	if err != nil {
+		return fmt.Errorf("cannot marshal figure, %w", err)
+	}
+	return writeFile(path, figBytes, opts.Compression)
+}
+
+// WriteJSON writes the figure as JSON to w, ready to be loaded with Plotly.newPlot, with the options that modify the figure applied
+func WriteJSON(fig *grob.Fig, w io.Writer, opt ...Options) error {
+	opts := computeOptions(Options{}, opt...)
+	fig, err := opts.prepareFig(fig)
+	if err != nil {
+		return err
+	}
+	figBytes, err := json.Marshal(fig)
+	if err != nil {
+		return fmt.Errorf("cannot marshal figure, %w", err)
+	}
+	_, err = w.Write(figBytes)
+	return err
+}
+
+// writeFile writes the content to path compressed with the given compression
+func writeFile(path string, content []byte, compression Compression) error {
+	if compression == "" {
+		return ioutil.WriteFile(path, content, os.ModePerm)
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, os.ModePerm)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	cw, err := compressWriter(f, compression)
+	if err != nil {
+		return err
+	}
+	_, err = cw.Write(content)
+	if err != nil {
+		return err
+	}
+	err = cw.Close()
+	if err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+// WriteHtml writes the figure as an HTML page to w, for example an http.ResponseWriter.
+// Like ToHtml, it still requires internet to load plotly.js from CDN.
+func WriteHtml(fig *grob.Fig, w io.Writer, opt ...Options) error {
+	return writeHtml(fig, w, computeOptions(Options{}, opt...), "", false)
+}
+
+// ToHtmlStandalone saves the figure as HTML with plotly.js inlined, so it can be displayed without network access.
+// The page is rendered like ToHtml, with the same options, and the plotly.js bundle must be provided with Options.PlotlyJS
+// or Options.PlotlyJSPath. The locale bundle is inlined if given with Options.LocaleJS, otherwise it is loaded from Options.LocaleURL or the CDN.
+func ToHtmlStandalone(fig *grob.Fig, path string, opt ...Options) error {
+	opts := computeOptions(Options{}, opt...)
+
+	if opts.PlotlyJS == nil {
+		if opts.PlotlyJSPath == "" {
+			return errors.New("plotly.js bundle is required, set Options.PlotlyJS or Options.PlotlyJSPath")
+		}
+		var err error
+		opts.PlotlyJS, err = ioutil.ReadFile(opts.PlotlyJSPath)
+		if err != nil {
+			return fmt.Errorf("cannot read plotly.js bundle, %w", err)
+		}
+	}
+	if len(opts.PlotlyJS) == 0 {
+		return errors.New("plotly.js bundle is empty")
+	}
+
+	buf := getBuffer()
+	defer putBuffer(buf)
+	err := writeHtml(fig, buf, opts, "", true)
+	if err != nil {
+		return err
+	}
+	return writeFile(path, buf.Bytes(), opts.Compression)
 }
 
 // Show displays the figure in your browser.
 // Use serve if you want a persistent view
-func Show(fig *grob.Fig) {
-	buf := figToBuffer(fig)
+func Show(fig *grob.Fig, opt ...Options) {
+	buf := figToBuffer(fig, computeOptions(Options{}, opt...))
 	browser.OpenReader(buf)
 }
 
-func figToBuffer(fig *grob.Fig) *bytes.Buffer {
-	figBytes, err := json.Marshal(fig)
+// figToBuffer renders the page in a pooled buffer
+func figToBuffer(fig *grob.Fig, opts Options) *bytes.Buffer {
+	buf := getBuffer()
+	err := writeHtml(fig, buf, opts, "", false)
 	if err != nil {
 		panic(err)
 	}
-	tmpl, err := template.New("plotly").Parse(baseHtml)
+	return buf
+}
+
+// writeHtml renders the page template, fig can be any value that marshals to a figure. The script is added after the plot is created.
+// With inline, the plotly.js bundle and the locale bundle of the options, if any, are inlined instead of loaded from their URL
+func writeHtml(fig interface{}, w io.Writer, opts Options, script string, inline bool) error {
+	err := opts.checkDivID()
+	if err != nil {
+		return err
+	}
+	locale := opts.Locale
+	if f, ok := fig.(*grob.Fig); ok {
+		f, err := opts.prepareFig(f)
+		if err != nil {
+			return err
+		}
+		locale = opts.locale(f)
+		fig = f
+	}
+	figure, err := scriptJSON(fig)
 	if err != nil {
-		panic(err)
+		return fmt.Errorf("cannot marshal figure, %w", err)
 	}
-	buf := &bytes.Buffer{}
-	tmpl.Execute(buf, string(figBytes))
-	return buf
+	table := ""
+	if f, ok := fig.(*grob.Fig); ok && opts.DataTable {
+		table, err = dataTable(f)
+		if err != nil {
+			return err
+		}
+	}
+	css := opts.CSS
+	if opts.Print != nil {
+		printCSS, err := opts.Print.css(opts.divID())
+		if err != nil {
+			return err
+		}
+		css = printCSS + css
+	}
+	tmpl, err := opts.pageTemplate()
+	if err != nil {
+		return err
+	}
+	data := htmlData{
+		Title:       opts.Title,
+		DivID:       opts.divID(),
+		Head:        opts.Head,
+		CSS:         css,
+		PlotlyJSURL: opts.plotlyJSURL(),
+		LocaleURL:   opts.localeURL(locale),
+		Figure:      figure,
+		Style:       opts.divStyle(),
+		Responsive:  opts.Responsive,
+		Script:      script,
+		AltText:     opts.AltText,
+		DataTable:   table,
+	}
+	if inline {
+		data.PlotlyJS = inlineScript(opts.PlotlyJS)
+		if opts.LocaleJS != nil && locale != "" {
+			data.LocaleJS = inlineScript(opts.LocaleJS)
+			data.LocaleURL = ""
+		}
+	}
+	return tmpl.Execute(w, data)
+}
+
+// pageTemplate returns the custom template of the options, parsed on every call, or the cached built-in page
+func (opts Options) pageTemplate() (*template.Template, error) {
+	if opts.Template == "" {
+		return parseTemplate("plotly", baseHtml)
+	}
+	return template.New("plotly").Parse(opts.Template)
+}
+
+// inlineScript returns the script ready to be inlined in a script tag, which it must not close from inside
+func inlineScript(script []byte) string {
+	return strings.ReplaceAll(string(script), "</script", "<\\/script")
+}
+
+type htmlData struct {
+	Title       string
+	DivID       string
+	Head        string
+	CSS         string
+	PlotlyJSURL string
+	PlotlyJS    string
+	LocaleURL   string
+	LocaleJS    string
+	Figure      string
+	Style       string
+	Responsive  bool
+	Script      string
+	AltText     string
+	DataTable   string
+}
+
+// prepareFig applies the options that modify the figure, and the default template of grob, to a copy of it
+func (opts Options) prepareFig(fig *grob.Fig) (*grob.Fig, error) {
+	if opts.Theme != nil {
+		fig = withTheme(fig, opts.Theme)
+	}
+	fig, err := fig.WithDefaultTemplate()
+	if err != nil {
+		return nil, err
+	}
+	if opts.AnimationControls {
+		fig = withAnimationControls(fig)
+	}
+	if opts.Print != nil {
+		fig = withPrintLayout(fig, opts.Print)
+	}
+	if opts.Locale != "" {
+		fig = withLocale(fig, opts.Locale)
+	}
+	return fig, nil
+}
+
+// withTheme returns a copy of the figure that uses the theme as template, unless it already has one
+func withTheme(fig *grob.Fig, theme *grob.Template) *grob.Fig {
+	if fig.Layout != nil && fig.Layout.Template != nil {
+		return fig
+	}
+	themed := *fig
+	layout := &grob.Layout{}
+	if fig.Layout != nil {
+		*layout = *fig.Layout
+	}
+	layout.Template = theme
+	themed.Layout = layout
+	return &themed
+}
+
+// withLocale returns a copy of the figure with the locale in its config
+func withLocale(fig *grob.Fig, locale grob.ConfigLocale) *grob.Fig {
+	localized := *fig
+	config := &grob.Config{}
+	if fig.Config != nil {
+		*config = *fig.Config
+	}
+	config.Locale = locale
+	localized.Config = config
+	return &localized
+}
+
+// locale returns the locale of the page, from the options or the config of the figure
+func (opts Options) locale(fig *grob.Fig) grob.ConfigLocale {
+	if opts.Locale != "" || fig == nil || fig.Config == nil {
+		return opts.Locale
+	}
+	return fig.Config.Locale
+}
+
+// localeURL returns the URL to load the bundle of the locale from, empty for the locales built in plotly.js
+func (opts Options) localeURL(locale grob.ConfigLocale) string {
+	if locale == "" || locale == grob.ConfigLocaleEn || locale == grob.ConfigLocaleEnUS {
+		return ""
+	}
+	if opts.LocaleURL != "" {
+		return opts.LocaleURL
+	}
+	version := opts.PlotlyJSVersion
+	if version == "" {
+		version = DefaultPlotlyJSVersion
+	}
+	// the bundles are named after the locale in lower case, like plotly-locale-de-ch.js
+	return "https://cdn.jsdelivr.net/npm/plotly.js@" + version + "/dist/plotly-locale-" + strings.ToLower(string(locale)) + ".js"
+}
+
+// divStyle returns the CSS size of the plot div, responsive plots fill the page by default
+func (opts Options) divStyle() string {
+	width, height := opts.Width, opts.Height
+	if opts.Responsive {
+		if width == "" {
+			width = "100%"
+		}
+		if height == "" {
+			height = "100vh"
+		}
+	}
+	style := ""
+	if width != "" {
+		style += "width: " + width + ";"
+	}
+	if height != "" {
+		style += "height: " + height + ";"
+	}
+	return style
+}
+
+// divIDPattern matches the div ids that are safe in HTML attributes, JavaScript strings and CSS selectors without escaping
+var divIDPattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]*$`)
+
+// checkDivID returns an error if Options.DivID cannot be used as is in the page
+func (opts Options) checkDivID() error {
+	if opts.DivID != "" && !divIDPattern.MatchString(opts.DivID) {
+		return fmt.Errorf("the div id must start with a letter followed by letters, digits, - or _, got %q", opts.DivID)
+	}
+	return nil
+}
+
+func (opts Options) divID() string {
+	if opts.DivID != "" {
+		return opts.DivID
+	}
+	return "plot"
+}
+
+// plotlyJSURL returns the URL to load plotly.js from
+func (opts Options) plotlyJSURL() string {
+	if opts.PlotlyJSURL != "" {
+		return opts.PlotlyJSURL
+	}
+	version := opts.PlotlyJSVersion
+	if version == "" {
+		version = DefaultPlotlyJSVersion
+	}
+	return "https://cdn.plot.ly/plotly-" + version + ".min.js"
 }
 
 // Serve creates a local web server that displays the image using plotly.js
 // Is a good alternative to Show to avoid creating tmp files.
+// If a local plotly.js bundle is given with Options.PlotlyJS or Options.PlotlyJSPath, it is served by the same server.
+// Use NewServer to keep a reference to the server and push figure updates to the browser.
 func Serve(fig *grob.Fig, opt ...Options) {
-	opts := computeOptions(Options{
-		Addr: "localhost:8080",
-	}, opt...)
-
-	mux := &http.ServeMux{}
-	srv := &http.Server{
-		Handler: mux,
-		Addr:    opts.Addr,
+	srv, err := NewServer(fig, opt...)
+	if err != nil {
+		log.Print(err)
+		return
 	}
-	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
-		buf := figToBuffer(fig)
-		buf.WriteTo(w)
-	})
 
 	log.Print("Starting server")
 	if err := srv.ListenAndServe(); err != nil {
@@ -74,19 +449,133 @@ func computeOptions(def Options, opt ...Options) Options {
 		if opts.Addr != "" {
 			def.Addr = opts.Addr
 		}
+		if opts.PlotlyJSVersion != "" {
+			def.PlotlyJSVersion = opts.PlotlyJSVersion
+		}
+		if opts.PlotlyJSURL != "" {
+			def.PlotlyJSURL = opts.PlotlyJSURL
+		}
+		if opts.PlotlyJSPath != "" {
+			def.PlotlyJSPath = opts.PlotlyJSPath
+		}
+		if opts.PlotlyJS != nil {
+			def.PlotlyJS = opts.PlotlyJS
+		}
+		if opts.Title != "" {
+			def.Title = opts.Title
+		}
+		if opts.DivID != "" {
+			def.DivID = opts.DivID
+		}
+		if opts.Head != "" {
+			def.Head = opts.Head
+		}
+		if opts.CSS != "" {
+			def.CSS = opts.CSS
+		}
+		if opts.Template != "" {
+			def.Template = opts.Template
+		}
+		if opts.Responsive {
+			def.Responsive = opts.Responsive
+		}
+		if opts.Width != "" {
+			def.Width = opts.Width
+		}
+		if opts.Height != "" {
+			def.Height = opts.Height
+		}
+		if opts.AnimationControls {
+			def.AnimationControls = opts.AnimationControls
+		}
+		if opts.Compression != "" {
+			def.Compression = opts.Compression
+		}
+		if opts.Theme != nil {
+			def.Theme = opts.Theme
+		}
+		if opts.Print != nil {
+			def.Print = opts.Print
+		}
+		if opts.Locale != "" {
+			def.Locale = opts.Locale
+		}
+		if opts.LocaleURL != "" {
+			def.LocaleURL = opts.LocaleURL
+		}
+		if opts.LocaleJS != nil {
+			def.LocaleJS = opts.LocaleJS
+		}
+		if opts.AltText != "" {
+			def.AltText = opts.AltText
+		}
+		if opts.DataTable {
+			def.DataTable = opts.DataTable
+		}
 	}
 	return def
 }
 
 var baseHtml = `
+<html>
 	<head>
-		<script src="https://cdn.plot.ly/plotly-1.58.4.min.js"></script>
+		<meta charset="utf-8">
+		{{- if .Title }}
+		<title>{{ .Title | html }}</title>
+		{{- end }}
+		{{- if .PlotlyJS }}
+		<script type="text/javascript">{{ .PlotlyJS }}</script>
+		{{- else }}
+		<script src="{{ .PlotlyJSURL }}"></script>
+		{{- end }}
+		{{- if .LocaleJS }}
+		<script type="text/javascript">{{ .LocaleJS }}</script>
+		{{- else if .LocaleURL }}
+		<script src="{{ .LocaleURL }}"></script>
+		{{- end }}
+		{{- if .Head }}
+		{{ .Head }}
+		{{- end }}
+		{{- if .Responsive }}
+		<style>html, body { margin: 0; height: 100%; }</style>
+		{{- end }}
+		{{- if .CSS }}
+		<style>{{ .CSS }}</style>
+		{{- end }}
 	</head>
-	</body>
-		<div id="plot"></div>
-	<script>
-		data = JSON.parse('{{ . }}')
-		Plotly.newPlot('plot', data);
-	</script>
 	<body>
-	`
+		{{- if .AltText }}
+		<figure style="margin: 0;">
+		{{- end }}
+		<div id="{{ .DivID }}"{{ if .Style }} style="{{ .Style }}"{{ end }}{{ if .AltText }} role="img" aria-label="{{ .AltText | html }}"{{ end }}></div>
+		{{- if .AltText }}
+		<figcaption>{{ .AltText | html }}</figcaption>
+		</figure>
+		{{- end }}
+		{{- if .DataTable }}
+		{{ .DataTable }}
+		{{- end }}
+		<script>
+			data = {{ .Figure }};
+			{{- if .Responsive }}
+			data.config = Object.assign({}, data.config, {responsive: true});
+			{{- end }}
+			frames = data.frames;
+			delete data.frames;
+			Plotly.newPlot('{{ .DivID }}', data).then(function(gd) {
+				if (frames) {
+					return Plotly.addFrames(gd, frames);
+				}
+			});
+			{{- if .Responsive }}
+			window.addEventListener('resize', function() {
+				Plotly.Plots.resize('{{ .DivID }}');
+			});
+			{{- end }}
+			{{- if .Script }}
+			{{ .Script }}
+			{{- end }}
+		</script>
+	</body>
+</html>
+`
@@ -0,0 +1,377 @@
+package offline_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/offline"
+	"github.com/MetalBlueberry/go-plotly/themes"
+)
+
+var _ = Describe("Plot", func() {
+
+	var fig *grob.Fig
+
+	BeforeEach(func() {
+		fig = &grob.Fig{
+			Data: grob.Traces{
+				&grob.Bar{
+					Type: grob.TraceTypeBar,
+					X:    []float64{1, 2, 3},
+					Y:    []float64{1, 2, 3},
+				},
+			},
+		}
+	})
+
+	It("Should write the page", func() {
+		buf := &bytes.Buffer{}
+		err := offline.WriteHtml(fig, buf)
+		Expect(err).To(BeNil())
+		Expect(buf.String()).To(ContainSubstring(`<script src="https://cdn.plot.ly/plotly-1.58.4.min.js"></script>`))
+		Expect(buf.String()).To(ContainSubstring(`<div id="plot"></div>`))
+		Expect(buf.String()).To(ContainSubstring(`data = {"data":[{"type":"bar","x":[1,2,3],"y":[1,2,3]}]};`))
+	})
+
+	DescribeTable("Should load plotly.js from the options",
+		func(opts offline.Options, src string) {
+			buf := &bytes.Buffer{}
+			err := offline.WriteHtml(fig, buf, opts)
+			Expect(err).To(BeNil())
+			Expect(buf.String()).To(ContainSubstring(`<script src="` + src + `"></script>`))
+			Expect(strings.Count(buf.String(), "<script src=")).To(Equal(1))
+		},
+		Entry("default version", offline.Options{}, "https://cdn.plot.ly/plotly-"+offline.DefaultPlotlyJSVersion+".min.js"),
+		Entry("version", offline.Options{PlotlyJSVersion: "2.27.0"}, "https://cdn.plot.ly/plotly-2.27.0.min.js"),
+		Entry("URL", offline.Options{PlotlyJSURL: "/static/plotly.min.js"}, "/static/plotly.min.js"),
+		Entry("URL over version", offline.Options{PlotlyJSVersion: "2.27.0", PlotlyJSURL: "https://mirror.example.com/plotly.js"}, "https://mirror.example.com/plotly.js"),
+	)
+
+	It("Should render the same page concurrently", func() {
+		expected := &bytes.Buffer{}
+		Expect(offline.WriteHtml(fig, expected)).To(Succeed())
+
+		pages := make(chan string)
+		for i := 0; i < 8; i++ {
+			go func() {
+				buf := &bytes.Buffer{}
+				offline.WriteHtml(fig, buf)
+				pages <- buf.String()
+			}()
+		}
+		for i := 0; i < 8; i++ {
+			Expect(<-pages).To(Equal(expected.String()))
+		}
+	})
+
+	It("Should customize the page", func() {
+		buf := &bytes.Buffer{}
+		err := offline.WriteHtml(fig, buf, offline.Options{
+			Title: "Sales & Costs",
+			DivID: "sales",
+			Head:  `<link rel="icon" href="/favicon.ico">`,
+			CSS:   `body { margin: 0; }`,
+		})
+		Expect(err).To(BeNil())
+		Expect(buf.String()).To(ContainSubstring(`<title>Sales &amp; Costs</title>`))
+		Expect(buf.String()).To(ContainSubstring(`<link rel="icon" href="/favicon.ico">`))
+		Expect(buf.String()).To(ContainSubstring(`<style>body { margin: 0; }</style>`))
+		Expect(buf.String()).To(ContainSubstring(`<div id="sales"></div>`))
+		Expect(buf.String()).To(ContainSubstring(`Plotly.newPlot('sales', data)`))
+	})
+
+	DescribeTable("Should reject div ids that are not safe in the page",
+		func(render func(opts offline.Options) error) {
+			for _, id := range []string{`x" onmouseover="alert(1)`, `x');alert(1);//`, "1st", "sales plot"} {
+				err := render(offline.Options{DivID: id})
+				Expect(err).To(MatchError(ContainSubstring("the div id must start with a letter")), id)
+			}
+			Expect(render(offline.Options{DivID: "sales-2021_q1"})).To(Succeed())
+		},
+		Entry("WriteHtml", func(opts offline.Options) error {
+			return offline.WriteHtml(fig, &bytes.Buffer{}, opts)
+		}),
+		Entry("WriteSnippet", func(opts offline.Options) error {
+			return offline.WriteSnippet(fig, &bytes.Buffer{}, opts)
+		}),
+		Entry("NewServer", func(opts offline.Options) error {
+			_, err := offline.NewServer(fig, opts)
+			return err
+		}),
+	)
+
+	It("Should return the error of the file", func() {
+		err := offline.ToHtml(fig, filepath.Join(os.TempDir(), "missing", "directory", "fig.html"))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Should use a custom template", func() {
+		buf := &bytes.Buffer{}
+		err := offline.WriteHtml(fig, buf, offline.Options{
+			Template: `<main id="{{ .DivID }}"></main><script>Plotly.newPlot('{{ .DivID }}', {{ .Figure }})</script>`,
+		})
+		Expect(err).To(BeNil())
+		Expect(buf.String()).To(HavePrefix(`<main id="plot"></main><script>Plotly.newPlot('plot', {"data"`))
+	})
+
+	It("Should render every custom template with its own source", func() {
+		for _, tag := range []string{"main", "section", "article"} {
+			buf := &bytes.Buffer{}
+			err := offline.WriteHtml(fig, buf, offline.Options{
+				Template: `<` + tag + ` id="{{ .DivID }}"></` + tag + `>`,
+			})
+			Expect(err).To(BeNil())
+			Expect(buf.String()).To(Equal(`<` + tag + ` id="plot"></` + tag + `>`))
+		}
+
+		buf := &bytes.Buffer{}
+		Expect(offline.WriteHtml(fig, buf)).To(Succeed())
+		Expect(buf.String()).To(ContainSubstring(`<div id="plot"></div>`))
+	})
+
+	It("Should make the plot responsive", func() {
+		buf := &bytes.Buffer{}
+		err := offline.WriteHtml(fig, buf, offline.Options{
+			Responsive: true,
+			Height:     "50vh",
+		})
+		Expect(err).To(BeNil())
+		Expect(buf.String()).To(ContainSubstring(`<div id="plot" style="width: 100%;height: 50vh;"></div>`))
+		Expect(buf.String()).To(ContainSubstring(`data.config = Object.assign({}, data.config, {responsive: true});`))
+		Expect(buf.String()).To(ContainSubstring(`window.addEventListener('resize'`))
+		Expect(fig.Config).To(BeNil())
+	})
+
+	It("Should add the frames and the animation controls", func() {
+		fig.Frames = []grob.Frame{
+			{Name: "first", Data: grob.Traces{&grob.Bar{Type: grob.TraceTypeBar, Y: []float64{1, 2, 3}}}},
+			{Data: grob.Traces{&grob.Bar{Type: grob.TraceTypeBar, Y: []float64{3, 2, 1}}}},
+		}
+		buf := &bytes.Buffer{}
+		err := offline.WriteHtml(fig, buf, offline.Options{
+			AnimationControls: true,
+		})
+		Expect(err).To(BeNil())
+		Expect(buf.String()).To(ContainSubstring(`Plotly.addFrames(gd, frames)`))
+		Expect(buf.String()).To(ContainSubstring(`"label":"Play","method":"animate"`))
+		Expect(buf.String()).To(ContainSubstring(`"args":[["first"],`))
+		Expect(buf.String()).To(ContainSubstring(`"args":[["1"],`))
+		Expect(buf.String()).To(ContainSubstring(`"name":"1"`))
+
+		Expect(fig.Layout).To(BeNil())
+		Expect(fig.Frames[1].Name).To(BeNil())
+	})
+
+	It("Should apply the theme without modifying the figure", func() {
+		buf := &bytes.Buffer{}
+		err := offline.WriteHtml(fig, buf, offline.Options{
+			Theme: themes.Dark,
+		})
+		Expect(err).To(BeNil())
+		Expect(buf.String()).To(ContainSubstring(`"template":{"layout":{`))
+		Expect(buf.String()).To(ContainSubstring(`"paper_bgcolor":"rgb(17,17,17)"`))
+		Expect(fig.Layout).To(BeNil())
+	})
+
+	It("Should write the figure JSON with the theme", func() {
+		buf := &bytes.Buffer{}
+		err := offline.WriteJSON(fig, buf, offline.Options{
+			Theme: themes.Dark,
+		})
+		Expect(err).To(BeNil())
+		Expect(buf.String()).To(HavePrefix(`{"data":[{"type":"bar","x":[1,2,3],"y":[1,2,3]}],"layout":{"template":{`))
+		Expect(fig.Layout).To(BeNil())
+	})
+
+	It("Should keep the template of the figure", func() {
+		fig.Layout = &grob.Layout{Template: themes.Print}
+		buf := &bytes.Buffer{}
+		err := offline.WriteHtml(fig, buf, offline.Options{
+			Theme: themes.Dark,
+		})
+		Expect(err).To(BeNil())
+		Expect(buf.String()).NotTo(ContainSubstring(`"paper_bgcolor":"rgb(17,17,17)"`))
+	})
+
+	It("Should apply the default template after the theme", func() {
+		grob.SetDefaultTemplate(themes.Seaborn)
+		defer grob.SetDefaultTemplate(nil)
+
+		buf := &bytes.Buffer{}
+		Expect(offline.WriteJSON(fig, buf)).To(Succeed())
+		Expect(buf.String()).To(ContainSubstring(`"plot_bgcolor":"rgb(234,234,242)"`))
+		Expect(fig.Layout).To(BeNil())
+
+		buf.Reset()
+		Expect(offline.WriteJSON(fig, buf, offline.Options{Theme: themes.Dark})).To(Succeed())
+		Expect(buf.String()).To(ContainSubstring(`"paper_bgcolor":"rgb(17,17,17)"`))
+		Expect(buf.String()).NotTo(ContainSubstring(`"plot_bgcolor":"rgb(234,234,242)"`))
+	})
+
+	It("Should describe the chart and add the table of the data", func() {
+		fig.AddTraces(&grob.Scatter{
+			Name: "<b>Sales</b>",
+			X:    []string{"Jan", "Feb"},
+			Y:    []interface{}{10, nil},
+			Text: "same for all points",
+		})
+		buf := &bytes.Buffer{}
+		err := offline.WriteHtml(fig, buf, offline.Options{
+			AltText:   `Sales grew 20% in "Q1"`,
+			DataTable: true,
+		})
+		Expect(err).To(BeNil())
+		Expect(buf.String()).To(ContainSubstring(`<div id="plot" role="img" aria-label="Sales grew 20% in &#34;Q1&#34;"></div>`))
+		Expect(buf.String()).To(ContainSubstring(`<figcaption>Sales grew 20% in &#34;Q1&#34;</figcaption>`))
+		Expect(buf.String()).To(ContainSubstring(`<summary>Data</summary>`))
+		Expect(buf.String()).To(ContainSubstring(`<caption>Trace 1</caption>`))
+		Expect(buf.String()).To(ContainSubstring(`<tr><td>3</td><td>3</td></tr>`))
+		Expect(buf.String()).To(ContainSubstring(`<caption>&lt;b&gt;Sales&lt;/b&gt;</caption>`))
+		Expect(buf.String()).To(ContainSubstring(`<thead><tr><th scope="col">x</th><th scope="col">y</th></tr></thead>`))
+		Expect(buf.String()).To(ContainSubstring(`<tr><td>Feb</td><td></td></tr>`))
+	})
+
+	It("Should not add the table of the data by default", func() {
+		buf := &bytes.Buffer{}
+		Expect(offline.WriteHtml(fig, buf)).To(Succeed())
+		Expect(buf.String()).NotTo(ContainSubstring(`<details>`))
+		Expect(buf.String()).NotTo(ContainSubstring(`aria-label`))
+	})
+
+	It("Should load the locale bundle and set the locale without modifying the figure", func() {
+		buf := &bytes.Buffer{}
+		err := offline.WriteHtml(fig, buf, offline.Options{
+			Locale: grob.ConfigLocaleDeCH,
+		})
+		Expect(err).To(BeNil())
+		Expect(buf.String()).To(ContainSubstring(`<script src="https://cdn.plot.ly/plotly-1.58.4.min.js"></script>
+		<script src="https://cdn.jsdelivr.net/npm/plotly.js@1.58.4/dist/plotly-locale-de-ch.js"></script>`))
+		Expect(buf.String()).To(ContainSubstring(`"config":{"locale":"de-CH"}`))
+		Expect(fig.Config).To(BeNil())
+	})
+
+	It("Should load the bundle of the locale of the figure", func() {
+		fig.Config = &grob.Config{Locale: grob.ConfigLocaleFr}
+		buf := &bytes.Buffer{}
+		err := offline.WriteHtml(fig, buf, offline.Options{LocaleURL: "/assets/plotly-locale-fr.js"})
+		Expect(err).To(BeNil())
+		Expect(buf.String()).To(ContainSubstring(`<script src="/assets/plotly-locale-fr.js"></script>`))
+
+		fig.Config.Locale = grob.ConfigLocaleEnUS
+		buf.Reset()
+		err = offline.WriteHtml(fig, buf)
+		Expect(err).To(BeNil())
+		Expect(buf.String()).NotTo(ContainSubstring(`plotly-locale`))
+	})
+
+	Describe("ToHtmlStandalone", func() {
+
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "standalone")
+			Expect(err).To(BeNil())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		It("Should inline the bundle in the page of the options", func() {
+			path := filepath.Join(dir, "fig.html")
+			err := offline.ToHtmlStandalone(fig, path, offline.Options{
+				PlotlyJS:   []byte(`window.Plotly = {}; "</script>";`),
+				Title:      "Sales",
+				DivID:      "sales",
+				Responsive: true,
+				Theme:      themes.Dark,
+				Locale:     grob.ConfigLocaleDeCH,
+				LocaleJS:   []byte(`Plotly.register({moduleType: "locale", name: "de-CH"});`),
+			})
+			Expect(err).To(BeNil())
+
+			data, err := ioutil.ReadFile(path)
+			Expect(err).To(BeNil())
+			page := string(data)
+			Expect(page).To(ContainSubstring(`<script type="text/javascript">window.Plotly = {}; "<\/script>";</script>`))
+			Expect(page).To(ContainSubstring(`<script type="text/javascript">Plotly.register({moduleType: "locale", name: "de-CH"});</script>`))
+			Expect(page).NotTo(ContainSubstring(`<script src=`))
+			Expect(page).NotTo(ContainSubstring(`https://cdn`))
+			Expect(page).To(ContainSubstring(`<title>Sales</title>`))
+			Expect(page).To(ContainSubstring(`<div id="sales" style="width: 100%;height: 100vh;"></div>`))
+			Expect(page).To(ContainSubstring(`Plotly.newPlot('sales', data)`))
+			Expect(page).To(ContainSubstring(`window.addEventListener('resize'`))
+			Expect(page).To(ContainSubstring(`"paper_bgcolor":"rgb(17,17,17)"`))
+			Expect(page).To(ContainSubstring(`"config":{"locale":"de-CH"}`))
+		})
+
+		It("Should read the bundle from its path", func() {
+			bundle := filepath.Join(dir, "plotly.min.js")
+			Expect(ioutil.WriteFile(bundle, []byte(`window.Plotly = {};`), 0644)).To(Succeed())
+			path := filepath.Join(dir, "fig.html")
+			Expect(offline.ToHtmlStandalone(fig, path, offline.Options{PlotlyJSPath: bundle})).To(Succeed())
+
+			data, err := ioutil.ReadFile(path)
+			Expect(err).To(BeNil())
+			Expect(string(data)).To(ContainSubstring(`<script type="text/javascript">window.Plotly = {};</script>`))
+			Expect(string(data)).NotTo(ContainSubstring(`https://cdn`))
+		})
+
+		It("Should require the bundle", func() {
+			path := filepath.Join(dir, "fig.html")
+			Expect(offline.ToHtmlStandalone(fig, path)).To(MatchError(ContainSubstring("plotly.js bundle is required")))
+			Expect(offline.ToHtmlStandalone(fig, path, offline.Options{PlotlyJS: []byte{}})).To(MatchError("plotly.js bundle is empty"))
+			Expect(path).NotTo(BeAnExistingFile())
+		})
+	})
+})
+
+// allocations returns the average number of allocations and of allocated bytes of f, like testing.AllocsPerRun
+func allocations(runs int, f func()) (allocs, bytes float64) {
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(1))
+	f()
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	for i := 0; i < runs; i++ {
+		f()
+	}
+	runtime.ReadMemStats(&after)
+	return float64(after.Mallocs-before.Mallocs) / float64(runs), float64(after.TotalAlloc-before.TotalAlloc) / float64(runs)
+}
+
+var _ = Describe("Rendering budget", func() {
+
+	BeforeEach(func() {
+		if raceEnabled {
+			Skip("allocations are not representative with the race detector")
+		}
+	})
+
+	// The page is rendered with a pooled template and buffer, so the allocations don't grow with the figure
+	// and the bytes allocated are bounded by a few copies of the JSON of the figure
+	DescribeTable("Should render the page within the budget",
+		func(fig *grob.Fig, opts offline.Options, maxAllocs float64) {
+			data, err := fig.ToPlotlyJSON()
+			Expect(err).To(BeNil())
+
+			allocs, bytes := allocations(20, func() {
+				offline.WriteHtml(fig, ioutil.Discard, opts)
+			})
+			Expect(allocs).To(BeNumerically("<=", maxAllocs), "allocations of WriteHtml")
+			Expect(bytes).To(BeNumerically("<=", 3*float64(len(data))), "bytes allocated by WriteHtml for %d bytes of JSON", len(data))
+		},
+		Entry("points=1e4", benchmarkFig(1, 1e4), offline.Options{}, 10.0),
+		Entry("dashboard", benchmarkFig(50, 100), offline.Options{}, 10.0),
+		Entry("dashboard with theme", benchmarkFig(50, 100), offline.Options{Theme: themes.Dark}, 30.0),
+	)
+})
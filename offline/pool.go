@@ -0,0 +1,63 @@
+package offline
+
+import (
+	"bytes"
+	"encoding/json"
+	"sync"
+	"text/template"
+)
+
+// maxPooledBuffer is the capacity above which buffers are left to the garbage collector instead of being reused,
+// so a single huge figure does not keep its memory forever
+const maxPooledBuffer = 64 << 20
+
+// bufferPool reuses the buffers of the rendered pages and encoded figures, which are large and rendered often by live servers
+var bufferPool = sync.Pool{
+	New: func() interface{} {
+		return &bytes.Buffer{}
+	},
+}
+
+func getBuffer() *bytes.Buffer {
+	return bufferPool.Get().(*bytes.Buffer)
+}
+
+// putBuffer returns the buffer to the pool, its content must not be used afterwards
+func putBuffer(buf *bytes.Buffer) {
+	if buf.Cap() > maxPooledBuffer {
+		return
+	}
+	buf.Reset()
+	bufferPool.Put(buf)
+}
+
+// templates are the parsed built-in templates by name, they are safe to execute concurrently.
+// Custom templates of Options.Template are not cached, so the cache does not grow with the templates of the callers
+var templates sync.Map
+
+// parseTemplate returns the built-in template of the source, parsing it only the first time.
+// The name must identify the source, custom templates are parsed with template.New instead
+func parseTemplate(name, source string) (*template.Template, error) {
+	if tmpl, ok := templates.Load(name); ok {
+		return tmpl.(*template.Template), nil
+	}
+	tmpl, err := template.New(name).Parse(source)
+	if err != nil {
+		return nil, err
+	}
+	templates.Store(name, tmpl)
+	return tmpl, nil
+}
+
+// encodeJSON marshals the value like json.Marshal into a pooled buffer, that must be returned with putBuffer
+func encodeJSON(v interface{}) (*bytes.Buffer, error) {
+	buf := getBuffer()
+	err := json.NewEncoder(buf).Encode(v)
+	if err != nil {
+		putBuffer(buf)
+		return nil, err
+	}
+	// Encode ends the value with a new line
+	buf.Truncate(buf.Len() - 1)
+	return buf, nil
+}
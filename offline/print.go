@@ -0,0 +1,148 @@
+package offline
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// Unit is a physical length unit
+type Unit string
+
+const (
+	UnitMillimeter Unit = "mm"
+	UnitInch       Unit = "in"
+)
+
+// cssDPI is the fixed resolution of CSS, 1in is always 96px
+const cssDPI = 96
+
+// PrintOptions prepare the page to be printed or converted to PDF with a fixed physical size
+type PrintOptions struct {
+	// Width and Height of the figure in Unit
+	Width  float64
+	Height float64
+	// Unit of Width and Height, defaults to UnitMillimeter
+	Unit Unit
+	// DPI is the number of figure pixels per inch, defaults to 96.
+	// Higher values make text and lines smaller relative to the page
+	DPI float64
+	// Fonts are embedded in the page so the figure looks the same on any machine
+	Fonts []Font
+}
+
+// Font is a font file embedded in the page
+type Font struct {
+	// Family is the name used in the figure, such as layout.font.family
+	Family string
+	// Path to a woff2, woff, ttf or otf file
+	Path string
+	// Data is the content of the font file, it takes precedence over Path.
+	// Format must be given with Data, such as woff2 or truetype
+	Data   []byte
+	Format string
+}
+
+// inches returns the size in inches
+func (p *PrintOptions) inches() (float64, float64) {
+	if p.Unit == UnitInch {
+		return p.Width, p.Height
+	}
+	return p.Width / 25.4, p.Height / 25.4
+}
+
+func (p *PrintOptions) dpi() float64 {
+	if p.DPI > 0 {
+		return p.DPI
+	}
+	return cssDPI
+}
+
+// withPrintLayout returns a copy of the figure with the layout size in pixels and without interactivity
+func withPrintLayout(fig *grob.Fig, p *PrintOptions) *grob.Fig {
+	printed := *fig
+
+	layout := &grob.Layout{}
+	if fig.Layout != nil {
+		*layout = *fig.Layout
+	}
+	width, height := p.inches()
+	layout.Width = width * p.dpi()
+	layout.Height = height * p.dpi()
+	layout.Autosize = grob.False
+	printed.Layout = layout
+
+	config := &grob.Config{}
+	if fig.Config != nil {
+		*config = *fig.Config
+	}
+	config.Staticplot = grob.True
+	config.Displaymodebar = grob.ConfigDisplaymodebarFalse
+	config.Responsive = grob.False
+	printed.Config = config
+
+	return &printed
+}
+
+// css returns the page size, the size of the plot and the embedded fonts
+func (p *PrintOptions) css(divID string) (string, error) {
+	unit := p.Unit
+	if unit == "" {
+		unit = UnitMillimeter
+	}
+	width, height := p.inches()
+	scale := cssDPI / p.dpi()
+
+	css := &strings.Builder{}
+	fmt.Fprintf(css, "@page { size: %g%s %g%s; margin: 0; }\n", p.Width, unit, p.Height, unit)
+	fmt.Fprintf(css, "html, body { margin: 0; padding: 0; }\n")
+	fmt.Fprintf(css, "#%s { width: %gin; height: %gin; overflow: hidden; }\n", divID, width, height)
+	if scale != 1 {
+		fmt.Fprintf(css, "#%s > div { transform: scale(%g); transform-origin: top left; }\n", divID, scale)
+	}
+	for _, font := range p.Fonts {
+		data, format, err := font.load()
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(css, "@font-face { font-family: '%s'; src: url(data:%s;base64,%s) format('%s'); }\n",
+			font.Family, fontMIMETypes[format], base64.StdEncoding.EncodeToString(data), format)
+	}
+	return css.String(), nil
+}
+
+var fontMIMETypes = map[string]string{
+	"woff2":    "font/woff2",
+	"woff":     "font/woff",
+	"truetype": "font/ttf",
+	"opentype": "font/otf",
+}
+
+// load returns the content of the font file and its CSS format
+func (f Font) load() ([]byte, string, error) {
+	if f.Data != nil {
+		return f.Data, f.Format, nil
+	}
+	data, err := ioutil.ReadFile(f.Path)
+	if err != nil {
+		return nil, "", fmt.Errorf("cannot read font, %w", err)
+	}
+	format := f.Format
+	if format == "" {
+		switch strings.ToLower(filepath.Ext(f.Path)) {
+		case ".woff2":
+			format = "woff2"
+		case ".woff":
+			format = "woff"
+		case ".otf":
+			format = "opentype"
+		default:
+			format = "truetype"
+		}
+	}
+	return data, format, nil
+}
@@ -0,0 +1,61 @@
+package offline_test
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/offline"
+)
+
+var _ = Describe("Print", func() {
+
+	It("Should set the physical size and disable interactivity", func() {
+		fig := &grob.Fig{
+			Layout: &grob.Layout{
+				Title: &grob.LayoutTitle{Text: "report"},
+			},
+		}
+		buf := &bytes.Buffer{}
+		err := offline.WriteHtml(fig, buf, offline.Options{
+			Print: &offline.PrintOptions{
+				Width:  4,
+				Height: 3,
+				Unit:   offline.UnitInch,
+				DPI:    192,
+				Fonts: []offline.Font{
+					{Family: "Corporate Sans", Data: []byte("font"), Format: "woff2"},
+				},
+			},
+		})
+		Expect(err).To(BeNil())
+
+		Expect(buf.String()).To(ContainSubstring(`@page { size: 4in 3in; margin: 0; }`))
+		Expect(buf.String()).To(ContainSubstring(`#plot { width: 4in; height: 3in; overflow: hidden; }`))
+		Expect(buf.String()).To(ContainSubstring(`transform: scale(0.5)`))
+		Expect(buf.String()).To(ContainSubstring(`@font-face { font-family: 'Corporate Sans'; src: url(data:font/woff2;base64,Zm9udA==) format('woff2'); }`))
+		Expect(buf.String()).To(ContainSubstring(`"width":768`))
+		Expect(buf.String()).To(ContainSubstring(`"height":576`))
+		Expect(buf.String()).To(ContainSubstring(`"displayModeBar":false`))
+		Expect(buf.String()).To(ContainSubstring(`"staticPlot":true`))
+
+		Expect(fig.Layout.Width).To(BeZero())
+		Expect(fig.Config).To(BeNil())
+	})
+
+	It("Should default to millimeters", func() {
+		buf := &bytes.Buffer{}
+		err := offline.WriteHtml(&grob.Fig{}, buf, offline.Options{
+			Print: &offline.PrintOptions{
+				Width:  254,
+				Height: 127,
+			},
+		})
+		Expect(err).To(BeNil())
+		Expect(buf.String()).To(ContainSubstring(`@page { size: 254mm 127mm; margin: 0; }`))
+		Expect(buf.String()).To(ContainSubstring(`"width":960`))
+		Expect(buf.String()).To(ContainSubstring(`"height":480`))
+	})
+})
@@ -0,0 +1,7 @@
+//go:build race
+// +build race
+
+package offline_test
+
+// raceEnabled skips the allocation budgets, the race detector allocates and drops pooled objects
+const raceEnabled = true
@@ -0,0 +1,542 @@
+package offline
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// Server serves figures and pushes new versions of them to the connected browsers.
+// Browsers keep a Server-Sent Events connection open and re-render the figure with Plotly.react on every update.
+//
+// Figures are registered under paths with Handle and removed with Remove. The figure given to NewServer is served at /,
+// otherwise / displays an index with links to every figure, reloaded when figures are added or removed.
+//
+// The click, selection, hover and relayout events of the browsers are sent back over a WebSocket to the handlers
+// registered with OnClick, OnSelect, OnHover and OnRelayout, for drill-down applications written in Go.
+type Server struct {
+	opts Options
+	srv  *http.Server
+	mux  *http.ServeMux
+	done chan struct{}
+
+	mu      sync.Mutex
+	figures map[string]*liveFigure
+	// indexClients are the browsers displaying the index
+	indexClients map[chan event]struct{}
+	handlers     eventHandlers
+	closed       bool
+}
+
+// liveFigure is a figure and the browsers displaying it
+type liveFigure struct {
+	title   string
+	locale  grob.ConfigLocale
+	fig     []byte
+	clients map[chan event]struct{}
+	// traces is the number of traces of the figure
+	traces int
+	// pending are the points appended by Extend since the figure was last marshaled
+	pending [][]byte
+}
+
+// event is a message sent to the browsers, the whole figure or a delta
+type event struct {
+	name string
+	data []byte
+}
+
+// NewServer creates a server for the given figure, call ListenAndServe or Run to start it.
+// fig can be nil to start with an empty index and register the figures later with Handle.
+// Only the routes are configured, the server can also be used as an http.Handler.
+func NewServer(fig *grob.Fig, opt ...Options) (*Server, error) {
+	opts := computeOptions(Options{
+		Addr: "localhost:8080",
+	}, opt...)
+	err := opts.checkDivID()
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		mux:          &http.ServeMux{},
+		done:         make(chan struct{}),
+		figures:      map[string]*liveFigure{},
+		indexClients: map[chan event]struct{}{},
+	}
+	s.srv = &http.Server{
+		Handler: s.mux,
+		Addr:    opts.Addr,
+	}
+	if opts.PlotlyJS != nil || opts.PlotlyJSPath != "" {
+		s.mux.HandleFunc("/plotly.min.js", func(w http.ResponseWriter, r *http.Request) {
+			if opts.PlotlyJS != nil {
+				w.Header().Set("Content-Type", "text/javascript")
+				w.Write(opts.PlotlyJS)
+				return
+			}
+			http.ServeFile(w, r, opts.PlotlyJSPath)
+		})
+		opts.PlotlyJSURL = "/plotly.min.js"
+	}
+	if opts.LocaleJS != nil {
+		s.mux.HandleFunc("/plotly-locale.js", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/javascript")
+			w.Write(opts.LocaleJS)
+		})
+		opts.LocaleURL = "/plotly-locale.js"
+	}
+	s.opts = opts
+	s.mux.HandleFunc("/", s.route)
+
+	if fig != nil {
+		err = s.Update(fig)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Update replaces the figure served at / and sends it to the connected browsers
+func (s *Server) Update(fig *grob.Fig) error {
+	return s.Handle("/", fig)
+}
+
+// Handle serves the figure at the given path, such as /temperatures.
+// If the path already has a figure, it is replaced and sent to the connected browsers.
+func (s *Server) Handle(path string, fig *grob.Fig) error {
+	path, err := livePath(path)
+	if err != nil {
+		return err
+	}
+
+	prepared, err := s.opts.prepareFig(fig)
+	if err != nil {
+		return err
+	}
+	figBytes, err := json.Marshal(prepared)
+	if err != nil {
+		return fmt.Errorf("cannot marshal figure, %w", err)
+	}
+	s.publish(path, fig, event{data: figBytes}, figBytes)
+	return nil
+}
+
+// HandleDelta sends the changes of the figure marked with MarkDirty to the browsers displaying the path, see grob.Fig.MarshalDelta.
+// Only the changed traces and attributes are sent, which saves bandwidth for frequent updates of large figures.
+// The whole figure is still marshaled for the browsers that open the page later, and sent to those that missed a delta.
+//
+//	trace.Y = append(trace.Y.([]float64), value)
+//	fig.MarkDirty(0, "y")
+//	err := srv.HandleDelta("/", fig)
+func (s *Server) HandleDelta(path string, fig *grob.Fig) error {
+	path, err := livePath(path)
+	if err != nil {
+		return err
+	}
+	delta, err := fig.MarshalDelta()
+	if err != nil {
+		return err
+	}
+	prepared, err := s.opts.prepareFig(fig)
+	if err != nil {
+		return err
+	}
+	figBytes, err := json.Marshal(prepared)
+	if err != nil {
+		return fmt.Errorf("cannot marshal figure, %w", err)
+	}
+	s.publish(path, fig, event{name: "delta", data: delta}, figBytes)
+	return nil
+}
+
+// Remove stops serving the figure at the given path. The browsers displaying it keep the last version
+func (s *Server) Remove(path string) error {
+	path, err := livePath(path)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.figures[path]; !ok {
+		return fmt.Errorf("path %s has no figure", path)
+	}
+	delete(s.figures, path)
+	s.notifyIndex()
+	return nil
+}
+
+// livePath validates the path of a figure and removes its trailing slash
+func livePath(path string) (string, error) {
+	if !strings.HasPrefix(path, "/") {
+		return "", fmt.Errorf("path %s must start with /", path)
+	}
+	if path != "/" {
+		path = strings.TrimSuffix(path, "/")
+	}
+	if path == "/events" || strings.HasSuffix(path, "/events") {
+		return "", fmt.Errorf("path %s is reserved for the updates", path)
+	}
+	if path == "/ws" || strings.HasSuffix(path, "/ws") {
+		return "", fmt.Errorf("path %s is reserved for the events of the browsers", path)
+	}
+	return path, nil
+}
+
+// publish stores the figure of the path and sends the update to its browsers
+func (s *Server) publish(path string, fig *grob.Fig, update event, figBytes []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	live, ok := s.figures[path]
+	if !ok {
+		live = &liveFigure{
+			clients: map[chan event]struct{}{},
+		}
+		s.figures[path] = live
+		s.notifyIndex()
+	}
+	live.title = figTitle(fig)
+	live.locale = s.opts.locale(fig)
+	live.fig = figBytes
+	live.traces = len(fig.Data)
+	live.pending = nil
+	for client := range live.clients {
+		select {
+		case client <- update:
+		default:
+			// the client is still busy with a previous version, replace it with the whole figure so no delta is lost
+			select {
+			case <-client:
+			default:
+			}
+			client <- event{data: figBytes}
+		}
+	}
+}
+
+// notifyIndex tells the browsers displaying the index that the figures changed, s.mu must be held
+func (s *Server) notifyIndex() {
+	for client := range s.indexClients {
+		select {
+		case client <- event{data: []byte("{}")}:
+		default:
+			// the client has a pending notification already
+		}
+	}
+}
+
+// figTitle returns the title of the figure, if any
+func figTitle(fig *grob.Fig) string {
+	if fig.Layout == nil || fig.Layout.Title == nil || fig.Layout.Title.Text == nil {
+		return ""
+	}
+	return fmt.Sprint(fig.Layout.Title.Text)
+}
+
+// ServeHTTP implements http.Handler
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// ListenAndServe listens on Options.Addr and blocks until the server is closed
+func (s *Server) ListenAndServe() error {
+	return s.srv.ListenAndServe()
+}
+
+// Run listens on Options.Addr until ctx is done, then the server is gracefully shut down.
+// It returns nil once the server is stopped because of the context.
+func (s *Server) Run(ctx context.Context) error {
+	errs := make(chan error, 1)
+	go func() {
+		errs <- s.srv.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errs:
+		return err
+	case <-ctx.Done():
+	}
+	// ctx is already done, the pending requests are given a fresh one to finish
+	err := s.Shutdown(context.Background())
+	if err != nil {
+		return err
+	}
+	err = <-errs
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+// Shutdown disconnects the browsers waiting for updates and gracefully shuts down the server
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.disconnect()
+	return s.srv.Shutdown(ctx)
+}
+
+// Close stops the server and disconnects the browsers
+func (s *Server) Close() error {
+	s.disconnect()
+	return s.srv.Close()
+}
+
+func (s *Server) disconnect() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.closed {
+		s.closed = true
+		close(s.done)
+	}
+}
+
+// route dispatches the requests to the figure pages, their updates and the index
+func (s *Server) route(w http.ResponseWriter, r *http.Request) {
+	path := r.URL.Path
+	if path != "/" {
+		path = strings.TrimSuffix(path, "/")
+	}
+	events, socket := false, false
+	if path == "/events" {
+		path, events = "/", true
+	} else if strings.HasSuffix(path, "/events") {
+		path, events = strings.TrimSuffix(path, "/events"), true
+	} else if path == "/ws" {
+		path, socket = "/", true
+	} else if strings.HasSuffix(path, "/ws") {
+		path, socket = strings.TrimSuffix(path, "/ws"), true
+	}
+
+	s.mu.Lock()
+	live, ok := s.figures[path]
+	s.mu.Unlock()
+
+	switch {
+	case ok && events:
+		s.handleEvents(w, r, live.clients)
+	case ok && socket:
+		s.handleSocket(w, r, path)
+	case ok:
+		s.handlePage(w, r, live)
+	case path == "/" && events:
+		s.handleEvents(w, r, s.indexClients)
+	case path == "/":
+		s.handleIndex(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *Server) handleIndex(w http.ResponseWriter, r *http.Request) {
+	data := indexData{Title: s.opts.Title}
+	s.mu.Lock()
+	for path, live := range s.figures {
+		data.Figures = append(data.Figures, indexFigure{
+			// links are relative to work behind a path prefix
+			Path:  strings.TrimPrefix(path, "/"),
+			Title: live.title,
+		})
+	}
+	s.mu.Unlock()
+	sort.Slice(data.Figures, func(i, j int) bool {
+		return data.Figures[i].Path < data.Figures[j].Path
+	})
+
+	tmpl, err := parseTemplate("index", indexHtml)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	buf := getBuffer()
+	defer putBuffer(buf)
+	err = tmpl.Execute(buf, data)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	buf.WriteTo(w)
+}
+
+type indexData struct {
+	Title   string
+	Figures []indexFigure
+}
+
+type indexFigure struct {
+	Path  string
+	Title string
+}
+
+func (s *Server) handlePage(w http.ResponseWriter, r *http.Request, live *liveFigure) {
+	s.mu.Lock()
+	figBytes, err := live.extended()
+	opts := s.opts
+	opts.Locale = live.locale
+	kinds := s.handlers.kinds()
+	s.mu.Unlock()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	buf := getBuffer()
+	defer putBuffer(buf)
+	err = writeHtml(json.RawMessage(figBytes), buf, opts, liveScript(s.opts)+eventScript(kinds, s.opts.divID()), false)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if s.opts.Compression == "" || !acceptsEncoding(r, s.opts.Compression) {
+		buf.WriteTo(w)
+		return
+	}
+	cw, err := compressWriter(w, s.opts.Compression)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Content-Encoding", string(s.opts.Compression))
+	w.Header().Add("Vary", "Accept-Encoding")
+	buf.WriteTo(cw)
+	cw.Close()
+}
+
+// handleEvents streams the events sent to the clients of a figure or of the index
+func (s *Server) handleEvents(w http.ResponseWriter, r *http.Request, clients map[chan event]struct{}) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+
+	client := make(chan event, 1)
+	s.mu.Lock()
+	clients[client] = struct{}{}
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		delete(clients, client)
+		s.mu.Unlock()
+	}()
+
+	// headers are sent once the client is registered, so no update is lost after the connection is open
+	flusher.Flush()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-s.done:
+			return
+		case update := <-client:
+			if update.name != "" {
+				_, err := fmt.Fprintf(w, "event: %s\n", update.name)
+				if err != nil {
+					return
+				}
+			}
+			_, err := fmt.Fprintf(w, "data: %s\n\n", update.data)
+			if err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+// liveScript re-renders the plot when the server sends a new version of the figure.
+// The updates are served under the path of the page, such as /temperatures/events
+func liveScript(opts Options) string {
+	config := ""
+	if opts.Responsive {
+		config = `
+				data.config = Object.assign({}, data.config, {responsive: true});`
+	}
+	return fmt.Sprintf(`events = new EventSource(location.pathname.replace(/\/?$/, '/events'));
+			events.onmessage = function(event) {
+				data = JSON.parse(event.data);%s
+				Plotly.react('%s', data);
+			};
+			events.addEventListener('delta', function(event) {
+				var delta = JSON.parse(event.data);
+				data.layout = data.layout || {};
+				if (delta.base !== (data.layout.datarevision === undefined ? null : data.layout.datarevision)) {
+					// a delta was missed, the page has the whole figure
+					location.reload();
+					return;
+				}
+				data.data = data.data || [];
+				data.data.length = delta.length;
+				delta.traces.forEach(function(change) {
+					if (change.trace) {
+						data.data[change.index] = change.trace;
+						return;
+					}
+					var trace = data.data[change.index] = data.data[change.index] || {};
+					Object.keys(change.attributes).forEach(function(path) {
+						var object = trace, keys = path.split('.');
+						keys.slice(0, -1).forEach(function(key) {
+							object = object[key] = object[key] || {};
+						});
+						object[keys[keys.length - 1]] = change.attributes[path];
+					});
+				});
+				data.layout.datarevision = delta.datarevision;
+				Plotly.react('%s', data);
+			});
+			events.addEventListener('extend', function(event) {
+				var extension = JSON.parse(event.data);
+				var update = {};
+				if (extension.x) {
+					update.x = [extension.x];
+				}
+				if (extension.y) {
+					update.y = [extension.y];
+				}
+				// the traces of data are those of the plot, they are extended too
+				if (extension.maxPoints) {
+					Plotly.extendTraces('%s', update, [extension.trace], extension.maxPoints);
+				} else {
+					Plotly.extendTraces('%s', update, [extension.trace]);
+				}
+			});`, config, opts.divID(), opts.divID(), opts.divID(), opts.divID())
+}
+
+var indexHtml = `
+<html>
+	<head>
+		<meta charset="utf-8">
+		<title>{{ if .Title }}{{ .Title | html }}{{ else }}Figures{{ end }}</title>
+		<style>
+			body { font-family: sans-serif; margin: 16px; }
+		</style>
+	</head>
+	<body>
+		<h1>{{ if .Title }}{{ .Title | html }}{{ else }}Figures{{ end }}</h1>
+		<ul>
+			{{- range .Figures }}
+			<li><a href="{{ .Path | html }}">{{ if .Title }}{{ .Title | html }}{{ else }}{{ .Path | html }}{{ end }}</a></li>
+			{{- end }}
+		</ul>
+		<script>
+			new EventSource('events').onmessage = function() {
+				location.reload();
+			};
+		</script>
+	</body>
+</html>
+`
@@ -0,0 +1,220 @@
+package offline_test
+
+import (
+	"bufio"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/offline"
+)
+
+var _ = Describe("Server", func() {
+
+	var (
+		srv *offline.Server
+		ts  *httptest.Server
+	)
+
+	BeforeEach(func() {
+		var err error
+		srv, err = offline.NewServer(&grob.Fig{
+			Layout: &grob.Layout{
+				Title: &grob.LayoutTitle{Text: "first"},
+			},
+		})
+		Expect(err).To(BeNil())
+		ts = httptest.NewServer(srv)
+	})
+
+	AfterEach(func() {
+		ts.Close()
+	})
+
+	It("Should serve the figure", func() {
+		resp, err := http.Get(ts.URL)
+		Expect(err).To(BeNil())
+		defer resp.Body.Close()
+
+		body, err := ioutil.ReadAll(resp.Body)
+		Expect(err).To(BeNil())
+		Expect(string(body)).To(ContainSubstring(`first`))
+		Expect(string(body)).To(ContainSubstring(`new EventSource(`))
+	})
+
+	It("Should push updates to the browser", func() {
+		resp, err := http.Get(ts.URL + "/events")
+		Expect(err).To(BeNil())
+		defer resp.Body.Close()
+		Expect(resp.Header.Get("Content-Type")).To(Equal("text/event-stream"))
+
+		// the client is registered before the headers are sent, so the update is not lost
+		err = srv.Update(&grob.Fig{
+			Layout: &grob.Layout{
+				Title: &grob.LayoutTitle{Text: "second"},
+			},
+		})
+		Expect(err).To(BeNil())
+
+		line, err := bufio.NewReader(resp.Body).ReadString('\n')
+		Expect(err).To(BeNil())
+		Expect(line).To(HavePrefix("data: {"))
+		Expect(line).To(ContainSubstring(`"text":"second"`))
+	})
+
+	It("Should push deltas to the browser", func() {
+		resp, err := http.Get(ts.URL + "/events")
+		Expect(err).To(BeNil())
+		defer resp.Body.Close()
+
+		fig := &grob.Fig{}
+		fig.AddScatter([]float64{1}, []float64{1})
+		fig.MarkDirty(0, "y")
+		Expect(srv.HandleDelta("/", fig)).To(Succeed())
+
+		reader := bufio.NewReader(resp.Body)
+		line, err := reader.ReadString('\n')
+		Expect(err).To(BeNil())
+		Expect(line).To(Equal("event: delta\n"))
+		line, err = reader.ReadString('\n')
+		Expect(err).To(BeNil())
+		Expect(line).To(Equal(`data: {"base":null,"datarevision":1,"length":1,"traces":[{"index":0,"attributes":{"y":[1]}}]}` + "\n"))
+
+		By("serving the whole figure to new browsers")
+		page, err := http.Get(ts.URL)
+		Expect(err).To(BeNil())
+		defer page.Body.Close()
+		body, err := ioutil.ReadAll(page.Body)
+		Expect(err).To(BeNil())
+		Expect(string(body)).To(ContainSubstring(`"datarevision":1`))
+		Expect(string(body)).To(ContainSubstring(`addEventListener('delta'`))
+	})
+
+	It("Should serve figures under their paths", func() {
+		err := srv.Handle("/temperatures", &grob.Fig{
+			Layout: &grob.Layout{
+				Title: &grob.LayoutTitle{Text: "temperatures"},
+			},
+		})
+		Expect(err).To(BeNil())
+
+		resp, err := http.Get(ts.URL + "/temperatures")
+		Expect(err).To(BeNil())
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		Expect(err).To(BeNil())
+		Expect(string(body)).To(ContainSubstring(`"text":"temperatures"`))
+
+		events, err := http.Get(ts.URL + "/temperatures/events")
+		Expect(err).To(BeNil())
+		defer events.Body.Close()
+		Expect(events.Header.Get("Content-Type")).To(Equal("text/event-stream"))
+
+		missing, err := http.Get(ts.URL + "/pressures")
+		Expect(err).To(BeNil())
+		defer missing.Body.Close()
+		Expect(missing.StatusCode).To(Equal(http.StatusNotFound))
+	})
+
+	It("Should serve the locale bundle of the figures", func() {
+		srv, err := offline.NewServer(&grob.Fig{Config: &grob.Config{Locale: grob.ConfigLocaleDe}}, offline.Options{
+			LocaleJS: []byte(`Plotly.register({moduleType: "locale", name: "de"});`),
+		})
+		Expect(err).To(BeNil())
+		ts := httptest.NewServer(srv)
+		defer ts.Close()
+
+		resp, err := http.Get(ts.URL)
+		Expect(err).To(BeNil())
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		Expect(err).To(BeNil())
+		Expect(string(body)).To(ContainSubstring(`<script src="/plotly-locale.js"></script>`))
+
+		bundle, err := http.Get(ts.URL + "/plotly-locale.js")
+		Expect(err).To(BeNil())
+		defer bundle.Body.Close()
+		body, err = ioutil.ReadAll(bundle.Body)
+		Expect(err).To(BeNil())
+		Expect(string(body)).To(HavePrefix(`Plotly.register(`))
+	})
+
+	It("Should reject reserved paths", func() {
+		err := srv.Handle("/temperatures/events", &grob.Fig{})
+		Expect(err).NotTo(BeNil())
+		err = srv.Handle("temperatures", &grob.Fig{})
+		Expect(err).NotTo(BeNil())
+	})
+})
+
+var _ = Describe("Server index", func() {
+
+	It("Should list the figures", func() {
+		srv, err := offline.NewServer(nil, offline.Options{Title: "Plant"})
+		Expect(err).To(BeNil())
+		Expect(srv.Handle("/temperatures", &grob.Fig{Layout: &grob.Layout{Title: &grob.LayoutTitle{Text: "Temperatures"}}})).To(Succeed())
+		Expect(srv.Handle("/pressures", &grob.Fig{})).To(Succeed())
+
+		ts := httptest.NewServer(srv)
+		defer ts.Close()
+
+		resp, err := http.Get(ts.URL)
+		Expect(err).To(BeNil())
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		Expect(err).To(BeNil())
+		Expect(string(body)).To(ContainSubstring(`<h1>Plant</h1>`))
+		Expect(string(body)).To(ContainSubstring(`<li><a href="pressures">pressures</a></li>`))
+		Expect(string(body)).To(ContainSubstring(`<li><a href="temperatures">Temperatures</a></li>`))
+	})
+
+	It("Should reload the index when figures are added or removed", func() {
+		srv, err := offline.NewServer(nil)
+		Expect(err).To(BeNil())
+		Expect(srv.Handle("/temperatures", &grob.Fig{})).To(Succeed())
+
+		ts := httptest.NewServer(srv)
+		defer ts.Close()
+
+		resp, err := http.Get(ts.URL + "/events")
+		Expect(err).To(BeNil())
+		defer resp.Body.Close()
+		reader := bufio.NewReader(resp.Body)
+
+		Expect(srv.Handle("/pressures", &grob.Fig{})).To(Succeed())
+		line, err := reader.ReadString('\n')
+		Expect(err).To(BeNil())
+		Expect(line).To(Equal("data: {}\n"))
+		_, err = reader.ReadString('\n')
+		Expect(err).To(BeNil())
+
+		Expect(srv.Remove("/pressures")).To(Succeed())
+		line, err = reader.ReadString('\n')
+		Expect(err).To(BeNil())
+		Expect(line).To(Equal("data: {}\n"))
+
+		Expect(srv.Remove("/pressures")).NotTo(Succeed())
+		page, err := http.Get(ts.URL + "/pressures")
+		Expect(err).To(BeNil())
+		page.Body.Close()
+		Expect(page.StatusCode).To(Equal(http.StatusNotFound))
+	})
+
+	It("Should shut down when the context is done", func() {
+		srv, err := offline.NewServer(&grob.Fig{}, offline.Options{Addr: "localhost:0"})
+		Expect(err).To(BeNil())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		stopped := make(chan error)
+		go func() {
+			stopped <- srv.Run(ctx)
+		}()
+		cancel()
+		Eventually(stopped).Should(Receive(BeNil()))
+	})
+})
@@ -0,0 +1,78 @@
+package offline
+
+import (
+	"fmt"
+	"io"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// Snippet returns the HTML fragment that displays the figure in a div with the given id, without html, head or body tags.
+// It is meant to be embedded in pages that already load plotly.js, such as server rendered templates or htmx swaps.
+func Snippet(fig *grob.Fig, divID string) (string, error) {
+	figure, err := scriptJSON(fig)
+	if err != nil {
+		return "", fmt.Errorf("cannot marshal figure, %w", err)
+	}
+	tmpl, err := parseTemplate("snippet", snippetHtml)
+	if err != nil {
+		return "", err
+	}
+	buf := getBuffer()
+	defer putBuffer(buf)
+	err = tmpl.Execute(buf, htmlData{
+		DivID:  divID,
+		Figure: figure,
+	})
+	if err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// WriteSnippet writes the HTML fragment that renders the figure in the div of Options.DivID with Plotly.react,
+// so a plot swapped in place of the previous one, such as the response of an htmx hx-get, is updated instead of created again.
+// The options that modify the figure are applied, Options.Responsive and the size of the div are honored.
+func WriteSnippet(fig *grob.Fig, w io.Writer, opt ...Options) error {
+	opts := computeOptions(Options{}, opt...)
+	err := opts.checkDivID()
+	if err != nil {
+		return err
+	}
+	fig, err = opts.prepareFig(fig)
+	if err != nil {
+		return err
+	}
+	figure, err := scriptJSON(fig)
+	if err != nil {
+		return fmt.Errorf("cannot marshal figure, %w", err)
+	}
+	tmpl, err := parseTemplate("react", reactHtml)
+	if err != nil {
+		return err
+	}
+	return tmpl.Execute(w, htmlData{
+		DivID:      opts.divID(),
+		Figure:     figure,
+		Style:      opts.divStyle(),
+		Responsive: opts.Responsive,
+	})
+}
+
+var snippetHtml = `<div id="{{ .DivID | html }}"></div>
+<script>
+	Plotly.newPlot({{ .DivID | js | printf "'%s'" }}, {{ .Figure }});
+</script>
+`
+
+var reactHtml = `<div id="{{ .DivID | html }}"{{ if .Style }} style="{{ .Style }}"{{ end }}></div>
+<script>
+	(function() {
+		var data = {{ .Figure }};
+		{{- if .Responsive }}
+		data.config = Object.assign({}, data.config, {responsive: true});
+		{{- end }}
+		Plotly.react({{ .DivID | js | printf "'%s'" }}, data);
+	})();
+</script>
+`
@@ -0,0 +1,40 @@
+package offline_test
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/offline"
+)
+
+var _ = Describe("Snippet", func() {
+
+	It("Should return only the div and the script", func() {
+		snippet, err := offline.Snippet(&grob.Fig{
+			Layout: &grob.Layout{
+				Title: &grob.LayoutTitle{Text: "temperature"},
+			},
+		}, "temperature")
+		Expect(err).To(BeNil())
+		Expect(snippet).To(HavePrefix(`<div id="temperature"></div>`))
+		Expect(snippet).To(ContainSubstring(`Plotly.newPlot('temperature', {"layout":{"title":{"text":"temperature"}`))
+		Expect(snippet).NotTo(ContainSubstring(`<html>`))
+		Expect(snippet).NotTo(ContainSubstring(`<head>`))
+	})
+
+	It("Should write the fragment that reacts to the figure", func() {
+		buf := &bytes.Buffer{}
+		err := offline.WriteSnippet(&grob.Fig{
+			Layout: &grob.Layout{
+				Title: &grob.LayoutTitle{Text: "</script>"},
+			},
+		}, buf, offline.Options{DivID: "temperature", Responsive: true})
+		Expect(err).To(BeNil())
+		Expect(buf.String()).To(HavePrefix(`<div id="temperature" style="width: 100%;height: 100vh;"></div>`))
+		Expect(buf.String()).To(ContainSubstring(`var data = {"layout":{"title":{"text":"\u003c/script\u003e"}}};`))
+		Expect(buf.String()).To(ContainSubstring(`Plotly.react('temperature', data);`))
+	})
+})
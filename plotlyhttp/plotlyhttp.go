@@ -0,0 +1,185 @@
+// Package plotlyhttp serves figures built on every request, as JSON for JavaScript frontends that call Plotly.newPlot
+// or as an HTML page, from the same handler.
+//
+//	http.Handle("/sales", plotlyhttp.FigureHandler(func(r *http.Request) (*grob.Fig, error) {
+//		return salesChart(r.Context(), r.URL.Query().Get("region"))
+//	}))
+package plotlyhttp
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"html"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/offline"
+)
+
+// Options configure the responses of the handlers
+type Options struct {
+	// Page configures the HTML page and the figure, like the theme, see offline.Options
+	Page offline.Options
+	// Fragment always serves the fragment of the plot instead of the whole page, for endpoints dedicated to htmx or turbo swaps.
+	// Otherwise the fragment is served to the requests of htmx and turbo frames, that have an HX-Request or a Turbo-Frame header
+	Fragment bool
+	// MaxAge is how long the responses can be cached by browsers and proxies.
+	// Defaults to 0, the responses are revalidated with their ETag on every request
+	MaxAge time.Duration
+}
+
+func computeOptions(def Options, opt ...Options) Options {
+	if len(opt) == 1 {
+		opts := opt[0]
+		def.Page = opts.Page
+		if opts.Fragment {
+			def.Fragment = opts.Fragment
+		}
+		if opts.MaxAge != 0 {
+			def.MaxAge = opts.MaxAge
+		}
+	}
+	return def
+}
+
+// FigureHandler returns a handler that serves the figure returned by fig for every GET request.
+// The figure is served as JSON to the requests that prefer application/json in their Accept header, and as an HTML page otherwise.
+// The responses are gzipped when the client accepts it and have an ETag, so unchanged figures are answered with 304 Not Modified.
+// If fig fails, the error is answered with 500 Internal Server Error.
+//
+// The requests of htmx and turbo frames get only the div of the plot and the script that renders it with Plotly.react,
+// see offline.WriteSnippet, so server driven dashboards refresh their plots without custom JavaScript.
+// The page must load plotly.js and the plot is in the div of Options.Page.DivID, plot by default.
+//
+//	<div hx-get="/sales?region=north" hx-trigger="every 10s" hx-swap="innerHTML"></div>
+func FigureHandler(fig func(r *http.Request) (*grob.Fig, error), opt ...Options) http.Handler {
+	opts := computeOptions(Options{}, opt...)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		f, err := fig(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		buf := &bytes.Buffer{}
+		contentType := "text/html; charset=utf-8"
+		switch frame := r.Header.Get("Turbo-Frame"); {
+		case prefersJSON(r):
+			contentType = "application/json"
+			err = offline.WriteJSON(f, buf, opts.Page)
+		case frame != "":
+			// turbo only swaps the frame of the same id
+			fmt.Fprintf(buf, "<turbo-frame id=\"%s\">\n", html.EscapeString(frame))
+			err = offline.WriteSnippet(f, buf, opts.Page)
+			buf.WriteString("</turbo-frame>\n")
+		case opts.Fragment || r.Header.Get("HX-Request") == "true":
+			err = offline.WriteSnippet(f, buf, opts.Page)
+		default:
+			err = offline.WriteHtml(f, buf, opts.Page)
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		serve(w, r, buf.Bytes(), contentType, opts)
+	})
+}
+
+// serve writes the body with its caching headers, compressed if the client accepts gzip
+func serve(w http.ResponseWriter, r *http.Request, body []byte, contentType string, opts Options) {
+	gzipped := acceptsGzip(r)
+	sum := sha256.Sum256(body)
+	etag := hex.EncodeToString(sum[:16])
+	if gzipped {
+		// the compressed representation is a different entity
+		etag += "-gzip"
+	}
+	etag = `"` + etag + `"`
+
+	header := w.Header()
+	header.Set("Content-Type", contentType)
+	header.Set("ETag", etag)
+	header.Add("Vary", "Accept")
+	header.Add("Vary", "Accept-Encoding")
+	header.Add("Vary", "HX-Request")
+	header.Add("Vary", "Turbo-Frame")
+	if opts.MaxAge > 0 {
+		header.Set("Cache-Control", "max-age="+strconv.Itoa(int(opts.MaxAge/time.Second)))
+	} else {
+		header.Set("Cache-Control", "no-cache")
+	}
+	if matchesETag(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	if !gzipped {
+		header.Set("Content-Length", strconv.Itoa(len(body)))
+		w.Write(body)
+		return
+	}
+	header.Set("Content-Encoding", "gzip")
+	gw := gzip.NewWriter(w)
+	gw.Write(body)
+	gw.Close()
+}
+
+// prefersJSON tells if the Accept header of the request gives application/json a higher quality than text/html
+func prefersJSON(r *http.Request) bool {
+	json, html := -1.0, -1.0
+	for _, accepted := range strings.Split(r.Header.Get("Accept"), ",") {
+		parts := strings.Split(accepted, ";")
+		mediaType := strings.ToLower(strings.TrimSpace(parts[0]))
+		quality := 1.0
+		for _, param := range parts[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				q, err := strconv.ParseFloat(strings.TrimPrefix(param, "q="), 64)
+				if err == nil {
+					quality = q
+				}
+			}
+		}
+		switch mediaType {
+		case "application/json":
+			json = quality
+		case "text/html":
+			html = quality
+		}
+	}
+	return json > 0 && json > html
+}
+
+// acceptsGzip tells if the request accepts gzip content encoding
+func acceptsGzip(r *http.Request) bool {
+	for _, accepted := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		parts := strings.Split(accepted, ";")
+		if strings.TrimSpace(parts[0]) != "gzip" {
+			continue
+		}
+		return len(parts) == 1 || strings.ReplaceAll(strings.TrimSpace(parts[1]), " ", "") != "q=0"
+	}
+	return false
+}
+
+// matchesETag tells if the If-None-Match header lists the etag
+func matchesETag(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == etag || candidate == "*" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,13 @@
+package plotlyhttp_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestPlotlyhttp(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Plotlyhttp Suite")
+}
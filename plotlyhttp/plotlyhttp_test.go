@@ -0,0 +1,131 @@
+package plotlyhttp_test
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/offline"
+	"github.com/MetalBlueberry/go-plotly/plotlyhttp"
+)
+
+var _ = Describe("FigureHandler", func() {
+
+	var handler http.Handler
+
+	BeforeEach(func() {
+		handler = plotlyhttp.FigureHandler(func(r *http.Request) (*grob.Fig, error) {
+			if r.URL.Query().Get("fail") != "" {
+				return nil, errors.New("database is down")
+			}
+			fig := &grob.Fig{Layout: &grob.Layout{Title: &grob.LayoutTitle{Text: "Sales"}}}
+			fig.AddScatter([]float64{1, 2}, []float64{3, 4})
+			return fig, nil
+		})
+	})
+
+	get := func(headers map[string]string, target ...string) *httptest.ResponseRecorder {
+		url := "/sales"
+		if len(target) == 1 {
+			url = target[0]
+		}
+		r := httptest.NewRequest(http.MethodGet, url, nil)
+		for name, value := range headers {
+			r.Header.Set(name, value)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	It("Should serve an HTML page to browsers", func() {
+		w := get(map[string]string{"Accept": "text/html,application/xhtml+xml,*/*;q=0.8"})
+		Expect(w.Code).To(Equal(http.StatusOK))
+		Expect(w.Header().Get("Content-Type")).To(Equal("text/html; charset=utf-8"))
+		Expect(w.Body.String()).To(ContainSubstring("Plotly.newPlot"))
+		Expect(w.Body.String()).To(ContainSubstring("Sales"))
+	})
+
+	It("Should serve the figure JSON to frontends", func() {
+		w := get(map[string]string{"Accept": "application/json"})
+		Expect(w.Header().Get("Content-Type")).To(Equal("application/json"))
+		fig := map[string]interface{}{}
+		Expect(json.Unmarshal(w.Body.Bytes(), &fig)).To(Succeed())
+		Expect(fig).To(HaveKey("data"))
+		Expect(fig["layout"]).To(HaveKeyWithValue("title", map[string]interface{}{"text": "Sales"}))
+	})
+
+	It("Should serve the fragment to htmx", func() {
+		w := get(map[string]string{"HX-Request": "true"})
+		Expect(w.Header().Get("Content-Type")).To(Equal("text/html; charset=utf-8"))
+		Expect(w.Body.String()).To(HavePrefix(`<div id="plot"></div>`))
+		Expect(w.Body.String()).To(ContainSubstring("Plotly.react('plot', data);"))
+		Expect(w.Body.String()).NotTo(ContainSubstring("<html>"))
+	})
+
+	It("Should serve the fragment in the turbo frame", func() {
+		w := get(map[string]string{"Turbo-Frame": "sales"})
+		Expect(w.Body.String()).To(HavePrefix(`<turbo-frame id="sales">` + "\n" + `<div id="plot"></div>`))
+		Expect(w.Body.String()).To(HaveSuffix("</turbo-frame>\n"))
+	})
+
+	It("Should always serve the fragment", func() {
+		handler = plotlyhttp.FigureHandler(func(r *http.Request) (*grob.Fig, error) {
+			return &grob.Fig{}, nil
+		}, plotlyhttp.Options{Fragment: true, Page: offline.Options{DivID: "sales"}})
+		Expect(get(nil).Body.String()).To(HavePrefix(`<div id="sales"></div>`))
+	})
+
+	It("Should answer unchanged figures with not modified", func() {
+		w := get(nil)
+		etag := w.Header().Get("ETag")
+		Expect(etag).NotTo(BeEmpty())
+		Expect(w.Header().Get("Cache-Control")).To(Equal("no-cache"))
+		Expect(w.Header().Values("Vary")).To(Equal([]string{"Accept", "Accept-Encoding", "HX-Request", "Turbo-Frame"}))
+
+		w = get(map[string]string{"If-None-Match": etag})
+		Expect(w.Code).To(Equal(http.StatusNotModified))
+		Expect(w.Body.Len()).To(Equal(0))
+
+		w = get(map[string]string{"If-None-Match": etag, "Accept": "application/json"})
+		Expect(w.Code).To(Equal(http.StatusOK))
+	})
+
+	It("Should gzip the response if accepted", func() {
+		w := get(map[string]string{"Accept": "application/json", "Accept-Encoding": "gzip, deflate"})
+		Expect(w.Header().Get("Content-Encoding")).To(Equal("gzip"))
+		reader, err := gzip.NewReader(w.Body)
+		Expect(err).To(BeNil())
+		body, err := ioutil.ReadAll(reader)
+		Expect(err).To(BeNil())
+		Expect(json.Valid(body)).To(BeTrue())
+	})
+
+	It("Should set the max age", func() {
+		handler = plotlyhttp.FigureHandler(func(r *http.Request) (*grob.Fig, error) {
+			return &grob.Fig{}, nil
+		}, plotlyhttp.Options{MaxAge: time.Minute})
+		Expect(get(nil).Header().Get("Cache-Control")).To(Equal("max-age=60"))
+	})
+
+	It("Should answer the errors of the figure", func() {
+		w := get(nil, "/sales?fail=1")
+		Expect(w.Code).To(Equal(http.StatusInternalServerError))
+		Expect(w.Body.String()).To(ContainSubstring("database is down"))
+	})
+
+	It("Should only allow reading the figure", func() {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/sales", nil))
+		Expect(w.Code).To(Equal(http.StatusMethodNotAllowed))
+		Expect(w.Header().Get("Allow")).To(Equal("GET, HEAD"))
+	})
+})
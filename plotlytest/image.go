@@ -0,0 +1,196 @@
+package plotlytest
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/MetalBlueberry/go-plotly/export"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+const (
+	// DefaultPixelTolerance is the perceptual color difference, between 0 and 1, below which two pixels are equal by default.
+	// It absorbs the antialiasing and font rendering differences between machines
+	DefaultPixelTolerance = 0.1
+	// DefaultImageThreshold is the fraction of the pixels that can differ by default
+	DefaultImageThreshold = 0.001
+)
+
+// maxDelta is the YIQ difference between black and white
+const maxDelta = 35215.0
+
+// ImageOptions configure the comparison of images
+type ImageOptions struct {
+	// Export are the options of the rendering, like the size of the image. Without size, the size of the layout is used
+	Export export.Options
+	// Exporter renders the figures, to share a backend between tests. Defaults to a new exporter for every assertion
+	Exporter export.Exporter
+	// PixelTolerance is the perceptual difference between 0 and 1 allowed per pixel, defaults to DefaultPixelTolerance
+	PixelTolerance float64
+	// Threshold is the fraction of the pixels that can differ, defaults to DefaultImageThreshold
+	Threshold float64
+	// DiffPath is the image of the differences written when the images do not match,
+	// defaults to the golden file with the .diff.png extension
+	DiffPath string
+}
+
+func computeImageOptions(def ImageOptions, opt ...ImageOptions) ImageOptions {
+	if len(opt) == 1 {
+		opts := opt[0]
+		def.Export = opts.Export
+		if opts.Exporter != nil {
+			def.Exporter = opts.Exporter
+		}
+		if opts.PixelTolerance != 0 {
+			def.PixelTolerance = opts.PixelTolerance
+		}
+		if opts.Threshold != 0 {
+			def.Threshold = opts.Threshold
+		}
+		if opts.DiffPath != "" {
+			def.DiffPath = opts.DiffPath
+		}
+	}
+	return def
+}
+
+// AssertEqualImage renders the figure to PNG with the export package and compares it with the golden image.
+// The comparison is perceptual: pixels whose colors differ less than the pixel tolerance are equal, and the test fails
+// if the fraction of different pixels exceeds the threshold. On failure, an image with the different pixels in red over
+// a faded golden image is written to the diff path, to be kept as an artifact of the CI job.
+// With the -update flag, the golden image and its directory are written instead.
+//
+//	plotlytest.AssertEqualImage(t, SalesChart(data), "testdata/sales.png", plotlytest.ImageOptions{
+//		Export: export.Options{Width: 800, Height: 600},
+//	})
+func AssertEqualImage(t TestingT, got *grob.Fig, goldenPath string, opt ...ImageOptions) {
+	t.Helper()
+	opts := computeImageOptions(ImageOptions{
+		PixelTolerance: DefaultPixelTolerance,
+		Threshold:      DefaultImageThreshold,
+		DiffPath:       strings.TrimSuffix(goldenPath, filepath.Ext(goldenPath)) + ".diff.png",
+	}, opt...)
+
+	buf := &bytes.Buffer{}
+	var err error
+	if opts.Exporter != nil {
+		err = opts.Exporter.Export(got, buf, export.FormatPNG, opts.Export)
+	} else {
+		err = export.ToPNG(got, buf, opts.Export)
+	}
+	if err != nil {
+		t.Fatalf("cannot render figure, %s", err)
+		return
+	}
+
+	if *update {
+		err = os.MkdirAll(filepath.Dir(goldenPath), os.ModePerm)
+		if err == nil {
+			err = ioutil.WriteFile(goldenPath, buf.Bytes(), 0644)
+		}
+		if err != nil {
+			t.Fatalf("cannot update golden image, %s", err)
+		}
+		return
+	}
+
+	gotImage, err := png.Decode(buf)
+	if err != nil {
+		t.Fatalf("cannot decode rendered image, %s", err)
+		return
+	}
+	golden, err := os.Open(goldenPath)
+	if err != nil {
+		t.Fatalf("cannot read golden image, run the tests with -update to create it, %s", err)
+		return
+	}
+	defer golden.Close()
+	wantImage, err := png.Decode(golden)
+	if err != nil {
+		t.Fatalf("cannot decode golden image %s, %s", goldenPath, err)
+		return
+	}
+
+	diff, different, err := ImageDiff(gotImage, wantImage, opts.PixelTolerance)
+	if err != nil {
+		t.Errorf("image does not match %s, run the tests with -update to accept the changes: %s", goldenPath, err)
+		return
+	}
+	bounds := wantImage.Bounds()
+	fraction := float64(different) / float64(bounds.Dx()*bounds.Dy())
+	if fraction <= opts.Threshold {
+		return
+	}
+	err = writeImage(opts.DiffPath, diff)
+	if err != nil {
+		t.Errorf("cannot write image diff, %s", err)
+	}
+	t.Errorf("image does not match %s, %d pixels (%.2f%%) differ, see %s, run the tests with -update to accept the changes",
+		goldenPath, different, fraction*100, opts.DiffPath)
+}
+
+// ImageDiff compares two images of the same size pixel by pixel with the perceptual YIQ color difference.
+// Pixels that differ less than the tolerance, between 0 and 1, are equal. It returns the image of the differences,
+// the different pixels in red over a faded want image, and the number of different pixels.
+func ImageDiff(got, want image.Image, tolerance float64) (*image.RGBA, int, error) {
+	gotBounds, wantBounds := got.Bounds(), want.Bounds()
+	if gotBounds.Dx() != wantBounds.Dx() || gotBounds.Dy() != wantBounds.Dy() {
+		return nil, 0, fmt.Errorf("got a %dx%d image, want %dx%d", gotBounds.Dx(), gotBounds.Dy(), wantBounds.Dx(), wantBounds.Dy())
+	}
+
+	maxAllowed := maxDelta * tolerance * tolerance
+	diff := image.NewRGBA(image.Rect(0, 0, wantBounds.Dx(), wantBounds.Dy()))
+	different := 0
+	for y := 0; y < wantBounds.Dy(); y++ {
+		for x := 0; x < wantBounds.Dx(); x++ {
+			gotY, gotI, gotQ := yiq(got.At(gotBounds.Min.X+x, gotBounds.Min.Y+y))
+			wantY, wantI, wantQ := yiq(want.At(wantBounds.Min.X+x, wantBounds.Min.Y+y))
+			dy, di, dq := gotY-wantY, gotI-wantI, gotQ-wantQ
+			if 0.5053*dy*dy+0.299*di*di+0.1957*dq*dq > maxAllowed {
+				different++
+				diff.Set(x, y, color.RGBA{R: 255, A: 255})
+				continue
+			}
+			gray := uint8(255 + (wantY-255)*0.1)
+			diff.Set(x, y, color.RGBA{R: gray, G: gray, B: gray, A: 255})
+		}
+	}
+	return diff, different, nil
+}
+
+// yiq converts the color, blended over white, to the YIQ color space
+func yiq(c color.Color) (float64, float64, float64) {
+	r, g, b, a := c.RGBA()
+	// the components are premultiplied by alpha, blending over white adds the white behind the transparent part
+	white := float64(0xffff - a)
+	rf := (float64(r) + white) / 0x101
+	gf := (float64(g) + white) / 0x101
+	bf := (float64(b) + white) / 0x101
+	return rf*0.29889531 + gf*0.58662247 + bf*0.11448223,
+		rf*0.59597799 - gf*0.27417610 - bf*0.32180189,
+		rf*0.21147017 - gf*0.52261711 + bf*0.31114694
+}
+
+func writeImage(path string, img image.Image) error {
+	err := os.MkdirAll(filepath.Dir(path), os.ModePerm)
+	if err != nil {
+		return err
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	err = png.Encode(file, img)
+	if err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
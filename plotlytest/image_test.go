@@ -0,0 +1,137 @@
+package plotlytest_test
+
+import (
+	"flag"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/MetalBlueberry/go-plotly/export"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/plotlytest"
+)
+
+// fakeExporter renders every figure as its image
+type fakeExporter struct {
+	img image.Image
+}
+
+func (e *fakeExporter) Export(fig *grob.Fig, w io.Writer, format export.Format, opt ...export.Options) error {
+	return png.Encode(w, e.img)
+}
+
+func (e *fakeExporter) Close() error {
+	return nil
+}
+
+// square is a white image of 10x10 with the first pixels of the color
+func square(pixels int, c color.Color) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, 10, 10))
+	for i := 0; i < 100; i++ {
+		if i < pixels {
+			img.Set(i%10, i/10, c)
+		} else {
+			img.Set(i%10, i/10, color.White)
+		}
+	}
+	return img
+}
+
+var _ = Describe("AssertEqualImage", func() {
+
+	var (
+		dir      string
+		golden   string
+		exporter *fakeExporter
+		opts     plotlytest.ImageOptions
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "plotlytest")
+		Expect(err).To(BeNil())
+		golden = filepath.Join(dir, "testdata", "fig.png")
+		exporter = &fakeExporter{img: square(0, color.Black)}
+		opts = plotlytest.ImageOptions{Exporter: exporter}
+
+		Expect(flag.Set("update", "true")).To(Succeed())
+		plotlytest.AssertEqualImage(GinkgoT(), &grob.Fig{}, golden, opts)
+		Expect(flag.Set("update", "false")).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(flag.Set("update", "false")).To(Succeed())
+		os.RemoveAll(dir)
+	})
+
+	It("Should write the golden image with -update", func() {
+		file, err := os.Open(golden)
+		Expect(err).To(BeNil())
+		defer file.Close()
+		img, err := png.Decode(file)
+		Expect(err).To(BeNil())
+		Expect(img.Bounds()).To(Equal(image.Rect(0, 0, 10, 10)))
+	})
+
+	It("Should pass with the same image", func() {
+		t := &fakeT{}
+		plotlytest.AssertEqualImage(t, &grob.Fig{}, golden, opts)
+		Expect(t.errors).To(BeEmpty())
+		_, err := os.Stat(filepath.Join(dir, "testdata", "fig.diff.png"))
+		Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+
+	It("Should tolerate small perceptual differences", func() {
+		exporter.img = square(100, color.RGBA{R: 250, G: 250, B: 250, A: 255})
+		t := &fakeT{}
+		plotlytest.AssertEqualImage(t, &grob.Fig{}, golden, opts)
+		Expect(t.errors).To(BeEmpty())
+
+		exporter.img = square(1, color.Black)
+		opts.Threshold = 0.05
+		plotlytest.AssertEqualImage(t, &grob.Fig{}, golden, opts)
+		Expect(t.errors).To(BeEmpty())
+	})
+
+	It("Should fail with an image of the differences", func() {
+		exporter.img = square(10, color.Black)
+		t := &fakeT{}
+		plotlytest.AssertEqualImage(t, &grob.Fig{}, golden, opts)
+		Expect(t.errors).To(HaveLen(1))
+		diffPath := filepath.Join(dir, "testdata", "fig.diff.png")
+		Expect(t.errors[0]).To(ContainSubstring("10 pixels (10.00%) differ, see " + diffPath))
+
+		file, err := os.Open(diffPath)
+		Expect(err).To(BeNil())
+		defer file.Close()
+		diff, err := png.Decode(file)
+		Expect(err).To(BeNil())
+		Expect(color.RGBAModel.Convert(diff.At(0, 0))).To(Equal(color.RGBA{R: 255, A: 255}))
+		Expect(color.RGBAModel.Convert(diff.At(9, 9))).To(Equal(color.RGBA{R: 255, G: 255, B: 255, A: 255}))
+	})
+
+	It("Should fail with images of different sizes", func() {
+		exporter.img = image.NewRGBA(image.Rect(0, 0, 20, 10))
+		t := &fakeT{}
+		plotlytest.AssertEqualImage(t, &grob.Fig{}, golden, opts)
+		Expect(t.errors).To(HaveLen(1))
+		Expect(t.errors[0]).To(ContainSubstring("got a 20x10 image, want 10x10"))
+	})
+})
+
+var _ = Describe("ImageDiff", func() {
+
+	It("Should blend transparent pixels over white", func() {
+		transparent := image.NewRGBA(image.Rect(0, 0, 1, 1))
+		_, different, err := plotlytest.ImageDiff(transparent, square(0, color.Black).(*image.RGBA).SubImage(image.Rect(0, 0, 1, 1)), plotlytest.DefaultPixelTolerance)
+		Expect(err).To(BeNil())
+		Expect(different).To(Equal(0))
+	})
+})
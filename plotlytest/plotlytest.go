@@ -0,0 +1,222 @@
+// Package plotlytest compares figures with golden files, to test the code that builds charts.
+//
+//	func TestSalesChart(t *testing.T) {
+//		plotlytest.AssertEqualJSON(t, SalesChart(data), "testdata/sales.json")
+//	}
+//
+// Charts treated as product output can also be compared as rendered images, with a perceptual tolerance.
+// The figures are rendered by the export package, which needs one of its backends:
+//
+//	plotlytest.AssertEqualImage(t, SalesChart(data), "testdata/sales.png")
+//
+// The golden files are written or replaced by running the tests with the -update flag, defined by this package:
+//
+//	go test ./... -update
+package plotlytest
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// DefaultTolerance is the relative difference allowed between numbers by default
+const DefaultTolerance = 1e-9
+
+// maxDifferences is the number of differences reported by AssertEqualJSON
+const maxDifferences = 20
+
+var update = flag.Bool("update", false, "write the golden files of plotlytest.AssertEqualJSON and AssertEqualImage instead of comparing them")
+
+// TestingT is the subset of testing.T used by AssertEqualJSON, it is implemented by *testing.T and ginkgo.GinkgoT()
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+	Fatalf(format string, args ...interface{})
+}
+
+// Options configure the comparison
+type Options struct {
+	// Tolerance is the relative difference allowed between numbers, defaults to DefaultTolerance
+	Tolerance float64
+	// Ignore are the attributes not compared wherever they are, defaults to uirevision
+	Ignore []string
+}
+
+func computeOptions(def Options, opt ...Options) Options {
+	if len(opt) == 1 {
+		opts := opt[0]
+		if opts.Tolerance != 0 {
+			def.Tolerance = opts.Tolerance
+		}
+		if opts.Ignore != nil {
+			def.Ignore = opts.Ignore
+		}
+	}
+	return def
+}
+
+// AssertEqualJSON compares the plotly JSON of the figure with the golden file. The order of the keys does not matter,
+// numbers may differ up to the tolerance and uirevision attributes are ignored. The test fails with the paths of the differences.
+// With the -update flag, the golden file and its directory are written instead.
+func AssertEqualJSON(t TestingT, got *grob.Fig, goldenPath string, opt ...Options) {
+	t.Helper()
+
+	data, err := got.ToPlotlyJSON()
+	if err != nil {
+		t.Fatalf("cannot marshal figure, %s", err)
+		return
+	}
+	var gotJSON interface{}
+	err = json.Unmarshal(data, &gotJSON)
+	if err != nil {
+		t.Fatalf("cannot unmarshal figure, %s", err)
+		return
+	}
+
+	if *update {
+		err = writeGolden(goldenPath, gotJSON)
+		if err != nil {
+			t.Fatalf("cannot update golden file, %s", err)
+		}
+		return
+	}
+
+	golden, err := ioutil.ReadFile(goldenPath)
+	if err != nil {
+		t.Fatalf("cannot read golden file, run the tests with -update to create it, %s", err)
+		return
+	}
+	var wantJSON interface{}
+	err = json.Unmarshal(golden, &wantJSON)
+	if err != nil {
+		t.Fatalf("cannot unmarshal golden file %s, %s", goldenPath, err)
+		return
+	}
+
+	differences := Diff(gotJSON, wantJSON, opt...)
+	if len(differences) == 0 {
+		return
+	}
+	more := ""
+	if len(differences) > maxDifferences {
+		more = fmt.Sprintf("\n... and %d more", len(differences)-maxDifferences)
+		differences = differences[:maxDifferences]
+	}
+	message := ""
+	for _, difference := range differences {
+		message += "\n" + difference
+	}
+	t.Errorf("figure does not match %s, run the tests with -update to accept the changes:%s%s", goldenPath, message, more)
+}
+
+func writeGolden(path string, value interface{}) error {
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(path), os.ModePerm)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, append(data, '\n'), 0644)
+}
+
+// Diff compares two decoded JSON values and returns a line for every difference, with its path, sorted by path.
+// Keys are compared regardless of their order.
+func Diff(got, want interface{}, opt ...Options) []string {
+	opts := computeOptions(Options{
+		Tolerance: DefaultTolerance,
+		Ignore:    []string{"uirevision"},
+	}, opt...)
+	ignore := map[string]bool{}
+	for _, key := range opts.Ignore {
+		ignore[key] = true
+	}
+
+	differences := []string{}
+	diff(got, want, "", opts.Tolerance, ignore, &differences)
+	sort.Strings(differences)
+	return differences
+}
+
+func diff(got, want interface{}, path string, tolerance float64, ignore map[string]bool, differences *[]string) {
+	switch want := want.(type) {
+	case map[string]interface{}:
+		gotMap, ok := got.(map[string]interface{})
+		if !ok {
+			break
+		}
+		keys := map[string]bool{}
+		for key := range want {
+			keys[key] = true
+		}
+		for key := range gotMap {
+			keys[key] = true
+		}
+		for key := range keys {
+			if ignore[key] {
+				continue
+			}
+			gotValue, inGot := gotMap[key]
+			wantValue, inWant := want[key]
+			switch {
+			case !inGot:
+				*differences = append(*differences, fmt.Sprintf("%s: missing, want %s", join(path, key), format(wantValue)))
+			case !inWant:
+				*differences = append(*differences, fmt.Sprintf("%s: unexpected %s", join(path, key), format(gotValue)))
+			default:
+				diff(gotValue, wantValue, join(path, key), tolerance, ignore, differences)
+			}
+		}
+		return
+	case []interface{}:
+		gotArray, ok := got.([]interface{})
+		if !ok {
+			break
+		}
+		if len(gotArray) != len(want) {
+			*differences = append(*differences, fmt.Sprintf("%s: got %d items, want %d", path, len(gotArray), len(want)))
+			return
+		}
+		for i := range want {
+			diff(gotArray[i], want[i], fmt.Sprintf("%s[%d]", path, i), tolerance, ignore, differences)
+		}
+		return
+	case float64:
+		gotNumber, ok := got.(float64)
+		if ok && math.Abs(gotNumber-want) <= tolerance*math.Max(math.Abs(gotNumber), math.Abs(want)) {
+			return
+		}
+	default:
+		if got == want {
+			return
+		}
+	}
+	*differences = append(*differences, fmt.Sprintf("%s: got %s, want %s", path, format(got), format(want)))
+}
+
+func format(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	if len(data) > 80 {
+		return string(data[:77]) + "..."
+	}
+	return string(data)
+}
+
+func join(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
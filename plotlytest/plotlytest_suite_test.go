@@ -0,0 +1,13 @@
+package plotlytest_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestPlotlytest(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Plotlytest Suite")
+}
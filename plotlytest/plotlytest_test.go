@@ -0,0 +1,117 @@
+package plotlytest_test
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/plotlytest"
+)
+
+// fakeT records the failures instead of failing the test
+type fakeT struct {
+	errors []string
+	fatal  bool
+}
+
+func (t *fakeT) Helper() {}
+
+func (t *fakeT) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func (t *fakeT) Fatalf(format string, args ...interface{}) {
+	t.Errorf(format, args...)
+	t.fatal = true
+}
+
+var _ = Describe("AssertEqualJSON", func() {
+
+	var (
+		dir    string
+		golden string
+		fig    *grob.Fig
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "plotlytest")
+		Expect(err).To(BeNil())
+		golden = filepath.Join(dir, "testdata", "fig.json")
+		fig = &grob.Fig{}
+		_, err = fig.AddScatter([]float64{1, 2}, []float64{0.1, 0.2}, grob.SetTrace("name", "series"))
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		Expect(flag.Set("update", "false")).To(Succeed())
+		os.RemoveAll(dir)
+	})
+
+	update := func() {
+		Expect(flag.Set("update", "true")).To(Succeed())
+		plotlytest.AssertEqualJSON(GinkgoT(), fig, golden)
+		Expect(flag.Set("update", "false")).To(Succeed())
+	}
+
+	It("Should fail without golden file", func() {
+		t := &fakeT{}
+		plotlytest.AssertEqualJSON(t, fig, golden)
+		Expect(t.fatal).To(BeTrue())
+		Expect(t.errors[0]).To(ContainSubstring("-update"))
+	})
+
+	It("Should write the golden file with -update and then match it", func() {
+		update()
+		Expect(golden).To(BeAnExistingFile())
+
+		t := &fakeT{}
+		plotlytest.AssertEqualJSON(t, fig, golden)
+		Expect(t.errors).To(BeEmpty())
+	})
+
+	It("Should ignore the key order, small float differences and uirevision", func() {
+		Expect(os.MkdirAll(filepath.Dir(golden), os.ModePerm)).To(Succeed())
+		Expect(ioutil.WriteFile(golden, []byte(`{
+			"layout": {"uirevision": "old"},
+			"data": [{"y": [0.1, 0.20000000000000004], "x": [1, 2], "name": "series", "type": "scatter"}]
+		}`), 0644)).To(Succeed())
+		fig.Layout = &grob.Layout{Uirevision: "new"}
+
+		t := &fakeT{}
+		plotlytest.AssertEqualJSON(t, fig, golden)
+		Expect(t.errors).To(BeEmpty())
+	})
+
+	It("Should report the paths of the differences", func() {
+		update()
+		fig.Data[0].(*grob.Scatter).Name = "renamed"
+		fig.Data[0].(*grob.Scatter).Y = []float64{0.1, 0.3}
+		fig.Data[0].(*grob.Scatter).Mode = grob.ScatterModeLines
+
+		t := &fakeT{}
+		plotlytest.AssertEqualJSON(t, fig, golden)
+		Expect(t.fatal).To(BeFalse())
+		Expect(t.errors).To(HaveLen(1))
+		Expect(t.errors[0]).To(ContainSubstring(`data[0].name: got "renamed", want "series"`))
+		Expect(t.errors[0]).To(ContainSubstring(`data[0].y[1]: got 0.3, want 0.2`))
+		Expect(t.errors[0]).To(ContainSubstring(`data[0].mode: unexpected "lines"`))
+	})
+})
+
+var _ = Describe("Diff", func() {
+	It("Should use the tolerance and the ignored keys", func() {
+		got := map[string]interface{}{"a": 1.0, "b": []interface{}{"x"}, "c": 1.0}
+		want := map[string]interface{}{"a": 1.01, "b": []interface{}{"x", "y"}, "c": 2.0}
+		Expect(plotlytest.Diff(got, want, plotlytest.Options{Tolerance: 0.1, Ignore: []string{"c"}})).To(Equal([]string{
+			"b: got 1 items, want 2",
+		}))
+		Expect(plotlytest.Diff(got, want)).To(HaveLen(3))
+	})
+})
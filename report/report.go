@@ -0,0 +1,219 @@
+// Package report writes figures with headings, captions and text into paginated PDF documents,
+// for the daily or weekly reports built by scheduled jobs. The figures are rendered to images with the export package.
+//
+//	err := report.PDF(file, []report.Section{
+//		{Heading: "Sales", Fig: salesChart, Caption: "Sales by region over the last 7 days"},
+//		{Heading: "Incidents", Text: summary, Fig: incidentsChart},
+//	}, report.Options{Title: "Weekly report"})
+package report
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/jung-kurt/gofpdf"
+
+	"github.com/MetalBlueberry/go-plotly/export"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// PageSize is the size of the pages of the document
+type PageSize string
+
+const (
+	PageA4     PageSize = "A4"
+	PageA3     PageSize = "A3"
+	PageLetter PageSize = "Letter"
+	PageLegal  PageSize = "Legal"
+)
+
+// margin of the pages in millimeters
+const margin = 15.0
+
+// Section is a part of the report, with a heading, a paragraph of text and a figure with its caption. All of them are optional
+type Section struct {
+	// Heading is the title of the section
+	Heading string
+	// Text is a paragraph written before the figure
+	Text string
+	// Fig is rendered as an image as wide as the page, or shorter if it does not fit in the height of the page
+	Fig *grob.Fig
+	// Caption is written below the figure
+	Caption string
+	// PageBreak starts the section on a new page. Sections also start on a new page when their heading and figure do not fit in the current one
+	PageBreak bool
+}
+
+// Options configure the report
+type Options struct {
+	// Title is written at the top of the first page and set as the title of the document
+	Title string
+	// Author is set as the author of the document
+	Author string
+	// PageSize defaults to PageA4
+	PageSize PageSize
+	// Landscape turns the pages
+	Landscape bool
+	// Export are the options of the images, like their size in pixels. Without size, the size of the layout is used.
+	// The scale defaults to 2, so the figures stay sharp when printed
+	Export export.Options
+	// Exporter renders the figures, to share a backend between reports. Defaults to a new exporter for every report
+	Exporter export.Exporter
+}
+
+func computeOptions(def Options, opt ...Options) Options {
+	if len(opt) == 1 {
+		opts := opt[0]
+		if opts.Title != "" {
+			def.Title = opts.Title
+		}
+		if opts.Author != "" {
+			def.Author = opts.Author
+		}
+		if opts.PageSize != "" {
+			def.PageSize = opts.PageSize
+		}
+		if opts.Landscape {
+			def.Landscape = opts.Landscape
+		}
+		scale := def.Export.Scale
+		def.Export = opts.Export
+		if def.Export.Scale == 0 {
+			def.Export.Scale = scale
+		}
+		if opts.Exporter != nil {
+			def.Exporter = opts.Exporter
+		}
+	}
+	return def
+}
+
+// PDF renders the figures of the sections concurrently and writes the report as a PDF document to w.
+// The pages are numbered in their footer. Text is written with the standard PDF fonts, which cover the Western European languages.
+func PDF(w io.Writer, sections []Section, opt ...Options) error {
+	opts := computeOptions(Options{
+		PageSize: PageA4,
+		Export:   export.Options{Scale: 2},
+	}, opt...)
+
+	figs := []*grob.Fig{}
+	figSections := []int{}
+	for i, section := range sections {
+		if section.Fig != nil {
+			figs = append(figs, section.Fig)
+			figSections = append(figSections, i)
+		}
+	}
+	// images of the figures by section
+	images := map[int][]byte{}
+	if len(figs) > 0 {
+		rendered, err := export.Batch(context.Background(), figs, export.BatchOptions{
+			Options:  opts.Export,
+			Format:   export.FormatPNG,
+			Exporter: opts.Exporter,
+		})
+		var batchErr export.BatchError
+		if errors.As(err, &batchErr) {
+			for i := range figs {
+				if batchErr[i] != nil {
+					return fmt.Errorf("cannot render the figure of section %d, %w", figSections[i], batchErr[i])
+				}
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("cannot render figures, %w", err)
+		}
+		for i, image := range rendered {
+			images[figSections[i]] = image
+		}
+	}
+
+	orientation := "P"
+	if opts.Landscape {
+		orientation = "L"
+	}
+	pdf := gofpdf.New(orientation, "mm", string(opts.PageSize), "")
+	pdf.SetMargins(margin, margin, margin)
+	pdf.SetAutoPageBreak(true, margin)
+	pdf.SetTitle(opts.Title, true)
+	pdf.SetAuthor(opts.Author, true)
+	pdf.AliasNbPages("")
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-margin + 5)
+		pdf.SetFont("Helvetica", "", 8)
+		pdf.SetTextColor(128, 128, 128)
+		pdf.CellFormat(0, 5, fmt.Sprintf("%d / {nb}", pdf.PageNo()), "", 0, "C", false, 0, "")
+	})
+	// the standard fonts are encoded in cp1252
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	pdf.AddPage()
+
+	if opts.Title != "" {
+		pdf.SetFont("Helvetica", "B", 20)
+		pdf.MultiCell(0, 10, tr(opts.Title), "", "L", false)
+		pdf.Ln(4)
+	}
+
+	pageWidth, pageHeight := pdf.GetPageSize()
+	contentWidth, contentHeight := pageWidth-2*margin, pageHeight-2*margin
+	for i, section := range sections {
+		if section.PageBreak && pdf.GetY() > margin {
+			pdf.AddPage()
+		}
+
+		var name string
+		var width, height float64
+		if section.Fig != nil {
+			name = fmt.Sprintf("figure%d", i)
+			info := pdf.RegisterImageOptionsReader(name, gofpdf.ImageOptions{ImageType: "PNG"}, bytes.NewReader(images[i]))
+			if info == nil || !pdf.Ok() {
+				return fmt.Errorf("cannot read the image of section %d, %w", i, pdf.Error())
+			}
+			// the figure, its heading and its caption fit in a page
+			width, height = contentWidth, contentWidth*info.Height()/info.Width()
+			if maxHeight := contentHeight - 30; height > maxHeight {
+				width, height = width*maxHeight/height, maxHeight
+			}
+		}
+
+		// the heading is kept with the text and the figure that follow it
+		needed := height
+		if section.Heading != "" {
+			needed += 12
+		}
+		if section.Text != "" {
+			pdf.SetFont("Helvetica", "", 11)
+			needed += 6 * float64(len(pdf.SplitLines([]byte(tr(section.Text)), contentWidth)))
+		}
+		if pdf.GetY() > margin && pdf.GetY()+needed > pageHeight-margin {
+			pdf.AddPage()
+		}
+
+		if section.Heading != "" {
+			pdf.SetFont("Helvetica", "B", 14)
+			pdf.SetTextColor(0, 0, 0)
+			pdf.MultiCell(0, 8, tr(section.Heading), "", "L", false)
+			pdf.Ln(2)
+		}
+		if section.Text != "" {
+			pdf.SetFont("Helvetica", "", 11)
+			pdf.SetTextColor(0, 0, 0)
+			pdf.MultiCell(0, 6, tr(section.Text), "", "L", false)
+			pdf.Ln(2)
+		}
+		if name != "" {
+			pdf.ImageOptions(name, margin+(contentWidth-width)/2, pdf.GetY(), width, height, true, gofpdf.ImageOptions{}, 0, "")
+		}
+		if section.Caption != "" {
+			pdf.SetFont("Helvetica", "I", 10)
+			pdf.SetTextColor(80, 80, 80)
+			pdf.MultiCell(0, 5, tr(section.Caption), "", "C", false)
+		}
+		pdf.Ln(6)
+	}
+
+	return pdf.Output(w)
+}
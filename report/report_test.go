@@ -0,0 +1,96 @@
+package report_test
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/png"
+	"io"
+	"regexp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/MetalBlueberry/go-plotly/export"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/report"
+)
+
+// fakeExporter renders every figure as a gray image, lighter for every trace, or fails for the figures without layout
+type fakeExporter struct {
+	width, height int
+}
+
+func (e *fakeExporter) Export(fig *grob.Fig, w io.Writer, format export.Format, opt ...export.Options) error {
+	if fig.Layout == nil {
+		return errors.New("no layout")
+	}
+	img := image.NewGray(image.Rect(0, 0, e.width, e.height))
+	for i := range img.Pix {
+		img.Pix[i] = uint8(100 + 10*len(fig.Data))
+	}
+	return png.Encode(w, img)
+}
+
+func (e *fakeExporter) Close() error {
+	return nil
+}
+
+// pages returns the number of pages of the document
+func pages(document []byte) string {
+	match := regexp.MustCompile(`/Count (\d+)`).FindSubmatch(document)
+	if match == nil {
+		return ""
+	}
+	return string(match[1])
+}
+
+var _ = Describe("Report", func() {
+
+	var opts report.Options
+
+	BeforeEach(func() {
+		opts = report.Options{
+			Title:    "Weekly report – Zürich",
+			Exporter: &fakeExporter{width: 700, height: 450},
+		}
+	})
+
+	It("Should write the sections in a PDF document", func() {
+		buf := &bytes.Buffer{}
+		err := report.PDF(buf, []report.Section{
+			{Heading: "Sales", Text: "Sales grew in every region.", Fig: &grob.Fig{Layout: &grob.Layout{}}, Caption: "Sales by region"},
+			{Heading: "Incidents", Fig: &grob.Fig{Data: grob.Traces{&grob.Bar{}}, Layout: &grob.Layout{}}},
+			{Heading: "Notes", Text: "Nothing to report.", PageBreak: true},
+		}, opts)
+		Expect(err).To(BeNil())
+		Expect(buf.String()).To(HavePrefix("%PDF-"))
+		Expect(pages(buf.Bytes())).To(Equal("3"))
+		Expect(bytes.Count(buf.Bytes(), []byte("/Subtype /Image"))).To(Equal(2))
+	})
+
+	It("Should keep the figures in the height of the page", func() {
+		opts.Exporter = &fakeExporter{width: 100, height: 1000}
+		opts.Landscape = true
+		buf := &bytes.Buffer{}
+		err := report.PDF(buf, []report.Section{
+			{Heading: "Tall", Fig: &grob.Fig{Layout: &grob.Layout{}}},
+		}, opts)
+		Expect(err).To(BeNil())
+		Expect(pages(buf.Bytes())).To(Equal("1"))
+	})
+
+	It("Should report the sections whose figure cannot be rendered", func() {
+		err := report.PDF(&bytes.Buffer{}, []report.Section{
+			{Heading: "Text only"},
+			{Heading: "Broken", Fig: &grob.Fig{}},
+		}, opts)
+		Expect(err).To(MatchError("cannot render the figure of section 1, no layout"))
+	})
+
+	It("Should write reports without figures", func() {
+		buf := &bytes.Buffer{}
+		Expect(report.PDF(buf, []report.Section{{Text: "Empty week"}}, report.Options{PageSize: report.PageLetter})).To(Succeed())
+		Expect(pages(buf.Bytes())).To(Equal("1"))
+	})
+})
@@ -0,0 +1,156 @@
+// Package sankey builds sankey diagrams from a list of edges between named nodes.
+//
+// The sankey trace of plotly.js describes the nodes and the links with parallel arrays and the links refer to the nodes by index.
+// New computes those arrays from the edges, so the nodes are only referred to by name.
+//
+//	trace, err := sankey.New([]sankey.Edge{
+//		{Source: "coal", Target: "electricity", Value: 25},
+//		{Source: "electricity", Target: "homes", Value: 10},
+//	})
+package sankey
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/MetalBlueberry/go-plotly/colors"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// Edge is a flow of Value from the Source node to the Target node
+type Edge struct {
+	Source string
+	Target string
+	Value  float64
+}
+
+// Options configure the diagram
+type Options struct {
+	// NodeColors are the colors of the nodes by name
+	NodeColors map[string]string
+	// Palette colors the nodes without a color in NodeColors, in order of appearance.
+	// If nil, plotly.js chooses the colors of all the nodes
+	Palette colors.Palette
+	// LinkOpacity colors the links like their source node with this opacity, between 0 and 1.
+	// Only hex and rgb colors can be made transparent, the links of nodes with other colors are grey.
+	// If 0, the links are grey
+	LinkOpacity float64
+}
+
+// New builds a sankey trace with a node per distinct name, in order of appearance in the edges.
+// Edges between the same nodes are merged by summing their values.
+func New(edges []Edge, opt ...Options) (*grob.Sankey, error) {
+	opts := Options{}
+	if len(opt) == 1 {
+		opts = opt[0]
+	}
+	if opts.LinkOpacity < 0 || opts.LinkOpacity > 1 {
+		return nil, fmt.Errorf("link opacity %g must be between 0 and 1", opts.LinkOpacity)
+	}
+
+	index := map[string]int{}
+	labels := []string{}
+	node := func(name string) int {
+		i, ok := index[name]
+		if !ok {
+			i = len(labels)
+			index[name] = i
+			labels = append(labels, name)
+		}
+		return i
+	}
+
+	type pair struct{ source, target int }
+	links := map[pair]int{}
+	sources, targets := []int{}, []int{}
+	values := []float64{}
+	for _, edge := range edges {
+		if edge.Source == edge.Target {
+			return nil, fmt.Errorf("edge from %s to itself, sankey diagrams cannot have loops", edge.Source)
+		}
+		if edge.Value < 0 {
+			return nil, fmt.Errorf("edge from %s to %s has a negative value %g", edge.Source, edge.Target, edge.Value)
+		}
+		p := pair{node(edge.Source), node(edge.Target)}
+		if i, ok := links[p]; ok {
+			values[i] += edge.Value
+			continue
+		}
+		links[p] = len(values)
+		sources = append(sources, p.source)
+		targets = append(targets, p.target)
+		values = append(values, edge.Value)
+	}
+
+	trace := &grob.Sankey{
+		Type: grob.TraceTypeSankey,
+		Node: &grob.SankeyNode{
+			Label: labels,
+		},
+		Link: &grob.SankeyLink{
+			Source: sources,
+			Target: targets,
+			Value:  values,
+		},
+	}
+
+	if opts.NodeColors == nil && opts.Palette == nil {
+		return trace, nil
+	}
+	nodeColors := make([]string, len(labels))
+	next := 0
+	for i, label := range labels {
+		if color, ok := opts.NodeColors[label]; ok {
+			nodeColors[i] = color
+		} else if opts.Palette != nil {
+			nodeColors[i] = opts.Palette.Color(next)
+			next++
+		}
+	}
+	trace.Node.Color = nodeColors
+
+	if opts.LinkOpacity > 0 {
+		linkColors := make([]string, len(sources))
+		for i, source := range sources {
+			color, ok := transparent(nodeColors[source], opts.LinkOpacity)
+			if !ok {
+				color = fmt.Sprintf("rgba(128,128,128,%g)", opts.LinkOpacity)
+			}
+			linkColors[i] = color
+		}
+		trace.Link.Color = linkColors
+	}
+	return trace, nil
+}
+
+// transparent converts a hex or rgb color to rgba with the given opacity
+func transparent(color string, opacity float64) (string, bool) {
+	var channels []string
+	switch {
+	case strings.HasPrefix(color, "#") && len(color) == 4:
+		channels = []string{color[1:2] + color[1:2], color[2:3] + color[2:3], color[3:4] + color[3:4]}
+	case strings.HasPrefix(color, "#") && len(color) == 7:
+		channels = []string{color[1:3], color[3:5], color[5:7]}
+	case strings.HasPrefix(color, "rgb(") && strings.HasSuffix(color, ")"):
+		parts := strings.Split(strings.TrimSuffix(strings.TrimPrefix(color, "rgb("), ")"), ",")
+		if len(parts) != 3 {
+			return "", false
+		}
+		for i := range parts {
+			parts[i] = strings.TrimSpace(parts[i])
+		}
+		return fmt.Sprintf("rgba(%s,%g)", strings.Join(parts, ","), opacity), true
+	default:
+		return "", false
+	}
+	values := make([]string, 3)
+	for i, channel := range channels {
+		v, err := strconv.ParseUint(channel, 16, 8)
+		if err != nil {
+			return "", false
+		}
+		values[i] = strconv.FormatUint(v, 10)
+	}
+	return fmt.Sprintf("rgba(%s,%g)", strings.Join(values, ","), opacity), true
+}
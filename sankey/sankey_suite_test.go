@@ -0,0 +1,13 @@
+package sankey_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestSankey(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Sankey Suite")
+}
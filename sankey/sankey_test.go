@@ -0,0 +1,52 @@
+package sankey_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/MetalBlueberry/go-plotly/colors"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/sankey"
+)
+
+var _ = Describe("Sankey", func() {
+
+	edges := []sankey.Edge{
+		{Source: "coal", Target: "electricity", Value: 25},
+		{Source: "gas", Target: "electricity", Value: 15},
+		{Source: "electricity", Target: "homes", Value: 10},
+		{Source: "coal", Target: "electricity", Value: 5},
+	}
+
+	It("Should index the nodes in order of appearance and merge the edges", func() {
+		trace, err := sankey.New(edges)
+		Expect(err).To(BeNil())
+		Expect(trace.Type).To(Equal(grob.TraceTypeSankey))
+		Expect(trace.Node.Label).To(Equal([]string{"coal", "electricity", "gas", "homes"}))
+		Expect(trace.Node.Color).To(BeNil())
+		Expect(trace.Link.Source).To(Equal([]int{0, 2, 1}))
+		Expect(trace.Link.Target).To(Equal([]int{1, 1, 3}))
+		Expect(trace.Link.Value).To(Equal([]float64{30, 15, 10}))
+		Expect(trace.Link.Color).To(BeNil())
+	})
+
+	It("Should color the nodes and the links", func() {
+		trace, err := sankey.New(edges, sankey.Options{
+			NodeColors:  map[string]string{"coal": "black", "gas": "rgb(0, 0, 255)"},
+			Palette:     colors.Plotly,
+			LinkOpacity: 0.4,
+		})
+		Expect(err).To(BeNil())
+		Expect(trace.Node.Color).To(Equal([]string{"black", "#636EFA", "rgb(0, 0, 255)", "#EF553B"}))
+		Expect(trace.Link.Color).To(Equal([]string{"rgba(128,128,128,0.4)", "rgba(0,0,255,0.4)", "rgba(99,110,250,0.4)"}))
+	})
+
+	It("Should reject invalid edges", func() {
+		_, err := sankey.New([]sankey.Edge{{Source: "a", Target: "a", Value: 1}})
+		Expect(err).ToNot(BeNil())
+		_, err = sankey.New([]sankey.Edge{{Source: "a", Target: "b", Value: -1}})
+		Expect(err).ToNot(BeNil())
+		_, err = sankey.New(edges, sankey.Options{LinkOpacity: 2})
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,263 @@
+// Package spec builds figures from declarative specs, YAML or JSON files that name a data file and how to plot it,
+// so the figures are rebuilt when the data changes without writing code.
+//
+//	data: readings.csv
+//	kind: line
+//	x: time
+//	y: [temp, pressure]
+//	title: Sensors
+//
+// The data files are read with the ingest package and plotted with the express charts. Figures that need more
+// are declared with their plotly traces and layout, the columns of the traces name the columns of the data:
+//
+//	data: readings.csv
+//	traces:
+//	  - type: scatter
+//	    mode: lines
+//	    name: Temperature
+//	    columns: {x: time, y: temp}
+//	  - type: bar
+//	    data: alarms.csv
+//	    columns: {x: time, y: count}
+//	layout:
+//	  yaxis: {title: {text: °C}}
+//
+//	s, err := spec.Load("sensors.yaml")
+//	fig, err := s.Figure()
+package spec
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/MetalBlueberry/go-plotly/express"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/ingest"
+	"github.com/MetalBlueberry/go-plotly/themes"
+)
+
+// Kind is the chart used to plot the columns
+type Kind string
+
+const (
+	KindLine      Kind = "line"
+	KindScatter   Kind = "scatter"
+	KindBar       Kind = "bar"
+	KindHistogram Kind = "histogram"
+	KindBox       Kind = "box"
+)
+
+// Spec describes a figure built from a data file
+type Spec struct {
+	// Data is the CSV or JSON file of the data, relative to the spec file. The format is given by its extension,
+	// JSON files are arrays of records. It can be omitted if every trace has its data file
+	Data string `yaml:"data" json:"data"`
+	// Traces are the plotly traces of the figure. If set, they are plotted instead of the chart of Kind, X and Y
+	Traces []Trace `yaml:"traces" json:"traces"`
+	// Layout are the plotly attributes of the layout, like legend: {orientation: h}. They are set over the titles of the spec
+	Layout map[string]interface{} `yaml:"layout" json:"layout"`
+	// Kind of chart, defaults to line
+	Kind Kind `yaml:"kind" json:"kind"`
+	// X is the column of the x values, defaults to the row index
+	X string `yaml:"x" json:"x"`
+	// Y are the columns plotted, a trace each. Defaults to every numeric column but X
+	Y []string `yaml:"y" json:"y"`
+	// Title of the figure
+	Title string `yaml:"title" json:"title"`
+	// XTitle and YTitle are the titles of the axes, they default to the names of the columns
+	XTitle string `yaml:"xtitle" json:"xtitle"`
+	YTitle string `yaml:"ytitle" json:"ytitle"`
+	// Comma is the field delimiter of CSV files, defaults to ,
+	Comma string `yaml:"comma" json:"comma"`
+
+	// dir is the directory of the spec file, the data file is relative to it
+	dir string
+}
+
+// Load reads the spec file, YAML or JSON
+func Load(file string) (*Spec, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read spec, %w", err)
+	}
+	s := &Spec{}
+	err = yaml.UnmarshalStrict(data, s)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode spec %s, %w", file, err)
+	}
+	if s.Data == "" && len(s.Traces) == 0 {
+		return nil, fmt.Errorf("spec %s has no data file", file)
+	}
+	for i, trace := range s.Traces {
+		if s.Data == "" && trace.Data == "" {
+			return nil, fmt.Errorf("trace %d of spec %s has no data file", i, file)
+		}
+	}
+	s.dir = filepath.Dir(file)
+	return s, nil
+}
+
+// DataFile returns the path of the data file
+func (s *Spec) DataFile() string {
+	return s.path(s.Data)
+}
+
+// DataFiles returns the paths of the data files of the spec and of its traces
+func (s *Spec) DataFiles() []string {
+	files := []string{}
+	seen := map[string]bool{}
+	for _, data := range append([]string{s.Data}, s.traceData()...) {
+		if data == "" || seen[data] {
+			continue
+		}
+		seen[data] = true
+		files = append(files, s.path(data))
+	}
+	return files
+}
+
+// path returns the path of a file relative to the spec file
+func (s *Spec) path(file string) string {
+	if filepath.IsAbs(file) {
+		return file
+	}
+	return filepath.Join(s.dir, file)
+}
+
+// Figure reads the data files and plots them
+func (s *Spec) Figure() (*grob.Fig, error) {
+	if len(s.Traces) > 0 {
+		return s.traces()
+	}
+
+	table, err := s.read(s.Data, ingest.Options{
+		X: s.X,
+		Y: s.Y,
+	})
+	if err != nil {
+		return nil, err
+	}
+	fig, err := Chart(table, s.Kind, express.Options{
+		Title:  s.Title,
+		XTitle: s.XTitle,
+		YTitle: s.YTitle,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(s.Layout) == 0 {
+		return fig, nil
+	}
+	layout, err := json.Marshal(fig.Layout)
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal layout, %w", err)
+	}
+	attributes := map[string]interface{}{}
+	err = json.Unmarshal(layout, &attributes)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode layout, %w", err)
+	}
+	merge(attributes, jsonValue(s.Layout).(map[string]interface{}))
+	decoded, err := decode(nil, attributes)
+	if err != nil {
+		return nil, err
+	}
+	fig.Layout = decoded.Layout
+	return fig, nil
+}
+
+// read reads the data file with the delimiter of the spec
+func (s *Spec) read(data string, opts ingest.Options) (*ingest.Table, error) {
+	if s.Comma != "" {
+		comma, size := utf8.DecodeRuneInString(s.Comma)
+		if size != len(s.Comma) {
+			return nil, fmt.Errorf("the delimiter must be a single character, got %q", s.Comma)
+		}
+		opts.Comma = comma
+	}
+
+	file, err := os.Open(s.path(data))
+	if err != nil {
+		return nil, fmt.Errorf("cannot open data, %w", err)
+	}
+	defer file.Close()
+	switch ext := strings.ToLower(filepath.Ext(data)); ext {
+	case ".csv":
+		return ingest.FromCSV(file, opts)
+	case ".json":
+		return ingest.FromJSON(file, opts)
+	default:
+		return nil, fmt.Errorf("cannot read data %s, the extension must be csv or json", data)
+	}
+}
+
+// Chart plots the Y columns of the table with the chart of the kind. Like Table.Figure, the axis titles default to
+// the names of the columns and the columns are named in the legend if there are several, each with a color of the plotly colorway.
+func Chart(table *ingest.Table, kind Kind, opts express.Options) (*grob.Fig, error) {
+	var chart func(x, y interface{}, opts express.Options) *grob.Fig
+	switch kind {
+	case KindLine, "":
+		chart = func(x, y interface{}, opts express.Options) *grob.Fig {
+			return express.Line(x, y, opts)
+		}
+	case KindScatter:
+		chart = func(x, y interface{}, opts express.Options) *grob.Fig {
+			return express.Scatter(x, y, opts)
+		}
+	case KindBar:
+		chart = func(x, y interface{}, opts express.Options) *grob.Fig {
+			return express.Bar(x, y, opts)
+		}
+	case KindHistogram:
+		chart = func(x, y interface{}, opts express.Options) *grob.Fig {
+			// the column is on the x axis and the counts on the y axis
+			opts.XTitle, opts.YTitle = opts.YTitle, ""
+			return express.Histogram(y, opts)
+		}
+	case KindBox:
+		chart = func(x, y interface{}, opts express.Options) *grob.Fig {
+			opts.XTitle = ""
+			return express.Box(y, opts)
+		}
+	default:
+		return nil, fmt.Errorf("kind %s is not supported, it must be line, scatter, bar, histogram or box", kind)
+	}
+	if len(table.Y) == 0 {
+		return nil, fmt.Errorf("there are no columns to plot")
+	}
+
+	var x interface{}
+	if table.X != "" {
+		x = table.Columns[table.X]
+		if opts.XTitle == "" {
+			opts.XTitle = table.X
+		}
+	}
+	if len(table.Y) == 1 {
+		if opts.YTitle == "" {
+			opts.YTitle = table.Y[0]
+		}
+		return chart(x, table.Columns[table.Y[0]], opts), nil
+	}
+
+	var fig *grob.Fig
+	for i, name := range table.Y {
+		opts.Name = name
+		opts.Color = themes.PlotlyColorway[i%len(themes.PlotlyColorway)]
+		column := chart(x, table.Columns[name], opts)
+		if fig == nil {
+			fig = column
+			continue
+		}
+		fig.AddTraces(column.Data...)
+	}
+	fig.Layout.Showlegend = grob.True
+	return fig, nil
+}
@@ -0,0 +1,190 @@
+package spec_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/MetalBlueberry/go-plotly/express"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/ingest"
+	"github.com/MetalBlueberry/go-plotly/spec"
+)
+
+var _ = Describe("Spec", func() {
+
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "spec")
+		Expect(err).To(BeNil())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	write := func(name, content string) string {
+		file := filepath.Join(dir, name)
+		Expect(ioutil.WriteFile(file, []byte(content), 0644)).To(Succeed())
+		return file
+	}
+
+	It("Should plot the data file of the spec", func() {
+		write("readings.csv", "time,temp,pressure\n2024-01-01 10:00,20.5,1013\n2024-01-01 11:00,21,1012\n")
+		file := write("sensors.yaml", "data: readings.csv\nkind: scatter\nx: time\ny: [temp, pressure]\ntitle: Sensors\n")
+
+		s, err := spec.Load(file)
+		Expect(err).To(BeNil())
+		Expect(s.DataFile()).To(Equal(filepath.Join(dir, "readings.csv")))
+		fig, err := s.Figure()
+		Expect(err).To(BeNil())
+		Expect(fig.Data).To(HaveLen(2))
+		Expect(fig.Data[0].(*grob.Scatter).Name).To(Equal("temp"))
+		Expect(fig.Data[0].(*grob.Scatter).Mode).To(Equal(grob.ScatterModeMarkers))
+		Expect(fig.Data[1].(*grob.Scatter).Y).To(Equal([]float64{1013, 1012}))
+		Expect(fig.Layout.Title.Text).To(Equal("Sensors"))
+		Expect(fig.Layout.Showlegend).To(Equal(grob.True))
+	})
+
+	It("Should read JSON specs and data", func() {
+		write("readings.json", `[{"day": 1, "temp": 20}, {"day": 2, "temp": 22}]`)
+		file := write("sensors.json", `{"data": "readings.json", "kind": "bar", "x": "day"}`)
+
+		s, err := spec.Load(file)
+		Expect(err).To(BeNil())
+		fig, err := s.Figure()
+		Expect(err).To(BeNil())
+		Expect(fig.Data).To(HaveLen(1))
+		bar := fig.Data[0].(*grob.Bar)
+		Expect(bar.X).To(Equal([]float64{1, 2}))
+		Expect(bar.Y).To(Equal([]float64{20, 22}))
+		Expect(fig.Layout.Xaxis.Title.Text).To(Equal("day"))
+		Expect(fig.Layout.Yaxis.Title.Text).To(Equal("temp"))
+	})
+
+	It("Should fail with invalid specs", func() {
+		_, err := spec.Load(write("empty.yaml", "kind: line\n"))
+		Expect(err).NotTo(BeNil())
+		_, err = spec.Load(write("unknown.yaml", "data: a.csv\ncolour: red\n"))
+		Expect(err).NotTo(BeNil())
+
+		write("readings.csv", "temp\n1\n")
+		s, err := spec.Load(write("pie.yaml", "data: readings.csv\nkind: pie\n"))
+		Expect(err).To(BeNil())
+		_, err = s.Figure()
+		Expect(err).To(MatchError("kind pie is not supported, it must be line, scatter, bar, histogram or box"))
+	})
+
+	It("Should plot the traces of the spec", func() {
+		write("readings.csv", "time,temp,sensor\n1,20.5,a\n2,21,b\n")
+		write("alarms.json", `[{"time": 2, "count": 3}]`)
+		file := write("sensors.yaml", `data: readings.csv
+title: Sensors
+traces:
+  - mode: lines
+    name: Temperature
+    columns: {x: time, y: temp, text: sensor}
+    line: {dash: dot}
+  - type: bar
+    data: alarms.json
+    columns: {x: time, y: count, marker.color: count}
+layout:
+  title: {font: {size: 20}}
+  yaxis: {title: {text: °C}}
+`)
+
+		s, err := spec.Load(file)
+		Expect(err).To(BeNil())
+		Expect(s.DataFiles()).To(Equal([]string{filepath.Join(dir, "readings.csv"), filepath.Join(dir, "alarms.json")}))
+		fig, err := s.Figure()
+		Expect(err).To(BeNil())
+		Expect(fig.Data).To(HaveLen(2))
+		scatter := fig.Data[0].(*grob.Scatter)
+		Expect(scatter.Name).To(Equal("Temperature"))
+		Expect(scatter.Mode).To(Equal(grob.ScatterModeLines))
+		Expect(scatter.X).To(Equal([]interface{}{1.0, 2.0}))
+		Expect(scatter.Y).To(Equal([]interface{}{20.5, 21.0}))
+		Expect(scatter.Text).To(Equal([]interface{}{"a", "b"}))
+		Expect(scatter.Line.Dash).To(Equal("dot"))
+		bar := fig.Data[1].(*grob.Bar)
+		Expect(bar.Y).To(Equal([]interface{}{3.0}))
+		Expect(bar.Marker.Color).To(Equal([]interface{}{3.0}))
+		Expect(fig.Layout.Title.Text).To(Equal("Sensors"))
+		Expect(fig.Layout.Title.Font.Size).To(Equal(20.0))
+		Expect(fig.Layout.Yaxis.Title.Text).To(Equal("°C"))
+	})
+
+	It("Should set the layout over the chart", func() {
+		write("readings.csv", "temp\n1\n2\n")
+		s, err := spec.Load(write("sensors.yaml", "data: readings.csv\nlayout:\n  legend: {orientation: h}\n"))
+		Expect(err).To(BeNil())
+		fig, err := s.Figure()
+		Expect(err).To(BeNil())
+		Expect(fig.Layout.Legend.Orientation).To(Equal(grob.LayoutLegendOrientationH))
+		Expect(fig.Layout.Yaxis.Title.Text).To(Equal("temp"))
+	})
+
+	It("Should fail with invalid traces", func() {
+		write("readings.csv", "temp\n1\n")
+		_, err := spec.Load(write("nodata.yaml", "traces:\n  - columns: {y: temp}\n"))
+		Expect(err).NotTo(BeNil())
+
+		s, err := spec.Load(write("column.yaml", "data: readings.csv\ntraces:\n  - columns: {y: pressure}\n"))
+		Expect(err).To(BeNil())
+		_, err = s.Figure()
+		Expect(err).To(MatchError("cannot set y of trace 0, column pressure is not in readings.csv"))
+
+		s, err = spec.Load(write("unknown.yaml", "data: readings.csv\ntraces:\n  - columns: {y: temp}\n    colour: red\n"))
+		Expect(err).To(BeNil())
+		_, err = s.Figure()
+		Expect(err).To(MatchError(ContainSubstring("colour")))
+	})
+
+	It("Should plot histograms of the columns", func() {
+		table := &ingest.Table{
+			Columns: map[string]interface{}{"temp": []float64{1, 2, 2}},
+			Y:       []string{"temp"},
+		}
+		fig, err := spec.Chart(table, spec.KindHistogram, express.Options{})
+		Expect(err).To(BeNil())
+		Expect(fig.Data[0].(*grob.Histogram).X).To(Equal([]float64{1, 2, 2}))
+		Expect(fig.Layout.Xaxis.Title.Text).To(Equal("temp"))
+		Expect(fig.Layout.Yaxis.Title.Text).To(Equal("count"))
+	})
+
+	It("Should rebuild the figure when the data changes", func() {
+		data := write("readings.csv", "temp\n1\n2\n")
+		file := write("sensors.yaml", "data: readings.csv\n")
+
+		figs := make(chan *grob.Fig, 10)
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error)
+		go func() {
+			done <- spec.Watch(ctx, file, 10*time.Millisecond, func(fig *grob.Fig, err error) {
+				defer GinkgoRecover()
+				Expect(err).To(BeNil())
+				figs <- fig
+			})
+		}()
+		// the files are removed once the watcher is stopped
+		defer func() {
+			cancel()
+			Eventually(done).Should(Receive(Equal(context.Canceled)))
+		}()
+
+		var fig *grob.Fig
+		Eventually(figs).Should(Receive(&fig))
+		Expect(fig.Data[0].(*grob.Scatter).Y).To(Equal([]float64{1, 2}))
+
+		Expect(ioutil.WriteFile(data, []byte("temp\n1\n2\n3\n"), 0644)).To(Succeed())
+		Eventually(figs).Should(Receive(&fig))
+		Expect(fig.Data[0].(*grob.Scatter).Y).To(Equal([]float64{1, 2, 3}))
+	})
+})
@@ -0,0 +1,145 @@
+package spec
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/ingest"
+)
+
+// Trace is a plotly trace declared in a spec
+type Trace struct {
+	// Data is the data file of the trace, relative to the spec file. Defaults to the data file of the spec
+	Data string `yaml:"data" json:"data"`
+	// Columns are the attributes set to the values of a column, by attribute path, like y: temp or marker.color: pressure
+	Columns map[string]string `yaml:"columns" json:"columns"`
+	// Attributes are the other plotly attributes of the trace, like type: bar or line: {dash: dot}.
+	// The type defaults to scatter
+	Attributes map[string]interface{} `yaml:",inline" json:"-"`
+}
+
+// traceData returns the data files of the traces
+func (s *Spec) traceData() []string {
+	files := []string{}
+	for _, trace := range s.Traces {
+		files = append(files, trace.Data)
+	}
+	return files
+}
+
+// traces reads the data files of the traces and decodes the figure, the attributes unknown to plotly are errors
+func (s *Spec) traces() (*grob.Fig, error) {
+	tables := map[string]*ingest.Table{}
+	traces := []interface{}{}
+	for i, trace := range s.Traces {
+		data := trace.Data
+		if data == "" {
+			data = s.Data
+		}
+		table, ok := tables[data]
+		if !ok {
+			var err error
+			table, err = s.read(data, ingest.Options{})
+			if err != nil {
+				return nil, err
+			}
+			tables[data] = table
+		}
+
+		attributes := map[string]interface{}{"type": "scatter"}
+		merge(attributes, jsonValue(trace.Attributes).(map[string]interface{}))
+		for path, column := range trace.Columns {
+			values, ok := table.Columns[column]
+			if !ok {
+				return nil, fmt.Errorf("cannot set %s of trace %d, column %s is not in %s", path, i, column, data)
+			}
+			setPath(attributes, path, values)
+		}
+		traces = append(traces, attributes)
+	}
+
+	layout := map[string]interface{}{}
+	if s.Title != "" {
+		setPath(layout, "title.text", s.Title)
+	}
+	if s.XTitle != "" {
+		setPath(layout, "xaxis.title.text", s.XTitle)
+	}
+	if s.YTitle != "" {
+		setPath(layout, "yaxis.title.text", s.YTitle)
+	}
+	merge(layout, jsonValue(s.Layout).(map[string]interface{}))
+	return decode(traces, layout)
+}
+
+// decode decodes the traces and the layout into a figure
+func decode(traces []interface{}, layout map[string]interface{}) (*grob.Fig, error) {
+	data, err := json.Marshal(map[string]interface{}{
+		"data":   traces,
+		"layout": layout,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal figure, %w", err)
+	}
+	fig := &grob.Fig{}
+	err = grob.UnmarshalOptions{DisallowUnknownFields: true}.Unmarshal(data, fig)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode figure, %w", err)
+	}
+	return fig, nil
+}
+
+// setPath sets the attribute at the dotted path, like marker.color, creating the objects on the way
+func setPath(object map[string]interface{}, path string, value interface{}) {
+	keys := strings.Split(path, ".")
+	for _, key := range keys[:len(keys)-1] {
+		child, ok := object[key].(map[string]interface{})
+		if !ok {
+			child = map[string]interface{}{}
+			object[key] = child
+		}
+		object = child
+	}
+	object[keys[len(keys)-1]] = value
+}
+
+// merge sets the attributes of from into to, the objects of both are merged
+func merge(to, from map[string]interface{}) {
+	for key, value := range from {
+		child, ok := value.(map[string]interface{})
+		existing, isObject := to[key].(map[string]interface{})
+		if ok && isObject {
+			merge(existing, child)
+			continue
+		}
+		to[key] = value
+	}
+}
+
+// jsonValue converts the maps decoded from YAML, whose keys are interface{}, to maps that can be marshaled to JSON
+func jsonValue(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[interface{}]interface{}:
+		object := make(map[string]interface{}, len(value))
+		for key, v := range value {
+			object[fmt.Sprint(key)] = jsonValue(v)
+		}
+		return object
+	case map[string]interface{}:
+		object := make(map[string]interface{}, len(value))
+		for key, v := range value {
+			object[key] = jsonValue(v)
+		}
+		return object
+	case []interface{}:
+		array := make([]interface{}, len(value))
+		for i, v := range value {
+			array[i] = jsonValue(v)
+		}
+		return array
+	default:
+		return value
+	}
+}
@@ -0,0 +1,78 @@
+package spec
+
+import (
+	"context"
+	"os"
+	"time"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// fileState tells if a file changed since it was read
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+func stat(file string) fileState {
+	info, err := os.Stat(file)
+	if err != nil {
+		return fileState{}
+	}
+	return fileState{modTime: info.ModTime(), size: info.Size()}
+}
+
+// Watch builds the figure of the spec file and rebuilds it every time the spec or its data files change, until the context is done.
+// The files are checked at every interval. update is called with the figure, or with the error if the spec or the data cannot be read,
+// for example to send the figure to the browsers of an offline.Server:
+//
+//	err := spec.Watch(ctx, "sensors.yaml", time.Second, func(fig *grob.Fig, err error) {
+//		if err != nil {
+//			log.Print(err)
+//			return
+//		}
+//		srv.Update(fig)
+//	})
+func Watch(ctx context.Context, file string, interval time.Duration, update func(fig *grob.Fig, err error)) error {
+	var specState fileState
+	dataStates := map[string]fileState{}
+	build := func() {
+		specState = stat(file)
+		dataStates = map[string]fileState{}
+		s, err := Load(file)
+		if err != nil {
+			update(nil, err)
+			return
+		}
+		for _, dataFile := range s.DataFiles() {
+			dataStates[dataFile] = stat(dataFile)
+		}
+		fig, err := s.Figure()
+		update(fig, err)
+	}
+	changed := func() bool {
+		if stat(file) != specState {
+			return true
+		}
+		for dataFile, state := range dataStates {
+			if stat(dataFile) != state {
+				return true
+			}
+		}
+		return false
+	}
+	build()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if changed() {
+				build()
+			}
+		}
+	}
+}
@@ -0,0 +1,318 @@
+package stats
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// BoxOptions configure the boxes
+type BoxOptions struct {
+	// Whisker is the length of the whiskers in interquartile ranges from the box, they end at the furthest value within it
+	// and the values beyond are outliers. Defaults to 1.5 like plotly.js, math.Inf(1) extends them to the min and the max
+	Whisker float64
+}
+
+func computeBoxOptions(def BoxOptions, opt ...BoxOptions) BoxOptions {
+	if len(opt) == 1 {
+		opts := opt[0]
+		if opts.Whisker != 0 {
+			def.Whisker = opts.Whisker
+		}
+	}
+	return def
+}
+
+// BoxStats are the statistics drawn by a box, the result of Quartiles
+type BoxStats struct {
+	// N is the number of values
+	N        int
+	Min, Max float64
+	Q1       float64
+	Median   float64
+	Q3       float64
+	// Mean and SD, the sample standard deviation, are drawn as a dashed line and a diamond in the box
+	Mean float64
+	SD   float64
+	// LowerFence and UpperFence are the ends of the whiskers
+	LowerFence float64
+	UpperFence float64
+	// Outliers are the values beyond the whiskers, in increasing order
+	Outliers []float64
+}
+
+// Quartiles computes the statistics of a box of the values. NaN values are ignored.
+func Quartiles(values []float64, opt ...BoxOptions) (BoxStats, error) {
+	opts := computeBoxOptions(BoxOptions{
+		Whisker: 1.5,
+	}, opt...)
+	if opts.Whisker < 0 {
+		return BoxStats{}, fmt.Errorf("whisker %g must be positive", opts.Whisker)
+	}
+	sorted := sortedValues(values)
+	if len(sorted) == 0 {
+		return BoxStats{}, fmt.Errorf("there are no values")
+	}
+
+	mean, sd := meanStd(sorted)
+	stats := BoxStats{
+		N:      len(sorted),
+		Min:    sorted[0],
+		Max:    sorted[len(sorted)-1],
+		Q1:     quantile(sorted, 0.25),
+		Median: quantile(sorted, 0.5),
+		Q3:     quantile(sorted, 0.75),
+		Mean:   mean,
+		SD:     sd,
+	}
+	// the whiskers end at the furthest values within their length, so they do not extend beyond the data
+	iqr := stats.Q3 - stats.Q1
+	low, high := stats.Q1-opts.Whisker*iqr, stats.Q3+opts.Whisker*iqr
+	stats.LowerFence, stats.UpperFence = stats.Q1, stats.Q3
+	stats.Outliers = []float64{}
+	for _, v := range sorted {
+		switch {
+		case v < low || v > high:
+			stats.Outliers = append(stats.Outliers, v)
+		case v < stats.LowerFence:
+			stats.LowerFence = v
+		case v > stats.UpperFence:
+			stats.UpperFence = v
+		}
+	}
+	return stats, nil
+}
+
+// sortedValues returns the values without NaN in increasing order
+func sortedValues(values []float64) []float64 {
+	sorted := make([]float64, 0, len(values))
+	for _, v := range values {
+		if !math.IsNaN(v) {
+			sorted = append(sorted, v)
+		}
+	}
+	sort.Float64s(sorted)
+	return sorted
+}
+
+// Box computes the statistics of every group of values and returns a box trace with a box per group, placed at the labels.
+// The trace uses the precomputed statistics of plotly.js, q1, median, q3 and the fences, so only the statistics and the outliers
+// are sent to the browser instead of all the values. labels can be nil to place the boxes at 0, 1, 2...
+//
+//	trace, err := stats.Box([]string{"cell A", "cell B"}, [][]float64{capacitiesA, capacitiesB})
+func Box(labels []string, groups [][]float64, opt ...BoxOptions) (*grob.Box, error) {
+	if labels != nil && len(labels) != len(groups) {
+		return nil, fmt.Errorf("got %d labels for %d groups", len(labels), len(groups))
+	}
+	n := len(groups)
+	q1, median, q3 := make([]float64, n), make([]float64, n), make([]float64, n)
+	lower, upper := make([]float64, n), make([]float64, n)
+	mean, sd := make([]float64, n), make([]float64, n)
+	outliers := make([][]float64, n)
+	for i, group := range groups {
+		stats, err := Quartiles(group, opt...)
+		if err != nil {
+			return nil, fmt.Errorf("cannot compute the box of group %d, %w", i, err)
+		}
+		q1[i], median[i], q3[i] = stats.Q1, stats.Median, stats.Q3
+		lower[i], upper[i] = stats.LowerFence, stats.UpperFence
+		mean[i], sd[i] = stats.Mean, stats.SD
+		outliers[i] = stats.Outliers
+	}
+	trace := &grob.Box{
+		Type:       grob.TraceTypeBox,
+		Q1:         q1,
+		Median:     median,
+		Q3:         q3,
+		Lowerfence: lower,
+		Upperfence: upper,
+		Mean:       mean,
+		Sd:         sd,
+		// under the q1 signature, the sample points are the values of every box, only the outliers are kept
+		Y:         outliers,
+		Boxpoints: grob.BoxBoxpointsOutliers,
+	}
+	if labels != nil {
+		trace.X = labels
+	}
+	return trace, nil
+}
+
+// KDEOptions configure the kernel density estimation
+type KDEOptions struct {
+	// Bandwidth is the standard deviation of the gaussian kernel, defaults to Silverman's rule of thumb like plotly.js
+	Bandwidth float64
+	// Points is the number of points of the density curve, defaults to 100
+	Points int
+}
+
+func computeKDEOptions(def KDEOptions, opt ...KDEOptions) KDEOptions {
+	if len(opt) == 1 {
+		opts := opt[0]
+		if opts.Bandwidth != 0 {
+			def.Bandwidth = opts.Bandwidth
+		}
+		if opts.Points != 0 {
+			def.Points = opts.Points
+		}
+	}
+	return def
+}
+
+// DensityCurve is a curve of the probability density of values, the result of KDE
+type DensityCurve struct {
+	X []float64
+	Y []float64
+}
+
+// KDE estimates the probability density of the values with a gaussian kernel. The curve spans from 2 bandwidths below the min
+// of the values to 2 bandwidths above the max, like the violins of plotly.js. NaN values are ignored.
+func KDE(values []float64, opt ...KDEOptions) (DensityCurve, error) {
+	opts := computeKDEOptions(KDEOptions{
+		Points: 100,
+	}, opt...)
+	if opts.Bandwidth < 0 {
+		return DensityCurve{}, fmt.Errorf("bandwidth %g must be positive", opts.Bandwidth)
+	}
+	if opts.Points < 2 {
+		return DensityCurve{}, fmt.Errorf("the density needs at least 2 points, got %d", opts.Points)
+	}
+	sorted := sortedValues(values)
+	if len(sorted) == 0 {
+		return DensityCurve{}, fmt.Errorf("there are no values")
+	}
+
+	bandwidth := opts.Bandwidth
+	if bandwidth == 0 {
+		bandwidth = silverman(sorted)
+	}
+	x := linspace(sorted[0]-2*bandwidth, sorted[len(sorted)-1]+2*bandwidth, opts.Points)
+	y := make([]float64, len(x))
+	norm := 1 / (float64(len(sorted)) * bandwidth * math.Sqrt(2*math.Pi))
+	for i := range x {
+		sum := 0.0
+		for _, v := range sorted {
+			u := (x[i] - v) / bandwidth
+			sum += math.Exp(-u * u / 2)
+		}
+		y[i] = sum * norm
+	}
+	return DensityCurve{X: x, Y: y}, nil
+}
+
+// silverman returns the bandwidth of Silverman's rule of thumb, or 1 if the values are all equal
+func silverman(sorted []float64) float64 {
+	_, sd := meanStd(sorted)
+	spread := sd
+	if iqr := (quantile(sorted, 0.75) - quantile(sorted, 0.25)) / 1.349; iqr > 0 && iqr < spread {
+		spread = iqr
+	}
+	if spread == 0 {
+		return 1
+	}
+	return 0.9 * spread * math.Pow(float64(len(sorted)), -0.2)
+}
+
+// ViolinOptions configure the violins
+type ViolinOptions struct {
+	// KDE configures the density curves
+	KDE KDEOptions
+	// Box configures the box drawn inside the violins
+	Box BoxOptions
+	// Width of the violins, the violins are 1 apart. Defaults to 0.8
+	Width float64
+}
+
+func computeViolinOptions(def ViolinOptions, opt ...ViolinOptions) ViolinOptions {
+	if len(opt) == 1 {
+		opts := opt[0]
+		def.KDE = computeKDEOptions(def.KDE, opts.KDE)
+		def.Box = computeBoxOptions(def.Box, opts.Box)
+		if opts.Width != 0 {
+			def.Width = opts.Width
+		}
+	}
+	return def
+}
+
+// Violin computes the density and the box of every group of values and returns a figure with a violin per group.
+// plotly.js cannot draw violins from precomputed densities, so every violin is a filled scatter trace of its density curve,
+// mirrored around its position, with a box trace of precomputed statistics inside. The violins are placed at 0, 1, 2...
+// and the x axis shows the labels, which can be nil.
+//
+//	fig, err := stats.Violin([]string{"cell A", "cell B"}, [][]float64{capacitiesA, capacitiesB})
+func Violin(labels []string, groups [][]float64, opt ...ViolinOptions) (*grob.Fig, error) {
+	opts := computeViolinOptions(ViolinOptions{
+		KDE:   KDEOptions{Points: 100},
+		Box:   BoxOptions{Whisker: 1.5},
+		Width: 0.8,
+	}, opt...)
+	if labels != nil && len(labels) != len(groups) {
+		return nil, fmt.Errorf("got %d labels for %d groups", len(labels), len(groups))
+	}
+
+	fig := &grob.Fig{}
+	positions := make([]float64, len(groups))
+	for i, group := range groups {
+		positions[i] = float64(i)
+		density, err := KDE(group, opts.KDE)
+		if err != nil {
+			return nil, fmt.Errorf("cannot compute the density of group %d, %w", i, err)
+		}
+		// every violin has the same width
+		max := 0.0
+		for _, y := range density.Y {
+			max = math.Max(max, y)
+		}
+		scale := opts.Width / 2 / max
+
+		n := len(density.X)
+		x := make([]float64, 2*n)
+		y := make([]float64, 2*n)
+		for j := range density.X {
+			x[j] = positions[i] + density.Y[j]*scale
+			y[j] = density.X[j]
+			x[2*n-1-j] = positions[i] - density.Y[j]*scale
+			y[2*n-1-j] = density.X[j]
+		}
+		trace := &grob.Scatter{
+			Type:       grob.TraceTypeScatter,
+			X:          x,
+			Y:          y,
+			Mode:       grob.ScatterModeLines,
+			Fill:       grob.ScatterFillToself,
+			Hoveron:    grob.ScatterHoveronFills,
+			Line:       &grob.ScatterLine{Width: 1},
+			Showlegend: grob.False,
+		}
+		if labels != nil {
+			trace.Name = labels[i]
+		}
+		fig.AddTraces(trace)
+	}
+
+	box, err := Box(nil, groups, opts.Box)
+	if err != nil {
+		return nil, err
+	}
+	box.X = positions
+	box.Width = opts.Width / 8
+	box.Fillcolor = "white"
+	box.Line = &grob.BoxLine{Color: "#444", Width: 1}
+	box.Showlegend = grob.False
+	fig.AddTraces(box)
+
+	fig.Layout = &grob.Layout{
+		Xaxis: &grob.LayoutXaxis{
+			Tickmode: grob.LayoutXaxisTickmodeArray,
+			Tickvals: positions,
+			Zeroline: grob.False,
+		},
+	}
+	if labels != nil {
+		fig.Layout.Xaxis.Ticktext = labels
+	}
+	return fig, nil
+}
@@ -0,0 +1,118 @@
+package stats_test
+
+import (
+	"encoding/json"
+	"math"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/stats"
+)
+
+var _ = Describe("Box", func() {
+
+	values := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 100, math.NaN()}
+
+	It("Should compute the quartiles, the fences and the outliers", func() {
+		box, err := stats.Quartiles(values)
+		Expect(err).To(BeNil())
+		Expect(box.N).To(Equal(10))
+		Expect(box.Min).To(Equal(1.0))
+		Expect(box.Max).To(Equal(100.0))
+		Expect(box.Q1).To(Equal(3.25))
+		Expect(box.Median).To(Equal(5.5))
+		Expect(box.Q3).To(Equal(7.75))
+		Expect(box.Mean).To(Equal(14.5))
+		Expect(box.LowerFence).To(Equal(1.0))
+		Expect(box.UpperFence).To(Equal(9.0))
+		Expect(box.Outliers).To(Equal([]float64{100}))
+	})
+
+	It("Should extend the whiskers to the min and the max", func() {
+		box, err := stats.Quartiles(values, stats.BoxOptions{Whisker: math.Inf(1)})
+		Expect(err).To(BeNil())
+		Expect(box.UpperFence).To(Equal(100.0))
+		Expect(box.Outliers).To(BeEmpty())
+	})
+
+	It("Should return a box trace of precomputed statistics", func() {
+		trace, err := stats.Box([]string{"a", "b"}, [][]float64{values, {1, 2, 3}})
+		Expect(err).To(BeNil())
+		Expect(trace.Type).To(Equal(grob.TraceTypeBox))
+		Expect(trace.X).To(Equal([]string{"a", "b"}))
+		Expect(trace.Median).To(Equal([]float64{5.5, 2}))
+		Expect(trace.Upperfence).To(Equal([]float64{9, 3}))
+		Expect(trace.Y).To(Equal([][]float64{{100}, {}}))
+
+		data, err := json.Marshal(trace)
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(ContainSubstring(`"q1":[3.25,1.5]`))
+		Expect(string(data)).To(ContainSubstring(`"y":[[100],[]]`))
+	})
+
+	It("Should reject invalid groups", func() {
+		_, err := stats.Box([]string{"a"}, [][]float64{{1}, {2}})
+		Expect(err).NotTo(BeNil())
+
+		_, err = stats.Box(nil, [][]float64{{1}, {math.NaN()}})
+		Expect(err).NotTo(BeNil())
+	})
+})
+
+var _ = Describe("Violin", func() {
+
+	It("Should estimate a density that integrates to 1", func() {
+		density, err := stats.KDE([]float64{1, 2, 2, 3, 5}, stats.KDEOptions{Points: 500})
+		Expect(err).To(BeNil())
+		Expect(density.X).To(HaveLen(500))
+		area := 0.0
+		for i := 1; i < len(density.X); i++ {
+			area += (density.X[i] - density.X[i-1]) * (density.Y[i] + density.Y[i-1]) / 2
+		}
+		// the tails beyond 2 bandwidths are cut
+		Expect(area).To(BeNumerically("~", 0.95, 0.05))
+	})
+
+	It("Should span 2 bandwidths beyond the values", func() {
+		density, err := stats.KDE([]float64{0, 10}, stats.KDEOptions{Bandwidth: 1, Points: 3})
+		Expect(err).To(BeNil())
+		Expect(density.X).To(Equal([]float64{-2, 5, 12}))
+		Expect(density.Y[0]).To(BeNumerically("~", math.Exp(-2)/(2*math.Sqrt(2*math.Pi)), 1e-12))
+	})
+
+	It("Should draw a violin per group with a box inside", func() {
+		fig, err := stats.Violin([]string{"a", "b"}, [][]float64{{1, 2, 3}, {2, 4, 4, 8}}, stats.ViolinOptions{
+			KDE: stats.KDEOptions{Points: 10},
+		})
+		Expect(err).To(BeNil())
+		Expect(fig.Data).To(HaveLen(3))
+
+		violin := fig.Data[1].(*grob.Scatter)
+		Expect(violin.Name).To(Equal("b"))
+		Expect(violin.Fill).To(Equal(grob.ScatterFillToself))
+		x := violin.X.([]float64)
+		Expect(x).To(HaveLen(20))
+		max := 0.0
+		for i := range x {
+			max = math.Max(max, math.Abs(x[i]-1))
+			// mirrored around the position
+			Expect(x[i] - 1).To(BeNumerically("~", 1-x[len(x)-1-i], 1e-12))
+		}
+		Expect(max).To(BeNumerically("~", 0.4, 1e-12))
+
+		box := fig.Data[2].(*grob.Box)
+		Expect(box.X).To(Equal([]float64{0, 1}))
+		Expect(box.Median).To(Equal([]float64{2, 4}))
+		Expect(fig.Layout.Xaxis.Ticktext).To(Equal([]string{"a", "b"}))
+	})
+
+	It("Should reject invalid options", func() {
+		_, err := stats.KDE([]float64{1}, stats.KDEOptions{Bandwidth: -1})
+		Expect(err).NotTo(BeNil())
+
+		_, err = stats.Violin(nil, [][]float64{{}})
+		Expect(err).NotTo(BeNil())
+	})
+})
@@ -0,0 +1,97 @@
+package stats
+
+import (
+	"math"
+)
+
+// triangle of the triangulation, by index of its vertices, with its circumcircle
+type triangle struct {
+	v      [3]int
+	cx, cy float64
+	// r2 is the square of the radius of the circumcircle
+	r2 float64
+}
+
+// delaunay triangulates the samples with the Bowyer-Watson algorithm and returns the triangles by index of their samples.
+// Samples at the same point are triangulated once. It returns no triangles if the samples are on a line
+func delaunay(samples []sample) [][3]int {
+	// the points are scaled to the unit square, so the tolerances do not depend on the unit of the coordinates
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for _, s := range samples {
+		minX, maxX = math.Min(minX, s.x), math.Max(maxX, s.x)
+		minY, maxY = math.Min(minY, s.y), math.Max(maxY, s.y)
+	}
+	scale := math.Max(maxX-minX, maxY-minY)
+	if scale == 0 {
+		return nil
+	}
+	n := len(samples)
+	// the points are followed by the 3 vertices of a super triangle that contains them all
+	px := make([]float64, n+3)
+	py := make([]float64, n+3)
+	for i, s := range samples {
+		px[i] = (s.x - minX) / scale
+		py[i] = (s.y - minY) / scale
+	}
+	px[n], py[n] = -100, -100
+	px[n+1], py[n+1] = 100, -100
+	px[n+2], py[n+2] = 0.5, 100
+
+	circumcircle := func(a, b, c int) (triangle, bool) {
+		ax, ay, bx, by, cx, cy := px[a], py[a], px[b], py[b], px[c], py[c]
+		d := 2 * (ax*(by-cy) + bx*(cy-ay) + cx*(ay-by))
+		if math.Abs(d) < 1e-12 {
+			return triangle{}, false
+		}
+		ux := ((ax*ax+ay*ay)*(by-cy) + (bx*bx+by*by)*(cy-ay) + (cx*cx+cy*cy)*(ay-by)) / d
+		uy := ((ax*ax+ay*ay)*(cx-bx) + (bx*bx+by*by)*(ax-cx) + (cx*cx+cy*cy)*(bx-ax)) / d
+		return triangle{v: [3]int{a, b, c}, cx: ux, cy: uy, r2: (ax-ux)*(ax-ux) + (ay-uy)*(ay-uy)}, true
+	}
+
+	super, _ := circumcircle(n, n+1, n+2)
+	triangles := []triangle{super}
+	seen := map[[2]float64]bool{}
+	for p := 0; p < n; p++ {
+		point := [2]float64{px[p], py[p]}
+		if seen[point] {
+			continue
+		}
+		seen[point] = true
+
+		// the triangles whose circumcircle contains the point are replaced by triangles from their boundary to the point
+		edges := map[[2]int]int{}
+		kept := triangles[:0]
+		for _, t := range triangles {
+			if (px[p]-t.cx)*(px[p]-t.cx)+(py[p]-t.cy)*(py[p]-t.cy) < t.r2*(1+1e-12) {
+				for e := 0; e < 3; e++ {
+					a, b := t.v[e], t.v[(e+1)%3]
+					if a > b {
+						a, b = b, a
+					}
+					edges[[2]int{a, b}]++
+				}
+				continue
+			}
+			kept = append(kept, t)
+		}
+		triangles = kept
+		for edge, count := range edges {
+			if count > 1 {
+				continue
+			}
+			if t, ok := circumcircle(edge[0], edge[1], p); ok {
+				triangles = append(triangles, t)
+			}
+		}
+	}
+
+	result := [][3]int{}
+	for _, t := range triangles {
+		if t.v[0] >= n || t.v[1] >= n || t.v[2] >= n {
+			continue
+		}
+		result = append(result, t.v)
+	}
+	return result
+}
@@ -0,0 +1,179 @@
+// Package stats computes summaries of raw data in Go, such as error bars from replicates,
+// and sets them on the traces so the figure does not depend on plotly.js computations.
+package stats
+
+import (
+	"fmt"
+	"math"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"gonum.org/v1/gonum/stat/distuv"
+)
+
+// Spread is the size of the error bars computed from the replicates
+type Spread string
+
+const (
+	// StdDev is the sample standard deviation, symmetric around the mean
+	StdDev Spread = "std"
+	// StdErr is the standard error of the mean, symmetric around the mean
+	StdErr Spread = "sem"
+	// Confidence is the confidence interval of the mean using the Student's t distribution, symmetric around the mean
+	Confidence Spread = "ci"
+	// MinMax goes from the min to the max of the replicates, asymmetric around the mean
+	MinMax Spread = "minmax"
+)
+
+// ErrorOptions configure the error bars
+type ErrorOptions struct {
+	// Spread is the size of the error bars, defaults to StdDev
+	Spread Spread
+	// Level is the confidence level of the Confidence spread, defaults to 0.95
+	Level float64
+}
+
+func computeErrorOptions(def ErrorOptions, opt ...ErrorOptions) ErrorOptions {
+	if len(opt) == 1 {
+		opts := opt[0]
+		if opts.Spread != "" {
+			def.Spread = opts.Spread
+		}
+		if opts.Level != 0 {
+			def.Level = opts.Level
+		}
+	}
+	return def
+}
+
+// Summary is the mean of every group of replicates and the length of the error bars below and above it
+type Summary struct {
+	Mean  []float64
+	Minus []float64
+	Plus  []float64
+	// Symmetric is true if Minus and Plus are equal
+	Symmetric bool
+}
+
+// Summarize computes the mean and the error bars of every group of replicates. NaN values are ignored.
+// Groups with a single value have no error bars and empty groups are an error.
+func Summarize(replicates [][]float64, opt ...ErrorOptions) (Summary, error) {
+	opts := computeErrorOptions(ErrorOptions{
+		Spread: StdDev,
+		Level:  0.95,
+	}, opt...)
+	if opts.Level <= 0 || opts.Level >= 1 {
+		return Summary{}, fmt.Errorf("confidence level %g must be between 0 and 1", opts.Level)
+	}
+
+	summary := Summary{
+		Mean:      make([]float64, len(replicates)),
+		Minus:     make([]float64, len(replicates)),
+		Plus:      make([]float64, len(replicates)),
+		Symmetric: opts.Spread != MinMax,
+	}
+	for i, group := range replicates {
+		values := make([]float64, 0, len(group))
+		for _, v := range group {
+			if !math.IsNaN(v) {
+				values = append(values, v)
+			}
+		}
+		if len(values) == 0 {
+			return Summary{}, fmt.Errorf("group %d has no values", i)
+		}
+		mean, std := meanStd(values)
+		n := float64(len(values))
+
+		var minus, plus float64
+		switch opts.Spread {
+		case StdDev:
+			minus, plus = std, std
+		case StdErr:
+			minus = std / math.Sqrt(n)
+			plus = minus
+		case Confidence:
+			if len(values) > 1 {
+				t := distuv.StudentsT{Mu: 0, Sigma: 1, Nu: n - 1}.Quantile(1 - (1-opts.Level)/2)
+				minus = t * std / math.Sqrt(n)
+				plus = minus
+			}
+		case MinMax:
+			min, max := values[0], values[0]
+			for _, v := range values {
+				min = math.Min(min, v)
+				max = math.Max(max, v)
+			}
+			minus, plus = mean-min, max-mean
+		default:
+			return Summary{}, fmt.Errorf("unknown spread %s", opts.Spread)
+		}
+		summary.Mean[i] = mean
+		summary.Minus[i] = minus
+		summary.Plus[i] = plus
+	}
+	return summary, nil
+}
+
+// meanStd returns the mean and the sample standard deviation of the values, which is 0 for a single value
+func meanStd(values []float64) (float64, float64) {
+	sum := 0.0
+	for _, v := range values {
+		sum += v
+	}
+	mean := sum / float64(len(values))
+	if len(values) < 2 {
+		return mean, 0
+	}
+	squares := 0.0
+	for _, v := range values {
+		squares += (v - mean) * (v - mean)
+	}
+	return mean, math.Sqrt(squares / float64(len(values)-1))
+}
+
+// ErrorY sets the y values of the trace to the mean of every group of replicates and draws their error bars.
+// The trace must have error bars, such as scatter or bar.
+//
+//	err := stats.ErrorY(trace, [][]float64{{1, 1.2, 0.9}, {2, 2.3, 2.1}}, stats.ErrorOptions{Spread: stats.StdErr})
+func ErrorY(trace grob.Trace, replicates [][]float64, opt ...ErrorOptions) error {
+	return setErrors(trace, "y", replicates, opt...)
+}
+
+// ErrorX sets the x values of the trace to the mean of every group of replicates and draws their error bars,
+// for horizontal bars or scatter plots of measured x values.
+func ErrorX(trace grob.Trace, replicates [][]float64, opt ...ErrorOptions) error {
+	return setErrors(trace, "x", replicates, opt...)
+}
+
+func setErrors(trace grob.Trace, axis string, replicates [][]float64, opt ...ErrorOptions) error {
+	summary, err := Summarize(replicates, opt...)
+	if err != nil {
+		return err
+	}
+	return SetErrors(trace, axis, summary)
+}
+
+// SetErrors sets the x or y values of the trace to the mean of the summary and configures error_x or error_y with its error bars
+func SetErrors(trace grob.Trace, axis string, summary Summary) error {
+	if axis != "x" && axis != "y" {
+		return fmt.Errorf("axis must be x or y, not %s", axis)
+	}
+	path := "error_" + axis
+	options := []grob.TraceOption{
+		grob.SetTrace(axis, summary.Mean),
+		grob.SetTrace(path+".type", "data"),
+		grob.SetTrace(path+".array", summary.Plus),
+		grob.SetTrace(path+".symmetric", summary.Symmetric),
+		grob.SetTrace(path+".visible", true),
+	}
+	if !summary.Symmetric {
+		options = append(options, grob.SetTrace(path+".arrayminus", summary.Minus))
+	}
+	for _, option := range options {
+		err := option(trace)
+		if err != nil {
+			return fmt.Errorf("cannot set error bars, %w", err)
+		}
+	}
+	return nil
+}
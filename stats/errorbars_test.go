@@ -0,0 +1,89 @@
+package stats_test
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/stats"
+)
+
+var _ = Describe("Error bars", func() {
+
+	replicates := [][]float64{{1, 2, 3}, {4, 4, math.NaN()}, {7}}
+
+	It("Should compute the standard deviation by default", func() {
+		summary, err := stats.Summarize(replicates)
+		Expect(err).To(BeNil())
+		Expect(summary.Mean).To(Equal([]float64{2, 4, 7}))
+		Expect(summary.Plus).To(Equal([]float64{1, 0, 0}))
+		Expect(summary.Minus).To(Equal(summary.Plus))
+		Expect(summary.Symmetric).To(BeTrue())
+	})
+
+	It("Should compute the standard error of the mean", func() {
+		summary, err := stats.Summarize(replicates, stats.ErrorOptions{Spread: stats.StdErr})
+		Expect(err).To(BeNil())
+		Expect(summary.Plus[0]).To(BeNumerically("~", 1/math.Sqrt(3), 1e-9))
+	})
+
+	It("Should compute the confidence interval", func() {
+		summary, err := stats.Summarize(replicates, stats.ErrorOptions{Spread: stats.Confidence})
+		Expect(err).To(BeNil())
+		Expect(summary.Plus[0]).To(BeNumerically("~", 4.302653/math.Sqrt(3), 1e-5))
+		Expect(summary.Plus[2]).To(Equal(0.0))
+
+		summary, err = stats.Summarize(replicates, stats.ErrorOptions{Spread: stats.Confidence, Level: 0.99})
+		Expect(err).To(BeNil())
+		Expect(summary.Plus[0]).To(BeNumerically("~", 9.924843/math.Sqrt(3), 1e-5))
+	})
+
+	It("Should compute asymmetric min max error bars", func() {
+		summary, err := stats.Summarize([][]float64{{1, 2, 6}}, stats.ErrorOptions{Spread: stats.MinMax})
+		Expect(err).To(BeNil())
+		Expect(summary.Mean).To(Equal([]float64{3}))
+		Expect(summary.Minus).To(Equal([]float64{2}))
+		Expect(summary.Plus).To(Equal([]float64{3}))
+		Expect(summary.Symmetric).To(BeFalse())
+	})
+
+	It("Should fail on invalid input", func() {
+		_, err := stats.Summarize([][]float64{{}})
+		Expect(err).ToNot(BeNil())
+		_, err = stats.Summarize(replicates, stats.ErrorOptions{Level: 2})
+		Expect(err).ToNot(BeNil())
+		_, err = stats.Summarize(replicates, stats.ErrorOptions{Spread: "iqr"})
+		Expect(err).ToNot(BeNil())
+	})
+
+	It("Should set symmetric error bars on a bar trace", func() {
+		trace := &grob.Bar{Type: grob.TraceTypeBar}
+		err := stats.ErrorY(trace, replicates)
+		Expect(err).To(BeNil())
+		Expect(trace.Y).To(Equal([]float64{2, 4, 7}))
+		Expect(trace.ErrorY.Type).To(Equal(grob.BarErrorYTypeData))
+		Expect(trace.ErrorY.Array).To(Equal([]float64{1, 0, 0}))
+		Expect(trace.ErrorY.Arrayminus).To(BeNil())
+		Expect(*trace.ErrorY.Symmetric).To(BeTrue())
+		Expect(*trace.ErrorY.Visible).To(BeTrue())
+	})
+
+	It("Should set asymmetric error bars on a scatter trace", func() {
+		trace := &grob.Scatter{Type: grob.TraceTypeScatter}
+		err := stats.ErrorX(trace, [][]float64{{1, 2, 6}}, stats.ErrorOptions{Spread: stats.MinMax})
+		Expect(err).To(BeNil())
+		Expect(trace.X).To(Equal([]float64{3}))
+		Expect(trace.ErrorX.Array).To(Equal([]float64{3}))
+		Expect(trace.ErrorX.Arrayminus).To(Equal([]float64{2}))
+		Expect(*trace.ErrorX.Symmetric).To(BeFalse())
+	})
+
+	It("Should fail on traces without error bars", func() {
+		err := stats.ErrorY(&grob.Pie{Type: grob.TraceTypePie}, replicates)
+		Expect(err).ToNot(BeNil())
+		err = stats.SetErrors(&grob.Scatter{}, "z", stats.Summary{})
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,242 @@
+package stats
+
+import (
+	"fmt"
+	"math"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// Interpolation estimates the values of a grid from scattered samples
+type Interpolation string
+
+const (
+	// Linear interpolates in the triangles of the Delaunay triangulation of the samples.
+	// The points outside of the convex hull of the samples are left blank
+	Linear Interpolation = "linear"
+	// Nearest takes the value of the closest sample, every point of the grid has a value
+	Nearest Interpolation = "nearest"
+	// IDW is the inverse distance weighting of all the samples, with the weights decreasing with the distance to the Power.
+	// Every point of the grid has a value
+	IDW Interpolation = "idw"
+)
+
+// DefaultGridSize is the number of points of the grid along x and y
+const DefaultGridSize = 100
+
+// GridOptions configure the grid
+type GridOptions struct {
+	// Interpolation defaults to Linear
+	Interpolation Interpolation
+	// Cols and Rows are the number of points of the grid along x and y, they default to DefaultGridSize
+	Cols int
+	Rows int
+	// XRange and YRange are the min and the max of the grid along x and y, they default to those of the samples
+	XRange []float64
+	YRange []float64
+	// Power of the distances of IDW, defaults to 2. Higher powers give more weight to the closest samples
+	Power float64
+}
+
+func computeGridOptions(def GridOptions, opt ...GridOptions) GridOptions {
+	if len(opt) == 1 {
+		opts := opt[0]
+		if opts.Interpolation != "" {
+			def.Interpolation = opts.Interpolation
+		}
+		if opts.Cols != 0 {
+			def.Cols = opts.Cols
+		}
+		if opts.Rows != 0 {
+			def.Rows = opts.Rows
+		}
+		if opts.XRange != nil {
+			def.XRange = opts.XRange
+		}
+		if opts.YRange != nil {
+			def.YRange = opts.YRange
+		}
+		if opts.Power != 0 {
+			def.Power = opts.Power
+		}
+	}
+	return def
+}
+
+// Grid are values on a regular grid, the result of Interpolate
+type Grid struct {
+	// X are the coordinates of the columns and Y of the rows
+	X []float64
+	Y []float64
+	// Z are the values, a row per y. The points without value are NaN, plotly leaves them blank
+	Z *grob.Matrix
+}
+
+// Interpolate estimates the values on a regular grid from the scattered samples at x and y, such as the readings of sensors
+// placed over a floor. The samples with a NaN coordinate or value are ignored.
+//
+//	grid, err := stats.Interpolate(x, y, temperatures, stats.GridOptions{Interpolation: stats.IDW})
+//	fig.AddTraces(grid.Contour())
+func Interpolate(x, y, z []float64, opt ...GridOptions) (Grid, error) {
+	opts := computeGridOptions(GridOptions{
+		Interpolation: Linear,
+		Cols:          DefaultGridSize,
+		Rows:          DefaultGridSize,
+		Power:         2,
+	}, opt...)
+	if len(x) != len(y) || len(x) != len(z) {
+		return Grid{}, fmt.Errorf("x, y and z must have the same length, got %d, %d and %d", len(x), len(y), len(z))
+	}
+	if opts.Cols < 2 || opts.Rows < 2 {
+		return Grid{}, fmt.Errorf("the grid must have at least 2 columns and 2 rows, got %dx%d", opts.Cols, opts.Rows)
+	}
+	samples := make([]sample, 0, len(x))
+	for i := range x {
+		if math.IsNaN(x[i]) || math.IsNaN(y[i]) || math.IsNaN(z[i]) {
+			continue
+		}
+		samples = append(samples, sample{x: x[i], y: y[i], z: z[i]})
+	}
+	if len(samples) == 0 {
+		return Grid{}, fmt.Errorf("there are no samples to interpolate")
+	}
+
+	xRange, err := gridRange(opts.XRange, samples, func(s sample) float64 { return s.x })
+	if err != nil {
+		return Grid{}, fmt.Errorf("invalid x range, %w", err)
+	}
+	yRange, err := gridRange(opts.YRange, samples, func(s sample) float64 { return s.y })
+	if err != nil {
+		return Grid{}, fmt.Errorf("invalid y range, %w", err)
+	}
+	grid := Grid{
+		X: linspace(xRange[0], xRange[1], opts.Cols),
+		Y: linspace(yRange[0], yRange[1], opts.Rows),
+		Z: grob.NewMatrix(opts.Rows, opts.Cols),
+	}
+
+	var value func(x, y float64) float64
+	switch opts.Interpolation {
+	case Linear:
+		triangles := delaunay(samples)
+		if len(triangles) == 0 {
+			return Grid{}, fmt.Errorf("linear interpolation needs 3 samples that are not on a line")
+		}
+		value = func(x, y float64) float64 {
+			return linear(samples, triangles, x, y)
+		}
+	case Nearest:
+		value = func(x, y float64) float64 {
+			return nearest(samples, x, y)
+		}
+	case IDW:
+		value = func(x, y float64) float64 {
+			return idw(samples, x, y, opts.Power)
+		}
+	default:
+		return Grid{}, fmt.Errorf("unknown interpolation %s", opts.Interpolation)
+	}
+	for i, y := range grid.Y {
+		for j, x := range grid.X {
+			grid.Z.Set(i, j, value(x, y))
+		}
+	}
+	return grid, nil
+}
+
+// Contour returns a contour trace of the grid
+func (g Grid) Contour() *grob.Contour {
+	return &grob.Contour{
+		Type: grob.TraceTypeContour,
+		X:    g.X,
+		Y:    g.Y,
+		Z:    g.Z,
+	}
+}
+
+// Heatmap returns a heatmap trace of the grid
+func (g Grid) Heatmap() *grob.Heatmap {
+	return &grob.Heatmap{
+		Type: grob.TraceTypeHeatmap,
+		X:    g.X,
+		Y:    g.Y,
+		Z:    g.Z,
+	}
+}
+
+// sample is a value at a point
+type sample struct {
+	x, y, z float64
+}
+
+// gridRange returns the range of the options or the min and the max of the samples
+func gridRange(r []float64, samples []sample, coordinate func(sample) float64) ([2]float64, error) {
+	if r != nil {
+		if len(r) != 2 || !(r[0] < r[1]) || math.IsInf(r[0], 0) || math.IsInf(r[1], 0) {
+			return [2]float64{}, fmt.Errorf("the range must be a min and a greater max, got %v", r)
+		}
+		return [2]float64{r[0], r[1]}, nil
+	}
+	min, max := math.Inf(1), math.Inf(-1)
+	for _, s := range samples {
+		min = math.Min(min, coordinate(s))
+		max = math.Max(max, coordinate(s))
+	}
+	if math.IsInf(min, 0) || math.IsInf(max, 0) {
+		return [2]float64{}, fmt.Errorf("the samples are not finite")
+	}
+	if min == max {
+		return [2]float64{min - 0.5, max + 0.5}, nil
+	}
+	return [2]float64{min, max}, nil
+}
+
+// linspace returns n values evenly spaced from min to max
+func linspace(min, max float64, n int) []float64 {
+	values := make([]float64, n)
+	for i := range values {
+		values[i] = min + (max-min)*float64(i)/float64(n-1)
+	}
+	values[n-1] = max
+	return values
+}
+
+func nearest(samples []sample, x, y float64) float64 {
+	best, value := math.Inf(1), math.NaN()
+	for _, s := range samples {
+		if d := (s.x-x)*(s.x-x) + (s.y-y)*(s.y-y); d < best {
+			best, value = d, s.z
+		}
+	}
+	return value
+}
+
+func idw(samples []sample, x, y, power float64) float64 {
+	sum, weights := 0.0, 0.0
+	for _, s := range samples {
+		d := math.Hypot(s.x-x, s.y-y)
+		if d == 0 {
+			return s.z
+		}
+		w := 1 / math.Pow(d, power)
+		sum += w * s.z
+		weights += w
+	}
+	return sum / weights
+}
+
+func linear(samples []sample, triangles [][3]int, x, y float64) float64 {
+	for _, t := range triangles {
+		a, b, c := samples[t[0]], samples[t[1]], samples[t[2]]
+		det := (b.y-c.y)*(a.x-c.x) + (c.x-b.x)*(a.y-c.y)
+		l1 := ((b.y-c.y)*(x-c.x) + (c.x-b.x)*(y-c.y)) / det
+		l2 := ((c.y-a.y)*(x-c.x) + (a.x-c.x)*(y-c.y)) / det
+		l3 := 1 - l1 - l2
+		// tolerance for the points on the edges
+		const eps = -1e-9
+		if l1 >= eps && l2 >= eps && l3 >= eps {
+			return l1*a.z + l2*b.z + l3*c.z
+		}
+	}
+	return math.NaN()
+}
@@ -0,0 +1,116 @@
+package stats_test
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/stats"
+)
+
+var _ = Describe("Interpolate", func() {
+
+	// samples of the plane z = x + 2y on the corners and the center of the unit square
+	x := []float64{0, 1, 0, 1, 0.5, math.NaN()}
+	y := []float64{0, 0, 1, 1, 0.5, 0}
+	z := []float64{0, 1, 2, 3, 1.5, 0}
+
+	It("Should interpolate a plane exactly with linear interpolation", func() {
+		grid, err := stats.Interpolate(x, y, z, stats.GridOptions{Cols: 5, Rows: 3})
+		Expect(err).To(BeNil())
+		Expect(grid.X).To(Equal([]float64{0, 0.25, 0.5, 0.75, 1}))
+		Expect(grid.Y).To(Equal([]float64{0, 0.5, 1}))
+		rows, cols := grid.Z.Dims()
+		Expect(rows).To(Equal(3))
+		Expect(cols).To(Equal(5))
+		for i, y := range grid.Y {
+			for j, x := range grid.X {
+				Expect(grid.Z.At(i, j)).To(BeNumerically("~", x+2*y, 1e-9))
+			}
+		}
+	})
+
+	It("Should leave the points outside of the samples blank with linear interpolation", func() {
+		grid, err := stats.Interpolate([]float64{0, 1, 0}, []float64{0, 0, 1}, []float64{1, 1, 1}, stats.GridOptions{Cols: 2, Rows: 2})
+		Expect(err).To(BeNil())
+		Expect(grid.Z.At(0, 0)).To(Equal(1.0))
+		Expect(math.IsNaN(grid.Z.At(1, 1))).To(BeTrue())
+	})
+
+	It("Should take the value of the closest sample", func() {
+		grid, err := stats.Interpolate(x, y, z, stats.GridOptions{Interpolation: stats.Nearest, Cols: 5, Rows: 5})
+		Expect(err).To(BeNil())
+		Expect(grid.Z.At(0, 0)).To(Equal(0.0))
+		Expect(grid.Z.At(0, 1)).To(Equal(0.0))
+		Expect(grid.Z.At(4, 4)).To(Equal(3.0))
+		Expect(grid.Z.At(2, 2)).To(Equal(1.5))
+	})
+
+	It("Should weight the samples by inverse distance", func() {
+		grid, err := stats.Interpolate([]float64{0, 2}, []float64{0, 0}, []float64{0, 4}, stats.GridOptions{
+			Interpolation: stats.IDW,
+			Cols:          3,
+			Rows:          2,
+			YRange:        []float64{0, 1},
+		})
+		Expect(err).To(BeNil())
+		Expect(grid.Z.At(0, 0)).To(Equal(0.0))
+		Expect(grid.Z.At(0, 1)).To(Equal(2.0))
+		Expect(grid.Z.At(0, 2)).To(Equal(4.0))
+		Expect(grid.Z.At(1, 0)).To(BeNumerically("~", 4.0/6, 1e-9))
+	})
+
+	It("Should interpolate many random samples", func() {
+		n := 200
+		x, y, z := make([]float64, n), make([]float64, n), make([]float64, n)
+		for i := range x {
+			// deterministic pseudo random points
+			x[i] = math.Mod(float64(i)*0.618033988749, 1) * 100
+			y[i] = math.Mod(float64(i)*0.414213562373, 1) * 50
+			z[i] = 3*x[i] - y[i]
+		}
+		grid, err := stats.Interpolate(x, y, z, stats.GridOptions{Cols: 20, Rows: 20})
+		Expect(err).To(BeNil())
+		values := 0
+		for i, y := range grid.Y {
+			for j, x := range grid.X {
+				if v := grid.Z.At(i, j); !math.IsNaN(v) {
+					values++
+					Expect(v).To(BeNumerically("~", 3*x-y, 1e-6))
+				}
+			}
+		}
+		Expect(values).To(BeNumerically(">", 300))
+	})
+
+	It("Should return contour and heatmap traces", func() {
+		grid, err := stats.Interpolate(x, y, z, stats.GridOptions{Cols: 2, Rows: 2})
+		Expect(err).To(BeNil())
+		contour := grid.Contour()
+		Expect(contour.Type).To(Equal(grob.TraceTypeContour))
+		Expect(contour.X).To(Equal(grid.X))
+		Expect(contour.Z).To(Equal(grid.Z))
+		heatmap := grid.Heatmap()
+		Expect(heatmap.Type).To(Equal(grob.TraceTypeHeatmap))
+		Expect(heatmap.Y).To(Equal(grid.Y))
+	})
+
+	It("Should reject invalid samples", func() {
+		_, err := stats.Interpolate([]float64{0, 1}, []float64{0}, []float64{0, 1})
+		Expect(err).NotTo(BeNil())
+
+		_, err = stats.Interpolate([]float64{math.NaN()}, []float64{0}, []float64{0})
+		Expect(err).NotTo(BeNil())
+
+		_, err = stats.Interpolate([]float64{0, 1, 2}, []float64{0, 1, 2}, []float64{0, 1, 2})
+		Expect(err).NotTo(BeNil())
+
+		_, err = stats.Interpolate(x, y, z, stats.GridOptions{XRange: []float64{1, 0}})
+		Expect(err).NotTo(BeNil())
+
+		_, err = stats.Interpolate(x, y, z, stats.GridOptions{Interpolation: "cubic"})
+		Expect(err).NotTo(BeNil())
+	})
+})
@@ -0,0 +1,260 @@
+package stats
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// Rule computes the number of bins from the values
+type Rule string
+
+const (
+	// Sturges uses log2(n) + 1 bins, it suits values close to a normal distribution
+	Sturges Rule = "sturges"
+	// FreedmanDiaconis uses bins of width 2 IQR / n^(1/3), it is robust to outliers.
+	// It falls back to Sturges if the interquartile range is 0
+	FreedmanDiaconis Rule = "fd"
+)
+
+// Norm is the normalization of the bin heights, like histnorm in plotly.js
+type Norm string
+
+const (
+	// Count is the number of values in each bin
+	Count Norm = ""
+	// Percent is the percentage of the values in each bin
+	Percent Norm = "percent"
+	// Probability is the fraction of the values in each bin
+	Probability Norm = "probability"
+	// Density is the fraction of the values divided by the width of the bin, so the total area is 1
+	Density Norm = "density"
+)
+
+// BinOptions configure the bins. Edges take precedence over Width, which takes precedence over Rule
+type BinOptions struct {
+	// Edges are the increasing limits of the bins. Values outside of them are not counted
+	Edges []float64
+	// Width of the bins, they are aligned on multiples of the width
+	Width float64
+	// Rule computes the number of bins between the min and the max of the values, defaults to Sturges
+	Rule Rule
+	// Norm is the normalization of the heights, defaults to Count
+	Norm Norm
+}
+
+func computeBinOptions(def BinOptions, opt ...BinOptions) BinOptions {
+	if len(opt) == 1 {
+		opts := opt[0]
+		if opts.Edges != nil {
+			def.Edges = opts.Edges
+		}
+		if opts.Width != 0 {
+			def.Width = opts.Width
+		}
+		if opts.Rule != "" {
+			def.Rule = opts.Rule
+		}
+		if opts.Norm != "" {
+			def.Norm = opts.Norm
+		}
+	}
+	return def
+}
+
+// Bins are the result of Bin. Every bin includes its lower edge and excludes the upper one, except the last bin that includes both
+type Bins struct {
+	// Edges has one more element than Heights
+	Edges []float64
+	// Heights of the bins, normalized by the Norm option
+	Heights []float64
+}
+
+// Bin counts the values in bins. NaN values are ignored.
+func Bin(values []float64, opt ...BinOptions) (Bins, error) {
+	opts := computeBinOptions(BinOptions{
+		Rule: Sturges,
+	}, opt...)
+
+	sorted := make([]float64, 0, len(values))
+	for _, v := range values {
+		if !math.IsNaN(v) {
+			sorted = append(sorted, v)
+		}
+	}
+	sort.Float64s(sorted)
+
+	edges := opts.Edges
+	switch {
+	case edges != nil:
+		if len(edges) < 2 {
+			return Bins{}, fmt.Errorf("at least 2 edges are required, got %d", len(edges))
+		}
+		for i := 1; i < len(edges); i++ {
+			if edges[i] <= edges[i-1] {
+				return Bins{}, fmt.Errorf("edges must be increasing, %g is after %g", edges[i], edges[i-1])
+			}
+		}
+	case len(sorted) == 0:
+		return Bins{}, fmt.Errorf("there are no values to bin")
+	case opts.Width < 0:
+		return Bins{}, fmt.Errorf("width %g must be positive", opts.Width)
+	case opts.Width > 0:
+		edges = widthEdges(sorted[0], sorted[len(sorted)-1], opts.Width)
+	default:
+		var err error
+		edges, err = ruleEdges(sorted, opts.Rule)
+		if err != nil {
+			return Bins{}, err
+		}
+	}
+
+	counts := make([]float64, len(edges)-1)
+	total := 0.0
+	for _, v := range sorted {
+		if v < edges[0] || v > edges[len(edges)-1] {
+			continue
+		}
+		// index of the first edge greater than v, the last edge is included in the last bin
+		i := sort.Search(len(edges), func(i int) bool { return edges[i] > v }) - 1
+		if i == len(counts) {
+			i--
+		}
+		counts[i]++
+		total++
+	}
+
+	if total > 0 {
+		for i := range counts {
+			switch opts.Norm {
+			case Count:
+			case Percent:
+				counts[i] = 100 * counts[i] / total
+			case Probability:
+				counts[i] = counts[i] / total
+			case Density:
+				counts[i] = counts[i] / total / (edges[i+1] - edges[i])
+			default:
+				return Bins{}, fmt.Errorf("unknown norm %s", opts.Norm)
+			}
+		}
+	}
+	return Bins{Edges: edges, Heights: counts}, nil
+}
+
+// widthEdges returns edges aligned on multiples of width that cover min and max
+func widthEdges(min, max, width float64) []float64 {
+	start := math.Floor(min/width) * width
+	n := int(math.Floor((max-start)/width)) + 1
+	edges := make([]float64, n+1)
+	for i := range edges {
+		edges[i] = start + float64(i)*width
+	}
+	return edges
+}
+
+// ruleEdges returns equal bins between the min and the max of the sorted values
+func ruleEdges(sorted []float64, rule Rule) ([]float64, error) {
+	min, max := sorted[0], sorted[len(sorted)-1]
+	if min == max {
+		return []float64{min - 0.5, max + 0.5}, nil
+	}
+	n := float64(len(sorted))
+	count := 0
+	switch rule {
+	case Sturges:
+	case FreedmanDiaconis:
+		iqr := quantile(sorted, 0.75) - quantile(sorted, 0.25)
+		if iqr > 0 {
+			count = int(math.Ceil((max - min) / (2 * iqr / math.Cbrt(n))))
+		}
+	default:
+		return nil, fmt.Errorf("unknown rule %s", rule)
+	}
+	if count == 0 {
+		count = int(math.Ceil(math.Log2(n))) + 1
+	}
+	edges := make([]float64, count+1)
+	for i := range edges {
+		edges[i] = min + (max-min)*float64(i)/float64(count)
+	}
+	edges[count] = max
+	return edges, nil
+}
+
+// quantile interpolates linearly between the closest ranks of the sorted values
+func quantile(sorted []float64, q float64) float64 {
+	pos := q * float64(len(sorted)-1)
+	lower := int(math.Floor(pos))
+	upper := int(math.Ceil(pos))
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(pos-float64(lower))
+}
+
+// Bars is the bar trace of a histogram with a width per bar.
+// Bar.Width is a single number, so the Widths of uneven bins are written in its place when the trace is encoded
+type Bars struct {
+	*grob.Bar
+	// Widths of the bars, nil if the bins are evenly spaced and Bar.Width is set
+	Widths []float64
+}
+
+// MarshalJSON encodes the bar trace with the widths of the bars
+func (t *Bars) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(t.Bar)
+	if err != nil || t.Widths == nil {
+		return data, err
+	}
+	trace := map[string]json.RawMessage{}
+	err = json.Unmarshal(data, &trace)
+	if err != nil {
+		return nil, err
+	}
+	trace["width"], err = json.Marshal(t.Widths)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(trace)
+}
+
+// Histogram bins the values and returns a bar trace with a bar per bin, for when the bins must be computed in Go
+// instead of by the histogram trace of plotly.js. The bars are placed at the center of the bins with their width,
+// so they touch each other, and the hover label shows the edges.
+//
+//	trace, err := stats.Histogram(durations, stats.BinOptions{Width: 5})
+func Histogram(values []float64, opt ...BinOptions) (*Bars, error) {
+	bins, err := Bin(values, opt...)
+	if err != nil {
+		return nil, err
+	}
+	n := len(bins.Heights)
+	centers := make([]float64, n)
+	widths := make([]float64, n)
+	edges := make([][]float64, n)
+	even := true
+	for i := 0; i < n; i++ {
+		centers[i] = (bins.Edges[i] + bins.Edges[i+1]) / 2
+		widths[i] = bins.Edges[i+1] - bins.Edges[i]
+		edges[i] = []float64{bins.Edges[i], bins.Edges[i+1]}
+		if math.Abs(widths[i]-widths[0]) > 1e-9*widths[0] {
+			even = false
+		}
+	}
+	trace := &Bars{
+		Bar: &grob.Bar{
+			Type:          grob.TraceTypeBar,
+			X:             centers,
+			Y:             bins.Heights,
+			Customdata:    edges,
+			Hovertemplate: "[%{customdata[0]}, %{customdata[1]}): %{y}<extra></extra>",
+		},
+	}
+	if even {
+		trace.Width = widths[0]
+	} else {
+		trace.Widths = widths
+	}
+	return trace, nil
+}
@@ -0,0 +1,104 @@
+package stats_test
+
+import (
+	"encoding/json"
+	"math"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/stats"
+)
+
+var _ = Describe("Histogram", func() {
+
+	values := []float64{0.5, 1, 1.5, 2, 2.5, 3, 3.5, 4, math.NaN()}
+
+	It("Should bin with a fixed width aligned on its multiples", func() {
+		bins, err := stats.Bin(values, stats.BinOptions{Width: 2})
+		Expect(err).To(BeNil())
+		Expect(bins.Edges).To(Equal([]float64{0, 2, 4, 6}))
+		Expect(bins.Heights).To(Equal([]float64{3, 4, 1}))
+	})
+
+	It("Should bin with custom edges and include the last edge", func() {
+		bins, err := stats.Bin(values, stats.BinOptions{Edges: []float64{1, 2, 4}})
+		Expect(err).To(BeNil())
+		Expect(bins.Heights).To(Equal([]float64{2, 5}))
+	})
+
+	It("Should use Sturges rule by default", func() {
+		bins, err := stats.Bin(values)
+		Expect(err).To(BeNil())
+		// 8 values, log2(8) + 1 bins
+		Expect(bins.Edges).To(HaveLen(5))
+		Expect(bins.Edges[0]).To(Equal(0.5))
+		Expect(bins.Edges[4]).To(Equal(4.0))
+		Expect(bins.Heights).To(Equal([]float64{2, 2, 2, 2}))
+	})
+
+	It("Should use Freedman Diaconis rule", func() {
+		// IQR 1.75, width 2 * 1.75 / 2 = 1.75, 3.5 / 1.75 bins
+		bins, err := stats.Bin(values, stats.BinOptions{Rule: stats.FreedmanDiaconis})
+		Expect(err).To(BeNil())
+		Expect(bins.Edges).To(Equal([]float64{0.5, 2.25, 4}))
+		Expect(bins.Heights).To(Equal([]float64{4, 4}))
+	})
+
+	It("Should normalize the heights", func() {
+		bins, err := stats.Bin(values, stats.BinOptions{Width: 2, Norm: stats.Probability})
+		Expect(err).To(BeNil())
+		Expect(bins.Heights).To(Equal([]float64{0.375, 0.5, 0.125}))
+
+		bins, err = stats.Bin(values, stats.BinOptions{Width: 2, Norm: stats.Density})
+		Expect(err).To(BeNil())
+		Expect(bins.Heights).To(Equal([]float64{0.1875, 0.25, 0.0625}))
+	})
+
+	It("Should put equal values in a single bin", func() {
+		bins, err := stats.Bin([]float64{3, 3})
+		Expect(err).To(BeNil())
+		Expect(bins.Edges).To(Equal([]float64{2.5, 3.5}))
+		Expect(bins.Heights).To(Equal([]float64{2}))
+	})
+
+	It("Should fail on invalid options", func() {
+		_, err := stats.Bin(values, stats.BinOptions{Edges: []float64{1}})
+		Expect(err).ToNot(BeNil())
+		_, err = stats.Bin(values, stats.BinOptions{Edges: []float64{1, 1}})
+		Expect(err).ToNot(BeNil())
+		_, err = stats.Bin(values, stats.BinOptions{Width: -1})
+		Expect(err).ToNot(BeNil())
+		_, err = stats.Bin(values, stats.BinOptions{Rule: "scott"})
+		Expect(err).ToNot(BeNil())
+		_, err = stats.Bin(values, stats.BinOptions{Norm: "cumulative"})
+		Expect(err).ToNot(BeNil())
+		_, err = stats.Bin(nil)
+		Expect(err).ToNot(BeNil())
+	})
+
+	It("Should build a bar trace with a bar per bin", func() {
+		trace, err := stats.Histogram(values, stats.BinOptions{Width: 2})
+		Expect(err).To(BeNil())
+		Expect(trace.Type).To(Equal(grob.TraceTypeBar))
+		Expect(trace.X).To(Equal([]float64{1, 3, 5}))
+		Expect(trace.Y).To(Equal([]float64{3, 4, 1}))
+		Expect(trace.Width).To(Equal(2.0))
+		Expect(trace.Customdata).To(Equal([][]float64{{0, 2}, {2, 4}, {4, 6}}))
+	})
+
+	It("Should give each bar the width of its bin", func() {
+		trace, err := stats.Histogram(values, stats.BinOptions{Edges: []float64{0, 1, 4, 6}})
+		Expect(err).To(BeNil())
+		Expect(trace.X).To(Equal([]float64{0.5, 2.5, 5}))
+		Expect(trace.Y).To(Equal([]float64{1, 6, 1}))
+		Expect(trace.Width).To(BeZero())
+		Expect(trace.Widths).To(Equal([]float64{1, 3, 2}))
+
+		data, err := json.Marshal(&grob.Fig{Data: grob.Traces{trace}})
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(ContainSubstring(`"width":[1,3,2]`))
+		Expect(string(data)).To(ContainSubstring(`"type":"bar"`))
+	})
+})
@@ -0,0 +1,328 @@
+// Package stream writes figures whose data arrays are read from a source while the figure is written,
+// such as a file read in chunks, so datasets larger than the available memory are plotted without loading them in slices.
+// Only the chunk being written is kept in memory.
+//
+//	trace := &grob.Scattergl{
+//		X: stream.Array{Source: stream.Func(n, func(i int) float64 { return float64(i) })},
+//		Y: stream.Array{Source: readColumn("data.bin")},
+//	}
+//	fig := &grob.Fig{Data: grob.Traces{trace}}
+//	err := stream.Write(w, fig)
+package stream
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"strconv"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// Source produces the values of an array by calling emit with consecutive chunks, until it returns.
+// The chunk can be reused by the source once emit returns, and the errors of emit must be returned.
+// A source is read every time the figure is written, so it must start from the beginning on every call, like opening the file again.
+type Source func(emit func(chunk []float64) error) error
+
+// chunkSize is the number of values emitted at once by the sources of this package
+const chunkSize = 4096
+
+// Func is a source of n values computed by f, such as the positions of evenly spaced samples
+func Func(n int, f func(i int) float64) Source {
+	return func(emit func(chunk []float64) error) error {
+		chunk := make([]float64, 0, chunkSize)
+		for i := 0; i < n; i++ {
+			chunk = append(chunk, f(i))
+			if len(chunk) == chunkSize || i == n-1 {
+				err := emit(chunk)
+				if err != nil {
+					return err
+				}
+				chunk = chunk[:0]
+			}
+		}
+		return nil
+	}
+}
+
+// Float64s is a source that reads little endian float64 values from the reader returned by open, like a binary column file.
+// The reader is closed after the values are read.
+func Float64s(open func() (io.ReadCloser, error)) Source {
+	return func(emit func(chunk []float64) error) error {
+		r, err := open()
+		if err != nil {
+			return fmt.Errorf("cannot open source, %w", err)
+		}
+		defer r.Close()
+
+		raw := make([]byte, 8*chunkSize)
+		chunk := make([]float64, chunkSize)
+		for {
+			n, err := io.ReadFull(r, raw)
+			if n%8 != 0 {
+				return fmt.Errorf("cannot read source, %d bytes are not a whole number of float64", n%8)
+			}
+			for i := 0; i < n/8; i++ {
+				chunk[i] = math.Float64frombits(binary.LittleEndian.Uint64(raw[8*i:]))
+			}
+			if n > 0 {
+				emitErr := emit(chunk[:n/8])
+				if emitErr != nil {
+					return emitErr
+				}
+			}
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("cannot read source, %w", err)
+			}
+		}
+	}
+}
+
+// Array is a data array read from its source when the figure is written with Write. It can be set to any trace attribute
+// that takes an array, like X, Y or Marker.Color. NaN and infinite values are written as null, plotly leaves them blank.
+// Marshaled with encoding/json, out of Write, the whole array is read in memory.
+type Array struct {
+	Source Source
+	// TypedArray writes the values as a base64 typed array, {"dtype": "f8", "bdata": "..."}, which is smaller and faster to decode.
+	// It requires plotly.js 2.28 or later, the default version does not support it.
+	TypedArray bool
+}
+
+// MarshalJSON implements json.Marshaler
+func (a Array) MarshalJSON() ([]byte, error) {
+	buf := &bytes.Buffer{}
+	bw := bufio.NewWriter(buf)
+	err := a.write(bw)
+	if err != nil {
+		return nil, err
+	}
+	err = bw.Flush()
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// write writes the values of the array as they are read from its source
+func (a Array) write(w *bufio.Writer) error {
+	if a.Source == nil {
+		return errors.New("array without source")
+	}
+	if a.TypedArray {
+		return a.writeTypedArray(w)
+	}
+	number := make([]byte, 0, 32)
+	first := true
+	w.WriteByte('[')
+	err := a.Source(func(chunk []float64) error {
+		for _, value := range chunk {
+			if !first {
+				w.WriteByte(',')
+			}
+			first = false
+			number = appendNumber(number[:0], value)
+			_, err := w.Write(number)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return w.WriteByte(']')
+}
+
+// writeTypedArray writes the values as a typed array of little endian float64
+func (a Array) writeTypedArray(w *bufio.Writer) error {
+	w.WriteString(`{"dtype":"f8","bdata":"`)
+	encoder := base64.NewEncoder(base64.StdEncoding, w)
+	raw := make([]byte, 8)
+	err := a.Source(func(chunk []float64) error {
+		for _, value := range chunk {
+			binary.LittleEndian.PutUint64(raw, math.Float64bits(value))
+			_, err := encoder.Write(raw)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	err = encoder.Close()
+	if err != nil {
+		return err
+	}
+	_, err = w.WriteString(`"}`)
+	return err
+}
+
+// placeholder takes the place of an array while the rest of the figure is marshaled, Write replaces it with the array
+type placeholder int
+
+var placeholderType = reflect.TypeOf(placeholder(0))
+
+// placeholderPrefix starts the string written for placeholders, the NUL character keeps it apart from the text of the figures
+const placeholderPrefix = `"\u0000go-plotly-stream:`
+
+// MarshalJSON implements json.Marshaler
+func (p placeholder) MarshalJSON() ([]byte, error) {
+	return []byte(placeholderPrefix + strconv.Itoa(int(p)) + `"`), nil
+}
+
+// Write writes the figure to w in the format of ToPlotlyJSON, reading the Arrays of the traces from their sources as they are written.
+// The figure is not modified. The arrays can be anywhere in the traces, but not in the layout or the frames.
+// If a source fails, the figure written to w is incomplete.
+func Write(w io.Writer, fig *grob.Fig) error {
+	arrays := []Array{}
+	streamed := *fig
+	streamed.Data = make(grob.Traces, len(fig.Data))
+	for i, trace := range fig.Data {
+		streamed.Data[i] = trace
+		if trace == nil {
+			continue
+		}
+		value, changed := replaceArrays(reflect.ValueOf(trace), &arrays)
+		if changed {
+			streamed.Data[i] = value.Interface().(grob.Trace)
+		}
+	}
+
+	data, err := streamed.ToPlotlyJSON()
+	if err != nil {
+		return fmt.Errorf("cannot marshal figure, %w", err)
+	}
+
+	bw := bufio.NewWriter(w)
+	prefix := []byte(placeholderPrefix)
+	for {
+		start := bytes.Index(data, prefix)
+		if start < 0 {
+			break
+		}
+		end := start + len(prefix) + bytes.IndexByte(data[start+len(prefix):], '"') + 1
+		index, err := strconv.Atoi(string(data[start+len(prefix) : end-1]))
+		if err != nil || index < 0 || index >= len(arrays) {
+			return fmt.Errorf("invalid array placeholder %s", data[start:end])
+		}
+		bw.Write(data[:start])
+		err = arrays[index].write(bw)
+		if err != nil {
+			return fmt.Errorf("cannot write array, %w", err)
+		}
+		data = data[end:]
+	}
+	bw.Write(data)
+	return bw.Flush()
+}
+
+// replaceArrays returns a copy of the value where the arrays are replaced by placeholders, which are indexes of arrays.
+// Only the structs and slices that contain arrays are copied, it returns false if there are no arrays.
+func replaceArrays(value reflect.Value, arrays *[]Array) (reflect.Value, bool) {
+	switch value.Kind() {
+	case reflect.Interface:
+		if value.IsNil() || !placeholderType.Implements(value.Type()) {
+			return value, false
+		}
+		switch array := value.Interface().(type) {
+		case Array:
+			*arrays = append(*arrays, array)
+			return reflect.ValueOf(placeholder(len(*arrays) - 1)), true
+		case *Array:
+			if array == nil {
+				return value, false
+			}
+			*arrays = append(*arrays, *array)
+			return reflect.ValueOf(placeholder(len(*arrays) - 1)), true
+		}
+		return replaceArrays(value.Elem(), arrays)
+	case reflect.Ptr:
+		if value.IsNil() || value.Elem().Kind() != reflect.Struct {
+			return value, false
+		}
+		elem, changed := replaceArrays(value.Elem(), arrays)
+		if !changed {
+			return value, false
+		}
+		copied := reflect.New(elem.Type())
+		copied.Elem().Set(elem)
+		return copied, true
+	case reflect.Struct:
+		var copied reflect.Value
+		for i := 0; i < value.NumField(); i++ {
+			if value.Type().Field(i).PkgPath != "" {
+				continue
+			}
+			field, changed := replaceArrays(value.Field(i), arrays)
+			if !changed {
+				continue
+			}
+			if !copied.IsValid() {
+				copied = reflect.New(value.Type()).Elem()
+				copied.Set(value)
+			}
+			copied.Field(i).Set(field)
+		}
+		if !copied.IsValid() {
+			return value, false
+		}
+		return copied, true
+	case reflect.Slice:
+		switch value.Type().Elem().Kind() {
+		case reflect.Interface, reflect.Ptr, reflect.Struct:
+		default:
+			// slices of values, like []float64, cannot contain arrays
+			return value, false
+		}
+		var copied reflect.Value
+		for i := 0; i < value.Len(); i++ {
+			item, changed := replaceArrays(value.Index(i), arrays)
+			if !changed {
+				continue
+			}
+			if !copied.IsValid() {
+				copied = reflect.MakeSlice(value.Type(), value.Len(), value.Len())
+				reflect.Copy(copied, value)
+			}
+			copied.Index(i).Set(item)
+		}
+		if !copied.IsValid() {
+			return value, false
+		}
+		return copied, true
+	}
+	return value, false
+}
+
+// appendNumber appends the number like encoding/json, NaN and infinities are null
+func appendNumber(b []byte, f float64) []byte {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return append(b, "null"...)
+	}
+	format := byte('f')
+	if abs := math.Abs(f); abs != 0 && (abs < 1e-6 || abs >= 1e21) {
+		format = 'e'
+	}
+	b = strconv.AppendFloat(b, f, format, -1, 64)
+	if format == 'e' {
+		// clean up e-09 to e-9
+		n := len(b)
+		if n >= 4 && b[n-4] == 'e' && b[n-3] == '-' && b[n-2] == '0' {
+			b[n-2] = b[n-1]
+			b = b[:n-1]
+		}
+	}
+	return b
+}
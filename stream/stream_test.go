@@ -0,0 +1,105 @@
+package stream_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"math"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/stream"
+)
+
+var _ = Describe("Write", func() {
+
+	It("Should write the arrays from their sources", func() {
+		trace := &grob.Scatter{
+			Name:   "streamed",
+			X:      stream.Array{Source: stream.Func(5, func(i int) float64 { return float64(i) / 2 })},
+			Y:      &stream.Array{Source: stream.Func(5, func(i int) float64 { return float64(i * i) })},
+			Marker: &grob.ScatterMarker{Color: stream.Array{Source: stream.Func(5, func(i int) float64 { return math.NaN() })}},
+		}
+		fig := &grob.Fig{Data: grob.Traces{trace, &grob.Bar{Y: []float64{1}}}}
+
+		buf := &bytes.Buffer{}
+		err := stream.Write(buf, fig)
+		Expect(err).To(BeNil())
+		Expect(buf.String()).To(Equal(`{"data":[{"marker":{"color":[null,null,null,null,null]},"name":"streamed","x":[0,0.5,1,1.5,2],"y":[0,1,4,9,16]},{"y":[1]}],"layout":{}}`))
+
+		By("leaving the figure unchanged")
+		Expect(trace.X).To(BeAssignableToTypeOf(stream.Array{}))
+		Expect(trace.Marker.Color).To(BeAssignableToTypeOf(stream.Array{}))
+	})
+
+	It("Should write the same JSON as ToPlotlyJSON for the materialized arrays", func() {
+		values := make([]float64, 10000)
+		for i := range values {
+			values[i] = math.Sin(float64(i))
+		}
+		streamed := &grob.Fig{Data: grob.Traces{&grob.Scattergl{Type: grob.TraceTypeScattergl, Y: stream.Array{Source: stream.Func(len(values), func(i int) float64 { return values[i] })}}}}
+		inMemory := &grob.Fig{Data: grob.Traces{&grob.Scattergl{Type: grob.TraceTypeScattergl, Y: values}}}
+
+		buf := &bytes.Buffer{}
+		err := stream.Write(buf, streamed)
+		Expect(err).To(BeNil())
+		want, err := inMemory.ToPlotlyJSON()
+		Expect(err).To(BeNil())
+		Expect(buf.String()).To(Equal(string(want)))
+
+		By("marshaling the arrays out of Write too")
+		data, err := json.Marshal(streamed)
+		Expect(err).To(BeNil())
+		Expect(data).To(ContainSubstring(`"y":[0,0.8414709848078965,`))
+	})
+
+	It("Should write typed arrays", func() {
+		fig := &grob.Fig{Data: grob.Traces{&grob.Scattergl{Y: stream.Array{Source: stream.Func(2, func(i int) float64 { return 1 }), TypedArray: true}}}}
+		buf := &bytes.Buffer{}
+		err := stream.Write(buf, fig)
+		Expect(err).To(BeNil())
+		Expect(buf.String()).To(ContainSubstring(`"y":{"dtype":"f8","bdata":"AAAAAAAA8D8AAAAAAADwPw=="}`))
+	})
+
+	It("Should read float64 files in chunks", func() {
+		raw := make([]byte, 8*10000)
+		for i := 0; i < 10000; i++ {
+			binary.LittleEndian.PutUint64(raw[8*i:], math.Float64bits(float64(i)))
+		}
+		source := stream.Float64s(func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(raw)), nil
+		})
+		chunks, count := 0, 0
+		err := source(func(chunk []float64) error {
+			for _, value := range chunk {
+				Expect(value).To(Equal(float64(count)))
+				count++
+			}
+			chunks++
+			return nil
+		})
+		Expect(err).To(BeNil())
+		Expect(count).To(Equal(10000))
+		Expect(chunks).To(BeNumerically(">", 1))
+
+		source = stream.Float64s(func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(bytes.NewReader(raw[:12])), nil
+		})
+		err = source(func(chunk []float64) error { return nil })
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("Should return the errors of the sources", func() {
+		failing := func(emit func([]float64) error) error {
+			return errors.New("disk failure")
+		}
+		fig := &grob.Fig{Data: grob.Traces{&grob.Scatter{Y: stream.Array{Source: failing}}}}
+		err := stream.Write(ioutil.Discard, fig)
+		Expect(err).To(MatchError(ContainSubstring("disk failure")))
+	})
+})
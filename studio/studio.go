@@ -0,0 +1,155 @@
+// Package studio uploads figures to Chart Studio, either plotly cloud or an on-premise installation.
+//
+// Credentials are read from the PLOTLY_USERNAME and PLOTLY_API_KEY environment variables unless given in the Options.
+package studio
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// DefaultURL is the Chart Studio API of plotly cloud
+const DefaultURL = "https://api.plot.ly"
+
+// Sharing defines who can see an uploaded figure
+type Sharing string
+
+const (
+	// SharingPublic figures can be found and viewed by anyone
+	SharingPublic Sharing = "public"
+	// SharingPrivate figures can only be viewed by the owner and the users they are shared with
+	SharingPrivate Sharing = "private"
+	// SharingSecret figures can be viewed by anyone with the link, that contains a share key
+	SharingSecret Sharing = "secret"
+)
+
+// Options for the uploads
+type Options struct {
+	// Username and APIKey of the Chart Studio account, default to PLOTLY_USERNAME and PLOTLY_API_KEY
+	Username string
+	APIKey   string
+	// URL of the Chart Studio API, such as https://plotly.example.com/api for on-premise installations. Defaults to DefaultURL
+	URL string
+	// Sharing of the figure, defaults to SharingPublic
+	Sharing Sharing
+	// ParentPath is the folder where the figure is stored
+	ParentPath string
+	// HTTPClient sends the requests, defaults to http.DefaultClient
+	HTTPClient *http.Client
+}
+
+type uploadRequest struct {
+	Figure          *grob.Fig `json:"figure"`
+	Filename        string    `json:"filename"`
+	WorldReadable   bool      `json:"world_readable"`
+	ShareKeyEnabled bool      `json:"share_key_enabled,omitempty"`
+	ParentPath      string    `json:"parent_path,omitempty"`
+}
+
+type uploadResponse struct {
+	File struct {
+		Fid      string `json:"fid"`
+		WebURL   string `json:"web_url"`
+		ShareKey string `json:"share_key"`
+	} `json:"file"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// Upload creates a plot with the given filename and returns the URL where it is hosted.
+// Secret figures include the share key in the URL.
+func Upload(fig *grob.Fig, filename string, opt ...Options) (string, error) {
+	opts := computeOptions(Options{
+		Username:   os.Getenv("PLOTLY_USERNAME"),
+		APIKey:     os.Getenv("PLOTLY_API_KEY"),
+		URL:        DefaultURL,
+		Sharing:    SharingPublic,
+		HTTPClient: http.DefaultClient,
+	}, opt...)
+
+	if opts.Username == "" || opts.APIKey == "" {
+		return "", errors.New("chart studio credentials are required, set Options.Username and Options.APIKey")
+	}
+
+	body, err := json.Marshal(uploadRequest{
+		Figure:          fig,
+		Filename:        filename,
+		WorldReadable:   opts.Sharing == SharingPublic,
+		ShareKeyEnabled: opts.Sharing == SharingSecret,
+		ParentPath:      opts.ParentPath,
+	})
+	if err != nil {
+		return "", fmt.Errorf("cannot marshal figure, %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, strings.TrimSuffix(opts.URL, "/")+"/v2/plots", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.SetBasicAuth(opts.Username, opts.APIKey)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Plotly-Client-Platform", "go-plotly")
+
+	resp, err := opts.HTTPClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("cannot upload figure, %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("cannot read response, %w", err)
+	}
+	response := uploadResponse{}
+	err = json.Unmarshal(respBody, &response)
+	if err != nil {
+		return "", fmt.Errorf("invalid response from chart studio, status %s", resp.Status)
+	}
+	if resp.StatusCode >= 300 {
+		messages := make([]string, len(response.Errors))
+		for i, e := range response.Errors {
+			messages[i] = e.Message
+		}
+		return "", fmt.Errorf("chart studio error, status %s, %s", resp.Status, strings.Join(messages, "; "))
+	}
+
+	url := response.File.WebURL
+	if opts.Sharing == SharingSecret && response.File.ShareKey != "" {
+		url += "?share_key=" + response.File.ShareKey
+	}
+	return url, nil
+}
+
+func computeOptions(def Options, opt ...Options) Options {
+	if len(opt) == 1 {
+		opts := opt[0]
+		if opts.Username != "" {
+			def.Username = opts.Username
+		}
+		if opts.APIKey != "" {
+			def.APIKey = opts.APIKey
+		}
+		if opts.URL != "" {
+			def.URL = opts.URL
+		}
+		if opts.Sharing != "" {
+			def.Sharing = opts.Sharing
+		}
+		if opts.ParentPath != "" {
+			def.ParentPath = opts.ParentPath
+		}
+		if opts.HTTPClient != nil {
+			def.HTTPClient = opts.HTTPClient
+		}
+	}
+	return def
+}
@@ -0,0 +1,80 @@
+package studio_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/studio"
+)
+
+var _ = Describe("Studio", func() {
+
+	var (
+		ts      *httptest.Server
+		request map[string]interface{}
+		opts    studio.Options
+	)
+
+	BeforeEach(func() {
+		request = nil
+		ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			Expect(r.URL.Path).To(Equal("/v2/plots"))
+
+			user, key, ok := r.BasicAuth()
+			if !ok || user != "user" || key != "key" {
+				w.WriteHeader(http.StatusUnauthorized)
+				w.Write([]byte(`{"errors": [{"message": "Authentication credentials were not provided."}]}`))
+				return
+			}
+			err := json.NewDecoder(r.Body).Decode(&request)
+			Expect(err).To(BeNil())
+
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"file": {"fid": "user:1", "web_url": "https://chart-studio.plotly.com/~user/1/", "share_key": "abc"}}`))
+		}))
+		opts = studio.Options{
+			Username: "user",
+			APIKey:   "key",
+			URL:      ts.URL,
+		}
+	})
+
+	AfterEach(func() {
+		ts.Close()
+	})
+
+	It("Should upload the figure", func() {
+		url, err := studio.Upload(&grob.Fig{
+			Layout: &grob.Layout{
+				Title: &grob.LayoutTitle{Text: "uploaded"},
+			},
+		}, "report", opts)
+		Expect(err).To(BeNil())
+		Expect(url).To(Equal("https://chart-studio.plotly.com/~user/1/"))
+
+		Expect(request).To(HaveKeyWithValue("filename", "report"))
+		Expect(request).To(HaveKeyWithValue("world_readable", true))
+		Expect(request).To(HaveKey("figure"))
+	})
+
+	It("Should add the share key to secret figures", func() {
+		opts.Sharing = studio.SharingSecret
+		url, err := studio.Upload(&grob.Fig{}, "report", opts)
+		Expect(err).To(BeNil())
+		Expect(url).To(Equal("https://chart-studio.plotly.com/~user/1/?share_key=abc"))
+		Expect(request).To(HaveKeyWithValue("world_readable", false))
+		Expect(request).To(HaveKeyWithValue("share_key_enabled", true))
+	})
+
+	It("Should report API errors", func() {
+		opts.APIKey = "wrong"
+		_, err := studio.Upload(&grob.Fig{}, "report", opts)
+		Expect(err).To(MatchError(ContainSubstring("Authentication credentials were not provided.")))
+	})
+})
@@ -0,0 +1,216 @@
+package subplots
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var subplotPattern = regexp.MustCompile(`^x([1-9][0-9]*)?y([1-9][0-9]*)?$`)
+
+// Grid declares the layout grid of plotly.js, which places the axes in rows and columns without computing their domains.
+// It is lighter than New, plotly.js lays out the cells, but the spacing and sizes of the cells cannot be customized.
+//
+//	grid := subplots.Grid{Rows: 2, Columns: 2, Pattern: grob.LayoutGridPatternIndependent}
+//	err := grid.Place(trace, 2, 1)
+//	err = grid.Apply(fig)
+type Grid struct {
+	// Rows and Columns of the grid. They default to the size of Cells if it is given
+	Rows, Columns int
+	// Pattern is coupled for an x axis per column and a y axis per row, or independent for a pair of axes per cell.
+	// Defaults to coupled, ignored if Cells is given
+	Pattern grob.LayoutGridPattern
+	// BottomToTop numbers the rows from the bottom, by default the first row is at the top
+	BottomToTop bool
+	// Cells are the subplots of every cell by row, like xy or x2y, or empty to leave the cell empty.
+	// An x axis can only be reused in the same column and a y axis in the same row
+	Cells [][]string
+	// Xgap and Ygap are the space between the cells as a fraction of a cell, they default to the values of plotly.js
+	Xgap, Ygap float64
+}
+
+// Place anchors the trace to the axes of the cell at the given row and column, starting at 1.
+// The first row is at the top unless BottomToTop is set.
+func (g Grid) Place(trace grob.Trace, row, col int) error {
+	rows, cols := g.size()
+	if row < 1 || row > rows || col < 1 || col > cols {
+		return fmt.Errorf("cell (%d, %d) is out of the %dx%d grid", row, col, rows, cols)
+	}
+	subplot := g.subplot(row, col)
+	if subplot == "" {
+		return fmt.Errorf("cell (%d, %d) of the grid is empty", row, col)
+	}
+	xaxis, yaxis, ok := axisFields(trace)
+	if !ok {
+		return fmt.Errorf("trace %s cannot be placed in a subplot", trace.GetType())
+	}
+	x := subplot[:strings.Index(subplot, "y")]
+	xaxis.Set(reflect.ValueOf(x))
+	yaxis.Set(reflect.ValueOf(subplot[len(x):]))
+	return nil
+}
+
+// Apply sets the grid of the layout of the figure. It fails if the grid is not valid or if a cartesian trace
+// of the figure is anchored to a subplot that is not a cell of the grid.
+func (g Grid) Apply(fig *grob.Fig) error {
+	err := g.validate()
+	if err != nil {
+		return err
+	}
+
+	cells := map[string]bool{}
+	rows, cols := g.size()
+	for row := 1; row <= rows; row++ {
+		for col := 1; col <= cols; col++ {
+			cells[g.subplot(row, col)] = true
+		}
+	}
+	for i, trace := range fig.Data {
+		xaxis, yaxis, ok := axisFields(trace)
+		if !ok {
+			continue
+		}
+		subplot := normalizeSubplot(axisName(xaxis, "x") + axisName(yaxis, "y"))
+		if !cells[subplot] {
+			return fmt.Errorf("trace %d is anchored to %s, which is not a cell of the grid", i, subplot)
+		}
+	}
+
+	grid := &grob.LayoutGrid{
+		Rows:    int64(rows),
+		Columns: int64(cols),
+		Pattern: g.Pattern,
+		Xgap:    g.Xgap,
+		Ygap:    g.Ygap,
+	}
+	if g.Cells != nil {
+		grid.Pattern = ""
+		grid.Subplots = g.Cells
+	}
+	if g.BottomToTop {
+		grid.Roworder = grob.LayoutGridRoworderBottomToTop
+	}
+	if fig.Layout == nil {
+		fig.Layout = &grob.Layout{}
+	}
+	fig.Layout.Grid = grid
+	return nil
+}
+
+// size returns the number of rows and columns of the grid
+func (g Grid) size() (int, int) {
+	rows, cols := g.Rows, g.Columns
+	if rows == 0 {
+		rows = len(g.Cells)
+	}
+	if cols == 0 {
+		for _, row := range g.Cells {
+			if len(row) > cols {
+				cols = len(row)
+			}
+		}
+	}
+	return rows, cols
+}
+
+// subplot returns the subplot of the cell, like x2y, or empty if there is none
+func (g Grid) subplot(row, col int) string {
+	if g.Cells != nil {
+		if row > len(g.Cells) || col > len(g.Cells[row-1]) {
+			return ""
+		}
+		return normalizeSubplot(g.Cells[row-1][col-1])
+	}
+	if g.Pattern == grob.LayoutGridPatternIndependent {
+		_, cols := g.size()
+		n := (row-1)*cols + col
+		return axisRef("x", n) + axisRef("y", n)
+	}
+	return axisRef("x", col) + axisRef("y", row)
+}
+
+// validate checks the size of the grid and that the axes of the cells are only reused in the same column or row
+func (g Grid) validate() error {
+	rows, cols := g.size()
+	if rows < 1 || cols < 1 {
+		return fmt.Errorf("a grid of %dx%d is not supported, it must have at least a cell", rows, cols)
+	}
+	if g.Cells == nil {
+		return nil
+	}
+	if len(g.Cells) > rows {
+		return fmt.Errorf("%d rows of cells given for %d rows", len(g.Cells), rows)
+	}
+
+	xColumns := map[string]int{}
+	yRows := map[string]int{}
+	for row, cells := range g.Cells {
+		if len(cells) > cols {
+			return fmt.Errorf("%d cells given in row %d for %d columns", len(cells), row+1, cols)
+		}
+		for col, cell := range cells {
+			if cell == "" {
+				continue
+			}
+			subplot := normalizeSubplot(cell)
+			if !subplotPattern.MatchString(subplot) {
+				return fmt.Errorf("cell (%d, %d) is %s, it must be a subplot like xy or x2y3", row+1, col+1, cell)
+			}
+			x := subplot[:strings.Index(subplot, "y")]
+			y := subplot[len(x):]
+			if c, ok := xColumns[x]; ok && c != col {
+				return fmt.Errorf("axis %s is used in the columns %d and %d, it can only be reused in the same column", x, c+1, col+1)
+			}
+			if r, ok := yRows[y]; ok && r != row {
+				return fmt.Errorf("axis %s is used in the rows %d and %d, it can only be reused in the same row", y, r+1, row+1)
+			}
+			xColumns[x] = col
+			yRows[y] = row
+		}
+	}
+	return nil
+}
+
+// axisFields returns the Xaxis and Yaxis fields of cartesian traces
+func axisFields(trace grob.Trace) (reflect.Value, reflect.Value, bool) {
+	value := reflect.ValueOf(trace)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, reflect.Value{}, false
+	}
+	value = value.Elem()
+	xaxis, yaxis := value.FieldByName("Xaxis"), value.FieldByName("Yaxis")
+	if xaxis.Kind() != reflect.Interface || yaxis.Kind() != reflect.Interface {
+		return reflect.Value{}, reflect.Value{}, false
+	}
+	return xaxis, yaxis, true
+}
+
+// axisName returns the axis referenced by the field of a trace, which defaults to the first axis
+func axisName(field reflect.Value, label string) string {
+	if field.IsNil() {
+		return label
+	}
+	name := fmt.Sprint(field.Interface())
+	if name == "" {
+		return label
+	}
+	return name
+}
+
+// normalizeSubplot removes the number of the first axes, x1y1 is xy
+func normalizeSubplot(subplot string) string {
+	match := subplotPattern.FindStringSubmatch(subplot)
+	if match == nil {
+		return subplot
+	}
+	if match[1] == "1" {
+		match[1] = ""
+	}
+	if match[2] == "1" {
+		match[2] = ""
+	}
+	return "x" + match[1] + "y" + match[2]
+}
@@ -0,0 +1,82 @@
+package subplots_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/subplots"
+)
+
+var _ = Describe("Grid", func() {
+
+	It("Should place the traces in a coupled grid", func() {
+		grid := subplots.Grid{Rows: 2, Columns: 3}
+		scatter := &grob.Scatter{}
+		Expect(grid.Place(scatter, 2, 3)).To(Succeed())
+		Expect(scatter.Xaxis).To(Equal("x3"))
+		Expect(scatter.Yaxis).To(Equal("y2"))
+
+		fig := &grob.Fig{}
+		fig.AddTraces(scatter, &grob.Bar{})
+		Expect(grid.Apply(fig)).To(Succeed())
+		data, err := json.Marshal(fig.Layout.Grid)
+		Expect(err).To(BeNil())
+		Expect(data).To(MatchJSON(`{"rows": 2, "columns": 3}`))
+	})
+
+	It("Should place the traces in an independent grid", func() {
+		grid := subplots.Grid{Rows: 2, Columns: 2, Pattern: grob.LayoutGridPatternIndependent, BottomToTop: true, Ygap: 0.2}
+		bar := &grob.Bar{}
+		Expect(grid.Place(bar, 2, 1)).To(Succeed())
+		Expect(bar.Xaxis).To(Equal("x3"))
+		Expect(bar.Yaxis).To(Equal("y3"))
+		Expect(grid.Place(bar, 3, 1)).NotTo(Succeed())
+		Expect(grid.Place(&grob.Pie{}, 1, 1)).NotTo(Succeed())
+
+		fig := &grob.Fig{}
+		fig.AddTraces(bar)
+		Expect(grid.Apply(fig)).To(Succeed())
+		data, err := json.Marshal(fig.Layout.Grid)
+		Expect(err).To(BeNil())
+		Expect(data).To(MatchJSON(`{"rows": 2, "columns": 2, "pattern": "independent", "roworder": "bottom to top", "ygap": 0.2}`))
+	})
+
+	It("Should declare the subplots of the cells", func() {
+		grid := subplots.Grid{Cells: [][]string{
+			{"xy", "x2y"},
+			{"xy2", ""},
+		}}
+		scatter := &grob.Scatter{}
+		Expect(grid.Place(scatter, 1, 2)).To(Succeed())
+		Expect(scatter.Xaxis).To(Equal("x2"))
+		Expect(scatter.Yaxis).To(Equal("y"))
+		Expect(grid.Place(scatter, 2, 2)).NotTo(Succeed())
+
+		fig := &grob.Fig{}
+		fig.AddTraces(scatter, &grob.Scatter{Xaxis: "x1", Yaxis: "y1"})
+		Expect(grid.Apply(fig)).To(Succeed())
+		Expect(fig.Layout.Grid.Rows).To(BeEquivalentTo(2))
+		Expect(fig.Layout.Grid.Columns).To(BeEquivalentTo(2))
+		Expect(fig.Layout.Grid.Subplots).To(Equal(grid.Cells))
+	})
+
+	It("Should check the traces against the grid", func() {
+		fig := &grob.Fig{}
+		fig.AddTraces(&grob.Scatter{Xaxis: "x3", Yaxis: "y"})
+		err := subplots.Grid{Rows: 2, Columns: 2}.Apply(fig)
+		Expect(err).To(MatchError("trace 0 is anchored to x3y, which is not a cell of the grid"))
+		Expect(fig.Layout).To(BeNil())
+	})
+
+	It("Should reject invalid grids", func() {
+		fig := &grob.Fig{}
+		Expect(subplots.Grid{}.Apply(fig)).NotTo(Succeed())
+		Expect(subplots.Grid{Cells: [][]string{{"xy", "x2y"}, {"x2y2", ""}}}.Apply(fig)).To(MatchError(ContainSubstring("axis x2 is used in the columns 2 and 1")))
+		Expect(subplots.Grid{Cells: [][]string{{"xy"}, {"x2y"}}}.Apply(fig)).To(MatchError(ContainSubstring("axis y is used in the rows 1 and 2")))
+		Expect(subplots.Grid{Cells: [][]string{{"polar"}}}.Apply(fig)).NotTo(Succeed())
+		Expect(subplots.Grid{Rows: 1, Cells: [][]string{{"xy"}, {"xy2"}}}.Apply(fig)).NotTo(Succeed())
+	})
+})
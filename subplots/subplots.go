@@ -0,0 +1,270 @@
+// Package subplots arranges traces in a grid of cartesian subplots, like make_subplots in plotly.py.
+//
+// Every cell has its own x and y axes, numbered from the top left cell in row order.
+// The axis domains leave the given spacing between cells and shared axes are linked with the matches attribute.
+//
+//	sp := subplots.New(2, 1, subplots.Options{SharedXAxes: true, Titles: []string{"Price", "Volume"}})
+//	sp.Add(&grob.Scatter{X: days, Y: price}, 1, 1)
+//	sp.Add(&grob.Bar{X: days, Y: volume}, 2, 1)
+//	offline.Show(sp.Figure())
+//
+// Grid declares the layout grid of plotly.js instead, which lays out the cells itself.
+package subplots
+
+import (
+	"fmt"
+	"reflect"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// MaxCells is the maximum number of cells in a grid, limited by the axes available in grob.Layout
+const MaxCells = 16
+
+// Options configure the grid
+type Options struct {
+	// SharedXAxes links the x axes of each column, only the bottom row displays the tick labels
+	SharedXAxes bool
+	// SharedYAxes links the y axes of each row, only the first column displays the tick labels
+	SharedYAxes bool
+	// HorizontalSpacing is the space between columns as a fraction of the plot width, defaults to 0.2 / cols
+	HorizontalSpacing float64
+	// VerticalSpacing is the space between rows as a fraction of the plot height, defaults to 0.3 / rows
+	VerticalSpacing float64
+	// ColumnWidths are the relative widths of the columns, defaults to equal widths
+	ColumnWidths []float64
+	// RowHeights are the relative heights of the rows from top to bottom, defaults to equal heights
+	RowHeights []float64
+	// Titles are displayed above the cells, in row order. Empty titles are skipped
+	Titles []string
+}
+
+// Subplots is a figure with a grid of subplots
+type Subplots struct {
+	rows, cols int
+	opts       Options
+	fig        *grob.Fig
+}
+
+// New creates a grid of rows x cols subplots. It panics if the grid is empty, has more than MaxCells cells
+// or if ColumnWidths or RowHeights do not match the number of columns or rows.
+func New(rows, cols int, opt ...Options) *Subplots {
+	if rows < 1 || cols < 1 || rows*cols > MaxCells {
+		panic(fmt.Sprintf("subplots: a grid of %dx%d is not supported, it must have between 1 and %d cells", rows, cols, MaxCells))
+	}
+	opts := computeOptions(Options{
+		HorizontalSpacing: 0.2 / float64(cols),
+		VerticalSpacing:   0.3 / float64(rows),
+	}, opt...)
+	if opts.ColumnWidths != nil && len(opts.ColumnWidths) != cols {
+		panic(fmt.Sprintf("subplots: %d column widths given for %d columns", len(opts.ColumnWidths), cols))
+	}
+	if opts.RowHeights != nil && len(opts.RowHeights) != rows {
+		panic(fmt.Sprintf("subplots: %d row heights given for %d rows", len(opts.RowHeights), rows))
+	}
+
+	sp := &Subplots{
+		rows: rows,
+		cols: cols,
+		opts: opts,
+		fig: &grob.Fig{
+			Layout: &grob.Layout{},
+		},
+	}
+	sp.layout()
+	return sp
+}
+
+func computeOptions(def Options, opt ...Options) Options {
+	if len(opt) == 1 {
+		opts := opt[0]
+		if opts.SharedXAxes {
+			def.SharedXAxes = opts.SharedXAxes
+		}
+		if opts.SharedYAxes {
+			def.SharedYAxes = opts.SharedYAxes
+		}
+		if opts.HorizontalSpacing != 0 {
+			def.HorizontalSpacing = opts.HorizontalSpacing
+		}
+		if opts.VerticalSpacing != 0 {
+			def.VerticalSpacing = opts.VerticalSpacing
+		}
+		if opts.ColumnWidths != nil {
+			def.ColumnWidths = opts.ColumnWidths
+		}
+		if opts.RowHeights != nil {
+			def.RowHeights = opts.RowHeights
+		}
+		if opts.Titles != nil {
+			def.Titles = opts.Titles
+		}
+	}
+	return def
+}
+
+// Add places the trace in the cell at the given row and column, starting at 1 from the top left corner.
+// Cartesian traces are anchored to the axes of the cell, traces with a domain such as pie are placed in the cell area
+// and the axes of the cell are hidden.
+// The Type of the trace is set if it is empty.
+func (sp *Subplots) Add(trace grob.Trace, row, col int) error {
+	if row < 1 || row > sp.rows || col < 1 || col > sp.cols {
+		return fmt.Errorf("cell (%d, %d) is out of the %dx%d grid", row, col, sp.rows, sp.cols)
+	}
+	n := sp.axisNumber(row, col)
+
+	value := reflect.ValueOf(trace)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("trace %s cannot be placed in a subplot", trace.GetType())
+	}
+	value = value.Elem()
+
+	xaxis, yaxis := value.FieldByName("Xaxis"), value.FieldByName("Yaxis")
+	if xaxis.Kind() == reflect.Interface && yaxis.Kind() == reflect.Interface {
+		xaxis.Set(reflect.ValueOf(axisRef("x", n)))
+		yaxis.Set(reflect.ValueOf(axisRef("y", n)))
+		sp.fig.AddTraces(trace)
+		return nil
+	}
+
+	domain := value.FieldByName("Domain")
+	if domain.IsValid() && domain.Kind() == reflect.Ptr && domain.Type().Elem().Kind() == reflect.Struct {
+		d := reflect.New(domain.Type().Elem())
+		x, y := sp.cellDomain(row, col)
+		d.Elem().FieldByName("X").Set(reflect.ValueOf(x))
+		d.Elem().FieldByName("Y").Set(reflect.ValueOf(y))
+		domain.Set(d)
+		// the axes of the cell would be drawn behind the trace
+		sp.XAxis(row, col).Visible = grob.False
+		sp.YAxis(row, col).Visible = grob.False
+		sp.fig.AddTraces(trace)
+		return nil
+	}
+	return fmt.Errorf("trace %s cannot be placed in a subplot", trace.GetType())
+}
+
+// XAxis returns the x axis of the cell to set other attributes, such as the title
+func (sp *Subplots) XAxis(row, col int) *grob.LayoutXaxis {
+	return sp.axis("X", sp.axisNumber(row, col)).(*grob.LayoutXaxis)
+}
+
+// YAxis returns the y axis of the cell to set other attributes, such as the title
+func (sp *Subplots) YAxis(row, col int) *grob.LayoutYaxis {
+	return sp.axis("Y", sp.axisNumber(row, col)).(*grob.LayoutYaxis)
+}
+
+// Figure returns the figure with the traces added so far.
+// It is always the same figure, the layout can be customized as long as the axes domains and anchors are kept.
+func (sp *Subplots) Figure() *grob.Fig {
+	return sp.fig
+}
+
+// layout defines the axes of every cell and the titles
+func (sp *Subplots) layout() {
+	annotations := []map[string]interface{}{}
+	for row := 1; row <= sp.rows; row++ {
+		for col := 1; col <= sp.cols; col++ {
+			n := sp.axisNumber(row, col)
+			xDomain, yDomain := sp.cellDomain(row, col)
+
+			xaxis := sp.axis("X", n).(*grob.LayoutXaxis)
+			xaxis.Anchor = grob.LayoutXaxisAnchor(axisRef("y", n))
+			xaxis.Domain = xDomain
+			if sp.opts.SharedXAxes && row != sp.rows {
+				xaxis.Matches = grob.LayoutXaxisMatches(axisRef("x", sp.axisNumber(sp.rows, col)))
+				xaxis.Showticklabels = grob.False
+			}
+
+			yaxis := sp.axis("Y", n).(*grob.LayoutYaxis)
+			yaxis.Anchor = grob.LayoutYaxisAnchor(axisRef("x", n))
+			yaxis.Domain = yDomain
+			if sp.opts.SharedYAxes && col != 1 {
+				yaxis.Matches = grob.LayoutYaxisMatches(axisRef("y", sp.axisNumber(row, 1)))
+				yaxis.Showticklabels = grob.False
+			}
+
+			if n-1 < len(sp.opts.Titles) && sp.opts.Titles[n-1] != "" {
+				annotations = append(annotations, map[string]interface{}{
+					"text":      sp.opts.Titles[n-1],
+					"x":         (xDomain[0] + xDomain[1]) / 2,
+					"y":         yDomain[1],
+					"xref":      "paper",
+					"yref":      "paper",
+					"xanchor":   "center",
+					"yanchor":   "bottom",
+					"showarrow": false,
+					"font":      map[string]interface{}{"size": 16},
+				})
+			}
+		}
+	}
+	if len(annotations) > 0 {
+		sp.fig.Layout.Annotations = annotations
+	}
+}
+
+// axisNumber is the number of the axes of the cell, 1 for the top left cell
+func (sp *Subplots) axisNumber(row, col int) int {
+	return (row-1)*sp.cols + col
+}
+
+// axis returns the layout axis with the given label and number, creating it if needed
+func (sp *Subplots) axis(label string, n int) interface{} {
+	name := label + "axis"
+	if n > 1 {
+		name = fmt.Sprintf("%sAxis%d", label, n)
+	}
+	field := reflect.ValueOf(sp.fig.Layout).Elem().FieldByName(name)
+	if field.IsNil() {
+		field.Set(reflect.New(field.Type().Elem()))
+	}
+	return field.Interface()
+}
+
+// axisRef is the name used to reference an axis from traces and other axes, such as x or y2
+func axisRef(label string, n int) string {
+	if n == 1 {
+		return label
+	}
+	return fmt.Sprintf("%s%d", label, n)
+}
+
+// cellDomain returns the area of the cell in paper coordinates
+func (sp *Subplots) cellDomain(row, col int) ([]float64, []float64) {
+	x := domains(sp.cols, sp.opts.HorizontalSpacing, sp.opts.ColumnWidths)[col-1]
+
+	// rows are numbered from the top, but paper coordinates start at the bottom
+	var heights []float64
+	if sp.opts.RowHeights != nil {
+		heights = make([]float64, sp.rows)
+		for i, h := range sp.opts.RowHeights {
+			heights[sp.rows-1-i] = h
+		}
+	}
+	y := domains(sp.rows, sp.opts.VerticalSpacing, heights)[sp.rows-row]
+	return x, y
+}
+
+// domains splits [0, 1] in n intervals separated by spacing, with sizes proportional to weights
+func domains(n int, spacing float64, weights []float64) [][]float64 {
+	if weights == nil {
+		weights = make([]float64, n)
+		for i := range weights {
+			weights[i] = 1
+		}
+	}
+	total := 0.0
+	for _, w := range weights {
+		total += w
+	}
+	available := 1 - spacing*float64(n-1)
+
+	result := make([][]float64, n)
+	start := 0.0
+	for i := range result {
+		size := available * weights[i] / total
+		result[i] = []float64{start, start + size}
+		start += size + spacing
+	}
+	return result
+}
@@ -0,0 +1,13 @@
+package subplots_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestSubplots(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Subplots Suite")
+}
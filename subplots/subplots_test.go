@@ -0,0 +1,110 @@
+package subplots_test
+
+import (
+	"encoding/json"
+
+	"github.com/onsi/gomega/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/subplots"
+)
+
+var _ = Describe("Subplots", func() {
+
+	It("Should anchor the traces to the axes of their cell", func() {
+		sp := subplots.New(2, 2)
+		scatter := &grob.Scatter{Y: []float64{1, 2}}
+		bar := &grob.Bar{Y: []float64{3, 4}}
+		Expect(sp.Add(scatter, 1, 1)).To(Succeed())
+		Expect(sp.Add(bar, 2, 2)).To(Succeed())
+
+		Expect(scatter.Type).To(Equal(grob.TraceTypeScatter))
+		Expect(scatter.Xaxis).To(Equal("x"))
+		Expect(scatter.Yaxis).To(Equal("y"))
+		Expect(bar.Xaxis).To(Equal("x4"))
+		Expect(bar.Yaxis).To(Equal("y4"))
+
+		fig := sp.Figure()
+		Expect(fig.Data).To(HaveLen(2))
+		Expect(fig.Layout.XAxis4.Anchor).To(BeEquivalentTo("y4"))
+		Expect(fig.Layout.YAxis4.Anchor).To(BeEquivalentTo("x4"))
+		Expect(fig.Layout.XAxis5).To(BeNil())
+	})
+
+	It("Should compute the domains of the cells", func() {
+		sp := subplots.New(2, 2, subplots.Options{
+			HorizontalSpacing: 0.1,
+			VerticalSpacing:   0.2,
+			RowHeights:        []float64{3, 1},
+		})
+		layout := sp.Figure().Layout
+
+		Expect(layout.Xaxis.Domain).To(BeDomain(0, 0.45))
+		Expect(layout.XAxis2.Domain).To(BeDomain(0.55, 1))
+		// the first row is at the top
+		Expect(layout.Yaxis.Domain).To(BeDomain(0.4, 1))
+		Expect(layout.YAxis3.Domain).To(BeDomain(0, 0.2))
+	})
+
+	It("Should link shared axes", func() {
+		sp := subplots.New(2, 2, subplots.Options{SharedXAxes: true, SharedYAxes: true})
+		layout := sp.Figure().Layout
+
+		Expect(layout.Xaxis.Matches).To(BeEquivalentTo("x3"))
+		Expect(layout.Xaxis.Showticklabels).To(Equal(grob.False))
+		Expect(layout.XAxis3.Matches).To(BeEquivalentTo(""))
+		Expect(layout.YAxis2.Matches).To(BeEquivalentTo("y"))
+		Expect(layout.YAxis4.Matches).To(BeEquivalentTo("y3"))
+		Expect(layout.Yaxis.Matches).To(BeEquivalentTo(""))
+	})
+
+	It("Should add the titles above the cells", func() {
+		sp := subplots.New(1, 2, subplots.Options{
+			HorizontalSpacing: 0.2,
+			Titles:            []string{"", "Second"},
+		})
+		annotations := sp.Figure().Layout.Annotations.([]map[string]interface{})
+		Expect(annotations).To(HaveLen(1))
+		Expect(annotations[0]).To(HaveKeyWithValue("text", "Second"))
+		Expect(annotations[0]).To(HaveKeyWithValue("x", 0.8))
+		Expect(annotations[0]).To(HaveKeyWithValue("y", 1.0))
+	})
+
+	It("Should place domain traces in the cell area", func() {
+		sp := subplots.New(1, 2, subplots.Options{HorizontalSpacing: 0.2})
+		pie := &grob.Pie{}
+		Expect(sp.Add(pie, 1, 2)).To(Succeed())
+		Expect(pie.Domain.X).To(BeDomain(0.6, 1))
+		Expect(pie.Domain.Y).To(BeDomain(0, 1))
+		Expect(sp.XAxis(1, 2).Visible).To(Equal(grob.False))
+	})
+
+	It("Should return an error outside of the grid", func() {
+		sp := subplots.New(1, 1)
+		Expect(sp.Add(&grob.Scatter{}, 1, 2)).NotTo(Succeed())
+	})
+
+	It("Should only emit the axes in use", func() {
+		sp := subplots.New(1, 2)
+		figBytes, err := json.Marshal(sp.Figure())
+		Expect(err).To(BeNil())
+		Expect(string(figBytes)).To(ContainSubstring(`"xaxis2"`))
+		Expect(string(figBytes)).NotTo(ContainSubstring(`"xaxis3"`))
+	})
+
+	It("Should panic with too many cells", func() {
+		Expect(func() { subplots.New(5, 4) }).To(Panic())
+	})
+})
+
+// BeDomain matches a domain with a tolerance for rounding errors
+func BeDomain(start, end float64) types.GomegaMatcher {
+	return And(
+		HaveLen(2),
+		WithTransform(func(d []float64) float64 { return d[0] }, BeNumerically("~", start, 1e-9)),
+		WithTransform(func(d []float64) float64 { return d[1] }, BeNumerically("~", end, 1e-9)),
+	)
+}
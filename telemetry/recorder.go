@@ -0,0 +1,138 @@
+package telemetry
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	"google.golang.org/protobuf/proto"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// DefaultRetention is the period of the points kept by recorders by default
+const DefaultRetention = time.Hour
+
+// Recorder keeps the points of the metrics exported over time, by metric name, to plot them with FromMetric.
+// The metrics of every resource and scope are merged. It is safe for concurrent use.
+type Recorder struct {
+	retention time.Duration
+
+	mu      sync.Mutex
+	metrics map[string]*metricspb.Metric
+}
+
+// NewRecorder returns a recorder keeping the points of the last retention period, DefaultRetention if 0
+func NewRecorder(retention time.Duration) *Recorder {
+	if retention == 0 {
+		retention = DefaultRetention
+	}
+	return &Recorder{
+		retention: retention,
+		metrics:   map[string]*metricspb.Metric{},
+	}
+}
+
+// Record adds the points of the metrics, such as those of an OTLP export request. The points are copied.
+// A metric whose type changes starts over.
+func (r *Recorder) Record(metrics ...*metricspb.ResourceMetrics) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, resource := range metrics {
+		for _, scope := range resource.GetScopeMetrics() {
+			for _, metric := range scope.GetMetrics() {
+				r.record(proto.Clone(metric).(*metricspb.Metric))
+			}
+		}
+	}
+}
+
+// record appends the points of the metric to the recorded metric and drops the points older than the retention period
+func (r *Recorder) record(metric *metricspb.Metric) {
+	recorded, ok := r.metrics[metric.GetName()]
+	if !ok || fmt.Sprintf("%T", recorded.GetData()) != fmt.Sprintf("%T", metric.GetData()) {
+		r.metrics[metric.GetName()] = metric
+		recorded = metric
+	} else {
+		switch data := recorded.GetData().(type) {
+		case *metricspb.Metric_Gauge:
+			data.Gauge.DataPoints = append(data.Gauge.DataPoints, metric.GetGauge().GetDataPoints()...)
+		case *metricspb.Metric_Sum:
+			data.Sum.DataPoints = append(data.Sum.DataPoints, metric.GetSum().GetDataPoints()...)
+		case *metricspb.Metric_Histogram:
+			data.Histogram.DataPoints = append(data.Histogram.DataPoints, metric.GetHistogram().GetDataPoints()...)
+		default:
+			r.metrics[metric.GetName()] = metric
+			recorded = metric
+		}
+	}
+
+	switch data := recorded.GetData().(type) {
+	case *metricspb.Metric_Gauge:
+		data.Gauge.DataPoints = retainNumbers(data.Gauge.DataPoints, r.retention)
+	case *metricspb.Metric_Sum:
+		data.Sum.DataPoints = retainNumbers(data.Sum.DataPoints, r.retention)
+	case *metricspb.Metric_Histogram:
+		data.Histogram.DataPoints = retainHistograms(data.Histogram.DataPoints, r.retention)
+	}
+}
+
+// Names returns the names of the recorded metrics, sorted
+func (r *Recorder) Names() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	names := make([]string, 0, len(r.metrics))
+	for name := range r.metrics {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Figure plots the recorded points of the metric with FromMetric
+func (r *Recorder) Figure(name string, opt ...Options) (*grob.Fig, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	metric, ok := r.metrics[name]
+	if !ok {
+		return nil, fmt.Errorf("metric %s not found", name)
+	}
+	// the figure is built while locked, its arrays are new so the points can be recorded afterwards
+	return FromMetric(metric, opt...)
+}
+
+// retainNumbers drops the points older than the retention period before the latest point
+func retainNumbers(points []*metricspb.NumberDataPoint, retention time.Duration) []*metricspb.NumberDataPoint {
+	latest := uint64(0)
+	for _, point := range points {
+		if point.GetTimeUnixNano() > latest {
+			latest = point.GetTimeUnixNano()
+		}
+	}
+	kept := points[:0]
+	for _, point := range points {
+		if latest-point.GetTimeUnixNano() <= uint64(retention) {
+			kept = append(kept, point)
+		}
+	}
+	return kept
+}
+
+// retainHistograms drops the points older than the retention period before the latest point
+func retainHistograms(points []*metricspb.HistogramDataPoint, retention time.Duration) []*metricspb.HistogramDataPoint {
+	latest := uint64(0)
+	for _, point := range points {
+		if point.GetTimeUnixNano() > latest {
+			latest = point.GetTimeUnixNano()
+		}
+	}
+	kept := points[:0]
+	for _, point := range points {
+		if latest-point.GetTimeUnixNano() <= uint64(retention) {
+			kept = append(kept, point)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,262 @@
+// Package telemetry plots OpenTelemetry metrics, in the OTLP data model of the exporters and the collector,
+// so services can expose charts of their own telemetry, like a /debug/charts page.
+//
+// Gauges and sums, like counters, are lines over time with a line per attribute set. Histograms are heatmaps of the counts
+// of their buckets over time. The Recorder keeps the points of the metrics exported over time to plot them.
+//
+//	recorder := telemetry.NewRecorder(time.Hour)
+//	recorder.Record(request.ResourceMetrics...)
+//	fig, err := recorder.Figure("http.server.duration")
+package telemetry
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+
+	"github.com/MetalBlueberry/go-plotly/express"
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// Options configure the figures of the metrics
+type Options struct {
+	// Rate plots the cumulative monotonic sums, like counters, as rates per second between consecutive points
+	Rate bool
+	// Title of the figure, defaults to the name of the metric
+	Title string
+}
+
+func computeOptions(def Options, opt ...Options) Options {
+	if len(opt) == 1 {
+		opts := opt[0]
+		if opts.Rate {
+			def.Rate = opts.Rate
+		}
+		if opts.Title != "" {
+			def.Title = opts.Title
+		}
+	}
+	return def
+}
+
+// FromMetric plots the data points of a gauge or a sum as lines, or those of a histogram as a heatmap.
+// Exponential histograms and summaries are not supported.
+func FromMetric(metric *metricspb.Metric, opt ...Options) (*grob.Fig, error) {
+	opts := computeOptions(Options{Title: metric.GetName()}, opt...)
+	switch data := metric.GetData().(type) {
+	case *metricspb.Metric_Gauge:
+		return linesFigure(metric, data.Gauge.GetDataPoints(), false, opts), nil
+	case *metricspb.Metric_Sum:
+		rate := opts.Rate && data.Sum.GetIsMonotonic() &&
+			data.Sum.GetAggregationTemporality() == metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE
+		return linesFigure(metric, data.Sum.GetDataPoints(), rate, opts), nil
+	case *metricspb.Metric_Histogram:
+		return histogramFigure(metric, data.Histogram, opts)
+	case nil:
+		return nil, fmt.Errorf("metric %s has no data", metric.GetName())
+	}
+	return nil, fmt.Errorf("metric %s of type %T is not supported", metric.GetName(), metric.GetData())
+}
+
+// linesFigure plots a line per attribute set, the rates between the points if rate is set
+func linesFigure(metric *metricspb.Metric, points []*metricspb.NumberDataPoint, rate bool, opts Options) *grob.Fig {
+	series := map[string][]*metricspb.NumberDataPoint{}
+	for _, point := range points {
+		name := attributesName(point.GetAttributes())
+		series[name] = append(series[name], point)
+	}
+	names := sortedNames(series)
+
+	traces := make(grob.Traces, 0, len(names))
+	for _, name := range names {
+		points := series[name]
+		sort.SliceStable(points, func(i, j int) bool { return points[i].GetTimeUnixNano() < points[j].GetTimeUnixNano() })
+		x := make([]time.Time, 0, len(points))
+		y := make([]interface{}, 0, len(points))
+		for i, point := range points {
+			value := numberValue(point)
+			if rate {
+				if i == 0 {
+					continue
+				}
+				previous := points[i-1]
+				seconds := float64(point.GetTimeUnixNano()-previous.GetTimeUnixNano()) / float64(time.Second)
+				delta := value - numberValue(previous)
+				if seconds <= 0 || delta < 0 {
+					// the counter was reset
+					x = append(x, unixNano(point.GetTimeUnixNano()))
+					y = append(y, nil)
+					continue
+				}
+				value = delta / seconds
+			}
+			x = append(x, unixNano(point.GetTimeUnixNano()))
+			y = append(y, value)
+		}
+		if name == "" {
+			name = metric.GetName()
+		}
+		traces = append(traces, &grob.Scatter{
+			Type:          grob.TraceTypeScatter,
+			Mode:          grob.ScatterModeLines,
+			Name:          name,
+			X:             x,
+			Y:             y,
+			Hovertemplate: "%{y}",
+		})
+	}
+
+	unit := metric.GetUnit()
+	if rate {
+		unit += "/s"
+	}
+	fig := express.TimeSeries(nil, nil, express.Options{Title: opts.Title, YTitle: unit})
+	fig.AddTraces(traces...)
+	return fig
+}
+
+// histogramFigure plots the counts of the buckets over time, summing the attribute sets
+func histogramFigure(metric *metricspb.Metric, histogram *metricspb.Histogram, opts Options) (*grob.Fig, error) {
+	cumulative := histogram.GetAggregationTemporality() == metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE
+	series := map[string][]*metricspb.HistogramDataPoint{}
+	var bounds []float64
+	for i, point := range histogram.GetDataPoints() {
+		if i == 0 {
+			bounds = point.GetExplicitBounds()
+		}
+		if !equalBounds(bounds, point.GetExplicitBounds()) || len(point.GetBucketCounts()) != len(bounds)+1 {
+			return nil, fmt.Errorf("histogram %s has points with different buckets", metric.GetName())
+		}
+		name := attributesName(point.GetAttributes())
+		series[name] = append(series[name], point)
+	}
+
+	// the counts of the buckets during the interval ending at every time
+	counts := map[uint64][]float64{}
+	for _, points := range series {
+		sort.SliceStable(points, func(i, j int) bool { return points[i].GetTimeUnixNano() < points[j].GetTimeUnixNano() })
+		for i, point := range points {
+			column, ok := counts[point.GetTimeUnixNano()]
+			if !ok {
+				column = make([]float64, len(bounds)+1)
+				counts[point.GetTimeUnixNano()] = column
+			}
+			var previous []uint64
+			if cumulative && i > 0 && points[i-1].GetStartTimeUnixNano() == point.GetStartTimeUnixNano() {
+				previous = points[i-1].GetBucketCounts()
+			}
+			for b, count := range point.GetBucketCounts() {
+				if previous != nil && previous[b] <= count {
+					count -= previous[b]
+				}
+				column[b] += float64(count)
+			}
+		}
+	}
+	times := make([]uint64, 0, len(counts))
+	for t := range counts {
+		times = append(times, t)
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i] < times[j] })
+
+	x := make([]time.Time, len(times))
+	z := make([][]float64, len(bounds)+1)
+	for b := range z {
+		z[b] = make([]float64, len(times))
+	}
+	for i, t := range times {
+		x[i] = unixNano(t)
+		for b, count := range counts[t] {
+			z[b][i] = count
+		}
+	}
+
+	fig := express.TimeSeries(nil, nil, express.Options{Title: opts.Title, YTitle: metric.GetUnit()})
+	fig.Layout.Hovermode = grob.LayoutHovermodeClosest
+	fig.AddTraces(&grob.Heatmap{
+		Type:          grob.TraceTypeHeatmap,
+		Name:          metric.GetName(),
+		X:             x,
+		Y:             bucketLabels(bounds),
+		Z:             z,
+		Hovertemplate: "%{y}<br>%{z} observations<extra></extra>",
+	})
+	return fig, nil
+}
+
+// bucketLabels names the buckets after their upper bound
+func bucketLabels(bounds []float64) []string {
+	labels := make([]string, 0, len(bounds)+1)
+	for _, bound := range bounds {
+		labels = append(labels, "≤ "+strconv.FormatFloat(bound, 'g', -1, 64))
+	}
+	if len(bounds) == 0 {
+		return append(labels, "all")
+	}
+	return append(labels, "> "+strconv.FormatFloat(bounds[len(bounds)-1], 'g', -1, 64))
+}
+
+func equalBounds(a, b []float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// attributesName names a series after its attributes, like method="GET", status="200", sorted by key
+func attributesName(attributes []*commonpb.KeyValue) string {
+	parts := make([]string, 0, len(attributes))
+	for _, attribute := range attributes {
+		parts = append(parts, attribute.GetKey()+"="+strconv.Quote(attributeValue(attribute.GetValue())))
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ", ")
+}
+
+// attributeValue formats the value of an attribute
+func attributeValue(value *commonpb.AnyValue) string {
+	switch value := value.GetValue().(type) {
+	case *commonpb.AnyValue_StringValue:
+		return value.StringValue
+	case *commonpb.AnyValue_BoolValue:
+		return strconv.FormatBool(value.BoolValue)
+	case *commonpb.AnyValue_IntValue:
+		return strconv.FormatInt(value.IntValue, 10)
+	case *commonpb.AnyValue_DoubleValue:
+		return strconv.FormatFloat(value.DoubleValue, 'g', -1, 64)
+	case nil:
+		return ""
+	}
+	return fmt.Sprint(value.GetValue())
+}
+
+// numberValue returns the value of a point as float64
+func numberValue(point *metricspb.NumberDataPoint) float64 {
+	if value, ok := point.GetValue().(*metricspb.NumberDataPoint_AsInt); ok {
+		return float64(value.AsInt)
+	}
+	return point.GetAsDouble()
+}
+
+func unixNano(t uint64) time.Time {
+	return time.Unix(0, int64(t)).UTC()
+}
+
+func sortedNames(series map[string][]*metricspb.NumberDataPoint) []string {
+	names := make([]string, 0, len(series))
+	for name := range series {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
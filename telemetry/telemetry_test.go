@@ -0,0 +1,161 @@
+package telemetry_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/telemetry"
+)
+
+var start = time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)
+
+// at returns the time in unix nanoseconds of the minute after start
+func at(minute int) uint64 {
+	return uint64(start.Add(time.Duration(minute) * time.Minute).UnixNano())
+}
+
+func minutes(ms ...int) []time.Time {
+	times := make([]time.Time, len(ms))
+	for i, m := range ms {
+		times[i] = start.Add(time.Duration(m) * time.Minute)
+	}
+	return times
+}
+
+func attribute(key, value string) []*commonpb.KeyValue {
+	return []*commonpb.KeyValue{{Key: key, Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}}}}
+}
+
+func number(minute int, value int64, attributes ...*commonpb.KeyValue) *metricspb.NumberDataPoint {
+	return &metricspb.NumberDataPoint{
+		Attributes:        attributes,
+		StartTimeUnixNano: at(0),
+		TimeUnixNano:      at(minute),
+		Value:             &metricspb.NumberDataPoint_AsInt{AsInt: value},
+	}
+}
+
+func histogramPoint(minute int, counts ...uint64) *metricspb.HistogramDataPoint {
+	return &metricspb.HistogramDataPoint{
+		StartTimeUnixNano: at(0),
+		TimeUnixNano:      at(minute),
+		BucketCounts:      counts,
+		ExplicitBounds:    []float64{0.1, 1},
+	}
+}
+
+var requests = &metricspb.Metric{
+	Name: "http.server.requests",
+	Unit: "{request}",
+	Data: &metricspb.Metric_Sum{Sum: &metricspb.Sum{
+		IsMonotonic:            true,
+		AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+		DataPoints: []*metricspb.NumberDataPoint{
+			number(2, 180, attribute("method", "GET")...),
+			number(1, 60, attribute("method", "GET")...),
+			number(1, 6, attribute("method", "POST")...),
+			number(3, 30, attribute("method", "GET")...),
+		},
+	}},
+}
+
+var _ = Describe("FromMetric", func() {
+
+	It("Should plot a line per attribute set", func() {
+		fig, err := telemetry.FromMetric(requests)
+		Expect(err).To(BeNil())
+		Expect(fig.Layout.Title.Text).To(Equal("http.server.requests"))
+		Expect(fig.Data).To(HaveLen(2))
+		get := fig.Data[0].(*grob.Scatter)
+		Expect(get.Name).To(Equal(`method="GET"`))
+		Expect(get.X).To(Equal(minutes(1, 2, 3)))
+		Expect(get.Y).To(Equal([]interface{}{60.0, 180.0, 30.0}))
+		Expect(fig.Data[1].(*grob.Scatter).Name).To(Equal(`method="POST"`))
+	})
+
+	It("Should plot the rates of counters", func() {
+		fig, err := telemetry.FromMetric(requests, telemetry.Options{Rate: true})
+		Expect(err).To(BeNil())
+		get := fig.Data[0].(*grob.Scatter)
+		Expect(get.X).To(Equal(minutes(2, 3)))
+		Expect(get.Y).To(Equal([]interface{}{2.0, nil}))
+		Expect(fig.Data[1].(*grob.Scatter).Y).To(BeEmpty())
+	})
+
+	It("Should name the series without attributes after the metric", func() {
+		fig, err := telemetry.FromMetric(&metricspb.Metric{
+			Name: "process.memory",
+			Data: &metricspb.Metric_Gauge{Gauge: &metricspb.Gauge{DataPoints: []*metricspb.NumberDataPoint{number(1, 1024)}}},
+		})
+		Expect(err).To(BeNil())
+		Expect(fig.Data[0].(*grob.Scatter).Name).To(Equal("process.memory"))
+	})
+
+	It("Should plot the buckets of histograms over time", func() {
+		fig, err := telemetry.FromMetric(&metricspb.Metric{
+			Name: "http.server.duration",
+			Unit: "s",
+			Data: &metricspb.Metric_Histogram{Histogram: &metricspb.Histogram{
+				AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+				DataPoints: []*metricspb.HistogramDataPoint{
+					histogramPoint(1, 5, 2, 0),
+					histogramPoint(2, 9, 3, 1),
+				},
+			}},
+		})
+		Expect(err).To(BeNil())
+		heatmap := fig.Data[0].(*grob.Heatmap)
+		Expect(heatmap.X).To(Equal(minutes(1, 2)))
+		Expect(heatmap.Y).To(Equal([]string{"≤ 0.1", "≤ 1", "> 1"}))
+		Expect(heatmap.Z).To(Equal([][]float64{{5, 4}, {2, 1}, {0, 1}}))
+	})
+
+	It("Should fail with different buckets and unsupported types", func() {
+		point := histogramPoint(2, 1, 2)
+		point.ExplicitBounds = []float64{1}
+		_, err := telemetry.FromMetric(&metricspb.Metric{
+			Name: "latency",
+			Data: &metricspb.Metric_Histogram{Histogram: &metricspb.Histogram{
+				DataPoints: []*metricspb.HistogramDataPoint{histogramPoint(1, 1, 2, 3), point},
+			}},
+		})
+		Expect(err).To(MatchError("histogram latency has points with different buckets"))
+
+		_, err = telemetry.FromMetric(&metricspb.Metric{Name: "latency", Data: &metricspb.Metric_Summary{Summary: &metricspb.Summary{}}})
+		Expect(err).To(MatchError("metric latency of type *v1.Metric_Summary is not supported"))
+	})
+})
+
+var _ = Describe("Recorder", func() {
+
+	export := func(metrics ...*metricspb.Metric) *metricspb.ResourceMetrics {
+		return &metricspb.ResourceMetrics{ScopeMetrics: []*metricspb.ScopeMetrics{{Metrics: metrics}}}
+	}
+	gauge := func(points ...*metricspb.NumberDataPoint) *metricspb.Metric {
+		return &metricspb.Metric{
+			Name: "queue.length",
+			Data: &metricspb.Metric_Gauge{Gauge: &metricspb.Gauge{DataPoints: points}},
+		}
+	}
+
+	It("Should keep the points of the retention period", func() {
+		recorder := telemetry.NewRecorder(2 * time.Minute)
+		recorder.Record(export(gauge(number(1, 4))))
+		recorder.Record(export(gauge(number(2, 5)), requests))
+		recorder.Record(export(gauge(number(4, 7))))
+		Expect(recorder.Names()).To(Equal([]string{"http.server.requests", "queue.length"}))
+
+		fig, err := recorder.Figure("queue.length")
+		Expect(err).To(BeNil())
+		Expect(fig.Data[0].(*grob.Scatter).X).To(Equal(minutes(2, 4)))
+		Expect(fig.Data[0].(*grob.Scatter).Y).To(Equal([]interface{}{5.0, 7.0}))
+
+		_, err = recorder.Figure("cpu")
+		Expect(err).To(MatchError("metric cpu not found"))
+	})
+})
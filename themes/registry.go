@@ -0,0 +1,113 @@
+package themes
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]*grob.Template{
+		"plotly":      Plotly,
+		"plotly_dark": Dark,
+		"ggplot2":     GGPlot2,
+		"seaborn":     Seaborn,
+		"print":       Print,
+	}
+)
+
+// Register adds a theme to the registry, such as a corporate theme, so it can be applied by name.
+// A theme already registered with the same name is replaced.
+func Register(name string, theme *grob.Template) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry[name] = theme
+}
+
+// Get returns the theme registered with the given name
+func Get(name string) (*grob.Template, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	theme, ok := registry[name]
+	return theme, ok
+}
+
+// Names returns the names of the registered themes, sorted
+func Names() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Apply styles the figure with the registered theme. The figure values take precedence over the theme,
+// and if the figure already has a template, its values take precedence over the theme too.
+// The figure gets a copy of the theme, so it can be modified without changing the registered one.
+func Apply(fig *grob.Fig, name string) error {
+	theme, ok := Get(name)
+	if !ok {
+		return fmt.Errorf("theme %s is not registered", name)
+	}
+
+	merged, err := toMap(theme)
+	if err != nil {
+		return err
+	}
+	if fig.Layout == nil {
+		fig.Layout = &grob.Layout{}
+	}
+	if fig.Layout.Template != nil {
+		current, err := toMap(fig.Layout.Template)
+		if err != nil {
+			return err
+		}
+		merge(merged, current)
+	}
+
+	mergedBytes, err := json.Marshal(merged)
+	if err != nil {
+		return err
+	}
+	template := &grob.Template{}
+	err = json.Unmarshal(mergedBytes, template)
+	if err != nil {
+		return fmt.Errorf("cannot apply theme %s, %w", name, err)
+	}
+	fig.Layout.Template = template
+	return nil
+}
+
+// toMap converts the value to its JSON representation as a map
+func toMap(v interface{}) (map[string]interface{}, error) {
+	vBytes, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal template, %w", err)
+	}
+	m := map[string]interface{}{}
+	err = json.Unmarshal(vBytes, &m)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// merge copies the values of src into dst, merging nested objects
+func merge(dst, src map[string]interface{}) {
+	for key, value := range src {
+		srcObject, srcOk := value.(map[string]interface{})
+		dstObject, dstOk := dst[key].(map[string]interface{})
+		if srcOk && dstOk {
+			merge(dstObject, srcObject)
+			continue
+		}
+		dst[key] = value
+	}
+}
@@ -0,0 +1,69 @@
+package themes_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/themes"
+)
+
+var _ = Describe("Registry", func() {
+
+	It("Should contain the built-in themes", func() {
+		Expect(themes.Names()).To(ContainElements("plotly", "plotly_dark", "ggplot2", "seaborn"))
+		theme, ok := themes.Get("plotly_dark")
+		Expect(ok).To(BeTrue())
+		Expect(theme).To(Equal(themes.Dark))
+	})
+
+	It("Should apply registered themes", func() {
+		corporate := &grob.Template{
+			Layout: &grob.Layout{
+				Font: &grob.LayoutFont{
+					Family: "Corporate Sans",
+				},
+			},
+		}
+		themes.Register("corporate", corporate)
+
+		fig := &grob.Fig{}
+		Expect(themes.Apply(fig, "corporate")).To(Succeed())
+		template := fig.Layout.Template.(*grob.Template)
+		Expect(template.Layout.Font.Family).To(Equal("Corporate Sans"))
+
+		// the figure has a copy
+		template.Layout.Font.Family = "Other"
+		Expect(corporate.Layout.Font.Family).To(Equal("Corporate Sans"))
+	})
+
+	It("Should not overwrite the template of the figure", func() {
+		fig := &grob.Fig{
+			Layout: &grob.Layout{
+				Template: &grob.Template{
+					Layout: &grob.Layout{
+						PlotBgcolor: "black",
+						Font: &grob.LayoutFont{
+							Size: 20,
+						},
+					},
+					Data: map[grob.TraceType]grob.Traces{
+						grob.TraceTypeBar: {&grob.Bar{Opacity: 0.5}},
+					},
+				},
+			},
+		}
+		Expect(themes.Apply(fig, "seaborn")).To(Succeed())
+
+		template := fig.Layout.Template.(*grob.Template)
+		Expect(template.Layout.PlotBgcolor).To(Equal("black"))
+		Expect(template.Layout.PaperBgcolor).To(Equal("white"))
+		Expect(template.Layout.Font.Size).To(Equal(20.0))
+		Expect(template.Layout.Font.Color).To(Equal("rgb(36,36,36)"))
+		Expect(template.Data[grob.TraceTypeBar]).To(HaveLen(1))
+	})
+
+	It("Should fail with unknown themes", func() {
+		Expect(themes.Apply(&grob.Fig{}, "unknown")).NotTo(Succeed())
+	})
+})
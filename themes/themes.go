@@ -0,0 +1,164 @@
+// Package themes contains ready to use templates to style figures, equivalent to the templates of plotly.py.
+//
+// Set them as the layout template, give them to offline.Options.Theme to style figures when they are rendered,
+// or apply them by name with Apply. Custom themes can be added with Register.
+package themes
+
+import (
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// PlotlyColorway is the default sequence of trace colors of plotly
+var PlotlyColorway = grob.ColorList{"#636efa", "#EF553B", "#00cc96", "#ab63fa", "#FFA15A", "#19d3f3", "#FF6692", "#B6E880", "#FF97FF", "#FECB52"}
+
+// Plotly is the default look of plotly.py, with a light blue plot area and white grid, equivalent to plotly
+var Plotly = &grob.Template{
+	Layout: &grob.Layout{
+		PaperBgcolor: "white",
+		PlotBgcolor:  "#E5ECF6",
+		Font: &grob.LayoutFont{
+			Color: "#2a3f5f",
+		},
+		Colorway: PlotlyColorway,
+		Hoverlabel: &grob.LayoutHoverlabel{
+			Align: grob.LayoutHoverlabelAlignLeft,
+		},
+		Title: &grob.LayoutTitle{
+			X: 0.05,
+		},
+		Xaxis: &grob.LayoutXaxis{
+			Gridcolor:     "white",
+			Linecolor:     "white",
+			Zerolinecolor: "white",
+			Zerolinewidth: 2,
+			Automargin:    grob.True,
+		},
+		Yaxis: &grob.LayoutYaxis{
+			Gridcolor:     "white",
+			Linecolor:     "white",
+			Zerolinecolor: "white",
+			Zerolinewidth: 2,
+			Automargin:    grob.True,
+		},
+	},
+}
+
+// Dark is a dark background theme, equivalent to plotly_dark
+var Dark = &grob.Template{
+	Layout: &grob.Layout{
+		PaperBgcolor: "rgb(17,17,17)",
+		PlotBgcolor:  "rgb(17,17,17)",
+		Font: &grob.LayoutFont{
+			Color: "#f2f5fa",
+		},
+		Colorway: PlotlyColorway,
+		Hoverlabel: &grob.LayoutHoverlabel{
+			Align: grob.LayoutHoverlabelAlignLeft,
+		},
+		Xaxis: &grob.LayoutXaxis{
+			Gridcolor:     "#283442",
+			Linecolor:     "#506784",
+			Zerolinecolor: "#283442",
+			Zerolinewidth: 2,
+			Automargin:    grob.True,
+		},
+		Yaxis: &grob.LayoutYaxis{
+			Gridcolor:     "#283442",
+			Linecolor:     "#506784",
+			Zerolinecolor: "#283442",
+			Zerolinewidth: 2,
+			Automargin:    grob.True,
+		},
+	},
+}
+
+// GGPlot2 mimics the default look of the ggplot2 R package, with grey plot area and white grid
+var GGPlot2 = &grob.Template{
+	Layout: &grob.Layout{
+		PaperBgcolor: "white",
+		PlotBgcolor:  "rgb(237,237,237)",
+		Font: &grob.LayoutFont{
+			Color: "rgb(51,51,51)",
+		},
+		Colorway: grob.ColorList{"#F8766D", "#A3A500", "#00BF7D", "#00B0F6", "#E76BF3"},
+		Xaxis: &grob.LayoutXaxis{
+			Gridcolor:  "white",
+			Linecolor:  "white",
+			Showgrid:   grob.True,
+			Ticks:      grob.LayoutXaxisTicksOutside,
+			Tickcolor:  "rgb(51,51,51)",
+			Zeroline:   grob.False,
+			Automargin: grob.True,
+		},
+		Yaxis: &grob.LayoutYaxis{
+			Gridcolor:  "white",
+			Linecolor:  "white",
+			Showgrid:   grob.True,
+			Ticks:      grob.LayoutYaxisTicksOutside,
+			Tickcolor:  "rgb(51,51,51)",
+			Zeroline:   grob.False,
+			Automargin: grob.True,
+		},
+	},
+}
+
+// Seaborn mimics the darkgrid style of the seaborn python package, equivalent to seaborn
+var Seaborn = &grob.Template{
+	Layout: &grob.Layout{
+		PaperBgcolor: "white",
+		PlotBgcolor:  "rgb(234,234,242)",
+		Font: &grob.LayoutFont{
+			Color: "rgb(36,36,36)",
+		},
+		Colorway: grob.ColorList{"rgb(76,114,176)", "rgb(221,132,82)", "rgb(85,168,104)", "rgb(196,78,82)", "rgb(129,114,179)", "rgb(147,120,96)", "rgb(218,139,195)", "rgb(140,140,140)", "rgb(204,185,116)", "rgb(100,181,205)"},
+		Xaxis: &grob.LayoutXaxis{
+			Gridcolor:  "white",
+			Linecolor:  "white",
+			Showgrid:   grob.True,
+			Zeroline:   grob.False,
+			Automargin: grob.True,
+		},
+		Yaxis: &grob.LayoutYaxis{
+			Gridcolor:  "white",
+			Linecolor:  "white",
+			Showgrid:   grob.True,
+			Zeroline:   grob.False,
+			Automargin: grob.True,
+		},
+	},
+}
+
+// Print is a minimal black on white theme without grid, suitable for printed reports
+var Print = &grob.Template{
+	Layout: &grob.Layout{
+		PaperBgcolor: "white",
+		PlotBgcolor:  "white",
+		Font: &grob.LayoutFont{
+			Color:  "black",
+			Family: "Georgia, 'Times New Roman', serif",
+		},
+		Colorway: grob.ColorList{"#000000", "#555555", "#999999", "#1f77b4", "#d62728", "#2ca02c"},
+		Xaxis: &grob.LayoutXaxis{
+			Showgrid:   grob.False,
+			Showline:   grob.True,
+			Linecolor:  "black",
+			Linewidth:  1,
+			Ticks:      grob.LayoutXaxisTicksOutside,
+			Tickcolor:  "black",
+			Mirror:     grob.LayoutXaxisMirrorFalse,
+			Zeroline:   grob.False,
+			Automargin: grob.True,
+		},
+		Yaxis: &grob.LayoutYaxis{
+			Showgrid:   grob.False,
+			Showline:   grob.True,
+			Linecolor:  "black",
+			Linewidth:  1,
+			Ticks:      grob.LayoutYaxisTicksOutside,
+			Tickcolor:  "black",
+			Mirror:     grob.LayoutYaxisMirrorFalse,
+			Zeroline:   grob.False,
+			Automargin: grob.True,
+		},
+	},
+}
@@ -0,0 +1,166 @@
+package threed
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"strconv"
+	"strings"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/themes"
+)
+
+// stlHeader is the size of the header of binary STL files, followed by the number of triangles
+const stlHeader = 80
+
+// stlTriangle is the size of a triangle in binary STL files: the normal, the three vertices and the attributes
+const stlTriangle = 50
+
+// MeshFromSTL reads the triangles of an STL model, ASCII or binary, and plots them as a mesh3d trace.
+// The vertices shared by triangles are merged and the faces are flat shaded, like in CAD software.
+func MeshFromSTL(r io.Reader, opt ...Options) (*grob.Fig, error) {
+	opts := computeOptions(Options{
+		AspectMode: grob.LayoutSceneAspectmodeData,
+		Color:      themes.PlotlyColorway[0],
+	}, opt...)
+
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read stl, %w", err)
+	}
+	var triangles [][3][3]float64
+	if isBinarySTL(data) {
+		triangles = binarySTL(data)
+	} else {
+		triangles, err = asciiSTL(data)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(triangles) == 0 {
+		return nil, fmt.Errorf("cannot read stl, there are no triangles")
+	}
+
+	mesh := newMesh()
+	for _, triangle := range triangles {
+		mesh.add(triangle)
+	}
+	fig := &grob.Fig{}
+	fig.AddTraces(&grob.Mesh3d{
+		X:           mesh.x,
+		Y:           mesh.y,
+		Z:           mesh.z,
+		I:           mesh.i,
+		J:           mesh.j,
+		K:           mesh.k,
+		Color:       opts.Color,
+		Flatshading: grob.True,
+	})
+	fig.Layout = layout(opts)
+	return fig, nil
+}
+
+// isBinarySTL tells if the data is a binary STL file. ASCII files start with solid, but so do some binary files,
+// the size given by the number of triangles tells them apart
+func isBinarySTL(data []byte) bool {
+	if len(data) < stlHeader+4 {
+		return false
+	}
+	count := binary.LittleEndian.Uint32(data[stlHeader:])
+	return uint64(len(data)) == stlHeader+4+uint64(count)*stlTriangle
+}
+
+func binarySTL(data []byte) [][3][3]float64 {
+	count := int(binary.LittleEndian.Uint32(data[stlHeader:]))
+	triangles := make([][3][3]float64, count)
+	for t := range triangles {
+		// the normal is skipped, it can be computed from the vertices
+		offset := stlHeader + 4 + t*stlTriangle + 12
+		for v := 0; v < 3; v++ {
+			for c := 0; c < 3; c++ {
+				bits := binary.LittleEndian.Uint32(data[offset+(3*v+c)*4:])
+				triangles[t][v][c] = float64(math.Float32frombits(bits))
+			}
+		}
+	}
+	return triangles
+}
+
+func asciiSTL(data []byte) ([][3][3]float64, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	triangles := [][3][3]float64{}
+	var triangle [3][3]float64
+	vertices := 0
+	line := 0
+	for scanner.Scan() {
+		line++
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		switch strings.ToLower(fields[0]) {
+		case "solid", "facet", "outer", "endsolid":
+		case "vertex":
+			if len(fields) != 4 || vertices == 3 {
+				return nil, fmt.Errorf("cannot read stl, invalid vertex at line %d", line)
+			}
+			for c := 0; c < 3; c++ {
+				value, err := strconv.ParseFloat(fields[c+1], 64)
+				if err != nil {
+					return nil, fmt.Errorf("cannot read stl, invalid vertex at line %d, %w", line, err)
+				}
+				triangle[vertices][c] = value
+			}
+			vertices++
+		case "endloop":
+			if vertices != 3 {
+				return nil, fmt.Errorf("cannot read stl, facet with %d vertices at line %d", vertices, line)
+			}
+		case "endfacet":
+			triangles = append(triangles, triangle)
+			vertices = 0
+		default:
+			return nil, fmt.Errorf("cannot read stl, unknown keyword %s at line %d", fields[0], line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("cannot read stl, %w", err)
+	}
+	return triangles, nil
+}
+
+// mesh are the vertices and the faces of a mesh3d trace
+type mesh struct {
+	x, y, z []float64
+	i, j, k []int
+	// index of the vertices already added
+	index map[[3]float64]int
+}
+
+func newMesh() *mesh {
+	return &mesh{index: map[[3]float64]int{}}
+}
+
+// add adds the triangle, the vertices already in the mesh are reused
+func (m *mesh) add(triangle [3][3]float64) {
+	var indexes [3]int
+	for v, vertex := range triangle {
+		index, ok := m.index[vertex]
+		if !ok {
+			index = len(m.x)
+			m.index[vertex] = index
+			m.x = append(m.x, vertex[0])
+			m.y = append(m.y, vertex[1])
+			m.z = append(m.z, vertex[2])
+		}
+		indexes[v] = index
+	}
+	m.i = append(m.i, indexes[0])
+	m.j = append(m.j, indexes[1])
+	m.k = append(m.k, indexes[2])
+}
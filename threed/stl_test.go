@@ -0,0 +1,103 @@
+package threed_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"strconv"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/threed"
+)
+
+// tetrahedron are the faces of a tetrahedron, 4 vertices shared by the faces
+var tetrahedron = [][3][3]float32{
+	{{0, 0, 0}, {1, 0, 0}, {0, 1, 0}},
+	{{0, 0, 0}, {0, 0, 1}, {1, 0, 0}},
+	{{0, 0, 0}, {0, 1, 0}, {0, 0, 1}},
+	{{1, 0, 0}, {0, 0, 1}, {0, 1, 0}},
+}
+
+// binarySTL writes the faces as a binary STL file whose header starts with solid, like many exporters do
+func binarySTL(faces [][3][3]float32) []byte {
+	buf := &bytes.Buffer{}
+	header := make([]byte, 80)
+	copy(header, "solid exported")
+	buf.Write(header)
+	binary.Write(buf, binary.LittleEndian, uint32(len(faces)))
+	for _, face := range faces {
+		binary.Write(buf, binary.LittleEndian, [3]float32{})
+		binary.Write(buf, binary.LittleEndian, face)
+		binary.Write(buf, binary.LittleEndian, uint16(0))
+	}
+	return buf.Bytes()
+}
+
+// asciiSTL writes the faces as an ASCII STL file
+func asciiSTL(faces [][3][3]float32) string {
+	b := &strings.Builder{}
+	b.WriteString("solid tetrahedron\n")
+	for _, face := range faces {
+		b.WriteString("  facet normal 0 0 0\n    outer loop\n")
+		for _, vertex := range face {
+			b.WriteString("      vertex")
+			for _, value := range vertex {
+				b.WriteString(" " + strconv.FormatFloat(float64(value), 'e', -1, 32))
+			}
+			b.WriteString("\n")
+		}
+		b.WriteString("    endloop\n  endfacet\n")
+	}
+	b.WriteString("endsolid tetrahedron\n")
+	return b.String()
+}
+
+var _ = Describe("STL", func() {
+
+	expectTetrahedron := func(fig *grob.Fig) {
+		mesh := fig.Data[0].(*grob.Mesh3d)
+		Expect(mesh.Type).To(Equal(grob.TraceTypeMesh3d))
+		Expect(mesh.X).To(Equal([]float64{0, 1, 0, 0}))
+		Expect(mesh.Y).To(Equal([]float64{0, 0, 1, 0}))
+		Expect(mesh.Z).To(Equal([]float64{0, 0, 0, 1}))
+		Expect(mesh.I).To(Equal([]int{0, 0, 0, 1}))
+		Expect(mesh.J).To(Equal([]int{1, 3, 2, 3}))
+		Expect(mesh.K).To(Equal([]int{2, 1, 3, 2}))
+		Expect(mesh.Flatshading).To(Equal(grob.True))
+		Expect(fig.Layout.Scene.Aspectmode).To(Equal(grob.LayoutSceneAspectmodeData))
+	}
+
+	It("Should read binary files", func() {
+		fig, err := threed.MeshFromSTL(bytes.NewReader(binarySTL(tetrahedron)))
+		Expect(err).To(BeNil())
+		expectTetrahedron(fig)
+	})
+
+	It("Should read ASCII files", func() {
+		fig, err := threed.MeshFromSTL(strings.NewReader(asciiSTL(tetrahedron)), threed.Options{Color: "gray", Title: "Part"})
+		Expect(err).To(BeNil())
+		expectTetrahedron(fig)
+		Expect(fig.Data[0].(*grob.Mesh3d).Color).To(Equal("gray"))
+		Expect(fig.Layout.Title.Text).To(Equal("Part"))
+	})
+
+	It("Should keep the precision of binary files", func() {
+		fig, err := threed.MeshFromSTL(bytes.NewReader(binarySTL([][3][3]float32{{{0.1, 0, 0}, {1, 0, 0}, {0, 1, 0}}})))
+		Expect(err).To(BeNil())
+		Expect(fig.Data[0].(*grob.Mesh3d).X.([]float64)[0]).To(Equal(float64(float32(0.1))))
+		Expect(math.Abs(fig.Data[0].(*grob.Mesh3d).X.([]float64)[0] - 0.1)).To(BeNumerically("<", 1e-7))
+	})
+
+	It("Should fail with invalid files", func() {
+		_, err := threed.MeshFromSTL(strings.NewReader("solid empty\nendsolid empty\n"))
+		Expect(err).To(MatchError("cannot read stl, there are no triangles"))
+		_, err = threed.MeshFromSTL(strings.NewReader("solid broken\nfacet normal 0 0 0\nouter loop\nvertex 0 0\n"))
+		Expect(err).To(MatchError("cannot read stl, invalid vertex at line 4"))
+		_, err = threed.MeshFromSTL(strings.NewReader("not an stl file"))
+		Expect(err).NotTo(BeNil())
+	})
+})
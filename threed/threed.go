@@ -0,0 +1,156 @@
+// Package threed builds 3D figures from Go functions, STL models and vector fields, with scene axes that suit them.
+//
+// SurfaceFromFunc samples a function of x and y on a grid and plots it as a surface trace,
+// MeshFromSTL reads the triangles of an ASCII or binary STL file as a mesh3d trace,
+// Cones and Streamtubes plot samples of a vector field.
+//
+//	fig, err := threed.SurfaceFromFunc(func(x, y float64) float64 {
+//		return math.Sin(x) * math.Cos(y)
+//	}, threed.Range{Min: -math.Pi, Max: math.Pi}, threed.Range{Min: -math.Pi, Max: math.Pi}, 50)
+package threed
+
+import (
+	"fmt"
+	"math"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// Range is the interval of values from Min to Max
+type Range struct {
+	Min float64
+	Max float64
+}
+
+// Options configure the trace and the scene of the figure
+type Options struct {
+	// Title of the figure
+	Title string
+	// XTitle, YTitle and ZTitle are the titles of the axes of the scene, they default to x, y and z
+	XTitle string
+	YTitle string
+	ZTitle string
+	// AspectMode sets the proportions of the axes. It defaults to cube for surfaces, whose z is usually not in the unit of x and y,
+	// and to data for meshes, drawn with their real proportions. It defaults to manual if AspectRatio is given
+	AspectMode grob.LayoutSceneAspectmode
+	// AspectRatio is the length of the axes with the manual aspect mode
+	AspectRatio *grob.LayoutSceneAspectratio
+	// Colorscale of the surface, defaults to the colorscale of plotly
+	Colorscale grob.ColorScale
+	// Color of the mesh, defaults to the first color of the plotly colorway
+	Color grob.Color
+	// Camera is the point of view of the scene, it defaults to DefaultVectorCamera for vector fields and to the view of plotly otherwise
+	Camera *grob.LayoutSceneCamera
+	// SizeRef scales the cones and the streamtubes, it defaults to a size computed from the spacing of the samples for cones,
+	// and to the size of plotly for streamtubes
+	SizeRef float64
+}
+
+func computeOptions(def Options, opt ...Options) Options {
+	if len(opt) == 1 {
+		opts := opt[0]
+		if opts.Title != "" {
+			def.Title = opts.Title
+		}
+		if opts.XTitle != "" {
+			def.XTitle = opts.XTitle
+		}
+		if opts.YTitle != "" {
+			def.YTitle = opts.YTitle
+		}
+		if opts.ZTitle != "" {
+			def.ZTitle = opts.ZTitle
+		}
+		if opts.AspectRatio != nil {
+			def.AspectRatio = opts.AspectRatio
+			def.AspectMode = grob.LayoutSceneAspectmodeManual
+		}
+		if opts.AspectMode != "" {
+			def.AspectMode = opts.AspectMode
+		}
+		if opts.Colorscale != nil {
+			def.Colorscale = opts.Colorscale
+		}
+		if opts.Color != nil {
+			def.Color = opts.Color
+		}
+		if opts.Camera != nil {
+			def.Camera = opts.Camera
+		}
+		if opts.SizeRef != 0 {
+			def.SizeRef = opts.SizeRef
+		}
+	}
+	return def
+}
+
+// SurfaceFromFunc samples f on a grid of n by n points that spans the x and y ranges, and plots the values as a surface.
+// The values that are NaN or infinite, like those outside of the domain of f, are left blank.
+func SurfaceFromFunc(f func(x, y float64) float64, xr, yr Range, n int, opt ...Options) (*grob.Fig, error) {
+	opts := computeOptions(Options{
+		AspectMode: grob.LayoutSceneAspectmodeCube,
+	}, opt...)
+	if n < 2 {
+		return nil, fmt.Errorf("cannot sample the surface with %d points, at least 2 are needed", n)
+	}
+	for _, r := range []Range{xr, yr} {
+		if math.IsNaN(r.Min) || math.IsNaN(r.Max) || math.IsInf(r.Min, 0) || math.IsInf(r.Max, 0) || r.Min >= r.Max {
+			return nil, fmt.Errorf("range from %g to %g is empty or not finite", r.Min, r.Max)
+		}
+	}
+
+	x, y := sample(xr, n), sample(yr, n)
+	// the rows of z are the values along y
+	z := grob.NewMatrix(n, n)
+	for i := range y {
+		for j := range x {
+			z.Set(i, j, f(x[j], y[i]))
+		}
+	}
+
+	fig := &grob.Fig{}
+	fig.AddTraces(&grob.Surface{
+		X:          x,
+		Y:          y,
+		Z:          z,
+		Colorscale: opts.Colorscale,
+	})
+	fig.Layout = layout(opts)
+	return fig, nil
+}
+
+// sample returns n values evenly spaced from the min to the max of the range
+func sample(r Range, n int) []float64 {
+	values := make([]float64, n)
+	step := (r.Max - r.Min) / float64(n-1)
+	for i := range values {
+		values[i] = r.Min + step*float64(i)
+	}
+	// no rounding error at the end of the range
+	values[n-1] = r.Max
+	return values
+}
+
+// layout returns the layout with the scene of the options
+func layout(opts Options) *grob.Layout {
+	title := func(title, def string) string {
+		if title == "" {
+			return def
+		}
+		return title
+	}
+	layout := &grob.Layout{
+		Scene: &grob.LayoutScene{
+			Aspectmode:  opts.AspectMode,
+			Aspectratio: opts.AspectRatio,
+			Camera:      opts.Camera,
+			Xaxis:       &grob.LayoutSceneXaxis{Title: &grob.LayoutSceneXaxisTitle{Text: title(opts.XTitle, "x")}},
+			Yaxis:       &grob.LayoutSceneYaxis{Title: &grob.LayoutSceneYaxisTitle{Text: title(opts.YTitle, "y")}},
+			Zaxis:       &grob.LayoutSceneZaxis{Title: &grob.LayoutSceneZaxisTitle{Text: title(opts.ZTitle, "z")}},
+		},
+	}
+	if opts.Title != "" {
+		layout.Title = &grob.LayoutTitle{Text: opts.Title}
+	}
+	return layout
+}
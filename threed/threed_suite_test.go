@@ -0,0 +1,13 @@
+package threed_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestThreed(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Threed Suite")
+}
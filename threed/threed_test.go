@@ -0,0 +1,63 @@
+package threed_test
+
+import (
+	"math"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/threed"
+)
+
+var _ = Describe("Surface", func() {
+
+	It("Should sample the function on a grid", func() {
+		fig, err := threed.SurfaceFromFunc(func(x, y float64) float64 {
+			return x + 10*y
+		}, threed.Range{Min: 0, Max: 2}, threed.Range{Min: -1, Max: 1}, 3, threed.Options{ZTitle: "height"})
+		Expect(err).To(BeNil())
+
+		surface := fig.Data[0].(*grob.Surface)
+		Expect(surface.Type).To(Equal(grob.TraceTypeSurface))
+		Expect(surface.X).To(Equal([]float64{0, 1, 2}))
+		Expect(surface.Y).To(Equal([]float64{-1, 0, 1}))
+		z := surface.Z.(*grob.Matrix)
+		Expect(z.At(0, 2)).To(Equal(-8.0))
+		Expect(z.At(2, 0)).To(Equal(10.0))
+
+		scene := fig.Layout.Scene
+		Expect(scene.Aspectmode).To(Equal(grob.LayoutSceneAspectmodeCube))
+		Expect(scene.Xaxis.Title.Text).To(Equal("x"))
+		Expect(scene.Zaxis.Title.Text).To(Equal("height"))
+	})
+
+	It("Should leave the values out of the domain blank", func() {
+		fig, err := threed.SurfaceFromFunc(func(x, y float64) float64 {
+			return math.Log(x * y)
+		}, threed.Range{Min: -1, Max: 1}, threed.Range{Min: 1, Max: 2}, 2)
+		Expect(err).To(BeNil())
+		data, err := fig.ToPlotlyJSON()
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(ContainSubstring(`"z":[[null,0],[null,0.6931471805599453]]`))
+	})
+
+	It("Should use the manual aspect mode with an aspect ratio", func() {
+		ratio := &grob.LayoutSceneAspectratio{X: 1, Y: 1, Z: 0.5}
+		fig, err := threed.SurfaceFromFunc(func(x, y float64) float64 { return 0 },
+			threed.Range{Min: 0, Max: 1}, threed.Range{Min: 0, Max: 1}, 2, threed.Options{AspectRatio: ratio})
+		Expect(err).To(BeNil())
+		Expect(fig.Layout.Scene.Aspectmode).To(Equal(grob.LayoutSceneAspectmodeManual))
+		Expect(fig.Layout.Scene.Aspectratio).To(Equal(ratio))
+	})
+
+	It("Should fail with invalid grids", func() {
+		f := func(x, y float64) float64 { return 0 }
+		_, err := threed.SurfaceFromFunc(f, threed.Range{Min: 0, Max: 1}, threed.Range{Min: 0, Max: 1}, 1)
+		Expect(err).NotTo(BeNil())
+		_, err = threed.SurfaceFromFunc(f, threed.Range{Min: 1, Max: 1}, threed.Range{Min: 0, Max: 1}, 10)
+		Expect(err).NotTo(BeNil())
+		_, err = threed.SurfaceFromFunc(f, threed.Range{Min: 0, Max: 1}, threed.Range{Min: 0, Max: math.Inf(1)}, 10)
+		Expect(err).NotTo(BeNil())
+	})
+})
@@ -0,0 +1,174 @@
+package threed
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// DefaultVectorCamera looks at vector fields from above one of their corners, so the three directions of the vectors can be told apart
+var DefaultVectorCamera = &grob.LayoutSceneCamera{
+	Eye: &grob.LayoutSceneCameraEye{X: 1.6, Y: 1.6, Z: 1.1},
+	Up:  &grob.LayoutSceneCameraUp{Z: 1},
+}
+
+// VectorField are samples of a vector field, such as a flow or an electric field: the vector (U, V, W) at the position (X, Y, Z).
+// All the slices have the same length
+type VectorField struct {
+	X, Y, Z []float64
+	U, V, W []float64
+}
+
+// validate checks that the field has samples and that all the slices have the same length
+func (f VectorField) validate() error {
+	n := len(f.X)
+	if n == 0 {
+		return fmt.Errorf("the vector field has no samples")
+	}
+	for name, values := range map[string][]float64{"y": f.Y, "z": f.Z, "u": f.U, "v": f.V, "w": f.W} {
+		if len(values) != n {
+			return fmt.Errorf("the vector field has %d x but %d %s", n, len(values), name)
+		}
+	}
+	return nil
+}
+
+// Cones plots an arrow shaped cone per sample of the field, colored and sized by the norm of its vector.
+// The size of the cones is computed from the spacing of the samples, so the longest cone is about as long as the distance
+// between neighbour samples even if some samples are much closer, unless the SizeRef option is given.
+func Cones(field VectorField, opt ...Options) (*grob.Fig, error) {
+	opts := computeOptions(Options{
+		AspectMode: grob.LayoutSceneAspectmodeData,
+		Camera:     DefaultVectorCamera,
+	}, opt...)
+	err := field.validate()
+	if err != nil {
+		return nil, err
+	}
+	sizeref := opts.SizeRef
+	if sizeref == 0 {
+		sizeref = coneSizeref(field)
+	}
+
+	fig := &grob.Fig{}
+	fig.AddTraces(&grob.Cone{
+		X:             field.X,
+		Y:             field.Y,
+		Z:             field.Z,
+		U:             field.U,
+		V:             field.V,
+		W:             field.W,
+		Anchor:        grob.ConeAnchorTail,
+		Sizemode:      grob.ConeSizemodeScaled,
+		Sizeref:       sizeref,
+		Colorscale:    opts.Colorscale,
+		Hovertemplate: "(%{x}, %{y}, %{z})<br>norm=%{norm}<extra></extra>",
+	})
+	fig.Layout = layout(opts)
+	return fig, nil
+}
+
+// Streamtubes plots tubes along the streamlines of the field, whose diameter shows the divergence.
+// The samples must be on a grid, with a sample for every combination of the x, y and z coordinates in any order.
+// The streamlines start at the starts positions, or at the samples on the side of the grid at the min of x if starts is nil.
+func Streamtubes(field VectorField, starts [][3]float64, opt ...Options) (*grob.Fig, error) {
+	opts := computeOptions(Options{
+		AspectMode: grob.LayoutSceneAspectmodeData,
+		Camera:     DefaultVectorCamera,
+	}, opt...)
+	err := field.validate()
+	if err != nil {
+		return nil, err
+	}
+	nx, ny, nz := distinct(field.X), distinct(field.Y), distinct(field.Z)
+	if nx*ny*nz != len(field.X) {
+		return nil, fmt.Errorf("the samples are not on a grid, %d samples for %dx%dx%d coordinates", len(field.X), nx, ny, nz)
+	}
+
+	trace := &grob.Streamtube{
+		X:             field.X,
+		Y:             field.Y,
+		Z:             field.Z,
+		U:             field.U,
+		V:             field.V,
+		W:             field.W,
+		Sizeref:       opts.SizeRef,
+		Colorscale:    opts.Colorscale,
+		Hovertemplate: "(%{x}, %{y}, %{z})<br>norm=%{norm}<br>divergence=%{divergence}<extra></extra>",
+	}
+	if starts != nil {
+		x, y, z := make([]float64, len(starts)), make([]float64, len(starts)), make([]float64, len(starts))
+		for i, start := range starts {
+			x[i], y[i], z[i] = start[0], start[1], start[2]
+		}
+		trace.Starts = &grob.StreamtubeStarts{X: x, Y: y, Z: z}
+	}
+	fig := &grob.Fig{}
+	fig.AddTraces(trace)
+	fig.Layout = layout(opts)
+	return fig, nil
+}
+
+// distinct returns the number of distinct values
+func distinct(values []float64) int {
+	seen := map[float64]bool{}
+	for _, v := range values {
+		seen[v] = true
+	}
+	return len(seen)
+}
+
+// coneSizeref returns the scaled sizeref of the cones. plotly.js scales the longest cone to the min distance between two samples,
+// sizeref scales it to the mean spacing of the samples instead, leaving a gap between the cones
+func coneSizeref(field VectorField) float64 {
+	spacing := meanSpacing(field)
+	separation := minSeparation(field)
+	if spacing == 0 || separation == 0 || math.IsInf(separation, 1) {
+		return 0.5
+	}
+	return 0.8 * spacing / separation
+}
+
+// meanSpacing is the side of the volume of the bounding box of the samples divided by their number,
+// the bounding box is flat along the axes where all the samples have the same coordinate
+func meanSpacing(field VectorField) float64 {
+	size, dims := 1.0, 0
+	for _, values := range [][]float64{field.X, field.Y, field.Z} {
+		min, max := math.Inf(1), math.Inf(-1)
+		for _, v := range values {
+			min, max = math.Min(min, v), math.Max(max, v)
+		}
+		if max > min {
+			size *= max - min
+			dims++
+		}
+	}
+	if dims == 0 {
+		return 0
+	}
+	return math.Pow(size/float64(len(field.X)), 1/float64(dims))
+}
+
+// minSeparation is the min distance between two samples at different positions
+func minSeparation(field VectorField) float64 {
+	points := make([][3]float64, len(field.X))
+	for i := range points {
+		points[i] = [3]float64{field.X[i], field.Y[i], field.Z[i]}
+	}
+	sort.Slice(points, func(i, j int) bool { return points[i][0] < points[j][0] })
+
+	min := math.Inf(1)
+	for i := range points {
+		for j := i + 1; j < len(points) && points[j][0]-points[i][0] < min; j++ {
+			d := math.Sqrt((points[j][0]-points[i][0])*(points[j][0]-points[i][0]) +
+				(points[j][1]-points[i][1])*(points[j][1]-points[i][1]) +
+				(points[j][2]-points[i][2])*(points[j][2]-points[i][2]))
+			if d > 0 && d < min {
+				min = d
+			}
+		}
+	}
+	return min
+}
@@ -0,0 +1,94 @@
+package threed_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+	"github.com/MetalBlueberry/go-plotly/threed"
+)
+
+var _ = Describe("Vector field", func() {
+
+	// a rotation around z sampled on a 3x3x2 grid
+	grid := func() threed.VectorField {
+		field := threed.VectorField{}
+		for _, z := range []float64{0, 1} {
+			for _, y := range []float64{-1, 0, 1} {
+				for _, x := range []float64{-1, 0, 1} {
+					field.X = append(field.X, x)
+					field.Y = append(field.Y, y)
+					field.Z = append(field.Z, z)
+					field.U = append(field.U, -y)
+					field.V = append(field.V, x)
+					field.W = append(field.W, 0.1)
+				}
+			}
+		}
+		return field
+	}
+
+	It("Should size the cones from the spacing of the samples", func() {
+		fig, err := threed.Cones(grid(), threed.Options{Title: "Rotation"})
+		Expect(err).To(BeNil())
+		cone := fig.Data[0].(*grob.Cone)
+		Expect(cone.Type).To(Equal(grob.TraceTypeCone))
+		Expect(cone.X).To(HaveLen(18))
+		Expect(cone.Sizemode).To(Equal(grob.ConeSizemodeScaled))
+		// the closest samples are 1 apart and the mean spacing is the cube root of the 2 * 2 * 1 box divided by 18 samples
+		Expect(cone.Sizeref).To(BeNumerically("~", 0.8*0.6057, 1e-3))
+		Expect(cone.Anchor).To(Equal(grob.ConeAnchorTail))
+		Expect(fig.Layout.Scene.Camera).To(Equal(threed.DefaultVectorCamera))
+		Expect(fig.Layout.Scene.Aspectmode).To(Equal(grob.LayoutSceneAspectmodeData))
+		Expect(fig.Layout.Title.Text).To(Equal("Rotation"))
+
+		_, err = json.Marshal(fig)
+		Expect(err).To(BeNil())
+	})
+
+	It("Should enlarge the cones of samples that are close to each other", func() {
+		field := threed.VectorField{
+			X: []float64{0, 0.01, 10}, Y: []float64{0, 0, 0}, Z: []float64{0, 0, 0},
+			U: []float64{1, 1, 1}, V: []float64{0, 0, 0}, W: []float64{0, 0, 0},
+		}
+		fig, err := threed.Cones(field)
+		Expect(err).To(BeNil())
+		// the samples are on a line 10 long, 3.33 apart on average
+		Expect(fig.Data[0].(*grob.Cone).Sizeref).To(BeNumerically("~", 0.8*10.0/3/0.01, 1e-6))
+
+		fig, err = threed.Cones(field, threed.Options{SizeRef: 2})
+		Expect(err).To(BeNil())
+		Expect(fig.Data[0].(*grob.Cone).Sizeref).To(Equal(2.0))
+	})
+
+	It("Should plot streamtubes from the given starts", func() {
+		fig, err := threed.Streamtubes(grid(), [][3]float64{{-1, 0, 0}, {-1, 1, 0}})
+		Expect(err).To(BeNil())
+		tube := fig.Data[0].(*grob.Streamtube)
+		Expect(tube.Type).To(Equal(grob.TraceTypeStreamtube))
+		Expect(tube.Starts.X).To(Equal([]float64{-1, -1}))
+		Expect(tube.Starts.Y).To(Equal([]float64{0, 1}))
+		Expect(tube.Sizeref).To(Equal(0.0))
+
+		fig, err = threed.Streamtubes(grid(), nil)
+		Expect(err).To(BeNil())
+		Expect(fig.Data[0].(*grob.Streamtube).Starts).To(BeNil())
+	})
+
+	It("Should reject invalid fields", func() {
+		_, err := threed.Cones(threed.VectorField{})
+		Expect(err).NotTo(BeNil())
+
+		field := grid()
+		field.W = field.W[1:]
+		_, err = threed.Cones(field)
+		Expect(err).NotTo(BeNil())
+
+		field = grid()
+		field.X[0] = 0.5
+		_, err = threed.Streamtubes(field, nil)
+		Expect(err).NotTo(BeNil())
+	})
+})
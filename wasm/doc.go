@@ -0,0 +1,15 @@
+// Package wasm renders figures in the browser when the program is compiled to WebAssembly,
+// calling plotly.js through syscall/js with the same grob figures used on the server.
+//
+//	GOOS=js GOARCH=wasm go build -o main.wasm
+//
+// The page must load plotly.js before the program runs.
+//
+//	fig := express.Line(x, y)
+//	err := wasm.NewPlot("plot", fig)
+//	...
+//	fig.Data[0].(*grob.Scatter).Y = values
+//	err = wasm.React("plot", fig)
+//
+// The functions are only available with GOOS=js and GOARCH=wasm.
+package wasm
@@ -0,0 +1,74 @@
+//go:build js && wasm
+// +build js,wasm
+
+package wasm
+
+import (
+	"errors"
+	"fmt"
+	"syscall/js"
+
+	grob "github.com/MetalBlueberry/go-plotly/graph_objects"
+)
+
+// NewPlot creates the plot of the figure in the div of the given id with Plotly.newPlot, replacing any previous plot
+func NewPlot(divID string, fig *grob.Fig) error {
+	return call("newPlot", divID, fig)
+}
+
+// React updates the plot of the div with Plotly.react, which only redraws what changed.
+// It creates the plot if the div has none yet, so it can be called on every update of the figure.
+func React(divID string, fig *grob.Fig) error {
+	return call("react", divID, fig)
+}
+
+// Purge removes the plot of the div and its event listeners with Plotly.purge
+func Purge(divID string) error {
+	div, err := element(divID)
+	if err != nil {
+		return err
+	}
+	plotly, err := plotly()
+	if err != nil {
+		return err
+	}
+	plotly.Call("purge", div)
+	return nil
+}
+
+// call marshals the figure and calls the plotly function with the div and the figure, the promise it returns is not awaited
+func call(function string, divID string, fig *grob.Fig) error {
+	div, err := element(divID)
+	if err != nil {
+		return err
+	}
+	plotly, err := plotly()
+	if err != nil {
+		return err
+	}
+	figBytes, err := fig.ToPlotlyJSON()
+	if err != nil {
+		return fmt.Errorf("cannot marshal figure, %w", err)
+	}
+	figure := js.Global().Get("JSON").Call("parse", string(figBytes))
+	plotly.Call(function, div, figure)
+	return nil
+}
+
+// plotly returns the Plotly global of plotly.js
+func plotly() (js.Value, error) {
+	plotly := js.Global().Get("Plotly")
+	if !plotly.Truthy() {
+		return js.Value{}, errors.New("plotly.js is not loaded, Plotly is not defined")
+	}
+	return plotly, nil
+}
+
+// element returns the element of the document with the given id
+func element(id string) (js.Value, error) {
+	div := js.Global().Get("document").Call("getElementById", id)
+	if !div.Truthy() {
+		return js.Value{}, fmt.Errorf("element %s not found", id)
+	}
+	return div, nil
+}